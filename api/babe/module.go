@@ -1,6 +1,7 @@
 package babe
 
 import (
+	"bytes"
 	"reflect"
 
 	sc "github.com/LimeChain/goscale"
@@ -9,6 +10,7 @@ import (
 	babetypes "github.com/LimeChain/gosemble/primitives/babe"
 	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/session"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/LimeChain/gosemble/utils"
 )
@@ -71,7 +73,36 @@ func (m Module) Metadata() primitives.RuntimeApiMetadata {
 			Output: sc.ToCompact(metadata.TypesBabeEpoch),
 			Docs:   sc.Sequence[sc.Str]{""},
 		},
-		// TODO: add metadata for GenerateKeyOwnershipProof and SubmitReportEquivocationUnsignedExtrinsic
+		primitives.RuntimeApiMethodMetadata{
+			Name: "generate_key_ownership_proof",
+			Inputs: sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+				primitives.RuntimeApiMethodParamMetadata{
+					Name: "slot",
+					Type: sc.ToCompact(metadata.TypesSlot),
+				},
+				primitives.RuntimeApiMethodParamMetadata{
+					Name: "authority_id",
+					Type: sc.ToCompact(metadata.TypesSr25519PubKey),
+				},
+			},
+			Output: sc.ToCompact(metadata.TypesOptionSequenceU8),
+			Docs:   sc.Sequence[sc.Str]{""},
+		},
+		primitives.RuntimeApiMethodMetadata{
+			Name: "submit_report_equivocation_unsigned_extrinsic",
+			Inputs: sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+				primitives.RuntimeApiMethodParamMetadata{
+					Name: "equivocation_proof",
+					Type: sc.ToCompact(metadata.TypesBabeEquivocationProof),
+				},
+				primitives.RuntimeApiMethodParamMetadata{
+					Name: "key_owner_proof",
+					Type: sc.ToCompact(metadata.TypesSequenceU8),
+				},
+			},
+			Output: sc.ToCompact(metadata.TypesOptionEmptyTuple),
+			Docs:   sc.Sequence[sc.Str]{""},
+		},
 	}
 
 	return primitives.RuntimeApiMetadata{
@@ -167,11 +198,21 @@ func (m Module) NextEpoch() int64 {
 // implementations will instead use indexed data through an offchain
 // worker, not requiring older states to be available.
 func (m Module) GenerateKeyOwnershipProof(dataPtr int32, dataLen int32) int64 {
-	// TODO: Implement
+	b := m.memUtils.GetWasmMemorySlice(dataPtr, dataLen)
+	buffer := bytes.NewBuffer(b)
 
-	m.logger.Critical("GenerateKeyOwnershipProof is not implemented")
+	_, err := sc.DecodeU64(buffer)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
 
-	return m.memUtils.BytesToOffsetAndSize(sc.NewOption[sc.Empty](nil).Bytes())
+	authorityId, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	res := m.babe.HistoricalKeyOwnershipProof(authorityId)
+	return m.memUtils.BytesToOffsetAndSize(res.Bytes())
 }
 
 // Submits an unsigned extrinsic to report an equivocation. The caller
@@ -183,9 +224,28 @@ func (m Module) GenerateKeyOwnershipProof(dataPtr int32, dataLen int32) int64 {
 // reporting is disabled for the given runtime (i.e. this method is
 // hardcoded to return `None`). Only useful in an offchain context.
 func (m Module) SubmitReportEquivocationUnsignedExtrinsic(dataPtr int32, dataLen int32) int64 {
-	// TODO: Implement
+	b := m.memUtils.GetWasmMemorySlice(dataPtr, dataLen)
+	buffer := bytes.NewBuffer(b)
 
-	m.logger.Critical("SubmitReportEquivocationUnsignedExtrinsic is not implemented")
+	equivocationProof, err := babetypes.DecodeEquivocationProof(buffer)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	opaqueKeyOwnershipProof, err := sc.DecodeSequence[sc.U8](buffer)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	keyOwnerProof, err := session.DecodeMembershipProof(bytes.NewBuffer(sc.SequenceU8ToBytes(opaqueKeyOwnershipProof)))
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	err = m.babe.SubmitUnsignedEquivocationReport(equivocationProof, keyOwnerProof)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
 
 	return m.memUtils.BytesToOffsetAndSize(sc.NewOption[sc.Empty](nil).Bytes())
 }
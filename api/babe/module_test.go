@@ -2,18 +2,22 @@ package babe
 
 import (
 	"errors"
+	"io"
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/mocks"
 	"github.com/LimeChain/gosemble/primitives/babe"
 	babetypes "github.com/LimeChain/gosemble/primitives/babe"
 	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/session"
 	"github.com/LimeChain/gosemble/primitives/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -51,6 +55,32 @@ var (
 		Config:      genesisEpochConfig,
 	}
 
+	equivocationHeader = primitives.Header{
+		ParentHash:     primitives.Blake2bHash{FixedSequence: constants.ZeroAccountId.FixedSequence},
+		Number:         5,
+		StateRoot:      primitives.H256{FixedSequence: constants.OneAccountId.FixedSequence},
+		ExtrinsicsRoot: primitives.H256{FixedSequence: constants.ZeroAccountId.FixedSequence},
+		Digest:         primitives.NewDigest(sc.Sequence[primitives.DigestItem]{}),
+	}
+
+	equivocationProof = babetypes.EquivocationProof{
+		Offender:     pubKey1,
+		Slot:         babetypes.Slot(5),
+		FirstHeader:  equivocationHeader,
+		SecondHeader: equivocationHeader,
+	}
+
+	keyOwnerProof = session.MembershipProof{
+		SessionIndex: 1,
+		TrieNodes:    sc.Sequence[sc.Sequence[sc.U8]]{sc.BytesToSequenceU8([]byte{1, 2, 3})},
+		Validators:   2,
+	}
+	keyOwnerProofBytes = sc.BytesToSequenceU8(keyOwnerProof.Bytes())
+
+	dataPtr    = int32(0)
+	dataLen    = int32(1)
+	ptrAndSize = int64(5)
+
 	expectedErr = errors.New("panic")
 )
 
@@ -208,6 +238,61 @@ func Test_NextEpoch_Panics(t *testing.T) {
 	mockMemoryUtils.AssertNotCalled(t, "BytesToOffsetAndSize", epoch.Bytes())
 }
 
+func Test_GenerateKeyOwnershipProof(t *testing.T) {
+	setup()
+
+	authorityId := primitives.AccountId(pubKey1)
+	input := append(sc.U64(5).Bytes(), authorityId.Bytes()...)
+	expected := sc.NewOption[babetypes.OpaqueKeyOwnershipProof](keyOwnerProofBytes)
+
+	mockMemoryUtils.On("GetWasmMemorySlice", dataPtr, dataLen).Return(input)
+	mockBabe.On("HistoricalKeyOwnershipProof", authorityId).Return(expected)
+	mockMemoryUtils.On("BytesToOffsetAndSize", expected.Bytes()).Return(ptrAndSize)
+
+	result := target.GenerateKeyOwnershipProof(dataPtr, dataLen)
+
+	assert.Equal(t, ptrAndSize, result)
+	mockBabe.AssertCalled(t, "HistoricalKeyOwnershipProof", authorityId)
+}
+
+func Test_GenerateKeyOwnershipProof_Panics(t *testing.T) {
+	setup()
+
+	mockMemoryUtils.On("GetWasmMemorySlice", dataPtr, dataLen).Return([]byte{})
+
+	assert.PanicsWithValue(t, io.EOF.Error(), func() { target.GenerateKeyOwnershipProof(dataPtr, dataLen) })
+
+	mockBabe.AssertNotCalled(t, "HistoricalKeyOwnershipProof", mock.Anything)
+}
+
+func Test_SubmitReportEquivocationUnsignedExtrinsic(t *testing.T) {
+	setup()
+
+	input := append(equivocationProof.Bytes(), keyOwnerProofBytes.Bytes()...)
+
+	mockMemoryUtils.On("GetWasmMemorySlice", dataPtr, dataLen).Return(input)
+	mockBabe.On("SubmitUnsignedEquivocationReport", mock.Anything, keyOwnerProof).Return(nil)
+	mockMemoryUtils.On("BytesToOffsetAndSize", sc.NewOption[sc.Empty](nil).Bytes()).Return(ptrAndSize)
+
+	result := target.SubmitReportEquivocationUnsignedExtrinsic(dataPtr, dataLen)
+
+	assert.Equal(t, ptrAndSize, result)
+	mockBabe.AssertCalled(t, "SubmitUnsignedEquivocationReport", mock.Anything, keyOwnerProof)
+}
+
+func Test_SubmitReportEquivocationUnsignedExtrinsic_Panics(t *testing.T) {
+	setup()
+
+	input := append(equivocationProof.Bytes(), keyOwnerProofBytes.Bytes()...)
+
+	mockMemoryUtils.On("GetWasmMemorySlice", dataPtr, dataLen).Return(input)
+	mockBabe.On("SubmitUnsignedEquivocationReport", mock.Anything, keyOwnerProof).Return(expectedErr)
+
+	assert.PanicsWithValue(t, expectedErr.Error(), func() { target.SubmitReportEquivocationUnsignedExtrinsic(dataPtr, dataLen) })
+
+	mockMemoryUtils.AssertNotCalled(t, "BytesToOffsetAndSize", mock.Anything)
+}
+
 func Test_Module_Metadata(t *testing.T) {
 	setup()
 
@@ -238,6 +323,36 @@ func Test_Module_Metadata(t *testing.T) {
 				Output: sc.ToCompact(metadata.TypesBabeEpoch),
 				Docs:   sc.Sequence[sc.Str]{""},
 			},
+			primitives.RuntimeApiMethodMetadata{
+				Name: "generate_key_ownership_proof",
+				Inputs: sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+					primitives.RuntimeApiMethodParamMetadata{
+						Name: "slot",
+						Type: sc.ToCompact(metadata.TypesSlot),
+					},
+					primitives.RuntimeApiMethodParamMetadata{
+						Name: "authority_id",
+						Type: sc.ToCompact(metadata.TypesSr25519PubKey),
+					},
+				},
+				Output: sc.ToCompact(metadata.TypesOptionSequenceU8),
+				Docs:   sc.Sequence[sc.Str]{""},
+			},
+			primitives.RuntimeApiMethodMetadata{
+				Name: "submit_report_equivocation_unsigned_extrinsic",
+				Inputs: sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+					primitives.RuntimeApiMethodParamMetadata{
+						Name: "equivocation_proof",
+						Type: sc.ToCompact(metadata.TypesBabeEquivocationProof),
+					},
+					primitives.RuntimeApiMethodParamMetadata{
+						Name: "key_owner_proof",
+						Type: sc.ToCompact(metadata.TypesSequenceU8),
+					},
+				},
+				Output: sc.ToCompact(metadata.TypesOptionEmptyTuple),
+				Docs:   sc.Sequence[sc.Str]{""},
+			},
 		},
 		Docs: sc.Sequence[sc.Str]{"Babe consensus API module."},
 	}
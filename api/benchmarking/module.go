@@ -19,26 +19,28 @@ import (
 // For more information about the benchmarking process, see:
 // /docs/docs/development/benchmarking.md
 type Module struct {
-	modules       []primitives.Module
-	systemModule  system.Module
-	transactional support.Transactional[primitives.PostDispatchInfo]
-	decoder       types.RuntimeDecoder
-	memUtils      utils.WasmMemoryTranslator
-	hashing       io.Hashing
-	logger        log.RuntimeLogger
+	modules           []primitives.Module
+	systemModule      system.Module
+	transactional     support.Transactional[primitives.PostDispatchInfo]
+	transactionBroker io.TransactionBroker
+	decoder           types.RuntimeDecoder
+	memUtils          utils.WasmMemoryTranslator
+	hashing           io.Hashing
+	logger            log.RuntimeLogger
 }
 
 func New(systemIndex sc.U8, modules []primitives.Module, decoder types.RuntimeDecoder, storage io.Storage, transactionBroker io.TransactionBroker, logger log.RuntimeLogger) Module {
 	systemModule := primitives.MustGetModule(systemIndex, modules).(system.Module)
 
 	return Module{
-		modules:       modules,
-		systemModule:  systemModule,
-		decoder:       decoder,
-		transactional: support.NewTransactional[primitives.PostDispatchInfo](storage, transactionBroker, logger),
-		memUtils:      utils.NewMemoryTranslator(),
-		hashing:       io.NewHashing(),
-		logger:        logger,
+		modules:           modules,
+		systemModule:      systemModule,
+		decoder:           decoder,
+		transactional:     support.NewTransactional[primitives.PostDispatchInfo](storage, transactionBroker, logger),
+		transactionBroker: transactionBroker,
+		memUtils:          utils.NewMemoryTranslator(),
+		hashing:           io.NewHashing(),
+		logger:            logger,
 	}
 }
 
@@ -49,14 +51,11 @@ func New(systemIndex sc.U8, modules []primitives.Module, decoder types.RuntimeDe
 // which represent the SCALE-encoded benchmarking configuration.
 //
 // Executes a dispatch extrinsic call N times in isolated environment
-// by measuring the elapsed time in each execution.
+// by measuring the elapsed time in each execution. Each execution
+// starts from the same state, which is restored by rolling back the
+// storage transaction of the previous execution.
 //
 // Returns a pointer-size of the SCALE-encoded benchmarking result.
-//
-// TODO:
-// Implement DbCommit, DbWipe once the state implementation
-// in Gossamer supports caching and nested transactions.
-// https://github.com/ChainSafe/gossamer/discussions/3646
 func (m Module) BenchmarkDispatch(dataPtr int32, dataLen int32) int64 {
 	data := m.memUtils.GetWasmMemorySlice(dataPtr, dataLen)
 	buffer := bytes.NewBuffer(data)
@@ -190,22 +189,15 @@ func (m Module) executeBenchmark(config benchmarking.BenchmarkConfig, fn func(or
 
 	measuredDurations := []float64{}
 
-	benchmarking.StoreSnapshotDb()
-
 	// Always do at least one internal repeat.
 	repeats := int(config.InternalRepeats)
 	if repeats < 1 {
 		repeats = 1
 	}
 	for i := 1; i <= repeats; i++ {
-		// The dispatch call is executed in a transactional context,
-		// allowing to rollback and reset the state after each iteration.
-		// as an alternative of providing before hook.
-
-		benchmarking.RestoreSnapshotDb()
-
-		// Does nothing, for now
-		benchmarking.WipeDb()
+		// Each repeat is executed in its own storage transaction,
+		// which is rolled back to reset the state for the next one.
+		m.transactionBroker.Start()
 
 		// Set up the externalities environment for the setup we want to
 		// benchmark.
@@ -213,11 +205,6 @@ func (m Module) executeBenchmark(config benchmarking.BenchmarkConfig, fn func(or
 		// Sets the block number to 1 to allow emitting events
 		m.systemModule.StorageBlockNumberSet(1)
 
-		// Commit the externalities to the database, flushing the DB cache.
-		// This will enable worst case scenario for reading from the database.
-		// Does nothing, for now
-		benchmarking.CommitDb()
-
 		// Whitelist known storage keys.
 		m.whitelistWellKnownKeys()
 
@@ -228,7 +215,8 @@ func (m Module) executeBenchmark(config benchmarking.BenchmarkConfig, fn func(or
 		}
 
 		// Reset the read/write counter so we don't count
-		// operations in the setup process.
+		// operations in the setup process. Only the first access
+		// of each key is counted, as if it is read from a cold cache.
 		benchmarking.ResetReadWriteCount()
 
 		benchmarking.StartDbTracker()
@@ -240,9 +228,13 @@ func (m Module) executeBenchmark(config benchmarking.BenchmarkConfig, fn func(or
 
 		benchmarking.StopDbTracker()
 
-		// Commit the changes to get proper write count.
-		// Does nothing, for now
-		benchmarking.CommitDb()
+		// Keep the changes of the last repeat, so the resulting
+		// state can be inspected after the benchmark.
+		if i < repeats {
+			m.transactionBroker.Rollback()
+		} else {
+			m.transactionBroker.Commit()
+		}
 	}
 
 	// Calculate the average time.
@@ -252,9 +244,10 @@ func (m Module) executeBenchmark(config benchmarking.BenchmarkConfig, fn func(or
 	}
 
 	return benchmarking.BenchmarkResult{
-		Time:   sc.NewU128(int64(time)),
-		Reads:  sc.U32(benchmarking.DbReadCount()),
-		Writes: sc.U32(benchmarking.DbWriteCount()),
+		Time:      sc.NewU128(int64(time)),
+		Reads:     sc.U32(benchmarking.DbReadCount()),
+		Writes:    sc.U32(benchmarking.DbWriteCount()),
+		ProofSize: sc.U32(benchmarking.DbProofSize()),
 	}
 }
 
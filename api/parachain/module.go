@@ -138,7 +138,7 @@ func (m Module) ValidateBlock(dataPtr int32, dataLen int32) int64 {
 
 func (m Module) extractParachainInherentData(block primitives.Block) (parachain.InherentData, error) {
 	for _, extrinsic := range block.Extrinsics() {
-		if extrinsic.IsSigned() {
+		if !extrinsic.IsBare() {
 			continue
 		}
 		call := extrinsic.Function()
@@ -122,7 +122,7 @@ func Test_Module_ValidateBlock(t *testing.T) {
 	mockHashing.On("Blake256", header.Bytes()).Return(header.ParentHash.Bytes())
 	mockBlock.On("Header").Return(header)
 	mockBlock.On("Extrinsics").Return(sc.Sequence[primitives.UncheckedExtrinsic]{mockUncheckedExtrinsic})
-	mockUncheckedExtrinsic.On("IsSigned").Return(false)
+	mockUncheckedExtrinsic.On("IsBare").Return(true)
 	mockUncheckedExtrinsic.On("Function").Return(mockCall)
 	mockParachainSystem.On("GetIndex").Return(parachainIndex)
 	mockCall.On("ModuleIndex").Return(parachainIndex)
@@ -141,7 +141,7 @@ func Test_Module_ValidateBlock(t *testing.T) {
 	mockHashing.AssertCalled(t, "Blake256", header.Bytes())
 	mockBlock.AssertCalled(t, "Header")
 	mockBlock.AssertCalled(t, "Extrinsics")
-	mockUncheckedExtrinsic.AssertCalled(t, "IsSigned")
+	mockUncheckedExtrinsic.AssertCalled(t, "IsBare")
 	mockUncheckedExtrinsic.AssertCalled(t, "Function")
 	mockParachainSystem.AssertCalled(t, "GetIndex")
 	mockCall.AssertCalled(t, "ModuleIndex")
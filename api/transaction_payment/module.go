@@ -76,7 +76,7 @@ func (m Module) QueryInfo(dataPtr int32, dataLen int32) int64 {
 	dispatchInfo := primitives.GetDispatchInfo(ext.Function())
 
 	partialFee := sc.NewU128(0)
-	if ext.IsSigned() {
+	if !ext.IsBare() {
 		partialFee, err = m.txPayments.ComputeFee(length, dispatchInfo, constants.DefaultTip)
 		if err != nil {
 			m.logger.Critical(err.Error())
@@ -117,7 +117,7 @@ func (m Module) QueryFeeDetails(dataPtr int32, dataLen int32) int64 {
 	dispatchInfo := primitives.GetDispatchInfo(ext.Function())
 
 	var feeDetails tx_types.FeeDetails
-	if ext.IsSigned() {
+	if !ext.IsBare() {
 		feeDetails, err = m.txPayments.ComputeFeeDetails(length, dispatchInfo, constants.DefaultTip)
 		if err != nil {
 			m.logger.Critical(err.Error())
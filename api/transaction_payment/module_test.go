@@ -85,7 +85,7 @@ func Test_Module_QueryInfo_Signed(t *testing.T) {
 	mockCall.On("WeighData", baseWeight).Return(dispatchInfoWeight)
 	mockCall.On("ClassifyDispatch", baseWeight).Return(dispatchInfoClass)
 	mockCall.On("PaysFee", baseWeight).Return(dispatchInfoPays)
-	mockUxt.On("IsSigned").Return(true)
+	mockUxt.On("IsBare").Return(false)
 	mockTransactionPayment.On("ComputeFee", length, dispatchInfo, constants.DefaultTip).Return(partialFee, nil)
 	mockMemoryUtils.On("BytesToOffsetAndSize", runtimeDispatchInfo.Bytes()).Return(ptrAndSize)
 
@@ -99,7 +99,7 @@ func Test_Module_QueryInfo_Signed(t *testing.T) {
 	mockCall.AssertCalled(t, "WeighData", baseWeight)
 	mockCall.AssertCalled(t, "ClassifyDispatch", baseWeight)
 	mockCall.AssertCalled(t, "PaysFee", baseWeight)
-	mockUxt.AssertCalled(t, "IsSigned")
+	mockUxt.AssertCalled(t, "IsBare")
 	mockTransactionPayment.AssertCalled(t, "ComputeFee", length, dispatchInfo, constants.DefaultTip)
 	mockMemoryUtils.AssertCalled(t, "BytesToOffsetAndSize", runtimeDispatchInfo.Bytes())
 }
@@ -122,7 +122,7 @@ func Test_Module_QueryInfo_Unsigned(t *testing.T) {
 	mockCall.On("WeighData", baseWeight).Return(dispatchInfoWeight)
 	mockCall.On("ClassifyDispatch", baseWeight).Return(dispatchInfoClass)
 	mockCall.On("PaysFee", baseWeight).Return(dispatchInfoPays)
-	mockUxt.On("IsSigned").Return(false)
+	mockUxt.On("IsBare").Return(true)
 	mockMemoryUtils.On("BytesToOffsetAndSize", runtimeDispatchInfo.Bytes()).Return(ptrAndSize)
 
 	result := target.QueryInfo(dataPtr, dataLen)
@@ -135,7 +135,7 @@ func Test_Module_QueryInfo_Unsigned(t *testing.T) {
 	mockCall.AssertCalled(t, "WeighData", baseWeight)
 	mockCall.AssertCalled(t, "ClassifyDispatch", baseWeight)
 	mockCall.AssertCalled(t, "PaysFee", baseWeight)
-	mockUxt.AssertCalled(t, "IsSigned")
+	mockUxt.AssertCalled(t, "IsBare")
 	mockTransactionPayment.AssertNotCalled(t, "ComputeFee", mock.Anything, mock.Anything, mock.Anything)
 	mockMemoryUtils.AssertCalled(t, "BytesToOffsetAndSize", runtimeDispatchInfo.Bytes())
 }
@@ -192,7 +192,7 @@ func Test_Module_QueryInfo_ComputeFee_Panics(t *testing.T) {
 	mockCall.On("WeighData", baseWeight).Return(dispatchInfoWeight)
 	mockCall.On("ClassifyDispatch", baseWeight).Return(dispatchInfoClass)
 	mockCall.On("PaysFee", baseWeight).Return(dispatchInfoPays)
-	mockUxt.On("IsSigned").Return(true)
+	mockUxt.On("IsBare").Return(false)
 	mockTransactionPayment.On("ComputeFee", length, dispatchInfo, constants.DefaultTip).Return(partialFee, errPanic)
 
 	assert.PanicsWithValue(t,
@@ -207,7 +207,7 @@ func Test_Module_QueryInfo_ComputeFee_Panics(t *testing.T) {
 	mockCall.AssertCalled(t, "WeighData", baseWeight)
 	mockCall.AssertCalled(t, "ClassifyDispatch", baseWeight)
 	mockCall.AssertCalled(t, "PaysFee", baseWeight)
-	mockUxt.AssertCalled(t, "IsSigned")
+	mockUxt.AssertCalled(t, "IsBare")
 	mockTransactionPayment.AssertCalled(t, "ComputeFee", length, dispatchInfo, constants.DefaultTip)
 	mockMemoryUtils.AssertNotCalled(t, "BytesToOffsetAndSize", mock.Anything)
 }
@@ -233,7 +233,7 @@ func Test_Module_QueryFeeDetails_Signed(t *testing.T) {
 	mockCall.On("WeighData", baseWeight).Return(dispatchInfoWeight)
 	mockCall.On("ClassifyDispatch", baseWeight).Return(dispatchInfoClass)
 	mockCall.On("PaysFee", baseWeight).Return(dispatchInfoPays)
-	mockUxt.On("IsSigned").Return(true)
+	mockUxt.On("IsBare").Return(false)
 	mockTransactionPayment.On("ComputeFeeDetails", length, dispatchInfo, constants.DefaultTip).Return(feeDetails, nil)
 	mockMemoryUtils.On("BytesToOffsetAndSize", feeDetails.Bytes()).Return(ptrAndSize)
 
@@ -247,7 +247,7 @@ func Test_Module_QueryFeeDetails_Signed(t *testing.T) {
 	mockCall.AssertCalled(t, "WeighData", baseWeight)
 	mockCall.AssertCalled(t, "ClassifyDispatch", baseWeight)
 	mockCall.AssertCalled(t, "PaysFee", baseWeight)
-	mockUxt.AssertCalled(t, "IsSigned")
+	mockUxt.AssertCalled(t, "IsBare")
 	mockTransactionPayment.AssertCalled(t, "ComputeFeeDetails", length, dispatchInfo, constants.DefaultTip)
 	mockMemoryUtils.AssertCalled(t, "BytesToOffsetAndSize", feeDetails.Bytes())
 }
@@ -267,7 +267,7 @@ func Test_Module_QueryFeeDetails_Unsigned(t *testing.T) {
 	mockCall.On("WeighData", baseWeight).Return(dispatchInfoWeight)
 	mockCall.On("ClassifyDispatch", baseWeight).Return(dispatchInfoClass)
 	mockCall.On("PaysFee", baseWeight).Return(dispatchInfoPays)
-	mockUxt.On("IsSigned").Return(false)
+	mockUxt.On("IsBare").Return(true)
 	mockMemoryUtils.On("BytesToOffsetAndSize", feeDetails.Bytes()).Return(ptrAndSize)
 
 	result := target.QueryFeeDetails(dataPtr, dataLen)
@@ -280,7 +280,7 @@ func Test_Module_QueryFeeDetails_Unsigned(t *testing.T) {
 	mockCall.AssertCalled(t, "WeighData", baseWeight)
 	mockCall.AssertCalled(t, "ClassifyDispatch", baseWeight)
 	mockCall.AssertCalled(t, "PaysFee", baseWeight)
-	mockUxt.AssertCalled(t, "IsSigned")
+	mockUxt.AssertCalled(t, "IsBare")
 	mockTransactionPayment.AssertNotCalled(t, "ComputeFeeDetails", mock.Anything, mock.Anything, mock.Anything)
 	mockMemoryUtils.AssertCalled(t, "BytesToOffsetAndSize", feeDetails.Bytes())
 }
@@ -300,7 +300,7 @@ func Test_Module_QueryFeeDetails_DecodeUncheckedExtrinsic_Panics(t *testing.T) {
 
 	mockMemoryUtils.AssertCalled(t, "GetWasmMemorySlice", dataPtr, dataLen)
 	mockRuntimeDecoder.AssertExpectations(t)
-	mockUxt.AssertNotCalled(t, "IsSigned")
+	mockUxt.AssertNotCalled(t, "IsBare")
 }
 
 func Test_Module_QueryFeeDetails_DecodeU32_Panics(t *testing.T) {
@@ -318,7 +318,7 @@ func Test_Module_QueryFeeDetails_DecodeU32_Panics(t *testing.T) {
 
 	mockMemoryUtils.AssertCalled(t, "GetWasmMemorySlice", dataPtr, dataLen)
 	mockRuntimeDecoder.AssertExpectations(t)
-	mockUxt.AssertNotCalled(t, "IsSigned")
+	mockUxt.AssertNotCalled(t, "IsBare")
 }
 
 func Test_Module_QueryFeeDetails_ComputeFeeDetails_Panics(t *testing.T) {
@@ -342,7 +342,7 @@ func Test_Module_QueryFeeDetails_ComputeFeeDetails_Panics(t *testing.T) {
 	mockCall.On("WeighData", baseWeight).Return(dispatchInfoWeight)
 	mockCall.On("ClassifyDispatch", baseWeight).Return(dispatchInfoClass)
 	mockCall.On("PaysFee", baseWeight).Return(dispatchInfoPays)
-	mockUxt.On("IsSigned").Return(true)
+	mockUxt.On("IsBare").Return(false)
 	mockTransactionPayment.On("ComputeFeeDetails", length, dispatchInfo, constants.DefaultTip).Return(feeDetails, errPanic)
 
 	assert.PanicsWithValue(t,
@@ -357,7 +357,7 @@ func Test_Module_QueryFeeDetails_ComputeFeeDetails_Panics(t *testing.T) {
 	mockCall.AssertCalled(t, "WeighData", baseWeight)
 	mockCall.AssertCalled(t, "ClassifyDispatch", baseWeight)
 	mockCall.AssertCalled(t, "PaysFee", baseWeight)
-	mockUxt.AssertCalled(t, "IsSigned")
+	mockUxt.AssertCalled(t, "IsBare")
 	mockTransactionPayment.AssertCalled(t, "ComputeFeeDetails", length, dispatchInfo, constants.DefaultTip)
 	mockMemoryUtils.AssertNotCalled(t, "BytesToOffsetAndSize", mock.Anything)
 }
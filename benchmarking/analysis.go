@@ -14,6 +14,7 @@ type benchmarkResult struct {
 	components    []linear
 	extrinsicTime uint64
 	reads, writes uint64
+	proofSize     uint64
 }
 
 func newBenchmarkResult(benchmarkRes benchmarkingtypes.BenchmarkResult, components []linear) benchmarkResult {
@@ -21,25 +22,78 @@ func newBenchmarkResult(benchmarkRes benchmarkingtypes.BenchmarkResult, componen
 		extrinsicTime: benchmarkRes.Time.ToBigInt().Uint64(),
 		reads:         uint64(benchmarkRes.Reads),
 		writes:        uint64(benchmarkRes.Writes),
+		proofSize:     uint64(benchmarkRes.ProofSize),
 		components:    components,
 	}
 }
 
 type componentSlope struct {
-	ComponentName string
-	Slope         uint64
+	ComponentName string `json:"component"`
+	Slope         uint64 `json:"slope"`
 }
 
+// componentRange is the range of values, over which a component is benchmarked.
+type componentRange struct {
+	ComponentName string `json:"component"`
+	Min           uint32 `json:"min"`
+	Max           uint32 `json:"max"`
+}
+
+// regressionAnalysis holds the models of each benchmark metric, fitted by the min-squares analysis.
+type regressionAnalysis struct {
+	extrinsicTime, reads, writes, proofSize regressionModel
+}
+
+func (r regressionAnalysis) String() string {
+	return fmt.Sprintf("R2ExtrinsicTime: %.4f, R2Reads: %.4f, R2Writes: %.4f, R2ProofSize: %.4f, StdErrExtrinsicTime: %.2f, StdErrReads: %.2f, StdErrWrites: %.2f, StdErrProofSize: %.2f", r.extrinsicTime.rSquared, r.reads.rSquared, r.writes.rSquared, r.proofSize.rSquared, r.extrinsicTime.standardErrors, r.reads.standardErrors, r.writes.standardErrors, r.proofSize.standardErrors)
+}
+
+const (
+	analysisMedianSlopes = "median-slopes"
+	analysisMedianValues = "median-values"
+	analysisMinSquares   = "min-squares"
+	// analysisMax selects the worst case of the median-slopes and min-squares analyses for each weight term.
+	analysisMax = "max"
+)
+
 type analysis struct {
-	baseExtrinsicTime, baseReads, baseWrites                 uint64
-	slopesExtrinsicTime, slopesReads, slopesWrites           []uint64
-	minimumExtrinsicTime, minimumReads, minimumWrites        uint64
-	componentExtrinsicTimes, componentReads, componentWrites []componentSlope
-	componentNames                                           []string
+	baseExtrinsicTime, baseReads, baseWrites, baseProofSize                       uint64
+	slopesExtrinsicTime, slopesReads, slopesWrites, slopesProofSize               []uint64
+	minimumExtrinsicTime, minimumReads, minimumWrites, minimumProofSize           uint64
+	componentExtrinsicTimes, componentReads, componentWrites, componentProofSizes []componentSlope
+	componentNames                                                                []string
+	componentRanges                                                               []componentRange
+	regression                                                                    *regressionAnalysis
 }
 
 func (a analysis) String() string {
-	return fmt.Sprintf("BaseExtrinsicTime: %d, BaseReads: %d, BaseWrites: %d, SlopesExtrinsicTime: %d, SlopesReads: %d, SlopesWrites: %d, MinExtrinsicTime: %d, MinReads: %d, MinWrites: %d", a.baseExtrinsicTime, a.baseReads, a.baseWrites, a.slopesExtrinsicTime, a.slopesReads, a.slopesWrites, a.minimumExtrinsicTime, a.minimumReads, a.minimumWrites)
+	summary := fmt.Sprintf("BaseExtrinsicTime: %d, BaseReads: %d, BaseWrites: %d, BaseProofSize: %d, SlopesExtrinsicTime: %d, SlopesReads: %d, SlopesWrites: %d, SlopesProofSize: %d, MinExtrinsicTime: %d, MinReads: %d, MinWrites: %d, MinProofSize: %d", a.baseExtrinsicTime, a.baseReads, a.baseWrites, a.baseProofSize, a.slopesExtrinsicTime, a.slopesReads, a.slopesWrites, a.slopesProofSize, a.minimumExtrinsicTime, a.minimumReads, a.minimumWrites, a.minimumProofSize)
+	if a.regression != nil {
+		summary += ", " + a.regression.String()
+	}
+	return summary
+}
+
+// runAnalysis analyses `benchmarkResults` with the analysis named by `choice`.
+func runAnalysis(choice string, benchmarkResults []benchmarkResult) (analysis, error) {
+	var res analysis
+
+	switch choice {
+	case analysisMedianSlopes:
+		res = medianSlopesAnalysis(benchmarkResults)
+	case analysisMedianValues:
+		res = medianValuesAnalysis(benchmarkResults)
+	case analysisMinSquares:
+		res = minSquaresAnalysis(benchmarkResults)
+	case analysisMax:
+		res = maxAnalysis(medianSlopesAnalysis(benchmarkResults), minSquaresAnalysis(benchmarkResults))
+	default:
+		return analysis{}, fmt.Errorf("unknown analysis [%s]", choice)
+	}
+
+	res.componentRanges = componentRanges(benchmarkResults)
+
+	return res, nil
 }
 
 func medianSlopesAnalysis(benchmarkResults []benchmarkResult) analysis {
@@ -54,8 +108,8 @@ func medianSlopesAnalysis(benchmarkResults []benchmarkResult) analysis {
 	results := make([]struct {
 		others []float64
 		values []struct {
-			componentValue               float64
-			extrinsicTime, reads, writes float64
+			componentValue                          float64
+			extrinsicTime, reads, writes, proofSize float64
 		}
 	}, len(benchmarkResults[0].components))
 
@@ -102,27 +156,28 @@ func medianSlopesAnalysis(benchmarkResults []benchmarkResult) analysis {
 
 			results[i].values = append(
 				results[i].values, struct {
-					componentValue               float64
-					extrinsicTime, reads, writes float64
-				}{float64(br.components[i].Value()), float64(br.extrinsicTime), float64(br.reads), float64(br.writes)},
+					componentValue                          float64
+					extrinsicTime, reads, writes, proofSize float64
+				}{float64(br.components[i].Value()), float64(br.extrinsicTime), float64(br.reads), float64(br.writes), float64(br.proofSize)},
 			)
 		}
 	}
 
 	models := make([]struct {
-		offsetExtrinsicTime, offsetReads, offsetWrites float64
-		slopeExtrinsicTime, slopeReads, slopeWrites    float64
+		offsetExtrinsicTime, offsetReads, offsetWrites, offsetProofSize float64
+		slopeExtrinsicTime, slopeReads, slopeWrites, slopeProofSize     float64
 	}, len(results))
 
 	for i, r := range results {
-		slopes := []struct{ slopeExtrinsicTime, slopeReads, slopeWrites float64 }{}
+		slopes := []struct{ slopeExtrinsicTime, slopeReads, slopeWrites, slopeProofSize float64 }{}
 		for y, v1 := range r.values {
 			for _, v2 := range r.values[y+1:] {
 				if v1.componentValue != v2.componentValue {
-					slopes = append(slopes, struct{ slopeExtrinsicTime, slopeReads, slopeWrites float64 }{
+					slopes = append(slopes, struct{ slopeExtrinsicTime, slopeReads, slopeWrites, slopeProofSize float64 }{
 						(v1.extrinsicTime - v2.extrinsicTime) / (v1.componentValue - v2.componentValue),
 						(v1.reads - v2.reads) / (v1.componentValue - v2.componentValue),
 						(v1.writes - v2.writes) / (v1.componentValue - v2.componentValue),
+						(v1.proofSize - v2.proofSize) / (v1.componentValue - v2.componentValue),
 					})
 				}
 			}
@@ -148,12 +203,19 @@ func medianSlopesAnalysis(benchmarkResults []benchmarkResult) analysis {
 		})
 		models[i].slopeWrites = slopes[midIndex].slopeWrites
 
-		offsets := []struct{ offsetExtrinsicTime, offsetReads, offsetWrites float64 }{}
+		// slope proof size
+		sort.Slice(slopes, func(i, j int) bool {
+			return uint64(slopes[i].slopeProofSize) < uint64(slopes[j].slopeProofSize)
+		})
+		models[i].slopeProofSize = slopes[midIndex].slopeProofSize
+
+		offsets := []struct{ offsetExtrinsicTime, offsetReads, offsetWrites, offsetProofSize float64 }{}
 		for _, v := range r.values {
-			offsets = append(offsets, struct{ offsetExtrinsicTime, offsetReads, offsetWrites float64 }{
+			offsets = append(offsets, struct{ offsetExtrinsicTime, offsetReads, offsetWrites, offsetProofSize float64 }{
 				float64(v.extrinsicTime) - models[i].slopeExtrinsicTime*float64(v.componentValue),
 				float64(v.reads) - models[i].slopeReads*float64(v.componentValue),
 				float64(v.writes) - models[i].slopeWrites*float64(v.componentValue),
+				float64(v.proofSize) - models[i].slopeProofSize*float64(v.componentValue),
 			})
 		}
 
@@ -176,22 +238,30 @@ func medianSlopesAnalysis(benchmarkResults []benchmarkResult) analysis {
 			return uint64(offsets[i].offsetWrites) < uint64(offsets[j].offsetWrites)
 		})
 		models[i].offsetWrites = offsets[midIndex].offsetWrites
+
+		// offset proof size
+		sort.Slice(offsets, func(i, j int) bool {
+			return uint64(offsets[i].offsetProofSize) < uint64(offsets[j].offsetProofSize)
+		})
+		models[i].offsetProofSize = offsets[midIndex].offsetProofSize
 	}
 
 	for i, _ := range models {
-		over := struct{ overExtrinsicTime, overReads, overWrites float64 }{}
+		over := struct{ overExtrinsicTime, overReads, overWrites, overProofSize float64 }{}
 
 		for y, o := range results[i].others {
 			if y != i {
 				over.overExtrinsicTime += models[y].slopeExtrinsicTime * o
 				over.overReads += models[y].slopeReads * o
 				over.overWrites += models[y].slopeWrites * o
+				over.overProofSize += models[y].slopeProofSize * o
 			}
 		}
 
 		models[i].offsetExtrinsicTime -= over.overExtrinsicTime
 		models[i].offsetReads -= over.overReads
 		models[i].offsetWrites -= over.overWrites
+		models[i].offsetProofSize -= over.overProofSize
 	}
 
 	// analysis
@@ -263,6 +333,28 @@ func medianSlopesAnalysis(benchmarkResults []benchmarkResult) analysis {
 	})
 	res.minimumWrites = benchmarkResults[0].writes
 
+	// proof size
+	offsetProofSize := float64(0)
+	if len(models) > 0 {
+		offsetProofSize = models[0].offsetProofSize
+	}
+	res.baseProofSize = uint64(offsetProofSize + 0.000_000_005)
+
+	for i, m := range models {
+		slope := uint64(math.Max(m.slopeProofSize, 0) + 0.000_000_005)
+		res.slopesProofSize = append(res.slopesProofSize, slope)
+		if slope > 0 {
+			componentName := benchmarkResults[0].components[i].Name()
+			componentSlope := componentSlope{ComponentName: componentName, Slope: slope}
+			res.componentProofSizes = append(res.componentProofSizes, componentSlope)
+		}
+	}
+
+	sort.Slice(benchmarkResults, func(i, j int) bool {
+		return benchmarkResults[i].proofSize < benchmarkResults[j].proofSize
+	})
+	res.minimumProofSize = benchmarkResults[0].proofSize
+
 	res.componentNames = make([]string, len(benchmarkResults[0].components))
 	for i, c := range benchmarkResults[0].components {
 		res.componentNames[i] = c.Name()
@@ -304,5 +396,166 @@ func medianValuesAnalysis(benchmarkResults []benchmarkResult) analysis {
 	res.baseWrites = benchmarkResults[midIndex].writes
 	res.minimumWrites = benchmarkResults[0].writes
 
+	// proof size
+	sort.Slice(benchmarkResults, func(i, j int) bool {
+		return benchmarkResults[i].proofSize < benchmarkResults[j].proofSize
+	})
+
+	res.baseProofSize = benchmarkResults[midIndex].proofSize
+	res.minimumProofSize = benchmarkResults[0].proofSize
+
+	return res
+}
+
+func minSquaresAnalysis(benchmarkResults []benchmarkResult) analysis {
+	if len(benchmarkResults) == 0 {
+		return analysis{}
+	}
+
+	if len(benchmarkResults[0].components) == 0 {
+		return medianValuesAnalysis(benchmarkResults)
+	}
+
+	xs := make([][]float64, len(benchmarkResults))
+	extrinsicTimes := make([]float64, len(benchmarkResults))
+	reads := make([]float64, len(benchmarkResults))
+	writes := make([]float64, len(benchmarkResults))
+	proofSizes := make([]float64, len(benchmarkResults))
+	for i, br := range benchmarkResults {
+		xs[i] = make([]float64, len(br.components))
+		for y, c := range br.components {
+			xs[i][y] = float64(c.Value())
+		}
+		extrinsicTimes[i] = float64(br.extrinsicTime)
+		reads[i] = float64(br.reads)
+		writes[i] = float64(br.writes)
+		proofSizes[i] = float64(br.proofSize)
+	}
+
+	regression := regressionAnalysis{
+		extrinsicTime: fitLinearModel(xs, extrinsicTimes),
+		reads:         fitLinearModel(xs, reads),
+		writes:        fitLinearModel(xs, writes),
+		proofSize:     fitLinearModel(xs, proofSizes),
+	}
+
+	res := analysis{regression: &regression}
+
+	res.componentNames = make([]string, len(benchmarkResults[0].components))
+	for i, c := range benchmarkResults[0].components {
+		res.componentNames[i] = c.Name()
+	}
+
+	res.baseExtrinsicTime, res.slopesExtrinsicTime = roundModel(regression.extrinsicTime, 1000)
+	res.componentExtrinsicTimes = componentSlopes(res.componentNames, res.slopesExtrinsicTime)
+
+	res.baseReads, res.slopesReads = roundModel(regression.reads, 1)
+	res.componentReads = componentSlopes(res.componentNames, res.slopesReads)
+
+	res.baseWrites, res.slopesWrites = roundModel(regression.writes, 1)
+	res.componentWrites = componentSlopes(res.componentNames, res.slopesWrites)
+
+	res.baseProofSize, res.slopesProofSize = roundModel(regression.proofSize, 1)
+	res.componentProofSizes = componentSlopes(res.componentNames, res.slopesProofSize)
+
+	res.minimumExtrinsicTime = benchmarkResults[0].extrinsicTime
+	res.minimumReads = benchmarkResults[0].reads
+	res.minimumWrites = benchmarkResults[0].writes
+	res.minimumProofSize = benchmarkResults[0].proofSize
+	for _, br := range benchmarkResults[1:] {
+		res.minimumExtrinsicTime = min(res.minimumExtrinsicTime, br.extrinsicTime)
+		res.minimumReads = min(res.minimumReads, br.reads)
+		res.minimumWrites = min(res.minimumWrites, br.writes)
+		res.minimumProofSize = min(res.minimumProofSize, br.proofSize)
+	}
+
 	return res
 }
+
+// maxAnalysis combines `a` and `b` into the worst case of both, by taking the maximum base and slopes of each weight term.
+func maxAnalysis(a, b analysis) analysis {
+	res := analysis{
+		componentNames: a.componentNames,
+		regression:     a.regression,
+	}
+	if len(b.componentNames) > len(res.componentNames) {
+		res.componentNames = b.componentNames
+	}
+	if res.regression == nil {
+		res.regression = b.regression
+	}
+
+	res.baseExtrinsicTime = max(a.baseExtrinsicTime, b.baseExtrinsicTime)
+	res.slopesExtrinsicTime = maxSlopes(a.slopesExtrinsicTime, b.slopesExtrinsicTime)
+	res.componentExtrinsicTimes = componentSlopes(res.componentNames, res.slopesExtrinsicTime)
+	res.minimumExtrinsicTime = min(a.minimumExtrinsicTime, b.minimumExtrinsicTime)
+
+	res.baseReads = max(a.baseReads, b.baseReads)
+	res.slopesReads = maxSlopes(a.slopesReads, b.slopesReads)
+	res.componentReads = componentSlopes(res.componentNames, res.slopesReads)
+	res.minimumReads = min(a.minimumReads, b.minimumReads)
+
+	res.baseWrites = max(a.baseWrites, b.baseWrites)
+	res.slopesWrites = maxSlopes(a.slopesWrites, b.slopesWrites)
+	res.componentWrites = componentSlopes(res.componentNames, res.slopesWrites)
+	res.minimumWrites = min(a.minimumWrites, b.minimumWrites)
+
+	res.baseProofSize = max(a.baseProofSize, b.baseProofSize)
+	res.slopesProofSize = maxSlopes(a.slopesProofSize, b.slopesProofSize)
+	res.componentProofSizes = componentSlopes(res.componentNames, res.slopesProofSize)
+	res.minimumProofSize = min(a.minimumProofSize, b.minimumProofSize)
+
+	return res
+}
+
+// roundModel rounds the intercept and slopes of `model`, multiplied by `scale`, to non-negative integers.
+func roundModel(model regressionModel, scale float64) (uint64, []uint64) {
+	slopes := make([]uint64, len(model.slopes))
+	for i, slope := range model.slopes {
+		slopes[i] = uint64(math.Round(math.Max(slope*scale, 0)))
+	}
+	return uint64(math.Round(math.Max(model.intercept*scale, 0))), slopes
+}
+
+func maxSlopes(a, b []uint64) []uint64 {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	slopes := make([]uint64, len(a))
+	copy(slopes, a)
+	for i, slope := range b {
+		slopes[i] = max(slopes[i], slope)
+	}
+	return slopes
+}
+
+// componentSlopes returns the non-zero slopes of the named components.
+func componentSlopes(componentNames []string, slopes []uint64) []componentSlope {
+	var res []componentSlope
+	for i, slope := range slopes {
+		if slope > 0 {
+			res = append(res, componentSlope{ComponentName: componentNames[i], Slope: slope})
+		}
+	}
+	return res
+}
+
+// componentRanges returns the range of values of each component in `benchmarkResults`.
+func componentRanges(benchmarkResults []benchmarkResult) []componentRange {
+	if len(benchmarkResults) == 0 {
+		return nil
+	}
+
+	ranges := make([]componentRange, len(benchmarkResults[0].components))
+	for i, c := range benchmarkResults[0].components {
+		ranges[i] = componentRange{ComponentName: c.Name(), Min: c.Value(), Max: c.Value()}
+	}
+	for _, br := range benchmarkResults[1:] {
+		for i, c := range br.components {
+			ranges[i].Min = min(ranges[i].Min, c.Value())
+			ranges[i].Max = max(ranges[i].Max, c.Value())
+		}
+	}
+
+	return ranges
+}
@@ -10,14 +10,14 @@ import (
 // https://github.com/LimeChain/polkadot-sdk/blob/03841f6c0f51c6be6f491ce404e40d8323c994f1/substrate/frame/benchmarking/src/analysis.rs#L589
 func TestMedianSlopesAnalysis(t *testing.T) {
 	data := []benchmarkResult{
-		{[]linear{{value: 1}, {value: 5}}, 11_500_000, 3, 10},
-		{[]linear{{value: 2}, {value: 5}}, 12_500_000, 4, 10},
-		{[]linear{{value: 3}, {value: 5}}, 13_500_000, 5, 10},
-		{[]linear{{value: 4}, {value: 5}}, 14_500_000, 6, 10},
-		{[]linear{{value: 3}, {value: 1}}, 13_100_000, 5, 2},
-		{[]linear{{value: 3}, {value: 3}}, 13_300_000, 5, 6},
-		{[]linear{{value: 3}, {value: 7}}, 13_700_000, 5, 14},
-		{[]linear{{value: 3}, {value: 10}}, 14_000_000, 5, 20},
+		{[]linear{{value: 1}, {value: 5}}, 11_500_000, 3, 10, 1350},
+		{[]linear{{value: 2}, {value: 5}}, 12_500_000, 4, 10, 1450},
+		{[]linear{{value: 3}, {value: 5}}, 13_500_000, 5, 10, 1550},
+		{[]linear{{value: 4}, {value: 5}}, 14_500_000, 6, 10, 1650},
+		{[]linear{{value: 3}, {value: 1}}, 13_100_000, 5, 2, 1350},
+		{[]linear{{value: 3}, {value: 3}}, 13_300_000, 5, 6, 1450},
+		{[]linear{{value: 3}, {value: 7}}, 13_700_000, 5, 14, 1650},
+		{[]linear{{value: 3}, {value: 10}}, 14_000_000, 5, 20, 1800},
 	}
 
 	expectedAnalysis := analysis{
@@ -30,9 +30,13 @@ func TestMedianSlopesAnalysis(t *testing.T) {
 		baseWrites:              0,
 		slopesWrites:            []uint64{0, 2},
 		minimumWrites:           2,
+		baseProofSize:           1000,
+		slopesProofSize:         []uint64{100, 50},
+		minimumProofSize:        1350,
 		componentExtrinsicTimes: []componentSlope{{Slope: 1000000000}, {Slope: 100000000}},
 		componentReads:          []componentSlope{{Slope: 1}},
 		componentWrites:         []componentSlope{{Slope: 2}},
+		componentProofSizes:     []componentSlope{{Slope: 100}, {Slope: 50}},
 		componentNames:          []string{"", ""},
 	}
 
@@ -45,14 +49,14 @@ func TestMedianSlopesAnalysis(t *testing.T) {
 
 func TestMedianValuesAnalysis(t *testing.T) {
 	data := []benchmarkResult{
-		{[]linear{}, 11_500_000, 3, 10},
-		{[]linear{}, 12_500_000, 4, 10},
-		{[]linear{}, 13_500_000, 5, 10},
-		{[]linear{}, 14_500_000, 6, 10},
-		{[]linear{}, 13_100_000, 5, 2},
-		{[]linear{}, 13_300_000, 5, 6},
-		{[]linear{}, 13_700_000, 5, 14},
-		{[]linear{}, 14_000_000, 5, 20},
+		{[]linear{}, 11_500_000, 3, 10, 1350},
+		{[]linear{}, 12_500_000, 4, 10, 1450},
+		{[]linear{}, 13_500_000, 5, 10, 1550},
+		{[]linear{}, 14_500_000, 6, 10, 1650},
+		{[]linear{}, 13_100_000, 5, 2, 1350},
+		{[]linear{}, 13_300_000, 5, 6, 1450},
+		{[]linear{}, 13_700_000, 5, 14, 1650},
+		{[]linear{}, 14_000_000, 5, 20, 1800},
 	}
 
 	expectedAnalysis := analysis{
@@ -62,6 +66,8 @@ func TestMedianValuesAnalysis(t *testing.T) {
 		minimumReads:         3,
 		baseWrites:           10,
 		minimumWrites:        2,
+		baseProofSize:        1550,
+		minimumProofSize:     1350,
 	}
 
 	medianSlopesRes := medianSlopesAnalysis(data)
@@ -73,3 +79,117 @@ func TestMedianValuesAnalysis(t *testing.T) {
 	medianValuesRes = medianValuesAnalysis([]benchmarkResult{})
 	assert.Equal(t, analysis{}, medianValuesRes)
 }
+
+func TestMinSquaresAnalysis(t *testing.T) {
+	data := []benchmarkResult{
+		{[]linear{{value: 1}, {value: 5}}, 11_500_000, 3, 10, 1350},
+		{[]linear{{value: 2}, {value: 5}}, 12_500_000, 4, 10, 1450},
+		{[]linear{{value: 3}, {value: 5}}, 13_500_000, 5, 10, 1550},
+		{[]linear{{value: 4}, {value: 5}}, 14_500_000, 6, 10, 1650},
+		{[]linear{{value: 3}, {value: 1}}, 13_100_000, 5, 2, 1350},
+		{[]linear{{value: 3}, {value: 3}}, 13_300_000, 5, 6, 1450},
+		{[]linear{{value: 3}, {value: 7}}, 13_700_000, 5, 14, 1650},
+		{[]linear{{value: 3}, {value: 10}}, 14_000_000, 5, 20, 1800},
+	}
+
+	minSquaresRes := minSquaresAnalysis(data)
+
+	assert.Equal(t, uint64(10_000_000_000), minSquaresRes.baseExtrinsicTime)
+	assert.Equal(t, []uint64{1_000_000_000, 100_000_000}, minSquaresRes.slopesExtrinsicTime)
+	assert.Equal(t, uint64(11_500_000), minSquaresRes.minimumExtrinsicTime)
+	assert.Equal(t, uint64(2), minSquaresRes.baseReads)
+	assert.Equal(t, []uint64{1, 0}, minSquaresRes.slopesReads)
+	assert.Equal(t, uint64(3), minSquaresRes.minimumReads)
+	assert.Equal(t, uint64(0), minSquaresRes.baseWrites)
+	assert.Equal(t, []uint64{0, 2}, minSquaresRes.slopesWrites)
+	assert.Equal(t, uint64(2), minSquaresRes.minimumWrites)
+	assert.Equal(t, uint64(1000), minSquaresRes.baseProofSize)
+	assert.Equal(t, []uint64{100, 50}, minSquaresRes.slopesProofSize)
+	assert.Equal(t, uint64(1350), minSquaresRes.minimumProofSize)
+	assert.Equal(t, []componentSlope{{Slope: 1000000000}, {Slope: 100000000}}, minSquaresRes.componentExtrinsicTimes)
+	assert.Equal(t, []componentSlope{{Slope: 1}}, minSquaresRes.componentReads)
+	assert.Equal(t, []componentSlope{{Slope: 2}}, minSquaresRes.componentWrites)
+	assert.Equal(t, []componentSlope{{Slope: 100}, {Slope: 50}}, minSquaresRes.componentProofSizes)
+	assert.Equal(t, []string{"", ""}, minSquaresRes.componentNames)
+	assert.InDelta(t, 1, minSquaresRes.regression.extrinsicTime.rSquared, 1e-9)
+	assert.InDelta(t, 1, minSquaresRes.regression.reads.rSquared, 1e-9)
+	assert.InDelta(t, 1, minSquaresRes.regression.writes.rSquared, 1e-9)
+	assert.InDelta(t, 1, minSquaresRes.regression.proofSize.rSquared, 1e-9)
+
+	minSquaresRes = minSquaresAnalysis([]benchmarkResult{})
+	assert.Equal(t, analysis{}, minSquaresRes)
+}
+
+func TestMaxAnalysis(t *testing.T) {
+	a := analysis{
+		baseExtrinsicTime:    100,
+		slopesExtrinsicTime:  []uint64{10, 0},
+		minimumExtrinsicTime: 50,
+		baseReads:            2,
+		slopesReads:          []uint64{1, 0},
+		minimumReads:         1,
+		baseWrites:           1,
+		slopesWrites:         []uint64{0, 0},
+		minimumWrites:        1,
+		baseProofSize:        500,
+		slopesProofSize:      []uint64{0, 30},
+		minimumProofSize:     400,
+		componentNames:       []string{"a", "b"},
+	}
+	b := analysis{
+		baseExtrinsicTime:    90,
+		slopesExtrinsicTime:  []uint64{12, 3},
+		minimumExtrinsicTime: 50,
+		baseReads:            3,
+		slopesReads:          []uint64{0, 0},
+		minimumReads:         1,
+		baseWrites:           0,
+		slopesWrites:         []uint64{0, 2},
+		minimumWrites:        1,
+		baseProofSize:        450,
+		slopesProofSize:      []uint64{10, 20},
+		minimumProofSize:     400,
+		componentNames:       []string{"a", "b"},
+	}
+
+	expectedAnalysis := analysis{
+		baseExtrinsicTime:       100,
+		slopesExtrinsicTime:     []uint64{12, 3},
+		minimumExtrinsicTime:    50,
+		baseReads:               3,
+		slopesReads:             []uint64{1, 0},
+		minimumReads:            1,
+		baseWrites:              1,
+		slopesWrites:            []uint64{0, 2},
+		minimumWrites:           1,
+		baseProofSize:           500,
+		slopesProofSize:         []uint64{10, 30},
+		minimumProofSize:        400,
+		componentExtrinsicTimes: []componentSlope{{ComponentName: "a", Slope: 12}, {ComponentName: "b", Slope: 3}},
+		componentReads:          []componentSlope{{ComponentName: "a", Slope: 1}},
+		componentWrites:         []componentSlope{{ComponentName: "b", Slope: 2}},
+		componentProofSizes:     []componentSlope{{ComponentName: "a", Slope: 10}, {ComponentName: "b", Slope: 30}},
+		componentNames:          []string{"a", "b"},
+	}
+
+	assert.Equal(t, expectedAnalysis, maxAnalysis(a, b))
+}
+
+func TestRunAnalysis(t *testing.T) {
+	data := []benchmarkResult{
+		{[]linear{{name: "a", value: 1}}, 11_000_000, 3, 1, 1100},
+		{[]linear{{name: "a", value: 2}}, 12_000_000, 4, 1, 1200},
+		{[]linear{{name: "a", value: 3}}, 13_000_000, 5, 1, 1300},
+	}
+
+	res, err := runAnalysis(analysisMax, data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10_000_000_000), res.baseExtrinsicTime)
+	assert.Equal(t, []uint64{1_000_000_000}, res.slopesExtrinsicTime)
+	assert.Equal(t, []componentRange{{ComponentName: "a", Min: 1, Max: 3}}, res.componentRanges)
+	assert.NotNil(t, res.regression)
+
+	_, err = runAnalysis("unknown", data)
+	assert.EqualError(t, err, "unknown analysis [unknown]")
+}
@@ -2,7 +2,10 @@ package benchmarking
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"io/fs"
+	"path/filepath"
 	"testing"
 
 	"github.com/ChainSafe/gossamer/lib/runtime"
@@ -48,7 +51,10 @@ func RunDispatchCall(b *testing.B, outputPath string, testFn func(i *Instance),
 	}
 
 	// generate weight file
-	analysis := medianSlopesAnalysis(results)
+	analysis, err := runAnalysis(Config.Analysis, results)
+	if err != nil {
+		b.Fatalf("failed to analyse benchmark results: %v", err)
+	}
 	fmt.Println(analysis.String())
 
 	if Config.GenerateWeightFiles {
@@ -56,6 +62,47 @@ func RunDispatchCall(b *testing.B, outputPath string, testFn func(i *Instance),
 			b.Fatalf("failed to generate weight file: %v", err)
 		}
 	}
+
+	if Config.JsonOutput != "" || Config.Baseline != "" {
+		exportAndCompare(b, outputPath, results, analysis)
+	}
+}
+
+// exportAndCompare writes the JSON report of the benchmark and compares its weight against the baseline.
+// Fails the benchmark if a weight term increased by more than the allowed regression.
+func exportAndCompare(b *testing.B, outputPath string, results []benchmarkResult, analysis analysis) {
+	info, err := newBenchmarkInfo(outputPath, analysis.String())
+	if err != nil {
+		b.Fatalf("failed to get benchmark info: %v", err)
+	}
+	report := newBenchmarkReport(info, Config.Analysis, results, analysis)
+
+	if Config.JsonOutput != "" {
+		if err := writeBenchmarkReport(Config.JsonOutput, report); err != nil {
+			b.Fatalf("failed to write benchmark report: %v", err)
+		}
+	}
+
+	if Config.Baseline == "" {
+		return
+	}
+
+	baseline, err := readBenchmarkReport(Config.Baseline, report.Name)
+	if errors.Is(err, fs.ErrNotExist) {
+		b.Logf("no baseline for [%s], skipping comparison", report.Name)
+		return
+	}
+	if err != nil {
+		b.Fatalf("failed to read baseline: %v", err)
+	}
+
+	regressions := compareBenchmarkReports(baseline, report, Config.MaxRegression)
+	for _, regression := range regressions {
+		b.Error(regression.String())
+	}
+	if len(regressions) > 0 {
+		b.Fatalf("weight of [%s] regressed by more than %.2f%%", report.Name, Config.MaxRegression)
+	}
 }
 
 func RunHook(b *testing.B,
@@ -94,6 +141,17 @@ func RunHook(b *testing.B,
 	b.ReportMetric(float64(benchmarkResult.Time.ToBigInt().Int64()), "time")
 	b.ReportMetric(float64(benchmarkResult.Reads), "reads")
 	b.ReportMetric(float64(benchmarkResult.Writes), "writes")
+	b.ReportMetric(float64(benchmarkResult.ProofSize), "proof_size")
+
+	if Config.JsonOutput != "" || Config.Baseline != "" {
+		results := []benchmarkResult{newBenchmarkResult(benchmarkResult, []linear{})}
+		analysis, err := runAnalysis(Config.Analysis, results)
+		if err != nil {
+			b.Fatalf("failed to analyse benchmark results: %v", err)
+		}
+		// hooks do not generate weight files, the path only names the report, e.g. "hooks_onInitialize"
+		exportAndCompare(b, filepath.Join("hooks", hookName+".go"), results, analysis)
+	}
 
 	return benchmarkResult
 }
@@ -123,6 +181,7 @@ func runTestFn(b *testing.B, testFn func(i *Instance)) benchmarkingtypes.Benchma
 	b.ReportMetric(float64(benchmarkResult.Time.ToBigInt().Int64()), "time")
 	b.ReportMetric(float64(benchmarkResult.Reads), "reads")
 	b.ReportMetric(float64(benchmarkResult.Writes), "writes")
+	b.ReportMetric(float64(benchmarkResult.ProofSize), "proof_size")
 
 	return *benchmarkResult
 }
@@ -16,6 +16,8 @@ var Config = initBenchmarkingConfig()
 type benchmarkingConfig struct {
 	Steps, Repeat, HeapPages, DbCache      int
 	WasmRuntime, GC, TinyGoVersion, Target string
+	Analysis, JsonOutput, Baseline         string
+	MaxRegression                          float64
 	GenerateWeightFiles                    bool
 	Overhead                               overheadConfig
 }
@@ -36,6 +38,10 @@ func initBenchmarkingConfig() benchmarkingConfig {
 	flag.StringVar(&cfg.GC, "gc", "", "GC flag used for building the runtime.")
 	flag.StringVar(&cfg.TinyGoVersion, "tinygoversion", "", "TinyGO version used for building the runtime.")
 	flag.StringVar(&cfg.Target, "target", "", "Target used for building the runtime.")
+	flag.StringVar(&cfg.Analysis, "analysis", analysisMedianSlopes, "Analysis used to generate the weights: median-slopes, median-values, min-squares or max (the worst case of median-slopes and min-squares).")
+	flag.StringVar(&cfg.JsonOutput, "json-output", "", "Directory to write the JSON results of each benchmark into. Disabled if empty.")
+	flag.StringVar(&cfg.Baseline, "baseline", "", "Directory with the JSON results of a previous run to compare the weights against. Disabled if empty.")
+	flag.Float64Var(&cfg.MaxRegression, "max-regression", 10, "Maximum increase of a weight term against the baseline in percent, above which the benchmark fails.")
 	flag.BoolVar(&cfg.GenerateWeightFiles, "generate-weight-files", true, "Whether to generate weight files.")
 	cfg.Overhead = initOverheadConfig()
 	return cfg
@@ -0,0 +1,220 @@
+package benchmarking
+
+import (
+	"math"
+)
+
+const (
+	// significanceThreshold is the minimum number of standard errors, which a slope must
+	// exceed to be considered significant.
+	significanceThreshold = 2.0
+	// minSignificantSlope is the minimum slope, which is considered significant, regardless
+	// of its standard error. It filters out the rounding errors of the fit.
+	minSignificantSlope = 0.000_001
+	// singularityEpsilon is the relative size of a pivot, below which a matrix is considered singular.
+	singularityEpsilon = 1e-12
+)
+
+// regressionModel is a linear model of a benchmark metric, fitted with the least-squares method:
+// y = intercept + slopes[0] * x[0] + ... + slopes[n] * x[n].
+// The slopes and standard errors of insignificant components are zero.
+type regressionModel struct {
+	intercept      float64
+	slopes         []float64
+	standardErrors []float64
+	rSquared       float64
+}
+
+// fitLinearModel fits a linear model of `ys` over the component values `xs`, which hold one row per sample.
+// Components, which do not vary, are left out of the model. Components, whose slope is not positive or does
+// not exceed `significanceThreshold` standard errors, are insignificant and are left out of the model,
+// which is then fitted again without them.
+func fitLinearModel(xs [][]float64, ys []float64) regressionModel {
+	components := 0
+	if len(xs) > 0 {
+		components = len(xs[0])
+	}
+
+	model := regressionModel{
+		slopes:         make([]float64, components),
+		standardErrors: make([]float64, components),
+	}
+	if len(ys) == 0 {
+		return model
+	}
+
+	included := []int{}
+	for i := 0; i < components; i++ {
+		if varies(xs, i) {
+			included = append(included, i)
+		}
+	}
+
+	for {
+		coefficients, standardErrors, rSquared, ok := leastSquares(xs, ys, included)
+		if !ok {
+			// collinear components, drop the last one
+			included = included[:len(included)-1]
+			continue
+		}
+
+		significant := []int{}
+		for j, c := range included {
+			slope, standardError := coefficients[j+1], standardErrors[j+1]
+			if slope >= minSignificantSlope && slope > significanceThreshold*standardError {
+				significant = append(significant, c)
+			}
+		}
+
+		if len(significant) == len(included) {
+			model.intercept = coefficients[0]
+			model.rSquared = rSquared
+			for j, c := range included {
+				model.slopes[c] = coefficients[j+1]
+				model.standardErrors[c] = standardErrors[j+1]
+			}
+			return model
+		}
+
+		included = significant
+	}
+}
+
+// leastSquares solves the normal equations of the linear model of `ys` over the `included` components of `xs`.
+// Returns the intercept, followed by the slopes of the components, their standard errors and the coefficient of
+// determination (R²) of the fit. Fails if the normal equations are singular.
+func leastSquares(xs [][]float64, ys []float64, included []int) ([]float64, []float64, float64, bool) {
+	n := len(ys)
+	p := len(included) + 1
+
+	row := func(k int) []float64 {
+		r := make([]float64, p)
+		r[0] = 1
+		for j, c := range included {
+			r[j+1] = xs[k][c]
+		}
+		return r
+	}
+
+	// normal equations (XᵀX)β = Xᵀy
+	xtx := make([][]float64, p)
+	for i := range xtx {
+		xtx[i] = make([]float64, p)
+	}
+	xty := make([]float64, p)
+	for k := 0; k < n; k++ {
+		r := row(k)
+		for i := 0; i < p; i++ {
+			for j := 0; j < p; j++ {
+				xtx[i][j] += r[i] * r[j]
+			}
+			xty[i] += r[i] * ys[k]
+		}
+	}
+
+	inverse, ok := invert(xtx)
+	if !ok {
+		return nil, nil, 0, false
+	}
+
+	coefficients := make([]float64, p)
+	for i := 0; i < p; i++ {
+		for j := 0; j < p; j++ {
+			coefficients[i] += inverse[i][j] * xty[j]
+		}
+	}
+
+	mean := 0.0
+	for _, y := range ys {
+		mean += y
+	}
+	mean /= float64(n)
+
+	residuals, total := 0.0, 0.0
+	for k := 0; k < n; k++ {
+		r := row(k)
+		predicted := 0.0
+		for i := 0; i < p; i++ {
+			predicted += coefficients[i] * r[i]
+		}
+		residuals += (ys[k] - predicted) * (ys[k] - predicted)
+		total += (ys[k] - mean) * (ys[k] - mean)
+	}
+
+	rSquared := 1.0
+	if total > 0 {
+		rSquared = 1 - residuals/total
+	}
+
+	standardErrors := make([]float64, p)
+	if degreesOfFreedom := n - p; degreesOfFreedom > 0 {
+		variance := residuals / float64(degreesOfFreedom)
+		for i := 0; i < p; i++ {
+			standardErrors[i] = math.Sqrt(math.Max(variance*inverse[i][i], 0))
+		}
+	}
+
+	return coefficients, standardErrors, rSquared, true
+}
+
+// invert inverts the square matrix `m` with Gauss-Jordan elimination and partial pivoting.
+// Fails if `m` is singular.
+func invert(m [][]float64) ([][]float64, bool) {
+	n := len(m)
+
+	scale := 0.0
+	a := make([][]float64, n)
+	for i := range m {
+		a[i] = make([]float64, 2*n)
+		copy(a[i], m[i])
+		a[i][n+i] = 1
+		for _, v := range m[i] {
+			scale = math.Max(scale, math.Abs(v))
+		}
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(a[r][col]) > math.Abs(a[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(a[pivot][col]) <= singularityEpsilon*scale {
+			return nil, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+
+		divisor := a[col][col]
+		for j := range a[col] {
+			a[col][j] /= divisor
+		}
+
+		for r := 0; r < n; r++ {
+			if r == col || a[r][col] == 0 {
+				continue
+			}
+			factor := a[r][col]
+			for j := range a[r] {
+				a[r][j] -= factor * a[col][j]
+			}
+		}
+	}
+
+	inverse := make([][]float64, n)
+	for i := range a {
+		inverse[i] = a[i][n:]
+	}
+
+	return inverse, true
+}
+
+// varies returns whether the component at `index` takes more than one value in `xs`.
+func varies(xs [][]float64, index int) bool {
+	for _, x := range xs {
+		if x[index] != xs[0][index] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package benchmarking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFitLinearModel(t *testing.T) {
+	xs := [][]float64{{1, 4}, {2, 4}, {3, 4}, {4, 4}, {5, 4}}
+	ys := []float64{5, 7, 9, 11, 13}
+
+	model := fitLinearModel(xs, ys)
+
+	assert.InDelta(t, 3, model.intercept, 1e-9)
+	assert.InDelta(t, 2, model.slopes[0], 1e-9)
+	assert.Equal(t, float64(0), model.slopes[1])
+	assert.InDelta(t, 0, model.standardErrors[0], 1e-6)
+	assert.InDelta(t, 1, model.rSquared, 1e-9)
+}
+
+func TestFitLinearModel_InsignificantComponent(t *testing.T) {
+	xs := [][]float64{{1}, {2}, {3}, {4}, {5}, {6}}
+	ys := []float64{10, 12, 10, 12, 10, 12}
+
+	model := fitLinearModel(xs, ys)
+
+	assert.InDelta(t, 11, model.intercept, 1e-9)
+	assert.Equal(t, []float64{0}, model.slopes)
+	assert.Equal(t, []float64{0}, model.standardErrors)
+	assert.InDelta(t, 0, model.rSquared, 1e-9)
+}
+
+func TestFitLinearModel_NegativeSlope(t *testing.T) {
+	xs := [][]float64{{1, 1}, {2, 1}, {3, 1}, {1, 2}, {1, 3}}
+	ys := []float64{10, 8, 6, 13, 16}
+
+	model := fitLinearModel(xs, ys)
+
+	assert.Equal(t, float64(0), model.slopes[0])
+	assert.Greater(t, model.slopes[1], float64(0))
+}
+
+func TestFitLinearModel_Empty(t *testing.T) {
+	assert.Equal(t, regressionModel{slopes: []float64{}, standardErrors: []float64{}}, fitLinearModel(nil, nil))
+}
+
+func TestInvert(t *testing.T) {
+	inverse, ok := invert([][]float64{{4, 7}, {2, 6}})
+
+	assert.True(t, ok)
+	assert.InDelta(t, 0.6, inverse[0][0], 1e-9)
+	assert.InDelta(t, -0.7, inverse[0][1], 1e-9)
+	assert.InDelta(t, -0.2, inverse[1][0], 1e-9)
+	assert.InDelta(t, 0.4, inverse[1][1], 1e-9)
+}
+
+func TestInvert_Singular(t *testing.T) {
+	_, ok := invert([][]float64{{1, 2}, {2, 4}})
+
+	assert.False(t, ok)
+}
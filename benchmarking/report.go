@@ -0,0 +1,180 @@
+package benchmarking
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+)
+
+// benchmarkReport is the machine-readable result of a dispatch call benchmark.
+type benchmarkReport struct {
+	Name     string           `json:"name"`
+	Info     benchmarkInfo    `json:"info"`
+	Analysis string           `json:"analysis"`
+	Ranges   []componentRange `json:"ranges"`
+	Samples  []sampleReport   `json:"samples"`
+	Weight   weightReport     `json:"weight"`
+}
+
+// sampleReport is a single measurement of the benchmark, with the component values it is taken at.
+type sampleReport struct {
+	Components    []uint32 `json:"components"`
+	ExtrinsicTime uint64   `json:"extrinsicTime"`
+	Reads         uint64   `json:"reads"`
+	Writes        uint64   `json:"writes"`
+	ProofSize     uint64   `json:"proofSize"`
+}
+
+// weightReport is the weight function, which is generated from the analysis of the samples.
+type weightReport struct {
+	BaseExtrinsicTime       uint64           `json:"baseExtrinsicTime"`
+	BaseReads               uint64           `json:"baseReads"`
+	BaseWrites              uint64           `json:"baseWrites"`
+	BaseProofSize           uint64           `json:"baseProofSize"`
+	ComponentExtrinsicTimes []componentSlope `json:"componentExtrinsicTimes"`
+	ComponentReads          []componentSlope `json:"componentReads"`
+	ComponentWrites         []componentSlope `json:"componentWrites"`
+	ComponentProofSizes     []componentSlope `json:"componentProofSizes"`
+}
+
+// worstCase returns the extrinsic time, reads, writes and proof size of the weight function
+// at the maximum value of each component in `ranges`.
+func (w weightReport) worstCase(ranges []componentRange) (uint64, uint64, uint64, uint64) {
+	maximums := map[string]uint64{}
+	for _, r := range ranges {
+		maximums[r.ComponentName] = uint64(r.Max)
+	}
+
+	evaluate := func(base uint64, slopes []componentSlope) uint64 {
+		for _, s := range slopes {
+			base += s.Slope * maximums[s.ComponentName]
+		}
+		return base
+	}
+
+	return evaluate(w.BaseExtrinsicTime, w.ComponentExtrinsicTimes),
+		evaluate(w.BaseReads, w.ComponentReads),
+		evaluate(w.BaseWrites, w.ComponentWrites),
+		evaluate(w.BaseProofSize, w.ComponentProofSizes)
+}
+
+func newBenchmarkReport(info benchmarkInfo, analysisChoice string, benchmarkResults []benchmarkResult, analysisResult analysis) benchmarkReport {
+	report := benchmarkReport{
+		Name:     fmt.Sprintf("%s_%s", info.PackageName, info.FunctionName),
+		Info:     info,
+		Analysis: analysisChoice,
+		Ranges:   analysisResult.componentRanges,
+		Samples:  make([]sampleReport, len(benchmarkResults)),
+		Weight: weightReport{
+			BaseExtrinsicTime:       analysisResult.baseExtrinsicTime,
+			BaseReads:               analysisResult.baseReads,
+			BaseWrites:              analysisResult.baseWrites,
+			BaseProofSize:           analysisResult.baseProofSize,
+			ComponentExtrinsicTimes: analysisResult.componentExtrinsicTimes,
+			ComponentReads:          analysisResult.componentReads,
+			ComponentWrites:         analysisResult.componentWrites,
+			ComponentProofSizes:     analysisResult.componentProofSizes,
+		},
+	}
+
+	for i, br := range benchmarkResults {
+		report.Samples[i] = sampleReport{
+			Components:    componentValues(br.components),
+			ExtrinsicTime: br.extrinsicTime,
+			Reads:         br.reads,
+			Writes:        br.writes,
+			ProofSize:     br.proofSize,
+		}
+	}
+
+	return report
+}
+
+// writeBenchmarkReport writes `report` as JSON into `dir`, named after the benchmark.
+func writeBenchmarkReport(dir string, report benchmarkReport) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("error creating output directory: %v", err)
+	}
+
+	bytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(reportPath(dir, report.Name), bytes, 0o644)
+}
+
+// readBenchmarkReport reads the JSON report of the benchmark `name` from `dir`.
+func readBenchmarkReport(dir string, name string) (benchmarkReport, error) {
+	bytes, err := os.ReadFile(reportPath(dir, name))
+	if err != nil {
+		return benchmarkReport{}, err
+	}
+
+	report := benchmarkReport{}
+	if err := json.Unmarshal(bytes, &report); err != nil {
+		return benchmarkReport{}, fmt.Errorf("error decoding report [%s]: %v", name, err)
+	}
+
+	return report, nil
+}
+
+func reportPath(dir string, name string) string {
+	return filepath.Join(dir, name+".json")
+}
+
+// weightRegression is an increase of a weight term of a benchmark against its baseline.
+type weightRegression struct {
+	Benchmark         string
+	Term              string
+	Baseline, Current uint64
+	// Percent is the increase against the baseline. It is +Inf, if the baseline is zero.
+	Percent float64
+}
+
+func (r weightRegression) String() string {
+	return fmt.Sprintf("%s: %s increased from %d to %d (+%.2f%%)", r.Benchmark, r.Term, r.Baseline, r.Current, r.Percent)
+}
+
+// compareBenchmarkReports compares the worst-case weight of `current` against `baseline`, each at the component
+// ranges of its own run. Returns the weight terms, which increased by more than `thresholdPercent`.
+func compareBenchmarkReports(baseline, current benchmarkReport, thresholdPercent float64) []weightRegression {
+	baselineTime, baselineReads, baselineWrites, baselineProofSize := baseline.Weight.worstCase(baseline.Ranges)
+	currentTime, currentReads, currentWrites, currentProofSize := current.Weight.worstCase(current.Ranges)
+
+	terms := []struct {
+		name              string
+		baseline, current uint64
+	}{
+		{"extrinsic time", baselineTime, currentTime},
+		{"reads", baselineReads, currentReads},
+		{"writes", baselineWrites, currentWrites},
+		{"proof size", baselineProofSize, currentProofSize},
+	}
+
+	var regressions []weightRegression
+	for _, term := range terms {
+		if term.current <= term.baseline {
+			continue
+		}
+
+		percent := math.Inf(1)
+		if term.baseline > 0 {
+			percent = float64(term.current-term.baseline) / float64(term.baseline) * 100
+		}
+
+		if percent > thresholdPercent {
+			regressions = append(regressions, weightRegression{
+				Benchmark: current.Name,
+				Term:      term.name,
+				Baseline:  term.baseline,
+				Current:   term.current,
+				Percent:   percent,
+			})
+		}
+	}
+
+	return regressions
+}
@@ -0,0 +1,118 @@
+package benchmarking
+
+import (
+	"errors"
+	"io/fs"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	reportInfo = benchmarkInfo{
+		Steps:        50,
+		Repeat:       20,
+		PackageName:  "system",
+		FunctionName: "callRemarkWeight",
+	}
+	reportResults = []benchmarkResult{
+		{[]linear{{name: "size", value: 0}}, 1_000, 1, 1, 100},
+		{[]linear{{name: "size", value: 10}}, 2_000, 1, 1, 200},
+	}
+	reportAnalysis = analysis{
+		baseExtrinsicTime:       1_000_000,
+		baseReads:               1,
+		baseWrites:              1,
+		baseProofSize:           100,
+		componentExtrinsicTimes: []componentSlope{{ComponentName: "size", Slope: 100_000}},
+		componentProofSizes:     []componentSlope{{ComponentName: "size", Slope: 10}},
+		componentRanges:         []componentRange{{ComponentName: "size", Min: 0, Max: 10}},
+	}
+)
+
+func Test_newBenchmarkReport(t *testing.T) {
+	expect := benchmarkReport{
+		Name:     "system_callRemarkWeight",
+		Info:     reportInfo,
+		Analysis: analysisMedianSlopes,
+		Ranges:   []componentRange{{ComponentName: "size", Min: 0, Max: 10}},
+		Samples: []sampleReport{
+			{Components: []uint32{0}, ExtrinsicTime: 1_000, Reads: 1, Writes: 1, ProofSize: 100},
+			{Components: []uint32{10}, ExtrinsicTime: 2_000, Reads: 1, Writes: 1, ProofSize: 200},
+		},
+		Weight: weightReport{
+			BaseExtrinsicTime:       1_000_000,
+			BaseReads:               1,
+			BaseWrites:              1,
+			BaseProofSize:           100,
+			ComponentExtrinsicTimes: []componentSlope{{ComponentName: "size", Slope: 100_000}},
+			ComponentProofSizes:     []componentSlope{{ComponentName: "size", Slope: 10}},
+		},
+	}
+
+	assert.Equal(t, expect, newBenchmarkReport(reportInfo, analysisMedianSlopes, reportResults, reportAnalysis))
+}
+
+func Test_writeBenchmarkReport_readBenchmarkReport(t *testing.T) {
+	dir := t.TempDir()
+	report := newBenchmarkReport(reportInfo, analysisMedianSlopes, reportResults, reportAnalysis)
+
+	err := writeBenchmarkReport(dir, report)
+	assert.NoError(t, err)
+
+	result, err := readBenchmarkReport(dir, report.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, report, result)
+}
+
+func Test_readBenchmarkReport_NotExist(t *testing.T) {
+	_, err := readBenchmarkReport(t.TempDir(), "missing")
+
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+}
+
+func Test_weightReport_worstCase(t *testing.T) {
+	report := newBenchmarkReport(reportInfo, analysisMedianSlopes, reportResults, reportAnalysis)
+
+	extrinsicTime, reads, writes, proofSize := report.Weight.worstCase(report.Ranges)
+
+	assert.Equal(t, uint64(2_000_000), extrinsicTime)
+	assert.Equal(t, uint64(1), reads)
+	assert.Equal(t, uint64(1), writes)
+	assert.Equal(t, uint64(200), proofSize)
+}
+
+func Test_compareBenchmarkReports(t *testing.T) {
+	baseline := newBenchmarkReport(reportInfo, analysisMedianSlopes, reportResults, reportAnalysis)
+	current := newBenchmarkReport(reportInfo, analysisMedianSlopes, reportResults, reportAnalysis)
+	current.Weight.BaseExtrinsicTime = 1_100_000
+	current.Weight.BaseReads = 2
+	current.Weight.BaseProofSize = 150
+
+	expect := []weightRegression{
+		{Benchmark: "system_callRemarkWeight", Term: "reads", Baseline: 1, Current: 2, Percent: 100},
+		{Benchmark: "system_callRemarkWeight", Term: "proof size", Baseline: 200, Current: 250, Percent: 25},
+	}
+
+	assert.Equal(t, expect, compareBenchmarkReports(baseline, current, 10))
+	assert.Nil(t, compareBenchmarkReports(baseline, baseline, 10))
+}
+
+func Test_compareBenchmarkReports_ZeroBaseline(t *testing.T) {
+	baseline := newBenchmarkReport(reportInfo, analysisMedianSlopes, reportResults, reportAnalysis)
+	current := newBenchmarkReport(reportInfo, analysisMedianSlopes, reportResults, reportAnalysis)
+	baseline.Weight.BaseWrites = 0
+
+	result := compareBenchmarkReports(baseline, current, 10)
+
+	assert.Len(t, result, 1)
+	assert.Equal(t, "writes", result[0].Term)
+	assert.True(t, math.IsInf(result[0].Percent, 1))
+}
+
+func Test_weightRegression_String(t *testing.T) {
+	regression := weightRegression{Benchmark: "system_callRemarkWeight", Term: "reads", Baseline: 1, Current: 2, Percent: 100}
+
+	assert.Equal(t, "system_callRemarkWeight: reads increased from 1 to 2 (+100.00%)", regression.String())
+}
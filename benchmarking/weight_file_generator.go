@@ -14,63 +14,81 @@ import (
 )
 
 type benchmarkInfo struct {
-	Date          string
-	Steps         int
-	Repeat        int
-	DbCache       int
-	HeapPages     int
-	HostName      string
-	CpuName       string
-	Gc            string
-	TinyGoVersion string
-	Target        string
-	Summary       string
-	PackageName   string
-	FunctionName  string
+	Date          string `json:"date"`
+	Steps         int    `json:"steps"`
+	Repeat        int    `json:"repeat"`
+	DbCache       int    `json:"dbCache"`
+	HeapPages     int    `json:"heapPages"`
+	HostName      string `json:"hostName"`
+	CpuName       string `json:"cpuName"`
+	Gc            string `json:"gc"`
+	TinyGoVersion string `json:"tinyGoVersion"`
+	Target        string `json:"target"`
+	Summary       string `json:"summary"`
+	PackageName   string `json:"packageName"`
+	FunctionName  string `json:"functionName"`
 }
 
-func generateExtrinsicWeightFile(outputPath string, analysisResult analysis) error {
-	data := struct {
-		benchmarkInfo
-		ComponentNames                                      []string
-		BaseWeight, BaseReads, BaseWrites, MinExtrinsicTime uint64
-		ComponentWeights, ComponentReads, ComponentWrites   []componentSlope
-	}{}
-
-	data.Date = time.Now().String()
-	data.Steps = Config.Steps
-	data.Repeat = Config.Repeat
-	data.DbCache = Config.DbCache
-	data.HeapPages = Config.HeapPages
-	data.Gc = Config.GC
-	data.TinyGoVersion = Config.TinyGoVersion
-	data.Target = Config.Target
-
-	data.Summary = analysisResult.String()
+// newBenchmarkInfo returns the information about the benchmark, which generates the weight file at `outputPath`,
+// and the machine it runs on.
+func newBenchmarkInfo(outputPath string, summary string) (benchmarkInfo, error) {
+	info := benchmarkInfo{
+		Date:          time.Now().String(),
+		Steps:         Config.Steps,
+		Repeat:        Config.Repeat,
+		DbCache:       Config.DbCache,
+		HeapPages:     Config.HeapPages,
+		Gc:            Config.GC,
+		TinyGoVersion: Config.TinyGoVersion,
+		Target:        Config.Target,
+		Summary:       summary,
+	}
 
 	paths := strings.Split(filepath.Dir(outputPath), "/")
-	data.PackageName = paths[len(paths)-1]
+	info.PackageName = paths[len(paths)-1]
 
-	data.FunctionName = strcase.ToLowerCamel(strings.TrimSuffix(filepath.Base(outputPath), ".go")) // formats outputPath to weightFn name
+	info.FunctionName = strcase.ToLowerCamel(strings.TrimSuffix(filepath.Base(outputPath), ".go")) // formats outputPath to weightFn name
 
 	hostName, err := os.Hostname()
 	if err != nil {
-		return err
+		return benchmarkInfo{}, err
 	}
-	data.HostName = hostName
+	info.HostName = hostName
 
 	if c, err := cpu.Info(); err == nil && len(c) > 0 {
-		data.CpuName = fmt.Sprintf("%s(%d cores, %d mhz)", c[0].ModelName, c[0].Cores, int(c[0].Mhz))
+		info.CpuName = fmt.Sprintf("%s(%d cores, %d mhz)", c[0].ModelName, c[0].Cores, int(c[0].Mhz))
+	}
+
+	return info, nil
+}
+
+func generateExtrinsicWeightFile(outputPath string, analysisResult analysis) error {
+	data := struct {
+		benchmarkInfo
+		ComponentNames                                                     []string
+		BaseWeight, BaseReads, BaseWrites, BaseProofSize, MinExtrinsicTime uint64
+		ComponentWeights, ComponentReads, ComponentWrites                  []componentSlope
+		ComponentProofSizes                                                []componentSlope
+		ComponentRanges                                                    []componentRange
+	}{}
+
+	info, err := newBenchmarkInfo(outputPath, analysisResult.String())
+	if err != nil {
+		return err
 	}
+	data.benchmarkInfo = info
 
 	data.ComponentNames = analysisResult.componentNames
 	data.BaseWeight = analysisResult.baseExtrinsicTime
 	data.BaseReads = analysisResult.baseReads
 	data.BaseWrites = analysisResult.baseWrites
+	data.BaseProofSize = analysisResult.baseProofSize
 	data.MinExtrinsicTime = analysisResult.minimumExtrinsicTime
 	data.ComponentWeights = analysisResult.componentExtrinsicTimes
 	data.ComponentReads = analysisResult.componentReads
 	data.ComponentWrites = analysisResult.componentWrites
+	data.ComponentProofSizes = analysisResult.componentProofSizes
+	data.ComponentRanges = analysisResult.componentRanges
 
 	// create output file
 	outputFile, err := os.Create(outputPath)
@@ -105,31 +123,11 @@ func generateOverheadWeightFile(outputPath string, stats StatsResult) error {
 		BaseWeight uint64
 	}{}
 
-	data.Date = time.Now().String()
-	data.Steps = Config.Steps
-	data.Repeat = Config.Repeat
-	data.DbCache = Config.DbCache
-	data.HeapPages = Config.HeapPages
-	data.Gc = Config.GC
-	data.TinyGoVersion = Config.TinyGoVersion
-	data.Target = Config.Target
-
-	data.Summary = stats.String()
-
-	paths := strings.Split(filepath.Dir(outputPath), "/")
-	data.PackageName = paths[len(paths)-1]
-
-	data.FunctionName = strcase.ToLowerCamel(strings.TrimSuffix(filepath.Base(outputPath), ".go")) // formats outputPath to weightFn name
-
-	hostName, err := os.Hostname()
+	info, err := newBenchmarkInfo(outputPath, stats.String())
 	if err != nil {
 		return err
 	}
-	data.HostName = hostName
-
-	if c, err := cpu.Info(); err == nil && len(c) > 0 {
-		data.CpuName = fmt.Sprintf("%s(%d cores, %d mhz)", c[0].ModelName, c[0].Cores, int(c[0].Mhz))
-	}
+	data.benchmarkInfo = info
 
 	data.BaseWeight = uint64(stats.Mean)
 
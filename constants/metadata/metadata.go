@@ -261,4 +261,113 @@ const (
 	TypesParachainSystemEvents
 	TypesParachainSystemCalls
 	TypesParachainSystemErrors
+
+	TypesBalancesReasons
+	TypesBalancesBalanceLock
+	TypesSequenceBalancesBalanceLock
+	TypesRuntimeReason
+	TypesBalancesIdAmount
+	TypesSequenceBalancesIdAmount
+	TypesBalancesReserveData
+	TypesSequenceBalancesReserveData
+
+	TypesPerbill
+	TypesTransactionPaymentWeightToFeeCoefficient
+	TypesSequenceTransactionPaymentWeightToFeeCoefficient
+
+	TypesRawOrigin
+	TypesOriginCaller
+	TypesSequenceRuntimeCall
+	TypesUtilityCalls
+	TypesUtilityEvent
+	TypesUtilityErrors
+
+	TypesMultisigTimepoint
+	TypesOptionMultisigTimepoint
+	TypesMultisig
+	TypesMultisigStorageKey
+	TypesMultisigCalls
+	TypesMultisigEvent
+	TypesMultisigErrors
+
+	TypesProxyType
+	TypesOptionProxyType
+	TypesProxyDefinition
+	TypesSequenceProxyDefinition
+	TypesProxies
+	TypesProxyAnnouncement
+	TypesSequenceProxyAnnouncement
+	TypesProxyAnnouncements
+	TypesProxyCalls
+	TypesProxyEvent
+	TypesProxyErrors
+
+	TypesSchedulerTaskAddress
+	TypesSchedulerPeriod
+	TypesOptionSchedulerPeriod
+	TypesOptionSchedulerTaskName
+	TypesSchedulerScheduled
+	TypesOptionSchedulerScheduled
+	TypesSchedulerAgenda
+	TypesSchedulerCalls
+	TypesSchedulerEvent
+	TypesSchedulerErrors
+
+	TypesVestingInfo
+	TypesSequenceVestingInfo
+	TypesVestingCalls
+	TypesVestingEvent
+	TypesVestingErrors
+
+	TypesTupleU32H256
+	TypesSequenceTupleU32H256
+
+	TypesMessageQueueOrigin
+	TypesMessageQueueNeighbours
+	TypesMessageQueueOptionNeighbours
+	TypesMessageQueueBookState
+	TypesMessageQueuePage
+	TypesMessageQueueTupleOriginU32
+	TypesMessageQueueProcessMessageError
+	TypesMessageQueueOptionWeight
+	TypesMessageQueueCalls
+	TypesMessageQueueEvent
+	TypesMessageQueueErrors
+
+	TypesXcmpQueueOutboundState
+	TypesXcmpQueueOutboundChannelDetails
+	TypesSequenceXcmpQueueOutboundChannelDetails
+	TypesXcmpQueueTupleU32U16
+	TypesXcmpQueueEvent
+	TypesXcmpQueueErrors
+
+	TypesStakingRewardDestination
+	TypesStakingUnlockChunk
+	TypesSequenceStakingUnlockChunk
+	TypesStakingLedger
+	TypesStakingValidatorPrefs
+	TypesStakingNominations
+	TypesStakingIndividualExposure
+	TypesSequenceStakingIndividualExposure
+	TypesStakingExposure
+	TypesTupleAddress32U32
+	TypesSequenceTupleAddress32U32
+	TypesStakingEraRewardPoints
+	TypesTupleU32Address32
+	TypesSequenceMultiAddress
+	TypesStakingCalls
+	TypesStakingEvent
+	TypesStakingErrors
+
+	TypesOffencesKind
+	TypesOffencesOffenceDetails
+	TypesTupleOffencesKindSequenceU8
+	TypesSequenceH256
+	TypesOffencesEvent
+
+	TypesTupleH256U32
+
+	TypesBabeEquivocationProof
+	TypesSessionMembershipProof
+	TypesOptionEmptyTuple
 )
@@ -15,11 +15,11 @@ const WeightRefTimePerNanos sc.U64 = 1_000
 
 // We assume that ~10% of the block weight is consumed by `on_initialize` handlers.
 // This is used to limit the maximal weight of a single extrinsic.
-var AverageOnInitializeRatio types.Perbill = types.Perbill{Percentage: 10}
+var AverageOnInitializeRatio types.Perbill = types.NewPerbillFromPercent(10)
 
 // We allow `Normal` extrinsics to fill up the block up to 75%, the rest can be used
 // by  Operational  extrinsics.
-var NormalDispatchRatio types.Perbill = types.Perbill{Percentage: 75}
+var NormalDispatchRatio types.Perbill = types.NewPerbillFromPercent(75)
 
 // Block resource limits configuration structures.
 //
@@ -17,20 +17,5 @@ func ExtBenchmarkingStartDbTrackerVersion1()
 //go:wasmimport env ext_benchmarking_stop_db_tracker_version_1
 func ExtBenchmarkingStopDbTrackerVersion1()
 
-//go:wasmimport env ext_benchmarking_wipe_db_version_1
-func ExtBenchmarkingWipeDbVersion1()
-
-//go:wasmimport env ext_benchmarking_commit_db_version_1
-func ExtBenchmarkingCommitDbVersion1()
-
-//go:wasmimport env ext_benchmarking_db_read_count_version_1
-func ExtBenchmarkingDbReadCountVersion1() int32
-
-//go:wasmimport env ext_benchmarking_db_write_count_version_1
-func ExtBenchmarkingDbWriteCountVersion1() int32
-
-//go:wasmimport env ext_benchmarking_store_snapshot_db_version_1
-func ExtBenchmarkingStoreSnapshotDbVersion1()
-
-//go:wasmimport env ext_benchmarking_restore_snapshot_db_version_1
-func ExtBenchmarkingRestoreSnapshotDbVersion1()
+//go:wasmimport env ext_benchmarking_db_proof_size_version_1
+func ExtBenchmarkingDbProofSizeVersion1() int32
@@ -22,26 +22,6 @@ func ExtBenchmarkingStopDbTrackerVersion1() {
 	panic("not implemented")
 }
 
-func ExtBenchmarkingWipeDbVersion1() {
-	panic("not implemented")
-}
-
-func ExtBenchmarkingCommitDbVersion1() {
-	panic("not implemented")
-}
-
-func ExtBenchmarkingDbReadCountVersion1() int32 {
-	panic("not implemented")
-}
-
-func ExtBenchmarkingDbWriteCountVersion1() int32 {
-	panic("not implemented")
-}
-
-func ExtBenchmarkingStoreSnapshotDbVersion1() {
-	panic("not implemented")
-}
-
-func ExtBenchmarkingRestoreSnapshotDbVersion1() {
+func ExtBenchmarkingDbProofSizeVersion1() int32 {
 	panic("not implemented")
 }
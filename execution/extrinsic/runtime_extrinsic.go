@@ -8,6 +8,10 @@ import (
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
+// ExtrinsicVersionsCustomKey is the key of the custom metadata value, which holds the supported
+// extrinsic format versions.
+const ExtrinsicVersionsCustomKey = "extrinsic_versions"
+
 type RuntimeExtrinsic interface {
 	Module(index sc.U8) (module primitives.Module, isFound bool)
 	CreateInherents(inherentData primitives.InherentData) ([]byte, error)
@@ -75,8 +79,8 @@ func (re runtimeExtrinsic) CheckInherents(data primitives.InherentData, block pr
 
 	for _, extrinsic := range block.Extrinsics() {
 		// Inherents are before any other extrinsics.
-		// And signed extrinsics are not inherents.
-		if extrinsic.IsSigned() {
+		// And only bare extrinsics can be inherents.
+		if !extrinsic.IsBare() {
 			break
 		}
 
@@ -127,7 +131,7 @@ func (re runtimeExtrinsic) EnsureInherentsAreFirst(block primitives.Block) int {
 	for i, extrinsic := range block.Extrinsics() {
 		isInherent := false
 
-		if extrinsic.IsSigned() {
+		if !extrinsic.IsBare() {
 			signedExtrinsicFound = true
 		} else {
 			call := extrinsic.Function()
@@ -248,8 +252,15 @@ func (re runtimeExtrinsic) MetadataLatest() (sc.Sequence[primitives.MetadataModu
 		ErrorEnumType: sc.ToCompact(metadata.TypesRuntimeError),
 	}
 
+	// The metadata describes only the legacy extrinsic format version,
+	// the supported versions are exposed as a custom value.
 	custom := primitives.CustomMetadata{
-		Map: sc.Dictionary[sc.Str, primitives.CustomValueMetadata]{},
+		Map: sc.Dictionary[sc.Str, primitives.CustomValueMetadata]{
+			ExtrinsicVersionsCustomKey: {
+				Type:  sc.ToCompact(metadata.TypesSequenceU8),
+				Value: sc.BytesToSequenceU8(types.SupportedExtrinsicFormatVersions.Bytes()),
+			},
+		},
 	}
 
 	// iterate all modules and append their types and modules
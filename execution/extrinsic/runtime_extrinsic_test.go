@@ -212,7 +212,7 @@ func Test_RuntimeExtrinsic_CheckInherents(t *testing.T) {
 	expect := primitives.NewCheckInherentsResult()
 
 	mockBlock.On("Extrinsics").Return(sc.Sequence[primitives.UncheckedExtrinsic]{mockUncheckedExtrinsic})
-	mockUncheckedExtrinsic.On("IsSigned").Return(false)
+	mockUncheckedExtrinsic.On("IsBare").Return(true)
 	mockUncheckedExtrinsic.On("Function").Return(mockCallOne)
 	mockModuleOne.On("IsInherent", mockCallOne).Return(true)
 	mockModuleOne.On("CheckInherent", mockCallOne, inherentData).Return(nil)
@@ -223,7 +223,7 @@ func Test_RuntimeExtrinsic_CheckInherents(t *testing.T) {
 
 	assert.Equal(t, expect, result)
 	mockBlock.AssertCalled(t, "Extrinsics")
-	mockUncheckedExtrinsic.AssertCalled(t, "IsSigned")
+	mockUncheckedExtrinsic.AssertCalled(t, "IsBare")
 	mockUncheckedExtrinsic.AssertCalled(t, "Function")
 	mockModuleOne.AssertCalled(t, "IsInherent", mockCallOne)
 	mockModuleOne.AssertCalled(t, "CheckInherent", mockCallOne, inherentData)
@@ -243,7 +243,7 @@ func Test_RuntimeExtrinsic_CheckInherents_FatalError(t *testing.T) {
 	}
 
 	mockBlock.On("Extrinsics").Return(sc.Sequence[primitives.UncheckedExtrinsic]{mockUncheckedExtrinsic})
-	mockUncheckedExtrinsic.On("IsSigned").Return(false)
+	mockUncheckedExtrinsic.On("IsBare").Return(true)
 	mockUncheckedExtrinsic.On("Function").Return(mockCallOne)
 	mockModuleOne.On("IsInherent", mockCallOne).Return(true)
 	mockModuleOne.On("CheckInherent", mockCallOne, inherentData).Return(errInvalidTimestamp)
@@ -254,7 +254,7 @@ func Test_RuntimeExtrinsic_CheckInherents_FatalError(t *testing.T) {
 
 	assert.Equal(t, expect, result)
 	mockBlock.AssertCalled(t, "Extrinsics")
-	mockUncheckedExtrinsic.AssertCalled(t, "IsSigned")
+	mockUncheckedExtrinsic.AssertCalled(t, "IsBare")
 	mockUncheckedExtrinsic.AssertCalled(t, "Function")
 	mockModuleOne.AssertCalled(t, "IsInherent", mockCallOne)
 	mockModuleOne.AssertCalled(t, "CheckInherent", mockCallOne, inherentData)
@@ -268,7 +268,7 @@ func Test_RuntimeExtrinsic_CheckInherents_NoInherents(t *testing.T) {
 	expect := primitives.NewCheckInherentsResult()
 
 	mockBlock.On("Extrinsics").Return(sc.Sequence[primitives.UncheckedExtrinsic]{mockUncheckedExtrinsic})
-	mockUncheckedExtrinsic.On("IsSigned").Return(false)
+	mockUncheckedExtrinsic.On("IsBare").Return(true)
 	mockUncheckedExtrinsic.On("Function").Return(mockCallOne)
 	mockModuleOne.On("IsInherent", mockCallOne).Return(false)
 	mockModuleTwo.On("IsInherent", mockCallOne).Return(false)
@@ -278,7 +278,7 @@ func Test_RuntimeExtrinsic_CheckInherents_NoInherents(t *testing.T) {
 
 	assert.Equal(t, expect, result)
 	mockBlock.AssertCalled(t, "Extrinsics")
-	mockUncheckedExtrinsic.AssertCalled(t, "IsSigned")
+	mockUncheckedExtrinsic.AssertCalled(t, "IsBare")
 	mockUncheckedExtrinsic.AssertCalled(t, "Function")
 	mockModuleOne.AssertCalled(t, "IsInherent", mockCallOne)
 	mockModuleTwo.AssertCalled(t, "IsInherent", mockCallOne)
@@ -291,7 +291,7 @@ func Test_RuntimeExtrinsic_CheckInherents_Signed(t *testing.T) {
 	expect := primitives.NewCheckInherentsResult()
 
 	mockBlock.On("Extrinsics").Return(sc.Sequence[primitives.UncheckedExtrinsic]{mockUncheckedExtrinsic})
-	mockUncheckedExtrinsic.On("IsSigned").Return(true)
+	mockUncheckedExtrinsic.On("IsBare").Return(false)
 
 	result, err := target.CheckInherents(inherentData, mockBlock)
 	assert.NoError(t, err)
@@ -299,7 +299,7 @@ func Test_RuntimeExtrinsic_CheckInherents_Signed(t *testing.T) {
 	assert.Equal(t, expect, result)
 
 	mockBlock.AssertCalled(t, "Extrinsics")
-	mockUncheckedExtrinsic.AssertCalled(t, "IsSigned")
+	mockUncheckedExtrinsic.AssertCalled(t, "IsBare")
 }
 
 func Test_RuntimeExtrinsic_CheckInherents_CheckInherent_Error(t *testing.T) {
@@ -308,7 +308,7 @@ func Test_RuntimeExtrinsic_CheckInherents_CheckInherent_Error(t *testing.T) {
 	inherentData := *primitives.NewInherentData()
 
 	mockBlock.On("Extrinsics").Return(sc.Sequence[primitives.UncheckedExtrinsic]{mockUncheckedExtrinsic})
-	mockUncheckedExtrinsic.On("IsSigned").Return(false)
+	mockUncheckedExtrinsic.On("IsBare").Return(true)
 	mockUncheckedExtrinsic.On("Function").Return(mockCallOne)
 	mockModuleOne.On("IsInherent", mockCallOne).Return(true)
 	mockModuleOne.On("CheckInherent", mockCallOne, inherentData).Return(errPanic)
@@ -317,7 +317,7 @@ func Test_RuntimeExtrinsic_CheckInherents_CheckInherent_Error(t *testing.T) {
 	assert.Equal(t, errPanic, err)
 
 	mockBlock.AssertCalled(t, "Extrinsics")
-	mockUncheckedExtrinsic.AssertCalled(t, "IsSigned")
+	mockUncheckedExtrinsic.AssertCalled(t, "IsBare")
 	mockUncheckedExtrinsic.AssertCalled(t, "Function")
 	mockModuleOne.AssertCalled(t, "IsInherent", mockCallOne)
 	mockModuleOne.AssertCalled(t, "CheckInherent", mockCallOne, inherentData)
@@ -331,7 +331,7 @@ func Test_RuntimeExtrinsic_CheckInherents_PutError_InherentErrorInherentDataExis
 	inherentData.Put(inherentIdentifier, errNonFatalTimestampErr)
 
 	mockBlock.On("Extrinsics").Return(sc.Sequence[primitives.UncheckedExtrinsic]{mockUncheckedExtrinsic})
-	mockUncheckedExtrinsic.On("IsSigned").Return(false)
+	mockUncheckedExtrinsic.On("IsBare").Return(true)
 	mockUncheckedExtrinsic.On("Function").Return(mockCallOne)
 	mockModuleOne.On("IsInherent", mockCallOne).Return(true)
 	mockModuleOne.On("CheckInherent", mockCallOne, inherentData).Return(errNonFatalTimestampErr)
@@ -344,7 +344,7 @@ func Test_RuntimeExtrinsic_CheckInherents_PutError_InherentErrorInherentDataExis
 	assert.NoError(t, err)
 
 	mockBlock.AssertCalled(t, "Extrinsics")
-	mockUncheckedExtrinsic.AssertCalled(t, "IsSigned")
+	mockUncheckedExtrinsic.AssertCalled(t, "IsBare")
 	mockUncheckedExtrinsic.AssertCalled(t, "Function")
 	mockModuleOne.AssertCalled(t, "IsInherent", mockCallOne)
 	mockModuleOne.AssertCalled(t, "CheckInherent", mockCallOne, inherentData)
@@ -358,20 +358,20 @@ func Test_RuntimeExtrinsic_EnsureInherentsAreFirst_Signed(t *testing.T) {
 	target := setupRuntimeExtrinsic(mdGenerator)
 
 	mockBlock.On("Extrinsics").Return(sc.Sequence[primitives.UncheckedExtrinsic]{mockUncheckedExtrinsic})
-	mockUncheckedExtrinsic.On("IsSigned").Return(true)
+	mockUncheckedExtrinsic.On("IsBare").Return(false)
 
 	result := target.EnsureInherentsAreFirst(mockBlock)
 
 	assert.Equal(t, -1, result)
 	mockBlock.AssertCalled(t, "Extrinsics")
-	mockUncheckedExtrinsic.AssertCalled(t, "IsSigned")
+	mockUncheckedExtrinsic.AssertCalled(t, "IsBare")
 }
 
 func Test_RuntimeExtrinsic_EnsureInherentsAreFirst_Unsigned(t *testing.T) {
 	target := setupRuntimeExtrinsic(mdGenerator)
 
 	mockBlock.On("Extrinsics").Return(sc.Sequence[primitives.UncheckedExtrinsic]{mockUncheckedExtrinsic})
-	mockUncheckedExtrinsic.On("IsSigned").Return(false)
+	mockUncheckedExtrinsic.On("IsBare").Return(true)
 	mockUncheckedExtrinsic.On("Function").Return(mockCallOne)
 	mockModuleOne.On("IsInherent", mockCallOne).Return(true)
 	mockModuleTwo.On("IsInherent", mockCallOne).Return(false)
@@ -380,7 +380,7 @@ func Test_RuntimeExtrinsic_EnsureInherentsAreFirst_Unsigned(t *testing.T) {
 
 	assert.Equal(t, -1, result)
 	mockBlock.AssertCalled(t, "Extrinsics")
-	mockUncheckedExtrinsic.AssertCalled(t, "IsSigned")
+	mockUncheckedExtrinsic.AssertCalled(t, "IsBare")
 	mockUncheckedExtrinsic.AssertCalled(t, "Function")
 	mockModuleOne.AssertCalled(t, "IsInherent", mockCallOne)
 	mockModuleTwo.AssertCalled(t, "IsInherent", mockCallOne)
@@ -396,8 +396,8 @@ func Test_RuntimeExtrinsic_EnsureInherentsAreFirst_SignedBeforeUnsigned(t *testi
 			mockSignedUncheckedExtrinsic,
 			mockUncheckedExtrinsic,
 		})
-	mockSignedUncheckedExtrinsic.On("IsSigned").Return(true)
-	mockUncheckedExtrinsic.On("IsSigned").Return(false)
+	mockSignedUncheckedExtrinsic.On("IsBare").Return(false)
+	mockUncheckedExtrinsic.On("IsBare").Return(true)
 	mockUncheckedExtrinsic.On("Function").Return(mockCallOne)
 	mockModuleOne.On("IsInherent", mockCallOne).Return(true)
 	mockModuleTwo.On("IsInherent", mockCallOne).Return(false)
@@ -405,8 +405,8 @@ func Test_RuntimeExtrinsic_EnsureInherentsAreFirst_SignedBeforeUnsigned(t *testi
 	result := target.EnsureInherentsAreFirst(mockBlock)
 
 	assert.Equal(t, 1, result)
-	mockSignedUncheckedExtrinsic.AssertCalled(t, "IsSigned")
-	mockUncheckedExtrinsic.AssertCalled(t, "IsSigned")
+	mockSignedUncheckedExtrinsic.AssertCalled(t, "IsBare")
+	mockUncheckedExtrinsic.AssertCalled(t, "IsBare")
 	mockUncheckedExtrinsic.AssertCalled(t, "Function")
 	mockModuleOne.AssertCalled(t, "IsInherent", mockCallOne)
 	mockModuleTwo.AssertCalled(t, "IsInherent", mockCallOne)
@@ -616,7 +616,12 @@ func Test_RuntimeExtrinsic_MetadataLatest(t *testing.T) {
 		ErrorEnumType: sc.ToCompact(metadata.TypesRuntimeError),
 	}
 	expectCustom := primitives.CustomMetadata{
-		Map: sc.Dictionary[sc.Str, primitives.CustomValueMetadata]{},
+		Map: sc.Dictionary[sc.Str, primitives.CustomValueMetadata]{
+			"extrinsic_versions": {
+				Type:  sc.ToCompact(metadata.TypesSequenceU8),
+				Value: sc.Sequence[sc.U8]{8, 4, 5},
+			},
+		},
 	}
 	expectExtrinsic := primitives.MetadataExtrinsicV15{
 		Version:          types.ExtrinsicFormatVersion,
@@ -14,7 +14,9 @@ import (
 type checkedExtrinsic struct {
 	// Who this purports to be from and the number of extrinsics have come before
 	// from the same signer, if anyone (note this is not a signature).
-	signer        sc.Option[primitives.AccountId]
+	signer sc.Option[primitives.AccountId]
+	// Whether this is a general transaction, which is authorized by its transaction extensions.
+	general       bool
 	function      primitives.Call
 	extra         primitives.SignedExtra
 	transactional support.Transactional[primitives.PostDispatchInfo]
@@ -29,11 +31,27 @@ func NewCheckedExtrinsic(signer sc.Option[primitives.AccountId], function primit
 	}
 }
 
+// NewGeneralCheckedExtrinsic returns a checked general transaction. It has no signer and is dispatched
+// with the origin, which is returned by the validation of its transaction extensions.
+func NewGeneralCheckedExtrinsic(function primitives.Call, extra primitives.SignedExtra, storage io.Storage, transactionBroker io.TransactionBroker, logger log.RuntimeLogger) primitives.CheckedExtrinsic {
+	return checkedExtrinsic{
+		signer:        sc.NewOption[primitives.AccountId](nil),
+		general:       true,
+		function:      function,
+		extra:         extra,
+		transactional: support.NewTransactional[primitives.PostDispatchInfo](storage, transactionBroker, logger),
+	}
+}
+
 func (c checkedExtrinsic) Function() primitives.Call {
 	return c.function
 }
 
 func (c checkedExtrinsic) Apply(validator primitives.UnsignedValidator, info *primitives.DispatchInfo, length sc.Compact) (primitives.PostDispatchInfo, error) {
+	if c.general {
+		return c.applyGeneral(info, length)
+	}
+
 	var (
 		maybeWho sc.Option[primitives.AccountId]
 		maybePre sc.Option[sc.Sequence[primitives.Pre]]
@@ -83,6 +101,19 @@ func (c checkedExtrinsic) Apply(validator primitives.UnsignedValidator, info *pr
 }
 
 func (c checkedExtrinsic) Validate(validator primitives.UnsignedValidator, source primitives.TransactionSource, info *primitives.DispatchInfo, length sc.Compact) (primitives.ValidTransaction, error) {
+	if c.general {
+		valid, origin, err := c.extra.ValidateGeneral(primitives.NewRawOriginNone(), c.function, info, length)
+		if err != nil {
+			return primitives.ValidTransaction{}, err
+		}
+
+		if err := ensureAuthorized(origin); err != nil {
+			return primitives.ValidTransaction{}, err
+		}
+
+		return valid, nil
+	}
+
 	if c.signer.HasValue {
 		id := c.signer.Value
 		return c.extra.Validate(id, c.function, info, length)
@@ -104,3 +135,38 @@ func (c checkedExtrinsic) Validate(validator primitives.UnsignedValidator, sourc
 func (c checkedExtrinsic) dispatch(maybeWho sc.Option[primitives.AccountId]) (primitives.PostDispatchInfo, error) {
 	return c.function.Dispatch(primitives.RawOriginFrom(maybeWho), c.function.Args())
 }
+
+// applyGeneral applies a general transaction. Unlike unsigned transactions, it is not validated by
+// the modules, but by its transaction extensions, which also provide the origin to dispatch it with.
+func (c checkedExtrinsic) applyGeneral(info *primitives.DispatchInfo, length sc.Compact) (primitives.PostDispatchInfo, error) {
+	pre, origin, err := c.extra.PrepareGeneral(primitives.NewRawOriginNone(), c.function, info, length)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	if err := ensureAuthorized(origin); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	postInfo, err := c.transactional.WithStorageLayer(
+		func() (primitives.PostDispatchInfo, error) {
+			return c.function.Dispatch(origin, c.function.Args())
+		},
+	)
+
+	if err := c.extra.PostDispatchGeneral(pre, info, &postInfo, length, err); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return postInfo, err
+}
+
+// ensureAuthorized rejects a general transaction, whose transaction extensions did not authorize
+// an origin. Otherwise it would be dispatched with a none origin, without being validated as an
+// unsigned transaction.
+func ensureAuthorized(origin primitives.RuntimeOrigin) error {
+	if origin.IsNoneOrigin() {
+		return primitives.NewTransactionValidityError(primitives.NewInvalidTransactionUnknownOrigin())
+	}
+	return nil
+}
@@ -356,6 +356,129 @@ func Test_CheckedExtrinsic_Validate_Unsigned_ValidatorValidateUnsigned_Fails(t *
 		AssertCalled(t, "ValidateUnsigned", txSource, mockCall)
 }
 
+func Test_CheckedExtrinsic_Apply_General_Success(t *testing.T) {
+	target := setupGeneralCheckedExtrinsic()
+
+	origin := types.NewRawOriginSigned(constants.ZeroAccountId)
+	generalPre := sc.Sequence[sc.Option[types.Pre]]{sc.NewOption[types.Pre](sc.NewVaryingData(sc.U32(1)))}
+
+	mockSignedExtra.
+		On("PrepareGeneral", types.NewRawOriginNone(), mockCall, dispatchInfo, length).
+		Return(generalPre, origin, nil)
+	mockTransactional.On("WithStorageLayer", mockWithStorageLayer).Return(postDispatchInfoOk, nil)
+	mockSignedExtra.
+		On("PostDispatchGeneral", generalPre, dispatchInfo, &postDispatchInfoOk, length, nil).
+		Return(nil)
+
+	result, err := target.Apply(mockUnsignedValidator, dispatchInfo, length)
+
+	assert.Nil(t, err)
+	assert.Equal(t, postDispatchInfoOk, result)
+	mockSignedExtra.
+		AssertCalled(t, "PrepareGeneral", types.NewRawOriginNone(), mockCall, dispatchInfo, length)
+	mockSignedExtra.AssertNotCalled(t, "PreDispatch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockSignedExtra.AssertNotCalled(t, "PreDispatchUnsigned", mock.Anything, mock.Anything, mock.Anything)
+	mockTransactional.AssertCalled(t, "WithStorageLayer", mockWithStorageLayer)
+	mockSignedExtra.
+		AssertCalled(t, "PostDispatchGeneral", generalPre, dispatchInfo, &postDispatchInfoOk, length, nil)
+}
+
+func Test_CheckedExtrinsic_Apply_General_PrepareGeneral_Fails(t *testing.T) {
+	target := setupGeneralCheckedExtrinsic()
+
+	mockSignedExtra.
+		On("PrepareGeneral", types.NewRawOriginNone(), mockCall, dispatchInfo, length).
+		Return(sc.Sequence[sc.Option[types.Pre]]{}, types.NewRawOriginNone(), expectedInvalidTransactionPaymentErr)
+
+	_, err := target.Apply(mockUnsignedValidator, dispatchInfo, length)
+
+	assert.Equal(t, expectedInvalidTransactionPaymentErr, err)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+	mockSignedExtra.AssertNotCalled(t, "PostDispatchGeneral", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_CheckedExtrinsic_Apply_General_WithStorageLayerErr(t *testing.T) {
+	target := setupGeneralCheckedExtrinsic()
+
+	generalPre := sc.Sequence[sc.Option[types.Pre]]{sc.NewOption[types.Pre](nil)}
+
+	mockSignedExtra.
+		On("PrepareGeneral", types.NewRawOriginNone(), mockCall, dispatchInfo, length).
+		Return(generalPre, types.NewRawOriginSigned(constants.ZeroAccountId), nil)
+	mockTransactional.On("WithStorageLayer", mockWithStorageLayer).Return(postDispatchInfoErr, errPostDispatch)
+	mockSignedExtra.
+		On("PostDispatchGeneral", generalPre, dispatchInfo, &postDispatchInfoErr, length, errPostDispatch).
+		Return(nil)
+
+	_, err := target.Apply(mockUnsignedValidator, dispatchInfo, length)
+
+	assert.Equal(t, errPostDispatch, err)
+	mockSignedExtra.
+		AssertCalled(t, "PostDispatchGeneral", generalPre, dispatchInfo, &postDispatchInfoErr, length, errPostDispatch)
+}
+
+func Test_CheckedExtrinsic_Apply_General_UnknownOrigin(t *testing.T) {
+	target := setupGeneralCheckedExtrinsic()
+
+	generalPre := sc.Sequence[sc.Option[types.Pre]]{sc.NewOption[types.Pre](nil)}
+
+	mockSignedExtra.
+		On("PrepareGeneral", types.NewRawOriginNone(), mockCall, dispatchInfo, length).
+		Return(generalPre, types.NewRawOriginNone(), nil)
+
+	_, err := target.Apply(mockUnsignedValidator, dispatchInfo, length)
+
+	assert.Equal(t, types.NewTransactionValidityError(types.NewInvalidTransactionUnknownOrigin()), err)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+	mockSignedExtra.AssertNotCalled(t, "PostDispatchGeneral", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockUnsignedValidator.AssertNotCalled(t, "PreDispatch", mock.Anything)
+}
+
+func Test_CheckedExtrinsic_Validate_General_Success(t *testing.T) {
+	target := setupGeneralCheckedExtrinsic()
+
+	mockSignedExtra.
+		On("ValidateGeneral", types.NewRawOriginNone(), mockCall, dispatchInfo, length).
+		Return(types.DefaultValidTransaction(), types.NewRawOriginSigned(constants.ZeroAccountId), nil)
+
+	result, err := target.Validate(mockUnsignedValidator, txSource, dispatchInfo, length)
+
+	assert.Nil(t, err)
+	assert.Equal(t, types.DefaultValidTransaction(), result)
+	mockSignedExtra.
+		AssertCalled(t, "ValidateGeneral", types.NewRawOriginNone(), mockCall, dispatchInfo, length)
+	mockUnsignedValidator.
+		AssertNotCalled(t, "ValidateUnsigned", mock.Anything, mock.Anything)
+}
+
+func Test_CheckedExtrinsic_Validate_General_Fails(t *testing.T) {
+	target := setupGeneralCheckedExtrinsic()
+
+	mockSignedExtra.
+		On("ValidateGeneral", types.NewRawOriginNone(), mockCall, dispatchInfo, length).
+		Return(types.ValidTransaction{}, types.NewRawOriginNone(), expectedInvalidTransactionStaleErr)
+
+	result, err := target.Validate(mockUnsignedValidator, txSource, dispatchInfo, length)
+
+	assert.Equal(t, expectedInvalidTransactionStaleErr, err)
+	assert.Equal(t, types.ValidTransaction{}, result)
+}
+
+func Test_CheckedExtrinsic_Validate_General_UnknownOrigin(t *testing.T) {
+	target := setupGeneralCheckedExtrinsic()
+
+	mockSignedExtra.
+		On("ValidateGeneral", types.NewRawOriginNone(), mockCall, dispatchInfo, length).
+		Return(types.DefaultValidTransaction(), types.NewRawOriginNone(), nil)
+
+	result, err := target.Validate(mockUnsignedValidator, txSource, dispatchInfo, length)
+
+	assert.Equal(t, types.NewTransactionValidityError(types.NewInvalidTransactionUnknownOrigin()), err)
+	assert.Equal(t, types.ValidTransaction{}, result)
+	mockUnsignedValidator.
+		AssertNotCalled(t, "ValidateUnsigned", mock.Anything, mock.Anything)
+}
+
 func Test_CheckedExtrinsic_dispatch_Success(t *testing.T) {
 	target := setupCheckedExtrinsic(signerOption)
 
@@ -400,3 +523,10 @@ func setupCheckedExtrinsic(signer sc.Option[types.AccountId]) checkedExtrinsic {
 
 	return target
 }
+
+func setupGeneralCheckedExtrinsic() checkedExtrinsic {
+	target := setupCheckedExtrinsic(emptySigner)
+	target.general = true
+
+	return target
+}
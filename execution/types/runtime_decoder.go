@@ -13,9 +13,14 @@ import (
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
+// MaxExtrinsicDepth is the maximum depth, to which calls may be nested within other calls.
+const MaxExtrinsicDepth = 256
+
 var (
+	errMaxExtrinsicDepth       = errors.New("maximum call nesting depth exceeded")
 	errInvalidExtrinsicVersion = errors.New("invalid Extrinsic version")
 	errInvalidLengthPrefix     = errors.New("invalid length prefix")
+	errInvalidExtensionVersion = errors.New("invalid transaction extension version")
 )
 
 type RuntimeDecoder interface {
@@ -29,6 +34,11 @@ type SudoDecoder interface {
 	DecodeSudoArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error)
 }
 
+// NestedCallDecoder is implemented by calls whose arguments contain other runtime calls.
+type NestedCallDecoder interface {
+	DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error)
+}
+
 type runtimeDecoder struct {
 	modules           []types.Module
 	extra             primitives.SignedExtra
@@ -104,14 +114,26 @@ func (rd runtimeDecoder) DecodeUncheckedExtrinsic(buffer *bytes.Buffer) (primiti
 
 	version, _ := buffer.ReadByte()
 
-	if version&ExtrinsicUnmaskVersion != ExtrinsicFormatVersion {
+	// Signed extrinsics are supported only by the legacy format
+	// and general extrinsics only by the v5 format.
+	isSigned := version&ExtrinsicBitSigned != 0
+	isGeneral := version&ExtrinsicBitGeneral != 0
+	switch version & ExtrinsicUnmaskVersion {
+	case ExtrinsicFormatVersion:
+		if isGeneral {
+			return nil, errInvalidExtrinsicVersion
+		}
+	case ExtrinsicFormatVersionV5:
+		if isSigned {
+			return nil, errInvalidExtrinsicVersion
+		}
+	default:
 		return nil, errInvalidExtrinsicVersion
 	}
 
 	extra := rd.extra.DeepCopy()
 
 	var extSignature sc.Option[primitives.ExtrinsicSignature]
-	isSigned := version&ExtrinsicBitSigned != 0
 	if isSigned {
 		sig, err := primitives.DecodeExtrinsicSignature(extra, buffer)
 		if err != nil {
@@ -120,6 +142,21 @@ func (rd runtimeDecoder) DecodeUncheckedExtrinsic(buffer *bytes.Buffer) (primiti
 		extSignature = sc.NewOption[primitives.ExtrinsicSignature](sig)
 	}
 
+	var extensionVersion sc.U8
+	if isGeneral {
+		extensionVersion, err = sc.DecodeU8(buffer)
+		if err != nil {
+			return nil, err
+		}
+		if extensionVersion != primitives.TransactionExtensionVersion {
+			return nil, errInvalidExtensionVersion
+		}
+		err = extra.Decode(buffer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Decodes the dispatch call, including its arguments.
 	function, err := rd.DecodeCall(buffer)
 	if err != nil {
@@ -132,10 +169,24 @@ func (rd runtimeDecoder) DecodeUncheckedExtrinsic(buffer *bytes.Buffer) (primiti
 		return nil, errInvalidLengthPrefix
 	}
 
+	if isGeneral {
+		return NewGeneralUncheckedExtrinsic(extensionVersion, function, extra, rd.storage, rd.transactionBroker, rd.logger), nil
+	}
+
 	return NewUncheckedExtrinsic(sc.U8(version), extSignature, function, extra, rd.storage, rd.transactionBroker, rd.logger), nil
 }
 
 func (rd runtimeDecoder) DecodeCall(buffer *bytes.Buffer) (primitives.Call, error) {
+	return rd.decodeCall(buffer, 0)
+}
+
+// decodeCall decodes a call, which is nested `depth` levels deep within other calls. Deeper calls are
+// rejected, so that the decoding cannot exhaust the stack.
+func (rd runtimeDecoder) decodeCall(buffer *bytes.Buffer, depth int) (primitives.Call, error) {
+	if depth > MaxExtrinsicDepth {
+		return nil, errMaxExtrinsicDepth
+	}
+
 	moduleIndex, err := sc.DecodeU8(buffer)
 	if err != nil {
 		return nil, err
@@ -156,12 +207,18 @@ func (rd runtimeDecoder) DecodeCall(buffer *bytes.Buffer) (primitives.Call, erro
 		return nil, fmt.Errorf("function index [%d] for module [%d] not found", functionIndex, moduleIndex)
 	}
 
+	decodeNestedCall := func(buffer *bytes.Buffer) (primitives.Call, error) {
+		return rd.decodeCall(buffer, depth+1)
+	}
+
 	if rd.isSudoCall(moduleIndex) {
 		sudoCall, ok := function.(SudoDecoder)
 		if !ok {
 			return nil, fmt.Errorf("function index [%d] for module [%d] does not implement sudo decoder", functionIndex, moduleIndex)
 		}
-		function, err = sudoCall.DecodeSudoArgs(buffer, rd.DecodeCall)
+		function, err = sudoCall.DecodeSudoArgs(buffer, decodeNestedCall)
+	} else if nestedCall, ok := function.(NestedCallDecoder); ok {
+		function, err = nestedCall.DecodeNestedArgs(buffer, decodeNestedCall)
 	} else {
 		function, err = function.DecodeArgs(buffer)
 	}
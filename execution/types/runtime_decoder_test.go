@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"testing"
 
@@ -101,7 +102,7 @@ func Test_RuntimeDecoder_DecodeBlock_Single_Extrinsic(t *testing.T) {
 
 	mockSignedExtra.On("DeepCopy").Return(mockSignedExtra)
 	mockModuleOne.On("GetIndex").Return(moduleOneIdx)
-	mockSignedExtra.On("Decode", mock.Anything).Return()
+	mockSignedExtra.On("Decode", mock.Anything).Return(nil)
 	mockModuleOne.On("Functions").Return(moduleFunctions)
 	mockCallOne.On("DecodeArgs", decodeBlockBuff).Return(mockCallOne, nil)
 	result, err := target.DecodeBlock(decodeBlockBuff)
@@ -152,7 +153,7 @@ func Test_RuntimeDecoder_DecodeBlock_Multiple_Extrinsics(t *testing.T) {
 
 	mockSignedExtra.On("DeepCopy").Return(mockSignedExtra)
 	mockModuleOne.On("GetIndex").Return(moduleOneIdx)
-	mockSignedExtra.On("Decode", mock.Anything).Return()
+	mockSignedExtra.On("Decode", mock.Anything).Return(nil)
 	mockModuleOne.On("Functions").Return(moduleFunctions)
 	mockCallOne.On("DecodeArgs", buff).Return(mockCallOne, nil)
 	result, err := target.DecodeBlock(buff)
@@ -218,7 +219,7 @@ func Test_RuntimeDecoder_DecodeUncheckedExtrinsic_Unsigned(t *testing.T) {
 func Test_RuntimeDecoder_DecodeUncheckedExtrinsic_Signed(t *testing.T) {
 	target := setupRuntimeDecoder(defaultSudoIndex)
 
-	mockSignedExtra.On("Decode", mock.Anything).Return()
+	mockSignedExtra.On("Decode", mock.Anything).Return(nil)
 
 	buff := bytes.NewBuffer(append(sc.ToCompact(len(signedExtrinsicBytes)).Bytes(), signedExtrinsicBytes...))
 
@@ -261,10 +262,114 @@ func Test_RuntimeDecoder_DecodeUncheckedExtrinsic_InvalidExtrinsicVersion(t *tes
 	assert.Equal(t, errInvalidExtrinsicVersion, err)
 }
 
+func Test_RuntimeDecoder_DecodeUncheckedExtrinsic_BareV5(t *testing.T) {
+	target := setupRuntimeDecoder(defaultSudoIndex)
+	moduleFunctions[0] = mockCallOne
+
+	bareExtrBytes := []byte{byte(ExtrinsicFormatVersionV5), uint8(moduleOneIdx), uint8(functionIdx)}
+	buff := bytes.NewBuffer(append(sc.ToCompact(len(bareExtrBytes)).Bytes(), bareExtrBytes...))
+
+	mockSignedExtra.On("DeepCopy").Return(mockSignedExtra)
+	mockModuleOne.On("GetIndex").Return(moduleOneIdx)
+	mockModuleOne.On("Functions").Return(moduleFunctions)
+	mockCallOne.On("DecodeArgs", buff).Return(mockCallOne, nil)
+
+	result, err := target.DecodeUncheckedExtrinsic(buff)
+	assert.NoError(t, err)
+
+	assert.Equal(t, true, result.IsBare())
+	assert.Equal(t, false, result.IsSigned())
+	assert.Equal(t, false, result.IsGeneral())
+	assert.Equal(t, mockCallOne, result.Function())
+	mockSignedExtra.AssertNotCalled(t, "Decode", mock.Anything)
+}
+
+func Test_RuntimeDecoder_DecodeUncheckedExtrinsic_General(t *testing.T) {
+	target := setupRuntimeDecoder(defaultSudoIndex)
+	moduleFunctions[0] = mockCallOne
+
+	generalExtrBytes := []byte{
+		byte(ExtrinsicFormatVersionV5 | ExtrinsicBitGeneral), // version
+		byte(primitives.TransactionExtensionVersion),         // extension version
+		// extra
+		uint8(moduleOneIdx), uint8(functionIdx), // call
+	}
+	buff := bytes.NewBuffer(append(sc.ToCompact(len(generalExtrBytes)).Bytes(), generalExtrBytes...))
+
+	mockSignedExtra.On("DeepCopy").Return(mockSignedExtra)
+	mockSignedExtra.On("Decode", mock.Anything).Return(nil)
+	mockModuleOne.On("GetIndex").Return(moduleOneIdx)
+	mockModuleOne.On("Functions").Return(moduleFunctions)
+	mockCallOne.On("DecodeArgs", buff).Return(mockCallOne, nil)
+
+	result, err := target.DecodeUncheckedExtrinsic(buff)
+	assert.NoError(t, err)
+
+	expect := NewGeneralUncheckedExtrinsic(primitives.TransactionExtensionVersion, mockCallOne, mockSignedExtra, mockStorage, mockTransactionBroker, logger)
+
+	assert.Equal(t, expect, result)
+	assert.Equal(t, true, result.IsGeneral())
+	mockSignedExtra.AssertCalled(t, "Decode", buff)
+	mockCallOne.AssertCalled(t, "DecodeArgs", buff)
+}
+
+func Test_RuntimeDecoder_DecodeUncheckedExtrinsic_General_InvalidExtra(t *testing.T) {
+	target := setupRuntimeDecoder(defaultSudoIndex)
+
+	generalExtrBytes := []byte{
+		byte(ExtrinsicFormatVersionV5 | ExtrinsicBitGeneral), // version
+		byte(primitives.TransactionExtensionVersion),         // extension version
+		uint8(moduleOneIdx), uint8(functionIdx), // call
+	}
+	buff := bytes.NewBuffer(append(sc.ToCompact(len(generalExtrBytes)).Bytes(), generalExtrBytes...))
+
+	mockSignedExtra.On("DeepCopy").Return(mockSignedExtra)
+	mockSignedExtra.On("Decode", buff).Return(io.EOF)
+
+	_, err := target.DecodeUncheckedExtrinsic(buff)
+
+	assert.Equal(t, io.EOF, err)
+	mockModuleOne.AssertNotCalled(t, "Functions")
+}
+
+func Test_RuntimeDecoder_DecodeUncheckedExtrinsic_InvalidExtensionVersion(t *testing.T) {
+	target := setupRuntimeDecoder(defaultSudoIndex)
+
+	generalExtrBytes := []byte{
+		byte(ExtrinsicFormatVersionV5 | ExtrinsicBitGeneral), // version
+		byte(primitives.TransactionExtensionVersion + 1),     // extension version
+		uint8(moduleOneIdx), uint8(functionIdx), // call
+	}
+	buff := bytes.NewBuffer(append(sc.ToCompact(len(generalExtrBytes)).Bytes(), generalExtrBytes...))
+
+	mockSignedExtra.On("DeepCopy").Return(mockSignedExtra)
+
+	_, err := target.DecodeUncheckedExtrinsic(buff)
+	assert.Equal(t, errInvalidExtensionVersion, err)
+	mockSignedExtra.AssertNotCalled(t, "Decode", mock.Anything)
+}
+
+func Test_RuntimeDecoder_DecodeUncheckedExtrinsic_InvalidVersionBits(t *testing.T) {
+	for _, version := range []byte{
+		byte(ExtrinsicFormatVersion | ExtrinsicBitGeneral),
+		byte(ExtrinsicFormatVersionV5 | ExtrinsicBitSigned),
+	} {
+		t.Run(strconv.Itoa(int(version)), func(t *testing.T) {
+			target := setupRuntimeDecoder(defaultSudoIndex)
+
+			extrBytes := []byte{version, uint8(moduleOneIdx), uint8(functionIdx)}
+			buff := bytes.NewBuffer(append(sc.ToCompact(len(extrBytes)).Bytes(), extrBytes...))
+
+			_, err := target.DecodeUncheckedExtrinsic(buff)
+			assert.Equal(t, errInvalidExtrinsicVersion, err)
+		})
+	}
+}
+
 func Test_RuntimeDecoder_DecodeUncheckedExtrinsic_InvalidLengthPrefix(t *testing.T) {
 	target := setupRuntimeDecoder(defaultSudoIndex)
 
-	mockSignedExtra.On("Decode", mock.Anything).Return()
+	mockSignedExtra.On("Decode", mock.Anything).Return(nil)
 
 	invalidExpectedLength := sc.ToCompact(len(signedExtrinsicBytes) - 1)
 
@@ -360,6 +465,56 @@ func Test_RuntimeDecoder_DecodeCall(t *testing.T) {
 	mockCallOne.AssertCalled(t, "DecodeArgs", buf)
 }
 
+func Test_RuntimeDecoder_DecodeCall_Nested(t *testing.T) {
+	target := setupRuntimeDecoder(defaultSudoIndex)
+	mockCallNested := new(mocks.CallNested)
+
+	args := sc.NewVaryingData(moduleOneIdx, functionIdx)
+
+	callBytes := append(moduleOneIdx.Bytes(), functionIdx.Bytes()...)
+	callBytes = append(callBytes, args.Bytes()...)
+
+	buf := bytes.NewBuffer(callBytes)
+	moduleFunctions[0] = mockCallNested
+
+	mockModuleOne.On("GetIndex").Return(moduleOneIdx)
+	mockModuleOne.On("Functions").Return(moduleFunctions)
+	mockCallNested.On("DecodeNestedArgs", buf, mockDecodeCall).Run(func(args mock.Arguments) {
+		buf := args.Get(0).(*bytes.Buffer)
+		// reading 2 bytes for the nested call
+		buf.ReadByte()
+		buf.ReadByte()
+	}).Return(mockCallNested, nil)
+
+	res, err := target.DecodeCall(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, mockCallNested, res)
+
+	mockModuleOne.AssertCalled(t, "Functions")
+	mockCallNested.AssertCalled(t, "DecodeNestedArgs", buf, mockDecodeCall)
+	mockCallNested.AssertNotCalled(t, "DecodeArgs", mock.Anything)
+}
+
+func Test_RuntimeDecoder_DecodeCall_Nested_MaxExtrinsicDepth(t *testing.T) {
+	target := setupRuntimeDecoder(defaultSudoIndex)
+	moduleFunctions[0] = recursiveCall{mockCallOne}
+	mockModuleOne.On("GetIndex").Return(moduleOneIdx)
+	mockModuleOne.On("Functions").Return(moduleFunctions)
+
+	// The outermost call and MaxExtrinsicDepth nested calls.
+	buf := bytes.NewBuffer(bytes.Repeat([]byte{uint8(moduleOneIdx), uint8(functionIdx)}, MaxExtrinsicDepth+1))
+
+	res, err := target.DecodeCall(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, recursiveCall{mockCallOne}, res)
+
+	buf = bytes.NewBuffer(bytes.Repeat([]byte{uint8(moduleOneIdx), uint8(functionIdx)}, MaxExtrinsicDepth+2))
+
+	res, err = target.DecodeCall(buf)
+	assert.Equal(t, errMaxExtrinsicDepth, err)
+	assert.Nil(t, res)
+}
+
 func Test_RuntimeDecoder_DecodeSudoCall_InvalidType(t *testing.T) {
 	target := setupRuntimeDecoder(sudoIndex)
 
@@ -418,6 +573,21 @@ func Test_RuntimeDecoder_DecodeCall_Sudo(t *testing.T) {
 	mockCallSudo.AssertCalled(t, "DecodeSudoArgs", buf, mockDecodeCall)
 }
 
+// recursiveCall decodes the remainder of the buffer as a nested call.
+type recursiveCall struct {
+	*mocks.Call
+}
+
+func (c recursiveCall) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	if buffer.Len() == 0 {
+		return c, nil
+	}
+	if _, err := decodeCallFunc(buffer); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
 func setupRuntimeDecoder(sudoIndex sc.U8) RuntimeDecoder {
 	mockStorage = new(mocks.IoStorage)
 	mockTransactionBroker = new(mocks.IoTransactionBroker)
@@ -12,16 +12,24 @@ import (
 )
 
 const (
-	// ExtrinsicFormatVersion is the current version of the [`UncheckedExtrinsic`] encoded format.
+	// ExtrinsicFormatVersion is the legacy version of the [`UncheckedExtrinsic`] encoded format,
+	// which supports bare and signed extrinsics.
 	//
 	// This version needs to be bumped if the encoded representation changes.
 	// It ensures that if the representation is changed and the format is not known,
 	// the decoding fails.
 	ExtrinsicFormatVersion = 4
-	ExtrinsicBitSigned     = 0b1000_0000
-	ExtrinsicUnmaskVersion = 0b0111_1111
+	// ExtrinsicFormatVersionV5 is the version of the [`UncheckedExtrinsic`] encoded format,
+	// which supports bare and general extrinsics.
+	ExtrinsicFormatVersionV5 = 5
+	ExtrinsicBitSigned       = 0b1000_0000
+	ExtrinsicBitGeneral      = 0b0100_0000
+	ExtrinsicUnmaskVersion   = 0b0011_1111
 )
 
+// SupportedExtrinsicFormatVersions are the versions of the encoded format, which can be decoded.
+var SupportedExtrinsicFormatVersions = sc.Sequence[sc.U8]{ExtrinsicFormatVersion, ExtrinsicFormatVersionV5}
+
 var (
 	errInvalidMultisigType = errors.New("invalid MultiSignature type in Verify")
 )
@@ -35,7 +43,9 @@ type uncheckedExtrinsic struct {
 	// The signature, address, number of extrinsics have come before from
 	// the same signer and an era describing the longevity of this transaction,
 	// if this is a signed extrinsic.
-	signature         sc.Option[primitives.ExtrinsicSignature]
+	signature sc.Option[primitives.ExtrinsicSignature]
+	// The version of the transaction extensions, if this is a general extrinsic.
+	extensionVersion  sc.Option[sc.U8]
 	function          primitives.Call
 	extra             primitives.SignedExtra
 	initializePayload PayloadInitializer
@@ -62,6 +72,24 @@ func NewUncheckedExtrinsic(version sc.U8, signature sc.Option[primitives.Extrins
 	}
 }
 
+// NewGeneralUncheckedExtrinsic returns a new instance of a general extrinsic. It is not signed,
+// but carries transaction extensions, which are responsible for authorizing it.
+func NewGeneralUncheckedExtrinsic(extensionVersion sc.U8, function primitives.Call, extra primitives.SignedExtra, storage io.Storage, transactionBroker io.TransactionBroker, logger log.RuntimeLogger) primitives.UncheckedExtrinsic {
+	return uncheckedExtrinsic{
+		version:           ExtrinsicFormatVersionV5 | ExtrinsicBitGeneral,
+		signature:         sc.NewOption[primitives.ExtrinsicSignature](nil),
+		extensionVersion:  sc.NewOption[sc.U8](extensionVersion),
+		function:          function,
+		extra:             extra,
+		initializePayload: primitives.NewSignedPayload,
+		crypto:            io.NewCrypto(),
+		hashing:           io.NewHashing(),
+		storage:           storage,
+		transactionBroker: transactionBroker,
+		logger:            logger,
+	}
+}
+
 // NewUnsignedUncheckedExtrinsic returns a new instance of an unsigned extrinsic.
 func NewUnsignedUncheckedExtrinsic(function primitives.Call) primitives.UncheckedExtrinsic {
 	return uncheckedExtrinsic{
@@ -85,6 +113,12 @@ func (uxt uncheckedExtrinsic) Encode(buffer *bytes.Buffer) error {
 			return err
 		}
 	}
+	if uxt.extensionVersion.HasValue {
+		err := sc.EncodeEach(tempBuffer, uxt.extensionVersion.Value, uxt.extra)
+		if err != nil {
+			return err
+		}
+	}
 	err = uxt.function.Encode(tempBuffer)
 	if err != nil {
 		return err
@@ -119,6 +153,15 @@ func (uxt uncheckedExtrinsic) IsSigned() bool {
 	return bool(uxt.signature.HasValue)
 }
 
+func (uxt uncheckedExtrinsic) IsGeneral() bool {
+	return bool(uxt.extensionVersion.HasValue)
+}
+
+// IsBare returns whether the extrinsic is neither signed nor general, e.g. an inherent.
+func (uxt uncheckedExtrinsic) IsBare() bool {
+	return !uxt.IsSigned() && !uxt.IsGeneral()
+}
+
 func (uxt uncheckedExtrinsic) Check() (primitives.CheckedExtrinsic, error) {
 	if uxt.signature.HasValue {
 		signer, signature, extra := uxt.signature.Value.Signer, uxt.signature.Value.Signature, uxt.signature.Value.Extra
@@ -145,6 +188,10 @@ func (uxt uncheckedExtrinsic) Check() (primitives.CheckedExtrinsic, error) {
 		return NewCheckedExtrinsic(sc.NewOption[primitives.AccountId](signerAddress), uxt.function, extra, uxt.storage, uxt.transactionBroker, uxt.logger), nil
 	}
 
+	if uxt.extensionVersion.HasValue {
+		return NewGeneralCheckedExtrinsic(uxt.function, uxt.extra, uxt.storage, uxt.transactionBroker, uxt.logger), nil
+	}
+
 	return NewCheckedExtrinsic(sc.NewOption[primitives.AccountId](nil), uxt.function, uxt.extra, uxt.storage, uxt.transactionBroker, uxt.logger), nil
 }
 
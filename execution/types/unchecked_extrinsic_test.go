@@ -76,6 +76,7 @@ var (
 var (
 	targetSigned   uncheckedExtrinsic
 	targetUnsigned uncheckedExtrinsic
+	targetGeneral  uncheckedExtrinsic
 
 	extrinsicSignature sc.Option[types.ExtrinsicSignature]
 
@@ -105,6 +106,8 @@ func setup(signature types.MultiSignature) {
 
 	targetUnsigned = newTestUnsignedExtrinsic(mockCall)
 
+	targetGeneral = NewGeneralUncheckedExtrinsic(types.TransactionExtensionVersion, mockCall, mockSignedExtra, mockStorage, mockTransactionBroker, logger).(uncheckedExtrinsic)
+
 	targetSigned = newTestSignedExtrinsic(
 		extrinsicSignature,
 		mockStorage,
@@ -180,6 +183,26 @@ func Test_Encode_UncheckedExtrinsic_Signed(t *testing.T) {
 	}, buffer.Bytes())
 }
 
+func Test_Encode_UncheckedExtrinsic_General(t *testing.T) {
+	setup(signatureEd25519)
+
+	buffer := &bytes.Buffer{}
+	mockCall.On("Encode", mock.Anything)
+	mockSignedExtra.On("Encode", mock.Anything)
+
+	targetGeneral.Encode(buffer)
+
+	mockSignedExtra.AssertCalled(t, "Encode", mock.Anything)
+	mockCall.AssertCalled(t, "Encode", mock.Anything)
+	assert.Equal(t, []byte{
+		0x8,  // length
+		0x45, // version
+		0,    // extension version
+		// extra
+		// call
+	}, buffer.Bytes())
+}
+
 func Test_Bytes_UncheckedExtrinsic_Unsigned(t *testing.T) {
 	setup(signatureEd25519)
 
@@ -237,6 +260,36 @@ func Test_IsSigned(t *testing.T) {
 
 	assert.Equal(t, false, targetUnsigned.IsSigned())
 	assert.Equal(t, true, targetSigned.IsSigned())
+	assert.Equal(t, false, targetGeneral.IsSigned())
+}
+
+func Test_IsGeneral(t *testing.T) {
+	setup(signatureEd25519)
+
+	assert.Equal(t, false, targetUnsigned.IsGeneral())
+	assert.Equal(t, false, targetSigned.IsGeneral())
+	assert.Equal(t, true, targetGeneral.IsGeneral())
+}
+
+func Test_IsBare(t *testing.T) {
+	setup(signatureEd25519)
+
+	assert.Equal(t, true, targetUnsigned.IsBare())
+	assert.Equal(t, false, targetSigned.IsBare())
+	assert.Equal(t, false, targetGeneral.IsBare())
+}
+
+func Test_Check_GeneralUncheckedExtrinsic(t *testing.T) {
+	setup(signatureEd25519)
+
+	result, err := targetGeneral.Check()
+
+	assert.Nil(t, err)
+	checked := result.(checkedExtrinsic)
+	assert.Equal(t, true, checked.general)
+	assert.Equal(t, sc.NewOption[types.AccountId](nil), checked.signer)
+	assert.Equal(t, mockCall, checked.function)
+	assert.Equal(t, mockSignedExtra, checked.extra)
 }
 
 func Test_Check_UnsignedUncheckedExtrinsic(t *testing.T) {
@@ -115,8 +115,10 @@ func (m module) Author() (sc.Option[primitives.AccountId], error) {
 		return sc.NewOption[primitives.AccountId](nil), err
 	}
 
-	m.storage.Author.Put(authorId.Value)
-	return authorId, err
+	if authorId.HasValue {
+		m.storage.Author.Put(authorId.Value)
+	}
+	return authorId, nil
 }
 
 type EventHandler interface {
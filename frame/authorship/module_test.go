@@ -114,6 +114,22 @@ func Test_Authorship_Module_No_Author(t *testing.T) {
 	mockStorageAuthor.AssertNotCalled(t, "Put", mock.Anything)
 }
 
+func Test_Authorship_Module_Author_NotFound(t *testing.T) {
+	setup()
+
+	mockStorageAuthor.On("GetBytes").Return(sc.NewOption[sc.Sequence[sc.U8]](nil), nil)
+	mockSystemModule.On("StorageDigest").Return(digest, nil)
+	mockFindAccountFromAuthorIndex.On("FindAuthor", sc.Sequence[primitives.DigestPreRuntime]{}).
+		Return(sc.NewOption[primitives.AccountId](nil), nil)
+
+	result, err := target.Author()
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[primitives.AccountId](nil), result)
+
+	mockStorageAuthor.AssertNotCalled(t, "Put", mock.Anything)
+}
+
 func Test_Authorship_Module_Author(t *testing.T) {
 	setup()
 
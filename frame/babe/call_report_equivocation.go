@@ -0,0 +1,108 @@
+package babe
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	babetypes "github.com/LimeChain/gosemble/primitives/babe"
+	"github.com/LimeChain/gosemble/primitives/session"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Report authority equivocation/misbehavior. This method will verify
+// the equivocation proof and validate the given key ownership proof
+// against the extracted offender. If both are valid, the offence will
+// be reported.
+type callReportEquivocation struct {
+	primitives.Callable
+	dbWeight          primitives.RuntimeDbWeight
+	evidenceProcessor EvidenceProcessor
+}
+
+func newCallReportEquivocation(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, evidenceProcessor EvidenceProcessor) primitives.Call {
+	call := callReportEquivocation{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(babetypes.EquivocationProof{}, session.MembershipProof{}),
+		},
+		dbWeight:          dbWeight,
+		evidenceProcessor: evidenceProcessor,
+	}
+
+	return call
+}
+
+func (c callReportEquivocation) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	equivocationProof, err := babetypes.DecodeEquivocationProof(buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	keyOwnerProof, err := session.DecodeMembershipProof(buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Arguments = sc.NewVaryingData(equivocationProof, keyOwnerProof)
+	return c, nil
+}
+
+func (c callReportEquivocation) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callReportEquivocation) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callReportEquivocation) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c callReportEquivocation) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c callReportEquivocation) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (c callReportEquivocation) BaseWeight() primitives.Weight {
+	return callReportEquivocationWeight(c.dbWeight)
+}
+
+func (_ callReportEquivocation) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callReportEquivocation) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callReportEquivocation) PaysFee(baseWeight primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callReportEquivocation) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	reporter, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	equivocationProof := args[0].(babetypes.EquivocationProof)
+	keyOwnerProof := args[1].(session.MembershipProof)
+
+	err = c.evidenceProcessor.ProcessEvidence(reporter, equivocationProof, keyOwnerProof)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	// Waive the fee since the report is valid and beneficial
+	return primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, nil
+}
+
+func (_ callReportEquivocation) Docs() string {
+	return "Report authority equivocation/misbehavior."
+}
@@ -0,0 +1,178 @@
+package babe
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	babetypes "github.com/LimeChain/gosemble/primitives/babe"
+	"github.com/LimeChain/gosemble/primitives/session"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	mockEvidenceProcessor *MockEvidenceProcessor
+)
+
+var (
+	someReportEquivocationArgs    = sc.NewVaryingData(equivocationProof, keyOwnerProof)
+	defaultReportEquivocationArgs = sc.NewVaryingData(babetypes.EquivocationProof{}, session.MembershipProof{})
+)
+
+func Test_Call_ReportEquivocation_New(t *testing.T) {
+	call := setupCallReportEquivocation()
+
+	expected := callReportEquivocation{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionReportEquivocationIndex,
+			Arguments:  defaultReportEquivocationArgs,
+		},
+		dbWeight:          dbWeight,
+		evidenceProcessor: mockEvidenceProcessor,
+	}
+
+	assert.Equal(t, expected, call)
+}
+
+func Test_Call_ReportEquivocation_DecodeArgs_Success(t *testing.T) {
+	call := setupCallReportEquivocation()
+	assert.Equal(t, defaultReportEquivocationArgs, call.Args())
+
+	buf := bytes.NewBuffer(someReportEquivocationArgs.Bytes())
+	call, err := call.DecodeArgs(buf)
+
+	assert.Nil(t, err)
+	assert.Equal(t, someReportEquivocationArgs, call.Args())
+}
+
+func Test_Call_ReportEquivocation_DecodeArgs_Fails(t *testing.T) {
+	call := setupCallReportEquivocation()
+
+	_, err := call.DecodeArgs(bytes.NewBuffer(equivocationProof.Bytes()))
+
+	assert.Error(t, err)
+}
+
+func Test_Call_ReportEquivocation_Encode(t *testing.T) {
+	expectedBuf := bytes.NewBuffer(append([]byte{byte(moduleId), functionReportEquivocationIndex}, defaultReportEquivocationArgs.Bytes()...))
+	buf := &bytes.Buffer{}
+
+	call := setupCallReportEquivocation()
+	err := call.Encode(buf)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuf.Bytes(), buf.Bytes())
+}
+
+func Test_Call_ReportEquivocation_Bytes(t *testing.T) {
+	expected := append([]byte{byte(moduleId), functionReportEquivocationIndex}, defaultReportEquivocationArgs.Bytes()...)
+
+	call := setupCallReportEquivocation()
+
+	assert.Equal(t, expected, call.Bytes())
+}
+
+func Test_Call_ReportEquivocation_ModuleIndex(t *testing.T) {
+	testCases := []sc.U8{
+		moduleId,
+		1,
+		2,
+		3,
+	}
+
+	for _, tc := range testCases {
+		call := newCallReportEquivocation(tc, functionReportEquivocationIndex, dbWeight, new(MockEvidenceProcessor))
+
+		assert.Equal(t, tc, call.ModuleIndex())
+	}
+}
+
+func Test_Call_ReportEquivocation_FunctionIndex(t *testing.T) {
+	testCases := []sc.U8{
+		0,
+		1,
+		3,
+		functionReportEquivocationIndex,
+	}
+
+	for _, tc := range testCases {
+		call := newCallReportEquivocation(moduleId, tc, dbWeight, new(MockEvidenceProcessor))
+
+		assert.Equal(t, tc, call.FunctionIndex())
+	}
+}
+
+func Test_Call_ReportEquivocation_BaseWeight(t *testing.T) {
+	call := setupCallReportEquivocation()
+
+	assert.Equal(t, callReportEquivocationWeight(dbWeight), call.BaseWeight())
+}
+
+func Test_Call_ReportEquivocation_WeighData(t *testing.T) {
+	call := setupCallReportEquivocation()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), call.WeighData(baseWeight))
+}
+
+func Test_Call_ReportEquivocation_ClassifyDispatch(t *testing.T) {
+	call := setupCallReportEquivocation()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), call.ClassifyDispatch(baseWeight))
+}
+
+func Test_Call_ReportEquivocation_PaysFee(t *testing.T) {
+	call := setupCallReportEquivocation()
+
+	assert.Equal(t, primitives.PaysYes, call.PaysFee(baseWeight))
+}
+
+func Test_Call_ReportEquivocation_Dispatch(t *testing.T) {
+	call := setupCallReportEquivocation()
+
+	call, err := call.DecodeArgs(bytes.NewBuffer(someReportEquivocationArgs.Bytes()))
+	assert.Nil(t, err)
+
+	mockEvidenceProcessor.On("ProcessEvidence", sc.NewOption[primitives.AccountId](constants.TwoAccountId), equivocationProof, keyOwnerProof).Return(nil)
+
+	result, dispatchErr := call.Dispatch(primitives.NewRawOriginSigned(constants.TwoAccountId), call.Args())
+
+	assert.Nil(t, dispatchErr)
+	assert.Equal(t, primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, result)
+	mockEvidenceProcessor.AssertCalled(t, "ProcessEvidence", sc.NewOption[primitives.AccountId](constants.TwoAccountId), equivocationProof, keyOwnerProof)
+}
+
+func Test_Call_ReportEquivocation_Dispatch_InvalidEvidence(t *testing.T) {
+	call := setupCallReportEquivocation()
+	expectedErr := NewDispatchErrorInvalidEquivocationProof(moduleId)
+
+	call, err := call.DecodeArgs(bytes.NewBuffer(someReportEquivocationArgs.Bytes()))
+	assert.Nil(t, err)
+
+	mockEvidenceProcessor.On("ProcessEvidence", sc.NewOption[primitives.AccountId](constants.TwoAccountId), equivocationProof, keyOwnerProof).Return(expectedErr)
+
+	_, dispatchErr := call.Dispatch(primitives.NewRawOriginSigned(constants.TwoAccountId), call.Args())
+
+	assert.Equal(t, expectedErr, dispatchErr)
+}
+
+func Test_Call_ReportEquivocation_Dispatch_BadOrigin(t *testing.T) {
+	call := setupCallReportEquivocation()
+
+	call, err := call.DecodeArgs(bytes.NewBuffer(someReportEquivocationArgs.Bytes()))
+	assert.Nil(t, err)
+
+	_, dispatchErr := call.Dispatch(primitives.NewRawOriginNone(), call.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), dispatchErr)
+	mockEvidenceProcessor.AssertNotCalled(t, "ProcessEvidence", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func setupCallReportEquivocation() primitives.Call {
+	mockEvidenceProcessor = new(MockEvidenceProcessor)
+
+	return newCallReportEquivocation(moduleId, functionReportEquivocationIndex, dbWeight, mockEvidenceProcessor)
+}
@@ -0,0 +1,174 @@
+package babe
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	babetypes "github.com/LimeChain/gosemble/primitives/babe"
+	"github.com/LimeChain/gosemble/primitives/session"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	someReportEquivocationUnsignedArgs    = sc.NewVaryingData(equivocationProof, keyOwnerProof)
+	defaultReportEquivocationUnsignedArgs = sc.NewVaryingData(babetypes.EquivocationProof{}, session.MembershipProof{})
+)
+
+func Test_Call_ReportEquivocationUnsigned_New(t *testing.T) {
+	call := setupCallReportEquivocationUnsigned()
+
+	expected := callReportEquivocationUnsigned{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionReportEquivocationUnsignedIndex,
+			Arguments:  defaultReportEquivocationUnsignedArgs,
+		},
+		dbWeight:          dbWeight,
+		evidenceProcessor: mockEvidenceProcessor,
+	}
+
+	assert.Equal(t, expected, call)
+}
+
+func Test_Call_ReportEquivocationUnsigned_DecodeArgs_Success(t *testing.T) {
+	call := setupCallReportEquivocationUnsigned()
+	assert.Equal(t, defaultReportEquivocationUnsignedArgs, call.Args())
+
+	buf := bytes.NewBuffer(someReportEquivocationUnsignedArgs.Bytes())
+	call, err := call.DecodeArgs(buf)
+
+	assert.Nil(t, err)
+	assert.Equal(t, someReportEquivocationUnsignedArgs, call.Args())
+}
+
+func Test_Call_ReportEquivocationUnsigned_DecodeArgs_Fails(t *testing.T) {
+	call := setupCallReportEquivocationUnsigned()
+
+	_, err := call.DecodeArgs(bytes.NewBuffer(equivocationProof.Bytes()))
+
+	assert.Error(t, err)
+}
+
+func Test_Call_ReportEquivocationUnsigned_Encode(t *testing.T) {
+	expectedBuf := bytes.NewBuffer(append([]byte{byte(moduleId), functionReportEquivocationUnsignedIndex}, defaultReportEquivocationUnsignedArgs.Bytes()...))
+	buf := &bytes.Buffer{}
+
+	call := setupCallReportEquivocationUnsigned()
+	err := call.Encode(buf)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuf.Bytes(), buf.Bytes())
+}
+
+func Test_Call_ReportEquivocationUnsigned_Bytes(t *testing.T) {
+	expected := append([]byte{byte(moduleId), functionReportEquivocationUnsignedIndex}, defaultReportEquivocationUnsignedArgs.Bytes()...)
+
+	call := setupCallReportEquivocationUnsigned()
+
+	assert.Equal(t, expected, call.Bytes())
+}
+
+func Test_Call_ReportEquivocationUnsigned_ModuleIndex(t *testing.T) {
+	testCases := []sc.U8{
+		moduleId,
+		1,
+		2,
+		3,
+	}
+
+	for _, tc := range testCases {
+		call := newCallReportEquivocationUnsigned(tc, functionReportEquivocationUnsignedIndex, dbWeight, new(MockEvidenceProcessor))
+
+		assert.Equal(t, tc, call.ModuleIndex())
+	}
+}
+
+func Test_Call_ReportEquivocationUnsigned_FunctionIndex(t *testing.T) {
+	testCases := []sc.U8{
+		0,
+		1,
+		3,
+		functionReportEquivocationUnsignedIndex,
+	}
+
+	for _, tc := range testCases {
+		call := newCallReportEquivocationUnsigned(moduleId, tc, dbWeight, new(MockEvidenceProcessor))
+
+		assert.Equal(t, tc, call.FunctionIndex())
+	}
+}
+
+func Test_Call_ReportEquivocationUnsigned_BaseWeight(t *testing.T) {
+	call := setupCallReportEquivocationUnsigned()
+
+	assert.Equal(t, callReportEquivocationUnsignedWeight(dbWeight), call.BaseWeight())
+}
+
+func Test_Call_ReportEquivocationUnsigned_WeighData(t *testing.T) {
+	call := setupCallReportEquivocationUnsigned()
+
+	assert.Equal(t, primitives.WeightFromParts(567, 0), call.WeighData(baseWeight))
+}
+
+func Test_Call_ReportEquivocationUnsigned_ClassifyDispatch(t *testing.T) {
+	call := setupCallReportEquivocationUnsigned()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), call.ClassifyDispatch(baseWeight))
+}
+
+func Test_Call_ReportEquivocationUnsigned_PaysFee(t *testing.T) {
+	call := setupCallReportEquivocationUnsigned()
+
+	assert.Equal(t, primitives.PaysYes, call.PaysFee(baseWeight))
+}
+
+func Test_Call_ReportEquivocationUnsigned_Dispatch(t *testing.T) {
+	call := setupCallReportEquivocationUnsigned()
+
+	call, err := call.DecodeArgs(bytes.NewBuffer(someReportEquivocationUnsignedArgs.Bytes()))
+	assert.Nil(t, err)
+
+	mockEvidenceProcessor.On("ProcessEvidence", sc.NewOption[primitives.AccountId](nil), equivocationProof, keyOwnerProof).Return(nil)
+
+	result, dispatchErr := call.Dispatch(primitives.NewRawOriginNone(), call.Args())
+
+	assert.Nil(t, dispatchErr)
+	assert.Equal(t, primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}, result)
+	mockEvidenceProcessor.AssertCalled(t, "ProcessEvidence", sc.NewOption[primitives.AccountId](nil), equivocationProof, keyOwnerProof)
+}
+
+func Test_Call_ReportEquivocationUnsigned_Dispatch_InvalidEvidence(t *testing.T) {
+	call := setupCallReportEquivocationUnsigned()
+	expectedErr := NewDispatchErrorInvalidEquivocationProof(moduleId)
+
+	call, err := call.DecodeArgs(bytes.NewBuffer(someReportEquivocationUnsignedArgs.Bytes()))
+	assert.Nil(t, err)
+
+	mockEvidenceProcessor.On("ProcessEvidence", sc.NewOption[primitives.AccountId](nil), equivocationProof, keyOwnerProof).Return(expectedErr)
+
+	_, dispatchErr := call.Dispatch(primitives.NewRawOriginNone(), call.Args())
+
+	assert.Equal(t, expectedErr, dispatchErr)
+}
+
+func Test_Call_ReportEquivocationUnsigned_Dispatch_BadOrigin(t *testing.T) {
+	call := setupCallReportEquivocationUnsigned()
+
+	call, err := call.DecodeArgs(bytes.NewBuffer(someReportEquivocationUnsignedArgs.Bytes()))
+	assert.Nil(t, err)
+
+	_, dispatchErr := call.Dispatch(primitives.NewRawOriginSigned(constants.TwoAccountId), call.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), dispatchErr)
+	mockEvidenceProcessor.AssertNotCalled(t, "ProcessEvidence", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func setupCallReportEquivocationUnsigned() primitives.Call {
+	mockEvidenceProcessor = new(MockEvidenceProcessor)
+
+	return newCallReportEquivocationUnsigned(moduleId, functionReportEquivocationUnsignedIndex, dbWeight, mockEvidenceProcessor)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/LimeChain/gosemble/frame/system"
 	babetypes "github.com/LimeChain/gosemble/primitives/babe"
 	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/staking"
 	"github.com/LimeChain/gosemble/primitives/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
@@ -22,6 +23,9 @@ type Config struct {
 	MinimumPeriod      sc.U64
 	SystemDigest       func() (primitives.Digest, error)
 	SystemModule       system.Module
+	Offences           staking.ReportOffence
+	KeyOwnerProof      KeyOwnerProofSystem
+	ReportLongevity    sc.U64
 }
 
 func NewConfig(
@@ -36,6 +40,9 @@ func NewConfig(
 	minimumPeriod sc.U64,
 	systemDigest func() (primitives.Digest, error),
 	systemModule system.Module,
+	offences staking.ReportOffence,
+	keyOwnerProof KeyOwnerProofSystem,
+	reportLongevity sc.U64,
 ) *Config {
 	return &Config{
 		Storage:            storage,
@@ -49,5 +56,8 @@ func NewConfig(
 		MinimumPeriod:      minimumPeriod,
 		SystemDigest:       systemDigest,
 		SystemModule:       systemModule,
+		Offences:           offences,
+		KeyOwnerProof:      keyOwnerProof,
+		ReportLongevity:    reportLongevity,
 	}
 }
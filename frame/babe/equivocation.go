@@ -0,0 +1,374 @@
+package babe
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/frame/session_historical"
+	babetypes "github.com/LimeChain/gosemble/primitives/babe"
+	sessiontypes "github.com/LimeChain/gosemble/primitives/session"
+	"github.com/LimeChain/gosemble/primitives/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// EquivocationOffenceKind identifies BABE equivocation offences.
+	EquivocationOffenceKind = sc.BytesToFixedSequenceU8([]byte("babe:equivocatio"))
+
+	// equivocationTagPrefix prefixes the tags provided by unsigned equivocation reports.
+	equivocationTagPrefix = sc.Str("BabeEquivocation")
+)
+
+var (
+	errSubmitEquivocationReport = errors.New("Babe: failed to submit equivocation report")
+)
+
+// Something which can compute and check proofs of
+// a historical key owner and return full identification data of that
+// key owner.
+type KeyOwnerProofSystem interface {
+	// Prove membership of a key owner in the current block-state.
+	//
+	// This should typically only be called off-chain, since it may be
+	// computationally heavy.
+	//
+	// Returns `Some` iff the key owner referred to by the given `key` is a
+	// member of the current set.
+	Prove(key [4]byte, authorityId primitives.AccountId) sc.Option[sessiontypes.MembershipProof]
+
+	// Check a proof of membership on-chain. Return `Some` iff the proof is
+	// valid and recent enough to check.
+	CheckProof(key [4]byte, authorityId primitives.AccountId, proof sessiontypes.MembershipProof) sc.Option[session_historical.IdentificationTuple]
+}
+
+// EvidenceProcessor processes the equivocation evidence, submitted through the equivocation report calls.
+type EvidenceProcessor interface {
+	ProcessEvidence(reporter sc.Option[primitives.AccountId], equivocationProof babetypes.EquivocationProof, keyOwnerProof sessiontypes.MembershipProof) error
+}
+
+// BABE equivocation offence report.
+type EquivocationOffence struct {
+	// A babe slot in which this incident happened.
+	TimeSlot babetypes.Slot
+	// The session index in which the incident happened.
+	SessionIndex sc.U32
+	// The size of the validator set at the time of the offence.
+	ValidatorSetCount sc.U32
+	// The authority that produced the equivocation.
+	Offender primitives.AccountId
+}
+
+func (e EquivocationOffence) Kind() sc.FixedSequence[sc.U8] {
+	return EquivocationOffenceKind
+}
+
+func (e EquivocationOffence) Offenders() sc.Sequence[primitives.AccountId] {
+	return sc.Sequence[primitives.AccountId]{e.Offender}
+}
+
+func (e EquivocationOffence) Session() sc.U32 {
+	return e.SessionIndex
+}
+
+func (e EquivocationOffence) ValidatorSetSize() sc.U32 {
+	return e.ValidatorSetCount
+}
+
+func (e EquivocationOffence) Slot() sc.Encodable {
+	return e.TimeSlot
+}
+
+func (e EquivocationOffence) SlashFraction(offendersCount sc.U32) primitives.Perbill {
+	return staking.EquivocationSlashFraction(offendersCount, e.ValidatorSetCount)
+}
+
+// reportOffence reports the equivocation `offence` on behalf of `reporters` to the offences module.
+func (m module) reportOffence(reporters sc.Sequence[primitives.AccountId], offence EquivocationOffence) error {
+	if err := m.config.Offences.ReportOffence(reporters, offence); err != nil {
+		if errors.Is(err, staking.ErrDuplicateOffenceReport) {
+			return NewDispatchErrorDuplicateOffenceReport(m.index)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Submits an extrinsic to report an equivocation. This method will create
+// an unsigned extrinsic with a call to `report_equivocation_unsigned` and
+// will push the transaction to the pool. Only useful in an offchain
+// context.
+func (m module) SubmitUnsignedEquivocationReport(equivocationProof babetypes.EquivocationProof, keyOwnerProof sessiontypes.MembershipProof) error {
+	call := callReportEquivocationUnsigned{
+		Callable: primitives.Callable{
+			ModuleId:   m.index,
+			FunctionId: functionReportEquivocationUnsignedIndex,
+			Arguments:  sc.NewVaryingData(equivocationProof, keyOwnerProof),
+		},
+		dbWeight:          m.config.DbWeight,
+		evidenceProcessor: m,
+	}
+
+	xt := types.NewUnsignedUncheckedExtrinsic(call)
+	buffer := &bytes.Buffer{}
+	if err := xt.Encode(buffer); err != nil {
+		return err
+	}
+
+	// the result is an encoded `Result<(), ()>`
+	result := m.ioOffchain.SubmitTransaction(buffer.Bytes())
+	if len(result) == 0 || result[0] != 0 {
+		return errSubmitEquivocationReport
+	}
+
+	m.logger.Debug("Submitted equivocation report")
+
+	return nil
+}
+
+// HistoricalKeyOwnershipProof generates a proof of key ownership for the given authority in the current session.
+func (m module) HistoricalKeyOwnershipProof(authorityId primitives.AccountId) sc.Option[babetypes.OpaqueKeyOwnershipProof] {
+	proof := m.config.KeyOwnerProof.Prove(KeyTypeId, authorityId)
+	if !proof.HasValue {
+		return sc.NewOption[babetypes.OpaqueKeyOwnershipProof](nil)
+	}
+
+	return sc.NewOption[babetypes.OpaqueKeyOwnershipProof](sc.BytesToSequenceU8(proof.Value.Bytes()))
+}
+
+// ProcessEvidence validates the equivocation and key ownership proofs and reports the offence on
+// behalf of `reporter`, or the block author if there is no reporter.
+func (m module) ProcessEvidence(reporter sc.Option[primitives.AccountId], equivocationProof babetypes.EquivocationProof, keyOwnerProof sessiontypes.MembershipProof) error {
+	reporters := sc.Sequence[primitives.AccountId]{}
+	if reporter.HasValue {
+		reporters = append(reporters, reporter.Value)
+	} else {
+		author, err := m.blockAuthor()
+		if err != nil {
+			return err
+		}
+		if author.HasValue {
+			reporters = append(reporters, author.Value)
+		}
+	}
+
+	// validate the equivocation proof (check headers are different and seals are valid)
+	if !m.checkEquivocationProof(equivocationProof) {
+		return NewDispatchErrorInvalidEquivocationProof(m.index)
+	}
+
+	validatorSetCount := keyOwnerProof.ValidatorCount()
+	sessionIndex := keyOwnerProof.Session()
+
+	genesisSlot, err := m.storage.GenesisSlot.Get()
+	if err != nil {
+		return err
+	}
+	epochIndex := sc.SaturatingSubU64(equivocationProof.Slot, genesisSlot) / m.EpochDuration()
+
+	// check that the slot number is consistent with the session index
+	// in the key ownership proof (i.e. slot is for that epoch)
+	epochSessionIndex, err := m.sessionIndexForEpoch(epochIndex)
+	if err != nil {
+		return err
+	}
+	if epochSessionIndex != sessionIndex {
+		return NewDispatchErrorInvalidKeyOwnershipProof(m.index)
+	}
+
+	// check the membership proof and extract the offender's id
+	offender := m.config.KeyOwnerProof.CheckProof(KeyTypeId, primitives.AccountId(equivocationProof.Offender), keyOwnerProof)
+	if !offender.HasValue {
+		return NewDispatchErrorInvalidKeyOwnershipProof(m.index)
+	}
+
+	offence := EquivocationOffence{
+		TimeSlot:          equivocationProof.Slot,
+		SessionIndex:      sessionIndex,
+		ValidatorSetCount: validatorSetCount,
+		Offender:          offender.Value.Validator,
+	}
+
+	return m.reportOffence(reporters, offence)
+}
+
+// checkEvidence checks the key ownership proof of an unsigned equivocation report and
+// whether the offence has already been reported.
+func (m module) checkEvidence(equivocationProof babetypes.EquivocationProof, keyOwnerProof sessiontypes.MembershipProof) error {
+	// check the membership proof to extract the offender's id
+	offender := m.config.KeyOwnerProof.CheckProof(KeyTypeId, primitives.AccountId(equivocationProof.Offender), keyOwnerProof)
+	if !offender.HasValue {
+		return primitives.NewTransactionValidityError(primitives.NewInvalidTransactionBadProof())
+	}
+
+	// check if the offence has already been reported, and if so then we can discard the report.
+	isKnown, err := m.config.Offences.IsKnownOffence(EquivocationOffenceKind, sc.Sequence[primitives.AccountId]{offender.Value.Validator}, equivocationProof.Slot)
+	if err != nil {
+		return err
+	}
+	if isKnown {
+		return primitives.NewTransactionValidityError(primitives.NewInvalidTransactionStale())
+	}
+
+	return nil
+}
+
+// checkEquivocationProof verifies the equivocation proof by making sure that: both headers have
+// different hashes, are targetting the same slot, and have valid signatures by the same authority.
+func (m module) checkEquivocationProof(equivocationProof babetypes.EquivocationProof) bool {
+	// we must have different headers for the equivocation to be valid
+	if reflect.DeepEqual(equivocationProof.FirstHeader.Bytes(), equivocationProof.SecondHeader.Bytes()) {
+		return false
+	}
+
+	firstPreDigest := findPreDigest(equivocationProof.FirstHeader)
+	secondPreDigest := findPreDigest(equivocationProof.SecondHeader)
+	if !firstPreDigest.HasValue || !secondPreDigest.HasValue {
+		return false
+	}
+
+	firstSlot, err := firstPreDigest.Value.Slot()
+	if err != nil {
+		return false
+	}
+	secondSlot, err := secondPreDigest.Value.Slot()
+	if err != nil {
+		return false
+	}
+
+	// both headers must be targetting the same slot and it must
+	// be the same as the one in the proof.
+	if equivocationProof.Slot != firstSlot || firstSlot != secondSlot {
+		return false
+	}
+
+	firstAuthorityIndex, err := firstPreDigest.Value.AuthorityIndex()
+	if err != nil {
+		return false
+	}
+	secondAuthorityIndex, err := secondPreDigest.Value.AuthorityIndex()
+	if err != nil {
+		return false
+	}
+
+	// both headers must have been authored by the same authority
+	if firstAuthorityIndex != secondAuthorityIndex {
+		return false
+	}
+
+	// we finally verify that the expected authority has signed both headers and
+	// that the signature is valid.
+	return m.verifySealSignature(equivocationProof.FirstHeader, equivocationProof.Offender) &&
+		m.verifySealSignature(equivocationProof.SecondHeader, equivocationProof.Offender)
+}
+
+// verifySealSignature verifies that the last digest item of `header` is a BABE seal, signed by `offender`
+// over the hash of the header without the seal.
+func (m module) verifySealSignature(header primitives.Header, offender primitives.Sr25519PublicKey) bool {
+	items := header.Digest.Sequence
+	if len(items) == 0 || !items[len(items)-1].IsSeal() {
+		return false
+	}
+
+	seal, err := items[len(items)-1].AsSeal()
+	if err != nil {
+		return false
+	}
+
+	if !reflect.DeepEqual(sc.FixedSequenceU8ToBytes(seal.ConsensusEngineId), EngineId[:]) {
+		return false
+	}
+
+	header.Digest = primitives.NewDigest(items[:len(items)-1])
+	preHash := m.ioHashing.Blake256(header.Bytes())
+
+	return m.ioCrypto.Sr25519Verify(sc.SequenceU8ToBytes(seal.Message), preHash, sc.FixedSequenceU8ToBytes(offender.FixedSequence))
+}
+
+// sessionIndexForEpoch returns the session index of `epochIndex`, taking into account the skipped epochs.
+func (m module) sessionIndexForEpoch(epochIndex sc.U64) (sc.U32, error) {
+	skippedEpochs, err := m.storage.SkippedEpochs.Get()
+	if err != nil {
+		return 0, err
+	}
+
+	// the skipped epochs are ordered by epoch index
+	i := sort.Search(len(skippedEpochs), func(i int) bool {
+		return skippedEpochs[i].U64 >= epochIndex
+	})
+
+	switch {
+	case i < len(skippedEpochs) && skippedEpochs[i].U64 == epochIndex:
+		// if the epoch was skipped, we use the session index stored for it
+		return skippedEpochs[i].SessionIndex, nil
+	case i == 0:
+		// no epochs were skipped before this epoch, so the session index is the epoch index
+		return saturatedU32(epochIndex), nil
+	default:
+		// take the session index of the last skipped epoch before this one,
+		// and add the number of epochs since
+		skippedEpoch := skippedEpochs[i-1]
+		return saturatedU32(sc.SaturatingAddU64(sc.SaturatingSubU64(epochIndex, skippedEpoch.U64), sc.U64(skippedEpoch.SessionIndex))), nil
+	}
+}
+
+// blockAuthor returns the account of the author of the current block.
+func (m module) blockAuthor() (sc.Option[primitives.AccountId], error) {
+	digest, err := m.config.SystemDigest()
+	if err != nil {
+		return sc.NewOption[primitives.AccountId](nil), err
+	}
+
+	preRuntimeDigests, err := digest.PreRuntimes()
+	if err != nil {
+		return sc.NewOption[primitives.AccountId](nil), err
+	}
+
+	return session.NewFindAccountFromAuthorIndex(m.config.SessionModule, m).FindAuthor(preRuntimeDigests)
+}
+
+// equivocationTag returns the tag provided by an unsigned equivocation report. Only one
+// equivocation report for the same offender at the same slot is allowed.
+func equivocationTag(equivocationProof babetypes.EquivocationProof) primitives.TransactionTag {
+	tag := equivocationTagPrefix.Bytes()
+	tag = append(tag, equivocationProof.Offender.Bytes()...)
+	tag = append(tag, equivocationProof.Slot.Bytes()...)
+
+	return sc.BytesToSequenceU8(tag)
+}
+
+// findPreDigest returns the BABE pre-runtime digest of `header`, if any.
+func findPreDigest(header primitives.Header) sc.Option[babetypes.PreDigest] {
+	for _, item := range header.Digest.Sequence {
+		if !item.IsPreRuntime() {
+			continue
+		}
+
+		preRuntime, err := item.AsPreRuntime()
+		if err != nil || !reflect.DeepEqual(sc.FixedSequenceU8ToBytes(preRuntime.ConsensusEngineId), EngineId[:]) {
+			continue
+		}
+
+		preDigest, err := babetypes.DecodePreDigest(bytes.NewBuffer(sc.SequenceU8ToBytes(preRuntime.Message)))
+		if err != nil {
+			continue
+		}
+
+		return sc.NewOption[babetypes.PreDigest](preDigest)
+	}
+
+	return sc.NewOption[babetypes.PreDigest](nil)
+}
+
+func saturatedU32(n sc.U64) sc.U32 {
+	if n > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return sc.U32(n)
+}
@@ -0,0 +1,368 @@
+package babe
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/session_historical"
+	babetypes "github.com/LimeChain/gosemble/primitives/babe"
+	"github.com/LimeChain/gosemble/primitives/session"
+	"github.com/LimeChain/gosemble/primitives/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	babeSlot = sc.U64(42)
+)
+
+var (
+	equivocationOffence = EquivocationOffence{
+		TimeSlot:          babeSlot,
+		SessionIndex:      3,
+		ValidatorSetCount: 10,
+		Offender:          constants.OneAccountId,
+	}
+	equivocationReporters = sc.Sequence[primitives.AccountId]{constants.TwoAccountId}
+)
+
+var (
+	sealSignature = sc.BytesToSequenceU8(make([]byte, 64))
+	firstPreHash  = []byte{1}
+	secondPreHash = []byte{2}
+
+	firstUnsealedHeader  = newEquivocationHeader(constants.OneAccountId, preDigest)
+	secondUnsealedHeader = newEquivocationHeader(constants.TwoAccountId, preDigest)
+	firstSealedHeader    = sealHeader(firstUnsealedHeader)
+	secondSealedHeader   = sealHeader(secondUnsealedHeader)
+
+	equivocationProof = babetypes.EquivocationProof{
+		Offender:     pubKey,
+		Slot:         slot,
+		FirstHeader:  firstSealedHeader,
+		SecondHeader: secondSealedHeader,
+	}
+
+	keyOwnerProof = session.MembershipProof{
+		SessionIndex: 3,
+		TrieNodes:    sc.Sequence[sc.Sequence[sc.U8]]{sc.BytesToSequenceU8([]byte{1, 2, 3})},
+		Validators:   10,
+	}
+
+	offenderIdentification = sc.NewOption[session_historical.IdentificationTuple](
+		session_historical.IdentificationTuple{Validator: constants.OneAccountId},
+	)
+
+	// slot 130 with genesis slot 100 and 10 slots per epoch is in epoch 3
+	reportedEpochDuration = sc.U64(10)
+
+	reportedOffence = EquivocationOffence{
+		TimeSlot:          slot,
+		SessionIndex:      3,
+		ValidatorSetCount: 10,
+		Offender:          constants.OneAccountId,
+	}
+)
+
+func newEquivocationHeader(stateRoot primitives.AccountId, digest babetypes.PreDigest) primitives.Header {
+	return primitives.Header{
+		ParentHash:     primitives.Blake2bHash{FixedSequence: constants.ZeroAccountId.FixedSequence},
+		Number:         5,
+		StateRoot:      primitives.H256{FixedSequence: stateRoot.FixedSequence},
+		ExtrinsicsRoot: primitives.H256{FixedSequence: constants.ZeroAccountId.FixedSequence},
+		Digest: primitives.NewDigest(sc.Sequence[primitives.DigestItem]{
+			primitives.NewDigestItemPreRuntime(sc.BytesToFixedSequenceU8(EngineId[:]), sc.BytesToSequenceU8(digest.Bytes())),
+		}),
+	}
+}
+
+func sealHeader(header primitives.Header) primitives.Header {
+	items := append(sc.Sequence[primitives.DigestItem]{}, header.Digest.Sequence...)
+	header.Digest = primitives.NewDigest(append(items, primitives.NewDigestItemSeal(sc.BytesToFixedSequenceU8(EngineId[:]), sealSignature)))
+	return header
+}
+
+func Test_EquivocationOffence(t *testing.T) {
+	assert.Equal(t, sc.BytesToFixedSequenceU8([]byte("babe:equivocatio")), equivocationOffence.Kind())
+	assert.Equal(t, sc.Sequence[primitives.AccountId]{constants.OneAccountId}, equivocationOffence.Offenders())
+	assert.Equal(t, sc.U32(3), equivocationOffence.Session())
+	assert.Equal(t, sc.U32(10), equivocationOffence.ValidatorSetSize())
+	assert.Equal(t, babeSlot.Bytes(), equivocationOffence.Slot().Bytes())
+	assert.Equal(t, primitives.NewPerbillFromPercent(9), equivocationOffence.SlashFraction(1))
+}
+
+func Test_Module_reportOffence(t *testing.T) {
+	target := setupModule()
+	mockReportOffence.On("ReportOffence", equivocationReporters, equivocationOffence).Return(nil)
+
+	err := target.reportOffence(equivocationReporters, equivocationOffence)
+
+	assert.NoError(t, err)
+	mockReportOffence.AssertCalled(t, "ReportOffence", equivocationReporters, equivocationOffence)
+}
+
+func Test_Module_reportOffence_Duplicate(t *testing.T) {
+	target := setupModule()
+	mockReportOffence.On("ReportOffence", equivocationReporters, equivocationOffence).Return(staking.ErrDuplicateOffenceReport)
+
+	err := target.reportOffence(equivocationReporters, equivocationOffence)
+
+	assert.Equal(t, NewDispatchErrorDuplicateOffenceReport(moduleId), err)
+}
+
+func Test_Module_reportOffence_Error(t *testing.T) {
+	target := setupModule()
+	expectErr := errors.New("report offence")
+	mockReportOffence.On("ReportOffence", equivocationReporters, equivocationOffence).Return(expectErr)
+
+	err := target.reportOffence(equivocationReporters, equivocationOffence)
+
+	assert.Equal(t, expectErr, err)
+}
+
+func Test_Module_ProcessEvidence(t *testing.T) {
+	target := setupEquivocationModule()
+	setupValidSeals()
+	mockStorageGenesisSlot.On("Get").Return(genesisSlot, nil)
+	mockStorageSkippedEpochs.On("Get").Return(sc.FixedSequence[babetypes.SkippedEpoch]{}, nil)
+	mockKeyOwnerProof.On("CheckProof", KeyTypeId, primitives.AccountId(pubKey), keyOwnerProof).Return(offenderIdentification)
+	mockReportOffence.On("ReportOffence", equivocationReporters, reportedOffence).Return(nil)
+
+	err := target.ProcessEvidence(sc.NewOption[primitives.AccountId](constants.TwoAccountId), equivocationProof, keyOwnerProof)
+
+	assert.NoError(t, err)
+	mockReportOffence.AssertCalled(t, "ReportOffence", equivocationReporters, reportedOffence)
+}
+
+func Test_Module_ProcessEvidence_BlockAuthorAsReporter(t *testing.T) {
+	target := setupEquivocationModule()
+	setupValidSeals()
+	mockSessionModule.On("Validators").Return(sc.Sequence[primitives.AccountId]{constants.OneAccountId, constants.TwoAccountId}, nil)
+	mockStorageGenesisSlot.On("Get").Return(genesisSlot, nil)
+	mockStorageSkippedEpochs.On("Get").Return(sc.FixedSequence[babetypes.SkippedEpoch]{}, nil)
+	mockKeyOwnerProof.On("CheckProof", KeyTypeId, primitives.AccountId(pubKey), keyOwnerProof).Return(offenderIdentification)
+	mockReportOffence.On("ReportOffence", equivocationReporters, reportedOffence).Return(nil)
+
+	err := target.ProcessEvidence(sc.NewOption[primitives.AccountId](nil), equivocationProof, keyOwnerProof)
+
+	assert.NoError(t, err)
+	mockReportOffence.AssertCalled(t, "ReportOffence", equivocationReporters, reportedOffence)
+}
+
+func Test_Module_ProcessEvidence_InvalidEquivocationProof(t *testing.T) {
+	target := setupEquivocationModule()
+	proof := equivocationProof
+	proof.SecondHeader = proof.FirstHeader
+
+	err := target.ProcessEvidence(sc.NewOption[primitives.AccountId](constants.TwoAccountId), proof, keyOwnerProof)
+
+	assert.Equal(t, NewDispatchErrorInvalidEquivocationProof(moduleId), err)
+	mockReportOffence.AssertNotCalled(t, "ReportOffence", mock.Anything, mock.Anything)
+}
+
+func Test_Module_ProcessEvidence_SessionMismatch(t *testing.T) {
+	target := setupEquivocationModule()
+	setupValidSeals()
+	mockStorageGenesisSlot.On("Get").Return(genesisSlot, nil)
+	mockStorageSkippedEpochs.On("Get").Return(sc.FixedSequence[babetypes.SkippedEpoch]{}, nil)
+	proof := keyOwnerProof
+	proof.SessionIndex = 4
+
+	err := target.ProcessEvidence(sc.NewOption[primitives.AccountId](constants.TwoAccountId), equivocationProof, proof)
+
+	assert.Equal(t, NewDispatchErrorInvalidKeyOwnershipProof(moduleId), err)
+	mockKeyOwnerProof.AssertNotCalled(t, "CheckProof", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Module_ProcessEvidence_InvalidKeyOwnershipProof(t *testing.T) {
+	target := setupEquivocationModule()
+	setupValidSeals()
+	mockStorageGenesisSlot.On("Get").Return(genesisSlot, nil)
+	mockStorageSkippedEpochs.On("Get").Return(sc.FixedSequence[babetypes.SkippedEpoch]{}, nil)
+	mockKeyOwnerProof.On("CheckProof", KeyTypeId, primitives.AccountId(pubKey), keyOwnerProof).Return(sc.NewOption[session_historical.IdentificationTuple](nil))
+
+	err := target.ProcessEvidence(sc.NewOption[primitives.AccountId](constants.TwoAccountId), equivocationProof, keyOwnerProof)
+
+	assert.Equal(t, NewDispatchErrorInvalidKeyOwnershipProof(moduleId), err)
+	mockReportOffence.AssertNotCalled(t, "ReportOffence", mock.Anything, mock.Anything)
+}
+
+func Test_Module_checkEvidence(t *testing.T) {
+	target := setupEquivocationModule()
+	offenders := sc.Sequence[primitives.AccountId]{constants.OneAccountId}
+	mockKeyOwnerProof.On("CheckProof", KeyTypeId, primitives.AccountId(pubKey), keyOwnerProof).Return(offenderIdentification)
+	mockReportOffence.On("IsKnownOffence", EquivocationOffenceKind, offenders, slot).Return(false, nil)
+
+	err := target.checkEvidence(equivocationProof, keyOwnerProof)
+
+	assert.NoError(t, err)
+	mockReportOffence.AssertCalled(t, "IsKnownOffence", EquivocationOffenceKind, offenders, slot)
+}
+
+func Test_Module_checkEvidence_BadProof(t *testing.T) {
+	target := setupEquivocationModule()
+	mockKeyOwnerProof.On("CheckProof", KeyTypeId, primitives.AccountId(pubKey), keyOwnerProof).Return(sc.NewOption[session_historical.IdentificationTuple](nil))
+
+	err := target.checkEvidence(equivocationProof, keyOwnerProof)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionBadProof()), err)
+}
+
+func Test_Module_checkEvidence_KnownOffence(t *testing.T) {
+	target := setupEquivocationModule()
+	offenders := sc.Sequence[primitives.AccountId]{constants.OneAccountId}
+	mockKeyOwnerProof.On("CheckProof", KeyTypeId, primitives.AccountId(pubKey), keyOwnerProof).Return(offenderIdentification)
+	mockReportOffence.On("IsKnownOffence", EquivocationOffenceKind, offenders, slot).Return(true, nil)
+
+	err := target.checkEvidence(equivocationProof, keyOwnerProof)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionStale()), err)
+}
+
+func Test_Module_checkEquivocationProof(t *testing.T) {
+	target := setupEquivocationModule()
+	setupValidSeals()
+
+	assert.True(t, target.checkEquivocationProof(equivocationProof))
+}
+
+func Test_Module_checkEquivocationProof_SlotMismatch(t *testing.T) {
+	target := setupEquivocationModule()
+	otherSlotPreDigest := babetypes.NewSecondaryPlainPreDigest(babetypes.AuthorityIndex(authorityIndex), slot+1)
+	proof := equivocationProof
+	proof.SecondHeader = sealHeader(newEquivocationHeader(constants.TwoAccountId, otherSlotPreDigest))
+
+	assert.False(t, target.checkEquivocationProof(proof))
+
+	proof = equivocationProof
+	proof.Slot = slot + 1
+
+	assert.False(t, target.checkEquivocationProof(proof))
+}
+
+func Test_Module_checkEquivocationProof_AuthorityMismatch(t *testing.T) {
+	target := setupEquivocationModule()
+	otherAuthorityPreDigest := babetypes.NewSecondaryPlainPreDigest(babetypes.AuthorityIndex(authorityIndex+1), slot)
+	proof := equivocationProof
+	proof.SecondHeader = sealHeader(newEquivocationHeader(constants.TwoAccountId, otherAuthorityPreDigest))
+
+	assert.False(t, target.checkEquivocationProof(proof))
+}
+
+func Test_Module_checkEquivocationProof_MissingSeal(t *testing.T) {
+	target := setupEquivocationModule()
+	proof := equivocationProof
+	proof.SecondHeader = secondUnsealedHeader
+	mockIoHashing.On("Blake256", firstUnsealedHeader.Bytes()).Return(firstPreHash)
+	mockIoCrypto.On("Sr25519Verify", sc.SequenceU8ToBytes(sealSignature), firstPreHash, pubKey.Bytes()).Return(true)
+
+	assert.False(t, target.checkEquivocationProof(proof))
+}
+
+func Test_Module_checkEquivocationProof_InvalidSignature(t *testing.T) {
+	target := setupEquivocationModule()
+	mockIoHashing.On("Blake256", firstUnsealedHeader.Bytes()).Return(firstPreHash)
+	mockIoHashing.On("Blake256", secondUnsealedHeader.Bytes()).Return(secondPreHash)
+	mockIoCrypto.On("Sr25519Verify", sc.SequenceU8ToBytes(sealSignature), firstPreHash, pubKey.Bytes()).Return(true)
+	mockIoCrypto.On("Sr25519Verify", sc.SequenceU8ToBytes(sealSignature), secondPreHash, pubKey.Bytes()).Return(false)
+
+	assert.False(t, target.checkEquivocationProof(equivocationProof))
+}
+
+func Test_Module_sessionIndexForEpoch(t *testing.T) {
+	skippedEpochs := sc.FixedSequence[babetypes.SkippedEpoch]{
+		{U64: 5, SessionIndex: 4},
+		{U64: 10, SessionIndex: 7},
+	}
+
+	for _, tt := range []struct {
+		epochIndex sc.U64
+		expected   sc.U32
+	}{
+		{epochIndex: 3, expected: 3},
+		{epochIndex: 5, expected: 4},
+		{epochIndex: 7, expected: 6},
+		{epochIndex: 10, expected: 7},
+		{epochIndex: 12, expected: 9},
+	} {
+		target := setupEquivocationModule()
+		mockStorageSkippedEpochs.On("Get").Return(skippedEpochs, nil)
+
+		result, err := target.sessionIndexForEpoch(tt.epochIndex)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, result)
+	}
+}
+
+func Test_Module_sessionIndexForEpoch_Saturates(t *testing.T) {
+	target := setupEquivocationModule()
+	mockStorageSkippedEpochs.On("Get").Return(sc.FixedSequence[babetypes.SkippedEpoch]{}, nil)
+
+	result, err := target.sessionIndexForEpoch(math.MaxUint64)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U32(math.MaxUint32), result)
+}
+
+func Test_Module_SubmitUnsignedEquivocationReport(t *testing.T) {
+	target := setupEquivocationModule()
+	call := newCallReportEquivocationUnsigned(moduleId, functionReportEquivocationUnsignedIndex, dbWeight, target)
+	call, err := call.DecodeArgs(bytes.NewBuffer(append(equivocationProof.Bytes(), keyOwnerProof.Bytes()...)))
+	assert.NoError(t, err)
+	xt := types.NewUnsignedUncheckedExtrinsic(call)
+	mockIoOffchain.On("SubmitTransaction", xt.Bytes()).Return([]byte{0})
+
+	err = target.SubmitUnsignedEquivocationReport(equivocationProof, keyOwnerProof)
+
+	assert.NoError(t, err)
+	mockIoOffchain.AssertCalled(t, "SubmitTransaction", xt.Bytes())
+}
+
+func Test_Module_SubmitUnsignedEquivocationReport_Fails(t *testing.T) {
+	target := setupEquivocationModule()
+	mockIoOffchain.On("SubmitTransaction", mock.Anything).Return([]byte{1})
+
+	err := target.SubmitUnsignedEquivocationReport(equivocationProof, keyOwnerProof)
+
+	assert.Equal(t, errSubmitEquivocationReport, err)
+}
+
+func Test_Module_HistoricalKeyOwnershipProof(t *testing.T) {
+	target := setupEquivocationModule()
+	mockKeyOwnerProof.On("Prove", KeyTypeId, constants.OneAccountId).Return(sc.NewOption[session.MembershipProof](keyOwnerProof))
+
+	result := target.HistoricalKeyOwnershipProof(constants.OneAccountId)
+
+	assert.Equal(t, sc.NewOption[babetypes.OpaqueKeyOwnershipProof](sc.BytesToSequenceU8(keyOwnerProof.Bytes())), result)
+}
+
+func Test_Module_HistoricalKeyOwnershipProof_None(t *testing.T) {
+	target := setupEquivocationModule()
+	mockKeyOwnerProof.On("Prove", KeyTypeId, constants.OneAccountId).Return(sc.NewOption[session.MembershipProof](nil))
+
+	result := target.HistoricalKeyOwnershipProof(constants.OneAccountId)
+
+	assert.Equal(t, sc.NewOption[babetypes.OpaqueKeyOwnershipProof](nil), result)
+}
+
+func setupEquivocationModule() module {
+	target := setupModule()
+	target.config.EpochDuration = reportedEpochDuration
+	target.storage.SkippedEpochs = mockStorageSkippedEpochs
+
+	return target
+}
+
+func setupValidSeals() {
+	mockIoHashing.On("Blake256", firstUnsealedHeader.Bytes()).Return(firstPreHash)
+	mockIoHashing.On("Blake256", secondUnsealedHeader.Bytes()).Return(secondPreHash)
+	mockIoCrypto.On("Sr25519Verify", sc.SequenceU8ToBytes(sealSignature), firstPreHash, pubKey.Bytes()).Return(true)
+	mockIoCrypto.On("Sr25519Verify", sc.SequenceU8ToBytes(sealSignature), secondPreHash, pubKey.Bytes()).Return(true)
+}
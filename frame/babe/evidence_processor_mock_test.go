@@ -0,0 +1,23 @@
+package babe
+
+import (
+	sc "github.com/LimeChain/goscale"
+	babetypes "github.com/LimeChain/gosemble/primitives/babe"
+	"github.com/LimeChain/gosemble/primitives/session"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockEvidenceProcessor struct {
+	mock.Mock
+}
+
+func (m *MockEvidenceProcessor) ProcessEvidence(reporter sc.Option[primitives.AccountId], equivocationProof babetypes.EquivocationProof, keyOwnerProof session.MembershipProof) error {
+	args := m.Called(reporter, equivocationProof, keyOwnerProof)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
@@ -0,0 +1,23 @@
+package babe
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/session_historical"
+	"github.com/LimeChain/gosemble/primitives/session"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockKeyOwnerProofSystem struct {
+	mock.Mock
+}
+
+func (m *MockKeyOwnerProofSystem) Prove(key [4]byte, authorityId primitives.AccountId) sc.Option[session.MembershipProof] {
+	args := m.Called(key, authorityId)
+	return args.Get(0).(sc.Option[session.MembershipProof])
+}
+
+func (m *MockKeyOwnerProofSystem) CheckProof(key [4]byte, authorityId primitives.AccountId, proof session.MembershipProof) sc.Option[session_historical.IdentificationTuple] {
+	args := m.Called(key, authorityId, proof)
+	return args.Get(0).(sc.Option[session_historical.IdentificationTuple])
+}
@@ -291,6 +291,51 @@ func (m module) metadataTypes() sc.Sequence[primitives.MetadataType] {
 			},
 		),
 
+		primitives.NewMetadataTypeWithParams(
+			metadata.TypesBabeEquivocationProof,
+			"EquivocationProof",
+			sc.Sequence[sc.Str]{"sp_consensus_slots", "EquivocationProof"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSr25519PubKey, "offender", "Id"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSlot, "slot", "Slot"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.Header, "first_header", "Header"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.Header, "second_header", "Header"),
+				},
+			),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.Header, "Header"),
+				primitives.NewMetadataTypeParameter(metadata.TypesSr25519PubKey, "Id"),
+			},
+		),
+
+		primitives.NewMetadataTypeWithPath(
+			metadata.TypesSessionMembershipProof,
+			"MembershipProof",
+			sc.Sequence[sc.Str]{"sp_session", "MembershipProof"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "session", "SessionIndex"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceSequenceU8, "trie_nodes", "Vec<Vec<u8>>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "validator_count", "ValidatorCount"),
+				},
+			),
+		),
+
+		primitives.NewMetadataTypeWithParam(
+			metadata.TypesOptionEmptyTuple,
+			"Option<()>",
+			sc.Sequence[sc.Str]{"Option"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("None", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, optionNoneIdx, ""),
+					primitives.NewMetadataDefinitionVariant("Some", sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesEmptyTuple)}, optionSomeIdx, ""),
+				},
+			),
+			primitives.NewMetadataTypeParameter(metadata.TypesEmptyTuple, "T"),
+		),
+
 		// 153
 		primitives.NewMetadataTypeWithParam(
 			metadata.TypesBabeCalls,
@@ -298,6 +343,24 @@ func (m module) metadataTypes() sc.Sequence[primitives.MetadataType] {
 			sc.Sequence[sc.Str]{"pallet_babe", "pallet", "Call"},
 			primitives.NewMetadataTypeDefinitionVariant(
 				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"report_equivocation",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeEquivocationProof, "equivocation_proof", "Box<EquivocationProof<HeaderFor<T>>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSessionMembershipProof, "key_owner_proof", "T::KeyOwnerProof"),
+						},
+						functionReportEquivocationIndex,
+						"Report authority equivocation/misbehavior. This method will verify the equivocation proof and validate the given key ownership proof against the extracted offender. If both are valid, the offence will be reported.",
+					),
+					primitives.NewMetadataDefinitionVariant(
+						"report_equivocation_unsigned",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeEquivocationProof, "equivocation_proof", "Box<EquivocationProof<HeaderFor<T>>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSessionMembershipProof, "key_owner_proof", "T::KeyOwnerProof"),
+						},
+						functionReportEquivocationUnsignedIndex,
+						"Report authority equivocation/misbehavior. This method will verify the equivocation proof and validate the given key ownership proof against the extracted offender. If both are valid, the offence will be reported. This extrinsic must be called unsigned and it is expected that only block authors will call it (validated in `ValidateUnsigned`), as such if the block author is defined it will be defined as the equivocation reporter.",
+					),
 					primitives.NewMetadataDefinitionVariant(
 						"plan_config_change",
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{
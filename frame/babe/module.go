@@ -43,8 +43,8 @@ var (
 )
 
 const (
-	functionReportEquivocationIndex         = iota // TODO: implemented
-	functionReportEquivocationUnsignedIndex        // TODO: implemented
+	functionReportEquivocationIndex = iota
+	functionReportEquivocationUnsignedIndex
 	functionPlanConfigChangeIndex
 )
 
@@ -73,6 +73,9 @@ type Module interface {
 	CurrentEpochStart() (babetypes.Slot, error)
 	CurrentEpoch() (babetypes.Epoch, error)
 	NextEpoch() (babetypes.Epoch, error)
+
+	SubmitUnsignedEquivocationReport(equivocationProof babetypes.EquivocationProof, keyOwnerProof sessiontypes.MembershipProof) error
+	HistoricalKeyOwnershipProof(authorityId primitives.AccountId) sc.Option[babetypes.OpaqueKeyOwnershipProof]
 }
 
 type module struct {
@@ -89,17 +92,17 @@ type module struct {
 	disabledValidators primitives.DisabledValidators
 	epochChangeTrigger EpochChangeTrigger
 	ioHashing          io.Hashing
+	ioCrypto           io.Crypto
+	ioOffchain         io.Offchain
 	mdGenerator        *primitives.MetadataTypeGenerator
 }
 
 func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.RuntimeLogger) Module {
 	storage := newStorage(config.Storage)
 
-	functions := map[sc.U8]primitives.Call{
-		functionPlanConfigChangeIndex: newCallPlanConfigChange(index, functionPlanConfigChangeIndex, config.DbWeight, storage.PendingEpochConfigChange),
-	}
+	functions := map[sc.U8]primitives.Call{}
 
-	return module{
+	moduleInstance := module{
 		index:              index,
 		config:             config,
 		constants:          newConstants(config.EpochDuration, config.MinimumPeriod, config.MaxAuthorities),
@@ -110,8 +113,16 @@ func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenera
 		disabledValidators: config.SessionModule,
 		epochChangeTrigger: config.EpochChangeTrigger,
 		ioHashing:          io.NewHashing(),
+		ioCrypto:           io.NewCrypto(),
+		ioOffchain:         io.NewOffchain(),
 		mdGenerator:        mdGenerator,
 	}
+
+	functions[functionReportEquivocationIndex] = newCallReportEquivocation(index, functionReportEquivocationIndex, config.DbWeight, moduleInstance)
+	functions[functionReportEquivocationUnsignedIndex] = newCallReportEquivocationUnsigned(index, functionReportEquivocationUnsignedIndex, config.DbWeight, moduleInstance)
+	functions[functionPlanConfigChangeIndex] = newCallPlanConfigChange(index, functionPlanConfigChangeIndex, config.DbWeight, storage.PendingEpochConfigChange)
+
+	return moduleInstance
 }
 
 func (m module) name() sc.Str {
@@ -126,14 +137,47 @@ func (m module) Functions() map[sc.U8]primitives.Call {
 	return m.functions
 }
 
-func (m module) PreDispatch(_ primitives.Call) (sc.Empty, error) {
-	// TODO: Implement once the rest of the calls are implemented
-	return sc.Empty{}, nil
+func (m module) PreDispatch(call primitives.Call) (sc.Empty, error) {
+	switch call := call.(type) {
+	case callReportEquivocationUnsigned:
+		equivocationProof := call.Args()[0].(babetypes.EquivocationProof)
+		keyOwnerProof := call.Args()[1].(sessiontypes.MembershipProof)
+
+		return sc.Empty{}, m.checkEvidence(equivocationProof, keyOwnerProof)
+	default:
+		return sc.Empty{}, nil
+	}
 }
 
-func (m module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
-	// TODO: Implement once the rest of the calls are implemented
-	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+func (m module) ValidateUnsigned(source primitives.TransactionSource, call primitives.Call) (primitives.ValidTransaction, error) {
+	switch call := call.(type) {
+	case callReportEquivocationUnsigned:
+		// discard equivocation report not coming from the local node
+		if len(source) == 0 || (source[0] != primitives.TransactionSourceLocal && source[0] != primitives.TransactionSourceInBlock) {
+			m.logger.Warn("rejecting unsigned report equivocation transaction because it is not local/in-block.")
+			return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionCall())
+		}
+
+		equivocationProof := call.Args()[0].(babetypes.EquivocationProof)
+		keyOwnerProof := call.Args()[1].(sessiontypes.MembershipProof)
+
+		if err := m.checkEvidence(equivocationProof, keyOwnerProof); err != nil {
+			return primitives.ValidTransaction{}, err
+		}
+
+		return primitives.ValidTransaction{
+			// We assign the maximum priority for any equivocation report.
+			Priority: primitives.TransactionPriority(math.MaxUint64),
+			Requires: sc.Sequence[primitives.TransactionTag]{},
+			// Only one equivocation report for the same offender at the same slot.
+			Provides:  sc.Sequence[primitives.TransactionTag]{equivocationTag(equivocationProof)},
+			Longevity: m.config.ReportLongevity,
+			// We don't propagate this. This can never be included on a remote node.
+			Propagate: false,
+		}, nil
+	default:
+		return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+	}
 }
 
 // Storage
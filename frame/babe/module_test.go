@@ -1,17 +1,21 @@
 package babe
 
 import (
+	"bytes"
 	"io"
+	"math"
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/session_historical"
 	"github.com/LimeChain/gosemble/mocks"
 	babetypes "github.com/LimeChain/gosemble/primitives/babe"
 	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -94,6 +98,8 @@ var (
 
 	skippedEpoch = babetypes.SkippedEpoch{}
 
+	reportLongevity = sc.U64(2400)
+
 	dbWeight = primitives.RuntimeDbWeight{
 		Read:  1,
 		Write: 2,
@@ -141,13 +147,17 @@ var (
 )
 
 var (
-	mockIoHashing *mocks.IoHashing
+	mockIoHashing  *mocks.IoHashing
+	mockIoCrypto   *mocks.IoCrypto
+	mockIoOffchain *mocks.IoOffchain
 )
 
 var (
 	mockSystemModule       *mocks.SystemModule
 	mockSessionModule      *mocks.SessionModule
 	mockEpochChangeTrigger *mocks.EpochChangeTrigger
+	mockReportOffence      *mocks.ReportOffence
+	mockKeyOwnerProof      *MockKeyOwnerProofSystem
 )
 
 var target module
@@ -173,8 +183,12 @@ func setupModule() module {
 	mockSystemModule = new(mocks.SystemModule)
 	mockSessionModule = new(mocks.SessionModule)
 	mockEpochChangeTrigger = new(mocks.EpochChangeTrigger)
+	mockReportOffence = new(mocks.ReportOffence)
+	mockKeyOwnerProof = new(MockKeyOwnerProofSystem)
 
 	mockIoHashing = new(mocks.IoHashing)
+	mockIoCrypto = new(mocks.IoCrypto)
+	mockIoOffchain = new(mocks.IoOffchain)
 
 	config := NewConfig(
 		mockStorage,
@@ -188,6 +202,9 @@ func setupModule() module {
 		timestampMinimumPeriod,
 		mockSystemDigestFn,
 		mockSystemModule,
+		mockReportOffence,
+		mockKeyOwnerProof,
+		reportLongevity,
 	)
 
 	target = New(moduleId, config, primitives.NewMetadataTypeGenerator(), log.NewLogger()).(module)
@@ -208,6 +225,8 @@ func setupModule() module {
 	target.storage.Lateness = mockStorageLateness
 
 	target.ioHashing = mockIoHashing
+	target.ioCrypto = mockIoCrypto
+	target.ioOffchain = mockIoOffchain
 
 	return target
 }
@@ -221,7 +240,7 @@ func Test_Module_Functions(t *testing.T) {
 	target := setupModule()
 	functions := target.Functions()
 
-	assert.Equal(t, 1, len(functions))
+	assert.Equal(t, 3, len(functions))
 }
 
 func Test_Module_PreDispatch(t *testing.T) {
@@ -244,6 +263,76 @@ func Test_Module_ValidateUnsigned(t *testing.T) {
 	assert.Equal(t, primitives.ValidTransaction{}, result)
 }
 
+func Test_Module_PreDispatch_ReportEquivocationUnsigned(t *testing.T) {
+	target := setupModule()
+	call := setupReportEquivocationUnsignedCall(t, target)
+	offenders := sc.Sequence[primitives.AccountId]{constants.OneAccountId}
+	mockKeyOwnerProof.On("CheckProof", KeyTypeId, primitives.AccountId(pubKey), keyOwnerProof).Return(offenderIdentification)
+	mockReportOffence.On("IsKnownOffence", EquivocationOffenceKind, offenders, slot).Return(false, nil)
+
+	result, err := target.PreDispatch(call)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_PreDispatch_ReportEquivocationUnsigned_KnownOffence(t *testing.T) {
+	target := setupModule()
+	call := setupReportEquivocationUnsignedCall(t, target)
+	offenders := sc.Sequence[primitives.AccountId]{constants.OneAccountId}
+	mockKeyOwnerProof.On("CheckProof", KeyTypeId, primitives.AccountId(pubKey), keyOwnerProof).Return(offenderIdentification)
+	mockReportOffence.On("IsKnownOffence", EquivocationOffenceKind, offenders, slot).Return(true, nil)
+
+	_, err := target.PreDispatch(call)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionStale()), err)
+}
+
+func Test_Module_ValidateUnsigned_ReportEquivocationUnsigned(t *testing.T) {
+	target := setupModule()
+	call := setupReportEquivocationUnsignedCall(t, target)
+	offenders := sc.Sequence[primitives.AccountId]{constants.OneAccountId}
+	mockKeyOwnerProof.On("CheckProof", KeyTypeId, primitives.AccountId(pubKey), keyOwnerProof).Return(offenderIdentification)
+	mockReportOffence.On("IsKnownOffence", EquivocationOffenceKind, offenders, slot).Return(false, nil)
+
+	expected := primitives.ValidTransaction{
+		Priority:  primitives.TransactionPriority(math.MaxUint64),
+		Requires:  sc.Sequence[primitives.TransactionTag]{},
+		Provides:  sc.Sequence[primitives.TransactionTag]{equivocationTag(equivocationProof)},
+		Longevity: reportLongevity,
+		Propagate: false,
+	}
+
+	for _, source := range []primitives.TransactionSource{primitives.NewTransactionSourceLocal(), primitives.NewTransactionSourceInBlock()} {
+		result, err := target.ValidateUnsigned(source, call)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	}
+}
+
+func Test_Module_ValidateUnsigned_ReportEquivocationUnsigned_External(t *testing.T) {
+	target := setupModule()
+	call := setupReportEquivocationUnsignedCall(t, target)
+
+	result, err := target.ValidateUnsigned(primitives.NewTransactionSourceExternal(), call)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionCall()), err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+	mockKeyOwnerProof.AssertNotCalled(t, "CheckProof", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Module_ValidateUnsigned_ReportEquivocationUnsigned_BadProof(t *testing.T) {
+	target := setupModule()
+	call := setupReportEquivocationUnsignedCall(t, target)
+	mockKeyOwnerProof.On("CheckProof", KeyTypeId, primitives.AccountId(pubKey), keyOwnerProof).Return(sc.NewOption[session_historical.IdentificationTuple](nil))
+
+	result, err := target.ValidateUnsigned(primitives.NewTransactionSourceLocal(), call)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionBadProof()), err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
 func Test_Module_StorageAuthorities(t *testing.T) {
 	target := setupModule()
 
@@ -504,3 +593,11 @@ func Test_Module_initializeGenesisAuthorities_Already_Initialized(t *testing.T)
 	mockStorageAuthorities.AssertNotCalled(t, "Put", authorities)
 	mockStorageNextAuthorities.AssertNotCalled(t, "Put", authorities)
 }
+
+func setupReportEquivocationUnsignedCall(t *testing.T, target module) primitives.Call {
+	call, err := target.Functions()[functionReportEquivocationUnsignedIndex].
+		DecodeArgs(bytes.NewBuffer(append(equivocationProof.Bytes(), keyOwnerProof.Bytes()...)))
+	assert.NoError(t, err)
+
+	return call
+}
@@ -11,16 +11,20 @@ type Config struct {
 	DbWeight           primitives.RuntimeDbWeight
 	MaxLocks           sc.U32
 	MaxReserves        sc.U32
+	MaxFreezes         sc.U32
+	MaxHolds           sc.U32
 	ExistentialDeposit sc.U128
 	StoredMap          primitives.StoredMap
 }
 
-func NewConfig(storage io.Storage, dbWeight primitives.RuntimeDbWeight, maxLocks sc.U32, maxReserves sc.U32, existentialDeposit sc.U128, storedMap primitives.StoredMap) *Config {
+func NewConfig(storage io.Storage, dbWeight primitives.RuntimeDbWeight, maxLocks sc.U32, maxReserves sc.U32, maxFreezes sc.U32, maxHolds sc.U32, existentialDeposit sc.U128, storedMap primitives.StoredMap) *Config {
 	return &Config{
 		Storage:            storage,
 		DbWeight:           dbWeight,
 		MaxLocks:           maxLocks,
 		MaxReserves:        maxReserves,
+		MaxFreezes:         maxFreezes,
+		MaxHolds:           maxHolds,
 		ExistentialDeposit: existentialDeposit,
 		StoredMap:          storedMap,
 	}
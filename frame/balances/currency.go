@@ -0,0 +1,27 @@
+package balances
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/balances/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// FreeBalance returns the free balance of `who`.
+func (m module) FreeBalance(who primitives.AccountId) (primitives.Balance, error) {
+	acc, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	return acc.Data.Free, nil
+}
+
+// Transfer moves `value` from the free balance of `from` to the free balance of `to`.
+func (m module) Transfer(from primitives.AccountId, to primitives.AccountId, value primitives.Balance, liveness primitives.ExistenceRequirement) error {
+	preservation := types.PreservationExpendable
+	if liveness == primitives.ExistenceRequirementKeepAlive {
+		preservation = types.PreservationPreserve
+	}
+
+	return m.transfer(from, to, value, preservation)
+}
@@ -0,0 +1,32 @@
+package balances
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Module_FreeBalance(t *testing.T) {
+	target = setupModule()
+
+	mockStoredMap.On("Get", fromAddress).Return(accountInfo, nil)
+
+	result, err := target.FreeBalance(fromAddress)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(4), result)
+}
+
+func Test_Module_FreeBalance_Error(t *testing.T) {
+	target = setupModule()
+	expectedErr := errors.New("error")
+
+	mockStoredMap.On("Get", fromAddress).Return(primitives.AccountInfo{}, expectedErr)
+
+	_, err := target.FreeBalance(fromAddress)
+
+	assert.Equal(t, primitives.NewDispatchErrorOther(sc.Str(expectedErr.Error())), err)
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 
 	sc "github.com/LimeChain/goscale"
-	"github.com/LimeChain/gosemble/frame/balances/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
@@ -64,7 +63,7 @@ func newEventUnreserved(moduleIndex sc.U8, account primitives.AccountId, amount
 	return primitives.NewEvent(moduleIndex, EventUnreserved, account, amount)
 }
 
-func newEventReserveRepatriated(moduleIndex sc.U8, from primitives.AccountId, to primitives.AccountId, amount primitives.Balance, destinationStatus types.BalanceStatus) primitives.Event {
+func newEventReserveRepatriated(moduleIndex sc.U8, from primitives.AccountId, to primitives.AccountId, amount primitives.Balance, destinationStatus primitives.BalanceStatus) primitives.Event {
 	return primitives.NewEvent(moduleIndex, EventReserveRepatriated, from, to, amount, destinationStatus)
 }
 
@@ -220,7 +219,7 @@ func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, err
 		if err != nil {
 			return primitives.Event{}, err
 		}
-		destinationStatus, err := types.DecodeBalanceStatus(buffer)
+		destinationStatus, err := primitives.DecodeBalanceStatus(buffer)
 		if err != nil {
 			return primitives.Event{}, err
 		}
@@ -255,6 +254,46 @@ func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, err
 			return primitives.Event{}, err
 		}
 		return newEventSlashed(moduleIndex, account, amount), nil
+	case EventLocked:
+		account, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		amount, err := sc.DecodeU128(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventLocked(moduleIndex, account, amount), nil
+	case EventUnlocked:
+		account, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		amount, err := sc.DecodeU128(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventUnlocked(moduleIndex, account, amount), nil
+	case EventFrozen:
+		account, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		amount, err := sc.DecodeU128(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventFrozen(moduleIndex, account, amount), nil
+	case EventThawed:
+		account, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		amount, err := sc.DecodeU128(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventThawed(moduleIndex, account, amount), nil
 	case EventTotalIssuanceForced:
 		old, err := sc.DecodeU128(buffer)
 		if err != nil {
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
-	"github.com/LimeChain/gosemble/frame/balances/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -128,7 +127,7 @@ func Test_Balances_DecodeEvent_ReserveRepatriated(t *testing.T) {
 	buffer.Write(fromAddress.Bytes())
 	buffer.Write(toAddress.Bytes())
 	buffer.Write(targetValue.Bytes())
-	buffer.Write(types.BalanceStatusFree.Bytes())
+	buffer.Write(primitives.BalanceStatusFree.Bytes())
 
 	result, err := DecodeEvent(moduleId, buffer)
 	assert.Nil(t, err)
@@ -139,7 +138,7 @@ func Test_Balances_DecodeEvent_ReserveRepatriated(t *testing.T) {
 			EventReserveRepatriated,
 			fromAddress,
 			toAddress,
-			targetValue, types.BalanceStatusFree)},
+			targetValue, primitives.BalanceStatusFree)},
 		result,
 	)
 }
@@ -201,6 +200,82 @@ func Test_Balances_DecodeEvent_Slashed(t *testing.T) {
 	)
 }
 
+func Test_Balances_DecodeEvent_Locked(t *testing.T) {
+	targetAddressId, err := targetMultiAddress.AsAccountId()
+	assert.Nil(t, err)
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(byte(moduleId))
+	buffer.Write(EventLocked.Bytes())
+	buffer.Write(targetAddressId.Bytes())
+	buffer.Write(targetValue.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{VaryingData: sc.NewVaryingData(sc.U8(moduleId), EventLocked, targetAddressId, targetValue)},
+		result,
+	)
+}
+
+func Test_Balances_DecodeEvent_Unlocked(t *testing.T) {
+	targetAddressId, err := targetMultiAddress.AsAccountId()
+	assert.Nil(t, err)
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(byte(moduleId))
+	buffer.Write(EventUnlocked.Bytes())
+	buffer.Write(targetAddressId.Bytes())
+	buffer.Write(targetValue.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{VaryingData: sc.NewVaryingData(sc.U8(moduleId), EventUnlocked, targetAddressId, targetValue)},
+		result,
+	)
+}
+
+func Test_Balances_DecodeEvent_Frozen(t *testing.T) {
+	targetAddressId, err := targetMultiAddress.AsAccountId()
+	assert.Nil(t, err)
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(byte(moduleId))
+	buffer.Write(EventFrozen.Bytes())
+	buffer.Write(targetAddressId.Bytes())
+	buffer.Write(targetValue.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{VaryingData: sc.NewVaryingData(sc.U8(moduleId), EventFrozen, targetAddressId, targetValue)},
+		result,
+	)
+}
+
+func Test_Balances_DecodeEvent_Thawed(t *testing.T) {
+	targetAddressId, err := targetMultiAddress.AsAccountId()
+	assert.Nil(t, err)
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(byte(moduleId))
+	buffer.Write(EventThawed.Bytes())
+	buffer.Write(targetAddressId.Bytes())
+	buffer.Write(targetValue.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		primitives.Event{VaryingData: sc.NewVaryingData(sc.U8(moduleId), EventThawed, targetAddressId, targetValue)},
+		result,
+	)
+}
+
 func Test_Balances_DecodeEvent_InvalidModule(t *testing.T) {
 	buffer := &bytes.Buffer{}
 	buffer.WriteByte(byte(moduleId + 1))
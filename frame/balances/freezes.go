@@ -0,0 +1,139 @@
+package balances
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// BalanceFrozen returns the amount of `who`'s balance frozen for `id`.
+func (m module) BalanceFrozen(id primitives.RuntimeReason, who primitives.AccountId) (primitives.Balance, error) {
+	freezes, err := m.storage.Freezes.Get(who)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	for _, freeze := range freezes {
+		if freeze.Id == id {
+			return freeze.Amount, nil
+		}
+	}
+
+	return constants.Zero, nil
+}
+
+// SetFreeze creates or overwrites the freeze `id` on the balance of `who`.
+// A zero `amount` removes the freeze.
+func (m module) SetFreeze(id primitives.RuntimeReason, who primitives.AccountId, amount primitives.Balance) error {
+	if amount.Eq(constants.Zero) {
+		return m.Thaw(id, who)
+	}
+
+	freezes, err := m.storage.Freezes.Get(who)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	found := false
+	for i, freeze := range freezes {
+		if freeze.Id == id {
+			freezes[i].Amount = amount
+			found = true
+			break
+		}
+	}
+	if !found {
+		freezes, err = m.pushFreeze(freezes, primitives.IdAmount{Id: id, Amount: amount})
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.updateFreezes(who, freezes)
+}
+
+// ExtendFreeze changes the freeze `id` on the balance of `who` so that it is at least `amount`.
+// Creates the freeze if it does not exist. A zero `amount` is a no-op.
+func (m module) ExtendFreeze(id primitives.RuntimeReason, who primitives.AccountId, amount primitives.Balance) error {
+	if amount.Eq(constants.Zero) {
+		return nil
+	}
+
+	freezes, err := m.storage.Freezes.Get(who)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	found := false
+	for i, freeze := range freezes {
+		if freeze.Id == id {
+			freezes[i].Amount = sc.Max128(freeze.Amount, amount)
+			found = true
+			break
+		}
+	}
+	if !found {
+		freezes, err = m.pushFreeze(freezes, primitives.IdAmount{Id: id, Amount: amount})
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.updateFreezes(who, freezes)
+}
+
+// Thaw removes the freeze `id` from the balance of `who`.
+func (m module) Thaw(id primitives.RuntimeReason, who primitives.AccountId) error {
+	freezes, err := m.storage.Freezes.Get(who)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	newFreezes := sc.Sequence[primitives.IdAmount]{}
+	for _, freeze := range freezes {
+		if freeze.Id != id {
+			newFreezes = append(newFreezes, freeze)
+		}
+	}
+
+	return m.updateFreezes(who, newFreezes)
+}
+
+func (m module) pushFreeze(freezes sc.Sequence[primitives.IdAmount], freeze primitives.IdAmount) (sc.Sequence[primitives.IdAmount], error) {
+	if sc.U32(len(freezes)) >= m.constants.MaxFreezes {
+		return nil, primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+			Index:   m.Index,
+			Err:     sc.U32(ErrorTooManyFreezes),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	return append(freezes, freeze), nil
+}
+
+// updateFreezes stores `freezes` for `who` and recomputes the frozen balance of the account.
+func (m module) updateFreezes(who primitives.AccountId, freezes sc.Sequence[primitives.IdAmount]) error {
+	locks, err := m.storage.Locks.Get(who)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	before, after, err := m.updateFrozen(who, locks, freezes)
+	if err != nil {
+		return err
+	}
+
+	if len(freezes) == 0 {
+		m.storage.Freezes.Remove(who)
+	} else {
+		m.storage.Freezes.Put(who, freezes)
+	}
+
+	if after.Gt(before) {
+		m.Config.StoredMap.DepositEvent(newEventFrozen(m.Index, who, after.Sub(before)))
+	} else if before.Gt(after) {
+		m.Config.StoredMap.DepositEvent(newEventThawed(m.Index, who, before.Sub(after)))
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package balances
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	freezeId      = primitives.NewRuntimeReason(moduleId, 0)
+	otherFreezeId = primitives.NewRuntimeReason(moduleId, 1)
+)
+
+func Test_Module_BalanceFrozen(t *testing.T) {
+	target = setupModule()
+	freezes := sc.Sequence[primitives.IdAmount]{{Id: freezeId, Amount: sc.NewU128(3)}}
+
+	mockFreezes.On("Get", fromAddress).Return(freezes, nil)
+
+	frozen, err := target.BalanceFrozen(freezeId, fromAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(3), frozen)
+
+	frozen, err = target.BalanceFrozen(otherFreezeId, fromAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.Zero, frozen)
+}
+
+func Test_Module_BalanceFrozen_Fails(t *testing.T) {
+	target = setupModule()
+
+	mockFreezes.On("Get", fromAddress).Return(sc.Sequence[primitives.IdAmount]{}, errors.New("err"))
+
+	_, err := target.BalanceFrozen(freezeId, fromAddress)
+
+	assert.Equal(t, primitives.NewDispatchErrorOther("err"), err)
+}
+
+func Test_Module_SetFreeze_New(t *testing.T) {
+	target = setupModule()
+	expected := sc.Sequence[primitives.IdAmount]{{Id: freezeId, Amount: sc.NewU128(3)}}
+
+	mockFreezes.On("Get", fromAddress).Return(sc.Sequence[primitives.IdAmount]{}, nil)
+	mockLocks.On("Get", fromAddress).Return(sc.Sequence[primitives.BalanceLock]{}, nil)
+	mockUpdateFrozen(fromAddress, constants.Zero, sc.NewU128(3))
+	mockFreezes.On("Put", fromAddress, expected).Return()
+	mockStoredMap.On("DepositEvent", newEventFrozen(moduleId, fromAddress, sc.NewU128(3)))
+
+	err := target.SetFreeze(freezeId, fromAddress, sc.NewU128(3))
+
+	assert.NoError(t, err)
+	mockFreezes.AssertCalled(t, "Put", fromAddress, expected)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventFrozen(moduleId, fromAddress, sc.NewU128(3)))
+}
+
+func Test_Module_SetFreeze_TooManyFreezes(t *testing.T) {
+	target = setupModule()
+	freezes := sc.Sequence[primitives.IdAmount]{
+		{Id: otherFreezeId, Amount: sc.NewU128(1)},
+		{Id: primitives.NewRuntimeReason(moduleId, 2), Amount: sc.NewU128(1)},
+	}
+	expectedErr := primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorTooManyFreezes),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+
+	mockFreezes.On("Get", fromAddress).Return(freezes, nil)
+
+	err := target.SetFreeze(freezeId, fromAddress, sc.NewU128(3))
+
+	assert.Equal(t, expectedErr, err)
+	mockFreezes.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_SetFreeze_ZeroAmount_Thaws(t *testing.T) {
+	target = setupModule()
+	freezes := sc.Sequence[primitives.IdAmount]{{Id: freezeId, Amount: sc.NewU128(3)}}
+
+	mockFreezes.On("Get", fromAddress).Return(freezes, nil)
+	mockLocks.On("Get", fromAddress).Return(sc.Sequence[primitives.BalanceLock]{}, nil)
+	mockUpdateFrozen(fromAddress, sc.NewU128(3), constants.Zero)
+	mockFreezes.On("Remove", fromAddress).Return()
+	mockStoredMap.On("DepositEvent", newEventThawed(moduleId, fromAddress, sc.NewU128(3)))
+
+	err := target.SetFreeze(freezeId, fromAddress, constants.Zero)
+
+	assert.NoError(t, err)
+	mockFreezes.AssertCalled(t, "Remove", fromAddress)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventThawed(moduleId, fromAddress, sc.NewU128(3)))
+}
+
+func Test_Module_ExtendFreeze_Existing(t *testing.T) {
+	target = setupModule()
+	freezes := sc.Sequence[primitives.IdAmount]{{Id: freezeId, Amount: sc.NewU128(3)}}
+	expected := sc.Sequence[primitives.IdAmount]{{Id: freezeId, Amount: sc.NewU128(4)}}
+
+	mockFreezes.On("Get", fromAddress).Return(freezes, nil)
+	mockLocks.On("Get", fromAddress).Return(sc.Sequence[primitives.BalanceLock]{}, nil)
+	mockUpdateFrozen(fromAddress, sc.NewU128(3), sc.NewU128(4))
+	mockFreezes.On("Put", fromAddress, expected).Return()
+	mockStoredMap.On("DepositEvent", newEventFrozen(moduleId, fromAddress, sc.NewU128(1)))
+
+	err := target.ExtendFreeze(freezeId, fromAddress, sc.NewU128(4))
+
+	assert.NoError(t, err)
+	mockFreezes.AssertCalled(t, "Put", fromAddress, expected)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventFrozen(moduleId, fromAddress, sc.NewU128(1)))
+}
+
+func Test_Module_ExtendFreeze_ZeroAmount(t *testing.T) {
+	target = setupModule()
+
+	err := target.ExtendFreeze(freezeId, fromAddress, constants.Zero)
+
+	assert.NoError(t, err)
+	mockFreezes.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_Thaw_LocksGet_Fails(t *testing.T) {
+	target = setupModule()
+
+	mockFreezes.On("Get", fromAddress).Return(sc.Sequence[primitives.IdAmount]{}, nil)
+	mockLocks.On("Get", fromAddress).Return(sc.Sequence[primitives.BalanceLock]{}, errors.New("err"))
+
+	err := target.Thaw(freezeId, fromAddress)
+
+	assert.Equal(t, primitives.NewDispatchErrorOther("err"), err)
+	mockFreezes.AssertNotCalled(t, "Remove", mock.Anything)
+}
@@ -0,0 +1,179 @@
+package balances
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/balances/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// BalanceOnHold returns the amount of `who`'s balance held for `reason`.
+func (m module) BalanceOnHold(reason primitives.RuntimeReason, who primitives.AccountId) (primitives.Balance, error) {
+	holds, err := m.storage.Holds.Get(who)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	for _, hold := range holds {
+		if hold.Id == reason {
+			return hold.Amount, nil
+		}
+	}
+
+	return constants.Zero, nil
+}
+
+// Hold moves `amount` from the free balance of `who` onto hold for `reason`.
+// The account is kept alive and freezes do not prevent funds from being held.
+func (m module) Hold(reason primitives.RuntimeReason, who primitives.AccountId, amount primitives.Balance) error {
+	if amount.Eq(constants.Zero) {
+		return nil
+	}
+
+	available, err := m.holdAvailable(reason, who)
+	if err != nil {
+		return err
+	}
+	if !available {
+		return primitives.NewDispatchErrorToken(primitives.NewTokenErrorCannotCreateHold())
+	}
+
+	reducible, err := m.reducibleBalance(who, types.PreservationProtect, types.FortitudeForce)
+	if err != nil {
+		return err
+	}
+	if amount.Gt(reducible) {
+		return primitives.NewDispatchErrorToken(primitives.NewTokenErrorFundsUnavailable())
+	}
+
+	onHold, err := m.BalanceOnHold(reason, who)
+	if err != nil {
+		return err
+	}
+	newOnHold, err := sc.CheckedAddU128(onHold, amount)
+	if err != nil {
+		return primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+	}
+
+	_, err = m.decreaseBalance(who, amount, types.PrecisionExact, types.PreservationProtect, types.FortitudeForce)
+	if err != nil {
+		return err
+	}
+
+	return m.setBalanceOnHold(reason, who, newOnHold)
+}
+
+// Release moves up to `amount` held for `reason` back into the free balance of `who`.
+// If `bestEffort` is false, releasing more than is on hold fails.
+// Returns the amount actually released.
+func (m module) Release(reason primitives.RuntimeReason, who primitives.AccountId, amount primitives.Balance, bestEffort bool) (primitives.Balance, error) {
+	onHold, err := m.BalanceOnHold(reason, who)
+	if err != nil {
+		return primitives.Balance{}, err
+	}
+	if !bestEffort && amount.Gt(onHold) {
+		return primitives.Balance{}, primitives.NewDispatchErrorToken(primitives.NewTokenErrorFundsUnavailable())
+	}
+	amount = sc.Min128(amount, onHold)
+
+	// Releasing does not change the total balance, so there is no need to check liquidity.
+	// Only the amount credited to the free balance is taken off hold.
+	released, err := m.increaseBalance(who, amount, types.PrecisionBestEffort)
+	if err != nil {
+		return primitives.Balance{}, err
+	}
+
+	err = m.setBalanceOnHold(reason, who, onHold.Sub(released))
+	if err != nil {
+		return primitives.Balance{}, err
+	}
+
+	return released, nil
+}
+
+// holdAvailable checks whether `who` can place funds on hold for `reason`.
+// The account must exist and either already hold funds for `reason` or have room for another hold.
+func (m module) holdAvailable(reason primitives.RuntimeReason, who primitives.AccountId) (bool, error) {
+	acc, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return false, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if acc.Providers == 0 {
+		return false, nil
+	}
+
+	holds, err := m.storage.Holds.Get(who)
+	if err != nil {
+		return false, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	for _, hold := range holds {
+		if hold.Id == reason {
+			return true, nil
+		}
+	}
+
+	return sc.U32(len(holds)) < m.constants.MaxHolds, nil
+}
+
+// setBalanceOnHold sets the amount held for `reason` to `amount` and adjusts the
+// reserved balance of `who` by the difference.
+func (m module) setBalanceOnHold(reason primitives.RuntimeReason, who primitives.AccountId, amount primitives.Balance) error {
+	holds, err := m.storage.Holds.Get(who)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	increase := true
+	delta := amount
+	found := false
+	newHolds := sc.Sequence[primitives.IdAmount]{}
+	for _, hold := range holds {
+		if hold.Id == reason {
+			found = true
+			increase = amount.Gt(hold.Amount)
+			delta = sc.Max128(hold.Amount, amount).Sub(sc.Min128(hold.Amount, amount))
+			hold.Amount = amount
+		}
+		if !hold.Amount.Eq(constants.Zero) {
+			newHolds = append(newHolds, hold)
+		}
+	}
+	if !found && !amount.Eq(constants.Zero) {
+		if sc.U32(len(newHolds)) >= m.constants.MaxHolds {
+			return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+				Index:   m.Index,
+				Err:     sc.U32(ErrorTooManyHolds),
+				Message: sc.NewOption[sc.Str](nil),
+			})
+		}
+		newHolds = append(newHolds, primitives.IdAmount{Id: reason, Amount: amount})
+	}
+
+	_, err = m.tryMutateAccountHandlingDust(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
+		if increase {
+			reserved, err := sc.CheckedAddU128(account.Reserved, delta)
+			if err != nil {
+				return nil, primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+			}
+			account.Reserved = reserved
+		} else {
+			reserved, err := sc.CheckedSubU128(account.Reserved, delta)
+			if err != nil {
+				return nil, primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorUnderflow())
+			}
+			account.Reserved = reserved
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(newHolds) == 0 {
+		m.storage.Holds.Remove(who)
+	} else {
+		m.storage.Holds.Put(who, newHolds)
+	}
+
+	return nil
+}
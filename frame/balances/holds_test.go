@@ -0,0 +1,160 @@
+package balances
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	holdReason      = primitives.NewRuntimeReason(moduleId, 0)
+	otherHoldReason = primitives.NewRuntimeReason(moduleId, 1)
+)
+
+func Test_Module_BalanceOnHold(t *testing.T) {
+	target = setupModule()
+	holds := sc.Sequence[primitives.IdAmount]{{Id: holdReason, Amount: sc.NewU128(3)}}
+
+	mockHolds.On("Get", fromAddress).Return(holds, nil)
+
+	onHold, err := target.BalanceOnHold(holdReason, fromAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(3), onHold)
+
+	onHold, err = target.BalanceOnHold(otherHoldReason, fromAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.Zero, onHold)
+}
+
+func Test_Module_BalanceOnHold_Fails(t *testing.T) {
+	target = setupModule()
+
+	mockHolds.On("Get", fromAddress).Return(sc.Sequence[primitives.IdAmount]{}, errors.New("err"))
+
+	_, err := target.BalanceOnHold(holdReason, fromAddress)
+
+	assert.Equal(t, primitives.NewDispatchErrorOther("err"), err)
+}
+
+func Test_Module_Hold_ZeroAmount(t *testing.T) {
+	target = setupModule()
+
+	err := target.Hold(holdReason, fromAddress, constants.Zero)
+
+	assert.NoError(t, err)
+	mockStoredMap.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_Hold_CannotCreateHold_NoProviders(t *testing.T) {
+	target = setupModule()
+
+	mockStoredMap.On("Get", fromAddress).Return(accountInfo, nil)
+
+	err := target.Hold(holdReason, fromAddress, sc.NewU128(1))
+
+	assert.Equal(t, primitives.NewDispatchErrorToken(primitives.NewTokenErrorCannotCreateHold()), err)
+	mockHolds.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_Hold_CannotCreateHold_TooManyHolds(t *testing.T) {
+	target = setupModule()
+	account := accountInfo
+	account.Providers = 1
+	holds := sc.Sequence[primitives.IdAmount]{
+		{Id: otherHoldReason, Amount: sc.NewU128(1)},
+		{Id: primitives.NewRuntimeReason(moduleId, 2), Amount: sc.NewU128(1)},
+	}
+
+	mockStoredMap.On("Get", fromAddress).Return(account, nil)
+	mockHolds.On("Get", fromAddress).Return(holds, nil)
+
+	err := target.Hold(holdReason, fromAddress, sc.NewU128(1))
+
+	assert.Equal(t, primitives.NewDispatchErrorToken(primitives.NewTokenErrorCannotCreateHold()), err)
+}
+
+func Test_Module_Hold_FundsUnavailable(t *testing.T) {
+	target = setupModule()
+	account := accountInfo
+	account.Providers = 1
+
+	mockStoredMap.On("Get", fromAddress).Return(account, nil)
+	mockHolds.On("Get", fromAddress).Return(sc.Sequence[primitives.IdAmount]{}, nil)
+	mockStoredMap.On("CanDecProviders", fromAddress).Return(true, nil)
+
+	err := target.Hold(holdReason, fromAddress, sc.NewU128(4))
+
+	assert.Equal(t, primitives.NewDispatchErrorToken(primitives.NewTokenErrorFundsUnavailable()), err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_Module_Release_FundsUnavailable(t *testing.T) {
+	target = setupModule()
+	holds := sc.Sequence[primitives.IdAmount]{{Id: holdReason, Amount: sc.NewU128(3)}}
+
+	mockHolds.On("Get", fromAddress).Return(holds, nil)
+
+	_, err := target.Release(holdReason, fromAddress, sc.NewU128(4), false)
+
+	assert.Equal(t, primitives.NewDispatchErrorToken(primitives.NewTokenErrorFundsUnavailable()), err)
+	mockStoredMap.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_setBalanceOnHold_New(t *testing.T) {
+	target = setupModule()
+	expected := sc.Sequence[primitives.IdAmount]{
+		{Id: otherHoldReason, Amount: sc.NewU128(1)},
+		{Id: holdReason, Amount: sc.NewU128(3)},
+	}
+
+	mockHolds.On("Get", fromAddress).Return(expected[:1], nil)
+	mockUpdateFrozen(fromAddress, constants.Zero, constants.Zero)
+	mockHolds.On("Put", fromAddress, expected).Return()
+
+	err := target.setBalanceOnHold(holdReason, fromAddress, sc.NewU128(3))
+
+	assert.NoError(t, err)
+	mockStoredMap.AssertCalled(t, "TryMutateExists", fromAddress, mockTypeMutateAccountData)
+	mockHolds.AssertCalled(t, "Put", fromAddress, expected)
+}
+
+func Test_Module_setBalanceOnHold_RemovesEmpty(t *testing.T) {
+	target = setupModule()
+	holds := sc.Sequence[primitives.IdAmount]{{Id: holdReason, Amount: sc.NewU128(3)}}
+
+	mockHolds.On("Get", fromAddress).Return(holds, nil)
+	mockUpdateFrozen(fromAddress, constants.Zero, constants.Zero)
+	mockHolds.On("Remove", fromAddress).Return()
+
+	err := target.setBalanceOnHold(holdReason, fromAddress, constants.Zero)
+
+	assert.NoError(t, err)
+	mockHolds.AssertCalled(t, "Remove", fromAddress)
+	mockHolds.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_setBalanceOnHold_TooManyHolds(t *testing.T) {
+	target = setupModule()
+	holds := sc.Sequence[primitives.IdAmount]{
+		{Id: otherHoldReason, Amount: sc.NewU128(1)},
+		{Id: primitives.NewRuntimeReason(moduleId, 2), Amount: sc.NewU128(1)},
+	}
+	expectedErr := primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorTooManyHolds),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+
+	mockHolds.On("Get", fromAddress).Return(holds, nil)
+
+	err := target.setBalanceOnHold(holdReason, fromAddress, sc.NewU128(3))
+
+	assert.Equal(t, expectedErr, err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+	mockHolds.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,145 @@
+package balances
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// SetLock creates or overwrites the lock `id` on the balance of `who`.
+// A zero `amount` is a no-op.
+func (m module) SetLock(id sc.FixedSequence[sc.U8], who primitives.AccountId, amount primitives.Balance, reasons primitives.Reasons) error {
+	if amount.Eq(constants.Zero) {
+		return nil
+	}
+
+	locks, err := m.storage.Locks.Get(who)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	newLock := primitives.BalanceLock{Id: id, Amount: amount, Reasons: reasons}
+	newLocks := sc.Sequence[primitives.BalanceLock]{}
+	replaced := false
+	for _, lock := range locks {
+		if reflect.DeepEqual(lock.Id, id) {
+			newLocks = append(newLocks, newLock)
+			replaced = true
+		} else {
+			newLocks = append(newLocks, lock)
+		}
+	}
+	if !replaced {
+		newLocks = append(newLocks, newLock)
+	}
+
+	return m.updateLocks(who, newLocks)
+}
+
+// ExtendLock changes the lock `id` on the balance of `who` so that it locks at least `amount`
+// for at least `reasons`. Creates the lock if it does not exist. A zero `amount` is a no-op.
+func (m module) ExtendLock(id sc.FixedSequence[sc.U8], who primitives.AccountId, amount primitives.Balance, reasons primitives.Reasons) error {
+	if amount.Eq(constants.Zero) {
+		return nil
+	}
+
+	locks, err := m.storage.Locks.Get(who)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	newLocks := sc.Sequence[primitives.BalanceLock]{}
+	extended := false
+	for _, lock := range locks {
+		if reflect.DeepEqual(lock.Id, id) {
+			lock.Amount = sc.Max128(lock.Amount, amount)
+			lock.Reasons = lock.Reasons.Union(reasons)
+			extended = true
+		}
+		newLocks = append(newLocks, lock)
+	}
+	if !extended {
+		newLocks = append(newLocks, primitives.BalanceLock{Id: id, Amount: amount, Reasons: reasons})
+	}
+
+	return m.updateLocks(who, newLocks)
+}
+
+// RemoveLock removes the lock `id` from the balance of `who`.
+func (m module) RemoveLock(id sc.FixedSequence[sc.U8], who primitives.AccountId) error {
+	locks, err := m.storage.Locks.Get(who)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	newLocks := sc.Sequence[primitives.BalanceLock]{}
+	for _, lock := range locks {
+		if !reflect.DeepEqual(lock.Id, id) {
+			newLocks = append(newLocks, lock)
+		}
+	}
+
+	return m.updateLocks(who, newLocks)
+}
+
+// updateLocks stores `locks` for `who` and recomputes the frozen balance of the account.
+// Exceeding MaxLocks is not enforced, only reported.
+func (m module) updateLocks(who primitives.AccountId, locks sc.Sequence[primitives.BalanceLock]) error {
+	if sc.U32(len(locks)) > m.constants.MaxLocks {
+		m.logger.Warn("a user has more currency locks than expected, a runtime configuration adjustment may be needed")
+	}
+
+	freezes, err := m.storage.Freezes.Get(who)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	before, after, err := m.updateFrozen(who, locks, freezes)
+	if err != nil {
+		return err
+	}
+
+	if len(locks) == 0 {
+		m.storage.Locks.Remove(who)
+	} else {
+		m.storage.Locks.Put(who, locks)
+	}
+
+	if after.Gt(before) {
+		m.Config.StoredMap.DepositEvent(newEventLocked(m.Index, who, after.Sub(before)))
+	} else if before.Gt(after) {
+		m.Config.StoredMap.DepositEvent(newEventUnlocked(m.Index, who, before.Sub(after)))
+	}
+
+	return nil
+}
+
+// updateFrozen sets the frozen balance of `who` to the largest amount among `locks` and `freezes`.
+// Returns the frozen balance before and after the update.
+func (m module) updateFrozen(who primitives.AccountId, locks sc.Sequence[primitives.BalanceLock], freezes sc.Sequence[primitives.IdAmount]) (primitives.Balance, primitives.Balance, error) {
+	result, err := m.tryMutateAccountHandlingDust(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
+		before := account.Frozen
+		account.Frozen = frozenBalance(locks, freezes)
+		return sc.NewVaryingData(before, account.Frozen), nil
+	})
+	if err != nil {
+		return primitives.Balance{}, primitives.Balance{}, err
+	}
+
+	frozen := result.(sc.VaryingData)
+	return frozen[0].(primitives.Balance), frozen[1].(primitives.Balance), nil
+}
+
+// frozenBalance returns the largest amount among `locks` and `freezes`.
+func frozenBalance(locks sc.Sequence[primitives.BalanceLock], freezes sc.Sequence[primitives.IdAmount]) primitives.Balance {
+	frozen := constants.Zero
+	for _, lock := range locks {
+		frozen = sc.Max128(frozen, lock.Amount)
+	}
+	for _, freeze := range freezes {
+		frozen = sc.Max128(frozen, freeze.Amount)
+	}
+	return frozen
+}
@@ -0,0 +1,182 @@
+package balances
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	lockId      = sc.BytesToFixedSequenceU8([]byte("staking "))
+	otherLockId = sc.BytesToFixedSequenceU8([]byte("vesting "))
+)
+
+func mockUpdateFrozen(who primitives.AccountId, before, after primitives.Balance) {
+	mockTryMutateAccount(who, accountInfo, sc.NewVaryingData(before, after))
+}
+
+func Test_Module_SetLock_New(t *testing.T) {
+	target = setupModule()
+	lock := primitives.BalanceLock{Id: lockId, Amount: sc.NewU128(5), Reasons: primitives.ReasonsAll}
+
+	mockLocks.On("Get", fromAddress).Return(sc.Sequence[primitives.BalanceLock]{}, nil)
+	mockFreezes.On("Get", fromAddress).Return(sc.Sequence[primitives.IdAmount]{}, nil)
+	mockUpdateFrozen(fromAddress, constants.Zero, sc.NewU128(5))
+	mockLocks.On("Put", fromAddress, sc.Sequence[primitives.BalanceLock]{lock}).Return()
+	mockStoredMap.On("DepositEvent", newEventLocked(moduleId, fromAddress, sc.NewU128(5)))
+
+	err := target.SetLock(lockId, fromAddress, sc.NewU128(5), primitives.ReasonsAll)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Put", fromAddress, sc.Sequence[primitives.BalanceLock]{lock})
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventLocked(moduleId, fromAddress, sc.NewU128(5)))
+}
+
+func Test_Module_SetLock_Overwrite(t *testing.T) {
+	target = setupModule()
+	existing := sc.Sequence[primitives.BalanceLock]{
+		{Id: lockId, Amount: sc.NewU128(5), Reasons: primitives.ReasonsAll},
+		{Id: otherLockId, Amount: sc.NewU128(1), Reasons: primitives.ReasonsMisc},
+	}
+	expected := sc.Sequence[primitives.BalanceLock]{
+		{Id: lockId, Amount: sc.NewU128(3), Reasons: primitives.ReasonsFee},
+		{Id: otherLockId, Amount: sc.NewU128(1), Reasons: primitives.ReasonsMisc},
+	}
+
+	mockLocks.On("Get", fromAddress).Return(existing, nil)
+	mockFreezes.On("Get", fromAddress).Return(sc.Sequence[primitives.IdAmount]{}, nil)
+	mockUpdateFrozen(fromAddress, sc.NewU128(5), sc.NewU128(3))
+	mockLocks.On("Put", fromAddress, expected).Return()
+	mockStoredMap.On("DepositEvent", newEventUnlocked(moduleId, fromAddress, sc.NewU128(2)))
+
+	err := target.SetLock(lockId, fromAddress, sc.NewU128(3), primitives.ReasonsFee)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Put", fromAddress, expected)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventUnlocked(moduleId, fromAddress, sc.NewU128(2)))
+}
+
+func Test_Module_SetLock_ZeroAmount(t *testing.T) {
+	target = setupModule()
+
+	err := target.SetLock(lockId, fromAddress, constants.Zero, primitives.ReasonsAll)
+
+	assert.NoError(t, err)
+	mockLocks.AssertNotCalled(t, "Get", mock.Anything)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_Module_SetLock_LocksGet_Fails(t *testing.T) {
+	target = setupModule()
+
+	mockLocks.On("Get", fromAddress).Return(sc.Sequence[primitives.BalanceLock]{}, errors.New("err"))
+
+	err := target.SetLock(lockId, fromAddress, sc.NewU128(5), primitives.ReasonsAll)
+
+	assert.Equal(t, primitives.NewDispatchErrorOther("err"), err)
+	mockLocks.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_ExtendLock_Existing(t *testing.T) {
+	target = setupModule()
+	existing := sc.Sequence[primitives.BalanceLock]{
+		{Id: lockId, Amount: sc.NewU128(5), Reasons: primitives.ReasonsFee},
+	}
+	expected := sc.Sequence[primitives.BalanceLock]{
+		{Id: lockId, Amount: sc.NewU128(5), Reasons: primitives.ReasonsAll},
+	}
+
+	mockLocks.On("Get", fromAddress).Return(existing, nil)
+	mockFreezes.On("Get", fromAddress).Return(sc.Sequence[primitives.IdAmount]{}, nil)
+	mockUpdateFrozen(fromAddress, sc.NewU128(5), sc.NewU128(5))
+	mockLocks.On("Put", fromAddress, expected).Return()
+
+	err := target.ExtendLock(lockId, fromAddress, sc.NewU128(3), primitives.ReasonsMisc)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Put", fromAddress, expected)
+	mockStoredMap.AssertNumberOfCalls(t, "DepositEvent", 1)
+}
+
+func Test_Module_ExtendLock_New(t *testing.T) {
+	target = setupModule()
+	expected := sc.Sequence[primitives.BalanceLock]{
+		{Id: otherLockId, Amount: sc.NewU128(2), Reasons: primitives.ReasonsAll},
+		{Id: lockId, Amount: sc.NewU128(3), Reasons: primitives.ReasonsMisc},
+	}
+
+	mockLocks.On("Get", fromAddress).Return(expected[:1], nil)
+	mockFreezes.On("Get", fromAddress).Return(sc.Sequence[primitives.IdAmount]{}, nil)
+	mockUpdateFrozen(fromAddress, sc.NewU128(2), sc.NewU128(3))
+	mockLocks.On("Put", fromAddress, expected).Return()
+	mockStoredMap.On("DepositEvent", newEventLocked(moduleId, fromAddress, sc.NewU128(1)))
+
+	err := target.ExtendLock(lockId, fromAddress, sc.NewU128(3), primitives.ReasonsMisc)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Put", fromAddress, expected)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventLocked(moduleId, fromAddress, sc.NewU128(1)))
+}
+
+func Test_Module_RemoveLock(t *testing.T) {
+	target = setupModule()
+	existing := sc.Sequence[primitives.BalanceLock]{
+		{Id: lockId, Amount: sc.NewU128(5), Reasons: primitives.ReasonsAll},
+	}
+
+	mockLocks.On("Get", fromAddress).Return(existing, nil)
+	mockFreezes.On("Get", fromAddress).Return(sc.Sequence[primitives.IdAmount]{}, nil)
+	mockUpdateFrozen(fromAddress, sc.NewU128(5), constants.Zero)
+	mockLocks.On("Remove", fromAddress).Return()
+	mockStoredMap.On("DepositEvent", newEventUnlocked(moduleId, fromAddress, sc.NewU128(5)))
+
+	err := target.RemoveLock(lockId, fromAddress)
+
+	assert.NoError(t, err)
+	mockLocks.AssertCalled(t, "Remove", fromAddress)
+	mockLocks.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventUnlocked(moduleId, fromAddress, sc.NewU128(5)))
+}
+
+func Test_Module_updateLocks_FreezesGet_Fails(t *testing.T) {
+	target = setupModule()
+
+	mockFreezes.On("Get", fromAddress).Return(sc.Sequence[primitives.IdAmount]{}, errors.New("err"))
+
+	err := target.updateLocks(fromAddress, sc.Sequence[primitives.BalanceLock]{})
+
+	assert.Equal(t, primitives.NewDispatchErrorOther("err"), err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+	mockLocks.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Module_updateLocks_TryMutateAccount_Fails(t *testing.T) {
+	target = setupModule()
+
+	mockFreezes.On("Get", fromAddress).Return(sc.Sequence[primitives.IdAmount]{}, nil)
+	mockStoredMap.On("Get", fromAddress).Return(accountInfo, expectedErr)
+
+	err := target.updateLocks(fromAddress, sc.Sequence[primitives.BalanceLock]{})
+
+	assert.Equal(t, expectedErr, err)
+	mockLocks.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_frozenBalance(t *testing.T) {
+	locks := sc.Sequence[primitives.BalanceLock]{
+		{Id: lockId, Amount: sc.NewU128(5), Reasons: primitives.ReasonsAll},
+		{Id: otherLockId, Amount: sc.NewU128(3), Reasons: primitives.ReasonsFee},
+	}
+	freezes := sc.Sequence[primitives.IdAmount]{
+		{Id: primitives.NewRuntimeReason(1, 0), Amount: sc.NewU128(7)},
+	}
+
+	assert.Equal(t, constants.Zero, frozenBalance(nil, nil))
+	assert.Equal(t, sc.NewU128(5), frozenBalance(locks, nil))
+	assert.Equal(t, sc.NewU128(7), frozenBalance(locks, freezes))
+}
@@ -14,6 +14,8 @@ func (m module) Metadata() primitives.MetadataModule {
 		ExistentialDeposit: primitives.ExistentialDeposit{U128: m.constants.ExistentialDeposit},
 		MaxLocks:           primitives.MaxLocks{U32: m.constants.MaxLocks},
 		MaxReserves:        primitives.MaxReserves{U32: m.constants.MaxReserves},
+		MaxHolds:           primitives.MaxHolds{U32: m.constants.MaxHolds},
+		MaxFreezes:         primitives.MaxFreezes{U32: m.constants.MaxFreezes},
 	}
 
 	moduleMdConstants := m.mdGenerator.BuildModuleConstants(reflect.ValueOf(mdConstants))
@@ -77,6 +79,38 @@ func (m module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
 				primitives.MetadataModuleStorageEntryModifierDefault,
 				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU128)),
 				"The total units of outstanding deactivated balance in the system."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Locks",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesSequenceBalancesBalanceLock)),
+				"Any liquidity locks on some account balances."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Reserves",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesSequenceBalancesReserveData)),
+				"Named reserves on some account balances."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Holds",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesSequenceBalancesIdAmount)),
+				"Holds on account balances."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Freezes",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesSequenceBalancesIdAmount)),
+				"Freeze locks on account balances."),
 		},
 	})
 }
@@ -276,12 +310,12 @@ func (m module) metadataTypes() sc.Sequence[primitives.MetadataType] {
 					primitives.NewMetadataDefinitionVariant(
 						"Free",
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						types.BalanceStatusFree,
+						primitives.BalanceStatusFree,
 						"BalanceStatus.Free"),
 					primitives.NewMetadataDefinitionVariant(
 						"Reserved",
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						types.BalanceStatusReserved,
+						primitives.BalanceStatusReserved,
 						"BalanceStatus.Reserved"),
 				},
 			),
@@ -305,6 +339,76 @@ func (m module) metadataTypes() sc.Sequence[primitives.MetadataType] {
 			),
 		),
 
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesReasons,
+			"Reasons",
+			sc.Sequence[sc.Str]{"pallet_balances", "types", "Reasons"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Fee",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						sc.U8(primitives.ReasonsFee),
+						"Reasons.Fee"),
+					primitives.NewMetadataDefinitionVariant(
+						"Misc",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						sc.U8(primitives.ReasonsMisc),
+						"Reasons.Misc"),
+					primitives.NewMetadataDefinitionVariant(
+						"All",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						sc.U8(primitives.ReasonsAll),
+						"Reasons.All"),
+				},
+			),
+		),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesBalanceLock,
+			"BalanceLock",
+			sc.Sequence[sc.Str]{"pallet_balances", "types", "BalanceLock"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "LockIdentifier"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBalancesReasons, "reasons", "Reasons"),
+				}),
+		),
+		primitives.NewMetadataType(metadata.TypesSequenceBalancesBalanceLock,
+			"[]BalanceLock",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesBalancesBalanceLock))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesRuntimeReason,
+			"RuntimeReason",
+			sc.Sequence[sc.Str]{"node_template_runtime", "RuntimeReason"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "module_index", "u8"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "reason", "u8"),
+				}),
+		),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesIdAmount,
+			"IdAmount",
+			sc.Sequence[sc.Str]{"pallet_balances", "types", "IdAmount"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesRuntimeReason, "id", "Id"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+				}),
+		),
+		primitives.NewMetadataType(metadata.TypesSequenceBalancesIdAmount,
+			"[]IdAmount",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesBalancesIdAmount))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesReserveData,
+			"ReserveData",
+			sc.Sequence[sc.Str]{"pallet_balances", "types", "ReserveData"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "ReserveIdentifier"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+				}),
+		),
+		primitives.NewMetadataType(metadata.TypesSequenceBalancesReserveData,
+			"[]ReserveData",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesBalancesReserveData))),
+
 		primitives.NewMetadataTypeWithParams(metadata.TypesBalancesErrors,
 			"pallet_balances pallet Error",
 			sc.Sequence[sc.Str]{"pallet_balances", "pallet", "Error"},
@@ -207,12 +207,12 @@ func Test_Module_Metadata(t *testing.T) {
 					primitives.NewMetadataDefinitionVariant(
 						"Free",
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						types.BalanceStatusFree,
+						primitives.BalanceStatusFree,
 						"BalanceStatus.Free"),
 					primitives.NewMetadataDefinitionVariant(
 						"Reserved",
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						types.BalanceStatusReserved,
+						primitives.BalanceStatusReserved,
 						"BalanceStatus.Reserved"),
 				},
 			),
@@ -236,6 +236,76 @@ func Test_Module_Metadata(t *testing.T) {
 			),
 		),
 
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesReasons,
+			"Reasons",
+			sc.Sequence[sc.Str]{"pallet_balances", "types", "Reasons"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Fee",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						sc.U8(primitives.ReasonsFee),
+						"Reasons.Fee"),
+					primitives.NewMetadataDefinitionVariant(
+						"Misc",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						sc.U8(primitives.ReasonsMisc),
+						"Reasons.Misc"),
+					primitives.NewMetadataDefinitionVariant(
+						"All",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						sc.U8(primitives.ReasonsAll),
+						"Reasons.All"),
+				},
+			),
+		),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesBalanceLock,
+			"BalanceLock",
+			sc.Sequence[sc.Str]{"pallet_balances", "types", "BalanceLock"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "LockIdentifier"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBalancesReasons, "reasons", "Reasons"),
+				}),
+		),
+		primitives.NewMetadataType(metadata.TypesSequenceBalancesBalanceLock,
+			"[]BalanceLock",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesBalancesBalanceLock))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesRuntimeReason,
+			"RuntimeReason",
+			sc.Sequence[sc.Str]{"node_template_runtime", "RuntimeReason"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "module_index", "u8"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "reason", "u8"),
+				}),
+		),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesIdAmount,
+			"IdAmount",
+			sc.Sequence[sc.Str]{"pallet_balances", "types", "IdAmount"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesRuntimeReason, "id", "Id"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+				}),
+		),
+		primitives.NewMetadataType(metadata.TypesSequenceBalancesIdAmount,
+			"[]IdAmount",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesBalancesIdAmount))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesReserveData,
+			"ReserveData",
+			sc.Sequence[sc.Str]{"pallet_balances", "types", "ReserveData"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "ReserveIdentifier"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+				}),
+		),
+		primitives.NewMetadataType(metadata.TypesSequenceBalancesReserveData,
+			"[]ReserveData",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesBalancesReserveData))),
+
 		primitives.NewMetadataTypeWithParams(metadata.TypesBalancesErrors,
 			"pallet_balances pallet Error",
 			sc.Sequence[sc.Str]{"pallet_balances", "pallet", "Error"},
@@ -395,6 +465,38 @@ func Test_Module_Metadata(t *testing.T) {
 					primitives.MetadataModuleStorageEntryModifierDefault,
 					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU128)),
 					"The total units of outstanding deactivated balance in the system."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Locks",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(metadata.TypesSequenceBalancesBalanceLock)),
+					"Any liquidity locks on some account balances."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Reserves",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(metadata.TypesSequenceBalancesReserveData)),
+					"Named reserves on some account balances."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Holds",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(metadata.TypesSequenceBalancesIdAmount)),
+					"Holds on account balances."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Freezes",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(metadata.TypesSequenceBalancesIdAmount)),
+					"Freeze locks on account balances."),
 			},
 		}),
 		Call: sc.NewOption[sc.Compact](sc.ToCompact(metadata.BalancesCalls)),
@@ -444,6 +546,18 @@ func Test_Module_Metadata(t *testing.T) {
 				sc.BytesToSequenceU8(target.constants.MaxReserves.Bytes()),
 				"The maximum number of named reserves that can exist on an account.",
 			),
+			primitives.NewMetadataModuleConstant(
+				"MaxHolds",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(target.constants.MaxHolds.Bytes()),
+				"The maximum number of holds that can exist on an account at any time.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxFreezes",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(target.constants.MaxFreezes.Bytes()),
+				"The maximum number of individual freeze locks that can exist on an account at any time.",
+			),
 		},
 		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesBalancesErrors)),
 		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
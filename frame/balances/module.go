@@ -31,11 +31,17 @@ const (
 
 type Module interface {
 	primitives.Module
+	primitives.Currency
+	primitives.LockableCurrency
+	primitives.MutateHold
+	primitives.MutateFreeze
+	primitives.NamedReservableCurrency
 
 	DepositIntoExisting(who primitives.AccountId, value sc.U128) (primitives.Balance, error)
+	DepositCreating(who primitives.AccountId, value sc.U128) (primitives.Balance, error)
 	Withdraw(who primitives.AccountId, value sc.U128, reasons sc.U8, liveness primitives.ExistenceRequirement) (primitives.Balance, error)
+	DropNegativeImbalance(value primitives.Balance) error
 	MutateAccountHandlingDust(who primitives.AccountId, f func(who *primitives.AccountData, bool bool) (sc.Encodable, error)) (sc.Encodable, error)
-	Unreserve(who primitives.AccountId, value sc.U128) (sc.U128, error)
 
 	DepositEvent(event primitives.Event)
 	DbWeight() primitives.RuntimeDbWeight
@@ -56,7 +62,7 @@ type module struct {
 }
 
 func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.RuntimeLogger) Module {
-	constants := newConstants(config.DbWeight, config.MaxLocks, config.MaxReserves, config.ExistentialDeposit)
+	constants := newConstants(config.DbWeight, config.MaxLocks, config.MaxReserves, config.MaxFreezes, config.MaxHolds, config.ExistentialDeposit)
 	storage := newStorage(config.Storage)
 
 	moduleInstance := module{
@@ -122,6 +128,31 @@ func (m module) DepositIntoExisting(who primitives.AccountId, value sc.U128) (pr
 	return result.(primitives.Balance), nil
 }
 
+// DepositCreating deposits `value` into the free balance of `who`, creating the account if it does not exist,
+// and increases the total issuance by `value`. Fails if the account does not exist and `value` is below the
+// existential deposit. If `value` is 0, it does nothing.
+func (m module) DepositCreating(who primitives.AccountId, value sc.U128) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return sc.NewU128(0), nil
+	}
+
+	result, err := m.tryMutateAccountHandlingDust(
+		who,
+		func(accountData *primitives.AccountData, isNew bool) (sc.Encodable, error) {
+			return m.depositCreating(who, accountData, isNew, value)
+		},
+	)
+	if err != nil {
+		return primitives.Balance{}, err
+	}
+
+	if err := newPositiveImbalance(value, m.storage.TotalIssuance).Drop(); err != nil {
+		return primitives.Balance{}, err
+	}
+
+	return result.(primitives.Balance), nil
+}
+
 func (m module) Withdraw(who primitives.AccountId, value sc.U128, reasons sc.U8, liveness primitives.ExistenceRequirement) (primitives.Balance, error) {
 	if value.Eq(constants.Zero) {
 		return sc.NewU128(0), nil
@@ -141,6 +172,16 @@ func (m module) Withdraw(who primitives.AccountId, value sc.U128, reasons sc.U8,
 	return result.(primitives.Balance), nil
 }
 
+// DropNegativeImbalance burns `value`, which has already been withdrawn from an account,
+// by removing it from the total issuance.
+func (m module) DropNegativeImbalance(value primitives.Balance) error {
+	if value.Eq(constants.Zero) {
+		return nil
+	}
+
+	return newNegativeImbalance(value, m.storage.TotalIssuance).Drop()
+}
+
 func (m module) deposit(who primitives.AccountId, account *primitives.AccountData, isNew bool, value sc.U128) (sc.Encodable, error) {
 	if isNew {
 		return nil, primitives.NewDispatchErrorModule(primitives.CustomModuleError{
@@ -161,6 +202,22 @@ func (m module) deposit(who primitives.AccountId, account *primitives.AccountDat
 	return value, nil
 }
 
+func (m module) depositCreating(who primitives.AccountId, account *primitives.AccountData, isNew bool, value sc.U128) (sc.Encodable, error) {
+	if isNew && value.Lt(m.constants.ExistentialDeposit) {
+		return nil, primitives.NewDispatchErrorToken(primitives.NewTokenErrorBelowMinimum())
+	}
+
+	free, err := sc.CheckedAddU128(account.Free, value)
+	if err != nil {
+		return nil, primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+	}
+	account.Free = free
+
+	m.Config.StoredMap.DepositEvent(newEventDeposit(m.Index, who, value))
+
+	return value, nil
+}
+
 func (m module) withdraw(who primitives.AccountId, value sc.U128, account *primitives.AccountData, reasons sc.U8, liveness primitives.ExistenceRequirement) (sc.Encodable, error) {
 	newFreeAccount, err := sc.CheckedSubU128(account.Free, value)
 	if err != nil {
@@ -193,6 +250,7 @@ func (m module) withdraw(who primitives.AccountId, value sc.U128, account *primi
 }
 
 // ensureCanWithdraw checks that an account can withdraw from their balance given any existing withdraw restrictions.
+// The frozen balance of the account is the largest of its locks and freezes, all of which apply to every withdrawal.
 func (m module) ensureCanWithdraw(who primitives.AccountId, amount sc.U128, _reasons primitives.Reasons, newBalance sc.U128) error {
 	if amount.Eq(constants.Zero) {
 		return nil
@@ -690,7 +748,7 @@ func (m module) reducibleBalance(who primitives.AccountId, preservation types.Pr
 
 	untouchable := sc.NewU128(0)
 	if force == types.FortitudePolite {
-		// Frozen balance applies to total. Anything on hold therefore gets discounted from the limit given by the freezes.
+		// Frozen balance (the largest lock or freeze) applies to total. Anything on hold therefore gets discounted from the limit given by the freezes.
 		untouchable = sc.SaturatingSubU128(acc.Data.Frozen, acc.Data.Reserved)
 	}
 
@@ -713,6 +771,8 @@ func (m module) reducibleBalance(who primitives.AccountId, preservation types.Pr
 	return sc.SaturatingSubU128(acc.Data.Free, untouchable), nil
 }
 
+// Unreserve moves up to `value` from the reserved balance of `who` back to its free balance.
+// Returns the amount that could not be unreserved.
 func (m module) Unreserve(who primitives.AccountId, value sc.U128) (sc.U128, error) {
 	if value.Eq(constants.Zero) {
 		return constants.Zero, nil
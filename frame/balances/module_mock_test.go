@@ -140,6 +140,16 @@ func (m *MockModule) DepositIntoExisting(who primitives.AccountId, value sc.U128
 	return args.Get(0).(primitives.Balance), args.Get(1).(error)
 }
 
+func (m *MockModule) DepositCreating(who primitives.AccountId, value sc.U128) (primitives.Balance, error) {
+	args := m.Called(who, value)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
+
 func (m *MockModule) Withdraw(who primitives.AccountId, value sc.U128, reasons sc.U8, liveness primitives.ExistenceRequirement) (primitives.Balance, error) {
 	args := m.Called(who, value, reasons, liveness)
 
@@ -149,3 +159,241 @@ func (m *MockModule) Withdraw(who primitives.AccountId, value sc.U128, reasons s
 
 	return args.Get(0).(primitives.Balance), args.Get(1).(error)
 }
+
+func (m *MockModule) DropNegativeImbalance(value primitives.Balance) error {
+	args := m.Called(value)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockModule) FreeBalance(who primitives.AccountId) (primitives.Balance, error) {
+	args := m.Called(who)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
+
+func (m *MockModule) Transfer(from primitives.AccountId, to primitives.AccountId, value primitives.Balance, liveness primitives.ExistenceRequirement) error {
+	args := m.Called(from, to, value, liveness)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *MockModule) SetLock(id sc.FixedSequence[sc.U8], who primitives.AccountId, amount primitives.Balance, reasons primitives.Reasons) error {
+	args := m.Called(id, who, amount, reasons)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *MockModule) ExtendLock(id sc.FixedSequence[sc.U8], who primitives.AccountId, amount primitives.Balance, reasons primitives.Reasons) error {
+	args := m.Called(id, who, amount, reasons)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *MockModule) RemoveLock(id sc.FixedSequence[sc.U8], who primitives.AccountId) error {
+	args := m.Called(id, who)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *MockModule) BalanceOnHold(reason primitives.RuntimeReason, who primitives.AccountId) (primitives.Balance, error) {
+	args := m.Called(reason, who)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
+
+func (m *MockModule) Hold(reason primitives.RuntimeReason, who primitives.AccountId, amount primitives.Balance) error {
+	args := m.Called(reason, who, amount)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *MockModule) Release(reason primitives.RuntimeReason, who primitives.AccountId, amount primitives.Balance, bestEffort bool) (primitives.Balance, error) {
+	args := m.Called(reason, who, amount, bestEffort)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
+
+func (m *MockModule) BalanceFrozen(id primitives.RuntimeReason, who primitives.AccountId) (primitives.Balance, error) {
+	args := m.Called(id, who)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
+
+func (m *MockModule) SetFreeze(id primitives.RuntimeReason, who primitives.AccountId, amount primitives.Balance) error {
+	args := m.Called(id, who, amount)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *MockModule) ExtendFreeze(id primitives.RuntimeReason, who primitives.AccountId, amount primitives.Balance) error {
+	args := m.Called(id, who, amount)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *MockModule) Thaw(id primitives.RuntimeReason, who primitives.AccountId) error {
+	args := m.Called(id, who)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *MockModule) CanReserve(who primitives.AccountId, value primitives.Balance) (bool, error) {
+	args := m.Called(who, value)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(bool), nil
+	}
+
+	return args.Get(0).(bool), args.Get(1).(error)
+}
+
+func (m *MockModule) ReservedBalance(who primitives.AccountId) (primitives.Balance, error) {
+	args := m.Called(who)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
+
+func (m *MockModule) Reserve(who primitives.AccountId, value primitives.Balance) error {
+	args := m.Called(who, value)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *MockModule) Slash(who primitives.AccountId, value primitives.Balance) (primitives.Balance, error) {
+	args := m.Called(who, value)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
+
+func (m *MockModule) SlashReserved(who primitives.AccountId, value primitives.Balance) (primitives.Balance, error) {
+	args := m.Called(who, value)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
+
+func (m *MockModule) RepatriateReserved(slashed primitives.AccountId, beneficiary primitives.AccountId, value primitives.Balance, status primitives.BalanceStatus) (primitives.Balance, error) {
+	args := m.Called(slashed, beneficiary, value, status)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
+
+func (m *MockModule) ReservedBalanceNamed(id sc.FixedSequence[sc.U8], who primitives.AccountId) (primitives.Balance, error) {
+	args := m.Called(id, who)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
+
+func (m *MockModule) ReserveNamed(id sc.FixedSequence[sc.U8], who primitives.AccountId, value primitives.Balance) error {
+	args := m.Called(id, who, value)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *MockModule) UnreserveNamed(id sc.FixedSequence[sc.U8], who primitives.AccountId, value primitives.Balance) (primitives.Balance, error) {
+	args := m.Called(id, who, value)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
+
+func (m *MockModule) SlashReservedNamed(id sc.FixedSequence[sc.U8], who primitives.AccountId, value primitives.Balance) (primitives.Balance, error) {
+	args := m.Called(id, who, value)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
+
+func (m *MockModule) RepatriateReservedNamed(id sc.FixedSequence[sc.U8], slashed primitives.AccountId, beneficiary primitives.AccountId, value primitives.Balance, status primitives.BalanceStatus) (primitives.Balance, error) {
+	args := m.Called(id, slashed, beneficiary, value, status)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.Balance), nil
+	}
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(error)
+}
@@ -20,6 +20,8 @@ const (
 var (
 	maxLocks           = sc.U32(5)
 	maxReserves        = sc.U32(6)
+	maxFreezes         = sc.U32(2)
+	maxHolds           = sc.U32(2)
 	existentialDeposit = sc.NewU128(1)
 	dbWeight           = primitives.RuntimeDbWeight{
 		Read:  1,
@@ -56,6 +58,10 @@ var (
 	mockStorage                   *mocks.IoStorage
 	mockStoredMap                 *mocks.StoredMap
 	mockTotalIssuance             *mocks.StorageValue[sc.U128]
+	mockLocks                     *mocks.StorageMap[primitives.AccountId, sc.Sequence[primitives.BalanceLock]]
+	mockFreezes                   *mocks.StorageMap[primitives.AccountId, sc.Sequence[primitives.IdAmount]]
+	mockHolds                     *mocks.StorageMap[primitives.AccountId, sc.Sequence[primitives.IdAmount]]
+	mockReserves                  *mocks.StorageMap[primitives.AccountId, sc.Sequence[primitives.ReserveData]]
 	mockCall                      = new(mocks.Call)
 	mockTypeMutateAccountData     = mock.AnythingOfType("func(*types.AccountData) (goscale.Encodable, error)")
 	mockTypeMutateAccountDataBool = mock.AnythingOfType("func(*types.AccountData, bool) (goscale.Encodable, error)")
@@ -69,14 +75,31 @@ func setupModule() module {
 	mockStorage = new(mocks.IoStorage)
 	mockStoredMap = new(mocks.StoredMap)
 	mockTotalIssuance = new(mocks.StorageValue[sc.U128])
+	mockLocks = new(mocks.StorageMap[primitives.AccountId, sc.Sequence[primitives.BalanceLock]])
+	mockFreezes = new(mocks.StorageMap[primitives.AccountId, sc.Sequence[primitives.IdAmount]])
+	mockHolds = new(mocks.StorageMap[primitives.AccountId, sc.Sequence[primitives.IdAmount]])
+	mockReserves = new(mocks.StorageMap[primitives.AccountId, sc.Sequence[primitives.ReserveData]])
 
-	config := NewConfig(mockStorage, dbWeight, maxLocks, maxReserves, existentialDeposit, mockStoredMap)
+	config := NewConfig(mockStorage, dbWeight, maxLocks, maxReserves, maxFreezes, maxHolds, existentialDeposit, mockStoredMap)
 	target = New(moduleId, config, mdGenerator, logger).(module)
 	target.storage.TotalIssuance = mockTotalIssuance
+	target.storage.Locks = mockLocks
+	target.storage.Freezes = mockFreezes
+	target.storage.Holds = mockHolds
+	target.storage.Reserves = mockReserves
 
 	return target
 }
 
+// mockTryMutateAccount mocks a successful account mutation of `who`, which returns `result`
+// after the account is upgraded.
+func mockTryMutateAccount(who primitives.AccountId, account primitives.AccountInfo, result sc.Encodable) {
+	mockStoredMap.On("Get", who).Return(account, nil)
+	tryMutateResult := sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[sc.U128](nil), result)
+	mockStoredMap.On("TryMutateExists", who, mockTypeMutateAccountData).Return(tryMutateResult, nil)
+	mockStoredMap.On("DepositEvent", newEventUpgraded(moduleId, who))
+}
+
 func Test_Module_GetIndex(t *testing.T) {
 	target = setupModule()
 
@@ -150,6 +173,69 @@ func Test_Module_DepositIntoExisting_TryMutateAccount_Fails(t *testing.T) {
 	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
 }
 
+func Test_Module_DepositCreating_Success(t *testing.T) {
+	target = setupModule()
+
+	mockTryMutateAccount(fromAddress, accountInfo, targetValue)
+	mockTotalIssuance.On("Get").Return(sc.NewU128(10), nil)
+	mockTotalIssuance.On("Put", sc.NewU128(15)).Return()
+
+	result, err := target.DepositCreating(fromAddress, targetValue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, targetValue, result)
+	mockStoredMap.AssertCalled(t, "TryMutateExists", fromAddress, mockTypeMutateAccountData)
+	mockTotalIssuance.AssertCalled(t, "Put", sc.NewU128(15))
+}
+
+func Test_Module_DepositCreating_ZeroValue(t *testing.T) {
+	target = setupModule()
+
+	result, err := target.DepositCreating(fromAddress, sc.NewU128(0))
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewU128(0), result)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+	mockTotalIssuance.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_DepositCreating_TryMutateAccount_Fails(t *testing.T) {
+	target = setupModule()
+
+	mockStoredMap.On("Get", fromAddress).Return(accountInfo, nil)
+	tryMutateResult := sc.NewVaryingData(sc.NewOption[sc.U128](nil), sc.NewOption[sc.U128](nil), targetValue)
+	mockStoredMap.On("TryMutateExists", fromAddress, mockTypeMutateAccountData).Return(tryMutateResult, expectedErr)
+
+	_, err := target.DepositCreating(fromAddress, targetValue)
+
+	assert.Equal(t, expectedErr, err)
+	mockTotalIssuance.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_depositCreating_NewAccountBelowMinimum(t *testing.T) {
+	target = setupModule()
+	target.constants.ExistentialDeposit = sc.NewU128(10)
+	account := &primitives.AccountData{}
+
+	_, err := target.depositCreating(fromAddress, account, true, targetValue)
+
+	assert.Equal(t, primitives.NewDispatchErrorToken(primitives.NewTokenErrorBelowMinimum()), err)
+	assert.Equal(t, primitives.AccountData{}, *account)
+}
+
+func Test_Module_depositCreating_NewAccount(t *testing.T) {
+	target = setupModule()
+	account := &primitives.AccountData{}
+	mockStoredMap.On("DepositEvent", newEventDeposit(moduleId, fromAddress, targetValue))
+
+	result, err := target.depositCreating(fromAddress, account, true, targetValue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, targetValue, result)
+	assert.Equal(t, targetValue, account.Free)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventDeposit(moduleId, fromAddress, targetValue))
+}
+
 func Test_Module_Withdraw_Success(t *testing.T) {
 	target = setupModule()
 
@@ -195,6 +281,28 @@ func Test_Module_Withdraw_TryMutateAccount_Fails(t *testing.T) {
 	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
 }
 
+func Test_Module_DropNegativeImbalance(t *testing.T) {
+	target := setupModule()
+
+	mockTotalIssuance.On("Get").Return(sc.NewU128(10), nil)
+	mockTotalIssuance.On("Put", sc.NewU128(7)).Return()
+
+	err := target.DropNegativeImbalance(sc.NewU128(3))
+	assert.NoError(t, err)
+
+	mockTotalIssuance.AssertCalled(t, "Put", sc.NewU128(7))
+}
+
+func Test_Module_DropNegativeImbalance_ZeroValue(t *testing.T) {
+	target := setupModule()
+
+	err := target.DropNegativeImbalance(sc.NewU128(0))
+	assert.NoError(t, err)
+
+	mockTotalIssuance.AssertNotCalled(t, "Get")
+	mockTotalIssuance.AssertNotCalled(t, "Put", mock.Anything)
+}
+
 func Test_Module_ensureCanWithdraw_Success(t *testing.T) {
 	target = setupModule()
 
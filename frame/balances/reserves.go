@@ -0,0 +1,462 @@
+package balances
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// CanReserve returns true if `value` can be moved from the free to the reserved balance of `who`
+// without the free balance dropping below the existential deposit or breaking a lock.
+func (m module) CanReserve(who primitives.AccountId, value primitives.Balance) (bool, error) {
+	if value.Eq(constants.Zero) {
+		return true, nil
+	}
+
+	acc, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return false, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	newBalance, err := sc.CheckedSubU128(acc.Data.Free, value)
+	if err != nil || newBalance.Lt(m.constants.ExistentialDeposit) {
+		return false, nil
+	}
+
+	return m.ensureCanWithdraw(who, value, primitives.ReasonsAll, newBalance) == nil, nil
+}
+
+// ReservedBalance returns the reserved balance of `who`.
+func (m module) ReservedBalance(who primitives.AccountId) (primitives.Balance, error) {
+	acc, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	return acc.Data.Reserved, nil
+}
+
+// Reserve moves `value` from the free balance of `who` to its reserved balance.
+func (m module) Reserve(who primitives.AccountId, value primitives.Balance) error {
+	if value.Eq(constants.Zero) {
+		return nil
+	}
+
+	_, err := m.tryMutateAccountHandlingDust(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
+		free, err := sc.CheckedSubU128(account.Free, value)
+		if err != nil {
+			return nil, primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+				Index:   m.Index,
+				Err:     sc.U32(ErrorInsufficientBalance),
+				Message: sc.NewOption[sc.Str](nil),
+			})
+		}
+		reserved, err := sc.CheckedAddU128(account.Reserved, value)
+		if err != nil {
+			return nil, primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+		}
+		if err := m.ensureCanWithdraw(who, value, primitives.ReasonsAll, free); err != nil {
+			return nil, err
+		}
+
+		account.Free = free
+		account.Reserved = reserved
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	m.Config.StoredMap.DepositEvent(newEventReserved(m.Index, who, value))
+
+	return nil
+}
+
+// Slash deducts up to `value` from the free balance of `who` and burns it.
+// The existential deposit is kept if the account cannot lose its provider reference.
+// Returns the amount that could not be slashed.
+func (m module) Slash(who primitives.AccountId, value primitives.Balance) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	acc, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if acc.Data.Total().Eq(constants.Zero) {
+		return value, nil
+	}
+
+	canDecProviders, err := m.Config.StoredMap.CanDecProviders(who)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	result, err := m.tryMutateAccountHandlingDust(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
+		actual := sc.Min128(value, account.Free)
+		if !canDecProviders {
+			actual = sc.Min128(value, sc.SaturatingSubU128(account.Free, m.constants.ExistentialDeposit))
+		}
+		account.Free = account.Free.Sub(actual)
+		return actual, nil
+	})
+	if err != nil {
+		return primitives.Balance{}, err
+	}
+	actual := result.(primitives.Balance)
+
+	if err := newNegativeImbalance(actual, m.storage.TotalIssuance).Drop(); err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	m.Config.StoredMap.DepositEvent(newEventSlashed(m.Index, who, actual))
+
+	return value.Sub(actual), nil
+}
+
+// SlashReserved deducts up to `value` from the reserved balance of `who` and burns it.
+// Returns the amount that could not be slashed.
+func (m module) SlashReserved(who primitives.AccountId, value primitives.Balance) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	acc, err := m.Config.StoredMap.Get(who)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if acc.Data.Total().Eq(constants.Zero) {
+		return value, nil
+	}
+
+	result, err := m.tryMutateAccountHandlingDust(who, func(account *primitives.AccountData, _ bool) (sc.Encodable, error) {
+		actual := sc.Min128(value, account.Reserved)
+		account.Reserved = account.Reserved.Sub(actual)
+		return actual, nil
+	})
+	if err != nil {
+		return primitives.Balance{}, err
+	}
+	actual := result.(primitives.Balance)
+
+	if err := newNegativeImbalance(actual, m.storage.TotalIssuance).Drop(); err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	m.Config.StoredMap.DepositEvent(newEventSlashed(m.Index, who, actual))
+
+	return value.Sub(actual), nil
+}
+
+// RepatriateReserved moves up to `value` from the reserved balance of `slashed` to the
+// balance of `beneficiary` described by `status`. The beneficiary must already exist.
+// Returns the amount that could not be moved.
+func (m module) RepatriateReserved(slashed primitives.AccountId, beneficiary primitives.AccountId, value primitives.Balance, status primitives.BalanceStatus) (primitives.Balance, error) {
+	actual, err := m.transferReserved(slashed, beneficiary, value, status)
+	if err != nil {
+		return primitives.Balance{}, err
+	}
+
+	return sc.SaturatingSubU128(value, actual), nil
+}
+
+// transferReserved moves as much as possible of `value` from the reserved balance of `slashed`
+// to `beneficiary`. Reserved funds backing a frozen balance that is not covered by free funds stay in place.
+// Returns the amount actually moved.
+func (m module) transferReserved(slashed primitives.AccountId, beneficiary primitives.AccountId, value primitives.Balance, status primitives.BalanceStatus) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	acc, err := m.Config.StoredMap.Get(slashed)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	unavailable := sc.SaturatingSubU128(acc.Data.Frozen, acc.Data.Free)
+	actual := sc.Min128(value, sc.SaturatingSubU128(acc.Data.Reserved, unavailable))
+
+	if reflect.DeepEqual(slashed, beneficiary) {
+		if status == primitives.BalanceStatusReserved {
+			return actual, nil
+		}
+		remaining, err := m.Unreserve(slashed, actual)
+		if err != nil {
+			return primitives.Balance{}, err
+		}
+		return sc.SaturatingSubU128(actual, remaining), nil
+	}
+
+	result, err := m.tryMutateAccount(beneficiary, func(to *primitives.AccountData, isNew bool) (sc.Encodable, error) {
+		if isNew {
+			return nil, primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+				Index:   m.Index,
+				Err:     sc.U32(ErrorDeadAccount),
+				Message: sc.NewOption[sc.Str](nil),
+			})
+		}
+
+		return m.tryMutateAccount(slashed, func(from *primitives.AccountData, _ bool) (sc.Encodable, error) {
+			if status == primitives.BalanceStatusFree {
+				free, err := sc.CheckedAddU128(to.Free, actual)
+				if err != nil {
+					return nil, primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+				}
+				to.Free = free
+			} else {
+				reserved, err := sc.CheckedAddU128(to.Reserved, actual)
+				if err != nil {
+					return nil, primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+				}
+				to.Reserved = reserved
+			}
+			from.Reserved = sc.SaturatingSubU128(from.Reserved, actual)
+			return nil, nil
+		})
+	})
+	if err != nil {
+		return primitives.Balance{}, err
+	}
+
+	// Both accounts may have produced dust: the beneficiary in the outer and `slashed` in the inner mutation.
+	beneficiaryResult := result.(sc.VaryingData)
+	slashedResult := beneficiaryResult[0].(sc.VaryingData)
+	for _, maybeDust := range []sc.Option[primitives.Balance]{
+		beneficiaryResult[1].(sc.Option[primitives.Balance]),
+		slashedResult[1].(sc.Option[primitives.Balance]),
+	} {
+		if maybeDust.HasValue {
+			if err := m.handleRawDust(maybeDust.Value); err != nil {
+				return primitives.Balance{}, err
+			}
+		}
+	}
+
+	m.Config.StoredMap.DepositEvent(newEventReserveRepatriated(m.Index, slashed, beneficiary, actual, status))
+
+	return actual, nil
+}
+
+// ReservedBalanceNamed returns the amount of `who`'s balance reserved under `id`.
+func (m module) ReservedBalanceNamed(id sc.FixedSequence[sc.U8], who primitives.AccountId) (primitives.Balance, error) {
+	reserves, err := m.storage.Reserves.Get(who)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	if index, found := findReserve(reserves, id); found {
+		return reserves[index].Amount, nil
+	}
+
+	return constants.Zero, nil
+}
+
+// ReserveNamed moves `value` from the free balance of `who` to its reserved balance under `id`.
+func (m module) ReserveNamed(id sc.FixedSequence[sc.U8], who primitives.AccountId, value primitives.Balance) error {
+	if value.Eq(constants.Zero) {
+		return nil
+	}
+
+	reserves, err := m.storage.Reserves.Get(who)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	reserves, err = m.addReserve(reserves, id, value)
+	if err != nil {
+		return err
+	}
+
+	if err := m.Reserve(who, value); err != nil {
+		return err
+	}
+
+	m.putReserves(who, reserves)
+
+	return nil
+}
+
+// UnreserveNamed moves up to `value` reserved under `id` back to the free balance of `who`.
+// Returns the amount that could not be unreserved.
+func (m module) UnreserveNamed(id sc.FixedSequence[sc.U8], who primitives.AccountId, value primitives.Balance) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	reserves, err := m.storage.Reserves.Get(who)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	index, found := findReserve(reserves, id)
+	if !found {
+		return value, nil
+	}
+
+	toChange := sc.Min128(reserves[index].Amount, value)
+	remaining, err := m.Unreserve(who, toChange)
+	if err != nil {
+		return primitives.Balance{}, err
+	}
+	actual := sc.SaturatingSubU128(toChange, remaining)
+
+	reserves[index].Amount = reserves[index].Amount.Sub(actual)
+	m.putReserves(who, reserves)
+
+	return value.Sub(actual), nil
+}
+
+// SlashReservedNamed deducts up to `value` reserved under `id` from the balance of `who` and burns it.
+// Returns the amount that could not be slashed.
+func (m module) SlashReservedNamed(id sc.FixedSequence[sc.U8], who primitives.AccountId, value primitives.Balance) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	reserves, err := m.storage.Reserves.Get(who)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	index, found := findReserve(reserves, id)
+	if !found {
+		return value, nil
+	}
+
+	toChange := sc.Min128(reserves[index].Amount, value)
+	remaining, err := m.SlashReserved(who, toChange)
+	if err != nil {
+		return primitives.Balance{}, err
+	}
+	actual := sc.SaturatingSubU128(toChange, remaining)
+
+	reserves[index].Amount = reserves[index].Amount.Sub(actual)
+	m.putReserves(who, reserves)
+
+	return value.Sub(actual), nil
+}
+
+// RepatriateReservedNamed moves up to `value` reserved under `id` from `slashed` to the balance
+// of `beneficiary` described by `status`. Funds moved to the reserved balance of `beneficiary`
+// are tracked under the same `id`.
+// Returns the amount that could not be moved.
+func (m module) RepatriateReservedNamed(id sc.FixedSequence[sc.U8], slashed primitives.AccountId, beneficiary primitives.AccountId, value primitives.Balance, status primitives.BalanceStatus) (primitives.Balance, error) {
+	if value.Eq(constants.Zero) {
+		return constants.Zero, nil
+	}
+
+	if reflect.DeepEqual(slashed, beneficiary) {
+		if status == primitives.BalanceStatusFree {
+			return m.UnreserveNamed(id, slashed, value)
+		}
+		reserved, err := m.ReservedBalanceNamed(id, slashed)
+		if err != nil {
+			return primitives.Balance{}, err
+		}
+		return sc.SaturatingSubU128(value, reserved), nil
+	}
+
+	reserves, err := m.storage.Reserves.Get(slashed)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	index, found := findReserve(reserves, id)
+	if !found {
+		return value, nil
+	}
+	toChange := sc.Min128(reserves[index].Amount, value)
+
+	var beneficiaryReserves sc.Sequence[primitives.ReserveData]
+	if status == primitives.BalanceStatusReserved {
+		beneficiaryReserves, err = m.storage.Reserves.Get(beneficiary)
+		if err != nil {
+			return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+		}
+		// Make sure the beneficiary can track the reserve before moving any funds.
+		if _, found := findReserve(beneficiaryReserves, id); !found && sc.U32(len(beneficiaryReserves)) >= m.constants.MaxReserves {
+			return primitives.Balance{}, m.errorTooManyReserves()
+		}
+	}
+
+	remaining, err := m.RepatriateReserved(slashed, beneficiary, toChange, status)
+	if err != nil {
+		return primitives.Balance{}, err
+	}
+	actual := sc.SaturatingSubU128(toChange, remaining)
+
+	if status == primitives.BalanceStatusReserved {
+		beneficiaryReserves, err = m.addReserve(beneficiaryReserves, id, actual)
+		if err != nil {
+			return primitives.Balance{}, err
+		}
+		m.putReserves(beneficiary, beneficiaryReserves)
+	}
+
+	reserves[index].Amount = reserves[index].Amount.Sub(actual)
+	m.putReserves(slashed, reserves)
+
+	return value.Sub(actual), nil
+}
+
+// addReserve adds `value` to the reserve `id`, inserting it in order if it does not exist yet.
+func (m module) addReserve(reserves sc.Sequence[primitives.ReserveData], id sc.FixedSequence[sc.U8], value primitives.Balance) (sc.Sequence[primitives.ReserveData], error) {
+	index, found := findReserve(reserves, id)
+	if found {
+		amount, err := sc.CheckedAddU128(reserves[index].Amount, value)
+		if err != nil {
+			return nil, primitives.NewDispatchErrorArithmetic(primitives.NewArithmeticErrorOverflow())
+		}
+		reserves[index].Amount = amount
+		return reserves, nil
+	}
+
+	if sc.U32(len(reserves)) >= m.constants.MaxReserves {
+		return nil, m.errorTooManyReserves()
+	}
+
+	result := append(sc.Sequence[primitives.ReserveData]{}, reserves[:index]...)
+	result = append(result, primitives.ReserveData{Id: id, Amount: value})
+	return append(result, reserves[index:]...), nil
+}
+
+// putReserves stores the non-empty `reserves` of `who`, removing the entry altogether if none are left.
+func (m module) putReserves(who primitives.AccountId, reserves sc.Sequence[primitives.ReserveData]) {
+	nonEmpty := sc.Sequence[primitives.ReserveData]{}
+	for _, reserve := range reserves {
+		if !reserve.Amount.Eq(constants.Zero) {
+			nonEmpty = append(nonEmpty, reserve)
+		}
+	}
+
+	if len(nonEmpty) == 0 {
+		m.storage.Reserves.Remove(who)
+	} else {
+		m.storage.Reserves.Put(who, nonEmpty)
+	}
+}
+
+func (m module) errorTooManyReserves() error {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   m.Index,
+		Err:     sc.U32(ErrorTooManyReserves),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+// findReserve returns the position of `id` in `reserves`, which are kept sorted by id.
+// If `id` is not present, the position it should be inserted at is returned.
+func findReserve(reserves sc.Sequence[primitives.ReserveData], id sc.FixedSequence[sc.U8]) (int, bool) {
+	idBytes := sc.FixedSequenceU8ToBytes(id)
+	for i, reserve := range reserves {
+		cmp := bytes.Compare(sc.FixedSequenceU8ToBytes(reserve.Id), idBytes)
+		if cmp == 0 {
+			return i, true
+		}
+		if cmp > 0 {
+			return i, false
+		}
+	}
+	return len(reserves), false
+}
@@ -0,0 +1,435 @@
+package balances
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	reserveId      = sc.BytesToFixedSequenceU8([]byte("multisig"))
+	otherReserveId = sc.BytesToFixedSequenceU8([]byte("democrac"))
+	lastReserveId  = sc.BytesToFixedSequenceU8([]byte("proxy/pr"))
+
+	reservedAccountInfo = primitives.AccountInfo{
+		Data: primitives.AccountData{
+			Free:     sc.NewU128(4),
+			Reserved: sc.NewU128(5),
+			Frozen:   primitives.Balance{},
+			Flags:    primitives.DefaultExtraFlags,
+		},
+	}
+)
+
+func Test_Module_CanReserve(t *testing.T) {
+	target = setupModule()
+
+	mockStoredMap.On("Get", fromAddress).Return(accountInfo, nil)
+
+	for _, tt := range []struct {
+		value    primitives.Balance
+		expected bool
+	}{
+		{value: constants.Zero, expected: true},
+		{value: sc.NewU128(3), expected: true},
+		{value: sc.NewU128(4), expected: false},
+		{value: sc.NewU128(5), expected: false},
+	} {
+		result, err := target.CanReserve(fromAddress, tt.value)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, result)
+	}
+}
+
+func Test_Module_CanReserve_Frozen(t *testing.T) {
+	target = setupModule()
+	account := accountInfo
+	account.Data.Frozen = sc.NewU128(2)
+
+	mockStoredMap.On("Get", fromAddress).Return(account, nil)
+
+	result, err := target.CanReserve(fromAddress, sc.NewU128(3))
+
+	assert.NoError(t, err)
+	assert.False(t, result)
+}
+
+func Test_Module_ReservedBalance(t *testing.T) {
+	target = setupModule()
+
+	mockStoredMap.On("Get", fromAddress).Return(reservedAccountInfo, nil)
+
+	result, err := target.ReservedBalance(fromAddress)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(5), result)
+}
+
+func Test_Module_Reserve(t *testing.T) {
+	target = setupModule()
+
+	mockTryMutateAccount(fromAddress, accountInfo, nil)
+	mockStoredMap.On("DepositEvent", newEventReserved(moduleId, fromAddress, sc.NewU128(3)))
+
+	err := target.Reserve(fromAddress, sc.NewU128(3))
+
+	assert.NoError(t, err)
+	mockStoredMap.AssertCalled(t, "TryMutateExists", fromAddress, mockTypeMutateAccountData)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventReserved(moduleId, fromAddress, sc.NewU128(3)))
+}
+
+func Test_Module_Reserve_ZeroValue(t *testing.T) {
+	target = setupModule()
+
+	err := target.Reserve(fromAddress, constants.Zero)
+
+	assert.NoError(t, err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_Module_Reserve_Fails(t *testing.T) {
+	target = setupModule()
+
+	mockStoredMap.On("Get", fromAddress).Return(accountInfo, expectedErr)
+
+	err := target.Reserve(fromAddress, sc.NewU128(3))
+
+	assert.Equal(t, expectedErr, err)
+	mockStoredMap.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Module_Slash(t *testing.T) {
+	target = setupModule()
+
+	mockTryMutateAccount(fromAddress, accountInfo, sc.NewU128(3))
+	mockStoredMap.On("CanDecProviders", fromAddress).Return(true, nil)
+	mockTotalIssuance.On("Get").Return(sc.NewU128(10), nil)
+	mockTotalIssuance.On("Put", sc.NewU128(7)).Return()
+	mockStoredMap.On("DepositEvent", newEventSlashed(moduleId, fromAddress, sc.NewU128(3)))
+
+	remaining, err := target.Slash(fromAddress, sc.NewU128(5))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(2), remaining)
+	mockTotalIssuance.AssertCalled(t, "Put", sc.NewU128(7))
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventSlashed(moduleId, fromAddress, sc.NewU128(3)))
+}
+
+func Test_Module_Slash_NoBalance(t *testing.T) {
+	target = setupModule()
+
+	mockStoredMap.On("Get", fromAddress).Return(primitives.AccountInfo{}, nil)
+
+	remaining, err := target.Slash(fromAddress, sc.NewU128(5))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(5), remaining)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+	mockTotalIssuance.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_Slash_CanDecProviders_Fails(t *testing.T) {
+	target = setupModule()
+
+	mockStoredMap.On("Get", fromAddress).Return(accountInfo, nil)
+	mockStoredMap.On("CanDecProviders", fromAddress).Return(false, errors.New("err"))
+
+	_, err := target.Slash(fromAddress, sc.NewU128(5))
+
+	assert.Equal(t, primitives.NewDispatchErrorOther("err"), err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_Module_SlashReserved(t *testing.T) {
+	target = setupModule()
+
+	mockTryMutateAccount(fromAddress, reservedAccountInfo, sc.NewU128(5))
+	mockTotalIssuance.On("Get").Return(sc.NewU128(10), nil)
+	mockTotalIssuance.On("Put", sc.NewU128(5)).Return()
+	mockStoredMap.On("DepositEvent", newEventSlashed(moduleId, fromAddress, sc.NewU128(5)))
+
+	remaining, err := target.SlashReserved(fromAddress, sc.NewU128(6))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(1), remaining)
+	mockTotalIssuance.AssertCalled(t, "Put", sc.NewU128(5))
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventSlashed(moduleId, fromAddress, sc.NewU128(5)))
+}
+
+func Test_Module_SlashReserved_ZeroValue(t *testing.T) {
+	target = setupModule()
+
+	remaining, err := target.SlashReserved(fromAddress, constants.Zero)
+
+	assert.NoError(t, err)
+	assert.Equal(t, constants.Zero, remaining)
+	mockStoredMap.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_RepatriateReserved(t *testing.T) {
+	target = setupModule()
+	slashedResult := sc.NewVaryingData(sc.Empty{}, sc.NewOption[sc.U128](nil))
+
+	mockStoredMap.On("Get", fromAddress).Return(reservedAccountInfo, nil)
+	mockTryMutateAccount(toAddress, accountInfo, slashedResult)
+	mockStoredMap.On("DepositEvent", newEventReserveRepatriated(moduleId, fromAddress, toAddress, sc.NewU128(5), primitives.BalanceStatusFree))
+
+	remaining, err := target.RepatriateReserved(fromAddress, toAddress, sc.NewU128(7), primitives.BalanceStatusFree)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(2), remaining)
+	mockStoredMap.AssertCalled(t, "TryMutateExists", toAddress, mockTypeMutateAccountData)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventReserveRepatriated(moduleId, fromAddress, toAddress, sc.NewU128(5), primitives.BalanceStatusFree))
+}
+
+func Test_Module_RepatriateReserved_Frozen(t *testing.T) {
+	target = setupModule()
+	account := reservedAccountInfo
+	account.Data.Frozen = sc.NewU128(7)
+	slashedResult := sc.NewVaryingData(sc.Empty{}, sc.NewOption[sc.U128](nil))
+
+	mockStoredMap.On("Get", fromAddress).Return(account, nil)
+	mockTryMutateAccount(toAddress, accountInfo, slashedResult)
+	mockStoredMap.On("DepositEvent", newEventReserveRepatriated(moduleId, fromAddress, toAddress, sc.NewU128(2), primitives.BalanceStatusReserved))
+
+	remaining, err := target.RepatriateReserved(fromAddress, toAddress, sc.NewU128(5), primitives.BalanceStatusReserved)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(3), remaining)
+}
+
+func Test_Module_RepatriateReserved_SameAccount(t *testing.T) {
+	target = setupModule()
+
+	mockStoredMap.On("Get", fromAddress).Return(reservedAccountInfo, nil)
+
+	remaining, err := target.RepatriateReserved(fromAddress, fromAddress, sc.NewU128(7), primitives.BalanceStatusReserved)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(2), remaining)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_Module_ReservedBalanceNamed(t *testing.T) {
+	target = setupModule()
+	reserves := sc.Sequence[primitives.ReserveData]{{Id: reserveId, Amount: sc.NewU128(3)}}
+
+	mockReserves.On("Get", fromAddress).Return(reserves, nil)
+
+	result, err := target.ReservedBalanceNamed(reserveId, fromAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(3), result)
+
+	result, err = target.ReservedBalanceNamed(otherReserveId, fromAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.Zero, result)
+}
+
+func Test_Module_ReserveNamed_New(t *testing.T) {
+	target = setupModule()
+	reserves := sc.Sequence[primitives.ReserveData]{
+		{Id: otherReserveId, Amount: sc.NewU128(1)},
+		{Id: lastReserveId, Amount: sc.NewU128(1)},
+	}
+	expected := sc.Sequence[primitives.ReserveData]{
+		{Id: otherReserveId, Amount: sc.NewU128(1)},
+		{Id: reserveId, Amount: sc.NewU128(2)},
+		{Id: lastReserveId, Amount: sc.NewU128(1)},
+	}
+
+	mockReserves.On("Get", fromAddress).Return(reserves, nil)
+	mockTryMutateAccount(fromAddress, accountInfo, nil)
+	mockStoredMap.On("DepositEvent", newEventReserved(moduleId, fromAddress, sc.NewU128(2)))
+	mockReserves.On("Put", fromAddress, expected).Return()
+
+	err := target.ReserveNamed(reserveId, fromAddress, sc.NewU128(2))
+
+	assert.NoError(t, err)
+	mockReserves.AssertCalled(t, "Put", fromAddress, expected)
+}
+
+func Test_Module_ReserveNamed_Existing(t *testing.T) {
+	target = setupModule()
+	reserves := sc.Sequence[primitives.ReserveData]{{Id: reserveId, Amount: sc.NewU128(1)}}
+	expected := sc.Sequence[primitives.ReserveData]{{Id: reserveId, Amount: sc.NewU128(3)}}
+
+	mockReserves.On("Get", fromAddress).Return(reserves, nil)
+	mockTryMutateAccount(fromAddress, accountInfo, nil)
+	mockStoredMap.On("DepositEvent", newEventReserved(moduleId, fromAddress, sc.NewU128(2)))
+	mockReserves.On("Put", fromAddress, expected).Return()
+
+	err := target.ReserveNamed(reserveId, fromAddress, sc.NewU128(2))
+
+	assert.NoError(t, err)
+	mockReserves.AssertCalled(t, "Put", fromAddress, expected)
+}
+
+func Test_Module_ReserveNamed_TooManyReserves(t *testing.T) {
+	target = setupModule()
+	reserves := sc.Sequence[primitives.ReserveData]{}
+	for i := sc.U32(0); i < maxReserves; i++ {
+		reserves = append(reserves, primitives.ReserveData{Id: sc.BytesToFixedSequenceU8([]byte{0, 0, 0, 0, 0, 0, 0, byte(i)}), Amount: sc.NewU128(1)})
+	}
+	expectedErr := primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorTooManyReserves),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+
+	mockReserves.On("Get", fromAddress).Return(reserves, nil)
+
+	err := target.ReserveNamed(reserveId, fromAddress, sc.NewU128(2))
+
+	assert.Equal(t, expectedErr, err)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+	mockReserves.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_ReserveNamed_Reserve_Fails(t *testing.T) {
+	target = setupModule()
+
+	mockReserves.On("Get", fromAddress).Return(sc.Sequence[primitives.ReserveData]{}, nil)
+	mockStoredMap.On("Get", fromAddress).Return(accountInfo, expectedErr)
+
+	err := target.ReserveNamed(reserveId, fromAddress, sc.NewU128(2))
+
+	assert.Equal(t, expectedErr, err)
+	mockReserves.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_UnreserveNamed(t *testing.T) {
+	target = setupModule()
+	reserves := sc.Sequence[primitives.ReserveData]{{Id: reserveId, Amount: sc.NewU128(3)}}
+
+	mockReserves.On("Get", fromAddress).Return(reserves, nil)
+	mockTryMutateAccount(fromAddress, reservedAccountInfo, sc.NewU128(3))
+	mockStoredMap.On("DepositEvent", newEventUnreserved(moduleId, fromAddress, sc.NewU128(3)))
+	mockReserves.On("Remove", fromAddress).Return()
+
+	remaining, err := target.UnreserveNamed(reserveId, fromAddress, sc.NewU128(4))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(1), remaining)
+	mockReserves.AssertCalled(t, "Remove", fromAddress)
+	mockStoredMap.AssertCalled(t, "DepositEvent", newEventUnreserved(moduleId, fromAddress, sc.NewU128(3)))
+}
+
+func Test_Module_UnreserveNamed_NotFound(t *testing.T) {
+	target = setupModule()
+
+	mockReserves.On("Get", fromAddress).Return(sc.Sequence[primitives.ReserveData]{}, nil)
+
+	remaining, err := target.UnreserveNamed(reserveId, fromAddress, sc.NewU128(4))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(4), remaining)
+	mockStoredMap.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_SlashReservedNamed(t *testing.T) {
+	target = setupModule()
+	reserves := sc.Sequence[primitives.ReserveData]{
+		{Id: otherReserveId, Amount: sc.NewU128(1)},
+		{Id: reserveId, Amount: sc.NewU128(4)},
+	}
+	expected := sc.Sequence[primitives.ReserveData]{
+		{Id: otherReserveId, Amount: sc.NewU128(1)},
+		{Id: reserveId, Amount: sc.NewU128(2)},
+	}
+
+	mockReserves.On("Get", fromAddress).Return(reserves, nil)
+	mockTryMutateAccount(fromAddress, reservedAccountInfo, sc.NewU128(2))
+	mockTotalIssuance.On("Get").Return(sc.NewU128(10), nil)
+	mockTotalIssuance.On("Put", sc.NewU128(8)).Return()
+	mockStoredMap.On("DepositEvent", newEventSlashed(moduleId, fromAddress, sc.NewU128(2)))
+	mockReserves.On("Put", fromAddress, expected).Return()
+
+	remaining, err := target.SlashReservedNamed(reserveId, fromAddress, sc.NewU128(2))
+
+	assert.NoError(t, err)
+	assert.Equal(t, constants.Zero, remaining)
+	mockReserves.AssertCalled(t, "Put", fromAddress, expected)
+	mockTotalIssuance.AssertCalled(t, "Put", sc.NewU128(8))
+}
+
+func Test_Module_RepatriateReservedNamed_Reserved(t *testing.T) {
+	target = setupModule()
+	slashedReserves := sc.Sequence[primitives.ReserveData]{{Id: reserveId, Amount: sc.NewU128(5)}}
+	beneficiaryReserves := sc.Sequence[primitives.ReserveData]{{Id: otherReserveId, Amount: sc.NewU128(1)}}
+	expectedBeneficiaryReserves := sc.Sequence[primitives.ReserveData]{
+		{Id: otherReserveId, Amount: sc.NewU128(1)},
+		{Id: reserveId, Amount: sc.NewU128(3)},
+	}
+	expectedSlashedReserves := sc.Sequence[primitives.ReserveData]{{Id: reserveId, Amount: sc.NewU128(2)}}
+
+	mockReserves.On("Get", fromAddress).Return(slashedReserves, nil)
+	mockReserves.On("Get", toAddress).Return(beneficiaryReserves, nil)
+	mockStoredMap.On("Get", fromAddress).Return(reservedAccountInfo, nil)
+	mockTryMutateAccount(toAddress, accountInfo, sc.NewVaryingData(sc.Empty{}, sc.NewOption[sc.U128](nil)))
+	mockStoredMap.On("DepositEvent", newEventReserveRepatriated(moduleId, fromAddress, toAddress, sc.NewU128(3), primitives.BalanceStatusReserved))
+	mockReserves.On("Put", toAddress, expectedBeneficiaryReserves).Return()
+	mockReserves.On("Put", fromAddress, expectedSlashedReserves).Return()
+
+	remaining, err := target.RepatriateReservedNamed(reserveId, fromAddress, toAddress, sc.NewU128(3), primitives.BalanceStatusReserved)
+
+	assert.NoError(t, err)
+	assert.Equal(t, constants.Zero, remaining)
+	mockReserves.AssertCalled(t, "Put", toAddress, expectedBeneficiaryReserves)
+	mockReserves.AssertCalled(t, "Put", fromAddress, expectedSlashedReserves)
+}
+
+func Test_Module_RepatriateReservedNamed_Free(t *testing.T) {
+	target = setupModule()
+	slashedReserves := sc.Sequence[primitives.ReserveData]{{Id: reserveId, Amount: sc.NewU128(5)}}
+
+	mockReserves.On("Get", fromAddress).Return(slashedReserves, nil)
+	mockStoredMap.On("Get", fromAddress).Return(reservedAccountInfo, nil)
+	mockTryMutateAccount(toAddress, accountInfo, sc.NewVaryingData(sc.Empty{}, sc.NewOption[sc.U128](nil)))
+	mockStoredMap.On("DepositEvent", newEventReserveRepatriated(moduleId, fromAddress, toAddress, sc.NewU128(5), primitives.BalanceStatusFree))
+	mockReserves.On("Remove", fromAddress).Return()
+
+	remaining, err := target.RepatriateReservedNamed(reserveId, fromAddress, toAddress, sc.NewU128(7), primitives.BalanceStatusFree)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(2), remaining)
+	mockReserves.AssertCalled(t, "Remove", fromAddress)
+	mockReserves.AssertNotCalled(t, "Get", toAddress)
+}
+
+func Test_Module_RepatriateReservedNamed_SameAccount(t *testing.T) {
+	target = setupModule()
+	reserves := sc.Sequence[primitives.ReserveData]{{Id: reserveId, Amount: sc.NewU128(5)}}
+
+	mockReserves.On("Get", fromAddress).Return(reserves, nil)
+
+	remaining, err := target.RepatriateReservedNamed(reserveId, fromAddress, fromAddress, sc.NewU128(7), primitives.BalanceStatusReserved)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(2), remaining)
+	mockStoredMap.AssertNotCalled(t, "TryMutateExists", mock.Anything, mock.Anything)
+}
+
+func Test_findReserve(t *testing.T) {
+	reserves := sc.Sequence[primitives.ReserveData]{
+		{Id: otherReserveId, Amount: sc.NewU128(1)},
+		{Id: lastReserveId, Amount: sc.NewU128(1)},
+	}
+
+	index, found := findReserve(reserves, lastReserveId)
+	assert.Equal(t, 1, index)
+	assert.True(t, found)
+
+	index, found = findReserve(reserves, reserveId)
+	assert.Equal(t, 1, index)
+	assert.False(t, found)
+
+	index, found = findReserve(reserves, sc.BytesToFixedSequenceU8([]byte("zzzzzzzz")))
+	assert.Equal(t, 2, index)
+	assert.False(t, found)
+}
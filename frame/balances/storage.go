@@ -1,25 +1,54 @@
 package balances
 
 import (
+	"bytes"
+
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 var (
 	keyBalances         = []byte("Balances")
 	keyInactiveIssuance = []byte("InactiveIssuance")
 	keyTotalIssuance    = []byte("TotalIssuance")
+	keyLocks            = []byte("Locks")
+	keyFreezes          = []byte("Freezes")
+	keyHolds            = []byte("Holds")
+	keyReserves         = []byte("Reserves")
 )
 
 type storage struct {
 	InactiveIssuance support.StorageValue[sc.U128]
 	TotalIssuance    support.StorageValue[sc.U128]
+	Locks            support.StorageMap[primitives.AccountId, sc.Sequence[primitives.BalanceLock]]
+	Freezes          support.StorageMap[primitives.AccountId, sc.Sequence[primitives.IdAmount]]
+	Holds            support.StorageMap[primitives.AccountId, sc.Sequence[primitives.IdAmount]]
+	Reserves         support.StorageMap[primitives.AccountId, sc.Sequence[primitives.ReserveData]]
 }
 
 func newStorage(s io.Storage) *storage {
+	hashing := io.NewHashing()
+
 	return &storage{
 		InactiveIssuance: support.NewHashStorageValue(s, keyBalances, keyInactiveIssuance, sc.DecodeU128),
 		TotalIssuance:    support.NewHashStorageValue(s, keyBalances, keyTotalIssuance, sc.DecodeU128),
+		Locks:            support.NewHashStorageMap[primitives.AccountId, sc.Sequence[primitives.BalanceLock]](s, keyBalances, keyLocks, hashing.Blake128, decodeLocks),
+		Freezes:          support.NewHashStorageMap[primitives.AccountId, sc.Sequence[primitives.IdAmount]](s, keyBalances, keyFreezes, hashing.Blake128, decodeIdAmounts),
+		Holds:            support.NewHashStorageMap[primitives.AccountId, sc.Sequence[primitives.IdAmount]](s, keyBalances, keyHolds, hashing.Blake128, decodeIdAmounts),
+		Reserves:         support.NewHashStorageMap[primitives.AccountId, sc.Sequence[primitives.ReserveData]](s, keyBalances, keyReserves, hashing.Blake128, decodeReserves),
 	}
 }
+
+func decodeLocks(buffer *bytes.Buffer) (sc.Sequence[primitives.BalanceLock], error) {
+	return sc.DecodeSequenceWith(buffer, primitives.DecodeBalanceLock)
+}
+
+func decodeIdAmounts(buffer *bytes.Buffer) (sc.Sequence[primitives.IdAmount], error) {
+	return sc.DecodeSequenceWith(buffer, primitives.DecodeIdAmount)
+}
+
+func decodeReserves(buffer *bytes.Buffer) (sc.Sequence[primitives.ReserveData], error) {
+	return sc.DecodeSequenceWith(buffer, primitives.DecodeReserveData)
+}
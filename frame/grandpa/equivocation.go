@@ -13,9 +13,15 @@ import (
 	"github.com/LimeChain/gosemble/primitives/io"
 	"github.com/LimeChain/gosemble/primitives/log"
 	"github.com/LimeChain/gosemble/primitives/session"
+	"github.com/LimeChain/gosemble/primitives/staking"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
+var (
+	// EquivocationOffenceKind identifies GRANDPA equivocation offences.
+	EquivocationOffenceKind = sc.BytesToFixedSequenceU8([]byte("grandpa:equivoca"))
+)
+
 // A round number and set id which point on the time of an offence.
 type TimeSlot struct {
 	// Grandpa Set ID.
@@ -24,34 +30,69 @@ type TimeSlot struct {
 	Round sc.U64
 }
 
+func (ts TimeSlot) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, ts.SetId, ts.Round)
+}
+
+func (ts TimeSlot) Bytes() []byte {
+	return sc.EncodedBytes(ts)
+}
+
 // GRANDPA equivocation offence report.
 type EquivocationOffence struct {
-	// Time slot at which this inEquivocationOffencecident happened.
+	// Time slot at which this incident happened.
 	TimeSlot TimeSlot
 	// The session index in which the incident happened.
 	SessionIndex sc.U32
 	// The size of the validator set at the time of the offence.
 	ValidatorSetCount sc.U32
-	// The authority which produced this equivocation.
+	// The validator which produced this equivocation, as identified by the key ownership proof.
 	Offender primitives.AccountId
 }
 
+func (e EquivocationOffence) Kind() sc.FixedSequence[sc.U8] {
+	return EquivocationOffenceKind
+}
+
+func (e EquivocationOffence) Offenders() sc.Sequence[primitives.AccountId] {
+	return sc.Sequence[primitives.AccountId]{e.Offender}
+}
+
+func (e EquivocationOffence) Session() sc.U32 {
+	return e.SessionIndex
+}
+
+func (e EquivocationOffence) ValidatorSetSize() sc.U32 {
+	return e.ValidatorSetCount
+}
+
+func (e EquivocationOffence) Slot() sc.Encodable {
+	return e.TimeSlot
+}
+
+func (e EquivocationOffence) SlashFraction(offendersCount sc.U32) primitives.Perbill {
+	return staking.EquivocationSlashFraction(offendersCount, e.ValidatorSetCount)
+}
+
 type EquivocationReportSystem struct {
 	grandpaModule           Module
 	authorshipModule        authorship.Module
 	sessionHistoricalModule session_historical.Module
+	offences                staking.ReportOffence
 	logger                  log.RuntimeLogger
 }
 
-func NewEquivocationReportSystem(sessionHistoricalModule session_historical.Module, authorshipModule authorship.Module, logger log.RuntimeLogger) EquivocationReportSystem {
-	return EquivocationReportSystem{
+func NewEquivocationReportSystem(sessionHistoricalModule session_historical.Module, authorshipModule authorship.Module, offences staking.ReportOffence, logger log.RuntimeLogger) *EquivocationReportSystem {
+	return &EquivocationReportSystem{
 		authorshipModule:        authorshipModule,
 		sessionHistoricalModule: sessionHistoricalModule,
+		offences:                offences,
 		logger:                  logger,
 	}
 }
 
-func (e EquivocationReportSystem) SetModule(grandpaModule Module) {
+// SetModule sets the GRANDPA module, which is constructed after the report system, as it depends on it.
+func (e *EquivocationReportSystem) SetModule(grandpaModule Module) {
 	e.grandpaModule = grandpaModule
 }
 
@@ -81,16 +122,16 @@ func (e EquivocationReportSystem) PublishEvidence(equivocationProof grandpatypes
 
 func (e EquivocationReportSystem) ProcessEvidence(reporterAccount sc.Option[primitives.AccountId], equivocationProof grandpatypes.EquivocationProof, keyOwnerProof grandpatypes.KeyOwnerProof,
 ) error {
-	var reporter primitives.AccountId
+	reporters := sc.Sequence[primitives.AccountId]{}
 	if reporterAccount.HasValue {
-		reporter = reporterAccount.Value
+		reporters = append(reporters, reporterAccount.Value)
 	} else {
 		author, err := e.authorshipModule.Author()
 		if err != nil {
 			return err
 		}
 		if author.HasValue {
-			reporter = author.Value
+			reporters = append(reporters, author.Value)
 		}
 	}
 
@@ -114,7 +155,7 @@ func (e EquivocationReportSystem) ProcessEvidence(reporterAccount sc.Option[prim
 
 	// Validate the key ownership proof extracting the id of the offender.
 	offenderId := e.sessionHistoricalModule.CheckProof(e.grandpaModule.KeyTypeId(), offender, keyOwnerProof.(session.MembershipProof))
-	if offenderId.HasValue {
+	if !offenderId.HasValue {
 		return NewDispatchErrorInvalidKeyOwnershipProof(e.grandpaModule.GetIndex())
 	}
 
@@ -153,14 +194,16 @@ func (e EquivocationReportSystem) ProcessEvidence(reporterAccount sc.Option[prim
 	offence := EquivocationOffence{
 		TimeSlot:          TimeSlot{setId, round},
 		SessionIndex:      sessionIndex,
-		Offender:          offender,
+		Offender:          offenderId.Value.Validator,
 		ValidatorSetCount: validatorSetCount,
 	}
 
-	// TODO:
-	// R::report_offence(reporter.into_iter().collect(), offence).map_err(|_| Error::<T>::DuplicateOffenceReport)?;
-	_ = reporter
-	_ = offence
+	if err := e.offences.ReportOffence(reporters, offence); err != nil {
+		if errors.Is(err, staking.ErrDuplicateOffenceReport) {
+			return NewDispatchErrorDuplicateOffenceReport(e.grandpaModule.GetIndex())
+		}
+		return err
+	}
 
 	return nil
 }
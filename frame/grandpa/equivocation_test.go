@@ -0,0 +1,43 @@
+package grandpa
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	equivocationTimeSlot = TimeSlot{SetId: 2, Round: 5}
+	equivocationOffence  = EquivocationOffence{
+		TimeSlot:          equivocationTimeSlot,
+		SessionIndex:      3,
+		ValidatorSetCount: 10,
+		Offender:          constants.OneAccountId,
+	}
+)
+
+func Test_TimeSlot_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := equivocationTimeSlot.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, append(sc.U64(2).Bytes(), sc.U64(5).Bytes()...), buffer.Bytes())
+}
+
+func Test_TimeSlot_Bytes(t *testing.T) {
+	assert.Equal(t, append(sc.U64(2).Bytes(), sc.U64(5).Bytes()...), equivocationTimeSlot.Bytes())
+}
+
+func Test_EquivocationOffence(t *testing.T) {
+	assert.Equal(t, sc.BytesToFixedSequenceU8([]byte("grandpa:equivoca")), equivocationOffence.Kind())
+	assert.Equal(t, sc.Sequence[primitives.AccountId]{constants.OneAccountId}, equivocationOffence.Offenders())
+	assert.Equal(t, sc.U32(3), equivocationOffence.Session())
+	assert.Equal(t, sc.U32(10), equivocationOffence.ValidatorSetSize())
+	assert.Equal(t, equivocationTimeSlot, equivocationOffence.Slot())
+	assert.Equal(t, primitives.NewPerbillFromPercent(9), equivocationOffence.SlashFraction(1))
+}
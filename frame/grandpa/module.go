@@ -408,9 +408,12 @@ func (m module) SubmitUnsignedEquivocationReport(equivocationProof grandpatypes.
 }
 
 func (m module) HistoricalKeyOwnershipProof(authorityId primitives.AccountId) sc.Option[grandpatypes.OpaqueKeyOwnershipProof] {
-	bytes := m.keyOwnerProof.Prove(KeyTypeId, authorityId).Bytes()
-	proof := sc.BytesToSequenceU8(bytes)
-	return sc.NewOption[grandpatypes.OpaqueKeyOwnershipProof](proof)
+	proof := m.keyOwnerProof.Prove(KeyTypeId, authorityId)
+	if !proof.HasValue {
+		return sc.NewOption[grandpatypes.OpaqueKeyOwnershipProof](nil)
+	}
+
+	return sc.NewOption[grandpatypes.OpaqueKeyOwnershipProof](sc.BytesToSequenceU8(proof.Value.Bytes()))
 }
 
 func (m module) OnStalled(furtherWait sc.U64, median sc.U64) {
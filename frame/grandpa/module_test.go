@@ -13,6 +13,7 @@ import (
 	"github.com/LimeChain/gosemble/primitives/grandpa"
 	grandpatypes "github.com/LimeChain/gosemble/primitives/grandpa"
 	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/session"
 	"github.com/LimeChain/gosemble/primitives/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/assert"
@@ -413,6 +414,32 @@ func Test_Grandpa_Module_StorageSetId(t *testing.T) {
 	mockStorageCurrentSetId.AssertCalled(t, "Get")
 }
 
+func Test_Grandpa_Module_HistoricalKeyOwnershipProof(t *testing.T) {
+	setup()
+
+	membershipProof := session.MembershipProof{
+		SessionIndex: 1,
+		TrieNodes:    sc.Sequence[sc.Sequence[sc.U8]]{{1, 2, 3}},
+		Validators:   2,
+	}
+
+	mockKeyOwnerProofSystem.On("Prove", KeyTypeId, constants.OneAccountId).Return(sc.NewOption[session.MembershipProof](membershipProof))
+
+	result := target.HistoricalKeyOwnershipProof(constants.OneAccountId)
+
+	assert.Equal(t, sc.NewOption[grandpatypes.OpaqueKeyOwnershipProof](sc.BytesToSequenceU8(membershipProof.Bytes())), result)
+}
+
+func Test_Grandpa_Module_HistoricalKeyOwnershipProof_None(t *testing.T) {
+	setup()
+
+	mockKeyOwnerProofSystem.On("Prove", KeyTypeId, constants.OneAccountId).Return(sc.NewOption[session.MembershipProof](nil))
+
+	result := target.HistoricalKeyOwnershipProof(constants.OneAccountId)
+
+	assert.Equal(t, sc.NewOption[grandpatypes.OpaqueKeyOwnershipProof](nil), result)
+}
+
 func Test_Grandpa_Module_Metadata(t *testing.T) {
 	setup()
 
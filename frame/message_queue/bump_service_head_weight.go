@@ -0,0 +1,9 @@
+package message_queue
+
+import primitives "github.com/LimeChain/gosemble/primitives/types"
+
+func bumpServiceHeadWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(7_000_000, 0).
+		SaturatingAdd(dbWeight.Reads(2)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
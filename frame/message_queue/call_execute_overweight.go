@@ -0,0 +1,113 @@
+package message_queue
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callExecuteOverweight executes an overweight message.
+type callExecuteOverweight struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallExecuteOverweight(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callExecuteOverweight{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(NewMessageOriginHere(), sc.U32(0), sc.U32(0), primitives.WeightZero()),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callExecuteOverweight) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	origin, err := DecodeMessageOrigin(buffer)
+	if err != nil {
+		return nil, err
+	}
+	page, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	weightLimit, err := primitives.DecodeWeight(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(origin, page, index, weightLimit)
+
+	return c, nil
+}
+
+func (c callExecuteOverweight) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callExecuteOverweight) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callExecuteOverweight) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callExecuteOverweight) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callExecuteOverweight) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callExecuteOverweight) BaseWeight() primitives.Weight {
+	weightLimit := c.Arguments[3].(primitives.Weight)
+
+	return executeOverweightPageRemovedWeight(c.dbWeight).
+		Max(executeOverweightPageUpdatedWeight(c.dbWeight)).
+		SaturatingAdd(weightLimit)
+}
+
+func (_ callExecuteOverweight) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callExecuteOverweight) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callExecuteOverweight) PaysFee(_ primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callExecuteOverweight) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	_, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	messageOrigin := args[0].(MessageOrigin)
+	page := args[1].(sc.U32)
+	index := args[2].(sc.U32)
+	weightLimit := args[3].(primitives.Weight)
+
+	actualWeight, err := c.module.doExecuteOverweight(messageOrigin, page, index, weightLimit)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](actualWeight),
+	}, nil
+}
+
+func (_ callExecuteOverweight) Docs() string {
+	return "Execute an overweight message. " +
+		"Temporary processing errors will be propagated whereas permanent errors are treated as success condition. " +
+		"The dispatch origin for this call must be `Signed`."
+}
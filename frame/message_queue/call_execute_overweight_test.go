@@ -0,0 +1,74 @@
+package message_queue
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_ExecuteOverweight_DecodeArgs(t *testing.T) {
+	target := setupCallExecuteOverweight()
+	buffer := &bytes.Buffer{}
+	buffer.Write(originSibling.Bytes())
+	buffer.Write(sc.U32(1).Bytes())
+	buffer.Write(sc.U32(2).Bytes())
+	buffer.Write(messageWeight.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(originSibling, sc.U32(1), sc.U32(2), messageWeight), result.Args())
+}
+
+func Test_Call_ExecuteOverweight_BaseWeight(t *testing.T) {
+	target := setupCallExecuteOverweight()
+	target.Arguments = sc.NewVaryingData(originSibling, sc.U32(0), sc.U32(0), messageWeight)
+
+	expect := executeOverweightPageRemovedWeight(dbWeight).
+		Max(executeOverweightPageUpdatedWeight(dbWeight)).
+		SaturatingAdd(messageWeight)
+	assert.Equal(t, expect, target.BaseWeight())
+}
+
+func Test_Call_ExecuteOverweight_Dispatch(t *testing.T) {
+	target := setupCallExecuteOverweight()
+	setupOverweightSiblingPage()
+	mockMessageProcessor.On("ProcessMessage", messageA, originSibling, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { args.Get(2).(*primitives.WeightMeter).Consume(messageWeight) }).
+		Return(true, nil)
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(constants.OneAccountId), sc.NewVaryingData(originSibling, sc.U32(0), sc.U32(0), idleMaxServiceWeight))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](messageWeight.SaturatingAdd(executeOverweightPageRemovedWeight(dbWeight))),
+	}, result)
+}
+
+func Test_Call_ExecuteOverweight_Dispatch_Error(t *testing.T) {
+	target := setupCallExecuteOverweight()
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{Begin: 1, End: 1}, nil)
+	mockStoragePages.On("Exists", keySibling0).Return(false)
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(constants.OneAccountId), sc.NewVaryingData(originSibling, sc.U32(0), sc.U32(0), idleMaxServiceWeight))
+
+	assert.Equal(t, NewDispatchErrorNoPage(moduleId), err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+}
+
+func Test_Call_ExecuteOverweight_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallExecuteOverweight()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallExecuteOverweight() callExecuteOverweight {
+	return newCallExecuteOverweight(moduleId, functionExecuteOverweight, dbWeight, setupModule()).(callExecuteOverweight)
+}
@@ -0,0 +1,15 @@
+package message_queue
+
+import primitives "github.com/LimeChain/gosemble/primitives/types"
+
+func executeOverweightPageRemovedWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(37_000_000, 0).
+		SaturatingAdd(dbWeight.Reads(2)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
+
+func executeOverweightPageUpdatedWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(33_000_000, 0).
+		SaturatingAdd(dbWeight.Reads(2)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
@@ -0,0 +1,88 @@
+package message_queue
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callReapPage removes a page, which has no unprocessed messages left or is stale.
+type callReapPage struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallReapPage(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callReapPage{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(NewMessageOriginHere(), sc.U32(0)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callReapPage) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	origin, err := DecodeMessageOrigin(buffer)
+	if err != nil {
+		return nil, err
+	}
+	pageIndex, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(origin, pageIndex)
+
+	return c, nil
+}
+
+func (c callReapPage) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callReapPage) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callReapPage) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callReapPage) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callReapPage) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callReapPage) BaseWeight() primitives.Weight {
+	return callReapPageWeight(c.dbWeight)
+}
+
+func (_ callReapPage) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callReapPage) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callReapPage) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callReapPage) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	_, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	messageOrigin := args[0].(MessageOrigin)
+	pageIndex := args[1].(sc.U32)
+
+	return primitives.PostDispatchInfo{}, c.module.doReapPage(messageOrigin, pageIndex)
+}
+
+func (_ callReapPage) Docs() string {
+	return "Remove a page, which has no more messages remaining to be processed or is stale."
+}
@@ -0,0 +1,55 @@
+package message_queue
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Call_ReapPage_DecodeArgs(t *testing.T) {
+	target := setupCallReapPage()
+	buffer := bytes.NewBuffer(append(originSibling.Bytes(), sc.U32(1).Bytes()...))
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(originSibling, sc.U32(1)), result.Args())
+}
+
+func Test_Call_ReapPage_BaseWeight(t *testing.T) {
+	target := setupCallReapPage()
+
+	assert.Equal(t, callReapPageWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_ReapPage_Dispatch(t *testing.T) {
+	target := setupCallReapPage()
+	page := newPageFromMessage(messageA)
+	page.noteProcessedAtPos(0)
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{Begin: 1, End: 1, Count: 1}, nil)
+	mockStoragePages.On("Exists", keySibling0).Return(true)
+	mockStoragePages.On("Get", keySibling0).Return(page, nil)
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(constants.OneAccountId), sc.NewVaryingData(originSibling, sc.U32(0)))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStoragePages.AssertCalled(t, "Remove", keySibling0)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventPageReaped(moduleId, originSibling, 0))
+}
+
+func Test_Call_ReapPage_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallReapPage()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallReapPage() callReapPage {
+	return newCallReapPage(moduleId, functionReapPage, dbWeight, setupModule()).(callReapPage)
+}
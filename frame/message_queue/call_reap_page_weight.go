@@ -0,0 +1,9 @@
+package message_queue
+
+import primitives "github.com/LimeChain/gosemble/primitives/types"
+
+func callReapPageWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(30_000_000, 0).
+		SaturatingAdd(dbWeight.Reads(2)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
@@ -0,0 +1,45 @@
+package message_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	Storage          io.Storage
+	DbWeight         primitives.RuntimeDbWeight
+	SystemModule     system.Module
+	MessageProcessor MessageProcessor
+	// HeapSize is the size of the heap of a page. It bounds the maximum length of a message.
+	HeapSize sc.U32
+	// MaxStale is the maximum number of stale pages of a queue before the oldest ones can be reaped.
+	MaxStale sc.U32
+	// ServiceWeight is the weight used to service the queues in `OnInitialize`, if any.
+	ServiceWeight sc.Option[primitives.Weight]
+	// IdleMaxServiceWeight is the maximum weight used to service the queues in `OnIdle`, if any.
+	IdleMaxServiceWeight sc.Option[primitives.Weight]
+}
+
+func NewConfig(
+	storage io.Storage,
+	dbWeight primitives.RuntimeDbWeight,
+	systemModule system.Module,
+	messageProcessor MessageProcessor,
+	heapSize sc.U32,
+	maxStale sc.U32,
+	serviceWeight sc.Option[primitives.Weight],
+	idleMaxServiceWeight sc.Option[primitives.Weight],
+) Config {
+	return Config{
+		Storage:              storage,
+		DbWeight:             dbWeight,
+		SystemModule:         systemModule,
+		MessageProcessor:     messageProcessor,
+		HeapSize:             heapSize,
+		MaxStale:             maxStale,
+		ServiceWeight:        serviceWeight,
+		IdleMaxServiceWeight: idleMaxServiceWeight,
+	}
+}
@@ -0,0 +1,11 @@
+package message_queue
+
+import primitives "github.com/LimeChain/gosemble/primitives/types"
+
+// enqueueMessageWeight is the worst case weight of enqueuing a message, which includes knitting
+// its queue into the ready ring.
+func enqueueMessageWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(16_000_000, 0).
+		SaturatingAdd(dbWeight.Reads(4)).
+		SaturatingAdd(dbWeight.Writes(4))
+}
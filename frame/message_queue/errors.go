@@ -0,0 +1,53 @@
+package message_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Message queue module errors.
+const (
+	ErrorNotReapable sc.U8 = iota
+	ErrorNoPage
+	ErrorNoMessage
+	ErrorAlreadyProcessed
+	ErrorQueued
+	ErrorInsufficientWeight
+	ErrorTemporarilyUnprocessable
+)
+
+func NewDispatchErrorNotReapable(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNotReapable)
+}
+
+func NewDispatchErrorNoPage(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNoPage)
+}
+
+func NewDispatchErrorNoMessage(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNoMessage)
+}
+
+func NewDispatchErrorAlreadyProcessed(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorAlreadyProcessed)
+}
+
+func NewDispatchErrorQueued(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorQueued)
+}
+
+func NewDispatchErrorInsufficientWeight(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorInsufficientWeight)
+}
+
+func NewDispatchErrorTemporarilyUnprocessable(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorTemporarilyUnprocessable)
+}
+
+func newDispatchError(moduleId sc.U8, err sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
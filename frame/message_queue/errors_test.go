@@ -0,0 +1,29 @@
+package message_queue
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewDispatchErrors(t *testing.T) {
+	for err, constructor := range map[sc.U8]func(sc.U8) primitives.DispatchError{
+		ErrorNotReapable:              NewDispatchErrorNotReapable,
+		ErrorNoPage:                   NewDispatchErrorNoPage,
+		ErrorNoMessage:                NewDispatchErrorNoMessage,
+		ErrorAlreadyProcessed:         NewDispatchErrorAlreadyProcessed,
+		ErrorQueued:                   NewDispatchErrorQueued,
+		ErrorInsufficientWeight:       NewDispatchErrorInsufficientWeight,
+		ErrorTemporarilyUnprocessable: NewDispatchErrorTemporarilyUnprocessable,
+	} {
+		expect := primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+			Index:   moduleId,
+			Err:     sc.U32(err),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+
+		assert.Equal(t, expect, constructor(moduleId))
+	}
+}
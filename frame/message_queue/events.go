@@ -0,0 +1,118 @@
+package message_queue
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Message queue module events.
+const (
+	EventProcessingFailed sc.U8 = iota
+	EventProcessed
+	EventOverweightEnqueued
+	EventPageReaped
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid message_queue.Event module")
+	errInvalidEventType   = errors.New("invalid message_queue.Event type")
+)
+
+func newEventProcessingFailed(moduleIndex sc.U8, id primitives.H256, origin MessageOrigin, err ProcessMessageError) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventProcessingFailed, id, origin, err)
+}
+
+func newEventProcessed(moduleIndex sc.U8, id primitives.H256, origin MessageOrigin, weightUsed primitives.Weight, success sc.Bool) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventProcessed, id, origin, weightUsed, success)
+}
+
+func newEventOverweightEnqueued(moduleIndex sc.U8, id primitives.H256, origin MessageOrigin, pageIndex sc.U32, messageIndex sc.U32) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventOverweightEnqueued, id, origin, pageIndex, messageIndex)
+}
+
+func newEventPageReaped(moduleIndex sc.U8, origin MessageOrigin, index sc.U32) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventPageReaped, origin, index)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventProcessingFailed:
+		id, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		origin, err := DecodeMessageOrigin(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		processErr, err := DecodeProcessMessageError(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventProcessingFailed(moduleIndex, id, origin, processErr), nil
+	case EventProcessed:
+		id, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		origin, err := DecodeMessageOrigin(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		weightUsed, err := primitives.DecodeWeight(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		success, err := sc.DecodeBool(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventProcessed(moduleIndex, id, origin, weightUsed, success), nil
+	case EventOverweightEnqueued:
+		id, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		origin, err := DecodeMessageOrigin(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		pageIndex, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		messageIndex, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventOverweightEnqueued(moduleIndex, id, origin, pageIndex, messageIndex), nil
+	case EventPageReaped:
+		origin, err := DecodeMessageOrigin(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		index, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventPageReaped(moduleIndex, origin, index), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
@@ -0,0 +1,42 @@
+package message_queue
+
+import (
+	"bytes"
+	"testing"
+
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DecodeEvent(t *testing.T) {
+	for _, event := range []primitives.Event{
+		newEventProcessingFailed(moduleId, messageId, originSibling, NewProcessMessageErrorUnsupported()),
+		newEventProcessed(moduleId, messageId, originSibling, primitives.WeightFromParts(1, 2), true),
+		newEventOverweightEnqueued(moduleId, messageId, originSibling, 1, 2),
+		newEventPageReaped(moduleId, originSibling, 1),
+	} {
+		result, err := DecodeEvent(moduleId, bytes.NewBuffer(event.Bytes()))
+		assert.Nil(t, err)
+
+		assert.Equal(t, event, result)
+	}
+}
+
+func Test_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId + 1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
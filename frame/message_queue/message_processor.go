@@ -0,0 +1,21 @@
+package message_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// MessageProcessor executes the messages of the queues.
+type MessageProcessor interface {
+	// ProcessMessage processes `message` from `origin`, consuming its weight from `meter`. The message `id`
+	// may be overwritten by the processor. Returns whether the message was executed successfully, or a
+	// ProcessMessageError if it could not be processed.
+	ProcessMessage(message sc.Sequence[sc.U8], origin MessageOrigin, meter *primitives.WeightMeter, id *primitives.H256) (bool, error)
+}
+
+// DefaultMessageProcessor rejects all messages as unsupported.
+type DefaultMessageProcessor struct{}
+
+func (dmp DefaultMessageProcessor) ProcessMessage(_ sc.Sequence[sc.U8], _ MessageOrigin, _ *primitives.WeightMeter, _ *primitives.H256) (bool, error) {
+	return false, NewProcessMessageErrorUnsupported()
+}
@@ -0,0 +1,21 @@
+package message_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockMessageProcessor struct {
+	mock.Mock
+}
+
+func (m *MockMessageProcessor) ProcessMessage(message sc.Sequence[sc.U8], origin MessageOrigin, meter *primitives.WeightMeter, id *primitives.H256) (bool, error) {
+	args := m.Called(message, origin, meter, id)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(bool), args.Get(1).(error)
+	}
+
+	return args.Get(0).(bool), nil
+}
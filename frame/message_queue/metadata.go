@@ -0,0 +1,373 @@
+package message_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func (m Module) Metadata() primitives.MetadataModule {
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMessageQueueCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesMessageQueueCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<MessageQueue, Runtime>"),
+				},
+				m.index,
+				"Call.MessageQueue")),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMessageQueueEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesMessageQueueEvent, "pallet_message_queue::Event<Runtime>"),
+				},
+				m.index,
+				"Events.MessageQueue"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"HeapSize",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.heapSize.Bytes()),
+				"The size of the page; this implies the maximum message size which can be sent.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxStale",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.maxStale.Bytes()),
+				"The maximum number of stale pages (i.e. of overweight messages) allowed before culling can happen. Once there are more stale pages than this, then historical pages may be dropped, even if they contain unprocessed overweight messages.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"ServiceWeight",
+				sc.ToCompact(metadata.TypesMessageQueueOptionWeight),
+				sc.BytesToSequenceU8(m.serviceWeight.Bytes()),
+				"The amount of weight (if any) which should be provided to the message queue for servicing enqueued items `on_initialize`.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"IdleMaxServiceWeight",
+				sc.ToCompact(metadata.TypesMessageQueueOptionWeight),
+				sc.BytesToSequenceU8(m.idleMaxServiceWeight.Bytes()),
+				"The maximum amount of weight (if any) to be used from remaining weight `on_idle` which should be provided to the message queue for servicing enqueued items `on_idle`.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMessageQueueErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesMessageQueueErrors),
+				},
+				m.index,
+				"Errors.MessageQueue"),
+		),
+		Index: m.index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"BookStateFor",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesMessageQueueOrigin),
+					sc.ToCompact(metadata.TypesMessageQueueBookState)),
+				"The index of the first and last (non-empty) pages."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ServiceHead",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesMessageQueueOrigin)),
+				"The origin at which we should begin servicing."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Pages",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesMessageQueueTupleOriginU32),
+					sc.ToCompact(metadata.TypesMessageQueuePage)),
+				"The map of page indices to pages."),
+		},
+	})
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesMessageQueueOrigin,
+			"AggregateMessageOrigin",
+			sc.Sequence[sc.Str]{"cumulus_primitives_core", "AggregateMessageOrigin"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Here",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						MessageOriginHere,
+						"The message came from the para-chain itself."),
+					primitives.NewMetadataDefinitionVariant(
+						"Parent",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						MessageOriginParent,
+						"The message came from the relay-chain."),
+					primitives.NewMetadataDefinitionVariant(
+						"Sibling",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.PrimitiveTypesU32, "ParaId"),
+						},
+						MessageOriginSibling,
+						"The message came from a sibling para-chain."),
+				})),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesMessageQueueNeighbours,
+			"Neighbours",
+			sc.Sequence[sc.Str]{"pallet_message_queue", "Neighbours"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMessageQueueOrigin, "prev", "MessageOrigin"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMessageQueueOrigin, "next", "MessageOrigin"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesMessageQueueOrigin, "MessageOrigin")),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesMessageQueueOptionNeighbours,
+			"Option<Neighbours<MessageOrigin>>",
+			sc.Sequence[sc.Str]{"Option"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						0,
+						""),
+					primitives.NewMetadataDefinitionVariant(
+						"Some",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.TypesMessageQueueNeighbours),
+						},
+						1,
+						""),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesMessageQueueNeighbours, "T")),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesMessageQueueBookState,
+			"BookState",
+			sc.Sequence[sc.Str]{"pallet_message_queue", "BookState"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "begin", "PageIndex"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "end", "PageIndex"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "count", "PageIndex"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMessageQueueOptionNeighbours, "ready_neighbours", "Option<Neighbours<MessageOrigin>>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "message_count", "u64"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "size", "u64"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesMessageQueueOrigin, "MessageOrigin")),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesMessageQueuePage,
+			"Page",
+			sc.Sequence[sc.Str]{"pallet_message_queue", "Page"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "remaining", "Size"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "remaining_size", "Size"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "first_index", "Size"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "first", "Size"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "last", "Size"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "heap", "BoundedVec<u8, IntoU32<HeapSize, Size>>"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU32, "Size")),
+
+		primitives.NewMetadataType(metadata.TypesMessageQueueTupleOriginU32, "(MessageOrigin, PageIndex)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.TypesMessageQueueOrigin),
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+			})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesMessageQueueProcessMessageError,
+			"ProcessMessageError",
+			sc.Sequence[sc.Str]{"frame_support", "traits", "messages", "ProcessMessageError"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"BadFormat",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ProcessMessageErrorBadFormat,
+						"The message data format is unknown (e.g. unrecognised header)"),
+					primitives.NewMetadataDefinitionVariant(
+						"Corrupt",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ProcessMessageErrorCorrupt,
+						"The message data is bad (e.g. decoding returns an error)."),
+					primitives.NewMetadataDefinitionVariant(
+						"Unsupported",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ProcessMessageErrorUnsupported,
+						"The message format is unsupported (e.g. old XCM version)."),
+					primitives.NewMetadataDefinitionVariant(
+						"Overweight",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesWeight, "Weight"),
+						},
+						ProcessMessageErrorOverweight,
+						"Message execution was not possible because the weight required was greater than the available weight."),
+					primitives.NewMetadataDefinitionVariant(
+						"Yield",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ProcessMessageErrorYield,
+						"The queue wants to give up its current processing slot."),
+					primitives.NewMetadataDefinitionVariant(
+						"StackLimitReached",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ProcessMessageErrorStackLimitReached,
+						"The message could not be processed for reaching the stack depth limit."),
+				})),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesMessageQueueOptionWeight,
+			"Option<Weight>",
+			sc.Sequence[sc.Str]{"Option"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						0,
+						""),
+					primitives.NewMetadataDefinitionVariant(
+						"Some",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.TypesWeight),
+						},
+						1,
+						""),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesWeight, "T")),
+
+		primitives.NewMetadataTypeWithPath(
+			metadata.TypesMessageQueueEvent,
+			"pallet_message_queue pallet Event",
+			sc.Sequence[sc.Str]{"pallet_message_queue", "pallet", "Event"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"ProcessingFailed",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "id", "H256"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMessageQueueOrigin, "origin", "MessageOriginOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMessageQueueProcessMessageError, "error", "ProcessMessageError"),
+						},
+						EventProcessingFailed,
+						"Message discarded due to an error in the `MessageProcessor` (usually a format error)."),
+					primitives.NewMetadataDefinitionVariant(
+						"Processed",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "id", "H256"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMessageQueueOrigin, "origin", "MessageOriginOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesWeight, "weight_used", "Weight"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "success", "bool"),
+						},
+						EventProcessed,
+						"Message is processed."),
+					primitives.NewMetadataDefinitionVariant(
+						"OverweightEnqueued",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "id", "H256"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMessageQueueOrigin, "origin", "MessageOriginOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "page_index", "PageIndex"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "message_index", "T::Size"),
+						},
+						EventOverweightEnqueued,
+						"Message placed in overweight queue."),
+					primitives.NewMetadataDefinitionVariant(
+						"PageReaped",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMessageQueueOrigin, "origin", "MessageOriginOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "PageIndex"),
+						},
+						EventPageReaped,
+						"This page was reaped."),
+				})),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesMessageQueueErrors,
+			"pallet_message_queue pallet Error",
+			sc.Sequence[sc.Str]{"pallet_message_queue", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"NotReapable",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNotReapable,
+						"Page is not reapable because it has items remaining to be processed and is not old enough."),
+					primitives.NewMetadataDefinitionVariant(
+						"NoPage",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNoPage,
+						"Page to be reaped does not exist."),
+					primitives.NewMetadataDefinitionVariant(
+						"NoMessage",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNoMessage,
+						"The referenced message could not be found."),
+					primitives.NewMetadataDefinitionVariant(
+						"AlreadyProcessed",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorAlreadyProcessed,
+						"The message was already processed and cannot be processed again."),
+					primitives.NewMetadataDefinitionVariant(
+						"Queued",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorQueued,
+						"The message is queued for future execution."),
+					primitives.NewMetadataDefinitionVariant(
+						"InsufficientWeight",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorInsufficientWeight,
+						"There is temporarily not enough weight to continue servicing messages."),
+					primitives.NewMetadataDefinitionVariant(
+						"TemporarilyUnprocessable",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTemporarilyUnprocessable,
+						"This message is temporarily unprocessable."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesMessageQueueCalls,
+			"MessageQueue calls",
+			sc.Sequence[sc.Str]{"pallet_message_queue", "pallet", "Call"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"reap_page",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMessageQueueOrigin, "message_origin", "MessageOriginOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "page_index", "PageIndex"),
+						},
+						functionReapPage,
+						"Remove a page which has no more messages remaining to be processed or is stale."),
+					primitives.NewMetadataDefinitionVariant(
+						"execute_overweight",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMessageQueueOrigin, "message_origin", "MessageOriginOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "page", "PageIndex"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "T::Size"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesWeight, "weight_limit", "Weight"),
+						},
+						functionExecuteOverweight,
+						"Execute an overweight message."),
+				}),
+			primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+}
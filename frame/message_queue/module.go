@@ -0,0 +1,699 @@
+package message_queue
+
+import (
+	"errors"
+	"math"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/parachain"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	functionReapPage = iota
+	functionExecuteOverweight
+)
+
+const (
+	name = sc.Str("MessageQueue")
+)
+
+var (
+	errServiceHeadNotReady = errors.New("service head is not in the ready ring")
+)
+
+var (
+	// maxWeight is used as the overweight limit when executing overweight messages.
+	maxWeight = primitives.WeightFromParts(math.MaxUint64, math.MaxUint64)
+)
+
+// messageExecutionStatus is the outcome of processing a single message.
+type messageExecutionStatus sc.U8
+
+const (
+	// messageProcessed means that the message was executed, successfully or not.
+	messageProcessed messageExecutionStatus = iota
+	// messageOverweight means that the message needs more weight than can ever be available to service it.
+	messageOverweight
+	// messageInsufficientWeight means that there is not enough weight left to process the message now.
+	messageInsufficientWeight
+	// messageTemporarilyUnprocessable means that the message cannot be processed now, but may be later.
+	messageTemporarilyUnprocessable
+	// messagePermanentlyUnprocessable means that the message can never be processed.
+	messagePermanentlyUnprocessable
+)
+
+// itemExecutionStatus is the outcome of servicing a single message of a page.
+type itemExecutionStatus sc.U8
+
+const (
+	// itemProcessed means that the message was processed and marked as such.
+	itemProcessed itemExecutionStatus = iota
+	// itemSkipped means that the message was skipped as overweight.
+	itemSkipped
+	// itemNoItem means that there are no more messages to service in the page.
+	itemNoItem
+	// itemNoProgress means that the message yielded and the queue must not be serviced further.
+	itemNoProgress
+	// itemBailed means that there is not enough weight left to service the message.
+	itemBailed
+)
+
+// pageExecutionStatus is the outcome of servicing a page.
+type pageExecutionStatus sc.U8
+
+const (
+	// pageNoMore means that there are no more messages to service in the page.
+	pageNoMore pageExecutionStatus = iota
+	// pageNoProgress means that a message yielded and the queue must not be serviced further.
+	pageNoProgress
+	// pageBailed means that there is not enough weight left to service the rest of the page.
+	pageBailed
+)
+
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	index                sc.U8
+	dbWeight             primitives.RuntimeDbWeight
+	heapSize             sc.U32
+	maxStale             sc.U32
+	serviceWeight        sc.Option[primitives.Weight]
+	idleMaxServiceWeight sc.Option[primitives.Weight]
+	functions            map[sc.U8]primitives.Call
+	storage              *storage
+	systemModule         system.Module
+	messageProcessor     MessageProcessor
+	hashing              io.Hashing
+	mdGenerator          *primitives.MetadataTypeGenerator
+	logger               log.RuntimeLogger
+}
+
+func New(index sc.U8, config Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.RuntimeLogger) Module {
+	functions := make(map[sc.U8]primitives.Call)
+
+	module := Module{
+		index:                index,
+		dbWeight:             config.DbWeight,
+		heapSize:             config.HeapSize,
+		maxStale:             config.MaxStale,
+		serviceWeight:        config.ServiceWeight,
+		idleMaxServiceWeight: config.IdleMaxServiceWeight,
+		storage:              newStorage(config.Storage),
+		systemModule:         config.SystemModule,
+		messageProcessor:     config.MessageProcessor,
+		hashing:              io.NewHashing(),
+		mdGenerator:          mdGenerator,
+		logger:               logger,
+	}
+
+	functions[functionReapPage] = newCallReapPage(index, functionReapPage, config.DbWeight, module)
+	functions[functionExecuteOverweight] = newCallExecuteOverweight(index, functionExecuteOverweight, config.DbWeight, module)
+
+	module.functions = functions
+
+	return module
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) GetIndex() sc.U8 { return m.index }
+
+func (m Module) Functions() map[sc.U8]primitives.Call { return m.functions }
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) { return sc.Empty{}, nil }
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnInitialize services the queues with ServiceWeight, if it is set.
+func (m Module) OnInitialize(_ sc.U64) (primitives.Weight, error) {
+	if !m.serviceWeight.HasValue {
+		return primitives.WeightZero(), nil
+	}
+
+	return m.serviceQueues(m.serviceWeight.Value)
+}
+
+// OnIdle services the queues with the remaining weight of the block, up to IdleMaxServiceWeight, if it is set.
+func (m Module) OnIdle(_ sc.U64, remainingWeight primitives.Weight) primitives.Weight {
+	if !m.idleMaxServiceWeight.HasValue {
+		return primitives.WeightZero()
+	}
+
+	weight, err := m.serviceQueues(m.idleMaxServiceWeight.Value.Min(remainingWeight))
+	if err != nil {
+		m.logger.Criticalf("failed to service message queues: [%s]", err.Error())
+	}
+
+	return weight
+}
+
+// BookState returns the state of the queue of `origin`.
+func (m Module) BookState(origin MessageOrigin) (BookState, error) {
+	return m.storage.BookStateFor.Get(origin)
+}
+
+// MaxMessageLen returns the maximum length of a message, which fits in a page.
+func (m Module) MaxMessageLen() sc.U32 {
+	if m.heapSize < itemHeaderSize {
+		return 0
+	}
+	return m.heapSize - itemHeaderSize
+}
+
+// EnqueueMessage appends `message` to the queue of `origin`. Messages longer than MaxMessageLen are dropped.
+func (m Module) EnqueueMessage(message sc.Sequence[sc.U8], origin MessageOrigin) error {
+	if sc.U32(len(message)) > m.MaxMessageLen() {
+		m.logger.Warnf("message of length [%d] does not fit in a page; dropping", len(message))
+		return nil
+	}
+
+	bookState, err := m.storage.BookStateFor.Get(origin)
+	if err != nil {
+		return err
+	}
+
+	if bookState.End > bookState.Begin {
+		key := pageKey{Origin: origin, Index: bookState.End - 1}
+		page, err := m.storage.Pages.Get(key)
+		if err != nil {
+			return err
+		}
+		if page.tryAppendMessage(message, m.heapSize) {
+			m.storage.Pages.Put(key, page)
+			bookState.MessageCount = sc.SaturatingAddU64(bookState.MessageCount, 1)
+			bookState.Size = sc.SaturatingAddU64(bookState.Size, sc.U64(len(message)))
+			m.storage.BookStateFor.Put(origin, bookState)
+			return nil
+		}
+	} else if !bookState.ReadyNeighbours.HasValue {
+		neighbours, err := m.readyRingKnit(origin)
+		if err != nil {
+			return err
+		}
+		bookState.ReadyNeighbours = sc.NewOption[Neighbours](neighbours)
+	}
+
+	// The queue is empty or its last page is full.
+	bookState.End++
+	bookState.Count++
+	bookState.MessageCount = sc.SaturatingAddU64(bookState.MessageCount, 1)
+	bookState.Size = sc.SaturatingAddU64(bookState.Size, sc.U64(len(message)))
+	m.storage.Pages.Put(pageKey{Origin: origin, Index: bookState.End - 1}, newPageFromMessage(message))
+	m.storage.BookStateFor.Put(origin, bookState)
+
+	return nil
+}
+
+// HandleDmpMessages enqueues the downward `messages` in the queue of the relay chain.
+func (m Module) HandleDmpMessages(messages sc.Sequence[parachain.InboundDownwardMessage], _ primitives.Weight) primitives.Weight {
+	weight := primitives.WeightZero()
+	for _, message := range messages {
+		if err := m.EnqueueMessage(message.Msg, NewMessageOriginParent()); err != nil {
+			m.logger.Criticalf("failed to enqueue downward message: [%s]", err.Error())
+		}
+		weight = weight.SaturatingAdd(enqueueMessageWeight(m.dbWeight))
+	}
+
+	return weight
+}
+
+// HandleXcmpMessages enqueues the horizontal `messages` in the queues of their senders.
+func (m Module) HandleXcmpMessages(messages sc.Sequence[parachain.InboundXcmpMessage], _ primitives.Weight) primitives.Weight {
+	weight := primitives.WeightZero()
+	for _, message := range messages {
+		if err := m.EnqueueMessage(message.Data, NewMessageOriginSibling(message.Sender)); err != nil {
+			m.logger.Criticalf("failed to enqueue horizontal message: [%s]", err.Error())
+		}
+		weight = weight.SaturatingAdd(enqueueMessageWeight(m.dbWeight))
+	}
+
+	return weight
+}
+
+// serviceQueues services the ready queues in a round-robin fashion, while there is weight left from `weightLimit`.
+func (m Module) serviceQueues(weightLimit primitives.Weight) (primitives.Weight, error) {
+	meter := primitives.NewWeightMeter(weightLimit)
+	overweightLimit := m.maxMessageWeight()
+
+	next, err := m.bumpServiceHead(&meter)
+	if err != nil {
+		return meter.Consumed, err
+	}
+	if !next.HasValue {
+		return meter.Consumed, nil
+	}
+
+	current := next.Value
+	lastNoProgress := sc.NewOption[MessageOrigin](nil)
+	for {
+		progressed, next, err := m.serviceQueue(current, &meter, overweightLimit)
+		if err != nil {
+			return meter.Consumed, err
+		}
+		if !next.HasValue {
+			break
+		}
+
+		if progressed {
+			lastNoProgress = sc.NewOption[MessageOrigin](nil)
+		} else {
+			// Stop once a whole round of the ready ring made no progress.
+			if lastNoProgress.HasValue && lastNoProgress.Value.Equal(next.Value) {
+				break
+			}
+			if !lastNoProgress.HasValue {
+				lastNoProgress = sc.NewOption[MessageOrigin](current)
+			}
+		}
+		current = next.Value
+	}
+
+	return meter.Consumed, nil
+}
+
+// maxMessageWeight returns the maximum weight a message can have in order to be processed while servicing the
+// queues. Heavier messages are permanently overweight and can only be executed with `execute_overweight`.
+func (m Module) maxMessageWeight() primitives.Weight {
+	maxServiceWeight := primitives.WeightZero()
+	if m.serviceWeight.HasValue {
+		maxServiceWeight = maxServiceWeight.Max(m.serviceWeight.Value)
+	}
+	if m.idleMaxServiceWeight.HasValue {
+		maxServiceWeight = maxServiceWeight.Max(m.idleMaxServiceWeight.Value)
+	}
+
+	return maxServiceWeight.SaturatingSub(m.singleMessageOverhead())
+}
+
+// singleMessageOverhead is the weight of servicing a single message, excluding its processing.
+func (m Module) singleMessageOverhead() primitives.Weight {
+	return bumpServiceHeadWeight(m.dbWeight).
+		SaturatingAdd(serviceQueueBaseWeight(m.dbWeight)).
+		SaturatingAdd(servicePageBaseWeight(m.dbWeight)).
+		SaturatingAdd(servicePageItemWeight())
+}
+
+// bumpServiceHead moves the service head to the next queue in the ready ring. Returns the previous service head.
+func (m Module) bumpServiceHead(meter *primitives.WeightMeter) (sc.Option[MessageOrigin], error) {
+	if !meter.TryConsume(bumpServiceHeadWeight(m.dbWeight)) {
+		return sc.NewOption[MessageOrigin](nil), nil
+	}
+
+	if !m.storage.ServiceHead.Exists() {
+		return sc.NewOption[MessageOrigin](nil), nil
+	}
+	head, err := m.storage.ServiceHead.Get()
+	if err != nil {
+		return sc.NewOption[MessageOrigin](nil), err
+	}
+
+	headBookState, err := m.storage.BookStateFor.Get(head)
+	if err != nil {
+		return sc.NewOption[MessageOrigin](nil), err
+	}
+	if !headBookState.ReadyNeighbours.HasValue {
+		return sc.NewOption[MessageOrigin](nil), nil
+	}
+
+	m.storage.ServiceHead.Put(headBookState.ReadyNeighbours.Value.Next)
+
+	return sc.NewOption[MessageOrigin](head), nil
+}
+
+// serviceQueue services the pages of the queue of `origin`, while there is enough weight left in `meter`.
+// Returns whether any message was processed and the next queue in the ready ring.
+func (m Module) serviceQueue(origin MessageOrigin, meter *primitives.WeightMeter, overweightLimit primitives.Weight) (bool, sc.Option[MessageOrigin], error) {
+	if !meter.TryConsume(serviceQueueBaseWeight(m.dbWeight)) {
+		return false, sc.NewOption[MessageOrigin](nil), nil
+	}
+
+	bookState, err := m.storage.BookStateFor.Get(origin)
+	if err != nil {
+		return false, sc.NewOption[MessageOrigin](nil), err
+	}
+
+	totalProcessed := sc.U32(0)
+	for bookState.End > bookState.Begin {
+		processed, status, err := m.servicePage(origin, &bookState, meter, overweightLimit)
+		if err != nil {
+			return false, sc.NewOption[MessageOrigin](nil), err
+		}
+		totalProcessed += processed
+
+		if status != pageNoMore {
+			break
+		}
+		bookState.Begin++
+	}
+
+	next := sc.NewOption[MessageOrigin](nil)
+	if bookState.ReadyNeighbours.HasValue {
+		next = sc.NewOption[MessageOrigin](bookState.ReadyNeighbours.Value.Next)
+	}
+
+	if bookState.Begin >= bookState.End && bookState.ReadyNeighbours.HasValue {
+		// The queue is no longer ready.
+		neighbours := bookState.ReadyNeighbours.Value
+		bookState.ReadyNeighbours = sc.NewOption[Neighbours](nil)
+		if err := m.readyRingUnknit(origin, neighbours); err != nil {
+			return false, sc.NewOption[MessageOrigin](nil), err
+		}
+	}
+
+	m.storage.BookStateFor.Put(origin, bookState)
+
+	return totalProcessed > 0, next, nil
+}
+
+// servicePage services the messages of the first ready page of the queue of `origin`, while there is enough weight
+// left in `meter`. Returns the number of processed messages.
+func (m Module) servicePage(origin MessageOrigin, bookState *BookState, meter *primitives.WeightMeter, overweightLimit primitives.Weight) (sc.U32, pageExecutionStatus, error) {
+	if !meter.TryConsume(servicePageBaseWeight(m.dbWeight)) {
+		return 0, pageBailed, nil
+	}
+
+	key := pageKey{Origin: origin, Index: bookState.Begin}
+	if !m.storage.Pages.Exists(key) {
+		m.logger.Warnf("missing page [%d] of a ready queue", bookState.Begin)
+		return 0, pageNoMore, nil
+	}
+	page, err := m.storage.Pages.Get(key)
+	if err != nil {
+		return 0, pageNoMore, err
+	}
+
+	totalProcessed := sc.U32(0)
+	status := pageNoMore
+	for serviced := false; !serviced; {
+		itemStatus, err := m.servicePageItem(origin, bookState.Begin, bookState, &page, meter, overweightLimit)
+		if err != nil {
+			return totalProcessed, pageNoMore, err
+		}
+
+		switch itemStatus {
+		case itemProcessed:
+			totalProcessed++
+		case itemSkipped:
+		case itemNoItem:
+			status, serviced = pageNoMore, true
+		case itemNoProgress:
+			status, serviced = pageNoProgress, true
+		case itemBailed:
+			status, serviced = pageBailed, true
+		}
+	}
+
+	if page.isComplete() {
+		m.storage.Pages.Remove(key)
+		if bookState.Count > 0 {
+			bookState.Count--
+		}
+	} else {
+		m.storage.Pages.Put(key, page)
+	}
+
+	return totalProcessed, status, nil
+}
+
+// servicePageItem services the first message of `page`, which is not yet serviced.
+func (m Module) servicePageItem(origin MessageOrigin, pageIndex sc.U32, bookState *BookState, page *Page, meter *primitives.WeightMeter, overweightLimit primitives.Weight) (itemExecutionStatus, error) {
+	if page.isComplete() {
+		return itemNoItem, nil
+	}
+	if !meter.TryConsume(servicePageItemWeight()) {
+		return itemBailed, nil
+	}
+
+	payload := page.peekFirst()
+	if !payload.HasValue {
+		return itemNoItem, nil
+	}
+
+	status, err := m.processMessagePayload(origin, pageIndex, page.FirstIndex, payload.Value, meter, overweightLimit)
+	if err != nil {
+		return itemBailed, err
+	}
+
+	switch status {
+	case messageInsufficientWeight:
+		return itemBailed, nil
+	case messageTemporarilyUnprocessable:
+		return itemNoProgress, nil
+	case messageOverweight:
+		page.skipFirst(false)
+		return itemSkipped, nil
+	}
+
+	bookState.MessageCount = sc.SaturatingSubU64(bookState.MessageCount, 1)
+	bookState.Size = sc.SaturatingSubU64(bookState.Size, sc.U64(len(payload.Value)))
+	page.skipFirst(true)
+
+	return itemProcessed, nil
+}
+
+// processMessagePayload processes `message` with the MessageProcessor and deposits an event with the outcome.
+func (m Module) processMessagePayload(origin MessageOrigin, pageIndex sc.U32, messageIndex sc.U32, message sc.Sequence[sc.U8], meter *primitives.WeightMeter, overweightLimit primitives.Weight) (messageExecutionStatus, error) {
+	id, err := primitives.NewH256(sc.BytesToFixedSequenceU8(m.hashing.Blake256(sc.SequenceU8ToBytes(message)))...)
+	if err != nil {
+		return messageInsufficientWeight, err
+	}
+
+	prevConsumed := meter.Consumed
+	success, err := m.messageProcessor.ProcessMessage(message, origin, meter, &id)
+	if err == nil {
+		weightUsed := meter.Consumed.SaturatingSub(prevConsumed)
+		m.systemModule.DepositEvent(newEventProcessed(m.index, id, origin, weightUsed, sc.Bool(success)))
+		return messageProcessed, nil
+	}
+
+	processErr, ok := err.(ProcessMessageError)
+	if !ok {
+		m.logger.Warnf("failed to process message: [%s]", err.Error())
+		processErr = NewProcessMessageErrorCorrupt()
+	}
+
+	switch processErr.VaryingData[0] {
+	case ProcessMessageErrorOverweight:
+		required := processErr.VaryingData[1].(primitives.Weight)
+		if required.AnyGt(overweightLimit) {
+			m.systemModule.DepositEvent(newEventOverweightEnqueued(m.index, id, origin, pageIndex, messageIndex))
+			return messageOverweight, nil
+		}
+		return messageInsufficientWeight, nil
+	case ProcessMessageErrorYield:
+		return messageTemporarilyUnprocessable, nil
+	default:
+		m.systemModule.DepositEvent(newEventProcessingFailed(m.index, id, origin, processErr))
+		return messagePermanentlyUnprocessable, nil
+	}
+}
+
+// readyRingKnit inserts the queue of `origin` at the end of the ready ring. Returns its neighbours.
+func (m Module) readyRingKnit(origin MessageOrigin) (Neighbours, error) {
+	if !m.storage.ServiceHead.Exists() {
+		m.storage.ServiceHead.Put(origin)
+		return Neighbours{Prev: origin, Next: origin}, nil
+	}
+
+	head, err := m.storage.ServiceHead.Get()
+	if err != nil {
+		return Neighbours{}, err
+	}
+
+	headBookState, err := m.storage.BookStateFor.Get(head)
+	if err != nil {
+		return Neighbours{}, err
+	}
+	if !headBookState.ReadyNeighbours.HasValue {
+		return Neighbours{}, errServiceHeadNotReady
+	}
+	tail := headBookState.ReadyNeighbours.Value.Prev
+	headBookState.ReadyNeighbours.Value.Prev = origin
+	m.storage.BookStateFor.Put(head, headBookState)
+
+	tailBookState, err := m.storage.BookStateFor.Get(tail)
+	if err != nil {
+		return Neighbours{}, err
+	}
+	if !tailBookState.ReadyNeighbours.HasValue {
+		return Neighbours{}, errServiceHeadNotReady
+	}
+	tailBookState.ReadyNeighbours.Value.Next = origin
+	m.storage.BookStateFor.Put(tail, tailBookState)
+
+	return Neighbours{Prev: tail, Next: head}, nil
+}
+
+// readyRingUnknit removes the queue of `origin` with `neighbours` from the ready ring.
+func (m Module) readyRingUnknit(origin MessageOrigin, neighbours Neighbours) error {
+	if origin.Equal(neighbours.Next) {
+		// The queue is the only one in the ring.
+		m.storage.ServiceHead.Clear()
+		return nil
+	}
+
+	nextBookState, err := m.storage.BookStateFor.Get(neighbours.Next)
+	if err != nil {
+		return err
+	}
+	if nextBookState.ReadyNeighbours.HasValue {
+		nextBookState.ReadyNeighbours.Value.Prev = neighbours.Prev
+		m.storage.BookStateFor.Put(neighbours.Next, nextBookState)
+	}
+
+	prevBookState, err := m.storage.BookStateFor.Get(neighbours.Prev)
+	if err != nil {
+		return err
+	}
+	if prevBookState.ReadyNeighbours.HasValue {
+		prevBookState.ReadyNeighbours.Value.Next = neighbours.Next
+		m.storage.BookStateFor.Put(neighbours.Prev, prevBookState)
+	}
+
+	if m.storage.ServiceHead.Exists() {
+		head, err := m.storage.ServiceHead.Get()
+		if err != nil {
+			return err
+		}
+		if head.Equal(origin) {
+			m.storage.ServiceHead.Put(neighbours.Next)
+		}
+	}
+
+	return nil
+}
+
+// doReapPage removes page `pageIndex` of the queue of `origin`, if it has no unprocessed messages left,
+// or if it is stale and too old.
+func (m Module) doReapPage(origin MessageOrigin, pageIndex sc.U32) error {
+	bookState, err := m.storage.BookStateFor.Get(origin)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	// Pages, which are not serviced yet, cannot be reaped.
+	if pageIndex >= bookState.Begin {
+		return NewDispatchErrorNotReapable(m.index)
+	}
+
+	key := pageKey{Origin: origin, Index: pageIndex}
+	if !m.storage.Pages.Exists(key) {
+		return NewDispatchErrorNoPage(m.index)
+	}
+	page, err := m.storage.Pages.Get(key)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	if !page.isComplete() && !m.isCullable(bookState, pageIndex) {
+		return NewDispatchErrorNotReapable(m.index)
+	}
+
+	m.storage.Pages.Remove(key)
+	if bookState.Count > 0 {
+		bookState.Count--
+	}
+	bookState.MessageCount = sc.SaturatingSubU64(bookState.MessageCount, sc.U64(page.Remaining))
+	bookState.Size = sc.SaturatingSubU64(bookState.Size, sc.U64(page.RemainingSize))
+	m.storage.BookStateFor.Put(origin, bookState)
+
+	m.systemModule.DepositEvent(newEventPageReaped(m.index, origin, pageIndex))
+
+	return nil
+}
+
+// isCullable checks if page `pageIndex` is older than the stale pages allowed by MaxStale.
+func (m Module) isCullable(bookState BookState, pageIndex sc.U32) bool {
+	totalPages := bookState.Count
+	readyPages := bookState.End - bookState.Begin
+	if readyPages > totalPages {
+		readyPages = totalPages
+	}
+	stalePages := totalPages - readyPages
+
+	if stalePages <= m.maxStale {
+		return false
+	}
+	overflow := stalePages - m.maxStale
+
+	backlog := m.maxStale * m.maxStale / overflow
+	if backlog < m.maxStale {
+		backlog = m.maxStale
+	}
+
+	watermark := sc.U32(0)
+	if bookState.Begin > backlog {
+		watermark = bookState.Begin - backlog
+	}
+
+	return pageIndex < watermark
+}
+
+// doExecuteOverweight executes the overweight message at `index` of page `pageIndex` of the queue of `origin`,
+// using at most `weightLimit`. Returns the weight used.
+func (m Module) doExecuteOverweight(origin MessageOrigin, pageIndex sc.U32, index sc.U32, weightLimit primitives.Weight) (primitives.Weight, error) {
+	bookState, err := m.storage.BookStateFor.Get(origin)
+	if err != nil {
+		return primitives.WeightZero(), primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	key := pageKey{Origin: origin, Index: pageIndex}
+	if !m.storage.Pages.Exists(key) {
+		return primitives.WeightZero(), NewDispatchErrorNoPage(m.index)
+	}
+	page, err := m.storage.Pages.Get(key)
+	if err != nil {
+		return primitives.WeightZero(), primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	position, isProcessed, payload, ok := page.peekIndex(index)
+	if !ok {
+		return primitives.WeightZero(), NewDispatchErrorNoMessage(m.index)
+	}
+	// The message must have been serviced already.
+	if pageIndex > bookState.Begin || (pageIndex == bookState.Begin && position >= page.First) {
+		return primitives.WeightZero(), NewDispatchErrorQueued(m.index)
+	}
+	if isProcessed {
+		return primitives.WeightZero(), NewDispatchErrorAlreadyProcessed(m.index)
+	}
+
+	meter := primitives.NewWeightMeter(weightLimit)
+	status, err := m.processMessagePayload(origin, pageIndex, index, payload, &meter, maxWeight)
+	if err != nil {
+		return primitives.WeightZero(), primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	switch status {
+	case messageOverweight, messageInsufficientWeight:
+		return primitives.WeightZero(), NewDispatchErrorInsufficientWeight(m.index)
+	case messageTemporarilyUnprocessable:
+		return primitives.WeightZero(), NewDispatchErrorTemporarilyUnprocessable(m.index)
+	}
+
+	page.noteProcessedAtPos(position)
+	bookState.MessageCount = sc.SaturatingSubU64(bookState.MessageCount, 1)
+	bookState.Size = sc.SaturatingSubU64(bookState.Size, sc.U64(len(payload)))
+
+	pageWeight := executeOverweightPageUpdatedWeight(m.dbWeight)
+	if page.isComplete() {
+		m.storage.Pages.Remove(key)
+		if bookState.Count > 0 {
+			bookState.Count--
+		}
+		pageWeight = executeOverweightPageRemovedWeight(m.dbWeight)
+	} else {
+		m.storage.Pages.Put(key, page)
+	}
+	m.storage.BookStateFor.Put(origin, bookState)
+
+	return meter.Consumed.SaturatingAdd(pageWeight), nil
+}
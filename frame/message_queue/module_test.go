@@ -0,0 +1,528 @@
+package message_queue
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/parachain"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId = 35
+	heapSize = sc.U32(64)
+	maxStale = sc.U32(2)
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	serviceWeight        = primitives.WeightFromParts(1_000_000, 1_000_000)
+	idleMaxServiceWeight = primitives.WeightFromParts(2_000_000, 2_000_000)
+	messageWeight        = primitives.WeightFromParts(100, 10)
+
+	originParent  = NewMessageOriginParent()
+	originSibling = NewMessageOriginSibling(2000)
+	keySibling0   = pageKey{Origin: originSibling, Index: 0}
+
+	messageId, _ = primitives.NewH256(sc.BytesToSequenceU8(make([]byte, 32))...)
+
+	mdGenerator                           = primitives.NewMetadataTypeGenerator()
+	unknownTransactionNoUnsignedValidator = primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+)
+
+var (
+	mockStorage             *mocks.IoStorage
+	mockSystemModule        *mocks.SystemModule
+	mockHashing             *mocks.IoHashing
+	mockMessageProcessor    *MockMessageProcessor
+	mockStorageBookStateFor *mocks.StorageMap[MessageOrigin, BookState]
+	mockStorageServiceHead  *mocks.StorageValue[MessageOrigin]
+	mockStoragePages        *mocks.StorageMap[pageKey, Page]
+	mockCall                *mocks.Call
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, 2, len(target.Functions()))
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.NewTransactionSourceLocal(), mockCall)
+
+	assert.Equal(t, unknownTransactionNoUnsignedValidator, err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_MaxMessageLen(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, heapSize-itemHeaderSize, target.MaxMessageLen())
+}
+
+func Test_Module_OnInitialize_NoServiceWeight(t *testing.T) {
+	target := setupModule()
+	target.serviceWeight = sc.NewOption[primitives.Weight](nil)
+
+	result, err := target.OnInitialize(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.WeightZero(), result)
+	mockStorageServiceHead.AssertNotCalled(t, "Exists")
+}
+
+func Test_Module_OnInitialize_NoReadyQueues(t *testing.T) {
+	target := setupModule()
+	mockStorageServiceHead.On("Exists").Return(false)
+
+	result, err := target.OnInitialize(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, bumpServiceHeadWeight(dbWeight), result)
+}
+
+func Test_Module_OnIdle_NoIdleMaxServiceWeight(t *testing.T) {
+	target := setupModule()
+	target.idleMaxServiceWeight = sc.NewOption[primitives.Weight](nil)
+
+	result := target.OnIdle(1, serviceWeight)
+
+	assert.Equal(t, primitives.WeightZero(), result)
+	mockStorageServiceHead.AssertNotCalled(t, "Exists")
+}
+
+func Test_Module_OnIdle_NotEnoughRemainingWeight(t *testing.T) {
+	target := setupModule()
+
+	result := target.OnIdle(1, primitives.WeightZero())
+
+	assert.Equal(t, primitives.WeightZero(), result)
+	mockStorageServiceHead.AssertNotCalled(t, "Exists")
+}
+
+func Test_Module_EnqueueMessage_TooLong(t *testing.T) {
+	target := setupModule()
+
+	err := target.EnqueueMessage(make(sc.Sequence[sc.U8], heapSize), originSibling)
+
+	assert.NoError(t, err)
+	mockStorageBookStateFor.AssertNotCalled(t, "Get", mock.Anything)
+	mockStoragePages.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_EnqueueMessage_FirstReadyQueue(t *testing.T) {
+	target := setupModule()
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{}, nil)
+	mockStorageServiceHead.On("Exists").Return(false)
+
+	err := target.EnqueueMessage(messageA, originSibling)
+
+	assert.NoError(t, err)
+	mockStorageServiceHead.AssertCalled(t, "Put", originSibling)
+	mockStoragePages.AssertCalled(t, "Put", keySibling0, newPageFromMessage(messageA))
+	mockStorageBookStateFor.AssertCalled(t, "Put", originSibling, BookState{
+		Begin:           0,
+		End:             1,
+		Count:           1,
+		ReadyNeighbours: sc.NewOption[Neighbours](Neighbours{Prev: originSibling, Next: originSibling}),
+		MessageCount:    1,
+		Size:            3,
+	})
+}
+
+func Test_Module_EnqueueMessage_KnitsReadyRing(t *testing.T) {
+	target := setupModule()
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{}, nil)
+	mockStorageServiceHead.On("Exists").Return(true)
+	mockStorageServiceHead.On("Get").Return(originParent, nil)
+	mockStorageBookStateFor.On("Get", originParent).Return(BookState{
+		End:             1,
+		Count:           1,
+		ReadyNeighbours: sc.NewOption[Neighbours](Neighbours{Prev: originParent, Next: originParent}),
+	}, nil).Once()
+	mockStorageBookStateFor.On("Get", originParent).Return(BookState{
+		End:             1,
+		Count:           1,
+		ReadyNeighbours: sc.NewOption[Neighbours](Neighbours{Prev: originSibling, Next: originParent}),
+	}, nil).Once()
+
+	err := target.EnqueueMessage(messageA, originSibling)
+
+	assert.NoError(t, err)
+	mockStorageServiceHead.AssertNotCalled(t, "Put", mock.Anything)
+	mockStorageBookStateFor.AssertCalled(t, "Put", originParent, BookState{
+		End:             1,
+		Count:           1,
+		ReadyNeighbours: sc.NewOption[Neighbours](Neighbours{Prev: originSibling, Next: originSibling}),
+	})
+	mockStorageBookStateFor.AssertCalled(t, "Put", originSibling, BookState{
+		End:             1,
+		Count:           1,
+		ReadyNeighbours: sc.NewOption[Neighbours](Neighbours{Prev: originParent, Next: originParent}),
+		MessageCount:    1,
+		Size:            3,
+	})
+}
+
+func Test_Module_EnqueueMessage_AppendsToLastPage(t *testing.T) {
+	target := setupModule()
+	bookState := BookState{
+		End:             1,
+		Count:           1,
+		ReadyNeighbours: sc.NewOption[Neighbours](Neighbours{Prev: originSibling, Next: originSibling}),
+		MessageCount:    1,
+		Size:            3,
+	}
+	mockStorageBookStateFor.On("Get", originSibling).Return(bookState, nil)
+	mockStoragePages.On("Get", keySibling0).Return(newPageFromMessage(messageA), nil)
+
+	err := target.EnqueueMessage(messageB, originSibling)
+
+	assert.NoError(t, err)
+	expectPage := newPageFromMessage(messageA)
+	expectPage.appendMessage(messageB)
+	mockStoragePages.AssertCalled(t, "Put", keySibling0, expectPage)
+	bookState.MessageCount = 2
+	bookState.Size = 5
+	mockStorageBookStateFor.AssertCalled(t, "Put", originSibling, bookState)
+}
+
+func Test_Module_HandleDmpMessages(t *testing.T) {
+	target := setupModule()
+	mockStorageBookStateFor.On("Get", originParent).Return(BookState{}, nil)
+	mockStorageServiceHead.On("Exists").Return(false)
+
+	result := target.HandleDmpMessages(sc.Sequence[parachain.InboundDownwardMessage]{
+		{SentAt: 1, Msg: messageA},
+	}, serviceWeight)
+
+	assert.Equal(t, enqueueMessageWeight(dbWeight), result)
+	mockStoragePages.AssertCalled(t, "Put", pageKey{Origin: originParent, Index: 0}, newPageFromMessage(messageA))
+}
+
+func Test_Module_HandleXcmpMessages(t *testing.T) {
+	target := setupModule()
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{}, nil)
+	mockStorageServiceHead.On("Exists").Return(false)
+
+	result := target.HandleXcmpMessages(sc.Sequence[parachain.InboundXcmpMessage]{
+		{Sender: 2000, SentAt: 1, Data: messageA},
+	}, serviceWeight)
+
+	assert.Equal(t, enqueueMessageWeight(dbWeight), result)
+	mockStoragePages.AssertCalled(t, "Put", keySibling0, newPageFromMessage(messageA))
+}
+
+func Test_Module_serviceQueues_Processed(t *testing.T) {
+	target := setupModule()
+	setupReadySiblingQueue()
+	mockMessageProcessor.On("ProcessMessage", messageA, originSibling, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { args.Get(2).(*primitives.WeightMeter).Consume(messageWeight) }).
+		Return(true, nil)
+
+	result, err := target.serviceQueues(serviceWeight)
+
+	assert.NoError(t, err)
+	expectWeight := bumpServiceHeadWeight(dbWeight).
+		SaturatingAdd(serviceQueueBaseWeight(dbWeight)).
+		SaturatingAdd(servicePageBaseWeight(dbWeight)).
+		SaturatingAdd(servicePageItemWeight()).
+		SaturatingAdd(messageWeight).
+		SaturatingAdd(serviceQueueBaseWeight(dbWeight))
+	assert.Equal(t, expectWeight, result)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventProcessed(moduleId, messageId, originSibling, messageWeight, true))
+	mockStoragePages.AssertCalled(t, "Remove", keySibling0)
+	mockStorageServiceHead.AssertCalled(t, "Clear")
+	mockStorageBookStateFor.AssertCalled(t, "Put", originSibling, BookState{
+		Begin:           1,
+		End:             1,
+		ReadyNeighbours: sc.NewOption[Neighbours](nil),
+	})
+}
+
+func Test_Module_serviceQueues_ProcessingFailed(t *testing.T) {
+	target := setupModule()
+	setupReadySiblingQueue()
+	mockMessageProcessor.On("ProcessMessage", messageA, originSibling, mock.Anything, mock.Anything).
+		Return(false, NewProcessMessageErrorUnsupported())
+
+	_, err := target.serviceQueues(serviceWeight)
+
+	assert.NoError(t, err)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventProcessingFailed(moduleId, messageId, originSibling, NewProcessMessageErrorUnsupported()))
+	mockStoragePages.AssertCalled(t, "Remove", keySibling0)
+}
+
+func Test_Module_serviceQueues_Overweight(t *testing.T) {
+	target := setupModule()
+	setupReadySiblingQueue()
+	mockMessageProcessor.On("ProcessMessage", messageA, originSibling, mock.Anything, mock.Anything).
+		Return(false, NewProcessMessageErrorOverweight(idleMaxServiceWeight))
+
+	_, err := target.serviceQueues(serviceWeight)
+
+	assert.NoError(t, err)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventOverweightEnqueued(moduleId, messageId, originSibling, 0, 0))
+	expectPage := newPageFromMessage(messageA)
+	expectPage.skipFirst(false)
+	mockStoragePages.AssertCalled(t, "Put", keySibling0, expectPage)
+	mockStoragePages.AssertNotCalled(t, "Remove", keySibling0)
+	mockStorageBookStateFor.AssertCalled(t, "Put", originSibling, BookState{
+		Begin:           1,
+		End:             1,
+		Count:           1,
+		ReadyNeighbours: sc.NewOption[Neighbours](nil),
+		MessageCount:    1,
+		Size:            3,
+	})
+}
+
+func Test_Module_serviceQueues_InsufficientWeight(t *testing.T) {
+	target := setupModule()
+	setupReadySiblingQueue()
+	mockMessageProcessor.On("ProcessMessage", messageA, originSibling, mock.Anything, mock.Anything).
+		Return(false, NewProcessMessageErrorOverweight(messageWeight))
+
+	_, err := target.serviceQueues(serviceWeight)
+
+	assert.NoError(t, err)
+	mockSystemModule.AssertNotCalled(t, "DepositEvent", mock.Anything)
+	mockStoragePages.AssertCalled(t, "Put", keySibling0, newPageFromMessage(messageA))
+	mockStorageServiceHead.AssertNotCalled(t, "Clear")
+}
+
+func Test_Module_serviceQueues_Yield(t *testing.T) {
+	target := setupModule()
+	setupReadySiblingQueue()
+	mockMessageProcessor.On("ProcessMessage", messageA, originSibling, mock.Anything, mock.Anything).
+		Return(false, NewProcessMessageErrorYield())
+
+	_, err := target.serviceQueues(serviceWeight)
+
+	assert.NoError(t, err)
+	mockSystemModule.AssertNotCalled(t, "DepositEvent", mock.Anything)
+	mockStoragePages.AssertCalled(t, "Put", keySibling0, newPageFromMessage(messageA))
+	mockStorageServiceHead.AssertNotCalled(t, "Clear")
+}
+
+func Test_Module_doReapPage(t *testing.T) {
+	target := setupModule()
+	page := newPageFromMessage(messageA)
+	page.skipFirst(false)
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{Begin: 10, End: 10, Count: 5, MessageCount: 5, Size: 15}, nil)
+	mockStoragePages.On("Exists", keySibling0).Return(true)
+	mockStoragePages.On("Get", keySibling0).Return(page, nil)
+
+	err := target.doReapPage(originSibling, 0)
+
+	assert.NoError(t, err)
+	mockStoragePages.AssertCalled(t, "Remove", keySibling0)
+	mockStorageBookStateFor.AssertCalled(t, "Put", originSibling, BookState{Begin: 10, End: 10, Count: 4, MessageCount: 4, Size: 12})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventPageReaped(moduleId, originSibling, 0))
+}
+
+func Test_Module_doReapPage_NotServiced(t *testing.T) {
+	target := setupModule()
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{Begin: 0, End: 1, Count: 1}, nil)
+
+	err := target.doReapPage(originSibling, 0)
+
+	assert.Equal(t, NewDispatchErrorNotReapable(moduleId), err)
+}
+
+func Test_Module_doReapPage_NoPage(t *testing.T) {
+	target := setupModule()
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{Begin: 1, End: 1}, nil)
+	mockStoragePages.On("Exists", keySibling0).Return(false)
+
+	err := target.doReapPage(originSibling, 0)
+
+	assert.Equal(t, NewDispatchErrorNoPage(moduleId), err)
+}
+
+func Test_Module_doReapPage_NotStale(t *testing.T) {
+	target := setupModule()
+	page := newPageFromMessage(messageA)
+	page.skipFirst(false)
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{Begin: 1, End: 1, Count: 1, MessageCount: 1, Size: 3}, nil)
+	mockStoragePages.On("Exists", keySibling0).Return(true)
+	mockStoragePages.On("Get", keySibling0).Return(page, nil)
+
+	err := target.doReapPage(originSibling, 0)
+
+	assert.Equal(t, NewDispatchErrorNotReapable(moduleId), err)
+	mockStoragePages.AssertNotCalled(t, "Remove", keySibling0)
+}
+
+func Test_Module_isCullable(t *testing.T) {
+	target := setupModule()
+
+	assert.False(t, target.isCullable(BookState{Begin: 10, End: 10, Count: 2}, 0))
+	assert.True(t, target.isCullable(BookState{Begin: 10, End: 10, Count: 5}, 7))
+	assert.False(t, target.isCullable(BookState{Begin: 10, End: 10, Count: 5}, 8))
+}
+
+func Test_Module_doExecuteOverweight(t *testing.T) {
+	target := setupModule()
+	setupOverweightSiblingPage()
+	mockMessageProcessor.On("ProcessMessage", messageA, originSibling, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { args.Get(2).(*primitives.WeightMeter).Consume(messageWeight) }).
+		Return(true, nil)
+
+	result, err := target.doExecuteOverweight(originSibling, 0, 0, idleMaxServiceWeight)
+
+	assert.NoError(t, err)
+	assert.Equal(t, messageWeight.SaturatingAdd(executeOverweightPageRemovedWeight(dbWeight)), result)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventProcessed(moduleId, messageId, originSibling, messageWeight, true))
+	mockStoragePages.AssertCalled(t, "Remove", keySibling0)
+	mockStorageBookStateFor.AssertCalled(t, "Put", originSibling, BookState{Begin: 1, End: 1})
+}
+
+func Test_Module_doExecuteOverweight_NoPage(t *testing.T) {
+	target := setupModule()
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{Begin: 1, End: 1}, nil)
+	mockStoragePages.On("Exists", keySibling0).Return(false)
+
+	_, err := target.doExecuteOverweight(originSibling, 0, 0, idleMaxServiceWeight)
+
+	assert.Equal(t, NewDispatchErrorNoPage(moduleId), err)
+}
+
+func Test_Module_doExecuteOverweight_NoMessage(t *testing.T) {
+	target := setupModule()
+	setupOverweightSiblingPage()
+
+	_, err := target.doExecuteOverweight(originSibling, 0, 1, idleMaxServiceWeight)
+
+	assert.Equal(t, NewDispatchErrorNoMessage(moduleId), err)
+}
+
+func Test_Module_doExecuteOverweight_Queued(t *testing.T) {
+	target := setupModule()
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{Begin: 0, End: 1, Count: 1, MessageCount: 1, Size: 3}, nil)
+	mockStoragePages.On("Exists", keySibling0).Return(true)
+	mockStoragePages.On("Get", keySibling0).Return(newPageFromMessage(messageA), nil)
+
+	_, err := target.doExecuteOverweight(originSibling, 0, 0, idleMaxServiceWeight)
+
+	assert.Equal(t, NewDispatchErrorQueued(moduleId), err)
+}
+
+func Test_Module_doExecuteOverweight_AlreadyProcessed(t *testing.T) {
+	target := setupModule()
+	page := newPageFromMessage(messageA)
+	page.appendMessage(messageB)
+	page.skipFirst(true)
+	page.skipFirst(false)
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{Begin: 1, End: 1, Count: 1, MessageCount: 1, Size: 2}, nil)
+	mockStoragePages.On("Exists", keySibling0).Return(true)
+	mockStoragePages.On("Get", keySibling0).Return(page, nil)
+
+	_, err := target.doExecuteOverweight(originSibling, 0, 0, idleMaxServiceWeight)
+
+	assert.Equal(t, NewDispatchErrorAlreadyProcessed(moduleId), err)
+}
+
+func Test_Module_doExecuteOverweight_InsufficientWeight(t *testing.T) {
+	target := setupModule()
+	setupOverweightSiblingPage()
+	mockMessageProcessor.On("ProcessMessage", messageA, originSibling, mock.Anything, mock.Anything).
+		Return(false, NewProcessMessageErrorOverweight(idleMaxServiceWeight))
+
+	_, err := target.doExecuteOverweight(originSibling, 0, 0, messageWeight)
+
+	assert.Equal(t, NewDispatchErrorInsufficientWeight(moduleId), err)
+	mockStoragePages.AssertNotCalled(t, "Remove", keySibling0)
+	mockStoragePages.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+// setupReadySiblingQueue stores a queue of originSibling with a single page of messageA, which is the only one
+// in the ready ring.
+func setupReadySiblingQueue() {
+	bookState := BookState{
+		End:             1,
+		Count:           1,
+		ReadyNeighbours: sc.NewOption[Neighbours](Neighbours{Prev: originSibling, Next: originSibling}),
+		MessageCount:    1,
+		Size:            3,
+	}
+
+	mockStorageServiceHead.On("Exists").Return(true)
+	mockStorageServiceHead.On("Get").Return(originSibling, nil)
+	mockStorageBookStateFor.On("Get", originSibling).Return(bookState, nil).Twice()
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{Begin: 1, End: 1}, nil)
+	mockStoragePages.On("Exists", keySibling0).Return(true)
+	mockStoragePages.On("Get", keySibling0).Return(newPageFromMessage(messageA), nil)
+}
+
+// setupOverweightSiblingPage stores a serviced page of originSibling with the overweight messageA.
+func setupOverweightSiblingPage() {
+	page := newPageFromMessage(messageA)
+	page.skipFirst(false)
+
+	mockStorageBookStateFor.On("Get", originSibling).Return(BookState{Begin: 1, End: 1, Count: 1, MessageCount: 1, Size: 3}, nil)
+	mockStoragePages.On("Exists", keySibling0).Return(true)
+	mockStoragePages.On("Get", keySibling0).Return(page, nil)
+}
+
+func setupModule() Module {
+	mockStorage = new(mocks.IoStorage)
+	mockSystemModule = new(mocks.SystemModule)
+	mockHashing = new(mocks.IoHashing)
+	mockMessageProcessor = new(MockMessageProcessor)
+	mockStorageBookStateFor = new(mocks.StorageMap[MessageOrigin, BookState])
+	mockStorageServiceHead = new(mocks.StorageValue[MessageOrigin])
+	mockStoragePages = new(mocks.StorageMap[pageKey, Page])
+	mockCall = new(mocks.Call)
+
+	config := NewConfig(
+		mockStorage,
+		dbWeight,
+		mockSystemModule,
+		mockMessageProcessor,
+		heapSize,
+		maxStale,
+		sc.NewOption[primitives.Weight](serviceWeight),
+		sc.NewOption[primitives.Weight](idleMaxServiceWeight),
+	)
+
+	target := New(moduleId, config, mdGenerator, log.NewLogger())
+	target.storage.BookStateFor = mockStorageBookStateFor
+	target.storage.ServiceHead = mockStorageServiceHead
+	target.storage.Pages = mockStoragePages
+	target.hashing = mockHashing
+
+	mockStorageBookStateFor.On("Put", mock.Anything, mock.Anything).Return()
+	mockStorageServiceHead.On("Put", mock.Anything).Return()
+	mockStorageServiceHead.On("Clear").Return()
+	mockStoragePages.On("Put", mock.Anything, mock.Anything).Return()
+	mockStoragePages.On("Remove", mock.Anything).Return()
+	mockHashing.On("Blake256", mock.Anything).Return(messageId.Bytes())
+	mockSystemModule.On("DepositEvent", mock.Anything)
+
+	return target
+}
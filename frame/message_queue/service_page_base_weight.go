@@ -0,0 +1,9 @@
+package message_queue
+
+import primitives "github.com/LimeChain/gosemble/primitives/types"
+
+func servicePageBaseWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(5_000_000, 0).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
@@ -0,0 +1,7 @@
+package message_queue
+
+import primitives "github.com/LimeChain/gosemble/primitives/types"
+
+func servicePageItemWeight() primitives.Weight {
+	return primitives.WeightFromParts(200_000, 0)
+}
@@ -0,0 +1,33 @@
+package message_queue
+
+import (
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/io"
+)
+
+var (
+	keyMessageQueue = []byte("MessageQueue")
+	keyBookStateFor = []byte("BookStateFor")
+	keyServiceHead  = []byte("ServiceHead")
+	keyPages        = []byte("Pages")
+)
+
+var (
+	defaultBookState = BookState{}
+)
+
+type storage struct {
+	BookStateFor support.StorageMap[MessageOrigin, BookState]
+	ServiceHead  support.StorageValue[MessageOrigin]
+	Pages        support.StorageMap[pageKey, Page]
+}
+
+func newStorage(s io.Storage) *storage {
+	hashing := io.NewHashing()
+
+	return &storage{
+		BookStateFor: support.NewHashStorageMapWithDefault[MessageOrigin, BookState](s, keyMessageQueue, keyBookStateFor, hashing.Twox64, DecodeBookState, &defaultBookState),
+		ServiceHead:  support.NewHashStorageValue(s, keyMessageQueue, keyServiceHead, DecodeMessageOrigin),
+		Pages:        support.NewHashStorageMap[pageKey, Page](s, keyMessageQueue, keyPages, hashing.Twox64, DecodePage),
+	}
+}
@@ -0,0 +1,468 @@
+package message_queue
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// itemHeaderSize is the encoded size of the header, which precedes each message in the heap of a page.
+	itemHeaderSize = 5
+)
+
+var (
+	errInvalidMessageOriginType       = errors.New("invalid message_queue.MessageOrigin type")
+	errInvalidProcessMessageErrorType = errors.New("invalid message_queue.ProcessMessageError type")
+)
+
+const (
+	// MessageOriginHere is the origin of messages, which come from the local chain.
+	MessageOriginHere sc.U8 = iota
+	// MessageOriginParent is the origin of downward messages, which come from the relay chain.
+	MessageOriginParent
+	// MessageOriginSibling is the origin of horizontal messages, which come from a sibling parachain.
+	MessageOriginSibling
+)
+
+// MessageOrigin is the origin of a queue of messages.
+type MessageOrigin struct {
+	sc.VaryingData
+}
+
+func NewMessageOriginHere() MessageOrigin {
+	return MessageOrigin{sc.NewVaryingData(MessageOriginHere)}
+}
+
+func NewMessageOriginParent() MessageOrigin {
+	return MessageOrigin{sc.NewVaryingData(MessageOriginParent)}
+}
+
+func NewMessageOriginSibling(paraId sc.U32) MessageOrigin {
+	return MessageOrigin{sc.NewVaryingData(MessageOriginSibling, paraId)}
+}
+
+func DecodeMessageOrigin(buffer *bytes.Buffer) (MessageOrigin, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return MessageOrigin{}, err
+	}
+
+	switch b {
+	case MessageOriginHere:
+		return NewMessageOriginHere(), nil
+	case MessageOriginParent:
+		return NewMessageOriginParent(), nil
+	case MessageOriginSibling:
+		paraId, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return MessageOrigin{}, err
+		}
+		return NewMessageOriginSibling(paraId), nil
+	default:
+		return MessageOrigin{}, errInvalidMessageOriginType
+	}
+}
+
+func (mo MessageOrigin) Equal(other MessageOrigin) bool {
+	return bytes.Equal(mo.Bytes(), other.Bytes())
+}
+
+// Neighbours are the previous and the next queue in the ring of queues, which are ready to be serviced.
+type Neighbours struct {
+	Prev MessageOrigin
+	Next MessageOrigin
+}
+
+func (n Neighbours) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, n.Prev, n.Next)
+}
+
+func DecodeNeighbours(buffer *bytes.Buffer) (Neighbours, error) {
+	prev, err := DecodeMessageOrigin(buffer)
+	if err != nil {
+		return Neighbours{}, err
+	}
+	next, err := DecodeMessageOrigin(buffer)
+	if err != nil {
+		return Neighbours{}, err
+	}
+
+	return Neighbours{
+		Prev: prev,
+		Next: next,
+	}, nil
+}
+
+func (n Neighbours) Bytes() []byte {
+	return sc.EncodedBytes(n)
+}
+
+// BookState is the state of the queue of a message origin.
+type BookState struct {
+	// Begin is the index of the first page with unprocessed messages.
+	Begin sc.U32
+	// End is the index after the last page.
+	End sc.U32
+	// Count is the number of pages stored, including the ones with only overweight messages left.
+	Count sc.U32
+	// ReadyNeighbours are the neighbours of the queue in the ready ring, if it is ready to be serviced.
+	ReadyNeighbours sc.Option[Neighbours]
+	// MessageCount is the number of unprocessed messages in the queue.
+	MessageCount sc.U64
+	// Size is the total size of the unprocessed messages in the queue.
+	Size sc.U64
+}
+
+func (bs BookState) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, bs.Begin, bs.End, bs.Count, bs.ReadyNeighbours, bs.MessageCount, bs.Size)
+}
+
+func DecodeBookState(buffer *bytes.Buffer) (BookState, error) {
+	begin, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return BookState{}, err
+	}
+	end, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return BookState{}, err
+	}
+	count, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return BookState{}, err
+	}
+	readyNeighbours, err := sc.DecodeOptionWith(buffer, DecodeNeighbours)
+	if err != nil {
+		return BookState{}, err
+	}
+	messageCount, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return BookState{}, err
+	}
+	size, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return BookState{}, err
+	}
+
+	return BookState{
+		Begin:           begin,
+		End:             end,
+		Count:           count,
+		ReadyNeighbours: readyNeighbours,
+		MessageCount:    messageCount,
+		Size:            size,
+	}, nil
+}
+
+func (bs BookState) Bytes() []byte {
+	return sc.EncodedBytes(bs)
+}
+
+// Page is a page of messages. The messages are stored in its heap, each preceded by an item header, which holds
+// the length of the message and whether it was processed.
+type Page struct {
+	// Remaining is the number of unprocessed messages in the page.
+	Remaining sc.U32
+	// RemainingSize is the total size of the unprocessed messages in the page.
+	RemainingSize sc.U32
+	// FirstIndex is the index of the first message, which is not yet serviced.
+	FirstIndex sc.U32
+	// First is the position in the heap of the first message, which is not yet serviced.
+	First sc.U32
+	// Last is the position in the heap of the last message.
+	Last sc.U32
+	Heap sc.Sequence[sc.U8]
+}
+
+func newPageFromMessage(message sc.Sequence[sc.U8]) Page {
+	page := Page{Heap: sc.Sequence[sc.U8]{}}
+	page.appendMessage(message)
+	return page
+}
+
+func (p Page) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, p.Remaining, p.RemainingSize, p.FirstIndex, p.First, p.Last, p.Heap)
+}
+
+func DecodePage(buffer *bytes.Buffer) (Page, error) {
+	remaining, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return Page{}, err
+	}
+	remainingSize, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return Page{}, err
+	}
+	firstIndex, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return Page{}, err
+	}
+	first, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return Page{}, err
+	}
+	last, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return Page{}, err
+	}
+	heap, err := sc.DecodeSequence[sc.U8](buffer)
+	if err != nil {
+		return Page{}, err
+	}
+
+	return Page{
+		Remaining:     remaining,
+		RemainingSize: remainingSize,
+		FirstIndex:    firstIndex,
+		First:         first,
+		Last:          last,
+		Heap:          heap,
+	}, nil
+}
+
+func (p Page) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+// tryAppendMessage appends `message` to the page, if it fits in `heapSize`. Returns whether it was appended.
+func (p *Page) tryAppendMessage(message sc.Sequence[sc.U8], heapSize sc.U32) bool {
+	if len(p.Heap)+itemHeaderSize+len(message) > int(heapSize) {
+		return false
+	}
+
+	p.appendMessage(message)
+	return true
+}
+
+func (p *Page) appendMessage(message sc.Sequence[sc.U8]) {
+	position := sc.U32(len(p.Heap))
+
+	header := itemHeader{PayloadLen: sc.U32(len(message)), IsProcessed: false}
+	p.Heap = append(p.Heap, sc.BytesToSequenceU8(header.Bytes())...)
+	p.Heap = append(p.Heap, message...)
+
+	p.Remaining++
+	p.RemainingSize += sc.U32(len(message))
+	p.Last = position
+}
+
+// peekFirst returns the first message, which is not yet serviced.
+func (p Page) peekFirst() sc.Option[sc.Sequence[sc.U8]] {
+	if p.First > p.Last {
+		return sc.NewOption[sc.Sequence[sc.U8]](nil)
+	}
+
+	header, ok := p.header(p.First)
+	if !ok {
+		return sc.NewOption[sc.Sequence[sc.U8]](nil)
+	}
+
+	start := int(p.First) + itemHeaderSize
+	return sc.NewOption[sc.Sequence[sc.U8]](p.Heap[start : start+int(header.PayloadLen)])
+}
+
+// skipFirst moves past the first message, which is not yet serviced, and marks it as processed if `isProcessed`.
+func (p *Page) skipFirst(isProcessed bool) {
+	header, ok := p.header(p.First)
+	if !ok {
+		return
+	}
+
+	if isProcessed {
+		p.noteProcessedAtPos(p.First)
+	}
+
+	p.First += itemHeaderSize + header.PayloadLen
+	p.FirstIndex++
+}
+
+// peekIndex returns the position in the heap, whether it was processed and the payload of the message at `index`.
+func (p Page) peekIndex(index sc.U32) (sc.U32, bool, sc.Sequence[sc.U8], bool) {
+	position := sc.U32(0)
+	for i := sc.U32(0); i < index; i++ {
+		header, ok := p.header(position)
+		if !ok {
+			return 0, false, nil, false
+		}
+		position += itemHeaderSize + header.PayloadLen
+	}
+
+	header, ok := p.header(position)
+	if !ok {
+		return 0, false, nil, false
+	}
+
+	start := int(position) + itemHeaderSize
+	return position, bool(header.IsProcessed), p.Heap[start : start+int(header.PayloadLen)], true
+}
+
+// noteProcessedAtPos marks the message at heap position `position` as processed.
+func (p *Page) noteProcessedAtPos(position sc.U32) {
+	header, ok := p.header(position)
+	if !ok || header.IsProcessed {
+		return
+	}
+
+	p.Heap[position+itemHeaderSize-1] = 1
+	p.Remaining--
+	p.RemainingSize -= header.PayloadLen
+}
+
+// isComplete checks if all messages in the page are processed.
+func (p Page) isComplete() bool {
+	return p.Remaining == 0
+}
+
+// header returns the header of the message at heap position `position`, if a whole message is stored there.
+func (p Page) header(position sc.U32) (itemHeader, bool) {
+	if int(position)+itemHeaderSize > len(p.Heap) {
+		return itemHeader{}, false
+	}
+
+	header, err := decodeItemHeader(bytes.NewBuffer(sc.SequenceU8ToBytes(p.Heap[position : position+itemHeaderSize])))
+	if err != nil {
+		return itemHeader{}, false
+	}
+	if int(position)+itemHeaderSize+int(header.PayloadLen) > len(p.Heap) {
+		return itemHeader{}, false
+	}
+
+	return header, true
+}
+
+// itemHeader precedes each message in the heap of a page.
+type itemHeader struct {
+	PayloadLen  sc.U32
+	IsProcessed sc.Bool
+}
+
+func (ih itemHeader) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, ih.PayloadLen, ih.IsProcessed)
+}
+
+func decodeItemHeader(buffer *bytes.Buffer) (itemHeader, error) {
+	payloadLen, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return itemHeader{}, err
+	}
+	isProcessed, err := sc.DecodeBool(buffer)
+	if err != nil {
+		return itemHeader{}, err
+	}
+
+	return itemHeader{
+		PayloadLen:  payloadLen,
+		IsProcessed: isProcessed,
+	}, nil
+}
+
+func (ih itemHeader) Bytes() []byte {
+	return sc.EncodedBytes(ih)
+}
+
+// pageKey is the key of a page in the queue of a message origin.
+type pageKey struct {
+	Origin MessageOrigin
+	Index  sc.U32
+}
+
+func (pk pageKey) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, pk.Origin, pk.Index)
+}
+
+func (pk pageKey) Bytes() []byte {
+	return sc.EncodedBytes(pk)
+}
+
+const (
+	// ProcessMessageErrorBadFormat means that the message data format is unknown.
+	ProcessMessageErrorBadFormat sc.U8 = iota
+	// ProcessMessageErrorCorrupt means that the message data is invalid.
+	ProcessMessageErrorCorrupt
+	// ProcessMessageErrorUnsupported means that the message is valid, but it cannot be processed.
+	ProcessMessageErrorUnsupported
+	// ProcessMessageErrorOverweight means that the message needs more weight than available. It holds the required weight.
+	ProcessMessageErrorOverweight
+	// ProcessMessageErrorYield means that the message cannot be processed now and the queue should be serviced later.
+	ProcessMessageErrorYield
+	// ProcessMessageErrorStackLimitReached means that the message processing exceeded the stack limit.
+	ProcessMessageErrorStackLimitReached
+)
+
+// ProcessMessageError is the reason a message could not be processed.
+type ProcessMessageError struct {
+	sc.VaryingData
+}
+
+func NewProcessMessageErrorBadFormat() ProcessMessageError {
+	return ProcessMessageError{sc.NewVaryingData(ProcessMessageErrorBadFormat)}
+}
+
+func NewProcessMessageErrorCorrupt() ProcessMessageError {
+	return ProcessMessageError{sc.NewVaryingData(ProcessMessageErrorCorrupt)}
+}
+
+func NewProcessMessageErrorUnsupported() ProcessMessageError {
+	return ProcessMessageError{sc.NewVaryingData(ProcessMessageErrorUnsupported)}
+}
+
+func NewProcessMessageErrorOverweight(required primitives.Weight) ProcessMessageError {
+	return ProcessMessageError{sc.NewVaryingData(ProcessMessageErrorOverweight, required)}
+}
+
+func NewProcessMessageErrorYield() ProcessMessageError {
+	return ProcessMessageError{sc.NewVaryingData(ProcessMessageErrorYield)}
+}
+
+func NewProcessMessageErrorStackLimitReached() ProcessMessageError {
+	return ProcessMessageError{sc.NewVaryingData(ProcessMessageErrorStackLimitReached)}
+}
+
+func DecodeProcessMessageError(buffer *bytes.Buffer) (ProcessMessageError, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return ProcessMessageError{}, err
+	}
+
+	switch b {
+	case ProcessMessageErrorBadFormat:
+		return NewProcessMessageErrorBadFormat(), nil
+	case ProcessMessageErrorCorrupt:
+		return NewProcessMessageErrorCorrupt(), nil
+	case ProcessMessageErrorUnsupported:
+		return NewProcessMessageErrorUnsupported(), nil
+	case ProcessMessageErrorOverweight:
+		required, err := primitives.DecodeWeight(buffer)
+		if err != nil {
+			return ProcessMessageError{}, err
+		}
+		return NewProcessMessageErrorOverweight(required), nil
+	case ProcessMessageErrorYield:
+		return NewProcessMessageErrorYield(), nil
+	case ProcessMessageErrorStackLimitReached:
+		return NewProcessMessageErrorStackLimitReached(), nil
+	default:
+		return ProcessMessageError{}, errInvalidProcessMessageErrorType
+	}
+}
+
+func (pme ProcessMessageError) Error() string {
+	switch pme.VaryingData[0] {
+	case ProcessMessageErrorBadFormat:
+		return "bad message format"
+	case ProcessMessageErrorCorrupt:
+		return "corrupt message"
+	case ProcessMessageErrorUnsupported:
+		return "unsupported message"
+	case ProcessMessageErrorOverweight:
+		return "message is overweight"
+	case ProcessMessageErrorYield:
+		return "message processing yielded"
+	case ProcessMessageErrorStackLimitReached:
+		return "stack limit reached"
+	default:
+		return errInvalidProcessMessageErrorType.Error()
+	}
+}
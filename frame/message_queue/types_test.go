@@ -0,0 +1,175 @@
+package message_queue
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	messageA = sc.Sequence[sc.U8]{1, 2, 3}
+	messageB = sc.Sequence[sc.U8]{4, 5}
+
+	expectedOriginSiblingBytes, _ = hex.DecodeString("02d0070000")
+	expectedPageBytes, _          = hex.DecodeString("01000000" + "03000000" + "00000000" + "00000000" + "00000000" + "20" + "0300000000010203")
+)
+
+func Test_MessageOrigin_Encode(t *testing.T) {
+	origin := NewMessageOriginSibling(2000)
+
+	buffer := &bytes.Buffer{}
+	err := origin.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedOriginSiblingBytes, buffer.Bytes())
+	assert.Equal(t, []byte{0}, NewMessageOriginHere().Bytes())
+	assert.Equal(t, []byte{1}, NewMessageOriginParent().Bytes())
+}
+
+func Test_DecodeMessageOrigin(t *testing.T) {
+	for _, origin := range []MessageOrigin{
+		NewMessageOriginHere(),
+		NewMessageOriginParent(),
+		NewMessageOriginSibling(2000),
+	} {
+		result, err := DecodeMessageOrigin(bytes.NewBuffer(origin.Bytes()))
+
+		assert.NoError(t, err)
+		assert.Equal(t, origin, result)
+	}
+}
+
+func Test_DecodeMessageOrigin_InvalidType(t *testing.T) {
+	_, err := DecodeMessageOrigin(bytes.NewBuffer([]byte{3}))
+
+	assert.Equal(t, errInvalidMessageOriginType, err)
+}
+
+func Test_MessageOrigin_Equal(t *testing.T) {
+	assert.True(t, NewMessageOriginSibling(2000).Equal(NewMessageOriginSibling(2000)))
+	assert.False(t, NewMessageOriginSibling(2000).Equal(NewMessageOriginSibling(2001)))
+	assert.False(t, NewMessageOriginParent().Equal(NewMessageOriginHere()))
+}
+
+func Test_DecodeBookState(t *testing.T) {
+	bookState := BookState{
+		Begin:           1,
+		End:             3,
+		Count:           2,
+		ReadyNeighbours: sc.NewOption[Neighbours](Neighbours{Prev: NewMessageOriginParent(), Next: NewMessageOriginSibling(2000)}),
+		MessageCount:    5,
+		Size:            100,
+	}
+
+	result, err := DecodeBookState(bytes.NewBuffer(bookState.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, bookState, result)
+}
+
+func Test_Page_Encode(t *testing.T) {
+	page := newPageFromMessage(messageA)
+
+	buffer := &bytes.Buffer{}
+	err := page.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedPageBytes, buffer.Bytes())
+	assert.Equal(t, expectedPageBytes, page.Bytes())
+}
+
+func Test_DecodePage(t *testing.T) {
+	result, err := DecodePage(bytes.NewBuffer(expectedPageBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, newPageFromMessage(messageA), result)
+}
+
+func Test_Page_TryAppendMessage(t *testing.T) {
+	page := newPageFromMessage(messageA)
+
+	assert.True(t, page.tryAppendMessage(messageB, 15))
+	assert.Equal(t, sc.U32(2), page.Remaining)
+	assert.Equal(t, sc.U32(5), page.RemainingSize)
+	assert.Equal(t, sc.U32(8), page.Last)
+
+	assert.False(t, page.tryAppendMessage(messageB, 15))
+	assert.Equal(t, sc.U32(2), page.Remaining)
+}
+
+func Test_Page_PeekFirst_SkipFirst(t *testing.T) {
+	page := newPageFromMessage(messageA)
+	page.appendMessage(messageB)
+
+	assert.Equal(t, sc.NewOption[sc.Sequence[sc.U8]](messageA), page.peekFirst())
+
+	page.skipFirst(false)
+
+	assert.Equal(t, sc.NewOption[sc.Sequence[sc.U8]](messageB), page.peekFirst())
+	assert.Equal(t, sc.U32(1), page.FirstIndex)
+	assert.Equal(t, sc.U32(2), page.Remaining)
+
+	page.skipFirst(true)
+
+	assert.Equal(t, sc.NewOption[sc.Sequence[sc.U8]](nil), page.peekFirst())
+	assert.Equal(t, sc.U32(2), page.FirstIndex)
+	assert.Equal(t, sc.U32(1), page.Remaining)
+	assert.Equal(t, sc.U32(3), page.RemainingSize)
+	assert.False(t, page.isComplete())
+}
+
+func Test_Page_PeekIndex(t *testing.T) {
+	page := newPageFromMessage(messageA)
+	page.appendMessage(messageB)
+
+	position, isProcessed, payload, ok := page.peekIndex(1)
+
+	assert.True(t, ok)
+	assert.Equal(t, sc.U32(8), position)
+	assert.False(t, isProcessed)
+	assert.Equal(t, messageB, payload)
+
+	_, _, _, ok = page.peekIndex(2)
+
+	assert.False(t, ok)
+}
+
+func Test_Page_NoteProcessedAtPos(t *testing.T) {
+	page := newPageFromMessage(messageA)
+
+	page.noteProcessedAtPos(0)
+	page.noteProcessedAtPos(0)
+
+	_, isProcessed, _, ok := page.peekIndex(0)
+	assert.True(t, ok)
+	assert.True(t, isProcessed)
+	assert.Equal(t, sc.U32(0), page.Remaining)
+	assert.Equal(t, sc.U32(0), page.RemainingSize)
+	assert.True(t, page.isComplete())
+}
+
+func Test_DecodeProcessMessageError(t *testing.T) {
+	for _, processErr := range []ProcessMessageError{
+		NewProcessMessageErrorBadFormat(),
+		NewProcessMessageErrorCorrupt(),
+		NewProcessMessageErrorUnsupported(),
+		NewProcessMessageErrorOverweight(primitives.WeightFromParts(1, 2)),
+		NewProcessMessageErrorYield(),
+		NewProcessMessageErrorStackLimitReached(),
+	} {
+		result, err := DecodeProcessMessageError(bytes.NewBuffer(processErr.Bytes()))
+
+		assert.NoError(t, err)
+		assert.Equal(t, processErr, result)
+	}
+}
+
+func Test_DecodeProcessMessageError_InvalidType(t *testing.T) {
+	_, err := DecodeProcessMessageError(bytes.NewBuffer([]byte{6}))
+
+	assert.Equal(t, errInvalidProcessMessageErrorType, err)
+}
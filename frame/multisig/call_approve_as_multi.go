@@ -0,0 +1,111 @@
+package multisig
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callApproveAsMulti registers an approval for a call, which is known only by its hash.
+type callApproveAsMulti struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallApproveAsMulti(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callApproveAsMulti{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U16(0), sc.Sequence[primitives.AccountId]{}, sc.NewOption[Timepoint](nil), primitives.H256{}, primitives.WeightZero()),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callApproveAsMulti) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	threshold, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return nil, err
+	}
+	otherSignatories, err := decodeOtherSignatories(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maybeTimepoint, err := sc.DecodeOptionWith(buffer, DecodeTimepoint)
+	if err != nil {
+		return nil, err
+	}
+	callHash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maxWeight, err := primitives.DecodeWeight(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(threshold, otherSignatories, maybeTimepoint, callHash, maxWeight)
+
+	return c, nil
+}
+
+func (c callApproveAsMulti) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callApproveAsMulti) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callApproveAsMulti) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callApproveAsMulti) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callApproveAsMulti) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callApproveAsMulti) BaseWeight() primitives.Weight {
+	size := sc.U64(len(c.Args()[1].(sc.Sequence[primitives.AccountId])))
+
+	return callApproveAsMultiCreateWeight(c.dbWeight, size).
+		Max(callApproveAsMultiApproveWeight(c.dbWeight, size))
+}
+
+func (_ callApproveAsMulti) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callApproveAsMulti) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callApproveAsMulti) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callApproveAsMulti) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	threshold := args[0].(sc.U16)
+	otherSignatories := args[1].(sc.Sequence[primitives.AccountId])
+	maybeTimepoint := args[2].(sc.Option[Timepoint])
+	callHash := args[3].(primitives.H256)
+	maxWeight := args[4].(primitives.Weight)
+
+	return c.module.operate(who.Value, threshold, otherSignatories, maybeTimepoint, nil, callHash, maxWeight)
+}
+
+func (_ callApproveAsMulti) Docs() string {
+	return "Register approval for a dispatch to be made from a deterministic composite account if " +
+		"approved by a total of `threshold - 1` of `other_signatories`. " +
+		"Payment: `DepositBase` will be reserved if this is the first approval, plus " +
+		"`threshold` times `DepositFactor`. It is returned once this dispatch happens or is cancelled. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"NOTE: If this is the final approval, you will want to use `as_multi` instead."
+}
@@ -0,0 +1,13 @@
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callApproveAsMultiApproveWeight(dbWeight primitives.RuntimeDbWeight, signatories sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(24016000, 0).
+		SaturatingAdd(primitives.WeightFromParts(126431, 0).SaturatingMul(signatories)).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
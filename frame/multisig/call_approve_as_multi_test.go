@@ -0,0 +1,102 @@
+package multisig
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_ApproveAsMulti_DecodeArgs(t *testing.T) {
+	target := setupCallApproveAsMulti()
+	maybeTimepoint := sc.NewOption[Timepoint](timepoint)
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(threshold.Bytes())
+	buffer.Write(otherSignatories.Bytes())
+	buffer.Write(maybeTimepoint.Bytes())
+	buffer.Write(callHash.Bytes())
+	buffer.Write(maxWeight.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(threshold, otherSignatories, maybeTimepoint, callHash, maxWeight), result.Args())
+}
+
+func Test_Call_ApproveAsMulti_BaseWeight(t *testing.T) {
+	target := setupCallApproveAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](nil), callHash, maxWeight)
+
+	assert.Equal(t, callApproveAsMultiCreateWeight(dbWeight, 2), target.BaseWeight())
+}
+
+func Test_Call_ApproveAsMulti_Dispatch_Create(t *testing.T) {
+	target := setupCallApproveAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](nil), callHash, maxWeight)
+	expectMultiAccountId(threshold)
+	expected := Multisig{When: timepoint, Deposit: deposit, Depositor: who, Approvals: sc.Sequence[primitives.AccountId]{who}}
+	mockStorageMultisigs.On("Exists", key).Return(false)
+	mockCurrency.On("Reserve", who, deposit).Return(nil)
+	mockSystemModule.On("StorageBlockNumber").Return(timepoint.Height, nil)
+	mockSystemModule.On("StorageExtrinsicIndex").Return(timepoint.Index, nil)
+	mockStorageMultisigs.On("Put", key, expected).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, postDispatchInfo(callApproveAsMultiCreateWeight(dbWeight, 2)), result)
+	mockStorageMultisigs.AssertCalled(t, "Put", key, expected)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventNewMultisig(moduleId, who, multiAccountId, callHash))
+}
+
+func Test_Call_ApproveAsMulti_Dispatch_Approve(t *testing.T) {
+	target := setupCallApproveAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](timepoint), callHash, maxWeight)
+	expectMultiAccountId(threshold)
+	stored := Multisig{When: timepoint, Deposit: deposit, Depositor: constants.ZeroAccountId, Approvals: sc.Sequence[primitives.AccountId]{constants.ZeroAccountId}}
+	expected := stored
+	expected.Approvals = sc.Sequence[primitives.AccountId]{constants.ZeroAccountId, who}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(stored, nil)
+	mockStorageMultisigs.On("Put", key, expected).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, postDispatchInfo(callApproveAsMultiApproveWeight(dbWeight, 2)), result)
+	mockStorageMultisigs.AssertCalled(t, "Put", key, expected)
+	mockStorageMultisigs.AssertNotCalled(t, "Remove", mock.Anything)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventMultisigApproval(moduleId, who, timepoint, multiAccountId, callHash))
+}
+
+func Test_Call_ApproveAsMulti_Dispatch_AlreadyApproved(t *testing.T) {
+	target := setupCallApproveAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](timepoint), callHash, maxWeight)
+	expectMultiAccountId(threshold)
+	stored := Multisig{When: timepoint, Deposit: deposit, Depositor: who, Approvals: sc.Sequence[primitives.AccountId]{who}}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(stored, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorAlreadyApproved(moduleId), err)
+	mockStorageMultisigs.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Call_ApproveAsMulti_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallApproveAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](nil), callHash, maxWeight)
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallApproveAsMulti() callApproveAsMulti {
+	return newCallApproveAsMulti(moduleId, functionApproveAsMulti, dbWeight, setupModule()).(callApproveAsMulti)
+}
@@ -0,0 +1,125 @@
+package multisig
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callAsMulti registers an approval for a call and dispatches it, once the threshold of approvals is reached.
+type callAsMulti struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallAsMulti(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callAsMulti{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U16(0), sc.Sequence[primitives.AccountId]{}, sc.NewOption[Timepoint](nil)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callAsMulti) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	threshold, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return nil, err
+	}
+	otherSignatories, err := decodeOtherSignatories(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maybeTimepoint, err := sc.DecodeOptionWith(buffer, DecodeTimepoint)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decodeCallFunc(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maxWeight, err := primitives.DecodeWeight(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(threshold, otherSignatories, maybeTimepoint, call, maxWeight)
+
+	return c, nil
+}
+
+func (c callAsMulti) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	c.module.logger.Critical("not implemented")
+	return nil, nil
+}
+
+func (c callAsMulti) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callAsMulti) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callAsMulti) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callAsMulti) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callAsMulti) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callAsMulti) BaseWeight() primitives.Weight {
+	size := sc.U64(len(c.Args()[1].(sc.Sequence[primitives.AccountId])))
+	callSize := sc.U64(len(c.Args()[3].(primitives.Call).Bytes()))
+	maxWeight := c.Args()[4].(primitives.Weight)
+
+	return callAsMultiCreateWeight(c.dbWeight, size, callSize).
+		Max(callAsMultiApproveWeight(c.dbWeight, size, callSize)).
+		Max(callAsMultiCompleteWeight(c.dbWeight, size, callSize)).
+		SaturatingAdd(maxWeight)
+}
+
+func (_ callAsMulti) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callAsMulti) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callAsMulti) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callAsMulti) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	threshold := args[0].(sc.U16)
+	otherSignatories := args[1].(sc.Sequence[primitives.AccountId])
+	maybeTimepoint := args[2].(sc.Option[Timepoint])
+	call := args[3].(primitives.Call)
+	maxWeight := args[4].(primitives.Weight)
+
+	callHash, err := c.module.callHash(call)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	return c.module.operate(who.Value, threshold, otherSignatories, maybeTimepoint, call, callHash, maxWeight)
+}
+
+func (_ callAsMulti) Docs() string {
+	return "Register approval for a dispatch to be made from a deterministic composite account if " +
+		"approved by a total of `threshold - 1` of `other_signatories`. " +
+		"If there are enough approvals, then dispatch the call. " +
+		"Payment: `DepositBase` will be reserved if this is the first approval, plus " +
+		"`threshold` times `DepositFactor`. It is returned once this dispatch happens or is cancelled. " +
+		"The dispatch origin for this call must be `Signed`."
+}
@@ -0,0 +1,14 @@
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callAsMultiApproveWeight(dbWeight primitives.RuntimeDbWeight, signatories sc.U64, callSize sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(27749000, 0).
+		SaturatingAdd(primitives.WeightFromParts(128935, 0).SaturatingMul(signatories)).
+		SaturatingAdd(primitives.WeightFromParts(1568, 0).SaturatingMul(callSize)).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
@@ -0,0 +1,14 @@
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callAsMultiCompleteWeight(dbWeight primitives.RuntimeDbWeight, signatories sc.U64, callSize sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(46823000, 0).
+		SaturatingAdd(primitives.WeightFromParts(152087, 0).SaturatingMul(signatories)).
+		SaturatingAdd(primitives.WeightFromParts(1571, 0).SaturatingMul(callSize)).
+		SaturatingAdd(dbWeight.Reads(2)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
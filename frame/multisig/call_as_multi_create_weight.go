@@ -0,0 +1,14 @@
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callAsMultiCreateWeight(dbWeight primitives.RuntimeDbWeight, signatories sc.U64, callSize sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(42489000, 0).
+		SaturatingAdd(primitives.WeightFromParts(127329, 0).SaturatingMul(signatories)).
+		SaturatingAdd(primitives.WeightFromParts(1532, 0).SaturatingMul(callSize)).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
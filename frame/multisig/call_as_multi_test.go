@@ -0,0 +1,244 @@
+package multisig
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	threshold = sc.U16(2)
+	maxWeight = primitives.WeightFromParts(1_000, 0)
+	deposit   = sc.NewU128(14)
+)
+
+func Test_Call_AsMulti_DecodeNestedArgs(t *testing.T) {
+	target := setupCallAsMulti()
+	maybeTimepoint := sc.NewOption[Timepoint](timepoint)
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(threshold.Bytes())
+	buffer.Write(otherSignatories.Bytes())
+	buffer.Write(maybeTimepoint.Bytes())
+	buffer.Write(maxWeight.Bytes())
+
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(threshold, otherSignatories, maybeTimepoint, mockCall, maxWeight), result.Args())
+}
+
+func Test_Call_AsMulti_BaseWeight(t *testing.T) {
+	target := setupCallAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](nil), mockCall, maxWeight)
+	mockCall.On("Bytes").Return(callBytes)
+
+	expect := callAsMultiCompleteWeight(dbWeight, 2, 3).Add(maxWeight)
+	assert.Equal(t, expect, target.BaseWeight())
+}
+
+func Test_Call_AsMulti_Dispatch_Create(t *testing.T) {
+	target := setupCallAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](nil), mockCall, maxWeight)
+	expectCallHash()
+	expectMultiAccountId(threshold)
+	expected := Multisig{When: timepoint, Deposit: deposit, Depositor: who, Approvals: sc.Sequence[primitives.AccountId]{who}}
+	mockStorageMultisigs.On("Exists", key).Return(false)
+	mockCurrency.On("Reserve", who, deposit).Return(nil)
+	mockSystemModule.On("StorageBlockNumber").Return(timepoint.Height, nil)
+	mockSystemModule.On("StorageExtrinsicIndex").Return(timepoint.Index, nil)
+	mockStorageMultisigs.On("Put", key, expected).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, postDispatchInfo(callAsMultiCreateWeight(dbWeight, 2, 3)), result)
+	mockCurrency.AssertCalled(t, "Reserve", who, deposit)
+	mockStorageMultisigs.AssertCalled(t, "Put", key, expected)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventNewMultisig(moduleId, who, multiAccountId, callHash))
+}
+
+func Test_Call_AsMulti_Dispatch_Create_UnexpectedTimepoint(t *testing.T) {
+	target := setupCallAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](timepoint), mockCall, maxWeight)
+	expectCallHash()
+	expectMultiAccountId(threshold)
+	mockStorageMultisigs.On("Exists", key).Return(false)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorUnexpectedTimepoint(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func Test_Call_AsMulti_Dispatch_Create_CannotReserve(t *testing.T) {
+	target := setupCallAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](nil), mockCall, maxWeight)
+	expectCallHash()
+	expectMultiAccountId(threshold)
+	mockStorageMultisigs.On("Exists", key).Return(false)
+	mockCurrency.On("Reserve", who, deposit).Return(dispatchErrOther)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, dispatchErrOther, err)
+	mockStorageMultisigs.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Call_AsMulti_Dispatch_Approve(t *testing.T) {
+	target := setupCallAsMulti()
+	threshold := sc.U16(3)
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](timepoint), mockCall, maxWeight)
+	expectCallHash()
+	expectMultiAccountId(threshold)
+	stored := Multisig{When: timepoint, Deposit: deposit, Depositor: constants.TwoAccountId, Approvals: sc.Sequence[primitives.AccountId]{constants.TwoAccountId}}
+	expected := stored
+	expected.Approvals = sc.Sequence[primitives.AccountId]{who, constants.TwoAccountId}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(stored, nil)
+	mockStorageMultisigs.On("Put", key, expected).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, postDispatchInfo(callAsMultiApproveWeight(dbWeight, 2, 3)), result)
+	mockStorageMultisigs.AssertCalled(t, "Put", key, expected)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventMultisigApproval(moduleId, who, timepoint, multiAccountId, callHash))
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func Test_Call_AsMulti_Dispatch_AlreadyApproved(t *testing.T) {
+	target := setupCallAsMulti()
+	threshold := sc.U16(3)
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](timepoint), mockCall, maxWeight)
+	expectCallHash()
+	expectMultiAccountId(threshold)
+	stored := Multisig{When: timepoint, Deposit: deposit, Depositor: who, Approvals: sc.Sequence[primitives.AccountId]{who}}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(stored, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorAlreadyApproved(moduleId), err)
+}
+
+func Test_Call_AsMulti_Dispatch_NoTimepoint(t *testing.T) {
+	target := setupCallAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](nil), mockCall, maxWeight)
+	expectCallHash()
+	expectMultiAccountId(threshold)
+	stored := Multisig{When: timepoint, Deposit: deposit, Depositor: constants.TwoAccountId, Approvals: sc.Sequence[primitives.AccountId]{constants.TwoAccountId}}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(stored, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorNoTimepoint(moduleId), err)
+}
+
+func Test_Call_AsMulti_Dispatch_WrongTimepoint(t *testing.T) {
+	target := setupCallAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](Timepoint{Height: 5, Index: 2}), mockCall, maxWeight)
+	expectCallHash()
+	expectMultiAccountId(threshold)
+	stored := Multisig{When: timepoint, Deposit: deposit, Depositor: constants.TwoAccountId, Approvals: sc.Sequence[primitives.AccountId]{constants.TwoAccountId}}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(stored, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorWrongTimepoint(moduleId), err)
+}
+
+func Test_Call_AsMulti_Dispatch_Execute(t *testing.T) {
+	target := setupCallAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](timepoint), mockCall, maxWeight)
+	expectCallHash()
+	expectMultiAccountId(threshold)
+	expectCallInfo()
+	stored := Multisig{When: timepoint, Deposit: deposit, Depositor: constants.TwoAccountId, Approvals: sc.Sequence[primitives.AccountId]{constants.TwoAccountId}}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(stored, nil)
+	mockStorageMultisigs.On("Remove", key).Return()
+	mockCurrency.On("Unreserve", constants.TwoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockTransactional.On("WithStorageLayer", mock.Anything).Return(postInfo, nil)
+	outcome, _ := primitives.NewDispatchOutcome(sc.Empty{})
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	expectWeight := callAsMultiCompleteWeight(dbWeight, 2, 3).Add(primitives.WeightFromParts(40, 0))
+	assert.Equal(t, postDispatchInfo(expectWeight), result)
+	mockStorageMultisigs.AssertCalled(t, "Remove", key)
+	mockCurrency.AssertCalled(t, "Unreserve", constants.TwoAccountId, deposit)
+	mockTransactional.AssertCalled(t, "WithStorageLayer", mock.Anything)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventMultisigExecuted(moduleId, who, timepoint, multiAccountId, callHash, outcome))
+}
+
+func Test_Call_AsMulti_Dispatch_Execute_CallFails(t *testing.T) {
+	target := setupCallAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](timepoint), mockCall, maxWeight)
+	expectCallHash()
+	expectMultiAccountId(threshold)
+	expectCallInfo()
+	stored := Multisig{When: timepoint, Deposit: deposit, Depositor: constants.TwoAccountId, Approvals: sc.Sequence[primitives.AccountId]{constants.TwoAccountId}}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(stored, nil)
+	mockStorageMultisigs.On("Remove", key).Return()
+	mockCurrency.On("Unreserve", constants.TwoAccountId, deposit).Return(sc.NewU128(0), nil)
+	mockTransactional.On("WithStorageLayer", mock.Anything).Return(primitives.PostDispatchInfo{}, dispatchErrOther)
+	outcome, _ := primitives.NewDispatchOutcome(dispatchErrOther)
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	expectWeight := callAsMultiCompleteWeight(dbWeight, 2, 3).Add(callWeight)
+	assert.Equal(t, postDispatchInfo(expectWeight), result)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventMultisigExecuted(moduleId, who, timepoint, multiAccountId, callHash, outcome))
+}
+
+func Test_Call_AsMulti_Dispatch_MaxWeightTooLow(t *testing.T) {
+	target := setupCallAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](timepoint), mockCall, primitives.WeightFromParts(99, 0))
+	expectCallHash()
+	expectMultiAccountId(threshold)
+	expectCallInfo()
+	stored := Multisig{When: timepoint, Deposit: deposit, Depositor: constants.TwoAccountId, Approvals: sc.Sequence[primitives.AccountId]{constants.TwoAccountId}}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(stored, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorMaxWeightTooLow(moduleId), err)
+	mockStorageMultisigs.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Call_AsMulti_Dispatch_MinimumThreshold(t *testing.T) {
+	target := setupCallAsMulti()
+	target.Arguments = sc.NewVaryingData(sc.U16(1), otherSignatories, sc.NewOption[Timepoint](nil), mockCall, maxWeight)
+	expectCallHash()
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorMinimumThreshold(moduleId), err)
+}
+
+func Test_Call_AsMulti_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, sc.NewOption[Timepoint](nil), mockCall, maxWeight)
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallAsMulti() callAsMulti {
+	return newCallAsMulti(moduleId, functionAsMulti, dbWeight, setupModule()).(callAsMulti)
+}
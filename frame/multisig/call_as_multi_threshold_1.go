@@ -0,0 +1,121 @@
+package multisig
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callAsMultiThreshold1 immediately dispatches a call from the multi-account of the sender and the other signatories, with a threshold of 1.
+type callAsMultiThreshold1 struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallAsMultiThreshold1(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callAsMultiThreshold1{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.AccountId]{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callAsMultiThreshold1) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	otherSignatories, err := decodeOtherSignatories(buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	call, err := decodeCallFunc(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(otherSignatories, call)
+
+	return c, nil
+}
+
+func (c callAsMultiThreshold1) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	c.module.logger.Critical("not implemented")
+	return nil, nil
+}
+
+func (c callAsMultiThreshold1) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callAsMultiThreshold1) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callAsMultiThreshold1) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callAsMultiThreshold1) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callAsMultiThreshold1) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callAsMultiThreshold1) BaseWeight() primitives.Weight {
+	call := c.Args()[1].(primitives.Call)
+
+	return callAsMultiThreshold1Weight(c.dbWeight, sc.U64(len(call.Bytes()))).
+		SaturatingAdd(call.WeighData(call.BaseWeight()))
+}
+
+func (_ callAsMultiThreshold1) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callAsMultiThreshold1) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	call := c.Args()[1].(primitives.Call)
+
+	return call.ClassifyDispatch(baseWeight)
+}
+
+func (_ callAsMultiThreshold1) PaysFee(_ primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callAsMultiThreshold1) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	otherSignatories := args[0].(sc.Sequence[primitives.AccountId])
+	call := args[1].(primitives.Call)
+
+	signatories, err := c.module.signatories(who.Value, otherSignatories)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	id, err := c.module.MultiAccountId(signatories, 1)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	postInfo, err := call.Dispatch(primitives.NewRawOriginSigned(id), call.Args())
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	weight := callAsMultiThreshold1Weight(c.dbWeight, sc.U64(len(call.Bytes()))).
+		SaturatingAdd(actualWeight(call, postInfo))
+
+	return postDispatchInfo(weight), nil
+}
+
+func (_ callAsMultiThreshold1) Docs() string {
+	return "Immediately dispatch a multi-signature call using a single approval from the caller. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"The result is equivalent to the dispatched result."
+}
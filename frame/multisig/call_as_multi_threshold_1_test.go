@@ -0,0 +1,96 @@
+package multisig
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_AsMultiThreshold1_DecodeNestedArgs(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+
+	buffer := bytes.NewBuffer(otherSignatories.Bytes())
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(otherSignatories, mockCall), result.Args())
+}
+
+func Test_Call_AsMultiThreshold1_BaseWeight(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+	target.Arguments = sc.NewVaryingData(otherSignatories, mockCall)
+	mockCall.On("Bytes").Return(callBytes)
+	mockCall.On("BaseWeight").Return(callWeight)
+	mockCall.On("WeighData", callWeight).Return(callWeight)
+
+	expect := callAsMultiThreshold1Weight(dbWeight, 3).Add(callWeight)
+	assert.Equal(t, expect, target.BaseWeight())
+}
+
+func Test_Call_AsMultiThreshold1_ClassifyDispatch(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+	target.Arguments = sc.NewVaryingData(otherSignatories, mockCall)
+	mockCall.On("ClassifyDispatch", callWeight).Return(primitives.NewDispatchClassOperational())
+
+	assert.Equal(t, primitives.NewDispatchClassOperational(), target.ClassifyDispatch(callWeight))
+}
+
+func Test_Call_AsMultiThreshold1_Dispatch(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+	target.Arguments = sc.NewVaryingData(otherSignatories, mockCall)
+	expectMultiAccountId(1)
+	expectCallInfo()
+	origin := primitives.NewRawOriginSigned(multiAccountId)
+	mockCall.On("Bytes").Return(callBytes)
+	mockCall.On("Args").Return(callArgs)
+	mockCall.On("Dispatch", origin, callArgs).Return(postInfo, nil)
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	expectWeight := callAsMultiThreshold1Weight(dbWeight, 3).Add(primitives.WeightFromParts(40, 0))
+	assert.Equal(t, postDispatchInfo(expectWeight), result)
+	mockCall.AssertCalled(t, "Dispatch", origin, callArgs)
+}
+
+func Test_Call_AsMultiThreshold1_Dispatch_CallFails(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+	target.Arguments = sc.NewVaryingData(otherSignatories, mockCall)
+	expectMultiAccountId(1)
+	origin := primitives.NewRawOriginSigned(multiAccountId)
+	mockCall.On("Args").Return(callArgs)
+	mockCall.On("Dispatch", origin, callArgs).Return(primitives.PostDispatchInfo{}, dispatchErrOther)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, dispatchErrOther, err)
+}
+
+func Test_Call_AsMultiThreshold1_Dispatch_SenderInSignatories(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.AccountId]{who}, mockCall)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorSenderInSignatories(moduleId), err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func Test_Call_AsMultiThreshold1_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallAsMultiThreshold1()
+	target.Arguments = sc.NewVaryingData(otherSignatories, mockCall)
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallAsMultiThreshold1() callAsMultiThreshold1 {
+	return newCallAsMultiThreshold1(moduleId, functionAsMultiThreshold1, dbWeight, setupModule()).(callAsMultiThreshold1)
+}
@@ -0,0 +1,13 @@
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callAsMultiThreshold1Weight(dbWeight primitives.RuntimeDbWeight, callSize sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(13363000, 0).
+		SaturatingAdd(primitives.WeightFromParts(502, 0).SaturatingMul(callSize)).
+		SaturatingAdd(dbWeight.Reads(0)).
+		SaturatingAdd(dbWeight.Writes(0))
+}
@@ -0,0 +1,139 @@
+package multisig
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callCancelAsMulti cancels an open multisig operation and returns the deposit of its depositor.
+type callCancelAsMulti struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallCancelAsMulti(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callCancelAsMulti{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U16(0), sc.Sequence[primitives.AccountId]{}, Timepoint{}, primitives.H256{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callCancelAsMulti) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	threshold, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return nil, err
+	}
+	otherSignatories, err := decodeOtherSignatories(buffer)
+	if err != nil {
+		return nil, err
+	}
+	timepoint, err := DecodeTimepoint(buffer)
+	if err != nil {
+		return nil, err
+	}
+	callHash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(threshold, otherSignatories, timepoint, callHash)
+
+	return c, nil
+}
+
+func (c callCancelAsMulti) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callCancelAsMulti) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callCancelAsMulti) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callCancelAsMulti) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callCancelAsMulti) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callCancelAsMulti) BaseWeight() primitives.Weight {
+	size := sc.U64(len(c.Args()[1].(sc.Sequence[primitives.AccountId])))
+
+	return callCancelAsMultiWeight(c.dbWeight, size)
+}
+
+func (_ callCancelAsMulti) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callCancelAsMulti) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callCancelAsMulti) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callCancelAsMulti) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	threshold := args[0].(sc.U16)
+	otherSignatories := args[1].(sc.Sequence[primitives.AccountId])
+	timepoint := args[2].(Timepoint)
+	callHash := args[3].(primitives.H256)
+
+	if threshold < 2 {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorMinimumThreshold(c.ModuleId)
+	}
+
+	signatories, err := c.module.signatories(who.Value, otherSignatories)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	id, err := c.module.MultiAccountId(signatories, threshold)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	key := multisigKey{Multisig: id, CallHash: callHash}
+	if !c.module.storage.Multisigs.Exists(key) {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorNotFound(c.ModuleId)
+	}
+
+	multisig, err := c.module.storage.Multisigs.Get(key)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if multisig.When != timepoint {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorWrongTimepoint(c.ModuleId)
+	}
+	if compareAccountIds(multisig.Depositor, who.Value) != 0 {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorNotOwner(c.ModuleId)
+	}
+
+	if _, err := c.module.currency.Unreserve(multisig.Depositor, multisig.Deposit); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	c.module.storage.Multisigs.Remove(key)
+
+	c.module.systemModule.DepositEvent(newEventMultisigCancelled(c.ModuleId, who.Value, timepoint, id, callHash))
+
+	return primitives.PostDispatchInfo{}, nil
+}
+
+func (_ callCancelAsMulti) Docs() string {
+	return "Cancel a pre-existing, on-going multisig transaction. " +
+		"Any deposit reserved previously for this operation will be unreserved on success. " +
+		"The dispatch origin for this call must be `Signed`."
+}
@@ -0,0 +1,105 @@
+package multisig
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_CancelAsMulti_DecodeArgs(t *testing.T) {
+	target := setupCallCancelAsMulti()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(threshold.Bytes())
+	buffer.Write(otherSignatories.Bytes())
+	buffer.Write(timepoint.Bytes())
+	buffer.Write(callHash.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(threshold, otherSignatories, timepoint, callHash), result.Args())
+}
+
+func Test_Call_CancelAsMulti_BaseWeight(t *testing.T) {
+	target := setupCallCancelAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, timepoint, callHash)
+
+	assert.Equal(t, callCancelAsMultiWeight(dbWeight, 2), target.BaseWeight())
+}
+
+func Test_Call_CancelAsMulti_Dispatch(t *testing.T) {
+	target := setupCallCancelAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, timepoint, callHash)
+	expectMultiAccountId(threshold)
+	stored := Multisig{When: timepoint, Deposit: deposit, Depositor: who, Approvals: sc.Sequence[primitives.AccountId]{who}}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(stored, nil)
+	mockStorageMultisigs.On("Remove", key).Return()
+	mockCurrency.On("Unreserve", who, deposit).Return(sc.NewU128(0), nil)
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Unreserve", who, deposit)
+	mockStorageMultisigs.AssertCalled(t, "Remove", key)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventMultisigCancelled(moduleId, who, timepoint, multiAccountId, callHash))
+}
+
+func Test_Call_CancelAsMulti_Dispatch_NotFound(t *testing.T) {
+	target := setupCallCancelAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, timepoint, callHash)
+	expectMultiAccountId(threshold)
+	mockStorageMultisigs.On("Exists", key).Return(false)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+}
+
+func Test_Call_CancelAsMulti_Dispatch_WrongTimepoint(t *testing.T) {
+	target := setupCallCancelAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, Timepoint{Height: 6, Index: 1}, callHash)
+	expectMultiAccountId(threshold)
+	stored := Multisig{When: timepoint, Deposit: deposit, Depositor: who, Approvals: sc.Sequence[primitives.AccountId]{who}}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(stored, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorWrongTimepoint(moduleId), err)
+}
+
+func Test_Call_CancelAsMulti_Dispatch_NotOwner(t *testing.T) {
+	target := setupCallCancelAsMulti()
+	target.Arguments = sc.NewVaryingData(threshold, otherSignatories, timepoint, callHash)
+	expectMultiAccountId(threshold)
+	stored := Multisig{When: timepoint, Deposit: deposit, Depositor: constants.TwoAccountId, Approvals: sc.Sequence[primitives.AccountId]{constants.TwoAccountId}}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(stored, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotOwner(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Unreserve", mock.Anything, mock.Anything)
+	mockStorageMultisigs.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Call_CancelAsMulti_Dispatch_MinimumThreshold(t *testing.T) {
+	target := setupCallCancelAsMulti()
+	target.Arguments = sc.NewVaryingData(sc.U16(1), otherSignatories, timepoint, callHash)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorMinimumThreshold(moduleId), err)
+}
+
+func setupCallCancelAsMulti() callCancelAsMulti {
+	return newCallCancelAsMulti(moduleId, functionCancelAsMulti, dbWeight, setupModule()).(callCancelAsMulti)
+}
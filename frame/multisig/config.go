@@ -0,0 +1,41 @@
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	Storage           io.Storage
+	TransactionBroker io.TransactionBroker
+	DbWeight          primitives.RuntimeDbWeight
+	Currency          primitives.ReservableCurrency
+	SystemModule      system.Module
+	DepositBase       primitives.Balance
+	DepositFactor     primitives.Balance
+	MaxSignatories    sc.U32
+}
+
+func NewConfig(
+	storage io.Storage,
+	transactionBroker io.TransactionBroker,
+	dbWeight primitives.RuntimeDbWeight,
+	currency primitives.ReservableCurrency,
+	systemModule system.Module,
+	depositBase primitives.Balance,
+	depositFactor primitives.Balance,
+	maxSignatories sc.U32,
+) Config {
+	return Config{
+		Storage:           storage,
+		TransactionBroker: transactionBroker,
+		DbWeight:          dbWeight,
+		Currency:          currency,
+		SystemModule:      systemModule,
+		DepositBase:       depositBase,
+		DepositFactor:     depositFactor,
+		MaxSignatories:    maxSignatories,
+	}
+}
@@ -0,0 +1,80 @@
+package multisig
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Multisig module errors.
+const (
+	ErrorMinimumThreshold sc.U8 = iota
+	ErrorAlreadyApproved
+	ErrorNoApprovalsNeeded
+	ErrorTooFewSignatories
+	ErrorTooManySignatories
+	ErrorSignatoriesOutOfOrder
+	ErrorSenderInSignatories
+	ErrorNotFound
+	ErrorNotOwner
+	ErrorNoTimepoint
+	ErrorWrongTimepoint
+	ErrorUnexpectedTimepoint
+	ErrorMaxWeightTooLow
+	ErrorAlreadyStored
+)
+
+func NewDispatchErrorMinimumThreshold(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorMinimumThreshold)
+}
+
+func NewDispatchErrorAlreadyApproved(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorAlreadyApproved)
+}
+
+func NewDispatchErrorTooFewSignatories(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorTooFewSignatories)
+}
+
+func NewDispatchErrorTooManySignatories(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorTooManySignatories)
+}
+
+func NewDispatchErrorSignatoriesOutOfOrder(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorSignatoriesOutOfOrder)
+}
+
+func NewDispatchErrorSenderInSignatories(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorSenderInSignatories)
+}
+
+func NewDispatchErrorNotFound(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNotFound)
+}
+
+func NewDispatchErrorNotOwner(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNotOwner)
+}
+
+func NewDispatchErrorNoTimepoint(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNoTimepoint)
+}
+
+func NewDispatchErrorWrongTimepoint(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorWrongTimepoint)
+}
+
+func NewDispatchErrorUnexpectedTimepoint(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorUnexpectedTimepoint)
+}
+
+func NewDispatchErrorMaxWeightTooLow(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorMaxWeightTooLow)
+}
+
+func newDispatchError(moduleId sc.U8, err sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
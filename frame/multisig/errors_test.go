@@ -0,0 +1,34 @@
+package multisig
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewDispatchErrors(t *testing.T) {
+	for err, constructor := range map[sc.U8]func(sc.U8) primitives.DispatchError{
+		ErrorMinimumThreshold:      NewDispatchErrorMinimumThreshold,
+		ErrorAlreadyApproved:       NewDispatchErrorAlreadyApproved,
+		ErrorTooFewSignatories:     NewDispatchErrorTooFewSignatories,
+		ErrorTooManySignatories:    NewDispatchErrorTooManySignatories,
+		ErrorSignatoriesOutOfOrder: NewDispatchErrorSignatoriesOutOfOrder,
+		ErrorSenderInSignatories:   NewDispatchErrorSenderInSignatories,
+		ErrorNotFound:              NewDispatchErrorNotFound,
+		ErrorNotOwner:              NewDispatchErrorNotOwner,
+		ErrorNoTimepoint:           NewDispatchErrorNoTimepoint,
+		ErrorWrongTimepoint:        NewDispatchErrorWrongTimepoint,
+		ErrorUnexpectedTimepoint:   NewDispatchErrorUnexpectedTimepoint,
+		ErrorMaxWeightTooLow:       NewDispatchErrorMaxWeightTooLow,
+	} {
+		expect := primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+			Index:   moduleId,
+			Err:     sc.U32(err),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+
+		assert.Equal(t, expect, constructor(moduleId))
+	}
+}
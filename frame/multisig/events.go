@@ -0,0 +1,130 @@
+package multisig
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Multisig module events.
+const (
+	EventNewMultisig sc.U8 = iota
+	EventMultisigApproval
+	EventMultisigExecuted
+	EventMultisigCancelled
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid multisig.Event module")
+	errInvalidEventType   = errors.New("invalid multisig.Event type")
+)
+
+func newEventNewMultisig(moduleIndex sc.U8, approving primitives.AccountId, multisig primitives.AccountId, callHash primitives.H256) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventNewMultisig, approving, multisig, callHash)
+}
+
+func newEventMultisigApproval(moduleIndex sc.U8, approving primitives.AccountId, timepoint Timepoint, multisig primitives.AccountId, callHash primitives.H256) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventMultisigApproval, approving, timepoint, multisig, callHash)
+}
+
+func newEventMultisigExecuted(moduleIndex sc.U8, approving primitives.AccountId, timepoint Timepoint, multisig primitives.AccountId, callHash primitives.H256, result primitives.DispatchOutcome) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventMultisigExecuted, approving, timepoint, multisig, callHash, result)
+}
+
+func newEventMultisigCancelled(moduleIndex sc.U8, cancelling primitives.AccountId, timepoint Timepoint, multisig primitives.AccountId, callHash primitives.H256) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventMultisigCancelled, cancelling, timepoint, multisig, callHash)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventNewMultisig:
+		approving, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		multisig, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		callHash, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventNewMultisig(moduleIndex, approving, multisig, callHash), nil
+	case EventMultisigApproval:
+		approving, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		timepoint, err := DecodeTimepoint(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		multisig, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		callHash, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventMultisigApproval(moduleIndex, approving, timepoint, multisig, callHash), nil
+	case EventMultisigExecuted:
+		approving, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		timepoint, err := DecodeTimepoint(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		multisig, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		callHash, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		result, err := primitives.DecodeDispatchOutcome(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventMultisigExecuted(moduleIndex, approving, timepoint, multisig, callHash, result), nil
+	case EventMultisigCancelled:
+		cancelling, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		timepoint, err := DecodeTimepoint(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		multisig, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		callHash, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventMultisigCancelled(moduleIndex, cancelling, timepoint, multisig, callHash), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
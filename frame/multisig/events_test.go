@@ -0,0 +1,54 @@
+package multisig
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DecodeEvent(t *testing.T) {
+	outcome, _ := primitives.NewDispatchOutcome(sc.Empty{})
+
+	for _, event := range []primitives.Event{
+		newEventNewMultisig(moduleId, who, multiAccountId, callHash),
+		newEventMultisigApproval(moduleId, who, timepoint, multiAccountId, callHash),
+		newEventMultisigExecuted(moduleId, who, timepoint, multiAccountId, callHash, outcome),
+		newEventMultisigCancelled(moduleId, who, timepoint, multiAccountId, callHash),
+	} {
+		result, err := DecodeEvent(moduleId, bytes.NewBuffer(event.Bytes()))
+		assert.Nil(t, err)
+
+		assert.Equal(t, event, result)
+	}
+}
+
+func Test_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId + 1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
+
+func Test_DecodeMultisig(t *testing.T) {
+	multisig := Multisig{When: timepoint, Deposit: sc.NewU128(14), Depositor: who, Approvals: signatories}
+
+	result, err := DecodeMultisig(bytes.NewBuffer(multisig.Bytes()))
+
+	assert.Nil(t, err)
+	assert.Equal(t, multisig, result)
+}
@@ -0,0 +1,663 @@
+package multisig
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	functionAsMultiThreshold1 = iota
+	functionAsMulti
+	functionApproveAsMulti
+	functionCancelAsMulti
+)
+
+const (
+	name = sc.Str("Multisig")
+)
+
+var (
+	// multiAccountPrefix is the prefix of the entropy from which multi-account ids are generated.
+	multiAccountPrefix = []byte("modlpy/utilisuba")
+)
+
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	index          sc.U8
+	dbWeight       primitives.RuntimeDbWeight
+	depositBase    primitives.Balance
+	depositFactor  primitives.Balance
+	maxSignatories sc.U32
+	functions      map[sc.U8]primitives.Call
+	storage        *storage
+	currency       primitives.ReservableCurrency
+	systemModule   system.Module
+	transactional  support.Transactional[primitives.PostDispatchInfo]
+	hashing        io.Hashing
+	mdGenerator    *primitives.MetadataTypeGenerator
+	logger         log.RuntimeLogger
+}
+
+func New(index sc.U8, config Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.RuntimeLogger) Module {
+	functions := make(map[sc.U8]primitives.Call)
+
+	module := Module{
+		index:          index,
+		dbWeight:       config.DbWeight,
+		depositBase:    config.DepositBase,
+		depositFactor:  config.DepositFactor,
+		maxSignatories: config.MaxSignatories,
+		storage:        newStorage(config.Storage),
+		currency:       config.Currency,
+		systemModule:   config.SystemModule,
+		transactional:  support.NewTransactional[primitives.PostDispatchInfo](config.Storage, config.TransactionBroker, logger),
+		hashing:        io.NewHashing(),
+		mdGenerator:    mdGenerator,
+		logger:         logger,
+	}
+
+	functions[functionAsMultiThreshold1] = newCallAsMultiThreshold1(index, functionAsMultiThreshold1, config.DbWeight, module)
+	functions[functionAsMulti] = newCallAsMulti(index, functionAsMulti, config.DbWeight, module)
+	functions[functionApproveAsMulti] = newCallApproveAsMulti(index, functionApproveAsMulti, config.DbWeight, module)
+	functions[functionCancelAsMulti] = newCallCancelAsMulti(index, functionCancelAsMulti, config.DbWeight, module)
+
+	module.functions = functions
+
+	return module
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) GetIndex() sc.U8 { return m.index }
+
+func (m Module) Functions() map[sc.U8]primitives.Call { return m.functions }
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) { return sc.Empty{}, nil }
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// MultiAccountId derives the account id of a multisig from its sorted `signatories` and the `threshold`
+// of approvals, which are needed to dispatch a call.
+func (m Module) MultiAccountId(signatories sc.Sequence[primitives.AccountId], threshold sc.U16) (primitives.AccountId, error) {
+	entropy := append([]byte{}, multiAccountPrefix...)
+	entropy = append(entropy, signatories.Bytes()...)
+	entropy = append(entropy, threshold.Bytes()...)
+
+	hash := m.hashing.Blake256(entropy)
+
+	return primitives.NewAccountId(sc.BytesToSequenceU8(hash)...)
+}
+
+// Multisig returns the open multisig operation of the account `multisig` for the call with hash `callHash`.
+func (m Module) Multisig(multisig primitives.AccountId, callHash primitives.H256) (sc.Option[Multisig], error) {
+	key := multisigKey{Multisig: multisig, CallHash: callHash}
+	if !m.storage.Multisigs.Exists(key) {
+		return sc.NewOption[Multisig](nil), nil
+	}
+
+	value, err := m.storage.Multisigs.Get(key)
+	if err != nil {
+		return sc.Option[Multisig]{}, err
+	}
+
+	return sc.NewOption[Multisig](value), nil
+}
+
+// callHash returns the hash, under which an operation dispatching `call` is stored.
+func (m Module) callHash(call primitives.Call) (primitives.H256, error) {
+	return primitives.NewH256(sc.BytesToSequenceU8(m.hashing.Blake256(call.Bytes()))...)
+}
+
+// timepoint returns the timepoint of the extrinsic, which is currently being applied.
+func (m Module) timepoint() (Timepoint, error) {
+	height, err := m.systemModule.StorageBlockNumber()
+	if err != nil {
+		return Timepoint{}, err
+	}
+	index, err := m.systemModule.StorageExtrinsicIndex()
+	if err != nil {
+		return Timepoint{}, err
+	}
+
+	return Timepoint{
+		Height: height,
+		Index:  index,
+	}, nil
+}
+
+// signatories validates the number of `otherSignatories` and returns all signatories of the multisig,
+// with `who` inserted at its sorted position.
+func (m Module) signatories(who primitives.AccountId, otherSignatories sc.Sequence[primitives.AccountId]) (sc.Sequence[primitives.AccountId], error) {
+	if len(otherSignatories) == 0 {
+		return nil, NewDispatchErrorTooFewSignatories(m.index)
+	}
+	if sc.U32(len(otherSignatories)) >= m.maxSignatories {
+		return nil, NewDispatchErrorTooManySignatories(m.index)
+	}
+
+	return m.ensureSortedAndInsert(otherSignatories, who)
+}
+
+// ensureSortedAndInsert checks that `otherSignatories` are sorted, without duplicates and without `who`,
+// and returns them with `who` inserted at its sorted position.
+func (m Module) ensureSortedAndInsert(otherSignatories sc.Sequence[primitives.AccountId], who primitives.AccountId) (sc.Sequence[primitives.AccountId], error) {
+	index := 0
+	for i, signatory := range otherSignatories {
+		if i > 0 && compareAccountIds(otherSignatories[i-1], signatory) >= 0 {
+			return nil, NewDispatchErrorSignatoriesOutOfOrder(m.index)
+		}
+
+		cmp := compareAccountIds(signatory, who)
+		if cmp == 0 {
+			return nil, NewDispatchErrorSenderInSignatories(m.index)
+		}
+		if cmp < 0 {
+			index++
+		}
+	}
+
+	signatories := make(sc.Sequence[primitives.AccountId], 0, len(otherSignatories)+1)
+	signatories = append(signatories, otherSignatories[:index]...)
+	signatories = append(signatories, who)
+	signatories = append(signatories, otherSignatories[index:]...)
+
+	return signatories, nil
+}
+
+// operate opens, approves or executes a multisig operation on behalf of `who`.
+// The `call` is nil when only its `callHash` is known, in which case the operation is never executed.
+func (m Module) operate(who primitives.AccountId, threshold sc.U16, otherSignatories sc.Sequence[primitives.AccountId], maybeTimepoint sc.Option[Timepoint], call primitives.Call, callHash primitives.H256, maxWeight primitives.Weight) (primitives.PostDispatchInfo, error) {
+	if threshold < 2 {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorMinimumThreshold(m.index)
+	}
+
+	signatories, err := m.signatories(who, otherSignatories)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	id, err := m.MultiAccountId(signatories, threshold)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	size := sc.U64(len(otherSignatories))
+	callSize := sc.U64(0)
+	if call != nil {
+		callSize = sc.U64(len(call.Bytes()))
+	}
+
+	key := multisigKey{Multisig: id, CallHash: callHash}
+	if !m.storage.Multisigs.Exists(key) {
+		if maybeTimepoint.HasValue {
+			return primitives.PostDispatchInfo{}, NewDispatchErrorUnexpectedTimepoint(m.index)
+		}
+
+		deposit := sc.SaturatingAddU128(m.depositBase, m.depositFactor.Mul(sc.NewU128(uint64(threshold))))
+		if err := m.currency.Reserve(who, deposit); err != nil {
+			return primitives.PostDispatchInfo{}, err
+		}
+
+		when, err := m.timepoint()
+		if err != nil {
+			return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+		}
+
+		m.storage.Multisigs.Put(key, Multisig{
+			When:      when,
+			Deposit:   deposit,
+			Depositor: who,
+			Approvals: sc.Sequence[primitives.AccountId]{who},
+		})
+		m.systemModule.DepositEvent(newEventNewMultisig(m.index, who, id, callHash))
+
+		weight := callApproveAsMultiCreateWeight(m.dbWeight, size)
+		if call != nil {
+			weight = callAsMultiCreateWeight(m.dbWeight, size, callSize)
+		}
+		return postDispatchInfo(weight), nil
+	}
+
+	multisig, err := m.storage.Multisigs.Get(key)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	if !maybeTimepoint.HasValue {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorNoTimepoint(m.index)
+	}
+	timepoint := maybeTimepoint.Value
+	if multisig.When != timepoint {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorWrongTimepoint(m.index)
+	}
+
+	position, approved := searchApproval(multisig.Approvals, who)
+	approvals := sc.U16(len(multisig.Approvals))
+	if !approved {
+		approvals++
+	}
+
+	if call != nil && approvals >= threshold {
+		info := primitives.GetDispatchInfo(call)
+		if info.Weight.AnyGt(maxWeight) {
+			return primitives.PostDispatchInfo{}, NewDispatchErrorMaxWeightTooLow(m.index)
+		}
+
+		m.storage.Multisigs.Remove(key)
+		if _, err := m.currency.Unreserve(multisig.Depositor, multisig.Deposit); err != nil {
+			return primitives.PostDispatchInfo{}, err
+		}
+
+		postInfo, err := m.dispatch(primitives.NewRawOriginSigned(id), call)
+		var result primitives.DispatchOutcome
+		if err != nil {
+			result, err = primitives.NewDispatchOutcome(toDispatchError(err))
+		} else {
+			result, err = primitives.NewDispatchOutcome(sc.Empty{})
+		}
+		if err != nil {
+			return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+		}
+		m.systemModule.DepositEvent(newEventMultisigExecuted(m.index, who, timepoint, id, callHash, result))
+
+		weight := callAsMultiCompleteWeight(m.dbWeight, size, callSize).
+			SaturatingAdd(actualWeight(call, postInfo))
+		return postDispatchInfo(weight), nil
+	}
+
+	if approved {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorAlreadyApproved(m.index)
+	}
+
+	multisig.Approvals = append(multisig.Approvals[:position], append(sc.Sequence[primitives.AccountId]{who}, multisig.Approvals[position:]...)...)
+	m.storage.Multisigs.Put(key, multisig)
+	m.systemModule.DepositEvent(newEventMultisigApproval(m.index, who, timepoint, id, callHash))
+
+	weight := callApproveAsMultiApproveWeight(m.dbWeight, size)
+	if call != nil {
+		weight = callAsMultiApproveWeight(m.dbWeight, size, callSize)
+	}
+	return postDispatchInfo(weight), nil
+}
+
+// dispatch dispatches the call in a new storage layer, so that the changes of a failed call are discarded.
+func (m Module) dispatch(origin primitives.RuntimeOrigin, call primitives.Call) (primitives.PostDispatchInfo, error) {
+	return m.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		postInfo, err := call.Dispatch(origin, call.Args())
+		if err != nil {
+			return primitives.PostDispatchInfo{}, toDispatchError(err)
+		}
+		return postInfo, nil
+	})
+}
+
+// searchApproval returns the position of `who` in the sorted `approvals`
+// and whether `who` is already there.
+func searchApproval(approvals sc.Sequence[primitives.AccountId], who primitives.AccountId) (int, bool) {
+	for i, approval := range approvals {
+		cmp := compareAccountIds(approval, who)
+		if cmp == 0 {
+			return i, true
+		}
+		if cmp > 0 {
+			return i, false
+		}
+	}
+	return len(approvals), false
+}
+
+func compareAccountIds(a, b primitives.AccountId) int {
+	return bytes.Compare(a.Bytes(), b.Bytes())
+}
+
+func toDispatchError(err error) primitives.DispatchError {
+	dispatchErr, ok := err.(primitives.DispatchError)
+	if !ok {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	return dispatchErr
+}
+
+// actualWeight returns the weight consumed by a dispatched call.
+func actualWeight(call primitives.Call, postInfo primitives.PostDispatchInfo) primitives.Weight {
+	info := primitives.GetDispatchInfo(call)
+	return postInfo.CalcActualWeight(&info)
+}
+
+func postDispatchInfo(weight primitives.Weight) primitives.PostDispatchInfo {
+	return primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](weight),
+		PaysFee:      primitives.PaysYes,
+	}
+}
+
+func decodeOtherSignatories(buffer *bytes.Buffer) (sc.Sequence[primitives.AccountId], error) {
+	return sc.DecodeSequenceWith(buffer, primitives.DecodeAccountId)
+}
+
+func (m Module) Metadata() primitives.MetadataModule {
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMultisigCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesMultisigCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Multisig, Runtime>"),
+				},
+				m.index,
+				"Call.Multisig")),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMultisigEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesMultisigEvent, "pallet_multisig::Event<Runtime>"),
+				},
+				m.index,
+				"Events.Multisig"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"DepositBase",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(m.depositBase.Bytes()),
+				"The base amount of currency needed to reserve for creating a multisig execution or to store a dispatch call for later.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"DepositFactor",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(m.depositFactor.Bytes()),
+				"The amount of currency needed per unit threshold when creating a multisig execution.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxSignatories",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.maxSignatories.Bytes()),
+				"The maximum amount of signatories allowed in the multisig.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMultisigErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesMultisigErrors),
+				},
+				m.index,
+				"Errors.Multisig"),
+		),
+		Index: m.index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Multisigs",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesMultisigStorageKey),
+					sc.ToCompact(metadata.TypesMultisig)),
+				"The set of open multisig operations."),
+		},
+	})
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParam(metadata.TypesMultisigTimepoint,
+			"Timepoint",
+			sc.Sequence[sc.Str]{"pallet_multisig", "Timepoint"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "height", "BlockNumber"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "u32"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU64, "BlockNumber")),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionMultisigTimepoint,
+			"Option<Timepoint>",
+			sc.Sequence[sc.Str]{"Option"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						0,
+						""),
+					primitives.NewMetadataDefinitionVariant(
+						"Some",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.TypesMultisigTimepoint),
+						},
+						1,
+						""),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesMultisigTimepoint, "T")),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesMultisig,
+			"Multisig",
+			sc.Sequence[sc.Str]{"pallet_multisig", "Multisig"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultisigTimepoint, "when", "Timepoint<BlockNumber>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "deposit", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "depositor", "AccountId"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceAddress32, "approvals", "BoundedVec<AccountId, MaxApprovals>"),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU64, "BlockNumber"),
+				primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance"),
+				primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "AccountId"),
+			}),
+
+		primitives.NewMetadataType(metadata.TypesMultisigStorageKey,
+			"<AccountId, [u8; 32]>",
+			primitives.NewMetadataTypeDefinitionTuple(
+				sc.Sequence[sc.Compact]{
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesFixedSequence32U8),
+				})),
+
+		primitives.NewMetadataTypeWithPath(
+			metadata.TypesMultisigEvent,
+			"pallet_multisig pallet Event",
+			sc.Sequence[sc.Str]{"pallet_multisig", "pallet", "Event"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"NewMultisig",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "approving", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "multisig", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, "call_hash", "CallHash"),
+						},
+						EventNewMultisig,
+						"A new multisig operation has begun."),
+					primitives.NewMetadataDefinitionVariant(
+						"MultisigApproval",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "approving", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultisigTimepoint, "timepoint", "Timepoint<BlockNumberFor<T>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "multisig", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, "call_hash", "CallHash"),
+						},
+						EventMultisigApproval,
+						"A multisig operation has been approved by someone."),
+					primitives.NewMetadataDefinitionVariant(
+						"MultisigExecuted",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "approving", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultisigTimepoint, "timepoint", "Timepoint<BlockNumberFor<T>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "multisig", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, "call_hash", "CallHash"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchOutcome, "result", "DispatchResult"),
+						},
+						EventMultisigExecuted,
+						"A multisig operation has been executed."),
+					primitives.NewMetadataDefinitionVariant(
+						"MultisigCancelled",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "cancelling", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultisigTimepoint, "timepoint", "Timepoint<BlockNumberFor<T>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "multisig", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, "call_hash", "CallHash"),
+						},
+						EventMultisigCancelled,
+						"A multisig operation has been cancelled."),
+				})),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesMultisigErrors,
+			"pallet_multisig pallet Error",
+			sc.Sequence[sc.Str]{"pallet_multisig", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"MinimumThreshold",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorMinimumThreshold,
+						"Threshold must be 2 or greater."),
+					primitives.NewMetadataDefinitionVariant(
+						"AlreadyApproved",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorAlreadyApproved,
+						"Call is already approved by this signatory."),
+					primitives.NewMetadataDefinitionVariant(
+						"NoApprovalsNeeded",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNoApprovalsNeeded,
+						"Call doesn't need any (more) approvals."),
+					primitives.NewMetadataDefinitionVariant(
+						"TooFewSignatories",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooFewSignatories,
+						"There are too few signatories in the list."),
+					primitives.NewMetadataDefinitionVariant(
+						"TooManySignatories",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManySignatories,
+						"There are too many signatories in the list."),
+					primitives.NewMetadataDefinitionVariant(
+						"SignatoriesOutOfOrder",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorSignatoriesOutOfOrder,
+						"The signatories were provided out of order; they should be ordered."),
+					primitives.NewMetadataDefinitionVariant(
+						"SenderInSignatories",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorSenderInSignatories,
+						"The sender was contained in the other signatories; it shouldn't be."),
+					primitives.NewMetadataDefinitionVariant(
+						"NotFound",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNotFound,
+						"Multisig operation not found when attempting to cancel."),
+					primitives.NewMetadataDefinitionVariant(
+						"NotOwner",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNotOwner,
+						"Only the account that originally created the multisig is able to cancel it."),
+					primitives.NewMetadataDefinitionVariant(
+						"NoTimepoint",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNoTimepoint,
+						"No timepoint was given, yet the multisig operation is already underway."),
+					primitives.NewMetadataDefinitionVariant(
+						"WrongTimepoint",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorWrongTimepoint,
+						"A different timepoint was given to the multisig operation that is underway."),
+					primitives.NewMetadataDefinitionVariant(
+						"UnexpectedTimepoint",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorUnexpectedTimepoint,
+						"A timepoint was given, yet no multisig operation is underway."),
+					primitives.NewMetadataDefinitionVariant(
+						"MaxWeightTooLow",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorMaxWeightTooLow,
+						"The maximum weight information provided was too low."),
+					primitives.NewMetadataDefinitionVariant(
+						"AlreadyStored",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorAlreadyStored,
+						"The data to be stored is already stored."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesMultisigCalls,
+			"Multisig calls",
+			sc.Sequence[sc.Str]{"pallet_multisig", "pallet", "Call"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"as_multi_threshold_1",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceAddress32, "other_signatories", "Vec<T::AccountId>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+						},
+						functionAsMultiThreshold1,
+						"Immediately dispatch a multi-signature call using a single approval from the caller."),
+					primitives.NewMetadataDefinitionVariant(
+						"as_multi",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU16, "threshold", "u16"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceAddress32, "other_signatories", "Vec<T::AccountId>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionMultisigTimepoint, "maybe_timepoint", "Option<Timepoint<BlockNumberFor<T>>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesWeight, "max_weight", "Weight"),
+						},
+						functionAsMulti,
+						"Register approval for a dispatch to be made from a deterministic composite account if approved by a total of `threshold - 1` of `other_signatories`."),
+					primitives.NewMetadataDefinitionVariant(
+						"approve_as_multi",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU16, "threshold", "u16"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceAddress32, "other_signatories", "Vec<T::AccountId>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionMultisigTimepoint, "maybe_timepoint", "Option<Timepoint<BlockNumberFor<T>>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, "call_hash", "[u8; 32]"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesWeight, "max_weight", "Weight"),
+						},
+						functionApproveAsMulti,
+						"Register approval for a dispatch to be made from a deterministic composite account if approved by a total of `threshold - 1` of `other_signatories`."),
+					primitives.NewMetadataDefinitionVariant(
+						"cancel_as_multi",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU16, "threshold", "u16"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceAddress32, "other_signatories", "Vec<T::AccountId>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultisigTimepoint, "timepoint", "Timepoint<BlockNumberFor<T>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, "call_hash", "[u8; 32]"),
+						},
+						functionCancelAsMulti,
+						"Cancel a pre-existing, on-going multisig transaction. Any deposit reserved previously for this operation will be unreserved on success."),
+				}),
+			primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+}
@@ -0,0 +1,335 @@
+package multisig
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId       = 10
+	maxSignatories = 3
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	depositBase   = sc.NewU128(10)
+	depositFactor = sc.NewU128(2)
+
+	who              = constants.OneAccountId
+	otherSignatories = sc.Sequence[primitives.AccountId]{constants.ZeroAccountId, constants.TwoAccountId}
+	signatories      = sc.Sequence[primitives.AccountId]{constants.ZeroAccountId, constants.OneAccountId, constants.TwoAccountId}
+
+	multiAccountHash = []byte{
+		7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
+		7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
+	}
+	multiAccountId, _ = primitives.NewAccountId(sc.BytesToSequenceU8(multiAccountHash)...)
+	callBytes         = []byte{1, 2, 3}
+	callHashBytes     = []byte{
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	}
+	callHash, _ = primitives.NewH256(sc.BytesToSequenceU8(callHashBytes)...)
+	key         = multisigKey{Multisig: multiAccountId, CallHash: callHash}
+	timepoint   = Timepoint{Height: 5, Index: 1}
+
+	callArgs   = sc.NewVaryingData(sc.U8(1))
+	callWeight = primitives.WeightFromParts(100, 0)
+	postInfo   = primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](primitives.WeightFromParts(40, 0))}
+
+	mdGenerator                           = primitives.NewMetadataTypeGenerator()
+	unknownTransactionNoUnsignedValidator = primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+	dispatchErrOther                      = primitives.NewDispatchErrorOther("error")
+)
+
+var (
+	mockStorage           *mocks.IoStorage
+	mockTransactionBroker *mocks.IoTransactionBroker
+	mockCurrency          *mocks.ReservableCurrency
+	mockSystemModule      *mocks.SystemModule
+	mockHashing           *mocks.IoHashing
+	mockTransactional     *mocks.IoTransactional[primitives.PostDispatchInfo]
+	mockStorageMultisigs  *mocks.StorageMap[multisigKey, Multisig]
+	mockCall              *mocks.Call
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, 4, len(target.Functions()))
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.NewTransactionSourceLocal(), mockCall)
+
+	assert.Equal(t, unknownTransactionNoUnsignedValidator, err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_MultiAccountId(t *testing.T) {
+	target := setupModule()
+
+	expectEntropy := append([]byte("modlpy/utilisuba"), signatories.Bytes()...)
+	expectEntropy = append(expectEntropy, 0x02, 0x00)
+	mockHashing.On("Blake256", expectEntropy).Return(multiAccountHash)
+
+	result, err := target.MultiAccountId(signatories, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, multiAccountId, result)
+	mockHashing.AssertCalled(t, "Blake256", expectEntropy)
+}
+
+func Test_Module_Multisig(t *testing.T) {
+	target := setupModule()
+	multisig := Multisig{When: timepoint, Deposit: depositBase, Depositor: who, Approvals: sc.Sequence[primitives.AccountId]{who}}
+	mockStorageMultisigs.On("Exists", key).Return(true)
+	mockStorageMultisigs.On("Get", key).Return(multisig, nil)
+
+	result, err := target.Multisig(multiAccountId, callHash)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[Multisig](multisig), result)
+}
+
+func Test_Module_Multisig_NotFound(t *testing.T) {
+	target := setupModule()
+	mockStorageMultisigs.On("Exists", key).Return(false)
+
+	result, err := target.Multisig(multiAccountId, callHash)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[Multisig](nil), result)
+	mockStorageMultisigs.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_timepoint(t *testing.T) {
+	target := setupModule()
+	mockSystemModule.On("StorageBlockNumber").Return(sc.U64(5), nil)
+	mockSystemModule.On("StorageExtrinsicIndex").Return(sc.U32(1), nil)
+
+	result, err := target.timepoint()
+
+	assert.NoError(t, err)
+	assert.Equal(t, timepoint, result)
+}
+
+func Test_Module_signatories(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.signatories(who, otherSignatories)
+
+	assert.NoError(t, err)
+	assert.Equal(t, signatories, result)
+}
+
+func Test_Module_signatories_InsertFirst(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.signatories(constants.ZeroAccountId, sc.Sequence[primitives.AccountId]{constants.OneAccountId, constants.TwoAccountId})
+
+	assert.NoError(t, err)
+	assert.Equal(t, signatories, result)
+}
+
+func Test_Module_signatories_InsertLast(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.signatories(constants.TwoAccountId, sc.Sequence[primitives.AccountId]{constants.ZeroAccountId, constants.OneAccountId})
+
+	assert.NoError(t, err)
+	assert.Equal(t, signatories, result)
+}
+
+func Test_Module_signatories_TooFewSignatories(t *testing.T) {
+	target := setupModule()
+
+	_, err := target.signatories(who, sc.Sequence[primitives.AccountId]{})
+
+	assert.Equal(t, NewDispatchErrorTooFewSignatories(moduleId), err)
+}
+
+func Test_Module_signatories_TooManySignatories(t *testing.T) {
+	target := setupModule()
+	others := sc.Sequence[primitives.AccountId]{constants.ZeroAccountId, constants.TwoAccountId, constants.TwoAccountId}
+
+	_, err := target.signatories(who, others)
+
+	assert.Equal(t, NewDispatchErrorTooManySignatories(moduleId), err)
+}
+
+func Test_Module_signatories_OutOfOrder(t *testing.T) {
+	target := setupModule()
+
+	_, err := target.signatories(who, sc.Sequence[primitives.AccountId]{constants.TwoAccountId, constants.ZeroAccountId})
+
+	assert.Equal(t, NewDispatchErrorSignatoriesOutOfOrder(moduleId), err)
+}
+
+func Test_Module_signatories_Duplicate(t *testing.T) {
+	target := setupModule()
+
+	_, err := target.signatories(who, sc.Sequence[primitives.AccountId]{constants.TwoAccountId, constants.TwoAccountId})
+
+	assert.Equal(t, NewDispatchErrorSignatoriesOutOfOrder(moduleId), err)
+}
+
+func Test_Module_signatories_SenderInSignatories(t *testing.T) {
+	target := setupModule()
+
+	_, err := target.signatories(who, sc.Sequence[primitives.AccountId]{constants.OneAccountId, constants.TwoAccountId})
+
+	assert.Equal(t, NewDispatchErrorSenderInSignatories(moduleId), err)
+}
+
+func Test_Module_callHash(t *testing.T) {
+	target := setupModule()
+	expectCallHash()
+
+	result, err := target.callHash(mockCall)
+
+	assert.NoError(t, err)
+	assert.Equal(t, callHash, result)
+}
+
+func Test_searchApproval(t *testing.T) {
+	approvals := sc.Sequence[primitives.AccountId]{constants.ZeroAccountId, constants.TwoAccountId}
+
+	position, found := searchApproval(approvals, constants.OneAccountId)
+	assert.Equal(t, 1, position)
+	assert.False(t, found)
+
+	position, found = searchApproval(approvals, constants.TwoAccountId)
+	assert.Equal(t, 1, position)
+	assert.True(t, found)
+
+	position, found = searchApproval(approvals[:1], constants.TwoAccountId)
+	assert.Equal(t, 1, position)
+	assert.False(t, found)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	result := target.Metadata()
+
+	assert.Equal(t, primitives.ModuleVersion14, result.Version)
+	assert.Equal(t, sc.Str("Multisig"), result.ModuleV14.Name)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMultisigCalls)), result.ModuleV14.Call)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMultisigEvent)), result.ModuleV14.Event)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesMultisigErrors)), result.ModuleV14.Error)
+	assert.Equal(t, sc.Str("Multisig"), result.ModuleV14.Storage.Value.Prefix)
+	assert.Equal(t, 1, len(result.ModuleV14.Storage.Value.Items))
+	assert.Equal(t,
+		sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"DepositBase",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(depositBase.Bytes()),
+				"The base amount of currency needed to reserve for creating a multisig execution or to store a dispatch call for later.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"DepositFactor",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(depositFactor.Bytes()),
+				"The amount of currency needed per unit threshold when creating a multisig execution.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxSignatories",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(maxSignatories).Bytes()),
+				"The maximum amount of signatories allowed in the multisig.",
+			),
+		},
+		result.ModuleV14.Constants)
+	assert.Equal(t, sc.U8(moduleId), result.ModuleV14.Index)
+}
+
+func Test_Module_dispatch(t *testing.T) {
+	target := setupModule()
+	origin := primitives.NewRawOriginSigned(multiAccountId)
+	mockCall.On("Args").Return(callArgs)
+	mockCall.On("Dispatch", origin, callArgs).Return(primitives.PostDispatchInfo{}, assert.AnError)
+
+	var fnErr error
+	mockTransactional.On("WithStorageLayer", mock.Anything).Run(func(args mock.Arguments) {
+		fn := args.Get(0).(func() (primitives.PostDispatchInfo, error))
+		_, fnErr = fn()
+	}).Return(primitives.PostDispatchInfo{}, dispatchErrOther)
+
+	_, err := target.dispatch(origin, mockCall)
+
+	assert.Equal(t, dispatchErrOther, err)
+	assert.Equal(t, primitives.NewDispatchErrorOther(sc.Str(assert.AnError.Error())), fnErr)
+	mockCall.AssertCalled(t, "Dispatch", origin, callArgs)
+}
+
+func setupModule() Module {
+	mockStorage = new(mocks.IoStorage)
+	mockTransactionBroker = new(mocks.IoTransactionBroker)
+	mockCurrency = new(mocks.ReservableCurrency)
+	mockSystemModule = new(mocks.SystemModule)
+	mockHashing = new(mocks.IoHashing)
+	mockTransactional = new(mocks.IoTransactional[primitives.PostDispatchInfo])
+	mockStorageMultisigs = new(mocks.StorageMap[multisigKey, Multisig])
+	mockCall = new(mocks.Call)
+
+	config := NewConfig(mockStorage, mockTransactionBroker, dbWeight, mockCurrency, mockSystemModule, depositBase, depositFactor, maxSignatories)
+
+	target := New(moduleId, config, mdGenerator, log.NewLogger())
+	target.storage.Multisigs = mockStorageMultisigs
+	target.transactional = mockTransactional
+	target.hashing = mockHashing
+
+	mockSystemModule.On("DepositEvent", mock.Anything)
+
+	return target
+}
+
+// expectCallHash expects the hash of the encoded call to be calculated.
+func expectCallHash() {
+	mockCall.On("Bytes").Return(callBytes)
+	mockHashing.On("Blake256", callBytes).Return(callHashBytes)
+}
+
+// expectMultiAccountId expects the multi-account id of the signatories to be derived.
+func expectMultiAccountId(threshold sc.U16) {
+	entropy := append([]byte("modlpy/utilisuba"), signatories.Bytes()...)
+	entropy = append(entropy, threshold.Bytes()...)
+	mockHashing.On("Blake256", entropy).Return(multiAccountHash)
+}
+
+func expectCallInfo() {
+	mockCall.On("BaseWeight").Return(callWeight)
+	mockCall.On("WeighData", callWeight).Return(callWeight)
+	mockCall.On("ClassifyDispatch", callWeight).Return(primitives.NewDispatchClassNormal())
+	mockCall.On("PaysFee", callWeight).Return(primitives.PaysYes)
+}
@@ -0,0 +1,23 @@
+package multisig
+
+import (
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/io"
+)
+
+var (
+	keyMultisig  = []byte("Multisig")
+	keyMultisigs = []byte("Multisigs")
+)
+
+type storage struct {
+	Multisigs support.StorageMap[multisigKey, Multisig]
+}
+
+func newStorage(s io.Storage) *storage {
+	hashing := io.NewHashing()
+
+	return &storage{
+		Multisigs: support.NewHashStorageMap[multisigKey, Multisig](s, keyMultisig, keyMultisigs, hashing.Twox64, DecodeMultisig),
+	}
+}
@@ -0,0 +1,99 @@
+package multisig
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Timepoint identifies an extrinsic by the height of its block and its index within that block.
+type Timepoint struct {
+	Height sc.U64
+	Index  sc.U32
+}
+
+func (t Timepoint) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, t.Height, t.Index)
+}
+
+func DecodeTimepoint(buffer *bytes.Buffer) (Timepoint, error) {
+	height, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return Timepoint{}, err
+	}
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return Timepoint{}, err
+	}
+
+	return Timepoint{
+		Height: height,
+		Index:  index,
+	}, nil
+}
+
+func (t Timepoint) Bytes() []byte {
+	return sc.EncodedBytes(t)
+}
+
+// Multisig is an open multisig operation.
+type Multisig struct {
+	// When is the extrinsic when the multisig operation was opened.
+	When Timepoint
+	// Deposit is the amount held in reserve of the depositor, to be returned once the operation ends.
+	Deposit primitives.Balance
+	// Depositor is the account who opened the operation.
+	Depositor primitives.AccountId
+	// Approvals are the sorted accounts which have approved the operation, including the depositor.
+	Approvals sc.Sequence[primitives.AccountId]
+}
+
+func (m Multisig) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, m.When, m.Deposit, m.Depositor, m.Approvals)
+}
+
+func DecodeMultisig(buffer *bytes.Buffer) (Multisig, error) {
+	when, err := DecodeTimepoint(buffer)
+	if err != nil {
+		return Multisig{}, err
+	}
+	deposit, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return Multisig{}, err
+	}
+	depositor, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return Multisig{}, err
+	}
+	approvals, err := sc.DecodeSequenceWith(buffer, primitives.DecodeAccountId)
+	if err != nil {
+		return Multisig{}, err
+	}
+
+	return Multisig{
+		When:      when,
+		Deposit:   deposit,
+		Depositor: depositor,
+		Approvals: approvals,
+	}, nil
+}
+
+func (m Multisig) Bytes() []byte {
+	return sc.EncodedBytes(m)
+}
+
+// multisigKey is the storage key of a multisig operation,
+// which is the multi-account id and the hash of the call to be dispatched.
+type multisigKey struct {
+	Multisig primitives.AccountId
+	CallHash primitives.H256
+}
+
+func (k multisigKey) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, k.Multisig, k.CallHash)
+}
+
+func (k multisigKey) Bytes() []byte {
+	return sc.EncodedBytes(k)
+}
@@ -0,0 +1,22 @@
+package offences
+
+import (
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/staking"
+)
+
+type Config struct {
+	Storage      io.Storage
+	SystemModule system.Module
+	// OnOffenceHandler acts on the offenders of each new report, e.g. by slashing and disabling them.
+	OnOffenceHandler staking.OnOffenceHandler
+}
+
+func NewConfig(storage io.Storage, systemModule system.Module, onOffenceHandler staking.OnOffenceHandler) Config {
+	return Config{
+		Storage:          storage,
+		SystemModule:     systemModule,
+		OnOffenceHandler: onOffenceHandler,
+	}
+}
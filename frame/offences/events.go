@@ -0,0 +1,54 @@
+package offences
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Offences module events.
+const (
+	EventOffence sc.U8 = iota
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid offences.Event module")
+	errInvalidEventType   = errors.New("invalid offences.Event type")
+)
+
+func newEventOffence(moduleIndex sc.U8, kind sc.FixedSequence[sc.U8], timeSlot sc.Sequence[sc.U8]) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventOffence, kind, timeSlot)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventOffence:
+		kind, err := sc.DecodeFixedSequence[sc.U8](staking.OffenceKindLength, buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		timeSlot, err := sc.DecodeSequence[sc.U8](buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventOffence(moduleIndex, kind, timeSlot), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
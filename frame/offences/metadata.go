@@ -0,0 +1,109 @@
+package offences
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func (m Module) Metadata() primitives.MetadataModule {
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](nil),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+		Event:   sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesOffencesEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesOffencesEvent, "pallet_offences::Event"),
+				},
+				m.index,
+				"Events.Offences"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](nil),
+		ErrorDef:  sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+		Index:     m.index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Reports",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesH256),
+					sc.ToCompact(metadata.TypesOffencesOffenceDetails)),
+				"The primary structure that holds all offence records keyed by report identifiers."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ConcurrentReportsIndex",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesTupleOffencesKindSequenceU8),
+					sc.ToCompact(metadata.TypesSequenceH256)),
+				"A vector of reports of the same kind that happened at the same time slot."),
+		},
+	})
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataType(metadata.TypesOffencesKind,
+			"Kind",
+			primitives.NewMetadataTypeDefinitionFixedSequence(staking.OffenceKindLength, sc.ToCompact(metadata.PrimitiveTypesU8))),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesOffencesOffenceDetails,
+			"OffenceDetails",
+			sc.Sequence[sc.Str]{"sp_staking", "offence", "OffenceDetails"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "offender", "Offender"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceAddress32, "reporters", "Vec<Reporter>"),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "Reporter"),
+				primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "Offender"),
+			}),
+
+		primitives.NewMetadataType(metadata.TypesTupleOffencesKindSequenceU8, "(Kind, OpaqueTimeSlot)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.TypesOffencesKind),
+				sc.ToCompact(metadata.TypesSequenceU8),
+			})),
+
+		primitives.NewMetadataType(metadata.TypesSequenceH256,
+			"Vec<ReportIdOf<T>>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesH256))),
+
+		primitives.NewMetadataTypeWithPath(
+			metadata.TypesOffencesEvent,
+			"pallet_offences pallet Event",
+			sc.Sequence[sc.Str]{"pallet_offences", "pallet", "Event"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Offence",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOffencesKind, "kind", "Kind"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "timeslot", "OpaqueTimeSlot"),
+						},
+						EventOffence,
+						"There is an offence reported of the given `kind` happened at the `session_index` and (kind-specific) time slot. This event is not deposited for duplicate slashes."),
+				})),
+	}
+}
@@ -0,0 +1,145 @@
+package offences
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	name = sc.Str("Offences")
+)
+
+// Module keeps track of reported offences and passes the offenders of each new report to an OnOffenceHandler.
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	index            sc.U8
+	functions        map[sc.U8]primitives.Call
+	storage          *storage
+	hashing          io.Hashing
+	systemModule     system.Module
+	onOffenceHandler staking.OnOffenceHandler
+	mdGenerator      *primitives.MetadataTypeGenerator
+	logger           log.RuntimeLogger
+}
+
+func New(index sc.U8, config Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.RuntimeLogger) Module {
+	return Module{
+		index:            index,
+		functions:        map[sc.U8]primitives.Call{},
+		storage:          newStorage(config.Storage),
+		hashing:          io.NewHashing(),
+		systemModule:     config.SystemModule,
+		onOffenceHandler: config.OnOffenceHandler,
+		mdGenerator:      mdGenerator,
+		logger:           logger,
+	}
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) GetIndex() sc.U8 { return m.index }
+
+func (m Module) Functions() map[sc.U8]primitives.Call { return m.functions }
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) { return sc.Empty{}, nil }
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// ReportOffence stores the report of `offence` by `reporters` for each offender, which has not been reported in the
+// same time slot yet. All concurrent offenders are then passed to the OnOffenceHandler with the slash fraction for
+// their count. Returns staking.ErrDuplicateOffenceReport if none of the offenders is new.
+func (m Module) ReportOffence(reporters sc.Sequence[primitives.AccountId], offence staking.Offence) error {
+	key := KindTimeSlot{
+		Kind:     offence.Kind(),
+		TimeSlot: sc.BytesToSequenceU8(offence.Slot().Bytes()),
+	}
+
+	concurrentReports, err := m.storage.ConcurrentReportsIndex.Get(key)
+	if err != nil {
+		return err
+	}
+
+	anyNew := false
+	for _, offender := range offence.Offenders() {
+		reportId, err := m.reportId(key, offender)
+		if err != nil {
+			return err
+		}
+		if m.storage.Reports.Exists(reportId) {
+			continue
+		}
+
+		anyNew = true
+		m.storage.Reports.Put(reportId, staking.OffenceDetails{
+			Offender:  offender,
+			Reporters: reporters,
+		})
+		concurrentReports = append(concurrentReports, reportId)
+	}
+
+	if !anyNew {
+		return staking.ErrDuplicateOffenceReport
+	}
+
+	m.storage.ConcurrentReportsIndex.Put(key, concurrentReports)
+
+	concurrentOffenders := make([]staking.OffenceDetails, 0, len(concurrentReports))
+	for _, reportId := range concurrentReports {
+		details, err := m.storage.Reports.Get(reportId)
+		if err != nil {
+			return err
+		}
+		concurrentOffenders = append(concurrentOffenders, details)
+	}
+
+	slashFraction := offence.SlashFraction(sc.U32(len(concurrentOffenders)))
+	slashFractions := make([]primitives.Perbill, len(concurrentOffenders))
+	for i := range slashFractions {
+		slashFractions[i] = slashFraction
+	}
+
+	if _, err := m.onOffenceHandler.OnOffence(concurrentOffenders, slashFractions, offence.Session()); err != nil {
+		return err
+	}
+
+	m.systemModule.DepositEvent(newEventOffence(m.index, key.Kind, key.TimeSlot))
+
+	return nil
+}
+
+// IsKnownOffence returns whether all `offenders` have already been reported for an offence of `kind` in `timeSlot`.
+func (m Module) IsKnownOffence(kind sc.FixedSequence[sc.U8], offenders sc.Sequence[primitives.AccountId], timeSlot sc.Encodable) (bool, error) {
+	key := KindTimeSlot{
+		Kind:     kind,
+		TimeSlot: sc.BytesToSequenceU8(timeSlot.Bytes()),
+	}
+
+	for _, offender := range offenders {
+		reportId, err := m.reportId(key, offender)
+		if err != nil {
+			return false, err
+		}
+		if !m.storage.Reports.Exists(reportId) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// reportId is the identifier of the report of `offender` for the offences identified by `key`.
+func (m Module) reportId(key KindTimeSlot, offender primitives.AccountId) (primitives.H256, error) {
+	preimage := append(key.Bytes(), offender.Bytes()...)
+
+	return primitives.NewH256(sc.BytesToSequenceU8(m.hashing.Blake256(preimage))...)
+}
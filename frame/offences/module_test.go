@@ -0,0 +1,236 @@
+package offences
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId     = 25
+	sessionIndex = sc.U32(3)
+)
+
+var (
+	kind     = sc.BytesToFixedSequenceU8([]byte("test:equivocatio"))
+	timeSlot = sc.U64(7)
+	key      = KindTimeSlot{
+		Kind:     kind,
+		TimeSlot: sc.BytesToSequenceU8(timeSlot.Bytes()),
+	}
+
+	offender      = constants.OneAccountId
+	otherOffender = constants.TwoAccountId
+	reporters     = sc.Sequence[primitives.AccountId]{constants.ZeroAccountId}
+
+	reportId, _      = primitives.NewH256(sc.BytesToSequenceU8(make([]byte, 32))...)
+	otherReportId, _ = primitives.NewH256(append(sc.BytesToSequenceU8(make([]byte, 31)), 1)...)
+
+	details = staking.OffenceDetails{
+		Offender:  offender,
+		Reporters: reporters,
+	}
+	otherDetails = staking.OffenceDetails{
+		Offender:  otherOffender,
+		Reporters: reporters,
+	}
+
+	slashFraction = primitives.NewPerbillFromPercent(9)
+
+	mdGenerator                           = primitives.NewMetadataTypeGenerator()
+	unknownTransactionNoUnsignedValidator = primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+)
+
+var (
+	mockStorage                       *mocks.IoStorage
+	mockHashing                       *mocks.IoHashing
+	mockSystemModule                  *mocks.SystemModule
+	mockOnOffenceHandler              *MockOnOffenceHandler
+	mockOffence                       *MockOffence
+	mockStorageReports                *mocks.StorageMap[primitives.H256, staking.OffenceDetails]
+	mockStorageConcurrentReportsIndex *mocks.StorageMap[KindTimeSlot, sc.Sequence[primitives.H256]]
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, 0, len(target.Functions()))
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(new(mocks.Call))
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.TransactionSource{}, new(mocks.Call))
+
+	assert.Equal(t, unknownTransactionNoUnsignedValidator, err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_ReportOffence(t *testing.T) {
+	target := setupModule()
+	expectOffence(sc.Sequence[primitives.AccountId]{offender})
+
+	mockStorageConcurrentReportsIndex.On("Get", key).Return(sc.Sequence[primitives.H256]{}, nil)
+	mockStorageReports.On("Exists", reportId).Return(false)
+	mockStorageReports.On("Put", reportId, details).Return()
+	mockStorageConcurrentReportsIndex.On("Put", key, sc.Sequence[primitives.H256]{reportId}).Return()
+	mockStorageReports.On("Get", reportId).Return(details, nil)
+	mockOffence.On("SlashFraction", sc.U32(1)).Return(slashFraction)
+	mockOnOffenceHandler.On("OnOffence", []staking.OffenceDetails{details}, []primitives.Perbill{slashFraction}, sessionIndex).Return(primitives.WeightZero(), nil)
+
+	err := target.ReportOffence(reporters, mockOffence)
+	assert.Nil(t, err)
+
+	mockStorageReports.AssertCalled(t, "Put", reportId, details)
+	mockStorageConcurrentReportsIndex.AssertCalled(t, "Put", key, sc.Sequence[primitives.H256]{reportId})
+	mockOnOffenceHandler.AssertCalled(t, "OnOffence", []staking.OffenceDetails{details}, []primitives.Perbill{slashFraction}, sessionIndex)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventOffence(moduleId, kind, key.TimeSlot))
+}
+
+func Test_Module_ReportOffence_IncludesConcurrentOffenders(t *testing.T) {
+	target := setupModule()
+	expectOffence(sc.Sequence[primitives.AccountId]{offender, otherOffender})
+
+	mockStorageConcurrentReportsIndex.On("Get", key).Return(sc.Sequence[primitives.H256]{reportId}, nil)
+	mockStorageReports.On("Exists", reportId).Return(true)
+	mockStorageReports.On("Exists", otherReportId).Return(false)
+	mockStorageReports.On("Put", otherReportId, otherDetails).Return()
+	mockStorageConcurrentReportsIndex.On("Put", key, sc.Sequence[primitives.H256]{reportId, otherReportId}).Return()
+	mockStorageReports.On("Get", reportId).Return(details, nil)
+	mockStorageReports.On("Get", otherReportId).Return(otherDetails, nil)
+	mockOffence.On("SlashFraction", sc.U32(2)).Return(slashFraction)
+	mockOnOffenceHandler.On("OnOffence", []staking.OffenceDetails{details, otherDetails}, []primitives.Perbill{slashFraction, slashFraction}, sessionIndex).Return(primitives.WeightZero(), nil)
+
+	err := target.ReportOffence(reporters, mockOffence)
+	assert.Nil(t, err)
+
+	mockStorageReports.AssertNotCalled(t, "Put", reportId, mock.Anything)
+	mockOnOffenceHandler.AssertCalled(t, "OnOffence", []staking.OffenceDetails{details, otherDetails}, []primitives.Perbill{slashFraction, slashFraction}, sessionIndex)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventOffence(moduleId, kind, key.TimeSlot))
+}
+
+func Test_Module_ReportOffence_DuplicateOffenceReport(t *testing.T) {
+	target := setupModule()
+	expectOffence(sc.Sequence[primitives.AccountId]{offender})
+
+	mockStorageConcurrentReportsIndex.On("Get", key).Return(sc.Sequence[primitives.H256]{reportId}, nil)
+	mockStorageReports.On("Exists", reportId).Return(true)
+
+	err := target.ReportOffence(reporters, mockOffence)
+	assert.Equal(t, staking.ErrDuplicateOffenceReport, err)
+
+	mockStorageConcurrentReportsIndex.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockOnOffenceHandler.AssertNotCalled(t, "OnOffence", mock.Anything, mock.Anything, mock.Anything)
+	mockSystemModule.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Module_ReportOffence_OnOffenceError(t *testing.T) {
+	target := setupModule()
+	expectOffence(sc.Sequence[primitives.AccountId]{offender})
+	expectErr := errors.New("on offence")
+
+	mockStorageConcurrentReportsIndex.On("Get", key).Return(sc.Sequence[primitives.H256]{}, nil)
+	mockStorageReports.On("Exists", reportId).Return(false)
+	mockStorageReports.On("Put", reportId, details).Return()
+	mockStorageConcurrentReportsIndex.On("Put", key, sc.Sequence[primitives.H256]{reportId}).Return()
+	mockStorageReports.On("Get", reportId).Return(details, nil)
+	mockOffence.On("SlashFraction", sc.U32(1)).Return(slashFraction)
+	mockOnOffenceHandler.On("OnOffence", []staking.OffenceDetails{details}, []primitives.Perbill{slashFraction}, sessionIndex).Return(primitives.WeightZero(), expectErr)
+
+	err := target.ReportOffence(reporters, mockOffence)
+	assert.Equal(t, expectErr, err)
+
+	mockSystemModule.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_Module_IsKnownOffence(t *testing.T) {
+	target := setupModule()
+
+	mockStorageReports.On("Exists", reportId).Return(true)
+	mockStorageReports.On("Exists", otherReportId).Return(true)
+
+	result, err := target.IsKnownOffence(kind, sc.Sequence[primitives.AccountId]{offender, otherOffender}, timeSlot)
+	assert.Nil(t, err)
+	assert.Equal(t, true, result)
+}
+
+func Test_Module_IsKnownOffence_Unknown(t *testing.T) {
+	target := setupModule()
+
+	mockStorageReports.On("Exists", reportId).Return(true)
+	mockStorageReports.On("Exists", otherReportId).Return(false)
+
+	result, err := target.IsKnownOffence(kind, sc.Sequence[primitives.AccountId]{offender, otherOffender}, timeSlot)
+	assert.Nil(t, err)
+	assert.Equal(t, false, result)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	result := target.Metadata()
+
+	assert.Equal(t, primitives.ModuleVersion14, result.Version)
+	assert.Equal(t, sc.Str("Offences"), result.ModuleV14.Name)
+	assert.Equal(t, sc.NewOption[sc.Compact](nil), result.ModuleV14.Call)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesOffencesEvent)), result.ModuleV14.Event)
+	assert.Equal(t, sc.NewOption[sc.Compact](nil), result.ModuleV14.Error)
+	assert.Equal(t, sc.Str("Offences"), result.ModuleV14.Storage.Value.Prefix)
+	assert.Equal(t, 2, len(result.ModuleV14.Storage.Value.Items))
+	assert.Equal(t, 0, len(result.ModuleV14.Constants))
+	assert.Equal(t, sc.U8(moduleId), result.ModuleV14.Index)
+}
+
+func expectOffence(offenders sc.Sequence[primitives.AccountId]) {
+	mockOffence.On("Kind").Return(kind)
+	mockOffence.On("Slot").Return(timeSlot)
+	mockOffence.On("Offenders").Return(offenders)
+	mockOffence.On("Session").Return(sessionIndex)
+}
+
+func setupModule() Module {
+	mockStorage = new(mocks.IoStorage)
+	mockHashing = new(mocks.IoHashing)
+	mockSystemModule = new(mocks.SystemModule)
+	mockOnOffenceHandler = new(MockOnOffenceHandler)
+	mockOffence = new(MockOffence)
+	mockStorageReports = new(mocks.StorageMap[primitives.H256, staking.OffenceDetails])
+	mockStorageConcurrentReportsIndex = new(mocks.StorageMap[KindTimeSlot, sc.Sequence[primitives.H256]])
+
+	config := NewConfig(mockStorage, mockSystemModule, mockOnOffenceHandler)
+
+	target := New(moduleId, config, mdGenerator, log.NewLogger())
+	target.storage.Reports = mockStorageReports
+	target.storage.ConcurrentReportsIndex = mockStorageConcurrentReportsIndex
+	target.hashing = mockHashing
+
+	mockHashing.On("Blake256", append(key.Bytes(), offender.Bytes()...)).Return(reportId.Bytes())
+	mockHashing.On("Blake256", append(key.Bytes(), otherOffender.Bytes()...)).Return(otherReportId.Bytes())
+	mockSystemModule.On("DepositEvent", mock.Anything)
+
+	return target
+}
@@ -0,0 +1,41 @@
+package offences
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockOffence struct {
+	mock.Mock
+}
+
+func (m *MockOffence) Kind() sc.FixedSequence[sc.U8] {
+	args := m.Called()
+	return args.Get(0).(sc.FixedSequence[sc.U8])
+}
+
+func (m *MockOffence) Offenders() sc.Sequence[primitives.AccountId] {
+	args := m.Called()
+	return args.Get(0).(sc.Sequence[primitives.AccountId])
+}
+
+func (m *MockOffence) Session() sc.U32 {
+	args := m.Called()
+	return args.Get(0).(sc.U32)
+}
+
+func (m *MockOffence) ValidatorSetSize() sc.U32 {
+	args := m.Called()
+	return args.Get(0).(sc.U32)
+}
+
+func (m *MockOffence) Slot() sc.Encodable {
+	args := m.Called()
+	return args.Get(0).(sc.Encodable)
+}
+
+func (m *MockOffence) SlashFraction(offendersCount sc.U32) primitives.Perbill {
+	args := m.Called(offendersCount)
+	return args.Get(0).(primitives.Perbill)
+}
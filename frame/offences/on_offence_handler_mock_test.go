@@ -0,0 +1,22 @@
+package offences
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockOnOffenceHandler struct {
+	mock.Mock
+}
+
+func (m *MockOnOffenceHandler) OnOffence(offenders []staking.OffenceDetails, slashFraction []primitives.Perbill, sessionIndex sc.U32) (primitives.Weight, error) {
+	args := m.Called(offenders, slashFraction, sessionIndex)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(primitives.Weight), args.Get(1).(error)
+	}
+
+	return args.Get(0).(primitives.Weight), nil
+}
@@ -0,0 +1,39 @@
+package offences
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	keyOffences               = []byte("Offences")
+	keyReports                = []byte("Reports")
+	keyConcurrentReportsIndex = []byte("ConcurrentReportsIndex")
+)
+
+var (
+	defaultReportIds = sc.Sequence[primitives.H256]{}
+)
+
+type storage struct {
+	Reports                support.StorageMap[primitives.H256, staking.OffenceDetails]
+	ConcurrentReportsIndex support.StorageMap[KindTimeSlot, sc.Sequence[primitives.H256]]
+}
+
+func newStorage(s io.Storage) *storage {
+	hashing := io.NewHashing()
+
+	return &storage{
+		Reports:                support.NewHashStorageMap[primitives.H256, staking.OffenceDetails](s, keyOffences, keyReports, hashing.Twox64, staking.DecodeOffenceDetails),
+		ConcurrentReportsIndex: support.NewHashStorageMapWithDefault[KindTimeSlot, sc.Sequence[primitives.H256]](s, keyOffences, keyConcurrentReportsIndex, hashing.Twox64, decodeReportIds, &defaultReportIds),
+	}
+}
+
+func decodeReportIds(buffer *bytes.Buffer) (sc.Sequence[primitives.H256], error) {
+	return sc.DecodeSequenceWith(buffer, primitives.DecodeH256)
+}
@@ -0,0 +1,22 @@
+package offences
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// KindTimeSlot identifies the concurrent offences, i.e. the offences of the same kind in the same time slot.
+type KindTimeSlot struct {
+	Kind sc.FixedSequence[sc.U8]
+	// TimeSlot is the encoded time slot of the offences.
+	TimeSlot sc.Sequence[sc.U8]
+}
+
+func (kts KindTimeSlot) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, kts.Kind, kts.TimeSlot)
+}
+
+func (kts KindTimeSlot) Bytes() []byte {
+	return sc.EncodedBytes(kts)
+}
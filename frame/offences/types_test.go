@@ -0,0 +1,21 @@
+package offences
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_KindTimeSlot_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := key.Encode(buffer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, append(kind.Bytes(), key.TimeSlot.Bytes()...), buffer.Bytes())
+}
+
+func Test_KindTimeSlot_Bytes(t *testing.T) {
+	assert.Equal(t, append(kind.Bytes(), key.TimeSlot.Bytes()...), key.Bytes())
+}
@@ -0,0 +1,46 @@
+package parachain_system
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/parachain"
+)
+
+// ChannelInfo provides the state of the outbound HRMP channels, as of the `RelevantMessagingState`,
+// which reflects the bandwidth used by the unincluded segment once the block is finalized.
+type ChannelInfo struct {
+	storage *storage
+}
+
+func NewChannelInfo(storage io.Storage) ChannelInfo {
+	return ChannelInfo{
+		storage: newStorage(storage),
+	}
+}
+
+// GetChannelStatus returns the status of the outbound HRMP channel to `recipient`.
+func (ci ChannelInfo) GetChannelStatus(recipient sc.U32) (parachain.ChannelStatus, error) {
+	channel, err := ci.GetChannelInfo(recipient)
+	if err != nil {
+		return parachain.ChannelStatus{}, err
+	}
+	if !channel.HasValue {
+		return parachain.NewChannelStatusClosed(), nil
+	}
+
+	return parachain.NewChannelStatusFromChannel(channel.Value), nil
+}
+
+// GetChannelInfo returns the outbound HRMP channel to `recipient`, if it exists.
+func (ci ChannelInfo) GetChannelInfo(recipient sc.U32) (sc.Option[parachain.AbridgedHRMPChannel], error) {
+	if !ci.storage.RelevantMessagingState.Exists() {
+		return sc.NewOption[parachain.AbridgedHRMPChannel](nil), nil
+	}
+
+	messagingState, err := ci.storage.RelevantMessagingState.Get()
+	if err != nil {
+		return sc.NewOption[parachain.AbridgedHRMPChannel](nil), err
+	}
+
+	return messagingState.EgressChannel(recipient), nil
+}
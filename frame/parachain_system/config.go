@@ -1,6 +1,7 @@
 package parachain_system
 
 import (
+	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/frame/parachain_info"
 	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/primitives/io"
@@ -14,9 +15,18 @@ type Config struct {
 	SelfParaId                 parachain_info.Module
 	systemModule               system.Module
 	ConsensusHook              ConsensusHook
+	DmpMessageHandler          DmpMessageHandler
+	XcmpMessageHandler         XcmpMessageHandler
+	OutboundXcmpMessageSource  OutboundXcmpMessageSource
+	// ReservedDmpWeight is the weight reserved for processing the downward messages of a block.
+	ReservedDmpWeight primitives.Weight
+	// ReservedXcmpWeight is the weight reserved for processing the horizontal messages of a block.
+	ReservedXcmpWeight primitives.Weight
+	// MaxInboundMessageLen is the maximum length of an inbound message. Longer messages are dropped.
+	MaxInboundMessageLen sc.U32
 }
 
-func NewConfig(storage io.Storage, dbWeight primitives.RuntimeDbWeight, checkAssociatedRelayNumber CheckAssociatedRelayNumber, selfParaId parachain_info.Module, systemModule system.Module, consensusHook ConsensusHook) Config {
+func NewConfig(storage io.Storage, dbWeight primitives.RuntimeDbWeight, checkAssociatedRelayNumber CheckAssociatedRelayNumber, selfParaId parachain_info.Module, systemModule system.Module, consensusHook ConsensusHook, dmpMessageHandler DmpMessageHandler, xcmpMessageHandler XcmpMessageHandler, outboundXcmpMessageSource OutboundXcmpMessageSource, reservedDmpWeight primitives.Weight, reservedXcmpWeight primitives.Weight, maxInboundMessageLen sc.U32) Config {
 	return Config{
 		Storage:                    storage,
 		DbWeight:                   dbWeight,
@@ -24,5 +34,11 @@ func NewConfig(storage io.Storage, dbWeight primitives.RuntimeDbWeight, checkAss
 		SelfParaId:                 selfParaId,
 		systemModule:               systemModule,
 		ConsensusHook:              consensusHook,
+		DmpMessageHandler:          dmpMessageHandler,
+		XcmpMessageHandler:         xcmpMessageHandler,
+		OutboundXcmpMessageSource:  outboundXcmpMessageSource,
+		ReservedDmpWeight:          reservedDmpWeight,
+		ReservedXcmpWeight:         reservedXcmpWeight,
+		MaxInboundMessageLen:       maxInboundMessageLen,
 	}
 }
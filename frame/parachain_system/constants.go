@@ -1,16 +1,18 @@
 package parachain_system
 
 import (
+	sc "github.com/LimeChain/goscale"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 const thresholdFactor = 2
 
-// TODO: FixedU128
-const exponentialFeeBase = 105
-
-// TODO: FixedU128
-const messageSizeFeeBase = 1000
+var (
+	// exponentialFeeBase is the factor, by which the delivery fee factor grows and decays, `1.05`.
+	exponentialFeeBase = primitives.NewFixedU128FromRational(sc.NewU128(105), sc.NewU128(100))
+	// messageSizeFeeBase is the additional growth of the delivery fee factor per KiB of a message, `0.001`.
+	messageSizeFeeBase = primitives.NewFixedU128FromRational(sc.NewU128(1), sc.NewU128(1000))
+)
 
 type consts struct {
 	DbWeight primitives.RuntimeDbWeight
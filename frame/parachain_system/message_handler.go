@@ -0,0 +1,46 @@
+package parachain_system
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/parachain"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// DmpMessageHandler consumes the downward messages, received from the relay chain.
+type DmpMessageHandler interface {
+	// HandleDmpMessages handles the downward `messages`, in the order they were sent, using at most `maxWeight`.
+	// Returns the weight consumed.
+	HandleDmpMessages(messages sc.Sequence[parachain.InboundDownwardMessage], maxWeight primitives.Weight) primitives.Weight
+}
+
+// XcmpMessageHandler consumes the horizontal messages, received from other parachains.
+type XcmpMessageHandler interface {
+	// HandleXcmpMessages handles the horizontal `messages`, ordered by the relay chain block they were sent at
+	// and then by sender, using at most `maxWeight`. Returns the weight consumed.
+	HandleXcmpMessages(messages sc.Sequence[parachain.InboundXcmpMessage], maxWeight primitives.Weight) primitives.Weight
+}
+
+// DefaultMessageHandler drops all inbound messages.
+type DefaultMessageHandler struct{}
+
+func (dmh DefaultMessageHandler) HandleDmpMessages(_ sc.Sequence[parachain.InboundDownwardMessage], _ primitives.Weight) primitives.Weight {
+	return primitives.WeightZero()
+}
+
+func (dmh DefaultMessageHandler) HandleXcmpMessages(_ sc.Sequence[parachain.InboundXcmpMessage], _ primitives.Weight) primitives.Weight {
+	return primitives.WeightZero()
+}
+
+// OutboundXcmpMessageSource provides the horizontal messages, which are sent to other parachains.
+type OutboundXcmpMessageSource interface {
+	// TakeOutboundMessages takes at most `maxMessageCount` messages, at most one per recipient, which fit in the
+	// outbound HRMP channels. The messages are ordered by recipient.
+	TakeOutboundMessages(maxMessageCount sc.U32) (sc.Sequence[parachain.OutboundHrmpMessage], error)
+}
+
+// DefaultOutboundXcmpMessageSource sends no horizontal messages.
+type DefaultOutboundXcmpMessageSource struct{}
+
+func (doxms DefaultOutboundXcmpMessageSource) TakeOutboundMessages(_ sc.U32) (sc.Sequence[parachain.OutboundHrmpMessage], error) {
+	return sc.Sequence[parachain.OutboundHrmpMessage]{}, nil
+}
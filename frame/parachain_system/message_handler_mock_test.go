@@ -0,0 +1,28 @@
+package parachain_system
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/parachain"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDmpMessageHandler struct {
+	mock.Mock
+}
+
+func (m *MockDmpMessageHandler) HandleDmpMessages(messages sc.Sequence[parachain.InboundDownwardMessage], maxWeight primitives.Weight) primitives.Weight {
+	args := m.Called(messages, maxWeight)
+
+	return args.Get(0).(primitives.Weight)
+}
+
+type MockXcmpMessageHandler struct {
+	mock.Mock
+}
+
+func (m *MockXcmpMessageHandler) HandleXcmpMessages(messages sc.Sequence[parachain.InboundXcmpMessage], maxWeight primitives.Weight) primitives.Weight {
+	args := m.Called(messages, maxWeight)
+
+	return args.Get(0).(primitives.Weight)
+}
@@ -190,6 +190,15 @@ func (m module) metadataTypes() sc.Sequence[primitives.MetadataType] {
 			"[](u32, AbridgedHrmpChannel)",
 			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleU32AbridgedHrmpChannel))),
 
+		primitives.NewMetadataType(metadata.TypesTupleU32H256, "(u32, H256)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.ToCompact(metadata.TypesH256),
+			})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleU32H256,
+			"[](u32, H256)",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleU32H256))),
+
 		primitives.NewMetadataTypeWithPath(
 			metadata.TypesMessagingStateSnapshot,
 			"cumulus primitives parachain_system MessagingStateSnapshot",
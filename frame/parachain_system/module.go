@@ -3,6 +3,7 @@ package parachain_system
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"reflect"
 
 	sc "github.com/LimeChain/goscale"
@@ -250,11 +251,70 @@ func (m module) OnFinalize(_ sc.U64) error {
 		return err
 	}
 
-	_ = totalBandwidthOut
-	_ = hostConfig
-	_ = relayUpgradeGoAhead
+	announcedHrmpMessages, err := m.storage.AnnouncedHrmpMessagesPerCandidate.Take()
+	if err != nil {
+		return err
+	}
+	maximumChannels := sc.Min32(hostConfig.MaxHrmpMessageNumPerCandidate, announcedHrmpMessages)
+
+	outboundMessages, err := m.config.OutboundXcmpMessageSource.TakeOutboundMessages(maximumChannels)
+	if err != nil {
+		return err
+	}
+
+	umpMsgCount, umpTotalBytes, err := m.sendPendingUpwardMessages(hostConfig)
+	if err != nil {
+		return err
+	}
+
+	hrmpOutgoing := sc.Dictionary[sc.U32, parachain.HrmpChannelUpdate]{}
+	for _, message := range outboundMessages {
+		hrmpOutgoing[message.Id] = parachain.HrmpChannelUpdate{
+			MsgCount:   1,
+			TotalBytes: sc.U32(len(message.Data)),
+		}
+	}
+
+	aggregatedSegment, err := m.storage.AggregatedUnincludedSegment.Get()
+	if err != nil {
+		return err
+	}
+
+	// The go ahead signal is consumed only once by the unincluded segment.
+	consumedGoAheadSignal := relayUpgradeGoAhead
+	if aggregatedSegment.ConsumedGoAheadSignal.HasValue {
+		consumedGoAheadSignal = sc.NewOption[sc.U8](nil)
+	}
 
-	// TODO: Handle messages
+	ancestor := parachain.Ancestor{
+		UsedBandwidth: parachain.UsedBandwidth{
+			UmpMsgCount:   umpMsgCount,
+			UmpTotalBytes: umpTotalBytes,
+			HrmpOutgoing:  hrmpOutgoing,
+		},
+		ParaHeadHash:          sc.NewOption[primitives.H256](nil),
+		ConsumedGoAheadSignal: consumedGoAheadSignal,
+	}
+
+	hrmpWatermark, err := m.storage.HrmpWatermark.Get()
+	if err != nil {
+		return err
+	}
+
+	err = aggregatedSegment.Append(ancestor, hrmpWatermark, validationData.RelayParentNumber, totalBandwidthOut)
+	if err != nil {
+		return fmt.Errorf("unincluded segment limits exceeded: %w", err)
+	}
+	m.storage.AggregatedUnincludedSegment.Put(aggregatedSegment)
+
+	unincludedSegment, err := m.storage.UnincludedSegment.Get()
+	if err != nil {
+		return err
+	}
+	unincludedSegment.Ancestors = append(unincludedSegment.Ancestors, ancestor)
+	m.storage.UnincludedSegment.Put(unincludedSegment)
+
+	m.storage.HrmpOutboundMessages.Put(outboundMessages)
 
 	return nil
 }
@@ -491,6 +551,8 @@ func (m module) dropProcessedMessagesFromInherent(parachainInherent parachain.In
 	}, nil
 }
 
+// enqueueInboundDownwardMessages verifies the inbound downward messages relayed by the collator against the
+// expected MQC head and delivers them to the `DmpMessageHandler`.
 func (m module) enqueueInboundDownwardMessages(expectedDmqMqcHead primitives.H256, downwardMessages sc.Sequence[parachain.InboundDownwardMessage]) (primitives.Weight, error) {
 	dmCount := len(downwardMessages)
 
@@ -510,23 +572,6 @@ func (m module) enqueueInboundDownwardMessages(expectedDmqMqcHead primitives.H25
 				return primitives.Weight{}, err
 			}
 		}
-		// TODO(message queue):
-		/*
-			let bounded = downward_messages
-							.iter()
-							// Note: we are not using `.defensive()` here since that prints the whole value to
-							// console. In case that the message is too long, this clogs up the log quite badly.
-							.filter_map(|m| match BoundedSlice::try_from(&m.msg[..]) {
-								Ok(bounded) => Some(bounded),
-								Err(_) => {
-									defensive!("Inbound Downward message was too long; dropping");
-									None
-								},
-							});
-						T::DmpQueue::handle_messages(bounded);
-		*/
-		m.storage.LastDmqMqcHead.Put(dmqHead)
-		m.config.systemModule.DepositEvent(newEventDownwardMessagesProcessed(m.index, weightUsed, dmqHead.RelayHash))
 	}
 
 	// After hashing each message in the message queue chain submitted by the collator, we
@@ -534,22 +579,112 @@ func (m module) enqueueInboundDownwardMessages(expectedDmqMqcHead primitives.H25
 	//
 	// A mismatch means that at least some of the submitted messages were altered, omitted or
 	// added improperly.
-	if reflect.DeepEqual(expectedDmqMqcHead, dmqHead.RelayHash) {
+	if !bytes.Equal(expectedDmqMqcHead.Bytes(), dmqHead.RelayHash.Bytes()) {
 		return primitives.Weight{}, errors.New("mismatching expected mqc head")
 	}
 
+	if dmCount != 0 {
+		bounded := sc.Sequence[parachain.InboundDownwardMessage]{}
+		for _, downwardMessage := range downwardMessages {
+			if sc.U32(len(downwardMessage.Msg)) > m.config.MaxInboundMessageLen {
+				m.logger.Warnf("inbound downward message of length [%d] was too long; dropping", len(downwardMessage.Msg))
+				continue
+			}
+			bounded = append(bounded, downwardMessage)
+		}
+
+		weightUsed = weightUsed.SaturatingAdd(m.config.DmpMessageHandler.HandleDmpMessages(bounded, m.config.ReservedDmpWeight))
+
+		m.storage.LastDmqMqcHead.Put(dmqHead)
+		m.config.systemModule.DepositEvent(newEventDownwardMessagesProcessed(m.index, weightUsed, dmqHead.RelayHash))
+	}
+
+	m.storage.ProcessedDownwardMessages.Put(sc.U32(dmCount))
+
 	return weightUsed, nil
 }
 
 // enqueueInboundHorizontalMessages processes all inbound horizontal messages relayed by the collator.
+// The messages are verified against the MQC heads of the ingress channels and delivered to the `XcmpMessageHandler`.
 func (m module) enqueueInboundHorizontalMessages(
 	ingressChannels sc.Sequence[parachain.Channel],
 	horizontalMessages parachain.HorizontalMessages,
 	relayParentNumber parachain.RelayChainBlockNumber) (primitives.Weight, error) {
-	// TODO: process all inbound horizontal messages
+	weightUsed := m.config.DbWeight.ReadsWrites(1, 2)
+
+	// Check that the collator submitted messages only from the senders, which have an open channel to this parachain.
+	for _, sender := range horizontalMessages.Senders() {
+		if !hasIngressChannel(ingressChannels, sender) {
+			return primitives.Weight{}, errors.New("one of the messages submitted by the collator was sent from a sender that doesn't have a channel opened to this parachain")
+		}
+	}
+
+	lastMqcHeads, err := m.storage.LastHrmpMqcHeads.Get()
+	if err != nil {
+		return primitives.Weight{}, err
+	}
+
+	runningMqcHeads := map[sc.U32]parachain.MessageQueueChain{}
+	for _, lastMqcHead := range lastMqcHeads {
+		runningMqcHeads[lastMqcHead.ParaId] = lastMqcHead.Head
+	}
+
+	messages := horizontalMessages.OrderedMessages()
+	bounded := sc.Sequence[parachain.InboundXcmpMessage]{}
+	for _, message := range messages {
+		if message.SentAt > relayParentNumber {
+			return primitives.Weight{}, errors.New("horizontal message was sent after the relay parent")
+		}
+
+		mqcHead, ok := runningMqcHeads[message.Sender]
+		if !ok {
+			mqcHead = parachain.NewEmptyMessageQueueChain()
+		}
+		err := mqcHead.ExtendHrmp(parachain.InboundHrmpMessage{SentAt: message.SentAt, Data: message.Data}, m.hashing)
+		if err != nil {
+			return primitives.Weight{}, err
+		}
+		runningMqcHeads[message.Sender] = mqcHead
+
+		if sc.U32(len(message.Data)) > m.config.MaxInboundMessageLen {
+			m.logger.Warnf("inbound horizontal message of length [%d] from [%d] was too long; dropping", len(message.Data), message.Sender)
+			continue
+		}
+		bounded = append(bounded, message)
+	}
+
+	// Check that the MQC heads for each channel provided by the relay chain match the MQC heads
+	// we have after processing all incoming messages.
+	//
+	// A mismatch means that at least some of the submitted messages were altered, omitted or
+	// added improperly.
+	newMqcHeads := sc.Sequence[parachain.HrmpMqcHead]{}
+	for _, channel := range ingressChannels {
+		mqcHead, ok := runningMqcHeads[channel.ParachainId]
+		if !ok {
+			mqcHead = parachain.NewEmptyMessageQueueChain()
+		}
+
+		expectedMqcHead := parachain.NewEmptyMessageQueueChain().RelayHash
+		if channel.AbridgedHRMPChannel.MqcHead.HasValue {
+			expectedMqcHead = channel.AbridgedHRMPChannel.MqcHead.Value
+		}
+
+		if !bytes.Equal(expectedMqcHead.Bytes(), mqcHead.RelayHash.Bytes()) {
+			return primitives.Weight{}, errors.New("mismatching expected mqc head of ingress channel")
+		}
+
+		newMqcHeads = append(newMqcHeads, parachain.HrmpMqcHead{ParaId: channel.ParachainId, Head: mqcHead})
+	}
+
+	if len(bounded) > 0 {
+		weightUsed = weightUsed.SaturatingAdd(m.config.XcmpMessageHandler.HandleXcmpMessages(bounded, m.config.ReservedXcmpWeight))
+	}
+
+	m.storage.LastHrmpMqcHeads.Put(newMqcHeads)
 	m.storage.HrmpWatermark.Put(relayParentNumber)
 
-	return primitives.Weight{}, nil
+	return weightUsed, nil
 }
 
 // adjustEgressBandwidthLimits adjusts the `RelevantMessagingState` according to the bandwidth limits in the
@@ -563,7 +698,7 @@ func (m module) adjustEgressBandwidthLimits() error {
 		return nil
 	}
 
-	_, err = parachain.DecodeSegmentTracker(bytes.NewBuffer(sc.SequenceU8ToBytes(bytesUnincludedSegment.Value)))
+	unincludedSegment, err := parachain.DecodeSegmentTracker(bytes.NewBuffer(sc.SequenceU8ToBytes(bytesUnincludedSegment.Value)))
 	if err != nil {
 		return err
 	}
@@ -573,14 +708,93 @@ func (m module) adjustEgressBandwidthLimits() error {
 		return err
 	}
 	if !bytesMessagingState.HasValue {
+		return nil
+	}
+
+	messagingState, err := parachain.DecodeMessagingStateSnapshot(bytes.NewBuffer(sc.SequenceU8ToBytes(bytesMessagingState.Value)))
+	if err != nil {
 		return err
 	}
 
-	// TODO: Update RelevantMessagingState
+	usedBandwidth := unincludedSegment.UsedBandwidth
+
+	for i, channel := range messagingState.EgressChannels {
+		channelUpdate, ok := usedBandwidth.HrmpOutgoing[channel.ParachainId]
+		if !ok {
+			continue
+		}
+
+		c := channel.AbridgedHRMPChannel
+		c.TotalSize = sc.U32(sc.Min64(sc.SaturatingAddU64(sc.U64(c.TotalSize), sc.U64(channelUpdate.TotalBytes)), sc.U64(c.MaxTotalSize)))
+		c.MsgCount = sc.U32(sc.Min64(sc.SaturatingAddU64(sc.U64(c.MsgCount), sc.U64(channelUpdate.MsgCount)), sc.U64(c.MaxCapacity)))
+		messagingState.EgressChannels[i].AbridgedHRMPChannel = c
+	}
+
+	upwardCapacity := messagingState.RelayDispatchQueueRemainingCapacity
+	upwardCapacity.RemainingCount = sc.U32(sc.SaturatingSubU64(sc.U64(upwardCapacity.RemainingCount), sc.U64(usedBandwidth.UmpMsgCount)))
+	upwardCapacity.RemainingSize = sc.U32(sc.SaturatingSubU64(sc.U64(upwardCapacity.RemainingSize), sc.U64(usedBandwidth.UmpTotalBytes)))
+	messagingState.RelayDispatchQueueRemainingCapacity = upwardCapacity
+
+	m.storage.RelevantMessagingState.Put(messagingState)
 
 	return nil
 }
 
+// sendPendingUpwardMessages moves as many of the `PendingUpwardMessages` to `UpwardMessages` as fit in the
+// remaining relay dispatch queue capacity. Decreases the delivery fee factor if the remaining pending messages
+// are below the congestion threshold. Returns the count and total size of the sent messages.
+func (m module) sendPendingUpwardMessages(hostConfig parachain.AbridgedHostConfiguration) (sc.U32, sc.U32, error) {
+	pendingMessages, err := m.storage.PendingUpwardMessages.Get()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	availableCapacity, availableSize := hostConfig.MaxUpwardQueueCount, hostConfig.MaxUpwardQueueSize
+	bytesMessagingState, err := m.storage.RelevantMessagingState.GetBytes()
+	if err != nil {
+		return 0, 0, err
+	}
+	if bytesMessagingState.HasValue {
+		messagingState, err := parachain.DecodeMessagingStateSnapshot(bytes.NewBuffer(sc.SequenceU8ToBytes(bytesMessagingState.Value)))
+		if err != nil {
+			return 0, 0, err
+		}
+		availableCapacity = messagingState.RelayDispatchQueueRemainingCapacity.RemainingCount
+		availableSize = messagingState.RelayDispatchQueueRemainingCapacity.RemainingSize
+	}
+	availableCapacity = sc.Min32(availableCapacity, hostConfig.MaxUpwardMessageNumPerCandidate)
+
+	num, totalSize := sc.U32(0), sc.U32(0)
+	for _, message := range pendingMessages {
+		newSize := sc.U64(totalSize) + sc.U64(len(message))
+		if sc.U64(num)+1 > sc.U64(availableCapacity) || newSize > sc.U64(availableSize) {
+			break
+		}
+		num++
+		totalSize = sc.U32(newSize)
+	}
+
+	m.storage.UpwardMessages.Put(pendingMessages[:num])
+	remainingMessages := pendingMessages[num:]
+	m.storage.PendingUpwardMessages.Put(remainingMessages)
+
+	// If the total size of the pending messages is less than the threshold,
+	// decrease the fee factor.
+	threshold := int(hostConfig.MaxUpwardQueueSize) / thresholdFactor
+	remainingTotalSize := 0
+	for _, message := range remainingMessages {
+		remainingTotalSize += len(message)
+	}
+	if remainingTotalSize <= threshold {
+		err := m.decreaseFeeFactor()
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return num, totalSize, nil
+}
+
 // sendUpwardMessage puts a message in the `PendingUpwardMessages` storage item.
 // The message will be later sent in `on_finalize`.
 // Checks host configuration to see if message is too big.
@@ -612,8 +826,7 @@ func (m module) sendUpwardMessage(data sc.Sequence[sc.U8]) error {
 			totalSize += len(um)
 		}
 		if totalSize > threshold {
-			messageSizeFactor := len(data) * messageSizeFeeBase
-			err := m.increaseFeeFactor(messageSizeFactor)
+			err := m.increaseFeeFactor(len(data))
 			if err != nil {
 				return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
 			}
@@ -633,14 +846,33 @@ func (m module) sendUpwardMessage(data sc.Sequence[sc.U8]) error {
 	return nil
 }
 
-func (m module) increaseFeeFactor(messageSizeFactor int) error {
+// increaseFeeFactor increases the delivery fee factor by `exponentialFeeBase` and by `messageSizeFeeBase`
+// for each KiB of the message of length `messageLen`.
+func (m module) increaseFeeFactor(messageLen int) error {
 	deliveryFactor, err := m.storage.UpwardDeliveryFeeFactor.Get()
 	if err != nil {
 		return err
 	}
 
-	multiplier := sc.SaturatingAddU128(sc.NewU128(exponentialFeeBase), sc.NewU128(messageSizeFactor))
-	newDeliveryFactor := deliveryFactor.Mul(multiplier)
+	messageSizeFactor := primitives.NewFixedU128FromInteger(sc.NewU128(messageLen / 1024)).SaturatingMul(messageSizeFeeBase)
+	newDeliveryFactor := deliveryFactor.SaturatingMul(exponentialFeeBase.SaturatingAdd(messageSizeFactor))
+
+	m.storage.UpwardDeliveryFeeFactor.Put(newDeliveryFactor)
+
+	return nil
+}
+
+// decreaseFeeFactor decreases the delivery fee factor by `exponentialFeeBase`, down to its initial value.
+func (m module) decreaseFeeFactor() error {
+	deliveryFactor, err := m.storage.UpwardDeliveryFeeFactor.Get()
+	if err != nil {
+		return err
+	}
+
+	newDeliveryFactor := deliveryFactor.SaturatingDiv(exponentialFeeBase)
+	if newDeliveryFactor.Lt(defaultInitialDeliveryFeeFactor) {
+		newDeliveryFactor = defaultInitialDeliveryFeeFactor
+	}
 
 	m.storage.UpwardDeliveryFeeFactor.Put(newDeliveryFactor)
 
@@ -652,6 +884,16 @@ func (m module) notifyPolkadotOfPendingUpgrade(code sc.Sequence[sc.U8]) {
 	m.storage.DidSetValidationCode.Put(true)
 }
 
+// hasIngressChannel checks if there is an open channel from parachain `sender` in `ingressChannels`.
+func hasIngressChannel(ingressChannels sc.Sequence[parachain.Channel], sender sc.U32) bool {
+	for _, channel := range ingressChannels {
+		if channel.ParachainId == sender {
+			return true
+		}
+	}
+	return false
+}
+
 func (m module) Metadata() primitives.MetadataModule {
 	dataV14 := primitives.MetadataModuleV14{
 		Name:    m.name(),
@@ -799,6 +1041,14 @@ func (m module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
 					" This value is loaded before and saved after processing inbound downward messages carried"+
 					" by the system inherent.",
 			),
+			primitives.NewMetadataModuleStorageEntry(
+				"LastHrmpMqcHeads",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceTupleU32H256)),
+				"The message queue chain heads we have observed per each incoming channel."+
+					" This value is loaded before and saved after processing inbound horizontal messages carried"+
+					" by the system inherent.",
+			),
 			primitives.NewMetadataModuleStorageEntry(
 				"ProcessedDownwardMessages",
 				primitives.MetadataModuleStorageEntryModifierDefault,
@@ -827,7 +1077,7 @@ func (m module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
 			primitives.NewMetadataModuleStorageEntry(
 				"UpwardDeliveryFeeFactor",
 				primitives.MetadataModuleStorageEntryModifierDefault,
-				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU128)),
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesFixedU128)),
 				"The factor to multiply the base delivery fee by for UMP."),
 			primitives.NewMetadataModuleStorageEntry(
 				"AnnouncedHrmpMessagesPerCandidate",
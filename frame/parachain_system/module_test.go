@@ -0,0 +1,316 @@
+package parachain_system
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/parachain"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId             = sc.U8(1)
+	maxInboundMessageLen = sc.U32(4)
+	relayParentNumber    = parachain.RelayChainBlockNumber(10)
+	senderOne            = sc.U32(1000)
+	senderTwo            = sc.U32(2000)
+)
+
+var (
+	dbWeight           = primitives.RuntimeDbWeight{Read: 1, Write: 2}
+	reservedDmpWeight  = primitives.WeightFromParts(1_000, 0)
+	reservedXcmpWeight = primitives.WeightFromParts(2_000, 0)
+	handlerWeight      = primitives.WeightFromParts(100, 0)
+	mdGenerator        = primitives.NewMetadataTypeGenerator()
+
+	downwardMessages = sc.Sequence[parachain.InboundDownwardMessage]{
+		{SentAt: 1, Msg: sc.Sequence[sc.U8]{1, 2}},
+		{SentAt: 2, Msg: sc.Sequence[sc.U8]{3, 4, 5}},
+	}
+	oversizedMessage = parachain.InboundDownwardMessage{SentAt: 3, Msg: sc.Sequence[sc.U8]{1, 2, 3, 4, 5}}
+)
+
+var (
+	mockStorage                      *mocks.IoStorage
+	mockSystemModule                 *mocks.SystemModule
+	mockDmpMessageHandler            *MockDmpMessageHandler
+	mockXcmpMessageHandler           *MockXcmpMessageHandler
+	mockStorageLastDmqMqcHead        *mocks.StorageValue[parachain.MessageQueueChain]
+	mockStorageLastHrmpMqcHeads      *mocks.StorageValue[sc.Sequence[parachain.HrmpMqcHead]]
+	mockStorageProcessedDownwardMsgs *mocks.StorageValue[sc.U32]
+	mockStorageHrmpWatermark         *mocks.StorageValue[sc.U32]
+)
+
+func Test_Module_EnqueueInboundDownwardMessages(t *testing.T) {
+	target := setupModule()
+	expectedHead := mqcHead(parachain.NewEmptyMessageQueueChain(), downwardMessages...)
+	mockStorageLastDmqMqcHead.On("Get").Return(parachain.NewEmptyMessageQueueChain(), nil)
+	mockDmpMessageHandler.On("HandleDmpMessages", downwardMessages, reservedDmpWeight).Return(handlerWeight)
+
+	result, err := target.enqueueInboundDownwardMessages(expectedHead.RelayHash, downwardMessages)
+
+	expectedWeight := enqueueInboundDownwardMessagesWeight(2, dbWeight).SaturatingAdd(handlerWeight)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedWeight, result)
+	mockStorageLastDmqMqcHead.AssertCalled(t, "Put", expectedHead)
+	mockStorageProcessedDownwardMsgs.AssertCalled(t, "Put", sc.U32(2))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventDownwardMessagesReceived(moduleId, 2))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventDownwardMessagesProcessed(moduleId, expectedWeight, expectedHead.RelayHash))
+}
+
+func Test_Module_EnqueueInboundDownwardMessages_ExtendsLastHead(t *testing.T) {
+	target := setupModule()
+	lastHead := mqcHead(parachain.NewEmptyMessageQueueChain(), downwardMessages[0])
+	expectedHead := mqcHead(lastHead, downwardMessages[1])
+	mockStorageLastDmqMqcHead.On("Get").Return(lastHead, nil)
+	mockDmpMessageHandler.On("HandleDmpMessages", downwardMessages[1:], reservedDmpWeight).Return(handlerWeight)
+
+	_, err := target.enqueueInboundDownwardMessages(expectedHead.RelayHash, downwardMessages[1:])
+
+	assert.NoError(t, err)
+	mockStorageLastDmqMqcHead.AssertCalled(t, "Put", expectedHead)
+}
+
+func Test_Module_EnqueueInboundDownwardMessages_HeadMismatch(t *testing.T) {
+	target := setupModule()
+	expectedHead := mqcHead(parachain.NewEmptyMessageQueueChain(), downwardMessages[0])
+	mockStorageLastDmqMqcHead.On("Get").Return(parachain.NewEmptyMessageQueueChain(), nil)
+
+	_, err := target.enqueueInboundDownwardMessages(expectedHead.RelayHash, downwardMessages)
+
+	assert.Equal(t, errors.New("mismatching expected mqc head"), err)
+	mockDmpMessageHandler.AssertNotCalled(t, "HandleDmpMessages", mock.Anything, mock.Anything)
+	mockStorageLastDmqMqcHead.AssertNotCalled(t, "Put", mock.Anything)
+	mockStorageProcessedDownwardMsgs.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_EnqueueInboundDownwardMessages_NoMessages(t *testing.T) {
+	target := setupModule()
+	lastHead := mqcHead(parachain.NewEmptyMessageQueueChain(), downwardMessages...)
+	mockStorageLastDmqMqcHead.On("Get").Return(lastHead, nil)
+
+	result, err := target.enqueueInboundDownwardMessages(lastHead.RelayHash, sc.Sequence[parachain.InboundDownwardMessage]{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, enqueueInboundDownwardMessagesWeight(0, dbWeight), result)
+	mockDmpMessageHandler.AssertNotCalled(t, "HandleDmpMessages", mock.Anything, mock.Anything)
+	mockStorageLastDmqMqcHead.AssertNotCalled(t, "Put", mock.Anything)
+	mockStorageProcessedDownwardMsgs.AssertCalled(t, "Put", sc.U32(0))
+}
+
+func Test_Module_EnqueueInboundDownwardMessages_DropsOversizedMessages(t *testing.T) {
+	target := setupModule()
+	messages := sc.Sequence[parachain.InboundDownwardMessage]{downwardMessages[0], oversizedMessage, downwardMessages[1]}
+	expectedHead := mqcHead(parachain.NewEmptyMessageQueueChain(), messages...)
+	mockStorageLastDmqMqcHead.On("Get").Return(parachain.NewEmptyMessageQueueChain(), nil)
+	mockDmpMessageHandler.On("HandleDmpMessages", downwardMessages, reservedDmpWeight).Return(handlerWeight)
+
+	_, err := target.enqueueInboundDownwardMessages(expectedHead.RelayHash, messages)
+
+	assert.NoError(t, err)
+	mockDmpMessageHandler.AssertCalled(t, "HandleDmpMessages", downwardMessages, reservedDmpWeight)
+	mockStorageLastDmqMqcHead.AssertCalled(t, "Put", expectedHead)
+	mockStorageProcessedDownwardMsgs.AssertCalled(t, "Put", sc.U32(3))
+}
+
+func Test_Module_EnqueueInboundHorizontalMessages(t *testing.T) {
+	target := setupModule()
+	lastHeadTwo := mqcHead(parachain.NewEmptyMessageQueueChain(), downwardMessages[0])
+	headOne := mqcHead(parachain.NewEmptyMessageQueueChain(), downwardMessages...)
+	mockStorageLastHrmpMqcHeads.On("Get").Return(sc.Sequence[parachain.HrmpMqcHead]{{ParaId: senderTwo, Head: lastHeadTwo}}, nil)
+	mockXcmpMessageHandler.On("HandleXcmpMessages", mock.Anything, reservedXcmpWeight).Return(handlerWeight)
+
+	result, err := target.enqueueInboundHorizontalMessages(
+		sc.Sequence[parachain.Channel]{newIngressChannel(senderOne, headOne), newIngressChannel(senderTwo, lastHeadTwo)},
+		parachain.NewHorizontalMessages(sc.Dictionary[sc.U32, sc.Sequence[parachain.InboundDownwardMessage]]{senderOne: downwardMessages}),
+		relayParentNumber,
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, dbWeight.ReadsWrites(1, 2).SaturatingAdd(handlerWeight), result)
+	mockXcmpMessageHandler.AssertCalled(t, "HandleXcmpMessages", sc.Sequence[parachain.InboundXcmpMessage]{
+		{Sender: senderOne, SentAt: 1, Data: downwardMessages[0].Msg},
+		{Sender: senderOne, SentAt: 2, Data: downwardMessages[1].Msg},
+	}, reservedXcmpWeight)
+	mockStorageLastHrmpMqcHeads.AssertCalled(t, "Put", sc.Sequence[parachain.HrmpMqcHead]{
+		{ParaId: senderOne, Head: headOne},
+		{ParaId: senderTwo, Head: lastHeadTwo},
+	})
+	mockStorageHrmpWatermark.AssertCalled(t, "Put", relayParentNumber)
+}
+
+func Test_Module_EnqueueInboundHorizontalMessages_NoIngressChannel(t *testing.T) {
+	target := setupModule()
+
+	_, err := target.enqueueInboundHorizontalMessages(
+		sc.Sequence[parachain.Channel]{newIngressChannel(senderOne, parachain.NewEmptyMessageQueueChain())},
+		parachain.NewHorizontalMessages(sc.Dictionary[sc.U32, sc.Sequence[parachain.InboundDownwardMessage]]{senderTwo: downwardMessages}),
+		relayParentNumber,
+	)
+
+	assert.Equal(t, errors.New("one of the messages submitted by the collator was sent from a sender that doesn't have a channel opened to this parachain"), err)
+	mockXcmpMessageHandler.AssertNotCalled(t, "HandleXcmpMessages", mock.Anything, mock.Anything)
+	mockStorageLastHrmpMqcHeads.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_EnqueueInboundHorizontalMessages_SentAfterRelayParent(t *testing.T) {
+	target := setupModule()
+	messages := sc.Sequence[parachain.InboundDownwardMessage]{{SentAt: relayParentNumber + 1, Msg: sc.Sequence[sc.U8]{1}}}
+	mockStorageLastHrmpMqcHeads.On("Get").Return(sc.Sequence[parachain.HrmpMqcHead]{}, nil)
+
+	_, err := target.enqueueInboundHorizontalMessages(
+		sc.Sequence[parachain.Channel]{newIngressChannel(senderOne, mqcHead(parachain.NewEmptyMessageQueueChain(), messages...))},
+		parachain.NewHorizontalMessages(sc.Dictionary[sc.U32, sc.Sequence[parachain.InboundDownwardMessage]]{senderOne: messages}),
+		relayParentNumber,
+	)
+
+	assert.Equal(t, errors.New("horizontal message was sent after the relay parent"), err)
+	mockXcmpMessageHandler.AssertNotCalled(t, "HandleXcmpMessages", mock.Anything, mock.Anything)
+	mockStorageLastHrmpMqcHeads.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_EnqueueInboundHorizontalMessages_HeadMismatch(t *testing.T) {
+	target := setupModule()
+	mockStorageLastHrmpMqcHeads.On("Get").Return(sc.Sequence[parachain.HrmpMqcHead]{}, nil)
+
+	_, err := target.enqueueInboundHorizontalMessages(
+		sc.Sequence[parachain.Channel]{newIngressChannel(senderOne, mqcHead(parachain.NewEmptyMessageQueueChain(), downwardMessages[0]))},
+		parachain.NewHorizontalMessages(sc.Dictionary[sc.U32, sc.Sequence[parachain.InboundDownwardMessage]]{senderOne: downwardMessages}),
+		relayParentNumber,
+	)
+
+	assert.Equal(t, errors.New("mismatching expected mqc head of ingress channel"), err)
+	mockXcmpMessageHandler.AssertNotCalled(t, "HandleXcmpMessages", mock.Anything, mock.Anything)
+	mockStorageLastHrmpMqcHeads.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_EnqueueInboundHorizontalMessages_DropsOversizedMessages(t *testing.T) {
+	target := setupModule()
+	messages := sc.Sequence[parachain.InboundDownwardMessage]{downwardMessages[0], oversizedMessage}
+	head := mqcHead(parachain.NewEmptyMessageQueueChain(), messages...)
+	mockStorageLastHrmpMqcHeads.On("Get").Return(sc.Sequence[parachain.HrmpMqcHead]{}, nil)
+	mockXcmpMessageHandler.On("HandleXcmpMessages", mock.Anything, reservedXcmpWeight).Return(handlerWeight)
+
+	_, err := target.enqueueInboundHorizontalMessages(
+		sc.Sequence[parachain.Channel]{newIngressChannel(senderOne, head)},
+		parachain.NewHorizontalMessages(sc.Dictionary[sc.U32, sc.Sequence[parachain.InboundDownwardMessage]]{senderOne: messages}),
+		relayParentNumber,
+	)
+
+	assert.NoError(t, err)
+	mockXcmpMessageHandler.AssertCalled(t, "HandleXcmpMessages", sc.Sequence[parachain.InboundXcmpMessage]{
+		{Sender: senderOne, SentAt: 1, Data: downwardMessages[0].Msg},
+	}, reservedXcmpWeight)
+	mockStorageLastHrmpMqcHeads.AssertCalled(t, "Put", sc.Sequence[parachain.HrmpMqcHead]{{ParaId: senderOne, Head: head}})
+}
+
+func Test_Module_IncreaseFeeFactor(t *testing.T) {
+	target := setupModule()
+	mockStorageUpwardDeliveryFeeFactor.On("Get").Return(defaultInitialDeliveryFeeFactor, nil)
+
+	err := target.increaseFeeFactor(2 * 1024)
+
+	assert.NoError(t, err)
+	mockStorageUpwardDeliveryFeeFactor.AssertCalled(t, "Put", primitives.NewFixedU128FromRational(sc.NewU128(1_052), sc.NewU128(1_000)))
+}
+
+func Test_Module_DecreaseFeeFactor_Initial(t *testing.T) {
+	target := setupModule()
+	mockStorageUpwardDeliveryFeeFactor.On("Get").Return(defaultInitialDeliveryFeeFactor, nil)
+
+	err := target.decreaseFeeFactor()
+
+	assert.NoError(t, err)
+	mockStorageUpwardDeliveryFeeFactor.AssertCalled(t, "Put", defaultInitialDeliveryFeeFactor)
+}
+
+func Test_Module_FeeFactor_RoundTrip(t *testing.T) {
+	factor := primitives.NewFixedU128FromInteger(sc.NewU128(2))
+	increased := primitives.NewFixedU128FromRational(sc.NewU128(21), sc.NewU128(10))
+
+	target := setupModule()
+	mockStorageUpwardDeliveryFeeFactor.On("Get").Return(factor, nil)
+	assert.NoError(t, target.increaseFeeFactor(1_023))
+	mockStorageUpwardDeliveryFeeFactor.AssertCalled(t, "Put", increased)
+
+	target = setupModule()
+	mockStorageUpwardDeliveryFeeFactor.On("Get").Return(increased, nil)
+	assert.NoError(t, target.decreaseFeeFactor())
+	mockStorageUpwardDeliveryFeeFactor.AssertCalled(t, "Put", factor)
+}
+
+func setupModule() module {
+	mockStorage = new(mocks.IoStorage)
+	mockSystemModule = new(mocks.SystemModule)
+	mockDmpMessageHandler = new(MockDmpMessageHandler)
+	mockXcmpMessageHandler = new(MockXcmpMessageHandler)
+	mockStorageLastDmqMqcHead = new(mocks.StorageValue[parachain.MessageQueueChain])
+	mockStorageLastHrmpMqcHeads = new(mocks.StorageValue[sc.Sequence[parachain.HrmpMqcHead]])
+	mockStorageProcessedDownwardMsgs = new(mocks.StorageValue[sc.U32])
+	mockStorageHrmpWatermark = new(mocks.StorageValue[sc.U32])
+	mockStorageUpwardDeliveryFeeFactor = new(mocks.StorageValue[primitives.FixedU128])
+
+	config := NewConfig(
+		mockStorage,
+		dbWeight,
+		nil,
+		nil,
+		mockSystemModule,
+		nil,
+		mockDmpMessageHandler,
+		mockXcmpMessageHandler,
+		DefaultOutboundXcmpMessageSource{},
+		reservedDmpWeight,
+		reservedXcmpWeight,
+		maxInboundMessageLen,
+	)
+
+	target := New(moduleId, config, mdGenerator, log.NewLogger()).(module)
+	target.storage.LastDmqMqcHead = mockStorageLastDmqMqcHead
+	target.storage.LastHrmpMqcHeads = mockStorageLastHrmpMqcHeads
+	target.storage.ProcessedDownwardMessages = mockStorageProcessedDownwardMsgs
+	target.storage.HrmpWatermark = mockStorageHrmpWatermark
+	target.storage.UpwardDeliveryFeeFactor = mockStorageUpwardDeliveryFeeFactor
+	target.hashing = blake2bHashing{new(mocks.IoHashing)}
+
+	mockStorageLastDmqMqcHead.On("Put", mock.Anything).Return()
+	mockStorageLastHrmpMqcHeads.On("Put", mock.Anything).Return()
+	mockStorageProcessedDownwardMsgs.On("Put", mock.Anything).Return()
+	mockStorageHrmpWatermark.On("Put", mock.Anything).Return()
+	mockStorageUpwardDeliveryFeeFactor.On("Put", mock.Anything).Return()
+	mockSystemModule.On("DepositEvent", mock.Anything)
+
+	return target
+}
+
+// blake2bHashing hashes with Blake2b, as the host does, so that distinct message queue chains have distinct heads.
+type blake2bHashing struct {
+	*mocks.IoHashing
+}
+
+func (blake2bHashing) Blake256(value []byte) []byte {
+	return common.MustBlake2bHash(value).ToBytes()
+}
+
+// mqcHead extends `head` with `messages`. Downward and horizontal messages extend a chain in the same way.
+func mqcHead(head parachain.MessageQueueChain, messages ...parachain.InboundDownwardMessage) parachain.MessageQueueChain {
+	for _, message := range messages {
+		if err := head.ExtendDownward(message, blake2bHashing{}); err != nil {
+			panic(err)
+		}
+	}
+	return head
+}
+
+func newIngressChannel(sender sc.U32, head parachain.MessageQueueChain) parachain.Channel {
+	return parachain.Channel{
+		ParachainId:         sender,
+		AbridgedHRMPChannel: parachain.AbridgedHRMPChannel{MqcHead: sc.NewOption[primitives.H256](head.RelayHash)},
+	}
+}
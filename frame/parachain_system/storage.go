@@ -5,9 +5,12 @@ import (
 	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/primitives/io"
 	"github.com/LimeChain/gosemble/primitives/parachain"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
-var defaultInitialDeliveryFeeFactor = sc.NewU128(1)
+var defaultInitialDeliveryFeeFactor = primitives.NewFixedU128FromInteger(sc.NewU128(1))
+
+var defaultMessageQueueChain = parachain.NewEmptyMessageQueueChain()
 
 var (
 	// module prefix
@@ -26,6 +29,7 @@ var (
 	keyRelevantMessagingState            = []byte("RelevantMessagingState")
 	keyHostConfiguration                 = []byte("HostConfiguration")
 	keyLastDmqMqcHead                    = []byte("LasatDmqMqcHead")
+	keyLastHrmpMqcHeads                  = []byte("LastHrmpMqcHeads")
 	keyHrmpOutboundMessages              = []byte("HrmpOutboundMessages")
 	keyHrmpWatermark                     = []byte("HrmpWatermark")
 	keyProcessedDownwardMessages         = []byte("ProcessedDownwardMessages")
@@ -51,12 +55,13 @@ type storage struct {
 	RelevantMessagingState            support.StorageValue[parachain.MessagingStateSnapshot]
 	HostConfiguration                 support.StorageValue[parachain.AbridgedHostConfiguration]
 	LastDmqMqcHead                    support.StorageValue[parachain.MessageQueueChain]
+	LastHrmpMqcHeads                  support.StorageValue[sc.Sequence[parachain.HrmpMqcHead]]
 	ProcessedDownwardMessages         support.StorageValue[sc.U32]
 	HrmpWatermark                     support.StorageValue[sc.U32]
 	HrmpOutboundMessages              support.StorageValue[sc.Sequence[parachain.OutboundHrmpMessage]]
 	UpwardMessages                    support.StorageValue[sc.Sequence[parachain.UpwardMessage]]
 	PendingUpwardMessages             support.StorageValue[sc.Sequence[parachain.UpwardMessage]]
-	UpwardDeliveryFeeFactor           support.StorageValue[sc.U128]
+	UpwardDeliveryFeeFactor           support.StorageValue[primitives.FixedU128]
 	AnnouncedHrmpMessagesPerCandidate support.StorageValue[sc.U32]
 	CustomValidationHeadData          support.StorageValue[sc.Sequence[sc.U8]]
 }
@@ -75,13 +80,14 @@ func newStorage(s io.Storage) *storage {
 		RelayStateProof:                   support.NewHashStorageValue(s, keyParachainSystem, keyRelayStateProof, parachain.DecodeStorageProof),
 		RelevantMessagingState:            support.NewHashStorageValue(s, keyParachainSystem, keyRelevantMessagingState, parachain.DecodeMessagingStateSnapshot),
 		HostConfiguration:                 support.NewHashStorageValue(s, keyParachainSystem, keyHostConfiguration, parachain.DecodeAbridgeHostConfiguration),
-		LastDmqMqcHead:                    support.NewHashStorageValue(s, keyParachainSystem, keyLastDmqMqcHead, parachain.DecodeMessageQueueChain),
+		LastDmqMqcHead:                    support.NewHashStorageValueWithDefault(s, keyParachainSystem, keyLastDmqMqcHead, parachain.DecodeMessageQueueChain, &defaultMessageQueueChain),
+		LastHrmpMqcHeads:                  support.NewHashStorageValue(s, keyParachainSystem, keyLastHrmpMqcHeads, parachain.DecodeHrmpMqcHeads),
 		ProcessedDownwardMessages:         support.NewHashStorageValue(s, keyParachainSystem, keyProcessedDownwardMessages, sc.DecodeU32),
 		HrmpWatermark:                     support.NewHashStorageValue(s, keyParachainSystem, keyHrmpWatermark, sc.DecodeU32),
 		HrmpOutboundMessages:              support.NewHashStorageValue(s, keyParachainSystem, keyHrmpOutboundMessages, parachain.DecodeOutboundHrmpMessages),
 		UpwardMessages:                    support.NewHashStorageValue(s, keyParachainSystem, keyUpwardMessages, parachain.DecodeUpwardMessages),
 		PendingUpwardMessages:             support.NewHashStorageValue(s, keyParachainSystem, keyPendingUpwardMessages, parachain.DecodeUpwardMessages),
-		UpwardDeliveryFeeFactor:           support.NewHashStorageValueWithDefault(s, keyParachainSystem, keyUpwardDeliveryFeeFactor, sc.DecodeU128, &defaultInitialDeliveryFeeFactor),
+		UpwardDeliveryFeeFactor:           support.NewHashStorageValueWithDefault(s, keyParachainSystem, keyUpwardDeliveryFeeFactor, primitives.DecodeFixedU128, &defaultInitialDeliveryFeeFactor),
 		AnnouncedHrmpMessagesPerCandidate: support.NewHashStorageValue(s, keyParachainSystem, keyAnnouncedHrmpMessagesPerCandidate, sc.DecodeU32),
 		CustomValidationHeadData:          support.NewHashStorageValue(s, keyParachainSystem, keyCustomValidationHeadData, sc.DecodeSequence[sc.U8]),
 	}
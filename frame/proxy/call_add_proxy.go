@@ -0,0 +1,100 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callAddProxy registers a proxy account for the sender, which is able to make calls on its behalf.
+type callAddProxy struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallAddProxy(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callAddProxy{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, sc.U8(0), sc.U64(0)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callAddProxy) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	delegate, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	proxyType, err := c.module.decodeProxyType(buffer)
+	if err != nil {
+		return nil, err
+	}
+	delay, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(delegate, proxyType, delay)
+
+	return c, nil
+}
+
+func (c callAddProxy) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callAddProxy) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callAddProxy) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callAddProxy) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callAddProxy) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callAddProxy) BaseWeight() primitives.Weight {
+	return callAddProxyWeight(c.dbWeight, sc.U64(c.module.maxProxies))
+}
+
+func (_ callAddProxy) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callAddProxy) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callAddProxy) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callAddProxy) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	delegate, err := lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	proxyType := args[1].(sc.U8)
+	delay := args[2].(sc.U64)
+
+	return primitives.PostDispatchInfo{}, c.module.addProxyDelegate(who.Value, delegate, proxyType, delay)
+}
+
+func (_ callAddProxy) Docs() string {
+	return "Register a proxy account for the sender that is able to make calls on its behalf. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"Parameters: `proxy`: The account that the `caller` would like to make a proxy. " +
+		"`proxy_type`: The permissions allowed for this proxy account. " +
+		"`delay`: The announcement period required of the initial proxy. Will generally be zero."
+}
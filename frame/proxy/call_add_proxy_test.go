@@ -0,0 +1,84 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_AddProxy_DecodeArgs(t *testing.T) {
+	target := setupCallAddProxy()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.Write(proxyTypeNonTransfer.Bytes())
+	buffer.Write(sc.U64(10).Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(whoAddress, proxyTypeNonTransfer, sc.U64(10)), result.Args())
+}
+
+func Test_Call_AddProxy_DecodeArgs_InvalidProxyType(t *testing.T) {
+	target := setupCallAddProxy()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.WriteByte(2)
+	buffer.Write(sc.U64(10).Bytes())
+
+	_, err := target.DecodeArgs(buffer)
+
+	assert.Equal(t, errInvalidProxyType, err)
+}
+
+func Test_Call_AddProxy_BaseWeight(t *testing.T) {
+	target := setupCallAddProxy()
+
+	assert.Equal(t, callAddProxyWeight(dbWeight, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_AddProxy_Dispatch(t *testing.T) {
+	target := setupCallAddProxy()
+	target.Arguments = sc.NewVaryingData(whoAddress, ProxyTypeAny, sc.U64(0))
+	mockStorageProxies.On("Get", realAccount).Return(defaultProxies, nil)
+	mockCurrency.On("Reserve", realAccount, sc.NewU128(12)).Return(nil)
+	mockStorageProxies.On("Put", realAccount, Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny}, Deposit: sc.NewU128(12)}).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(realAccount), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageProxies.AssertCalled(t, "Put", realAccount, Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny}, Deposit: sc.NewU128(12)})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventProxyAdded(moduleId, realAccount, who, ProxyTypeAny, 0))
+}
+
+func Test_Call_AddProxy_Dispatch_CannotReserve(t *testing.T) {
+	target := setupCallAddProxy()
+	target.Arguments = sc.NewVaryingData(whoAddress, ProxyTypeAny, sc.U64(0))
+	mockStorageProxies.On("Get", realAccount).Return(defaultProxies, nil)
+	mockCurrency.On("Reserve", realAccount, sc.NewU128(12)).Return(dispatchErrOther)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(realAccount), target.Args())
+
+	assert.Equal(t, dispatchErrOther, err)
+	mockStorageProxies.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Call_AddProxy_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallAddProxy()
+	target.Arguments = sc.NewVaryingData(whoAddress, ProxyTypeAny, sc.U64(0))
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallAddProxy() callAddProxy {
+	return newCallAddProxy(moduleId, FunctionAddProxy, dbWeight, setupModule()).(callAddProxy)
+}
@@ -0,0 +1,127 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callAnnounce publishes the hash of a call, which the sender will dispatch as a proxy once its delay passes.
+type callAnnounce struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallAnnounce(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callAnnounce{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, primitives.H256{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callAnnounce) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	realAccount, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	callHash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(realAccount, callHash)
+
+	return c, nil
+}
+
+func (c callAnnounce) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callAnnounce) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callAnnounce) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callAnnounce) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callAnnounce) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callAnnounce) BaseWeight() primitives.Weight {
+	return callAnnounceWeight(c.dbWeight, sc.U64(c.module.maxPending), sc.U64(c.module.maxProxies))
+}
+
+func (_ callAnnounce) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callAnnounce) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callAnnounce) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callAnnounce) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	realAccount, err := lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	callHash := args[1].(primitives.H256)
+
+	if _, err := c.module.findProxy(realAccount, who.Value, sc.NewOption[sc.U8](nil)); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	height, err := c.module.systemModule.StorageBlockNumber()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	announcements, err := c.module.storage.Announcements.Get(who.Value)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if sc.U32(len(announcements.Announcements)) >= c.module.maxPending {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorTooMany(c.ModuleId)
+	}
+
+	pending := append(sc.Sequence[Announcement]{}, announcements.Announcements...)
+	pending = append(pending, Announcement{Real: realAccount, CallHash: callHash, Height: height})
+
+	deposit := c.module.announcementDeposit(len(pending))
+	if err := c.module.updateDeposit(who.Value, announcements.Deposit, deposit); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	c.module.storage.Announcements.Put(who.Value, Announcements{Announcements: pending, Deposit: deposit})
+	c.module.systemModule.DepositEvent(newEventAnnounced(c.ModuleId, realAccount, who.Value, callHash))
+
+	return primitives.PostDispatchInfo{}, nil
+}
+
+func (_ callAnnounce) Docs() string {
+	return "Publish the hash of a proxy-call that will be made in the future. " +
+		"This must be called some number of blocks before the corresponding `proxy` is attempted " +
+		"if the delay associated with the proxy relationship is greater than zero. " +
+		"No more than `MaxPending` announcements may be made at any one time. " +
+		"This will take a deposit of `AnnouncementDepositFactor` as well as " +
+		"`AnnouncementDepositBase` if there are no other pending announcements. " +
+		"The dispatch origin for this call must be `Signed` and a proxy of `real`. " +
+		"Parameters: `real`: The account that the proxy will make a call on behalf of. " +
+		"`call_hash`: The hash of the call to be made by the `real` account."
+}
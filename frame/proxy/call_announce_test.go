@@ -0,0 +1,91 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_Announce_DecodeArgs(t *testing.T) {
+	target := setupCallAnnounce()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(realAddress.Bytes())
+	buffer.Write(callHash.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(realAddress, callHash), result.Args())
+}
+
+func Test_Call_Announce_BaseWeight(t *testing.T) {
+	target := setupCallAnnounce()
+
+	assert.Equal(t, callAnnounceWeight(dbWeight, maxPending, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_Announce_Dispatch(t *testing.T) {
+	target := setupCallAnnounce()
+	target.Arguments = sc.NewVaryingData(realAddress, callHash)
+	expectProxies(definitionDelayed)
+	mockSystemModule.On("StorageBlockNumber").Return(sc.U64(5), nil)
+	mockStorageAnnouncements.On("Get", who).Return(defaultAnnouncements, nil)
+	mockCurrency.On("Reserve", who, sc.NewU128(11)).Return(nil)
+	expect := Announcements{
+		Announcements: sc.Sequence[Announcement]{{Real: realAccount, CallHash: callHash, Height: 5}},
+		Deposit:       sc.NewU128(11),
+	}
+	mockStorageAnnouncements.On("Put", who, expect).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Reserve", who, sc.NewU128(11))
+	mockStorageAnnouncements.AssertCalled(t, "Put", who, expect)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventAnnounced(moduleId, realAccount, who, callHash))
+}
+
+func Test_Call_Announce_Dispatch_NotProxy(t *testing.T) {
+	target := setupCallAnnounce()
+	target.Arguments = sc.NewVaryingData(realAddress, callHash)
+	expectProxies()
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotProxy(moduleId), err)
+	mockStorageAnnouncements.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Call_Announce_Dispatch_TooMany(t *testing.T) {
+	target := setupCallAnnounce()
+	target.Arguments = sc.NewVaryingData(realAddress, callHash)
+	expectProxies(definitionDelayed)
+	mockSystemModule.On("StorageBlockNumber").Return(sc.U64(5), nil)
+	announcement := Announcement{Real: realAccount, CallHash: callHash, Height: 4}
+	pending := Announcements{Announcements: sc.Sequence[Announcement]{announcement, announcement}, Deposit: sc.NewU128(14)}
+	mockStorageAnnouncements.On("Get", who).Return(pending, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorTooMany(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func Test_Call_Announce_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallAnnounce()
+	target.Arguments = sc.NewVaryingData(realAddress, callHash)
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallAnnounce() callAnnounce {
+	return newCallAnnounce(moduleId, FunctionAnnounce, dbWeight, setupModule()).(callAnnounce)
+}
@@ -0,0 +1,14 @@
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callAnnounceWeight(dbWeight primitives.RuntimeDbWeight, announcements sc.U64, proxies sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(34916000, 0).
+		SaturatingAdd(primitives.WeightFromParts(153641, 0).SaturatingMul(announcements)).
+		SaturatingAdd(primitives.WeightFromParts(32912, 0).SaturatingMul(proxies)).
+		SaturatingAdd(dbWeight.Reads(3)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
@@ -0,0 +1,128 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callCreatePure spawns a new account, which is accessible only through a proxy of the sender.
+type callCreatePure struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallCreatePure(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callCreatePure{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U8(0), sc.U64(0), sc.U16(0)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callCreatePure) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	proxyType, err := c.module.decodeProxyType(buffer)
+	if err != nil {
+		return nil, err
+	}
+	delay, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	index, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(proxyType, delay, index)
+
+	return c, nil
+}
+
+func (c callCreatePure) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callCreatePure) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callCreatePure) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callCreatePure) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callCreatePure) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callCreatePure) BaseWeight() primitives.Weight {
+	return callCreatePureWeight(c.dbWeight, sc.U64(c.module.maxProxies))
+}
+
+func (_ callCreatePure) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callCreatePure) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callCreatePure) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callCreatePure) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	proxyType := args[0].(sc.U8)
+	delay := args[1].(sc.U64)
+	index := args[2].(sc.U16)
+
+	height, err := c.module.systemModule.StorageBlockNumber()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	extrinsicIndex, err := c.module.systemModule.StorageExtrinsicIndex()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	pure, err := c.module.PureAccount(who.Value, proxyType, index, height, extrinsicIndex)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if c.module.storage.Proxies.Exists(pure) {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorDuplicate(c.ModuleId)
+	}
+
+	deposit := c.module.proxyDeposit(1)
+	if err := c.module.currency.Reserve(who.Value, deposit); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	c.module.storage.Proxies.Put(pure, Proxies{
+		Definitions: sc.Sequence[ProxyDefinition]{{Delegate: who.Value, ProxyType: proxyType, Delay: delay}},
+		Deposit:     deposit,
+	})
+	c.module.systemModule.DepositEvent(newEventPureCreated(c.ModuleId, pure, who.Value, proxyType, index))
+
+	return primitives.PostDispatchInfo{}, nil
+}
+
+func (_ callCreatePure) Docs() string {
+	return "Spawn a fresh new account that is guaranteed to be otherwise inaccessible, and " +
+		"initialize it with a proxy of `proxy_type` for `origin` sender. " +
+		"Requires a `Signed` origin. " +
+		"`proxy_type`: The type of the proxy that the sender will be registered as over the new account. " +
+		"`delay`: The announcement period required of the initial proxy. Will generally be zero. " +
+		"`index`: A disambiguation index, in case this is called multiple times in the same " +
+		"transaction (e.g. with `utility::batch`). Unless you're using `batch` you probably just want to use `0`. " +
+		"Fails if there are insufficient funds to pay for deposit."
+}
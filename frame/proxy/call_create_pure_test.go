@@ -0,0 +1,93 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_CreatePure_DecodeArgs(t *testing.T) {
+	target := setupCallCreatePure()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(proxyTypeNonTransfer.Bytes())
+	buffer.Write(sc.U64(10).Bytes())
+	buffer.Write(sc.U16(1).Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(proxyTypeNonTransfer, sc.U64(10), sc.U16(1)), result.Args())
+}
+
+func Test_Call_CreatePure_BaseWeight(t *testing.T) {
+	target := setupCallCreatePure()
+
+	assert.Equal(t, callCreatePureWeight(dbWeight, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_CreatePure_Dispatch(t *testing.T) {
+	target := setupCallCreatePure()
+	target.Arguments = sc.NewVaryingData(ProxyTypeAny, sc.U64(0), sc.U16(0))
+	mockSystemModule.On("StorageBlockNumber").Return(sc.U64(5), nil)
+	mockSystemModule.On("StorageExtrinsicIndex").Return(sc.U32(1), nil)
+	expectPureAccount(ProxyTypeAny, 0, 5, 1)
+	mockStorageProxies.On("Exists", pureAccount).Return(false)
+	mockCurrency.On("Reserve", who, sc.NewU128(12)).Return(nil)
+	expect := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny}, Deposit: sc.NewU128(12)}
+	mockStorageProxies.On("Put", pureAccount, expect).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Reserve", who, sc.NewU128(12))
+	mockStorageProxies.AssertCalled(t, "Put", pureAccount, expect)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventPureCreated(moduleId, pureAccount, who, ProxyTypeAny, 0))
+}
+
+func Test_Call_CreatePure_Dispatch_Duplicate(t *testing.T) {
+	target := setupCallCreatePure()
+	target.Arguments = sc.NewVaryingData(ProxyTypeAny, sc.U64(0), sc.U16(0))
+	mockSystemModule.On("StorageBlockNumber").Return(sc.U64(5), nil)
+	mockSystemModule.On("StorageExtrinsicIndex").Return(sc.U32(1), nil)
+	expectPureAccount(ProxyTypeAny, 0, 5, 1)
+	mockStorageProxies.On("Exists", pureAccount).Return(true)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorDuplicate(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func Test_Call_CreatePure_Dispatch_CannotReserve(t *testing.T) {
+	target := setupCallCreatePure()
+	target.Arguments = sc.NewVaryingData(ProxyTypeAny, sc.U64(0), sc.U16(0))
+	mockSystemModule.On("StorageBlockNumber").Return(sc.U64(5), nil)
+	mockSystemModule.On("StorageExtrinsicIndex").Return(sc.U32(1), nil)
+	expectPureAccount(ProxyTypeAny, 0, 5, 1)
+	mockStorageProxies.On("Exists", pureAccount).Return(false)
+	mockCurrency.On("Reserve", who, sc.NewU128(12)).Return(dispatchErrOther)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, dispatchErrOther, err)
+	mockStorageProxies.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Call_CreatePure_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallCreatePure()
+	target.Arguments = sc.NewVaryingData(ProxyTypeAny, sc.U64(0), sc.U16(0))
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallCreatePure() callCreatePure {
+	return newCallCreatePure(moduleId, FunctionCreatePure, dbWeight, setupModule()).(callCreatePure)
+}
@@ -0,0 +1,133 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callKillPure removes a pure proxy account and returns the deposit of its spawner.
+type callKillPure struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallKillPure(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callKillPure{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, sc.U8(0), sc.U16(0), sc.ToCompact(sc.U64(0)), sc.ToCompact(sc.U32(0))),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callKillPure) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	spawner, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	proxyType, err := c.module.decodeProxyType(buffer)
+	if err != nil {
+		return nil, err
+	}
+	index, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return nil, err
+	}
+	height, err := sc.DecodeCompact[sc.U64](buffer)
+	if err != nil {
+		return nil, err
+	}
+	extrinsicIndex, err := sc.DecodeCompact[sc.U32](buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(spawner, proxyType, index, height, extrinsicIndex)
+
+	return c, nil
+}
+
+func (c callKillPure) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callKillPure) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callKillPure) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callKillPure) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callKillPure) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callKillPure) BaseWeight() primitives.Weight {
+	return callKillPureWeight(c.dbWeight, sc.U64(c.module.maxProxies))
+}
+
+func (_ callKillPure) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callKillPure) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callKillPure) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callKillPure) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	spawner, err := lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	proxyType := args[1].(sc.U8)
+	index := args[2].(sc.U16)
+	height := sc.U64(args[3].(sc.Compact).ToBigInt().Uint64())
+	extrinsicIndex := sc.U32(args[4].(sc.Compact).ToBigInt().Uint64())
+
+	pure, err := c.module.PureAccount(spawner, proxyType, index, height, extrinsicIndex)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if compareAccountIds(pure, who.Value) != 0 {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorNoPermission(c.ModuleId)
+	}
+
+	proxies, err := c.module.storage.Proxies.Get(who.Value)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if _, err := c.module.currency.Unreserve(spawner, proxies.Deposit); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	c.module.storage.Proxies.Remove(who.Value)
+
+	return primitives.PostDispatchInfo{}, nil
+}
+
+func (_ callKillPure) Docs() string {
+	return "Removes a previously spawned pure proxy. " +
+		"WARNING: **All access to this account will be lost.** Any funds held in it will be inaccessible. " +
+		"Requires a `Signed` origin, and the sender account must have been created by a call to " +
+		"`pure` with corresponding parameters. " +
+		"`spawner`: The account that originally called `pure` to create this account. " +
+		"`index`: The disambiguation index originally passed to `pure`. Probably `0`. " +
+		"`proxy_type`: The proxy type originally passed to `pure`. " +
+		"`height`: The height of the chain when the call to `pure` was processed. " +
+		"`ext_index`: The extrinsic index in which the call to `pure` was processed. " +
+		"Fails with `NoPermission` in case the caller is not a previously created pure " +
+		"account whose `pure` call has corresponding parameters."
+}
@@ -0,0 +1,78 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	killPureArgs = sc.NewVaryingData(whoAddress, ProxyTypeAny, sc.U16(0), sc.ToCompact(sc.U64(5)), sc.ToCompact(sc.U32(1)))
+)
+
+func Test_Call_KillPure_DecodeArgs(t *testing.T) {
+	target := setupCallKillPure()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.Write(ProxyTypeAny.Bytes())
+	buffer.Write(sc.U16(0).Bytes())
+	buffer.Write(sc.ToCompact(sc.U64(5)).Bytes())
+	buffer.Write(sc.ToCompact(sc.U32(1)).Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, killPureArgs, result.Args())
+}
+
+func Test_Call_KillPure_BaseWeight(t *testing.T) {
+	target := setupCallKillPure()
+
+	assert.Equal(t, callKillPureWeight(dbWeight, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_KillPure_Dispatch(t *testing.T) {
+	target := setupCallKillPure()
+	target.Arguments = killPureArgs
+	expectPureAccount(ProxyTypeAny, 0, 5, 1)
+	proxies := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny}, Deposit: sc.NewU128(12)}
+	mockStorageProxies.On("Get", pureAccount).Return(proxies, nil)
+	mockCurrency.On("Unreserve", who, sc.NewU128(12)).Return(sc.NewU128(0), nil)
+	mockStorageProxies.On("Remove", pureAccount).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(pureAccount), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Unreserve", who, sc.NewU128(12))
+	mockStorageProxies.AssertCalled(t, "Remove", pureAccount)
+}
+
+func Test_Call_KillPure_Dispatch_NoPermission(t *testing.T) {
+	target := setupCallKillPure()
+	target.Arguments = killPureArgs
+	expectPureAccount(ProxyTypeAny, 0, 5, 1)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(delegate), target.Args())
+
+	assert.Equal(t, NewDispatchErrorNoPermission(moduleId), err)
+	mockStorageProxies.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Call_KillPure_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallKillPure()
+	target.Arguments = killPureArgs
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallKillPure() callKillPure {
+	return newCallKillPure(moduleId, FunctionKillPure, dbWeight, setupModule()).(callKillPure)
+}
@@ -0,0 +1,123 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callProxy dispatches a call on behalf of an account, which has registered the sender as its proxy.
+type callProxy struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallProxy(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callProxy{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, sc.NewOption[sc.U8](nil)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callProxy) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	realAccount, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	forceProxyType, err := c.module.decodeOptionProxyType(buffer)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decodeCallFunc(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(realAccount, forceProxyType, call)
+
+	return c, nil
+}
+
+func (c callProxy) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	c.module.logger.Critical("not implemented")
+	return nil, nil
+}
+
+func (c callProxy) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callProxy) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callProxy) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callProxy) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callProxy) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callProxy) BaseWeight() primitives.Weight {
+	call := c.Args()[2].(primitives.Call)
+
+	return callProxyWeight(c.dbWeight, sc.U64(c.module.maxProxies)).
+		SaturatingAdd(call.WeighData(call.BaseWeight()))
+}
+
+func (_ callProxy) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callProxy) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	call := c.Args()[2].(primitives.Call)
+
+	return call.ClassifyDispatch(baseWeight)
+}
+
+func (_ callProxy) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callProxy) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	realAccount, err := lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	forceProxyType := args[1].(sc.Option[sc.U8])
+	call := args[2].(primitives.Call)
+
+	definition, err := c.module.findProxy(realAccount, who.Value, forceProxyType)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	if definition.Delay != 0 {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorUnannounced(c.ModuleId)
+	}
+
+	consumed, err := c.module.doProxy(definition, realAccount, call)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return postDispatchInfo(callProxyWeight(c.dbWeight, sc.U64(c.module.maxProxies)).SaturatingAdd(consumed)), nil
+}
+
+func (_ callProxy) Docs() string {
+	return "Dispatch the given `call` from an account that the sender is authorised for through `add_proxy`. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"Parameters: `real`: The account that the proxy will make a call on behalf of. " +
+		"`force_proxy_type`: Specify the exact proxy type to be used and checked for this call. " +
+		"`call`: The call to be made by the `real` account."
+}
@@ -0,0 +1,151 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callProxyAnnounced dispatches a previously announced call on behalf of an account, which has registered `delegate` as its proxy.
+type callProxyAnnounced struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallProxyAnnounced(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callProxyAnnounced{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, primitives.MultiAddress{}, sc.NewOption[sc.U8](nil)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callProxyAnnounced) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	delegate, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	realAccount, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	forceProxyType, err := c.module.decodeOptionProxyType(buffer)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decodeCallFunc(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(delegate, realAccount, forceProxyType, call)
+
+	return c, nil
+}
+
+func (c callProxyAnnounced) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	c.module.logger.Critical("not implemented")
+	return nil, nil
+}
+
+func (c callProxyAnnounced) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callProxyAnnounced) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callProxyAnnounced) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callProxyAnnounced) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callProxyAnnounced) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callProxyAnnounced) BaseWeight() primitives.Weight {
+	call := c.Args()[3].(primitives.Call)
+
+	return callProxyAnnouncedWeight(c.dbWeight, sc.U64(c.module.maxPending), sc.U64(c.module.maxProxies)).
+		SaturatingAdd(call.WeighData(call.BaseWeight()))
+}
+
+func (_ callProxyAnnounced) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callProxyAnnounced) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	call := c.Args()[3].(primitives.Call)
+
+	return call.ClassifyDispatch(baseWeight)
+}
+
+func (_ callProxyAnnounced) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callProxyAnnounced) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	delegate, err := lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	realAccount, err := lookup(args[1].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	forceProxyType := args[2].(sc.Option[sc.U8])
+	call := args[3].(primitives.Call)
+
+	definition, err := c.module.findProxy(realAccount, delegate, forceProxyType)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	callHash, err := c.module.callHash(call)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	now, err := c.module.systemModule.StorageBlockNumber()
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	removed, err := c.module.removeAnnouncements(delegate, func(announcement Announcement) bool {
+		return compareAccountIds(announcement.Real, realAccount) == 0 &&
+			bytes.Equal(announcement.CallHash.Bytes(), callHash.Bytes()) &&
+			sc.SaturatingAddU64(announcement.Height, definition.Delay) <= now
+	})
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	if !removed {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorUnannounced(c.ModuleId)
+	}
+
+	consumed, err := c.module.doProxy(definition, realAccount, call)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return postDispatchInfo(callProxyAnnouncedWeight(c.dbWeight, sc.U64(c.module.maxPending), sc.U64(c.module.maxProxies)).SaturatingAdd(consumed)), nil
+}
+
+func (_ callProxyAnnounced) Docs() string {
+	return "Dispatch the given `call` from an account that the sender is authorized for through `add_proxy`. " +
+		"Removes any corresponding announcement(s). " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"Parameters: `delegate`: The account that previously announced the call. " +
+		"`real`: The account that the proxy will make a call on behalf of. " +
+		"`force_proxy_type`: Specify the exact proxy type to be used and checked for this call. " +
+		"`call`: The call to be made by the `real` account."
+}
@@ -0,0 +1,94 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	whoAddress = primitives.NewMultiAddressId(who)
+)
+
+func Test_Call_ProxyAnnounced_DecodeNestedArgs(t *testing.T) {
+	target := setupCallProxyAnnounced()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.Write(realAddress.Bytes())
+	buffer.Write(sc.NewOption[sc.U8](nil).Bytes())
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(whoAddress, realAddress, sc.NewOption[sc.U8](nil), mockCall), result.Args())
+}
+
+func Test_Call_ProxyAnnounced_BaseWeight(t *testing.T) {
+	target := setupCallProxyAnnounced()
+	target.Arguments = sc.NewVaryingData(whoAddress, realAddress, sc.NewOption[sc.U8](nil), mockCall)
+	mockCall.On("BaseWeight").Return(callWeight)
+	mockCall.On("WeighData", callWeight).Return(callWeight)
+
+	expect := callProxyAnnouncedWeight(dbWeight, maxPending, maxProxies).Add(callWeight)
+	assert.Equal(t, expect, target.BaseWeight())
+}
+
+func Test_Call_ProxyAnnounced_Dispatch(t *testing.T) {
+	target := setupCallProxyAnnounced()
+	target.Arguments = sc.NewVaryingData(whoAddress, realAddress, sc.NewOption[sc.U8](nil), mockCall)
+	expectProxies(definitionDelayed)
+	expectCallHash()
+	expectCallInfo()
+	expectDispatch(postInfo, nil)
+	mockSystemModule.On("StorageBlockNumber").Return(sc.U64(15), nil)
+	announcement := Announcement{Real: realAccount, CallHash: callHash, Height: 5}
+	mockStorageAnnouncements.On("Get", who).Return(Announcements{Announcements: sc.Sequence[Announcement]{announcement}, Deposit: sc.NewU128(11)}, nil)
+	mockCurrency.On("Unreserve", who, sc.NewU128(11)).Return(sc.NewU128(0), nil)
+	mockStorageAnnouncements.On("Remove", who).Return()
+
+	// Called by anyone, once the delay of the announcement has passed.
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(delegate), target.Args())
+
+	assert.NoError(t, err)
+	expectWeight := callProxyAnnouncedWeight(dbWeight, maxPending, maxProxies).Add(primitives.WeightFromParts(40, 0))
+	assert.Equal(t, postDispatchInfo(expectWeight), result)
+	mockStorageAnnouncements.AssertCalled(t, "Remove", who)
+	mockCurrency.AssertCalled(t, "Unreserve", who, sc.NewU128(11))
+	mockTransactional.AssertCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func Test_Call_ProxyAnnounced_Dispatch_Unannounced(t *testing.T) {
+	target := setupCallProxyAnnounced()
+	target.Arguments = sc.NewVaryingData(whoAddress, realAddress, sc.NewOption[sc.U8](nil), mockCall)
+	expectProxies(definitionDelayed)
+	expectCallHash()
+	mockSystemModule.On("StorageBlockNumber").Return(sc.U64(14), nil)
+	announcement := Announcement{Real: realAccount, CallHash: callHash, Height: 5}
+	mockStorageAnnouncements.On("Get", who).Return(Announcements{Announcements: sc.Sequence[Announcement]{announcement}, Deposit: sc.NewU128(11)}, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(delegate), target.Args())
+
+	assert.Equal(t, NewDispatchErrorUnannounced(moduleId), err)
+	mockStorageAnnouncements.AssertNotCalled(t, "Remove", mock.Anything)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func Test_Call_ProxyAnnounced_Dispatch_NotProxy(t *testing.T) {
+	target := setupCallProxyAnnounced()
+	target.Arguments = sc.NewVaryingData(whoAddress, realAddress, sc.NewOption[sc.U8](proxyTypeNonTransfer), mockCall)
+	expectProxies(definitionDelayed)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(delegate), target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotProxy(moduleId), err)
+}
+
+func setupCallProxyAnnounced() callProxyAnnounced {
+	return newCallProxyAnnounced(moduleId, FunctionProxyAnnounced, dbWeight, setupModule()).(callProxyAnnounced)
+}
@@ -0,0 +1,14 @@
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callProxyAnnouncedWeight(dbWeight primitives.RuntimeDbWeight, announcements sc.U64, proxies sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(39205000, 0).
+		SaturatingAdd(primitives.WeightFromParts(154203, 0).SaturatingMul(announcements)).
+		SaturatingAdd(primitives.WeightFromParts(40815, 0).SaturatingMul(proxies)).
+		SaturatingAdd(dbWeight.Reads(3)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
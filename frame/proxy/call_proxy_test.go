@@ -0,0 +1,111 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	realAddress = primitives.NewMultiAddressId(realAccount)
+)
+
+func Test_Call_Proxy_DecodeNestedArgs(t *testing.T) {
+	target := setupCallProxy()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(realAddress.Bytes())
+	buffer.Write(sc.NewOption[sc.U8](proxyTypeNonTransfer).Bytes())
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(realAddress, sc.NewOption[sc.U8](proxyTypeNonTransfer), mockCall), result.Args())
+}
+
+func Test_Call_Proxy_DecodeNestedArgs_InvalidProxyType(t *testing.T) {
+	target := setupCallProxy()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(realAddress.Bytes())
+	buffer.Write(sc.NewOption[sc.U8](sc.U8(2)).Bytes())
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	_, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.Equal(t, errInvalidProxyType, err)
+}
+
+func Test_Call_Proxy_BaseWeight(t *testing.T) {
+	target := setupCallProxy()
+	target.Arguments = sc.NewVaryingData(realAddress, sc.NewOption[sc.U8](nil), mockCall)
+	mockCall.On("BaseWeight").Return(callWeight)
+	mockCall.On("WeighData", callWeight).Return(callWeight)
+
+	expect := callProxyWeight(dbWeight, maxProxies).Add(callWeight)
+	assert.Equal(t, expect, target.BaseWeight())
+}
+
+func Test_Call_Proxy_ClassifyDispatch(t *testing.T) {
+	target := setupCallProxy()
+	target.Arguments = sc.NewVaryingData(realAddress, sc.NewOption[sc.U8](nil), mockCall)
+	mockCall.On("ClassifyDispatch", callWeight).Return(primitives.NewDispatchClassOperational())
+
+	assert.Equal(t, primitives.NewDispatchClassOperational(), target.ClassifyDispatch(callWeight))
+}
+
+func Test_Call_Proxy_Dispatch(t *testing.T) {
+	target := setupCallProxy()
+	target.Arguments = sc.NewVaryingData(realAddress, sc.NewOption[sc.U8](nil), mockCall)
+	expectProxies(definitionAny)
+	expectCallInfo()
+	expectDispatch(postInfo, nil)
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	expectWeight := callProxyWeight(dbWeight, maxProxies).Add(primitives.WeightFromParts(40, 0))
+	assert.Equal(t, postDispatchInfo(expectWeight), result)
+	outcome, _ := primitives.NewDispatchOutcome(sc.Empty{})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventProxyExecuted(moduleId, outcome))
+}
+
+func Test_Call_Proxy_Dispatch_NotProxy(t *testing.T) {
+	target := setupCallProxy()
+	target.Arguments = sc.NewVaryingData(realAddress, sc.NewOption[sc.U8](nil), mockCall)
+	expectProxies()
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotProxy(moduleId), err)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func Test_Call_Proxy_Dispatch_Unannounced(t *testing.T) {
+	target := setupCallProxy()
+	target.Arguments = sc.NewVaryingData(realAddress, sc.NewOption[sc.U8](nil), mockCall)
+	expectProxies(definitionDelayed)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorUnannounced(moduleId), err)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func Test_Call_Proxy_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallProxy()
+	target.Arguments = sc.NewVaryingData(realAddress, sc.NewOption[sc.U8](nil), mockCall)
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallProxy() callProxy {
+	return newCallProxy(moduleId, FunctionProxy, dbWeight, setupModule()).(callProxy)
+}
@@ -0,0 +1,108 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callRejectAnnouncement removes an announcement made by a proxy of the sender and returns the deposit of the proxy.
+type callRejectAnnouncement struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallRejectAnnouncement(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callRejectAnnouncement{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, primitives.H256{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callRejectAnnouncement) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	delegate, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	callHash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(delegate, callHash)
+
+	return c, nil
+}
+
+func (c callRejectAnnouncement) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callRejectAnnouncement) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callRejectAnnouncement) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callRejectAnnouncement) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callRejectAnnouncement) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callRejectAnnouncement) BaseWeight() primitives.Weight {
+	return callRejectAnnouncementWeight(c.dbWeight, sc.U64(c.module.maxPending), sc.U64(c.module.maxProxies))
+}
+
+func (_ callRejectAnnouncement) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callRejectAnnouncement) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callRejectAnnouncement) PaysFee(_ primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callRejectAnnouncement) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	delegate, err := lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	callHash := args[1].(primitives.H256)
+
+	removed, err := c.module.removeAnnouncements(delegate, func(announcement Announcement) bool {
+		return compareAccountIds(announcement.Real, who.Value) == 0 && bytes.Equal(announcement.CallHash.Bytes(), callHash.Bytes())
+	})
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	if !removed {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorNotFound(c.ModuleId)
+	}
+
+	return primitives.PostDispatchInfo{}, nil
+}
+
+func (_ callRejectAnnouncement) Docs() string {
+	return "Remove the given announcement of a delegate. " +
+		"May be called by a target (proxied) account to remove a call that one of their delegates " +
+		"(`delegate`) has announced they want to execute. The deposit is returned. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"Parameters: `delegate`: The account that previously announced the call. " +
+		"`call_hash`: The hash of the call to be made."
+}
@@ -0,0 +1,71 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_RejectAnnouncement_DecodeArgs(t *testing.T) {
+	target := setupCallRejectAnnouncement()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.Write(callHash.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(whoAddress, callHash), result.Args())
+}
+
+func Test_Call_RejectAnnouncement_BaseWeight(t *testing.T) {
+	target := setupCallRejectAnnouncement()
+
+	assert.Equal(t, callRejectAnnouncementWeight(dbWeight, maxPending, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_RejectAnnouncement_Dispatch(t *testing.T) {
+	target := setupCallRejectAnnouncement()
+	target.Arguments = sc.NewVaryingData(whoAddress, callHash)
+	announcement := Announcement{Real: realAccount, CallHash: callHash, Height: 5}
+	mockStorageAnnouncements.On("Get", who).Return(Announcements{Announcements: sc.Sequence[Announcement]{announcement}, Deposit: sc.NewU128(11)}, nil)
+	mockCurrency.On("Unreserve", who, sc.NewU128(11)).Return(sc.NewU128(0), nil)
+	mockStorageAnnouncements.On("Remove", who).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(realAccount), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Unreserve", who, sc.NewU128(11))
+	mockStorageAnnouncements.AssertCalled(t, "Remove", who)
+}
+
+func Test_Call_RejectAnnouncement_Dispatch_NotFound(t *testing.T) {
+	target := setupCallRejectAnnouncement()
+	target.Arguments = sc.NewVaryingData(whoAddress, callHash)
+	announcement := Announcement{Real: delegate, CallHash: callHash, Height: 5}
+	mockStorageAnnouncements.On("Get", who).Return(Announcements{Announcements: sc.Sequence[Announcement]{announcement}, Deposit: sc.NewU128(11)}, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(realAccount), target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+	mockStorageAnnouncements.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Call_RejectAnnouncement_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallRejectAnnouncement()
+	target.Arguments = sc.NewVaryingData(whoAddress, callHash)
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallRejectAnnouncement() callRejectAnnouncement {
+	return newCallRejectAnnouncement(moduleId, FunctionRejectAnnouncement, dbWeight, setupModule()).(callRejectAnnouncement)
+}
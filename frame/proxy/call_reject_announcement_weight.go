@@ -0,0 +1,14 @@
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callRejectAnnouncementWeight(dbWeight primitives.RuntimeDbWeight, announcements sc.U64, proxies sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(22506000, 0).
+		SaturatingAdd(primitives.WeightFromParts(141008, 0).SaturatingMul(announcements)).
+		SaturatingAdd(primitives.WeightFromParts(9604, 0).SaturatingMul(proxies)).
+		SaturatingAdd(dbWeight.Reads(2)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
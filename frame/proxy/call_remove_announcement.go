@@ -0,0 +1,107 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callRemoveAnnouncement removes an announcement of the sender and returns its deposit.
+type callRemoveAnnouncement struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallRemoveAnnouncement(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callRemoveAnnouncement{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, primitives.H256{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callRemoveAnnouncement) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	realAccount, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	callHash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(realAccount, callHash)
+
+	return c, nil
+}
+
+func (c callRemoveAnnouncement) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callRemoveAnnouncement) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callRemoveAnnouncement) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callRemoveAnnouncement) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callRemoveAnnouncement) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callRemoveAnnouncement) BaseWeight() primitives.Weight {
+	return callRemoveAnnouncementWeight(c.dbWeight, sc.U64(c.module.maxPending), sc.U64(c.module.maxProxies))
+}
+
+func (_ callRemoveAnnouncement) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callRemoveAnnouncement) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callRemoveAnnouncement) PaysFee(_ primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callRemoveAnnouncement) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	realAccount, err := lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	callHash := args[1].(primitives.H256)
+
+	removed, err := c.module.removeAnnouncements(who.Value, func(announcement Announcement) bool {
+		return compareAccountIds(announcement.Real, realAccount) == 0 && bytes.Equal(announcement.CallHash.Bytes(), callHash.Bytes())
+	})
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	if !removed {
+		return primitives.PostDispatchInfo{}, NewDispatchErrorNotFound(c.ModuleId)
+	}
+
+	return primitives.PostDispatchInfo{}, nil
+}
+
+func (_ callRemoveAnnouncement) Docs() string {
+	return "Remove a given announcement. " +
+		"May be called by a proxy account to remove a call they previously announced and return the deposit. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"Parameters: `real`: The account that the proxy will make a call on behalf of. " +
+		"`call_hash`: The hash of the call to be made by the `real` account."
+}
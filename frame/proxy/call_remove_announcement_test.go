@@ -0,0 +1,71 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_RemoveAnnouncement_DecodeArgs(t *testing.T) {
+	target := setupCallRemoveAnnouncement()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(realAddress.Bytes())
+	buffer.Write(callHash.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(realAddress, callHash), result.Args())
+}
+
+func Test_Call_RemoveAnnouncement_BaseWeight(t *testing.T) {
+	target := setupCallRemoveAnnouncement()
+
+	assert.Equal(t, callRemoveAnnouncementWeight(dbWeight, maxPending, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_RemoveAnnouncement_Dispatch(t *testing.T) {
+	target := setupCallRemoveAnnouncement()
+	target.Arguments = sc.NewVaryingData(realAddress, callHash)
+	announcement := Announcement{Real: realAccount, CallHash: callHash, Height: 5}
+	mockStorageAnnouncements.On("Get", who).Return(Announcements{Announcements: sc.Sequence[Announcement]{announcement}, Deposit: sc.NewU128(11)}, nil)
+	mockCurrency.On("Unreserve", who, sc.NewU128(11)).Return(sc.NewU128(0), nil)
+	mockStorageAnnouncements.On("Remove", who).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Unreserve", who, sc.NewU128(11))
+	mockStorageAnnouncements.AssertCalled(t, "Remove", who)
+}
+
+func Test_Call_RemoveAnnouncement_Dispatch_NotFound(t *testing.T) {
+	target := setupCallRemoveAnnouncement()
+	target.Arguments = sc.NewVaryingData(realAddress, callHash)
+	announcement := Announcement{Real: delegate, CallHash: callHash, Height: 5}
+	mockStorageAnnouncements.On("Get", who).Return(Announcements{Announcements: sc.Sequence[Announcement]{announcement}, Deposit: sc.NewU128(11)}, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+	mockStorageAnnouncements.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Call_RemoveAnnouncement_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallRemoveAnnouncement()
+	target.Arguments = sc.NewVaryingData(realAddress, callHash)
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallRemoveAnnouncement() callRemoveAnnouncement {
+	return newCallRemoveAnnouncement(moduleId, FunctionRemoveAnnouncement, dbWeight, setupModule()).(callRemoveAnnouncement)
+}
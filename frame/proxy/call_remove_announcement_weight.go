@@ -0,0 +1,14 @@
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callRemoveAnnouncementWeight(dbWeight primitives.RuntimeDbWeight, announcements sc.U64, proxies sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(22410000, 0).
+		SaturatingAdd(primitives.WeightFromParts(139312, 0).SaturatingMul(announcements)).
+		SaturatingAdd(primitives.WeightFromParts(11862, 0).SaturatingMul(proxies)).
+		SaturatingAdd(dbWeight.Reads(2)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
@@ -0,0 +1,78 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callRemoveProxies unregisters all proxy accounts of the sender.
+type callRemoveProxies struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallRemoveProxies(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callRemoveProxies{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callRemoveProxies) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	return c, nil
+}
+
+func (c callRemoveProxies) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callRemoveProxies) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callRemoveProxies) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callRemoveProxies) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callRemoveProxies) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callRemoveProxies) BaseWeight() primitives.Weight {
+	return callRemoveProxiesWeight(c.dbWeight, sc.U64(c.module.maxProxies))
+}
+
+func (_ callRemoveProxies) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callRemoveProxies) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callRemoveProxies) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callRemoveProxies) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.removeAllProxyDelegates(who.Value)
+}
+
+func (_ callRemoveProxies) Docs() string {
+	return "Unregister all proxy accounts for the sender. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"WARNING: This may be called on accounts created by `pure`, however if done, then " +
+		"the unreserved fees will be inaccessible. **All access to this account will be lost.**"
+}
@@ -0,0 +1,52 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Call_RemoveProxies_DecodeArgs(t *testing.T) {
+	target := setupCallRemoveProxies()
+
+	result, err := target.DecodeArgs(&bytes.Buffer{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(), result.Args())
+}
+
+func Test_Call_RemoveProxies_BaseWeight(t *testing.T) {
+	target := setupCallRemoveProxies()
+
+	assert.Equal(t, callRemoveProxiesWeight(dbWeight, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_RemoveProxies_Dispatch(t *testing.T) {
+	target := setupCallRemoveProxies()
+	proxies := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny, definitionNonTransfer}, Deposit: sc.NewU128(14)}
+	mockStorageProxies.On("Get", realAccount).Return(proxies, nil)
+	mockCurrency.On("Unreserve", realAccount, sc.NewU128(14)).Return(sc.NewU128(0), nil)
+	mockStorageProxies.On("Remove", realAccount).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(realAccount), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Unreserve", realAccount, sc.NewU128(14))
+	mockStorageProxies.AssertCalled(t, "Remove", realAccount)
+}
+
+func Test_Call_RemoveProxies_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallRemoveProxies()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallRemoveProxies() callRemoveProxies {
+	return newCallRemoveProxies(moduleId, FunctionRemoveProxies, dbWeight, setupModule()).(callRemoveProxies)
+}
@@ -0,0 +1,99 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callRemoveProxy unregisters a proxy account of the sender.
+type callRemoveProxy struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallRemoveProxy(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callRemoveProxy{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, sc.U8(0), sc.U64(0)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callRemoveProxy) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	delegate, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	proxyType, err := c.module.decodeProxyType(buffer)
+	if err != nil {
+		return nil, err
+	}
+	delay, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(delegate, proxyType, delay)
+
+	return c, nil
+}
+
+func (c callRemoveProxy) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callRemoveProxy) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callRemoveProxy) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callRemoveProxy) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callRemoveProxy) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callRemoveProxy) BaseWeight() primitives.Weight {
+	return callRemoveProxyWeight(c.dbWeight, sc.U64(c.module.maxProxies))
+}
+
+func (_ callRemoveProxy) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callRemoveProxy) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callRemoveProxy) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callRemoveProxy) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	delegate, err := lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	proxyType := args[1].(sc.U8)
+	delay := args[2].(sc.U64)
+
+	return primitives.PostDispatchInfo{}, c.module.removeProxyDelegate(who.Value, delegate, proxyType, delay)
+}
+
+func (_ callRemoveProxy) Docs() string {
+	return "Unregister a proxy account for the sender. " +
+		"The dispatch origin for this call must be `Signed`. " +
+		"Parameters: `proxy`: The account that the `caller` would like to remove as a proxy. " +
+		"`proxy_type`: The permissions currently enabled for the removed proxy account."
+}
@@ -0,0 +1,67 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Call_RemoveProxy_DecodeArgs(t *testing.T) {
+	target := setupCallRemoveProxy()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.Write(ProxyTypeAny.Bytes())
+	buffer.Write(sc.U64(0).Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(whoAddress, ProxyTypeAny, sc.U64(0)), result.Args())
+}
+
+func Test_Call_RemoveProxy_BaseWeight(t *testing.T) {
+	target := setupCallRemoveProxy()
+
+	assert.Equal(t, callRemoveProxyWeight(dbWeight, maxProxies), target.BaseWeight())
+}
+
+func Test_Call_RemoveProxy_Dispatch(t *testing.T) {
+	target := setupCallRemoveProxy()
+	target.Arguments = sc.NewVaryingData(whoAddress, ProxyTypeAny, sc.U64(0))
+	expectProxies(definitionAny)
+	mockStorageProxies.On("Remove", realAccount).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(realAccount), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageProxies.AssertCalled(t, "Remove", realAccount)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventProxyRemoved(moduleId, realAccount, who, ProxyTypeAny, 0))
+}
+
+func Test_Call_RemoveProxy_Dispatch_NotFound(t *testing.T) {
+	target := setupCallRemoveProxy()
+	target.Arguments = sc.NewVaryingData(whoAddress, proxyTypeNonTransfer, sc.U64(0))
+	expectProxies(definitionAny)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(realAccount), target.Args())
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+}
+
+func Test_Call_RemoveProxy_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallRemoveProxy()
+	target.Arguments = sc.NewVaryingData(whoAddress, ProxyTypeAny, sc.U64(0))
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallRemoveProxy() callRemoveProxy {
+	return newCallRemoveProxy(moduleId, FunctionRemoveProxy, dbWeight, setupModule()).(callRemoveProxy)
+}
@@ -0,0 +1,53 @@
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	Storage                   io.Storage
+	TransactionBroker         io.TransactionBroker
+	DbWeight                  primitives.RuntimeDbWeight
+	Currency                  primitives.ReservableCurrency
+	SystemModule              system.Module
+	InstanceFilter            InstanceFilter
+	ProxyDepositBase          primitives.Balance
+	ProxyDepositFactor        primitives.Balance
+	MaxProxies                sc.U32
+	MaxPending                sc.U32
+	AnnouncementDepositBase   primitives.Balance
+	AnnouncementDepositFactor primitives.Balance
+}
+
+func NewConfig(
+	storage io.Storage,
+	transactionBroker io.TransactionBroker,
+	dbWeight primitives.RuntimeDbWeight,
+	currency primitives.ReservableCurrency,
+	systemModule system.Module,
+	instanceFilter InstanceFilter,
+	proxyDepositBase primitives.Balance,
+	proxyDepositFactor primitives.Balance,
+	maxProxies sc.U32,
+	maxPending sc.U32,
+	announcementDepositBase primitives.Balance,
+	announcementDepositFactor primitives.Balance,
+) Config {
+	return Config{
+		Storage:                   storage,
+		TransactionBroker:         transactionBroker,
+		DbWeight:                  dbWeight,
+		Currency:                  currency,
+		SystemModule:              systemModule,
+		InstanceFilter:            instanceFilter,
+		ProxyDepositBase:          proxyDepositBase,
+		ProxyDepositFactor:        proxyDepositFactor,
+		MaxProxies:                maxProxies,
+		MaxPending:                maxPending,
+		AnnouncementDepositBase:   announcementDepositBase,
+		AnnouncementDepositFactor: announcementDepositFactor,
+	}
+}
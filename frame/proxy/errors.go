@@ -0,0 +1,58 @@
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Proxy module errors.
+const (
+	ErrorTooMany sc.U8 = iota
+	ErrorNotFound
+	ErrorNotProxy
+	ErrorUnproxyable
+	ErrorDuplicate
+	ErrorNoPermission
+	ErrorUnannounced
+	ErrorNoSelfProxy
+)
+
+func NewDispatchErrorTooMany(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorTooMany)
+}
+
+func NewDispatchErrorNotFound(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNotFound)
+}
+
+func NewDispatchErrorNotProxy(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNotProxy)
+}
+
+func NewDispatchErrorUnproxyable(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorUnproxyable)
+}
+
+func NewDispatchErrorDuplicate(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorDuplicate)
+}
+
+func NewDispatchErrorNoPermission(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNoPermission)
+}
+
+func NewDispatchErrorUnannounced(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorUnannounced)
+}
+
+func NewDispatchErrorNoSelfProxy(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNoSelfProxy)
+}
+
+func newDispatchError(moduleId sc.U8, err sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
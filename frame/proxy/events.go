@@ -0,0 +1,122 @@
+package proxy
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Proxy module events.
+const (
+	EventProxyExecuted sc.U8 = iota
+	EventPureCreated
+	EventAnnounced
+	EventProxyAdded
+	EventProxyRemoved
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid proxy.Event module")
+	errInvalidEventType   = errors.New("invalid proxy.Event type")
+)
+
+func newEventProxyExecuted(moduleIndex sc.U8, result primitives.DispatchOutcome) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventProxyExecuted, result)
+}
+
+func newEventPureCreated(moduleIndex sc.U8, pure primitives.AccountId, who primitives.AccountId, proxyType sc.U8, disambiguationIndex sc.U16) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventPureCreated, pure, who, proxyType, disambiguationIndex)
+}
+
+func newEventAnnounced(moduleIndex sc.U8, realAccount primitives.AccountId, proxy primitives.AccountId, callHash primitives.H256) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventAnnounced, realAccount, proxy, callHash)
+}
+
+func newEventProxyAdded(moduleIndex sc.U8, delegator primitives.AccountId, delegatee primitives.AccountId, proxyType sc.U8, delay sc.U64) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventProxyAdded, delegator, delegatee, proxyType, delay)
+}
+
+func newEventProxyRemoved(moduleIndex sc.U8, delegator primitives.AccountId, delegatee primitives.AccountId, proxyType sc.U8, delay sc.U64) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventProxyRemoved, delegator, delegatee, proxyType, delay)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventProxyExecuted:
+		result, err := primitives.DecodeDispatchOutcome(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventProxyExecuted(moduleIndex, result), nil
+	case EventPureCreated:
+		pure, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		who, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		proxyType, err := sc.DecodeU8(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		disambiguationIndex, err := sc.DecodeU16(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventPureCreated(moduleIndex, pure, who, proxyType, disambiguationIndex), nil
+	case EventAnnounced:
+		realAccount, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		proxy, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		callHash, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventAnnounced(moduleIndex, realAccount, proxy, callHash), nil
+	case EventProxyAdded, EventProxyRemoved:
+		delegator, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		delegatee, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		proxyType, err := sc.DecodeU8(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		delay, err := sc.DecodeU64(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		if b == EventProxyAdded {
+			return newEventProxyAdded(moduleIndex, delegator, delegatee, proxyType, delay), nil
+		}
+		return newEventProxyRemoved(moduleIndex, delegator, delegatee, proxyType, delay), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
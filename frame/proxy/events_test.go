@@ -0,0 +1,67 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DecodeEvent(t *testing.T) {
+	outcome, _ := primitives.NewDispatchOutcome(sc.Empty{})
+
+	for _, event := range []primitives.Event{
+		newEventProxyExecuted(moduleId, outcome),
+		newEventPureCreated(moduleId, pureAccount, who, ProxyTypeAny, 1),
+		newEventAnnounced(moduleId, realAccount, who, callHash),
+		newEventProxyAdded(moduleId, realAccount, who, proxyTypeNonTransfer, 10),
+		newEventProxyRemoved(moduleId, realAccount, who, proxyTypeNonTransfer, 10),
+	} {
+		result, err := DecodeEvent(moduleId, bytes.NewBuffer(event.Bytes()))
+		assert.Nil(t, err)
+
+		assert.Equal(t, event, result)
+	}
+}
+
+func Test_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId + 1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
+
+func Test_DecodeProxies(t *testing.T) {
+	proxies := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny, definitionDelayed}, Deposit: sc.NewU128(14)}
+
+	result, err := DecodeProxies(bytes.NewBuffer(proxies.Bytes()))
+
+	assert.Nil(t, err)
+	assert.Equal(t, proxies, result)
+}
+
+func Test_DecodeAnnouncements(t *testing.T) {
+	announcements := Announcements{
+		Announcements: sc.Sequence[Announcement]{{Real: realAccount, CallHash: callHash, Height: 5}},
+		Deposit:       sc.NewU128(11),
+	}
+
+	result, err := DecodeAnnouncements(bytes.NewBuffer(announcements.Bytes()))
+
+	assert.Nil(t, err)
+	assert.Equal(t, announcements, result)
+}
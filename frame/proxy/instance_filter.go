@@ -0,0 +1,93 @@
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/vesting"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ProxyTypeAny is the default proxy type, which is allowed to dispatch any call.
+const ProxyTypeAny sc.U8 = 0
+
+// The proxy types supported by TypeFilter, in addition to ProxyTypeAny.
+const (
+	ProxyTypeNonTransfer sc.U8 = iota + 1
+	ProxyTypeCancelProxy
+)
+
+// InstanceFilter describes the proxy types of the runtime and decides which calls a proxy of each type is allowed to dispatch.
+// A proxy type is identified by the index of its variant, where the variant at index 0 must allow any call.
+type InstanceFilter interface {
+	// Variants returns the names of the proxy types, ordered by their index.
+	Variants() sc.Sequence[sc.Str]
+	// Filter returns true if a proxy of `proxyType` is allowed to dispatch `call`.
+	Filter(proxyType sc.U8, call primitives.Call) bool
+	// IsSuperset returns true if `proxyType` allows at least the calls allowed by `other`.
+	IsSuperset(proxyType sc.U8, other sc.U8) bool
+}
+
+// DefaultInstanceFilter supports only ProxyTypeAny.
+type DefaultInstanceFilter struct{}
+
+func (DefaultInstanceFilter) Variants() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Any"}
+}
+
+func (DefaultInstanceFilter) Filter(proxyType sc.U8, _ primitives.Call) bool {
+	return proxyType == ProxyTypeAny
+}
+
+func (DefaultInstanceFilter) IsSuperset(proxyType sc.U8, other sc.U8) bool {
+	return proxyType == ProxyTypeAny || proxyType == other
+}
+
+// TypeFilter supports the Any, NonTransfer and CancelProxy proxy types. It identifies the calls by the indices
+// of the modules in the runtime.
+type TypeFilter struct {
+	BalancesIndex  sc.U8
+	UtilityIndex   sc.U8
+	MultisigIndex  sc.U8
+	ProxyIndex     sc.U8
+	SchedulerIndex sc.U8
+	SudoIndex      sc.U8
+	VestingIndex   sc.U8
+}
+
+func (TypeFilter) Variants() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Any", "NonTransfer", "CancelProxy"}
+}
+
+// Filter checks the module and function index of `call` against the permissions of `proxyType`.
+// NonTransfer proxies cannot dispatch balance and vested transfers, nor the calls, which dispatch other calls
+// (batching, multisig, proxy, scheduler and sudo calls), as the nested calls are not inspected.
+// Of the proxy calls, they can only reject announcements and remove the proxies of the delegator.
+func (f TypeFilter) Filter(proxyType sc.U8, call primitives.Call) bool {
+	switch proxyType {
+	case ProxyTypeAny:
+		return true
+	case ProxyTypeNonTransfer:
+		switch call.ModuleIndex() {
+		case f.BalancesIndex, f.UtilityIndex, f.MultisigIndex, f.SchedulerIndex, f.SudoIndex:
+			return false
+		case f.ProxyIndex:
+			switch call.FunctionIndex() {
+			case FunctionRejectAnnouncement, FunctionRemoveProxies:
+				return true
+			}
+			return false
+		case f.VestingIndex:
+			return call.FunctionIndex() != vesting.FunctionVestedTransfer
+		}
+		return true
+	case ProxyTypeCancelProxy:
+		return call.ModuleIndex() == f.ProxyIndex && call.FunctionIndex() == FunctionRejectAnnouncement
+	}
+	return false
+}
+
+func (TypeFilter) IsSuperset(proxyType sc.U8, other sc.U8) bool {
+	if proxyType == other || proxyType == ProxyTypeAny {
+		return true
+	}
+	return proxyType == ProxyTypeNonTransfer && other == ProxyTypeCancelProxy
+}
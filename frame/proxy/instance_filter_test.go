@@ -0,0 +1,89 @@
+package proxy
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/vesting"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	filterSystemIndex sc.U8 = iota
+	filterBalancesIndex
+	filterUtilityIndex
+	filterMultisigIndex
+	filterProxyIndex
+	filterSchedulerIndex
+	filterSudoIndex
+	filterVestingIndex
+)
+
+var targetTypeFilter = TypeFilter{
+	BalancesIndex:  filterBalancesIndex,
+	UtilityIndex:   filterUtilityIndex,
+	MultisigIndex:  filterMultisigIndex,
+	ProxyIndex:     filterProxyIndex,
+	SchedulerIndex: filterSchedulerIndex,
+	SudoIndex:      filterSudoIndex,
+	VestingIndex:   filterVestingIndex,
+}
+
+func Test_TypeFilter_Variants(t *testing.T) {
+	assert.Equal(t, sc.Sequence[sc.Str]{"Any", "NonTransfer", "CancelProxy"}, targetTypeFilter.Variants())
+}
+
+func Test_TypeFilter_Filter(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		call        primitives.Call
+		any         bool
+		nonTransfer bool
+		cancelProxy bool
+	}{
+		{name: "System remark", call: newTestCall(filterSystemIndex, 0), any: true, nonTransfer: true},
+		{name: "Balances transfer_allow_death", call: newTestCall(filterBalancesIndex, 0), any: true},
+		{name: "Balances transfer_keep_alive", call: newTestCall(filterBalancesIndex, 3), any: true},
+		{name: "Utility batch", call: newTestCall(filterUtilityIndex, 0), any: true},
+		{name: "Utility as_derivative", call: newTestCall(filterUtilityIndex, 1), any: true},
+		{name: "Multisig as_multi_threshold_1", call: newTestCall(filterMultisigIndex, 0), any: true},
+		{name: "Multisig as_multi", call: newTestCall(filterMultisigIndex, 1), any: true},
+		{name: "Scheduler schedule", call: newTestCall(filterSchedulerIndex, 0), any: true},
+		{name: "Scheduler cancel", call: newTestCall(filterSchedulerIndex, 1), any: true},
+		{name: "Sudo sudo", call: newTestCall(filterSudoIndex, 0), any: true},
+		{name: "Sudo set_key", call: newTestCall(filterSudoIndex, 2), any: true},
+		{name: "Proxy proxy", call: newTestCall(filterProxyIndex, FunctionProxy), any: true},
+		{name: "Proxy add_proxy", call: newTestCall(filterProxyIndex, FunctionAddProxy), any: true},
+		{name: "Proxy remove_proxy", call: newTestCall(filterProxyIndex, FunctionRemoveProxy), any: true},
+		{name: "Proxy remove_proxies", call: newTestCall(filterProxyIndex, FunctionRemoveProxies), any: true, nonTransfer: true},
+		{name: "Proxy create_pure", call: newTestCall(filterProxyIndex, FunctionCreatePure), any: true},
+		{name: "Proxy kill_pure", call: newTestCall(filterProxyIndex, FunctionKillPure), any: true},
+		{name: "Proxy announce", call: newTestCall(filterProxyIndex, FunctionAnnounce), any: true},
+		{name: "Proxy remove_announcement", call: newTestCall(filterProxyIndex, FunctionRemoveAnnouncement), any: true},
+		{name: "Proxy reject_announcement", call: newTestCall(filterProxyIndex, FunctionRejectAnnouncement), any: true, nonTransfer: true, cancelProxy: true},
+		{name: "Proxy proxy_announced", call: newTestCall(filterProxyIndex, FunctionProxyAnnounced), any: true},
+		{name: "Vesting vest", call: newTestCall(filterVestingIndex, vesting.FunctionVest), any: true, nonTransfer: true},
+		{name: "Vesting vest_other", call: newTestCall(filterVestingIndex, vesting.FunctionVestOther), any: true, nonTransfer: true},
+		{name: "Vesting vested_transfer", call: newTestCall(filterVestingIndex, vesting.FunctionVestedTransfer), any: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.any, targetTypeFilter.Filter(ProxyTypeAny, tt.call))
+			assert.Equal(t, tt.nonTransfer, targetTypeFilter.Filter(ProxyTypeNonTransfer, tt.call))
+			assert.Equal(t, tt.cancelProxy, targetTypeFilter.Filter(ProxyTypeCancelProxy, tt.call))
+		})
+	}
+}
+
+func Test_TypeFilter_Filter_UnknownProxyType(t *testing.T) {
+	assert.False(t, targetTypeFilter.Filter(ProxyTypeCancelProxy+1, newTestCall(filterSystemIndex, 0)))
+}
+
+func Test_TypeFilter_IsSuperset(t *testing.T) {
+	assert.True(t, targetTypeFilter.IsSuperset(ProxyTypeAny, ProxyTypeNonTransfer))
+	assert.True(t, targetTypeFilter.IsSuperset(ProxyTypeAny, ProxyTypeCancelProxy))
+	assert.True(t, targetTypeFilter.IsSuperset(ProxyTypeNonTransfer, ProxyTypeCancelProxy))
+	assert.True(t, targetTypeFilter.IsSuperset(ProxyTypeCancelProxy, ProxyTypeCancelProxy))
+	assert.False(t, targetTypeFilter.IsSuperset(ProxyTypeNonTransfer, ProxyTypeAny))
+	assert.False(t, targetTypeFilter.IsSuperset(ProxyTypeCancelProxy, ProxyTypeNonTransfer))
+}
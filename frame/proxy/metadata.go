@@ -0,0 +1,407 @@
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func (m Module) Metadata() primitives.MetadataModule {
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesProxyCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesProxyCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Proxy, Runtime>"),
+				},
+				m.index,
+				"Call.Proxy")),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesProxyEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesProxyEvent, "pallet_proxy::Event<Runtime>"),
+				},
+				m.index,
+				"Events.Proxy"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"ProxyDepositBase",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(m.proxyDepositBase.Bytes()),
+				"The base amount of currency needed to reserve for creating a proxy.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"ProxyDepositFactor",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(m.proxyDepositFactor.Bytes()),
+				"The amount of currency needed per proxy added.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxProxies",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.maxProxies.Bytes()),
+				"The maximum amount of proxies allowed for a single account.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxPending",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.maxPending.Bytes()),
+				"The maximum amount of time-delayed announcements that are allowed to be pending.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"AnnouncementDepositBase",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(m.announcementDepositBase.Bytes()),
+				"The base amount of currency needed to reserve for creating an announcement.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"AnnouncementDepositFactor",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(m.announcementDepositFactor.Bytes()),
+				"The amount of currency needed per announcement made.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesProxyErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesProxyErrors),
+				},
+				m.index,
+				"Errors.Proxy"),
+		),
+		Index: m.index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Proxies",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesProxies)),
+				"The set of account proxies. Maps the account which has delegated to the accounts which are being delegated to, together with the amount held on deposit."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Announcements",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesProxyAnnouncements)),
+				"The announcements made by the proxy (key)."),
+		},
+	})
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesProxyType,
+			"ProxyType",
+			sc.Sequence[sc.Str]{"node_runtime", "ProxyType"},
+			primitives.NewMetadataTypeDefinitionVariant(m.metadataProxyTypeVariants())),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionProxyType,
+			"Option<ProxyType>",
+			sc.Sequence[sc.Str]{"Option"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						0,
+						""),
+					primitives.NewMetadataDefinitionVariant(
+						"Some",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.TypesProxyType),
+						},
+						1,
+						""),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesProxyType, "T")),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesProxyDefinition,
+			"ProxyDefinition",
+			sc.Sequence[sc.Str]{"pallet_proxy", "ProxyDefinition"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "delegate", "AccountId"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesProxyType, "proxy_type", "ProxyType"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "BlockNumber"),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "AccountId"),
+				primitives.NewMetadataTypeParameter(metadata.TypesProxyType, "ProxyType"),
+				primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU64, "BlockNumber"),
+			}),
+
+		primitives.NewMetadataType(metadata.TypesSequenceProxyDefinition,
+			"BoundedVec<ProxyDefinition<AccountId, ProxyType, BlockNumber>, MaxProxies>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesProxyDefinition))),
+
+		primitives.NewMetadataType(metadata.TypesProxies,
+			"(BoundedVec<ProxyDefinition<AccountId, ProxyType, BlockNumber>, MaxProxies>, Balance)",
+			primitives.NewMetadataTypeDefinitionTuple(
+				sc.Sequence[sc.Compact]{
+					sc.ToCompact(metadata.TypesSequenceProxyDefinition),
+					sc.ToCompact(metadata.PrimitiveTypesU128),
+				})),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesProxyAnnouncement,
+			"Announcement",
+			sc.Sequence[sc.Str]{"pallet_proxy", "Announcement"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "real", "AccountId"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "call_hash", "Hash"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "height", "BlockNumber"),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "AccountId"),
+				primitives.NewMetadataTypeParameter(metadata.TypesH256, "Hash"),
+				primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU64, "BlockNumber"),
+			}),
+
+		primitives.NewMetadataType(metadata.TypesSequenceProxyAnnouncement,
+			"BoundedVec<Announcement<AccountId, Hash, BlockNumber>, MaxPending>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesProxyAnnouncement))),
+
+		primitives.NewMetadataType(metadata.TypesProxyAnnouncements,
+			"(BoundedVec<Announcement<AccountId, Hash, BlockNumber>, MaxPending>, Balance)",
+			primitives.NewMetadataTypeDefinitionTuple(
+				sc.Sequence[sc.Compact]{
+					sc.ToCompact(metadata.TypesSequenceProxyAnnouncement),
+					sc.ToCompact(metadata.PrimitiveTypesU128),
+				})),
+
+		primitives.NewMetadataTypeWithPath(
+			metadata.TypesProxyEvent,
+			"pallet_proxy pallet Event",
+			sc.Sequence[sc.Str]{"pallet_proxy", "pallet", "Event"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"ProxyExecuted",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchOutcome, "result", "DispatchResult"),
+						},
+						EventProxyExecuted,
+						"A proxy was executed correctly, with the given."),
+					primitives.NewMetadataDefinitionVariant(
+						"PureCreated",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "pure", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesProxyType, "proxy_type", "T::ProxyType"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU16, "disambiguation_index", "u16"),
+						},
+						EventPureCreated,
+						"A pure account has been created by new proxy with given disambiguation index and proxy type."),
+					primitives.NewMetadataDefinitionVariant(
+						"Announced",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "real", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "proxy", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "call_hash", "CallHashOf<T>"),
+						},
+						EventAnnounced,
+						"An announcement was placed to make a call in the future."),
+					primitives.NewMetadataDefinitionVariant(
+						"ProxyAdded",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "delegator", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "delegatee", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesProxyType, "proxy_type", "T::ProxyType"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "BlockNumberFor<T>"),
+						},
+						EventProxyAdded,
+						"A proxy was added."),
+					primitives.NewMetadataDefinitionVariant(
+						"ProxyRemoved",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "delegator", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "delegatee", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesProxyType, "proxy_type", "T::ProxyType"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "BlockNumberFor<T>"),
+						},
+						EventProxyRemoved,
+						"A proxy was removed."),
+				})),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesProxyErrors,
+			"pallet_proxy pallet Error",
+			sc.Sequence[sc.Str]{"pallet_proxy", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"TooMany",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooMany,
+						"There are too many proxies registered or too many announcements pending."),
+					primitives.NewMetadataDefinitionVariant(
+						"NotFound",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNotFound,
+						"Proxy registration not found."),
+					primitives.NewMetadataDefinitionVariant(
+						"NotProxy",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNotProxy,
+						"Sender is not a proxy of the account to be proxied."),
+					primitives.NewMetadataDefinitionVariant(
+						"Unproxyable",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorUnproxyable,
+						"A call which is incompatible with the proxy type's filter was attempted."),
+					primitives.NewMetadataDefinitionVariant(
+						"Duplicate",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorDuplicate,
+						"Account is already a proxy."),
+					primitives.NewMetadataDefinitionVariant(
+						"NoPermission",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNoPermission,
+						"Call may not be made by proxy because it may escalate its privileges."),
+					primitives.NewMetadataDefinitionVariant(
+						"Unannounced",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorUnannounced,
+						"Announcement, if made at all, was made too recently."),
+					primitives.NewMetadataDefinitionVariant(
+						"NoSelfProxy",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNoSelfProxy,
+						"Cannot add self as proxy."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesProxyCalls,
+			"Proxy calls",
+			sc.Sequence[sc.Str]{"pallet_proxy", "pallet", "Call"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"proxy",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "real", "AccountIdLookupOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionProxyType, "force_proxy_type", "Option<T::ProxyType>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+						},
+						FunctionProxy,
+						"Dispatch the given `call` from an account that the sender is authorised for through `add_proxy`."),
+					primitives.NewMetadataDefinitionVariant(
+						"add_proxy",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "delegate", "AccountIdLookupOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesProxyType, "proxy_type", "T::ProxyType"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "BlockNumberFor<T>"),
+						},
+						FunctionAddProxy,
+						"Register a proxy account for the sender that is able to make calls on its behalf."),
+					primitives.NewMetadataDefinitionVariant(
+						"remove_proxy",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "delegate", "AccountIdLookupOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesProxyType, "proxy_type", "T::ProxyType"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "BlockNumberFor<T>"),
+						},
+						FunctionRemoveProxy,
+						"Unregister a proxy account for the sender."),
+					primitives.NewMetadataDefinitionVariant(
+						"remove_proxies",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						FunctionRemoveProxies,
+						"Unregister all proxy accounts for the sender."),
+					primitives.NewMetadataDefinitionVariant(
+						"create_pure",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesProxyType, "proxy_type", "T::ProxyType"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "delay", "BlockNumberFor<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU16, "index", "u16"),
+						},
+						FunctionCreatePure,
+						"Spawn a fresh new account that is guaranteed to be otherwise inaccessible, and initialize it with a proxy of `proxy_type` for `origin` sender."),
+					primitives.NewMetadataDefinitionVariant(
+						"kill_pure",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "spawner", "AccountIdLookupOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesProxyType, "proxy_type", "T::ProxyType"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU16, "index", "u16"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU64, "height", "BlockNumberFor<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU32, "ext_index", "u32"),
+						},
+						FunctionKillPure,
+						"Removes a previously spawned pure proxy."),
+					primitives.NewMetadataDefinitionVariant(
+						"announce",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "real", "AccountIdLookupOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "call_hash", "CallHashOf<T>"),
+						},
+						FunctionAnnounce,
+						"Publish the hash of a proxy-call that will be made in the future."),
+					primitives.NewMetadataDefinitionVariant(
+						"remove_announcement",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "real", "AccountIdLookupOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "call_hash", "CallHashOf<T>"),
+						},
+						FunctionRemoveAnnouncement,
+						"Remove a given announcement."),
+					primitives.NewMetadataDefinitionVariant(
+						"reject_announcement",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "delegate", "AccountIdLookupOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "call_hash", "CallHashOf<T>"),
+						},
+						FunctionRejectAnnouncement,
+						"Remove the given announcement of a delegate."),
+					primitives.NewMetadataDefinitionVariant(
+						"proxy_announced",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "delegate", "AccountIdLookupOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "real", "AccountIdLookupOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionProxyType, "force_proxy_type", "Option<T::ProxyType>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+						},
+						FunctionProxyAnnounced,
+						"Dispatch the given `call` from an account that the sender is authorized for through `add_proxy`, once its announcement delay has passed."),
+				}),
+			primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+}
+
+// metadataProxyTypeVariants returns the variants of the proxy types supported by the runtime.
+func (m Module) metadataProxyTypeVariants() sc.Sequence[primitives.MetadataDefinitionVariant] {
+	variants := sc.Sequence[primitives.MetadataDefinitionVariant]{}
+	for i, variant := range m.instanceFilter.Variants() {
+		variants = append(variants, primitives.NewMetadataDefinitionVariantStr(
+			variant,
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+			sc.U8(i),
+			""))
+	}
+	return variants
+}
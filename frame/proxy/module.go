@@ -0,0 +1,430 @@
+package proxy
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	FunctionProxy = iota
+	FunctionAddProxy
+	FunctionRemoveProxy
+	FunctionRemoveProxies
+	FunctionCreatePure
+	FunctionKillPure
+	FunctionAnnounce
+	FunctionRemoveAnnouncement
+	FunctionRejectAnnouncement
+	FunctionProxyAnnounced
+)
+
+const (
+	name = sc.Str("Proxy")
+)
+
+var (
+	// pureAccountPrefix is the prefix of the entropy from which pure proxy accounts are generated.
+	pureAccountPrefix = []byte("modlpy/proxy____")
+)
+
+var (
+	errInvalidProxyType = errors.New("invalid proxy type")
+)
+
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	index                     sc.U8
+	dbWeight                  primitives.RuntimeDbWeight
+	proxyDepositBase          primitives.Balance
+	proxyDepositFactor        primitives.Balance
+	maxProxies                sc.U32
+	maxPending                sc.U32
+	announcementDepositBase   primitives.Balance
+	announcementDepositFactor primitives.Balance
+	functions                 map[sc.U8]primitives.Call
+	storage                   *storage
+	currency                  primitives.ReservableCurrency
+	systemModule              system.Module
+	instanceFilter            InstanceFilter
+	transactional             support.Transactional[primitives.PostDispatchInfo]
+	hashing                   io.Hashing
+	mdGenerator               *primitives.MetadataTypeGenerator
+	logger                    log.RuntimeLogger
+}
+
+func New(index sc.U8, config Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.RuntimeLogger) Module {
+	functions := make(map[sc.U8]primitives.Call)
+
+	module := Module{
+		index:                     index,
+		dbWeight:                  config.DbWeight,
+		proxyDepositBase:          config.ProxyDepositBase,
+		proxyDepositFactor:        config.ProxyDepositFactor,
+		maxProxies:                config.MaxProxies,
+		maxPending:                config.MaxPending,
+		announcementDepositBase:   config.AnnouncementDepositBase,
+		announcementDepositFactor: config.AnnouncementDepositFactor,
+		storage:                   newStorage(config.Storage),
+		currency:                  config.Currency,
+		systemModule:              config.SystemModule,
+		instanceFilter:            config.InstanceFilter,
+		transactional:             support.NewTransactional[primitives.PostDispatchInfo](config.Storage, config.TransactionBroker, logger),
+		hashing:                   io.NewHashing(),
+		mdGenerator:               mdGenerator,
+		logger:                    logger,
+	}
+
+	functions[FunctionProxy] = newCallProxy(index, FunctionProxy, config.DbWeight, module)
+	functions[FunctionAddProxy] = newCallAddProxy(index, FunctionAddProxy, config.DbWeight, module)
+	functions[FunctionRemoveProxy] = newCallRemoveProxy(index, FunctionRemoveProxy, config.DbWeight, module)
+	functions[FunctionRemoveProxies] = newCallRemoveProxies(index, FunctionRemoveProxies, config.DbWeight, module)
+	functions[FunctionCreatePure] = newCallCreatePure(index, FunctionCreatePure, config.DbWeight, module)
+	functions[FunctionKillPure] = newCallKillPure(index, FunctionKillPure, config.DbWeight, module)
+	functions[FunctionAnnounce] = newCallAnnounce(index, FunctionAnnounce, config.DbWeight, module)
+	functions[FunctionRemoveAnnouncement] = newCallRemoveAnnouncement(index, FunctionRemoveAnnouncement, config.DbWeight, module)
+	functions[FunctionRejectAnnouncement] = newCallRejectAnnouncement(index, FunctionRejectAnnouncement, config.DbWeight, module)
+	functions[FunctionProxyAnnounced] = newCallProxyAnnounced(index, FunctionProxyAnnounced, config.DbWeight, module)
+
+	module.functions = functions
+
+	return module
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) GetIndex() sc.U8 { return m.index }
+
+func (m Module) Functions() map[sc.U8]primitives.Call { return m.functions }
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) { return sc.Empty{}, nil }
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// Proxies returns the proxy definitions of `delegator` and the amount reserved for them.
+func (m Module) Proxies(delegator primitives.AccountId) (Proxies, error) {
+	return m.storage.Proxies.Get(delegator)
+}
+
+// Announcements returns the pending announcements of `delegate` and the amount reserved for them.
+func (m Module) Announcements(delegate primitives.AccountId) (Announcements, error) {
+	return m.storage.Announcements.Get(delegate)
+}
+
+// PureAccount derives the account id of a pure proxy, created by `spawner` with `proxyType` and disambiguation `index`
+// in the extrinsic with index `extrinsicIndex` of the block at `height`.
+func (m Module) PureAccount(spawner primitives.AccountId, proxyType sc.U8, index sc.U16, height sc.U64, extrinsicIndex sc.U32) (primitives.AccountId, error) {
+	entropy := append([]byte{}, pureAccountPrefix...)
+	entropy = append(entropy, spawner.Bytes()...)
+	entropy = append(entropy, height.Bytes()...)
+	entropy = append(entropy, extrinsicIndex.Bytes()...)
+	entropy = append(entropy, proxyType.Bytes()...)
+	entropy = append(entropy, index.Bytes()...)
+
+	hash := m.hashing.Blake256(entropy)
+
+	return primitives.NewAccountId(sc.BytesToSequenceU8(hash)...)
+}
+
+// addProxyDelegate registers `delegatee` as a proxy of `delegator` and reserves the additional deposit.
+func (m Module) addProxyDelegate(delegator primitives.AccountId, delegatee primitives.AccountId, proxyType sc.U8, delay sc.U64) error {
+	if compareAccountIds(delegator, delegatee) == 0 {
+		return NewDispatchErrorNoSelfProxy(m.index)
+	}
+
+	proxies, err := m.storage.Proxies.Get(delegator)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	definition := ProxyDefinition{Delegate: delegatee, ProxyType: proxyType, Delay: delay}
+	position, found := searchProxy(proxies.Definitions, definition)
+	if found {
+		return NewDispatchErrorDuplicate(m.index)
+	}
+	if sc.U32(len(proxies.Definitions)) >= m.maxProxies {
+		return NewDispatchErrorTooMany(m.index)
+	}
+
+	definitions := make(sc.Sequence[ProxyDefinition], 0, len(proxies.Definitions)+1)
+	definitions = append(definitions, proxies.Definitions[:position]...)
+	definitions = append(definitions, definition)
+	definitions = append(definitions, proxies.Definitions[position:]...)
+
+	deposit := m.proxyDeposit(len(definitions))
+	if err := m.updateDeposit(delegator, proxies.Deposit, deposit); err != nil {
+		return err
+	}
+
+	m.storage.Proxies.Put(delegator, Proxies{Definitions: definitions, Deposit: deposit})
+	m.systemModule.DepositEvent(newEventProxyAdded(m.index, delegator, delegatee, proxyType, delay))
+
+	return nil
+}
+
+// removeProxyDelegate unregisters `delegatee` as a proxy of `delegator` and unreserves the released deposit.
+func (m Module) removeProxyDelegate(delegator primitives.AccountId, delegatee primitives.AccountId, proxyType sc.U8, delay sc.U64) error {
+	proxies, err := m.storage.Proxies.Get(delegator)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	position, found := searchProxy(proxies.Definitions, ProxyDefinition{Delegate: delegatee, ProxyType: proxyType, Delay: delay})
+	if !found {
+		return NewDispatchErrorNotFound(m.index)
+	}
+
+	definitions := make(sc.Sequence[ProxyDefinition], 0, len(proxies.Definitions)-1)
+	definitions = append(definitions, proxies.Definitions[:position]...)
+	definitions = append(definitions, proxies.Definitions[position+1:]...)
+
+	deposit := m.proxyDeposit(len(definitions))
+	if err := m.updateDeposit(delegator, proxies.Deposit, deposit); err != nil {
+		return err
+	}
+
+	if len(definitions) == 0 {
+		m.storage.Proxies.Remove(delegator)
+	} else {
+		m.storage.Proxies.Put(delegator, Proxies{Definitions: definitions, Deposit: deposit})
+	}
+	m.systemModule.DepositEvent(newEventProxyRemoved(m.index, delegator, delegatee, proxyType, delay))
+
+	return nil
+}
+
+// removeAllProxyDelegates unregisters all proxies of `delegator` and unreserves their deposit.
+func (m Module) removeAllProxyDelegates(delegator primitives.AccountId) error {
+	proxies, err := m.storage.Proxies.Get(delegator)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	if _, err := m.currency.Unreserve(delegator, proxies.Deposit); err != nil {
+		return err
+	}
+	m.storage.Proxies.Remove(delegator)
+
+	return nil
+}
+
+// findProxy returns the proxy definition of `delegate` for `realAccount`,
+// optionally restricted to `forceProxyType`.
+func (m Module) findProxy(realAccount primitives.AccountId, delegate primitives.AccountId, forceProxyType sc.Option[sc.U8]) (ProxyDefinition, error) {
+	proxies, err := m.storage.Proxies.Get(realAccount)
+	if err != nil {
+		return ProxyDefinition{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	for _, definition := range proxies.Definitions {
+		if compareAccountIds(definition.Delegate, delegate) != 0 {
+			continue
+		}
+		if forceProxyType.HasValue && forceProxyType.Value != definition.ProxyType {
+			continue
+		}
+		return definition, nil
+	}
+
+	return ProxyDefinition{}, NewDispatchErrorNotProxy(m.index)
+}
+
+// doProxy dispatches `call` on behalf of `realAccount` if the proxy `definition` allows it.
+// The outcome is deposited as an event and the weight consumed by the call is returned.
+func (m Module) doProxy(definition ProxyDefinition, realAccount primitives.AccountId, call primitives.Call) (primitives.Weight, error) {
+	var (
+		postInfo    primitives.PostDispatchInfo
+		dispatchErr error
+	)
+	if m.isCallAllowed(definition, call) {
+		postInfo, dispatchErr = m.dispatch(primitives.NewRawOriginSigned(realAccount), call)
+	} else {
+		dispatchErr = system.NewDispatchErrorCallFiltered(m.systemModule.GetIndex())
+	}
+
+	var (
+		result primitives.DispatchOutcome
+		err    error
+	)
+	if dispatchErr != nil {
+		result, err = primitives.NewDispatchOutcome(toDispatchError(dispatchErr))
+	} else {
+		result, err = primitives.NewDispatchOutcome(sc.Empty{})
+	}
+	if err != nil {
+		return primitives.Weight{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	m.systemModule.DepositEvent(newEventProxyExecuted(m.index, result))
+
+	info := primitives.GetDispatchInfo(call)
+	return postInfo.CalcActualWeight(&info), nil
+}
+
+// isCallAllowed checks whether a proxy of the type in `definition` is allowed to dispatch `call`.
+// A proxy can neither add nor remove proxies with more permissions than its own,
+// and only ProxyTypeAny can remove all proxies or kill a pure proxy.
+func (m Module) isCallAllowed(definition ProxyDefinition, call primitives.Call) bool {
+	if call.ModuleIndex() == m.index {
+		switch call.FunctionIndex() {
+		case FunctionAddProxy, FunctionRemoveProxy:
+			if !m.instanceFilter.IsSuperset(definition.ProxyType, call.Args()[1].(sc.U8)) {
+				return false
+			}
+		case FunctionRemoveProxies, FunctionKillPure:
+			if definition.ProxyType != ProxyTypeAny {
+				return false
+			}
+		}
+	}
+
+	return m.instanceFilter.Filter(definition.ProxyType, call)
+}
+
+// dispatch dispatches the call in a new storage layer, so that the changes of a failed call are discarded.
+func (m Module) dispatch(origin primitives.RuntimeOrigin, call primitives.Call) (primitives.PostDispatchInfo, error) {
+	return m.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		postInfo, err := call.Dispatch(origin, call.Args())
+		if err != nil {
+			return primitives.PostDispatchInfo{}, toDispatchError(err)
+		}
+		return postInfo, nil
+	})
+}
+
+// removeAnnouncements removes the announcements of `delegate` matched by `filter` and unreserves the released deposit.
+// Returns whether any announcement was removed.
+func (m Module) removeAnnouncements(delegate primitives.AccountId, filter func(announcement Announcement) bool) (bool, error) {
+	announcements, err := m.storage.Announcements.Get(delegate)
+	if err != nil {
+		return false, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	remaining := sc.Sequence[Announcement]{}
+	for _, announcement := range announcements.Announcements {
+		if !filter(announcement) {
+			remaining = append(remaining, announcement)
+		}
+	}
+	if len(remaining) == len(announcements.Announcements) {
+		return false, nil
+	}
+
+	deposit := m.announcementDeposit(len(remaining))
+	if err := m.updateDeposit(delegate, announcements.Deposit, deposit); err != nil {
+		return false, err
+	}
+
+	if len(remaining) == 0 {
+		m.storage.Announcements.Remove(delegate)
+	} else {
+		m.storage.Announcements.Put(delegate, Announcements{Announcements: remaining, Deposit: deposit})
+	}
+
+	return true, nil
+}
+
+// updateDeposit reserves or unreserves the difference between the `oldDeposit` and `newDeposit` of `who`.
+func (m Module) updateDeposit(who primitives.AccountId, oldDeposit primitives.Balance, newDeposit primitives.Balance) error {
+	if newDeposit.Gt(oldDeposit) {
+		return m.currency.Reserve(who, newDeposit.Sub(oldDeposit))
+	}
+	if oldDeposit.Gt(newDeposit) {
+		_, err := m.currency.Unreserve(who, oldDeposit.Sub(newDeposit))
+		return err
+	}
+	return nil
+}
+
+// proxyDeposit returns the amount to be reserved for `count` proxies.
+func (m Module) proxyDeposit(count int) primitives.Balance {
+	if count == 0 {
+		return sc.NewU128(0)
+	}
+	return sc.SaturatingAddU128(m.proxyDepositBase, m.proxyDepositFactor.Mul(sc.NewU128(uint64(count))))
+}
+
+// announcementDeposit returns the amount to be reserved for `count` announcements.
+func (m Module) announcementDeposit(count int) primitives.Balance {
+	if count == 0 {
+		return sc.NewU128(0)
+	}
+	return sc.SaturatingAddU128(m.announcementDepositBase, m.announcementDepositFactor.Mul(sc.NewU128(uint64(count))))
+}
+
+// callHash returns the hash, under which the dispatch of `call` is announced.
+func (m Module) callHash(call primitives.Call) (primitives.H256, error) {
+	return primitives.NewH256(sc.BytesToSequenceU8(m.hashing.Blake256(call.Bytes()))...)
+}
+
+// decodeProxyType decodes a proxy type and checks that it is supported by the runtime.
+func (m Module) decodeProxyType(buffer *bytes.Buffer) (sc.U8, error) {
+	proxyType, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return 0, err
+	}
+	if int(proxyType) >= len(m.instanceFilter.Variants()) {
+		return 0, errInvalidProxyType
+	}
+	return proxyType, nil
+}
+
+// decodeOptionProxyType decodes an optional proxy type.
+func (m Module) decodeOptionProxyType(buffer *bytes.Buffer) (sc.Option[sc.U8], error) {
+	return sc.DecodeOptionWith(buffer, m.decodeProxyType)
+}
+
+// searchProxy returns the position of `definition` in the sorted `definitions`
+// and whether it is already there.
+func searchProxy(definitions sc.Sequence[ProxyDefinition], definition ProxyDefinition) (int, bool) {
+	for i, d := range definitions {
+		cmp := d.compare(definition)
+		if cmp == 0 {
+			return i, true
+		}
+		if cmp > 0 {
+			return i, false
+		}
+	}
+	return len(definitions), false
+}
+
+// lookup returns the account id of `address`.
+func lookup(address primitives.MultiAddress) (primitives.AccountId, error) {
+	who, err := primitives.Lookup(address)
+	if err != nil {
+		return primitives.AccountId{}, primitives.NewDispatchErrorCannotLookup()
+	}
+	return who, nil
+}
+
+func compareAccountIds(a, b primitives.AccountId) int {
+	return bytes.Compare(a.Bytes(), b.Bytes())
+}
+
+func toDispatchError(err error) primitives.DispatchError {
+	dispatchErr, ok := err.(primitives.DispatchError)
+	if !ok {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	return dispatchErr
+}
+
+func postDispatchInfo(weight primitives.Weight) primitives.PostDispatchInfo {
+	return primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](weight),
+		PaysFee:      primitives.PaysYes,
+	}
+}
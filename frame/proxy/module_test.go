@@ -0,0 +1,608 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId             = 11
+	transferModuleId     = 5
+	maxProxies           = 2
+	maxPending           = 2
+	proxyTypeNonTransfer = sc.U8(1)
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	proxyDepositBase          = sc.NewU128(10)
+	proxyDepositFactor        = sc.NewU128(2)
+	announcementDepositBase   = sc.NewU128(8)
+	announcementDepositFactor = sc.NewU128(3)
+
+	realAccount = constants.ZeroAccountId
+	who         = constants.OneAccountId
+	delegate    = constants.TwoAccountId
+
+	definitionAny         = ProxyDefinition{Delegate: who, ProxyType: ProxyTypeAny, Delay: 0}
+	definitionNonTransfer = ProxyDefinition{Delegate: who, ProxyType: proxyTypeNonTransfer, Delay: 0}
+	definitionDelayed     = ProxyDefinition{Delegate: who, ProxyType: ProxyTypeAny, Delay: 10}
+
+	pureAccountHash = []byte{
+		7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
+		7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
+	}
+	pureAccount, _ = primitives.NewAccountId(sc.BytesToSequenceU8(pureAccountHash)...)
+	callBytes      = []byte{1, 2, 3}
+	callHashBytes  = []byte{
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	}
+	callHash, _ = primitives.NewH256(sc.BytesToSequenceU8(callHashBytes)...)
+
+	callArgs   = sc.NewVaryingData(sc.U8(1))
+	callWeight = primitives.WeightFromParts(100, 0)
+	postInfo   = primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](primitives.WeightFromParts(40, 0))}
+
+	mdGenerator                           = primitives.NewMetadataTypeGenerator()
+	unknownTransactionNoUnsignedValidator = primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+	dispatchErrOther                      = primitives.NewDispatchErrorOther("error")
+)
+
+var (
+	mockStorage              *mocks.IoStorage
+	mockTransactionBroker    *mocks.IoTransactionBroker
+	mockCurrency             *mocks.ReservableCurrency
+	mockSystemModule         *mocks.SystemModule
+	mockHashing              *mocks.IoHashing
+	mockTransactional        *mocks.IoTransactional[primitives.PostDispatchInfo]
+	mockStorageProxies       *mocks.StorageMap[primitives.AccountId, Proxies]
+	mockStorageAnnouncements *mocks.StorageMap[primitives.AccountId, Announcements]
+	mockCall                 *mocks.Call
+	testProxyTypes           = sc.Sequence[sc.Str]{"Any", "NonTransfer"}
+)
+
+// testInstanceFilter supports ProxyTypeAny and a proxy type, which is not allowed to dispatch calls of the transfer module.
+type testInstanceFilter struct{}
+
+func (testInstanceFilter) Variants() sc.Sequence[sc.Str] {
+	return testProxyTypes
+}
+
+func (testInstanceFilter) Filter(proxyType sc.U8, call primitives.Call) bool {
+	return proxyType == ProxyTypeAny || call.ModuleIndex() != transferModuleId
+}
+
+func (testInstanceFilter) IsSuperset(proxyType sc.U8, other sc.U8) bool {
+	return proxyType == ProxyTypeAny || proxyType == other
+}
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, 10, len(target.Functions()))
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.NewTransactionSourceLocal(), mockCall)
+
+	assert.Equal(t, unknownTransactionNoUnsignedValidator, err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_Proxies(t *testing.T) {
+	target := setupModule()
+	proxies := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny}, Deposit: sc.NewU128(12)}
+	mockStorageProxies.On("Get", realAccount).Return(proxies, nil)
+
+	result, err := target.Proxies(realAccount)
+
+	assert.NoError(t, err)
+	assert.Equal(t, proxies, result)
+}
+
+func Test_Module_Announcements(t *testing.T) {
+	target := setupModule()
+	announcements := Announcements{
+		Announcements: sc.Sequence[Announcement]{{Real: realAccount, CallHash: callHash, Height: 5}},
+		Deposit:       sc.NewU128(11),
+	}
+	mockStorageAnnouncements.On("Get", who).Return(announcements, nil)
+
+	result, err := target.Announcements(who)
+
+	assert.NoError(t, err)
+	assert.Equal(t, announcements, result)
+}
+
+func Test_Module_PureAccount(t *testing.T) {
+	target := setupModule()
+	expectPureAccount(ProxyTypeAny, 0, 5, 1)
+
+	result, err := target.PureAccount(who, ProxyTypeAny, 0, 5, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, pureAccount, result)
+}
+
+func Test_Module_addProxyDelegate(t *testing.T) {
+	target := setupModule()
+	mockStorageProxies.On("Get", realAccount).Return(defaultProxies, nil)
+	mockCurrency.On("Reserve", realAccount, sc.NewU128(12)).Return(nil)
+	mockStorageProxies.On("Put", realAccount, mock.Anything).Return()
+
+	err := target.addProxyDelegate(realAccount, who, ProxyTypeAny, 0)
+
+	assert.NoError(t, err)
+	mockCurrency.AssertCalled(t, "Reserve", realAccount, sc.NewU128(12))
+	mockStorageProxies.AssertCalled(t, "Put", realAccount, Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny}, Deposit: sc.NewU128(12)})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventProxyAdded(moduleId, realAccount, who, ProxyTypeAny, 0))
+}
+
+func Test_Module_addProxyDelegate_Sorted(t *testing.T) {
+	target := setupModule()
+	existing := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionNonTransfer}, Deposit: sc.NewU128(12)}
+	mockStorageProxies.On("Get", realAccount).Return(existing, nil)
+	mockCurrency.On("Reserve", realAccount, sc.NewU128(2)).Return(nil)
+	mockStorageProxies.On("Put", realAccount, mock.Anything).Return()
+
+	err := target.addProxyDelegate(realAccount, who, ProxyTypeAny, 0)
+
+	assert.NoError(t, err)
+	expect := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny, definitionNonTransfer}, Deposit: sc.NewU128(14)}
+	mockStorageProxies.AssertCalled(t, "Put", realAccount, expect)
+}
+
+func Test_Module_addProxyDelegate_NoSelfProxy(t *testing.T) {
+	target := setupModule()
+
+	err := target.addProxyDelegate(who, who, ProxyTypeAny, 0)
+
+	assert.Equal(t, NewDispatchErrorNoSelfProxy(moduleId), err)
+	mockStorageProxies.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_addProxyDelegate_Duplicate(t *testing.T) {
+	target := setupModule()
+	existing := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny}, Deposit: sc.NewU128(12)}
+	mockStorageProxies.On("Get", realAccount).Return(existing, nil)
+
+	err := target.addProxyDelegate(realAccount, who, ProxyTypeAny, 0)
+
+	assert.Equal(t, NewDispatchErrorDuplicate(moduleId), err)
+	mockStorageProxies.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_addProxyDelegate_TooMany(t *testing.T) {
+	target := setupModule()
+	existing := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny, definitionNonTransfer}, Deposit: sc.NewU128(14)}
+	mockStorageProxies.On("Get", realAccount).Return(existing, nil)
+
+	err := target.addProxyDelegate(realAccount, delegate, ProxyTypeAny, 0)
+
+	assert.Equal(t, NewDispatchErrorTooMany(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func Test_Module_removeProxyDelegate(t *testing.T) {
+	target := setupModule()
+	existing := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny, definitionNonTransfer}, Deposit: sc.NewU128(14)}
+	mockStorageProxies.On("Get", realAccount).Return(existing, nil)
+	mockCurrency.On("Unreserve", realAccount, sc.NewU128(2)).Return(sc.NewU128(0), nil)
+	mockStorageProxies.On("Put", realAccount, mock.Anything).Return()
+
+	err := target.removeProxyDelegate(realAccount, who, ProxyTypeAny, 0)
+
+	assert.NoError(t, err)
+	mockCurrency.AssertCalled(t, "Unreserve", realAccount, sc.NewU128(2))
+	expect := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionNonTransfer}, Deposit: sc.NewU128(12)}
+	mockStorageProxies.AssertCalled(t, "Put", realAccount, expect)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventProxyRemoved(moduleId, realAccount, who, ProxyTypeAny, 0))
+}
+
+func Test_Module_removeProxyDelegate_Last(t *testing.T) {
+	target := setupModule()
+	existing := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny}, Deposit: sc.NewU128(12)}
+	mockStorageProxies.On("Get", realAccount).Return(existing, nil)
+	mockCurrency.On("Unreserve", realAccount, sc.NewU128(12)).Return(sc.NewU128(0), nil)
+	mockStorageProxies.On("Remove", realAccount).Return()
+
+	err := target.removeProxyDelegate(realAccount, who, ProxyTypeAny, 0)
+
+	assert.NoError(t, err)
+	mockStorageProxies.AssertCalled(t, "Remove", realAccount)
+	mockStorageProxies.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_removeProxyDelegate_NotFound(t *testing.T) {
+	target := setupModule()
+	existing := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionNonTransfer}, Deposit: sc.NewU128(12)}
+	mockStorageProxies.On("Get", realAccount).Return(existing, nil)
+
+	err := target.removeProxyDelegate(realAccount, who, ProxyTypeAny, 0)
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+}
+
+func Test_Module_removeAllProxyDelegates(t *testing.T) {
+	target := setupModule()
+	existing := Proxies{Definitions: sc.Sequence[ProxyDefinition]{definitionAny, definitionNonTransfer}, Deposit: sc.NewU128(14)}
+	mockStorageProxies.On("Get", realAccount).Return(existing, nil)
+	mockCurrency.On("Unreserve", realAccount, sc.NewU128(14)).Return(sc.NewU128(0), nil)
+	mockStorageProxies.On("Remove", realAccount).Return()
+
+	err := target.removeAllProxyDelegates(realAccount)
+
+	assert.NoError(t, err)
+	mockCurrency.AssertCalled(t, "Unreserve", realAccount, sc.NewU128(14))
+	mockStorageProxies.AssertCalled(t, "Remove", realAccount)
+}
+
+func Test_Module_findProxy(t *testing.T) {
+	target := setupModule()
+	expectProxies(definitionAny, definitionNonTransfer)
+
+	result, err := target.findProxy(realAccount, who, sc.NewOption[sc.U8](proxyTypeNonTransfer))
+
+	assert.NoError(t, err)
+	assert.Equal(t, definitionNonTransfer, result)
+}
+
+func Test_Module_findProxy_NotProxy(t *testing.T) {
+	target := setupModule()
+	expectProxies(definitionAny)
+
+	_, err := target.findProxy(realAccount, who, sc.NewOption[sc.U8](proxyTypeNonTransfer))
+
+	assert.Equal(t, NewDispatchErrorNotProxy(moduleId), err)
+
+	_, err = target.findProxy(realAccount, delegate, sc.NewOption[sc.U8](nil))
+
+	assert.Equal(t, NewDispatchErrorNotProxy(moduleId), err)
+}
+
+func Test_Module_isCallAllowed(t *testing.T) {
+	target := setupModule()
+
+	for _, testCase := range []struct {
+		definition ProxyDefinition
+		call       primitives.Call
+		expect     bool
+	}{
+		{definitionAny, newTestCall(transferModuleId, 0), true},
+		{definitionNonTransfer, newTestCall(transferModuleId, 0), false},
+		{definitionNonTransfer, newTestCall(transferModuleId+1, 0), true},
+		{definitionNonTransfer, newTestCall(moduleId, FunctionAddProxy, primitives.MultiAddress{}, ProxyTypeAny, sc.U64(0)), false},
+		{definitionNonTransfer, newTestCall(moduleId, FunctionRemoveProxy, primitives.MultiAddress{}, proxyTypeNonTransfer, sc.U64(0)), true},
+		{definitionNonTransfer, newTestCall(moduleId, FunctionRemoveProxies), false},
+		{definitionNonTransfer, newTestCall(moduleId, FunctionKillPure), false},
+		{definitionAny, newTestCall(moduleId, FunctionKillPure), true},
+	} {
+		assert.Equal(t, testCase.expect, target.isCallAllowed(testCase.definition, testCase.call))
+	}
+}
+
+func Test_Module_doProxy(t *testing.T) {
+	target := setupModule()
+	expectCallInfo()
+	expectDispatch(postInfo, nil)
+
+	result, err := target.doProxy(definitionAny, realAccount, mockCall)
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.WeightFromParts(40, 0), result)
+	outcome, _ := primitives.NewDispatchOutcome(sc.Empty{})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventProxyExecuted(moduleId, outcome))
+}
+
+func Test_Module_doProxy_CallFails(t *testing.T) {
+	target := setupModule()
+	expectCallInfo()
+	expectDispatch(primitives.PostDispatchInfo{}, dispatchErrOther)
+
+	result, err := target.doProxy(definitionAny, realAccount, mockCall)
+
+	assert.NoError(t, err)
+	assert.Equal(t, callWeight, result)
+	outcome, _ := primitives.NewDispatchOutcome(dispatchErrOther)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventProxyExecuted(moduleId, outcome))
+}
+
+func Test_Module_doProxy_CallFiltered(t *testing.T) {
+	target := setupModule()
+	expectCallInfo()
+	mockCall.On("ModuleIndex").Return(sc.U8(transferModuleId))
+	mockSystemModule.On("GetIndex").Return(sc.U8(0))
+
+	result, err := target.doProxy(definitionNonTransfer, realAccount, mockCall)
+
+	assert.NoError(t, err)
+	assert.Equal(t, callWeight, result)
+	outcome, _ := primitives.NewDispatchOutcome(system.NewDispatchErrorCallFiltered(0))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventProxyExecuted(moduleId, outcome))
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func Test_Module_removeAnnouncements(t *testing.T) {
+	target := setupModule()
+	first := Announcement{Real: realAccount, CallHash: callHash, Height: 5}
+	second := Announcement{Real: delegate, CallHash: callHash, Height: 6}
+	mockStorageAnnouncements.On("Get", who).Return(Announcements{Announcements: sc.Sequence[Announcement]{first, second}, Deposit: sc.NewU128(14)}, nil)
+	mockCurrency.On("Unreserve", who, sc.NewU128(3)).Return(sc.NewU128(0), nil)
+	mockStorageAnnouncements.On("Put", who, mock.Anything).Return()
+
+	removed, err := target.removeAnnouncements(who, func(announcement Announcement) bool {
+		return compareAccountIds(announcement.Real, realAccount) == 0
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, removed)
+	mockStorageAnnouncements.AssertCalled(t, "Put", who, Announcements{Announcements: sc.Sequence[Announcement]{second}, Deposit: sc.NewU128(11)})
+}
+
+func Test_Module_removeAnnouncements_Last(t *testing.T) {
+	target := setupModule()
+	first := Announcement{Real: realAccount, CallHash: callHash, Height: 5}
+	mockStorageAnnouncements.On("Get", who).Return(Announcements{Announcements: sc.Sequence[Announcement]{first}, Deposit: sc.NewU128(11)}, nil)
+	mockCurrency.On("Unreserve", who, sc.NewU128(11)).Return(sc.NewU128(0), nil)
+	mockStorageAnnouncements.On("Remove", who).Return()
+
+	removed, err := target.removeAnnouncements(who, func(_ Announcement) bool { return true })
+
+	assert.NoError(t, err)
+	assert.True(t, removed)
+	mockStorageAnnouncements.AssertCalled(t, "Remove", who)
+}
+
+func Test_Module_removeAnnouncements_NoneMatched(t *testing.T) {
+	target := setupModule()
+	first := Announcement{Real: realAccount, CallHash: callHash, Height: 5}
+	mockStorageAnnouncements.On("Get", who).Return(Announcements{Announcements: sc.Sequence[Announcement]{first}, Deposit: sc.NewU128(11)}, nil)
+
+	removed, err := target.removeAnnouncements(who, func(_ Announcement) bool { return false })
+
+	assert.NoError(t, err)
+	assert.False(t, removed)
+	mockCurrency.AssertNotCalled(t, "Unreserve", mock.Anything, mock.Anything)
+}
+
+func Test_Module_updateDeposit(t *testing.T) {
+	target := setupModule()
+	mockCurrency.On("Reserve", who, sc.NewU128(2)).Return(nil)
+	mockCurrency.On("Unreserve", who, sc.NewU128(3)).Return(sc.NewU128(0), nil)
+
+	assert.NoError(t, target.updateDeposit(who, sc.NewU128(10), sc.NewU128(12)))
+	assert.NoError(t, target.updateDeposit(who, sc.NewU128(12), sc.NewU128(9)))
+	assert.NoError(t, target.updateDeposit(who, sc.NewU128(9), sc.NewU128(9)))
+
+	mockCurrency.AssertNumberOfCalls(t, "Reserve", 1)
+	mockCurrency.AssertNumberOfCalls(t, "Unreserve", 1)
+}
+
+func Test_Module_updateDeposit_Fails(t *testing.T) {
+	target := setupModule()
+	mockCurrency.On("Reserve", who, sc.NewU128(2)).Return(dispatchErrOther)
+
+	err := target.updateDeposit(who, sc.NewU128(10), sc.NewU128(12))
+
+	assert.Equal(t, dispatchErrOther, err)
+}
+
+func Test_Module_proxyDeposit(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.NewU128(0), target.proxyDeposit(0))
+	assert.Equal(t, sc.NewU128(12), target.proxyDeposit(1))
+	assert.Equal(t, sc.NewU128(14), target.proxyDeposit(2))
+}
+
+func Test_Module_announcementDeposit(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.NewU128(0), target.announcementDeposit(0))
+	assert.Equal(t, sc.NewU128(11), target.announcementDeposit(1))
+	assert.Equal(t, sc.NewU128(14), target.announcementDeposit(2))
+}
+
+func Test_Module_callHash(t *testing.T) {
+	target := setupModule()
+	expectCallHash()
+
+	result, err := target.callHash(mockCall)
+
+	assert.NoError(t, err)
+	assert.Equal(t, callHash, result)
+}
+
+func Test_Module_decodeProxyType(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.decodeProxyType(bytes.NewBuffer([]byte{1}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, proxyTypeNonTransfer, result)
+
+	_, err = target.decodeProxyType(bytes.NewBuffer([]byte{2}))
+
+	assert.Equal(t, errInvalidProxyType, err)
+}
+
+func Test_Module_decodeOptionProxyType(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.decodeOptionProxyType(bytes.NewBuffer([]byte{1, 1}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[sc.U8](proxyTypeNonTransfer), result)
+
+	result, err = target.decodeOptionProxyType(bytes.NewBuffer([]byte{0}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[sc.U8](nil), result)
+}
+
+func Test_searchProxy(t *testing.T) {
+	definitions := sc.Sequence[ProxyDefinition]{definitionAny, definitionDelayed}
+
+	position, found := searchProxy(definitions, definitionNonTransfer)
+	assert.Equal(t, 2, position)
+	assert.False(t, found)
+
+	position, found = searchProxy(definitions, definitionDelayed)
+	assert.Equal(t, 1, position)
+	assert.True(t, found)
+
+	position, found = searchProxy(definitions, ProxyDefinition{Delegate: realAccount})
+	assert.Equal(t, 0, position)
+	assert.False(t, found)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	result := target.Metadata()
+
+	assert.Equal(t, primitives.ModuleVersion14, result.Version)
+	assert.Equal(t, sc.Str("Proxy"), result.ModuleV14.Name)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesProxyCalls)), result.ModuleV14.Call)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesProxyEvent)), result.ModuleV14.Event)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesProxyErrors)), result.ModuleV14.Error)
+	assert.Equal(t, sc.Str("Proxy"), result.ModuleV14.Storage.Value.Prefix)
+	assert.Equal(t, 2, len(result.ModuleV14.Storage.Value.Items))
+	assert.Equal(t, 6, len(result.ModuleV14.Constants))
+	assert.Equal(t, sc.U8(moduleId), result.ModuleV14.Index)
+}
+
+func Test_Module_dispatch(t *testing.T) {
+	target := setupModule()
+	origin := primitives.NewRawOriginSigned(realAccount)
+	mockCall.On("Args").Return(callArgs)
+	mockCall.On("Dispatch", origin, callArgs).Return(primitives.PostDispatchInfo{}, assert.AnError)
+
+	var fnErr error
+	mockTransactional.On("WithStorageLayer", mock.Anything).Run(func(args mock.Arguments) {
+		fn := args.Get(0).(func() (primitives.PostDispatchInfo, error))
+		_, fnErr = fn()
+	}).Return(primitives.PostDispatchInfo{}, dispatchErrOther)
+
+	_, err := target.dispatch(origin, mockCall)
+
+	assert.Equal(t, dispatchErrOther, err)
+	assert.Equal(t, primitives.NewDispatchErrorOther(sc.Str(assert.AnError.Error())), fnErr)
+	mockCall.AssertCalled(t, "Dispatch", origin, callArgs)
+}
+
+func setupModule() Module {
+	mockStorage = new(mocks.IoStorage)
+	mockTransactionBroker = new(mocks.IoTransactionBroker)
+	mockCurrency = new(mocks.ReservableCurrency)
+	mockSystemModule = new(mocks.SystemModule)
+	mockHashing = new(mocks.IoHashing)
+	mockTransactional = new(mocks.IoTransactional[primitives.PostDispatchInfo])
+	mockStorageProxies = new(mocks.StorageMap[primitives.AccountId, Proxies])
+	mockStorageAnnouncements = new(mocks.StorageMap[primitives.AccountId, Announcements])
+	mockCall = new(mocks.Call)
+
+	config := NewConfig(
+		mockStorage,
+		mockTransactionBroker,
+		dbWeight,
+		mockCurrency,
+		mockSystemModule,
+		testInstanceFilter{},
+		proxyDepositBase,
+		proxyDepositFactor,
+		maxProxies,
+		maxPending,
+		announcementDepositBase,
+		announcementDepositFactor,
+	)
+
+	target := New(moduleId, config, mdGenerator, log.NewLogger())
+	target.storage.Proxies = mockStorageProxies
+	target.storage.Announcements = mockStorageAnnouncements
+	target.transactional = mockTransactional
+	target.hashing = mockHashing
+
+	mockSystemModule.On("DepositEvent", mock.Anything)
+
+	return target
+}
+
+// newTestCall returns a call of the module with `moduleIndex` and the function with `functionIndex`.
+func newTestCall(moduleIndex sc.U8, functionIndex sc.U8, args ...sc.Encodable) primitives.Call {
+	call := new(mocks.Call)
+	call.On("ModuleIndex").Return(moduleIndex)
+	call.On("FunctionIndex").Return(functionIndex)
+	call.On("Args").Return(sc.NewVaryingData(args...))
+
+	return call
+}
+
+// expectProxies expects the proxies of the real account to be read.
+func expectProxies(definitions ...ProxyDefinition) {
+	proxies := Proxies{Definitions: definitions, Deposit: sc.NewU128(0)}
+	mockStorageProxies.On("Get", realAccount).Return(proxies, nil)
+}
+
+// expectPureAccount expects the pure proxy account of the sender to be derived.
+func expectPureAccount(proxyType sc.U8, index sc.U16, height sc.U64, extrinsicIndex sc.U32) {
+	entropy := append([]byte("modlpy/proxy____"), who.Bytes()...)
+	entropy = append(entropy, height.Bytes()...)
+	entropy = append(entropy, extrinsicIndex.Bytes()...)
+	entropy = append(entropy, proxyType.Bytes()...)
+	entropy = append(entropy, index.Bytes()...)
+	mockHashing.On("Blake256", entropy).Return(pureAccountHash)
+}
+
+// expectCallHash expects the hash of the encoded call to be calculated.
+func expectCallHash() {
+	mockCall.On("Bytes").Return(callBytes)
+	mockHashing.On("Blake256", callBytes).Return(callHashBytes)
+}
+
+// expectDispatch expects the call to be dispatched on behalf of the real account in a new storage layer.
+func expectDispatch(result primitives.PostDispatchInfo, err error) {
+	origin := primitives.NewRawOriginSigned(realAccount)
+	mockCall.On("ModuleIndex").Return(sc.U8(transferModuleId + 1))
+	mockCall.On("Args").Return(callArgs)
+	mockCall.On("Dispatch", origin, callArgs).Return(result, err)
+	mockTransactional.On("WithStorageLayer", mock.Anything).Return(result, err)
+}
+
+func expectCallInfo() {
+	mockCall.On("BaseWeight").Return(callWeight)
+	mockCall.On("WeighData", callWeight).Return(callWeight)
+	mockCall.On("ClassifyDispatch", callWeight).Return(primitives.NewDispatchClassNormal())
+	mockCall.On("PaysFee", callWeight).Return(primitives.PaysYes)
+}
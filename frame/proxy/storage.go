@@ -0,0 +1,33 @@
+package proxy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	keyProxy         = []byte("Proxy")
+	keyProxies       = []byte("Proxies")
+	keyAnnouncements = []byte("Announcements")
+)
+
+var (
+	defaultProxies       = Proxies{Definitions: sc.Sequence[ProxyDefinition]{}, Deposit: sc.NewU128(0)}
+	defaultAnnouncements = Announcements{Announcements: sc.Sequence[Announcement]{}, Deposit: sc.NewU128(0)}
+)
+
+type storage struct {
+	Proxies       support.StorageMap[primitives.AccountId, Proxies]
+	Announcements support.StorageMap[primitives.AccountId, Announcements]
+}
+
+func newStorage(s io.Storage) *storage {
+	hashing := io.NewHashing()
+
+	return &storage{
+		Proxies:       support.NewHashStorageMapWithDefault[primitives.AccountId, Proxies](s, keyProxy, keyProxies, hashing.Twox64, DecodeProxies, &defaultProxies),
+		Announcements: support.NewHashStorageMapWithDefault[primitives.AccountId, Announcements](s, keyProxy, keyAnnouncements, hashing.Twox64, DecodeAnnouncements, &defaultAnnouncements),
+	}
+}
@@ -0,0 +1,163 @@
+package proxy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ProxyDefinition is a delegation of the permissions of an account to a proxy.
+type ProxyDefinition struct {
+	// Delegate is the account which may act on behalf of the delegator.
+	Delegate primitives.AccountId
+	// ProxyType is the type of the proxy, which restricts the calls the delegate may dispatch.
+	ProxyType sc.U8
+	// Delay is the number of blocks, which must pass between the announcement of a call and its dispatch.
+	Delay sc.U64
+}
+
+func (pd ProxyDefinition) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, pd.Delegate, pd.ProxyType, pd.Delay)
+}
+
+func DecodeProxyDefinition(buffer *bytes.Buffer) (ProxyDefinition, error) {
+	delegate, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return ProxyDefinition{}, err
+	}
+	proxyType, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return ProxyDefinition{}, err
+	}
+	delay, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return ProxyDefinition{}, err
+	}
+
+	return ProxyDefinition{
+		Delegate:  delegate,
+		ProxyType: proxyType,
+		Delay:     delay,
+	}, nil
+}
+
+func (pd ProxyDefinition) Bytes() []byte {
+	return sc.EncodedBytes(pd)
+}
+
+// compare orders proxy definitions by delegate, proxy type and delay.
+func (pd ProxyDefinition) compare(other ProxyDefinition) int {
+	if cmp := bytes.Compare(pd.Delegate.Bytes(), other.Delegate.Bytes()); cmp != 0 {
+		return cmp
+	}
+	if pd.ProxyType != other.ProxyType {
+		if pd.ProxyType < other.ProxyType {
+			return -1
+		}
+		return 1
+	}
+	if pd.Delay != other.Delay {
+		if pd.Delay < other.Delay {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// Proxies are the sorted proxy definitions of an account and the amount reserved for them.
+type Proxies struct {
+	Definitions sc.Sequence[ProxyDefinition]
+	Deposit     primitives.Balance
+}
+
+func (p Proxies) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, p.Definitions, p.Deposit)
+}
+
+func DecodeProxies(buffer *bytes.Buffer) (Proxies, error) {
+	definitions, err := sc.DecodeSequenceWith(buffer, DecodeProxyDefinition)
+	if err != nil {
+		return Proxies{}, err
+	}
+	deposit, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return Proxies{}, err
+	}
+
+	return Proxies{
+		Definitions: definitions,
+		Deposit:     deposit,
+	}, nil
+}
+
+func (p Proxies) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+// Announcement is a call, announced by a proxy to be dispatched on behalf of `Real` once the proxy delay passes.
+type Announcement struct {
+	Real     primitives.AccountId
+	CallHash primitives.H256
+	Height   sc.U64
+}
+
+func (a Announcement) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, a.Real, a.CallHash, a.Height)
+}
+
+func DecodeAnnouncement(buffer *bytes.Buffer) (Announcement, error) {
+	realAccount, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return Announcement{}, err
+	}
+	callHash, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return Announcement{}, err
+	}
+	height, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return Announcement{}, err
+	}
+
+	return Announcement{
+		Real:     realAccount,
+		CallHash: callHash,
+		Height:   height,
+	}, nil
+}
+
+func (a Announcement) Bytes() []byte {
+	return sc.EncodedBytes(a)
+}
+
+// Announcements are the pending announcements of a proxy and the amount reserved for them.
+type Announcements struct {
+	Announcements sc.Sequence[Announcement]
+	Deposit       primitives.Balance
+}
+
+func (a Announcements) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, a.Announcements, a.Deposit)
+}
+
+func DecodeAnnouncements(buffer *bytes.Buffer) (Announcements, error) {
+	announcements, err := sc.DecodeSequenceWith(buffer, DecodeAnnouncement)
+	if err != nil {
+		return Announcements{}, err
+	}
+	deposit, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return Announcements{}, err
+	}
+
+	return Announcements{
+		Announcements: announcements,
+		Deposit:       deposit,
+	}, nil
+}
+
+func (a Announcements) Bytes() []byte {
+	return sc.EncodedBytes(a)
+}
@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callCancel cancels an anonymously scheduled task.
+type callCancel struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallCancel(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callCancel{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U64(0), sc.U32(0)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callCancel) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	when, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(when, index)
+
+	return c, nil
+}
+
+func (c callCancel) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callCancel) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callCancel) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callCancel) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callCancel) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callCancel) BaseWeight() primitives.Weight {
+	return callCancelWeight(c.dbWeight, sc.U64(c.module.maxScheduledPerBlock))
+}
+
+func (_ callCancel) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callCancel) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callCancel) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callCancel) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := c.module.scheduleOrigin(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	when := args[0].(sc.U64)
+	index := args[1].(sc.U32)
+
+	err = c.module.doCancel(primitives.NewOriginCallerSystem(origin), when, index)
+
+	return primitives.PostDispatchInfo{}, err
+}
+
+func (_ callCancel) Docs() string {
+	return "Cancel an anonymously scheduled task. " +
+		"The dispatch origin for this call must be the `ScheduleOrigin` and either `Root` or the origin, which scheduled the task."
+}
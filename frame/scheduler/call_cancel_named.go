@@ -0,0 +1,85 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callCancelNamed cancels a named scheduled task.
+type callCancelNamed struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallCancelNamed(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callCancelNamed{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(TaskName{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callCancelNamed) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	id, err := DecodeTaskName(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(id)
+
+	return c, nil
+}
+
+func (c callCancelNamed) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callCancelNamed) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callCancelNamed) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callCancelNamed) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callCancelNamed) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callCancelNamed) BaseWeight() primitives.Weight {
+	return callCancelNamedWeight(c.dbWeight, sc.U64(c.module.maxScheduledPerBlock))
+}
+
+func (_ callCancelNamed) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callCancelNamed) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callCancelNamed) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callCancelNamed) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := c.module.scheduleOrigin(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	id := args[0].(TaskName)
+
+	err = c.module.doCancelNamed(primitives.NewOriginCallerSystem(origin), id)
+
+	return primitives.PostDispatchInfo{}, err
+}
+
+func (_ callCancelNamed) Docs() string {
+	return "Cancel a named scheduled task. " +
+		"The dispatch origin for this call must be the `ScheduleOrigin` and either `Root` or the origin, which scheduled the task."
+}
@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Call_CancelNamed_DecodeArgs(t *testing.T) {
+	target := setupCallCancelNamed()
+
+	result, err := target.DecodeArgs(bytes.NewBuffer(taskName.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(taskName), result.Args())
+}
+
+func Test_Call_CancelNamed_BaseWeight(t *testing.T) {
+	target := setupCallCancelNamed()
+
+	assert.Equal(t, callCancelNamedWeight(dbWeight, maxScheduledPerBlock), target.BaseWeight())
+}
+
+func Test_Call_CancelNamed_Dispatch(t *testing.T) {
+	target := setupCallCancelNamed()
+	task := newTestTask(0)
+	task.MaybeId = sc.NewOption[TaskName](taskName)
+	mockStorageLookup.On("Exists", taskName).Return(true)
+	mockStorageLookup.On("Get", taskName).Return(TaskAddress{When: 15, Index: 0}, nil)
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{sc.NewOption[Scheduled](task)}, nil)
+	mockStorageAgenda.On("Remove", sc.U64(15)).Return()
+	mockStorageLookup.On("Remove", taskName).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginRoot(), sc.NewVaryingData(taskName))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageLookup.AssertCalled(t, "Remove", taskName)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventCanceled(moduleId, 15, 0))
+}
+
+func Test_Call_CancelNamed_Dispatch_NotFound(t *testing.T) {
+	target := setupCallCancelNamed()
+	mockStorageLookup.On("Exists", taskName).Return(false)
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData(taskName))
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+}
+
+func Test_Call_CancelNamed_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallCancelNamed()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(taskName))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallCancelNamed() callCancelNamed {
+	return newCallCancelNamed(moduleId, functionCancelNamed, dbWeight, setupModule()).(callCancelNamed)
+}
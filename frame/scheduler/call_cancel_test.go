@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Call_Cancel_DecodeArgs(t *testing.T) {
+	target := setupCallCancel()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(sc.U64(15).Bytes())
+	buffer.Write(sc.U32(2).Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(sc.U64(15), sc.U32(2)), result.Args())
+}
+
+func Test_Call_Cancel_BaseWeight(t *testing.T) {
+	target := setupCallCancel()
+
+	assert.Equal(t, callCancelWeight(dbWeight, maxScheduledPerBlock), target.BaseWeight())
+}
+
+func Test_Call_Cancel_Dispatch(t *testing.T) {
+	target := setupCallCancel()
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{sc.NewOption[Scheduled](newTestTask(0))}, nil)
+	mockStorageAgenda.On("Remove", sc.U64(15)).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(sc.U64(15), sc.U32(0)))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAgenda.AssertCalled(t, "Remove", sc.U64(15))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventCanceled(moduleId, 15, 0))
+}
+
+func Test_Call_Cancel_Dispatch_NotFound(t *testing.T) {
+	target := setupCallCancel()
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{}, nil)
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData(sc.U64(15), sc.U32(0)))
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+}
+
+func Test_Call_Cancel_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallCancel()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(sc.U64(15), sc.U32(0)))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallCancel() callCancel {
+	return newCallCancel(moduleId, functionCancel, dbWeight, setupModule()).(callCancel)
+}
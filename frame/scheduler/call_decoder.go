@@ -0,0 +1,13 @@
+package scheduler
+
+import (
+	"bytes"
+
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// CallDecoder decodes the scheduled calls, which are stored in their encoded form, before they are dispatched.
+// It is usually implemented by the runtime decoder.
+type CallDecoder interface {
+	DecodeCall(buffer *bytes.Buffer) (primitives.Call, error)
+}
@@ -0,0 +1,107 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callSchedule schedules a call to be dispatched at a given block.
+type callSchedule struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallSchedule(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callSchedule{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U64(0), sc.NewOption[Period](nil), sc.U8(0)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callSchedule) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	when, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maybePeriodic, err := sc.DecodeOptionWith(buffer, DecodePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priority, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decodeCallFunc(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(when, maybePeriodic, priority, call)
+
+	return c, nil
+}
+
+func (c callSchedule) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	c.module.logger.Critical("not implemented")
+	return nil, nil
+}
+
+func (c callSchedule) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callSchedule) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callSchedule) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callSchedule) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callSchedule) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callSchedule) BaseWeight() primitives.Weight {
+	return callScheduleWeight(c.dbWeight, sc.U64(c.module.maxScheduledPerBlock))
+}
+
+func (_ callSchedule) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callSchedule) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callSchedule) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callSchedule) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := c.module.scheduleOrigin(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	when := args[0].(sc.U64)
+	maybePeriodic := args[1].(sc.Option[Period])
+	priority := args[2].(sc.U8)
+	call := args[3].(primitives.Call)
+
+	_, err = c.module.doSchedule(when, maybePeriodic, priority, primitives.NewOriginCallerSystem(origin), call)
+
+	return primitives.PostDispatchInfo{}, err
+}
+
+func (_ callSchedule) Docs() string {
+	return "Anonymously schedule a task. " +
+		"The dispatch origin for this call must be the `ScheduleOrigin`. " +
+		"The task dispatches `call` at block `when` on behalf of the origin and is repeated according to `maybe_periodic`. " +
+		"Tasks with a lower `priority` are dispatched first."
+}
@@ -0,0 +1,111 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callScheduleAfter schedules a call to be dispatched after a given number of blocks.
+type callScheduleAfter struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallScheduleAfter(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callScheduleAfter{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U64(0), sc.NewOption[Period](nil), sc.U8(0)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callScheduleAfter) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	after, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maybePeriodic, err := sc.DecodeOptionWith(buffer, DecodePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priority, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decodeCallFunc(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(after, maybePeriodic, priority, call)
+
+	return c, nil
+}
+
+func (c callScheduleAfter) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	c.module.logger.Critical("not implemented")
+	return nil, nil
+}
+
+func (c callScheduleAfter) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callScheduleAfter) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callScheduleAfter) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callScheduleAfter) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callScheduleAfter) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callScheduleAfter) BaseWeight() primitives.Weight {
+	return callScheduleWeight(c.dbWeight, sc.U64(c.module.maxScheduledPerBlock))
+}
+
+func (_ callScheduleAfter) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callScheduleAfter) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callScheduleAfter) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callScheduleAfter) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := c.module.scheduleOrigin(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	after := args[0].(sc.U64)
+	maybePeriodic := args[1].(sc.Option[Period])
+	priority := args[2].(sc.U8)
+	call := args[3].(primitives.Call)
+
+	when, err := c.module.blockNumberAfter(after)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	_, err = c.module.doSchedule(when, maybePeriodic, priority, primitives.NewOriginCallerSystem(origin), call)
+
+	return primitives.PostDispatchInfo{}, err
+}
+
+func (_ callScheduleAfter) Docs() string {
+	return "Anonymously schedule a task after a delay. " +
+		"The dispatch origin for this call must be the `ScheduleOrigin`. " +
+		"The task is first dispatched `after` blocks after the current one."
+}
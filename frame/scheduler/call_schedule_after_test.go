@@ -0,0 +1,60 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_ScheduleAfter_DecodeNestedArgs(t *testing.T) {
+	target := setupCallScheduleAfter()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(sc.U64(5).Bytes())
+	buffer.Write(period.Bytes())
+	buffer.Write(sc.U8(4).Bytes())
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(sc.U64(5), period, sc.U8(4), mockCall), result.Args())
+}
+
+func Test_Call_ScheduleAfter_BaseWeight(t *testing.T) {
+	target := setupCallScheduleAfter()
+
+	assert.Equal(t, callScheduleWeight(dbWeight, maxScheduledPerBlock), target.BaseWeight())
+}
+
+func Test_Call_ScheduleAfter_Dispatch(t *testing.T) {
+	target := setupCallScheduleAfter()
+	when := blockNumber + 6
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+	mockCall.On("Bytes").Return(callBytes)
+	mockStorageAgenda.On("Get", when).Return(Agenda{}, nil)
+	mockStorageAgenda.On("Put", when, mock.Anything).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(sc.U64(5), sc.NewOption[Period](nil), sc.U8(4), mockCall))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAgenda.AssertCalled(t, "Put", when, Agenda{sc.NewOption[Scheduled](newTestTask(4))})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventScheduled(moduleId, when, 0))
+}
+
+func Test_Call_ScheduleAfter_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallScheduleAfter()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(sc.U64(5), period, sc.U8(4), mockCall))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallScheduleAfter() callScheduleAfter {
+	return newCallScheduleAfter(moduleId, functionScheduleAfter, dbWeight, setupModule()).(callScheduleAfter)
+}
@@ -0,0 +1,111 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callScheduleNamed schedules a named call to be dispatched at a given block.
+type callScheduleNamed struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallScheduleNamed(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callScheduleNamed{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(TaskName{}, sc.U64(0), sc.NewOption[Period](nil), sc.U8(0)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callScheduleNamed) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	id, err := DecodeTaskName(buffer)
+	if err != nil {
+		return nil, err
+	}
+	when, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return nil, err
+	}
+	maybePeriodic, err := sc.DecodeOptionWith(buffer, DecodePeriod)
+	if err != nil {
+		return nil, err
+	}
+	priority, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return nil, err
+	}
+	call, err := decodeCallFunc(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(id, when, maybePeriodic, priority, call)
+
+	return c, nil
+}
+
+func (c callScheduleNamed) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	c.module.logger.Critical("not implemented")
+	return nil, nil
+}
+
+func (c callScheduleNamed) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callScheduleNamed) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callScheduleNamed) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callScheduleNamed) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callScheduleNamed) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callScheduleNamed) BaseWeight() primitives.Weight {
+	return callScheduleNamedWeight(c.dbWeight, sc.U64(c.module.maxScheduledPerBlock))
+}
+
+func (_ callScheduleNamed) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callScheduleNamed) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callScheduleNamed) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callScheduleNamed) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	err := c.module.scheduleOrigin(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	id := args[0].(TaskName)
+	when := args[1].(sc.U64)
+	maybePeriodic := args[2].(sc.Option[Period])
+	priority := args[3].(sc.U8)
+	call := args[4].(primitives.Call)
+
+	_, err = c.module.doScheduleNamed(id, when, maybePeriodic, priority, primitives.NewOriginCallerSystem(origin), call)
+
+	return primitives.PostDispatchInfo{}, err
+}
+
+func (_ callScheduleNamed) Docs() string {
+	return "Schedule a named task. " +
+		"The dispatch origin for this call must be the `ScheduleOrigin`. " +
+		"The task can be cancelled by its unique `id`."
+}
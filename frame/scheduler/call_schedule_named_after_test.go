@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_ScheduleNamedAfter_DecodeNestedArgs(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(taskName.Bytes())
+	buffer.Write(sc.U64(5).Bytes())
+	buffer.Write(period.Bytes())
+	buffer.Write(sc.U8(4).Bytes())
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(taskName, sc.U64(5), period, sc.U8(4), mockCall), result.Args())
+}
+
+func Test_Call_ScheduleNamedAfter_BaseWeight(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+
+	assert.Equal(t, callScheduleNamedWeight(dbWeight, maxScheduledPerBlock), target.BaseWeight())
+}
+
+func Test_Call_ScheduleNamedAfter_Dispatch(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+	when := blockNumber + 6
+	task := newTestTask(4)
+	task.MaybeId = sc.NewOption[TaskName](taskName)
+	mockStorageLookup.On("Exists", taskName).Return(false)
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+	mockCall.On("Bytes").Return(callBytes)
+	mockStorageAgenda.On("Get", when).Return(Agenda{}, nil)
+	mockStorageAgenda.On("Put", when, mock.Anything).Return()
+	mockStorageLookup.On("Put", taskName, TaskAddress{When: when, Index: 0}).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(taskName, sc.U64(5), sc.NewOption[Period](nil), sc.U8(4), mockCall))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAgenda.AssertCalled(t, "Put", when, Agenda{sc.NewOption[Scheduled](task)})
+	mockStorageLookup.AssertCalled(t, "Put", taskName, TaskAddress{When: when, Index: 0})
+}
+
+func Test_Call_ScheduleNamedAfter_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallScheduleNamedAfter()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(taskName, sc.U64(5), period, sc.U8(4), mockCall))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallScheduleNamedAfter() callScheduleNamedAfter {
+	return newCallScheduleNamedAfter(moduleId, functionScheduleNamedAfter, dbWeight, setupModule()).(callScheduleNamedAfter)
+}
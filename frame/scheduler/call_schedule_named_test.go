@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_ScheduleNamed_DecodeNestedArgs(t *testing.T) {
+	target := setupCallScheduleNamed()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(taskName.Bytes())
+	buffer.Write(sc.U64(15).Bytes())
+	buffer.Write(period.Bytes())
+	buffer.Write(sc.U8(4).Bytes())
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(taskName, sc.U64(15), period, sc.U8(4), mockCall), result.Args())
+}
+
+func Test_Call_ScheduleNamed_BaseWeight(t *testing.T) {
+	target := setupCallScheduleNamed()
+
+	assert.Equal(t, callScheduleNamedWeight(dbWeight, maxScheduledPerBlock), target.BaseWeight())
+}
+
+func Test_Call_ScheduleNamed_Dispatch(t *testing.T) {
+	target := setupCallScheduleNamed()
+	task := newTestTask(4)
+	task.MaybeId = sc.NewOption[TaskName](taskName)
+	mockStorageLookup.On("Exists", taskName).Return(false)
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+	mockCall.On("Bytes").Return(callBytes)
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{}, nil)
+	mockStorageAgenda.On("Put", sc.U64(15), mock.Anything).Return()
+	mockStorageLookup.On("Put", taskName, TaskAddress{When: 15, Index: 0}).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(taskName, sc.U64(15), sc.NewOption[Period](nil), sc.U8(4), mockCall))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAgenda.AssertCalled(t, "Put", sc.U64(15), Agenda{sc.NewOption[Scheduled](task)})
+	mockStorageLookup.AssertCalled(t, "Put", taskName, TaskAddress{When: 15, Index: 0})
+}
+
+func Test_Call_ScheduleNamed_Dispatch_FailedToSchedule(t *testing.T) {
+	target := setupCallScheduleNamed()
+	mockStorageLookup.On("Exists", taskName).Return(true)
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData(taskName, sc.U64(15), period, sc.U8(4), mockCall))
+
+	assert.Equal(t, NewDispatchErrorFailedToSchedule(moduleId), err)
+}
+
+func Test_Call_ScheduleNamed_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallScheduleNamed()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(taskName, sc.U64(15), period, sc.U8(4), mockCall))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallScheduleNamed() callScheduleNamed {
+	return newCallScheduleNamed(moduleId, functionScheduleNamed, dbWeight, setupModule()).(callScheduleNamed)
+}
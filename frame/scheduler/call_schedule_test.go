@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	period = sc.NewOption[Period](Period{Interval: 5, Count: 3})
+)
+
+func Test_Call_Schedule_DecodeNestedArgs(t *testing.T) {
+	target := setupCallSchedule()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(sc.U64(15).Bytes())
+	buffer.Write(period.Bytes())
+	buffer.Write(sc.U8(4).Bytes())
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(sc.U64(15), period, sc.U8(4), mockCall), result.Args())
+}
+
+func Test_Call_Schedule_BaseWeight(t *testing.T) {
+	target := setupCallSchedule()
+
+	assert.Equal(t, callScheduleWeight(dbWeight, maxScheduledPerBlock), target.BaseWeight())
+}
+
+func Test_Call_Schedule_ClassifyDispatch(t *testing.T) {
+	target := setupCallSchedule()
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(callWeight))
+}
+
+func Test_Call_Schedule_PaysFee(t *testing.T) {
+	target := setupCallSchedule()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(callWeight))
+}
+
+func Test_Call_Schedule_Dispatch(t *testing.T) {
+	target := setupCallSchedule()
+	task := newTestTask(4)
+	task.MaybePeriodic = sc.NewOption[Period](Period{Interval: 5, Count: 2})
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+	mockCall.On("Bytes").Return(callBytes)
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{}, nil)
+	mockStorageAgenda.On("Put", sc.U64(15), mock.Anything).Return()
+
+	result, err := target.Dispatch(signedOrigin, sc.NewVaryingData(sc.U64(15), period, sc.U8(4), mockCall))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageAgenda.AssertCalled(t, "Put", sc.U64(15), Agenda{sc.NewOption[Scheduled](task)})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventScheduled(moduleId, 15, 0))
+}
+
+func Test_Call_Schedule_Dispatch_Root(t *testing.T) {
+	target := setupCallSchedule()
+	task := newTestTask(4)
+	task.Origin = rootCaller
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+	mockCall.On("Bytes").Return(callBytes)
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{}, nil)
+	mockStorageAgenda.On("Put", sc.U64(15), mock.Anything).Return()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), sc.NewVaryingData(sc.U64(15), sc.NewOption[Period](nil), sc.U8(4), mockCall))
+
+	assert.NoError(t, err)
+	mockStorageAgenda.AssertCalled(t, "Put", sc.U64(15), Agenda{sc.NewOption[Scheduled](task)})
+}
+
+func Test_Call_Schedule_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallSchedule()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(sc.U64(15), period, sc.U8(4), mockCall))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func Test_Call_Schedule_Dispatch_DefaultScheduleOriginIsRoot(t *testing.T) {
+	module := setupModule()
+	config := NewConfig(mockStorage, mockTransactionBroker, dbWeight, mockSystemModule, mockRuntimeDecoder, maximumWeight, maxScheduledPerBlock, nil, maxCallSize)
+	module.scheduleOrigin = New(moduleId, config, mdGenerator, log.NewLogger()).scheduleOrigin
+	target := newCallSchedule(moduleId, functionSchedule, dbWeight, module)
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData(sc.U64(15), period, sc.U8(4), mockCall))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageAgenda.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Call_Schedule_Dispatch_TargetBlockNumberInPast(t *testing.T) {
+	target := setupCallSchedule()
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+
+	_, err := target.Dispatch(signedOrigin, sc.NewVaryingData(blockNumber, period, sc.U8(4), mockCall))
+
+	assert.Equal(t, NewDispatchErrorTargetBlockNumberInPast(moduleId), err)
+}
+
+func setupCallSchedule() callSchedule {
+	return newCallSchedule(moduleId, functionSchedule, dbWeight, setupModule()).(callSchedule)
+}
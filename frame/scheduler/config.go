@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// DefaultMaxCallSize is the maximum size of the encoded call of a task, if the runtime does not configure one.
+const DefaultMaxCallSize sc.U32 = 128
+
+// ScheduleOrigin checks that `origin` is allowed to schedule and cancel tasks.
+type ScheduleOrigin func(origin primitives.RuntimeOrigin) error
+
+type Config struct {
+	Storage              io.Storage
+	TransactionBroker    io.TransactionBroker
+	DbWeight             primitives.RuntimeDbWeight
+	SystemModule         system.Module
+	CallDecoder          CallDecoder
+	MaximumWeight        primitives.Weight
+	MaxScheduledPerBlock sc.U32
+	// ScheduleOrigin is the origin, which may schedule and cancel tasks. Defaults to `Root`.
+	ScheduleOrigin ScheduleOrigin
+	// MaxCallSize is the maximum size of the encoded call of a task, which is stored in the agenda.
+	// Defaults to DefaultMaxCallSize.
+	MaxCallSize sc.U32
+}
+
+func NewConfig(
+	storage io.Storage,
+	transactionBroker io.TransactionBroker,
+	dbWeight primitives.RuntimeDbWeight,
+	systemModule system.Module,
+	callDecoder CallDecoder,
+	maximumWeight primitives.Weight,
+	maxScheduledPerBlock sc.U32,
+	scheduleOrigin ScheduleOrigin,
+	maxCallSize sc.U32,
+) Config {
+	return Config{
+		Storage:              storage,
+		TransactionBroker:    transactionBroker,
+		DbWeight:             dbWeight,
+		SystemModule:         systemModule,
+		CallDecoder:          callDecoder,
+		MaximumWeight:        maximumWeight,
+		MaxScheduledPerBlock: maxScheduledPerBlock,
+		ScheduleOrigin:       scheduleOrigin,
+		MaxCallSize:          maxCallSize,
+	}
+}
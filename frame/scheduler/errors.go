@@ -0,0 +1,43 @@
+package scheduler
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Scheduler module errors.
+const (
+	ErrorFailedToSchedule sc.U8 = iota
+	ErrorNotFound
+	ErrorTargetBlockNumberInPast
+	ErrorRescheduleNoChange
+	ErrorNamed
+)
+
+func NewDispatchErrorFailedToSchedule(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorFailedToSchedule)
+}
+
+func NewDispatchErrorNotFound(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNotFound)
+}
+
+func NewDispatchErrorTargetBlockNumberInPast(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorTargetBlockNumberInPast)
+}
+
+func NewDispatchErrorRescheduleNoChange(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorRescheduleNoChange)
+}
+
+func NewDispatchErrorNamed(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNamed)
+}
+
+func newDispatchError(moduleId sc.U8, err sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,105 @@
+package scheduler
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Scheduler module events.
+const (
+	EventScheduled sc.U8 = iota
+	EventCanceled
+	EventDispatched
+	EventCallUnavailable
+	EventPeriodicFailed
+	EventPermanentlyOverweight
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid scheduler.Event module")
+	errInvalidEventType   = errors.New("invalid scheduler.Event type")
+)
+
+func newEventScheduled(moduleIndex sc.U8, when sc.U64, index sc.U32) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventScheduled, when, index)
+}
+
+func newEventCanceled(moduleIndex sc.U8, when sc.U64, index sc.U32) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventCanceled, when, index)
+}
+
+func newEventDispatched(moduleIndex sc.U8, task TaskAddress, id sc.Option[TaskName], result primitives.DispatchOutcome) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventDispatched, task, id, result)
+}
+
+func newEventCallUnavailable(moduleIndex sc.U8, task TaskAddress, id sc.Option[TaskName]) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventCallUnavailable, task, id)
+}
+
+func newEventPeriodicFailed(moduleIndex sc.U8, task TaskAddress, id sc.Option[TaskName]) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventPeriodicFailed, task, id)
+}
+
+func newEventPermanentlyOverweight(moduleIndex sc.U8, task TaskAddress, id sc.Option[TaskName]) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventPermanentlyOverweight, task, id)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventScheduled, EventCanceled:
+		when, err := sc.DecodeU64(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		index, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		if b == EventScheduled {
+			return newEventScheduled(moduleIndex, when, index), nil
+		}
+		return newEventCanceled(moduleIndex, when, index), nil
+	case EventDispatched:
+		task, err := DecodeTaskAddress(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		id, err := sc.DecodeOptionWith(buffer, DecodeTaskName)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		result, err := primitives.DecodeDispatchOutcome(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventDispatched(moduleIndex, task, id, result), nil
+	case EventCallUnavailable, EventPeriodicFailed, EventPermanentlyOverweight:
+		task, err := DecodeTaskAddress(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		id, err := sc.DecodeOptionWith(buffer, DecodeTaskName)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return primitives.NewEvent(moduleIndex, b, task, id), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DecodeEvent(t *testing.T) {
+	address := TaskAddress{When: 15, Index: 2}
+	id := sc.NewOption[TaskName](taskName)
+
+	for _, event := range []primitives.Event{
+		newEventScheduled(moduleId, 15, 2),
+		newEventCanceled(moduleId, 15, 2),
+		newEventDispatched(moduleId, address, id, dispatchOutcomeOk()),
+		newEventCallUnavailable(moduleId, address, sc.NewOption[TaskName](nil)),
+		newEventPeriodicFailed(moduleId, address, id),
+		newEventPermanentlyOverweight(moduleId, address, id),
+	} {
+		result, err := DecodeEvent(moduleId, bytes.NewBuffer(event.Bytes()))
+		assert.Nil(t, err)
+
+		assert.Equal(t, event, result)
+	}
+}
+
+func Test_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId + 1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
+
+func Test_DecodeAgenda(t *testing.T) {
+	named := newTestTask(3)
+	named.MaybeId = sc.NewOption[TaskName](taskName)
+	named.MaybePeriodic = sc.NewOption[Period](Period{Interval: 5, Count: 2})
+	agenda := Agenda{sc.NewOption[Scheduled](newTestTask(0)), sc.NewOption[Scheduled](nil), sc.NewOption[Scheduled](named)}
+
+	result, err := DecodeAgenda(bytes.NewBuffer(agenda.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, agenda, result)
+}
+
+func Test_DecodeTaskAddress(t *testing.T) {
+	address := TaskAddress{When: 15, Index: 2}
+
+	result, err := DecodeTaskAddress(bytes.NewBuffer(address.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, address, result)
+}
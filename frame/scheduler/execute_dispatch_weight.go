@@ -0,0 +1,12 @@
+package scheduler
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func executeDispatchWeight(signed bool) primitives.Weight {
+	if signed {
+		return primitives.WeightFromParts(2000000, 0)
+	}
+	return primitives.WeightFromParts(1800000, 0)
+}
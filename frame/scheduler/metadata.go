@@ -0,0 +1,353 @@
+package scheduler
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func (m Module) Metadata() primitives.MetadataModule {
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSchedulerCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSchedulerCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Scheduler, Runtime>"),
+				},
+				m.index,
+				"Call.Scheduler")),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSchedulerEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSchedulerEvent, "pallet_scheduler::Event<Runtime>"),
+				},
+				m.index,
+				"Events.Scheduler"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"MaximumWeight",
+				sc.ToCompact(metadata.TypesWeight),
+				sc.BytesToSequenceU8(m.maximumWeight.Bytes()),
+				"The maximum weight that may be scheduled per block for any dispatchables.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxScheduledPerBlock",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.maxScheduledPerBlock.Bytes()),
+				"The maximum number of scheduled calls in the queue for a single block.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSchedulerErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesSchedulerErrors),
+				},
+				m.index,
+				"Errors.Scheduler"),
+		),
+		Index: m.index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"IncompleteSince",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU64)),
+				"The earliest block, whose agenda was not fully serviced."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Agenda",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.PrimitiveTypesU64),
+					sc.ToCompact(metadata.TypesSchedulerAgenda)),
+				"Items to be executed, indexed by the block number that they should be executed on."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Lookup",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesFixedSequence32U8),
+					sc.ToCompact(metadata.TypesSchedulerTaskAddress)),
+				"Lookup from a name to the block number and index of the task."),
+		},
+	})
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataType(metadata.TypesSchedulerTaskAddress,
+			"TaskAddress<BlockNumber>",
+			primitives.NewMetadataTypeDefinitionTuple(
+				sc.Sequence[sc.Compact]{
+					sc.ToCompact(metadata.PrimitiveTypesU64),
+					sc.ToCompact(metadata.PrimitiveTypesU32),
+				})),
+
+		primitives.NewMetadataType(metadata.TypesSchedulerPeriod,
+			"schedule::Period<BlockNumber>",
+			primitives.NewMetadataTypeDefinitionTuple(
+				sc.Sequence[sc.Compact]{
+					sc.ToCompact(metadata.PrimitiveTypesU64),
+					sc.ToCompact(metadata.PrimitiveTypesU32),
+				})),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionSchedulerPeriod,
+			"Option<schedule::Period<BlockNumber>>",
+			sc.Sequence[sc.Str]{"Option"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						0,
+						""),
+					primitives.NewMetadataDefinitionVariant(
+						"Some",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.TypesSchedulerPeriod),
+						},
+						1,
+						""),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesSchedulerPeriod, "T")),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionSchedulerTaskName,
+			"Option<TaskName>",
+			sc.Sequence[sc.Str]{"Option"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						0,
+						""),
+					primitives.NewMetadataDefinitionVariant(
+						"Some",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.TypesFixedSequence32U8),
+						},
+						1,
+						""),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesFixedSequence32U8, "T")),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesSchedulerScheduled,
+			"Scheduled",
+			sc.Sequence[sc.Str]{"pallet_scheduler", "Scheduled"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionSchedulerTaskName, "maybe_id", "Option<Name>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "priority", "schedule::Priority"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "call", "Call"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionSchedulerPeriod, "maybe_periodic", "Option<schedule::Period<BlockNumber>>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOriginCaller, "origin", "PalletsOrigin"),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.TypesFixedSequence32U8, "Name"),
+				primitives.NewMetadataTypeParameter(metadata.TypesSequenceU8, "Call"),
+				primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU64, "BlockNumber"),
+				primitives.NewMetadataTypeParameter(metadata.TypesOriginCaller, "PalletsOrigin"),
+			}),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionSchedulerScheduled,
+			"Option<Scheduled>",
+			sc.Sequence[sc.Str]{"Option"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						0,
+						""),
+					primitives.NewMetadataDefinitionVariant(
+						"Some",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.TypesSchedulerScheduled),
+						},
+						1,
+						""),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesSchedulerScheduled, "T")),
+
+		primitives.NewMetadataType(metadata.TypesSchedulerAgenda,
+			"BoundedVec<Option<Scheduled>, MaxScheduledPerBlock>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesOptionSchedulerScheduled))),
+
+		primitives.NewMetadataTypeWithPath(
+			metadata.TypesSchedulerEvent,
+			"pallet_scheduler pallet Event",
+			sc.Sequence[sc.Str]{"pallet_scheduler", "pallet", "Event"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Scheduled",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "when", "BlockNumberFor<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "u32"),
+						},
+						EventScheduled,
+						"Scheduled some task."),
+					primitives.NewMetadataDefinitionVariant(
+						"Canceled",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "when", "BlockNumberFor<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "u32"),
+						},
+						EventCanceled,
+						"Canceled some task."),
+					primitives.NewMetadataDefinitionVariant(
+						"Dispatched",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSchedulerTaskAddress, "task", "TaskAddress<BlockNumberFor<T>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionSchedulerTaskName, "id", "Option<TaskName>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchOutcome, "result", "DispatchResult"),
+						},
+						EventDispatched,
+						"Dispatched some task."),
+					primitives.NewMetadataDefinitionVariant(
+						"CallUnavailable",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSchedulerTaskAddress, "task", "TaskAddress<BlockNumberFor<T>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionSchedulerTaskName, "id", "Option<TaskName>"),
+						},
+						EventCallUnavailable,
+						"The call for the provided hash was not found so the task has been aborted."),
+					primitives.NewMetadataDefinitionVariant(
+						"PeriodicFailed",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSchedulerTaskAddress, "task", "TaskAddress<BlockNumberFor<T>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionSchedulerTaskName, "id", "Option<TaskName>"),
+						},
+						EventPeriodicFailed,
+						"The given task was unable to be renewed since the agenda is full at that block."),
+					primitives.NewMetadataDefinitionVariant(
+						"PermanentlyOverweight",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSchedulerTaskAddress, "task", "TaskAddress<BlockNumberFor<T>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionSchedulerTaskName, "id", "Option<TaskName>"),
+						},
+						EventPermanentlyOverweight,
+						"The given task can never be executed since it is overweight."),
+				})),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesSchedulerErrors,
+			"pallet_scheduler pallet Error",
+			sc.Sequence[sc.Str]{"pallet_scheduler", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"FailedToSchedule",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorFailedToSchedule,
+						"Failed to schedule a call."),
+					primitives.NewMetadataDefinitionVariant(
+						"NotFound",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNotFound,
+						"Cannot find the scheduled call."),
+					primitives.NewMetadataDefinitionVariant(
+						"TargetBlockNumberInPast",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTargetBlockNumberInPast,
+						"Given target block number is in the past."),
+					primitives.NewMetadataDefinitionVariant(
+						"RescheduleNoChange",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorRescheduleNoChange,
+						"Reschedule failed because it does not change scheduled time."),
+					primitives.NewMetadataDefinitionVariant(
+						"Named",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNamed,
+						"Attempt to use a non-named function on a named task."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesSchedulerCalls,
+			"Scheduler calls",
+			sc.Sequence[sc.Str]{"pallet_scheduler", "pallet", "Call"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"schedule",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "when", "BlockNumberFor<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionSchedulerPeriod, "maybe_periodic", "Option<schedule::Period<BlockNumberFor<T>>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "priority", "schedule::Priority"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+						},
+						functionSchedule,
+						"Anonymously schedule a task."),
+					primitives.NewMetadataDefinitionVariant(
+						"cancel",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "when", "BlockNumberFor<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "u32"),
+						},
+						functionCancel,
+						"Cancel an anonymously scheduled task."),
+					primitives.NewMetadataDefinitionVariant(
+						"schedule_named",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, "id", "TaskName"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "when", "BlockNumberFor<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionSchedulerPeriod, "maybe_periodic", "Option<schedule::Period<BlockNumberFor<T>>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "priority", "schedule::Priority"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+						},
+						functionScheduleNamed,
+						"Schedule a named task."),
+					primitives.NewMetadataDefinitionVariant(
+						"cancel_named",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, "id", "TaskName"),
+						},
+						functionCancelNamed,
+						"Cancel a named scheduled task."),
+					primitives.NewMetadataDefinitionVariant(
+						"schedule_after",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "after", "BlockNumberFor<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionSchedulerPeriod, "maybe_periodic", "Option<schedule::Period<BlockNumberFor<T>>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "priority", "schedule::Priority"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+						},
+						functionScheduleAfter,
+						"Anonymously schedule a task after a delay."),
+					primitives.NewMetadataDefinitionVariant(
+						"schedule_named_after",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, "id", "TaskName"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "after", "BlockNumberFor<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionSchedulerPeriod, "maybe_periodic", "Option<schedule::Period<BlockNumberFor<T>>>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "priority", "schedule::Priority"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+						},
+						functionScheduleNamedAfter,
+						"Schedule a named task after a delay."),
+				}),
+			primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+}
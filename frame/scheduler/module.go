@@ -0,0 +1,541 @@
+package scheduler
+
+import (
+	"bytes"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	functionSchedule = iota
+	functionCancel
+	functionScheduleNamed
+	functionCancelNamed
+	functionScheduleAfter
+	functionScheduleNamedAfter
+)
+
+const (
+	name = sc.Str("Scheduler")
+)
+
+// serviceTaskResult is the outcome of servicing a single task of an agenda.
+type serviceTaskResult sc.U8
+
+const (
+	// taskServiced means that the call of the task was dispatched.
+	taskServiced serviceTaskResult = iota
+	// taskUnavailable means that the task cannot be dispatched and is left in the agenda.
+	taskUnavailable
+	// taskOverweight means that there was not enough weight to dispatch the task and it is postponed.
+	taskOverweight
+)
+
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	index                sc.U8
+	dbWeight             primitives.RuntimeDbWeight
+	maximumWeight        primitives.Weight
+	maxScheduledPerBlock sc.U32
+	maxCallSize          sc.U32
+	scheduleOrigin       ScheduleOrigin
+	functions            map[sc.U8]primitives.Call
+	storage              *storage
+	systemModule         system.Module
+	callDecoder          CallDecoder
+	transactional        support.Transactional[primitives.PostDispatchInfo]
+	mdGenerator          *primitives.MetadataTypeGenerator
+	logger               log.RuntimeLogger
+}
+
+func New(index sc.U8, config Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.RuntimeLogger) Module {
+	functions := make(map[sc.U8]primitives.Call)
+
+	if config.ScheduleOrigin == nil {
+		config.ScheduleOrigin = system.EnsureRoot
+	}
+	if config.MaxCallSize == 0 {
+		config.MaxCallSize = DefaultMaxCallSize
+	}
+
+	module := Module{
+		index:                index,
+		dbWeight:             config.DbWeight,
+		maximumWeight:        config.MaximumWeight,
+		maxScheduledPerBlock: config.MaxScheduledPerBlock,
+		maxCallSize:          config.MaxCallSize,
+		scheduleOrigin:       config.ScheduleOrigin,
+		storage:              newStorage(config.Storage),
+		systemModule:         config.SystemModule,
+		callDecoder:          config.CallDecoder,
+		transactional:        support.NewTransactional[primitives.PostDispatchInfo](config.Storage, config.TransactionBroker, logger),
+		mdGenerator:          mdGenerator,
+		logger:               logger,
+	}
+
+	functions[functionSchedule] = newCallSchedule(index, functionSchedule, config.DbWeight, module)
+	functions[functionCancel] = newCallCancel(index, functionCancel, config.DbWeight, module)
+	functions[functionScheduleNamed] = newCallScheduleNamed(index, functionScheduleNamed, config.DbWeight, module)
+	functions[functionCancelNamed] = newCallCancelNamed(index, functionCancelNamed, config.DbWeight, module)
+	functions[functionScheduleAfter] = newCallScheduleAfter(index, functionScheduleAfter, config.DbWeight, module)
+	functions[functionScheduleNamedAfter] = newCallScheduleNamedAfter(index, functionScheduleNamedAfter, config.DbWeight, module)
+
+	module.functions = functions
+
+	return module
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) GetIndex() sc.U8 { return m.index }
+
+func (m Module) Functions() map[sc.U8]primitives.Call { return m.functions }
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) { return sc.Empty{}, nil }
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnInitialize dispatches the tasks scheduled for block `n`, as well as the tasks postponed from previous blocks,
+// as long as their weight does not exceed MaximumWeight.
+func (m Module) OnInitialize(n sc.U64) (primitives.Weight, error) {
+	meter := primitives.NewWeightMeter(m.maximumWeight)
+
+	if err := m.serviceAgendas(&meter, n); err != nil {
+		return primitives.WeightZero(), err
+	}
+
+	return meter.Consumed, nil
+}
+
+// Agenda returns the tasks scheduled for block `when`.
+func (m Module) Agenda(when sc.U64) (Agenda, error) {
+	return m.storage.Agenda.Get(when)
+}
+
+// Lookup returns the address of the named task `id`, if it is scheduled.
+func (m Module) Lookup(id TaskName) (sc.Option[TaskAddress], error) {
+	if !m.storage.Lookup.Exists(id) {
+		return sc.NewOption[TaskAddress](nil), nil
+	}
+
+	address, err := m.storage.Lookup.Get(id)
+	if err != nil {
+		return sc.NewOption[TaskAddress](nil), err
+	}
+
+	return sc.NewOption[TaskAddress](address), nil
+}
+
+// serviceAgendas services the agendas from the earliest incomplete block up to block `now`, while there is enough
+// weight left in `meter`.
+func (m Module) serviceAgendas(meter *primitives.WeightMeter, now sc.U64) error {
+	if !meter.TryConsume(serviceAgendasBaseWeight(m.dbWeight)) {
+		return nil
+	}
+
+	incompleteSince := sc.SaturatingAddU64(now, 1)
+	when := now
+
+	if m.storage.IncompleteSince.Exists() {
+		since, err := m.storage.IncompleteSince.Take()
+		if err != nil {
+			return err
+		}
+		when = since
+	}
+
+	executed := sc.U32(0)
+	agendaWeight := serviceAgendaBaseWeight(m.dbWeight, sc.U64(m.maxScheduledPerBlock))
+	for when <= now && meter.CanConsume(agendaWeight) {
+		complete, err := m.serviceAgenda(meter, &executed, now, when)
+		if err != nil {
+			return err
+		}
+		if !complete && when < incompleteSince {
+			incompleteSince = when
+		}
+		when++
+	}
+
+	if when < incompleteSince {
+		incompleteSince = when
+	}
+	if incompleteSince <= now {
+		m.storage.IncompleteSince.Put(incompleteSince)
+	}
+
+	return nil
+}
+
+// serviceAgenda dispatches the tasks in the agenda of block `when` in order of priority, while there is enough weight
+// left in `meter`. Returns whether no task was postponed.
+func (m Module) serviceAgenda(meter *primitives.WeightMeter, executed *sc.U32, now sc.U64, when sc.U64) (bool, error) {
+	agenda, err := m.storage.Agenda.Get(when)
+	if err != nil {
+		return false, err
+	}
+
+	ordered := []int{}
+	for i, slot := range agenda {
+		if slot.HasValue {
+			ordered = append(ordered, i)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return agenda[ordered[i]].Value.Priority < agenda[ordered[j]].Value.Priority
+	})
+
+	meter.TryConsume(serviceAgendaBaseWeight(m.dbWeight, sc.U64(len(ordered))))
+
+	postponed, dropped := 0, 0
+	for _, agendaIndex := range ordered {
+		task := agenda[agendaIndex].Value
+
+		taskWeight := serviceTaskWeight(m.dbWeight, task.MaybeId.HasValue, task.MaybePeriodic.HasValue)
+		if !meter.CanConsume(taskWeight) {
+			postponed++
+			break
+		}
+
+		result, err := m.serviceTask(meter, now, when, sc.U32(agendaIndex), *executed == 0, task)
+		if err != nil {
+			return false, err
+		}
+
+		switch result {
+		case taskServiced:
+			*executed++
+			agenda[agendaIndex] = sc.NewOption[Scheduled](nil)
+		case taskUnavailable:
+			dropped++
+		case taskOverweight:
+			postponed++
+		}
+	}
+
+	if postponed > 0 || dropped > 0 {
+		m.storage.Agenda.Put(when, agenda)
+	} else {
+		m.storage.Agenda.Remove(when)
+	}
+
+	return postponed == 0, nil
+}
+
+// serviceTask decodes and dispatches the call of `task`. Periodic tasks are scheduled again after their interval.
+func (m Module) serviceTask(meter *primitives.WeightMeter, now sc.U64, when sc.U64, agendaIndex sc.U32, isFirst bool, task Scheduled) (serviceTaskResult, error) {
+	if task.MaybeId.HasValue {
+		m.storage.Lookup.Remove(task.MaybeId.Value)
+	}
+
+	address := TaskAddress{When: when, Index: agendaIndex}
+	taskWeight := serviceTaskWeight(m.dbWeight, task.MaybeId.HasValue, task.MaybePeriodic.HasValue)
+
+	call, err := m.callDecoder.DecodeCall(bytes.NewBuffer(sc.SequenceU8ToBytes(task.Call)))
+	if err != nil {
+		meter.TryConsume(taskWeight)
+		m.systemModule.DepositEvent(newEventCallUnavailable(m.index, address, task.MaybeId))
+		return taskUnavailable, nil
+	}
+
+	meter.TryConsume(taskWeight)
+
+	outcome, ok, err := m.executeDispatch(meter, task.Origin.RawOrigin, call)
+	if err != nil {
+		return taskUnavailable, err
+	}
+	if !ok {
+		if isFirst {
+			m.systemModule.DepositEvent(newEventPermanentlyOverweight(m.index, address, task.MaybeId))
+			return taskUnavailable, nil
+		}
+		return taskOverweight, nil
+	}
+
+	m.systemModule.DepositEvent(newEventDispatched(m.index, address, task.MaybeId, outcome))
+
+	if task.MaybePeriodic.HasValue {
+		period := task.MaybePeriodic.Value
+		if period.Count > 1 {
+			task.MaybePeriodic = sc.NewOption[Period](Period{Interval: period.Interval, Count: period.Count - 1})
+		} else {
+			task.MaybePeriodic = sc.NewOption[Period](nil)
+		}
+
+		if _, err := m.placeTask(sc.SaturatingAddU64(now, period.Interval), task); err != nil {
+			m.systemModule.DepositEvent(newEventPeriodicFailed(m.index, address, task.MaybeId))
+		}
+	}
+
+	return taskServiced, nil
+}
+
+// executeDispatch dispatches `call` on behalf of `origin`, if its weight can be consumed from `meter`.
+// Returns whether the call was dispatched.
+func (m Module) executeDispatch(meter *primitives.WeightMeter, origin primitives.RawOrigin, call primitives.Call) (primitives.DispatchOutcome, bool, error) {
+	baseWeight := executeDispatchWeight(origin.IsSignedOrigin())
+	info := primitives.GetDispatchInfo(call)
+
+	if !meter.CanConsume(baseWeight.SaturatingAdd(info.Weight)) {
+		return primitives.DispatchOutcome{}, false, nil
+	}
+
+	postInfo, dispatchErr := m.dispatch(origin, call)
+
+	meter.Consume(baseWeight)
+	meter.Consume(postInfo.CalcActualWeight(&info))
+
+	if dispatchErr != nil {
+		outcome, err := primitives.NewDispatchOutcome(toDispatchError(dispatchErr))
+		return outcome, true, err
+	}
+
+	outcome, err := primitives.NewDispatchOutcome(sc.Empty{})
+	return outcome, true, err
+}
+
+// dispatch executes `call` in a new storage layer, which is committed only if the call succeeds.
+func (m Module) dispatch(origin primitives.RuntimeOrigin, call primitives.Call) (primitives.PostDispatchInfo, error) {
+	return m.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		postInfo, err := call.Dispatch(origin, call.Args())
+		if err != nil {
+			return primitives.PostDispatchInfo{}, toDispatchError(err)
+		}
+		return postInfo, nil
+	})
+}
+
+// doSchedule schedules `call` to be dispatched at block `when` on behalf of `origin`.
+func (m Module) doSchedule(when sc.U64, maybePeriodic sc.Option[Period], priority sc.U8, origin primitives.OriginCaller, call primitives.Call) (TaskAddress, error) {
+	if err := m.ensureFuture(when); err != nil {
+		return TaskAddress{}, err
+	}
+
+	encodedCall, err := m.boundCall(call)
+	if err != nil {
+		return TaskAddress{}, err
+	}
+
+	task := Scheduled{
+		MaybeId:       sc.NewOption[TaskName](nil),
+		Priority:      priority,
+		Call:          encodedCall,
+		MaybePeriodic: normalizePeriodic(maybePeriodic),
+		Origin:        origin,
+	}
+
+	return m.placeTask(when, task)
+}
+
+// doScheduleNamed schedules `call` under the unique name `id` to be dispatched at block `when` on behalf of `origin`.
+func (m Module) doScheduleNamed(id TaskName, when sc.U64, maybePeriodic sc.Option[Period], priority sc.U8, origin primitives.OriginCaller, call primitives.Call) (TaskAddress, error) {
+	if m.storage.Lookup.Exists(id) {
+		return TaskAddress{}, NewDispatchErrorFailedToSchedule(m.index)
+	}
+
+	if err := m.ensureFuture(when); err != nil {
+		return TaskAddress{}, err
+	}
+
+	encodedCall, err := m.boundCall(call)
+	if err != nil {
+		return TaskAddress{}, err
+	}
+
+	task := Scheduled{
+		MaybeId:       sc.NewOption[TaskName](id),
+		Priority:      priority,
+		Call:          encodedCall,
+		MaybePeriodic: normalizePeriodic(maybePeriodic),
+		Origin:        origin,
+	}
+
+	return m.placeTask(when, task)
+}
+
+// doCancel removes the task at index `index` of the agenda of block `when`.
+func (m Module) doCancel(origin primitives.OriginCaller, when sc.U64, index sc.U32) error {
+	agenda, err := m.storage.Agenda.Get(when)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	if int(index) >= len(agenda) || !agenda[index].HasValue {
+		return NewDispatchErrorNotFound(m.index)
+	}
+
+	task := agenda[index].Value
+	if !hasPrivilege(origin, task.Origin) {
+		return primitives.NewDispatchErrorBadOrigin()
+	}
+
+	agenda[index] = sc.NewOption[Scheduled](nil)
+	m.storeAgenda(when, agenda)
+
+	if task.MaybeId.HasValue {
+		m.storage.Lookup.Remove(task.MaybeId.Value)
+	}
+
+	m.systemModule.DepositEvent(newEventCanceled(m.index, when, index))
+
+	return nil
+}
+
+// doCancelNamed removes the named task `id`.
+func (m Module) doCancelNamed(origin primitives.OriginCaller, id TaskName) error {
+	if !m.storage.Lookup.Exists(id) {
+		return NewDispatchErrorNotFound(m.index)
+	}
+
+	address, err := m.storage.Lookup.Get(id)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	agenda, err := m.storage.Agenda.Get(address.When)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	if int(address.Index) < len(agenda) && agenda[address.Index].HasValue {
+		if !hasPrivilege(origin, agenda[address.Index].Value.Origin) {
+			return primitives.NewDispatchErrorBadOrigin()
+		}
+		agenda[address.Index] = sc.NewOption[Scheduled](nil)
+		m.storeAgenda(address.When, agenda)
+	}
+
+	m.storage.Lookup.Remove(id)
+
+	m.systemModule.DepositEvent(newEventCanceled(m.index, address.When, address.Index))
+
+	return nil
+}
+
+// placeTask adds `task` to the agenda of block `when` and registers its name, if it is named.
+func (m Module) placeTask(when sc.U64, task Scheduled) (TaskAddress, error) {
+	index, err := m.pushToAgenda(when, task)
+	if err != nil {
+		return TaskAddress{}, err
+	}
+
+	address := TaskAddress{When: when, Index: index}
+	if task.MaybeId.HasValue {
+		m.storage.Lookup.Put(task.MaybeId.Value, address)
+	}
+
+	m.systemModule.DepositEvent(newEventScheduled(m.index, when, index))
+
+	return address, nil
+}
+
+// pushToAgenda adds `task` to the agenda of block `when`. If the agenda is full, the task takes the first empty slot.
+// Returns the index of the task in the agenda.
+func (m Module) pushToAgenda(when sc.U64, task Scheduled) (sc.U32, error) {
+	agenda, err := m.storage.Agenda.Get(when)
+	if err != nil {
+		return 0, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	index := len(agenda)
+	if sc.U32(len(agenda)) < m.maxScheduledPerBlock {
+		agenda = append(agenda, sc.NewOption[Scheduled](task))
+	} else {
+		index = -1
+		for i, slot := range agenda {
+			if !slot.HasValue {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return 0, primitives.NewDispatchErrorExhausted()
+		}
+		agenda[index] = sc.NewOption[Scheduled](task)
+	}
+
+	m.storage.Agenda.Put(when, agenda)
+
+	return sc.U32(index), nil
+}
+
+// storeAgenda stores `agenda` without its trailing empty slots. An agenda without tasks is removed.
+func (m Module) storeAgenda(when sc.U64, agenda Agenda) {
+	length := len(agenda)
+	for length > 0 && !agenda[length-1].HasValue {
+		length--
+	}
+
+	if length == 0 {
+		m.storage.Agenda.Remove(when)
+		return
+	}
+
+	m.storage.Agenda.Put(when, agenda[:length])
+}
+
+// boundCall returns the encoded `call`, which is stored in the agenda. Calls, which are larger than
+// `maxCallSize`, cannot be scheduled, since they would be stored without a deposit.
+func (m Module) boundCall(call primitives.Call) (sc.Sequence[sc.U8], error) {
+	encodedCall := call.Bytes()
+	if sc.U32(len(encodedCall)) > m.maxCallSize {
+		return nil, NewDispatchErrorFailedToSchedule(m.index)
+	}
+	return sc.BytesToSequenceU8(encodedCall), nil
+}
+
+// ensureFuture checks that block `when` is after the current block.
+func (m Module) ensureFuture(when sc.U64) error {
+	now, err := m.systemModule.StorageBlockNumber()
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if when <= now {
+		return NewDispatchErrorTargetBlockNumberInPast(m.index)
+	}
+	return nil
+}
+
+// blockNumberAfter returns the block number, which is `after` blocks after the current one.
+func (m Module) blockNumberAfter(after sc.U64) (sc.U64, error) {
+	now, err := m.systemModule.StorageBlockNumber()
+	if err != nil {
+		return 0, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	return sc.SaturatingAddU64(sc.SaturatingAddU64(now, after), 1), nil
+}
+
+// normalizePeriodic drops periods, which do not repeat, and accounts for the first dispatch of the task.
+func normalizePeriodic(maybePeriodic sc.Option[Period]) sc.Option[Period] {
+	if !maybePeriodic.HasValue || maybePeriodic.Value.Count <= 1 || maybePeriodic.Value.Interval == 0 {
+		return sc.NewOption[Period](nil)
+	}
+	return sc.NewOption[Period](Period{
+		Interval: maybePeriodic.Value.Interval,
+		Count:    maybePeriodic.Value.Count - 1,
+	})
+}
+
+// hasPrivilege checks whether `origin` may cancel a task scheduled by `scheduledBy`.
+func hasPrivilege(origin primitives.OriginCaller, scheduledBy primitives.OriginCaller) bool {
+	return origin.IsRootOrigin() || bytes.Equal(origin.Bytes(), scheduledBy.Bytes())
+}
+
+func toDispatchError(err error) primitives.DispatchError {
+	dispatchErr, ok := err.(primitives.DispatchError)
+	if !ok {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	return dispatchErr
+}
@@ -0,0 +1,627 @@
+package scheduler
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId             = 12
+	maxScheduledPerBlock = 3
+	maxCallSize          = 8
+	blockNumber          = sc.U64(10)
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	maximumWeight = primitives.WeightFromParts(50_000_000, 0)
+
+	who          = constants.OneAccountId
+	signedOrigin = primitives.NewRawOriginSigned(who)
+	signedCaller = primitives.NewOriginCallerSystem(signedOrigin)
+	rootCaller   = primitives.NewOriginCallerSystem(primitives.NewRawOriginRoot())
+	otherCaller  = primitives.NewOriginCallerSystem(primitives.NewRawOriginSigned(constants.TwoAccountId))
+
+	taskName = TaskName{
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	}
+	callBytes  = []byte{1, 2, 3}
+	callArgs   = sc.NewVaryingData(sc.U8(1))
+	callWeight = primitives.WeightFromParts(100, 0)
+	postInfo   = primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](primitives.WeightFromParts(40, 0))}
+
+	mdGenerator                           = primitives.NewMetadataTypeGenerator()
+	unknownTransactionNoUnsignedValidator = primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+	dispatchErrOther                      = primitives.NewDispatchErrorOther("error")
+
+	// signedOrRootOrigin allows the tests to schedule and cancel tasks on behalf of signed origins.
+	signedOrRootOrigin = func(origin primitives.RuntimeOrigin) error {
+		_, err := system.EnsureSignedOrRoot(origin)
+		return err
+	}
+)
+
+var (
+	mockStorage                *mocks.IoStorage
+	mockTransactionBroker      *mocks.IoTransactionBroker
+	mockSystemModule           *mocks.SystemModule
+	mockRuntimeDecoder         *mocks.RuntimeDecoder
+	mockTransactional          *mocks.IoTransactional[primitives.PostDispatchInfo]
+	mockStorageIncompleteSince *mocks.StorageValue[sc.U64]
+	mockStorageAgenda          *mocks.StorageMap[sc.U64, Agenda]
+	mockStorageLookup          *mocks.StorageMap[TaskName, TaskAddress]
+	mockCall                   *mocks.Call
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, 6, len(target.Functions()))
+}
+
+func Test_Module_New_DefaultMaxCallSize(t *testing.T) {
+	config := NewConfig(mockStorage, mockTransactionBroker, dbWeight, mockSystemModule, mockRuntimeDecoder, maximumWeight, maxScheduledPerBlock, nil, 0)
+
+	target := New(moduleId, config, mdGenerator, log.NewLogger())
+
+	assert.Equal(t, DefaultMaxCallSize, target.maxCallSize)
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.NewTransactionSourceLocal(), mockCall)
+
+	assert.Equal(t, unknownTransactionNoUnsignedValidator, err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_Lookup(t *testing.T) {
+	target := setupModule()
+	address := TaskAddress{When: 15, Index: 1}
+	mockStorageLookup.On("Exists", taskName).Return(true)
+	mockStorageLookup.On("Get", taskName).Return(address, nil)
+
+	result, err := target.Lookup(taskName)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[TaskAddress](address), result)
+}
+
+func Test_Module_Lookup_None(t *testing.T) {
+	target := setupModule()
+	mockStorageLookup.On("Exists", taskName).Return(false)
+
+	result, err := target.Lookup(taskName)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[TaskAddress](nil), result)
+	mockStorageLookup.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_OnInitialize_EmptyAgenda(t *testing.T) {
+	target := setupModule()
+	mockStorageIncompleteSince.On("Exists").Return(false)
+	mockStorageAgenda.On("Get", blockNumber).Return(Agenda{}, nil)
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+
+	result, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	expect := serviceAgendasBaseWeight(dbWeight).Add(serviceAgendaBaseWeight(dbWeight, 0))
+	assert.Equal(t, expect, result)
+	mockStorageAgenda.AssertCalled(t, "Remove", blockNumber)
+	mockStorageIncompleteSince.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_OnInitialize_DispatchesTask(t *testing.T) {
+	target := setupModule()
+	task := newTestTask(0)
+	mockStorageIncompleteSince.On("Exists").Return(false)
+	mockStorageAgenda.On("Get", blockNumber).Return(Agenda{sc.NewOption[Scheduled](task)}, nil)
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, nil)
+	expectDispatch(postInfo, nil)
+
+	result, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	expect := serviceAgendasBaseWeight(dbWeight).
+		Add(serviceAgendaBaseWeight(dbWeight, 1)).
+		Add(serviceTaskWeight(dbWeight, false, false)).
+		Add(executeDispatchWeight(true)).
+		Add(postInfo.ActualWeight.Value)
+	assert.Equal(t, expect, result)
+	mockCall.AssertCalled(t, "Dispatch", signedOrigin, callArgs)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventDispatched(moduleId, TaskAddress{When: blockNumber, Index: 0}, task.MaybeId, dispatchOutcomeOk()))
+	mockStorageAgenda.AssertCalled(t, "Remove", blockNumber)
+}
+
+func Test_Module_OnInitialize_DispatchesByPriority(t *testing.T) {
+	target := setupModule()
+	low, high := newTestTask(10), newTestTask(1)
+	mockStorageIncompleteSince.On("Exists").Return(false)
+	mockStorageAgenda.On("Get", blockNumber).Return(Agenda{sc.NewOption[Scheduled](low), sc.NewOption[Scheduled](nil), sc.NewOption[Scheduled](high)}, nil)
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, nil)
+	expectDispatch(postInfo, nil)
+
+	_, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	events := []primitives.Event{}
+	for _, c := range mockSystemModule.Calls {
+		events = append(events, c.Arguments.Get(0).(primitives.Event))
+	}
+	assert.Equal(t, []primitives.Event{
+		newEventDispatched(moduleId, TaskAddress{When: blockNumber, Index: 2}, high.MaybeId, dispatchOutcomeOk()),
+		newEventDispatched(moduleId, TaskAddress{When: blockNumber, Index: 0}, low.MaybeId, dispatchOutcomeOk()),
+	}, events)
+}
+
+func Test_Module_OnInitialize_DispatchFails(t *testing.T) {
+	target := setupModule()
+	task := newTestTask(0)
+	mockStorageIncompleteSince.On("Exists").Return(false)
+	mockStorageAgenda.On("Get", blockNumber).Return(Agenda{sc.NewOption[Scheduled](task)}, nil)
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, nil)
+	expectDispatch(primitives.PostDispatchInfo{}, dispatchErrOther)
+
+	_, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	outcome, _ := primitives.NewDispatchOutcome(dispatchErrOther)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventDispatched(moduleId, TaskAddress{When: blockNumber, Index: 0}, task.MaybeId, outcome))
+	mockStorageAgenda.AssertCalled(t, "Remove", blockNumber)
+}
+
+func Test_Module_OnInitialize_NamedPeriodicTask(t *testing.T) {
+	target := setupModule()
+	task := newTestTask(0)
+	task.MaybeId = sc.NewOption[TaskName](taskName)
+	task.MaybePeriodic = sc.NewOption[Period](Period{Interval: 5, Count: 2})
+	next := task
+	next.MaybePeriodic = sc.NewOption[Period](Period{Interval: 5, Count: 1})
+	nextAddress := TaskAddress{When: blockNumber + 5, Index: 0}
+	mockStorageIncompleteSince.On("Exists").Return(false)
+	mockStorageAgenda.On("Get", blockNumber).Return(Agenda{sc.NewOption[Scheduled](task)}, nil)
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+	mockStorageLookup.On("Remove", taskName).Return()
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, nil)
+	expectDispatch(postInfo, nil)
+	mockStorageAgenda.On("Get", blockNumber+5).Return(Agenda{}, nil)
+	mockStorageAgenda.On("Put", blockNumber+5, Agenda{sc.NewOption[Scheduled](next)}).Return()
+	mockStorageLookup.On("Put", taskName, nextAddress).Return()
+
+	_, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	mockStorageLookup.AssertCalled(t, "Remove", taskName)
+	mockStorageAgenda.AssertCalled(t, "Put", blockNumber+5, Agenda{sc.NewOption[Scheduled](next)})
+	mockStorageLookup.AssertCalled(t, "Put", taskName, nextAddress)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventScheduled(moduleId, nextAddress.When, nextAddress.Index))
+}
+
+func Test_Module_OnInitialize_PeriodicFailed(t *testing.T) {
+	target := setupModule()
+	task := newTestTask(0)
+	task.MaybePeriodic = sc.NewOption[Period](Period{Interval: 5, Count: 1})
+	full := Agenda{sc.NewOption[Scheduled](task), sc.NewOption[Scheduled](task), sc.NewOption[Scheduled](task)}
+	mockStorageIncompleteSince.On("Exists").Return(false)
+	mockStorageAgenda.On("Get", blockNumber).Return(Agenda{sc.NewOption[Scheduled](task)}, nil)
+	mockStorageAgenda.On("Remove", blockNumber).Return()
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, nil)
+	expectDispatch(postInfo, nil)
+	mockStorageAgenda.On("Get", blockNumber+5).Return(full, nil)
+
+	_, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventPeriodicFailed(moduleId, TaskAddress{When: blockNumber, Index: 0}, task.MaybeId))
+	mockStorageAgenda.AssertNotCalled(t, "Put", blockNumber+5, mock.Anything)
+}
+
+func Test_Module_OnInitialize_CallUnavailable(t *testing.T) {
+	target := setupModule()
+	task := newTestTask(0)
+	agenda := Agenda{sc.NewOption[Scheduled](task)}
+	mockStorageIncompleteSince.On("Exists").Return(false)
+	mockStorageAgenda.On("Get", blockNumber).Return(agenda, nil)
+	mockStorageAgenda.On("Put", blockNumber, agenda).Return()
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, assert.AnError)
+
+	_, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventCallUnavailable(moduleId, TaskAddress{When: blockNumber, Index: 0}, task.MaybeId))
+	mockStorageAgenda.AssertCalled(t, "Put", blockNumber, agenda)
+	mockStorageIncompleteSince.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_OnInitialize_PermanentlyOverweight(t *testing.T) {
+	target := setupModule()
+	task := newTestTask(0)
+	agenda := Agenda{sc.NewOption[Scheduled](task)}
+	mockStorageIncompleteSince.On("Exists").Return(false)
+	mockStorageAgenda.On("Get", blockNumber).Return(agenda, nil)
+	mockStorageAgenda.On("Put", blockNumber, agenda).Return()
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, nil)
+	expectCallWeight(maximumWeight)
+
+	_, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventPermanentlyOverweight(moduleId, TaskAddress{When: blockNumber, Index: 0}, task.MaybeId))
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+	mockStorageIncompleteSince.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_OnInitialize_Postponed(t *testing.T) {
+	target := setupModule()
+	target.maximumWeight = serviceAgendasBaseWeight(dbWeight).
+		Add(serviceAgendaBaseWeight(dbWeight, maxScheduledPerBlock)).
+		Add(serviceTaskWeight(dbWeight, false, false)).
+		Add(executeDispatchWeight(true)).
+		Add(callWeight)
+	first, second := newTestTask(0), newTestTask(1)
+	mockStorageIncompleteSince.On("Exists").Return(false)
+	mockStorageAgenda.On("Get", blockNumber).Return(Agenda{sc.NewOption[Scheduled](first), sc.NewOption[Scheduled](second)}, nil)
+	mockStorageAgenda.On("Put", blockNumber, mock.Anything).Return()
+	mockStorageIncompleteSince.On("Put", blockNumber).Return()
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, nil)
+	expectDispatch(postInfo, nil)
+
+	_, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	mockCall.AssertNumberOfCalls(t, "Dispatch", 1)
+	mockStorageAgenda.AssertCalled(t, "Put", blockNumber, Agenda{sc.NewOption[Scheduled](nil), sc.NewOption[Scheduled](second)})
+	mockStorageIncompleteSince.AssertCalled(t, "Put", blockNumber)
+}
+
+func Test_Module_OnInitialize_IncompleteSince(t *testing.T) {
+	target := setupModule()
+	mockStorageIncompleteSince.On("Exists").Return(true)
+	mockStorageIncompleteSince.On("Take").Return(blockNumber-1, nil)
+	mockStorageAgenda.On("Get", mock.Anything).Return(Agenda{}, nil)
+	mockStorageAgenda.On("Remove", mock.Anything).Return()
+
+	_, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	mockStorageAgenda.AssertCalled(t, "Get", blockNumber-1)
+	mockStorageAgenda.AssertCalled(t, "Get", blockNumber)
+	mockStorageIncompleteSince.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_OnInitialize_NotEnoughWeight(t *testing.T) {
+	target := setupModule()
+	target.maximumWeight = serviceAgendasBaseWeight(dbWeight)
+	mockStorageIncompleteSince.On("Exists").Return(false)
+	mockStorageIncompleteSince.On("Put", blockNumber).Return()
+
+	result, err := target.OnInitialize(blockNumber)
+
+	assert.NoError(t, err)
+	assert.Equal(t, serviceAgendasBaseWeight(dbWeight), result)
+	mockStorageAgenda.AssertNotCalled(t, "Get", mock.Anything)
+	mockStorageIncompleteSince.AssertCalled(t, "Put", blockNumber)
+}
+
+func Test_Module_doSchedule(t *testing.T) {
+	target := setupModule()
+	task := newTestTask(0)
+	task.MaybePeriodic = sc.NewOption[Period](Period{Interval: 2, Count: 2})
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+	mockCall.On("Bytes").Return(callBytes)
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{}, nil)
+	mockStorageAgenda.On("Put", sc.U64(15), mock.Anything).Return()
+
+	result, err := target.doSchedule(15, sc.NewOption[Period](Period{Interval: 2, Count: 3}), 0, signedCaller, mockCall)
+
+	assert.NoError(t, err)
+	assert.Equal(t, TaskAddress{When: 15, Index: 0}, result)
+	mockStorageAgenda.AssertCalled(t, "Put", sc.U64(15), Agenda{sc.NewOption[Scheduled](task)})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventScheduled(moduleId, 15, 0))
+}
+
+func Test_Module_doSchedule_TargetBlockNumberInPast(t *testing.T) {
+	target := setupModule()
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+
+	_, err := target.doSchedule(blockNumber, sc.NewOption[Period](nil), 0, signedCaller, mockCall)
+
+	assert.Equal(t, NewDispatchErrorTargetBlockNumberInPast(moduleId), err)
+}
+
+func Test_Module_doSchedule_CallTooLarge(t *testing.T) {
+	target := setupModule()
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+	mockCall.On("Bytes").Return(make([]byte, maxCallSize+1))
+
+	_, err := target.doSchedule(15, sc.NewOption[Period](nil), 0, signedCaller, mockCall)
+
+	assert.Equal(t, NewDispatchErrorFailedToSchedule(moduleId), err)
+	mockStorageAgenda.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_doScheduleNamed(t *testing.T) {
+	target := setupModule()
+	task := newTestTask(0)
+	task.MaybeId = sc.NewOption[TaskName](taskName)
+	mockStorageLookup.On("Exists", taskName).Return(false)
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+	mockCall.On("Bytes").Return(callBytes)
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{sc.NewOption[Scheduled](nil)}, nil)
+	mockStorageAgenda.On("Put", sc.U64(15), mock.Anything).Return()
+	mockStorageLookup.On("Put", taskName, TaskAddress{When: 15, Index: 1}).Return()
+
+	result, err := target.doScheduleNamed(taskName, 15, sc.NewOption[Period](nil), 0, signedCaller, mockCall)
+
+	assert.NoError(t, err)
+	assert.Equal(t, TaskAddress{When: 15, Index: 1}, result)
+	mockStorageAgenda.AssertCalled(t, "Put", sc.U64(15), Agenda{sc.NewOption[Scheduled](nil), sc.NewOption[Scheduled](task)})
+	mockStorageLookup.AssertCalled(t, "Put", taskName, TaskAddress{When: 15, Index: 1})
+}
+
+func Test_Module_doScheduleNamed_FailedToSchedule(t *testing.T) {
+	target := setupModule()
+	mockStorageLookup.On("Exists", taskName).Return(true)
+
+	_, err := target.doScheduleNamed(taskName, 15, sc.NewOption[Period](nil), 0, signedCaller, mockCall)
+
+	assert.Equal(t, NewDispatchErrorFailedToSchedule(moduleId), err)
+}
+
+func Test_Module_doScheduleNamed_CallTooLarge(t *testing.T) {
+	target := setupModule()
+	mockStorageLookup.On("Exists", taskName).Return(false)
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+	mockCall.On("Bytes").Return(make([]byte, maxCallSize+1))
+
+	_, err := target.doScheduleNamed(taskName, 15, sc.NewOption[Period](nil), 0, signedCaller, mockCall)
+
+	assert.Equal(t, NewDispatchErrorFailedToSchedule(moduleId), err)
+	mockStorageLookup.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_pushToAgenda_FullTakesEmptySlot(t *testing.T) {
+	target := setupModule()
+	task := newTestTask(0)
+	agenda := Agenda{sc.NewOption[Scheduled](task), sc.NewOption[Scheduled](nil), sc.NewOption[Scheduled](task)}
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(agenda, nil)
+	mockStorageAgenda.On("Put", sc.U64(15), mock.Anything).Return()
+
+	result, err := target.pushToAgenda(15, task)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U32(1), result)
+	mockStorageAgenda.AssertCalled(t, "Put", sc.U64(15), Agenda{sc.NewOption[Scheduled](task), sc.NewOption[Scheduled](task), sc.NewOption[Scheduled](task)})
+}
+
+func Test_Module_pushToAgenda_Exhausted(t *testing.T) {
+	target := setupModule()
+	task := newTestTask(0)
+	agenda := Agenda{sc.NewOption[Scheduled](task), sc.NewOption[Scheduled](task), sc.NewOption[Scheduled](task)}
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(agenda, nil)
+
+	_, err := target.pushToAgenda(15, task)
+
+	assert.Equal(t, primitives.NewDispatchErrorExhausted(), err)
+	mockStorageAgenda.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_doCancel(t *testing.T) {
+	target := setupModule()
+	task := newTestTask(0)
+	task.MaybeId = sc.NewOption[TaskName](taskName)
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{sc.NewOption[Scheduled](task), sc.NewOption[Scheduled](task)}, nil)
+	mockStorageAgenda.On("Put", sc.U64(15), mock.Anything).Return()
+	mockStorageLookup.On("Remove", taskName).Return()
+
+	err := target.doCancel(signedCaller, 15, 1)
+
+	assert.NoError(t, err)
+	mockStorageAgenda.AssertCalled(t, "Put", sc.U64(15), Agenda{sc.NewOption[Scheduled](task)})
+	mockStorageLookup.AssertCalled(t, "Remove", taskName)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventCanceled(moduleId, 15, 1))
+}
+
+func Test_Module_doCancel_Root(t *testing.T) {
+	target := setupModule()
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{sc.NewOption[Scheduled](newTestTask(0))}, nil)
+	mockStorageAgenda.On("Remove", sc.U64(15)).Return()
+
+	err := target.doCancel(rootCaller, 15, 0)
+
+	assert.NoError(t, err)
+	mockStorageAgenda.AssertCalled(t, "Remove", sc.U64(15))
+}
+
+func Test_Module_doCancel_BadOrigin(t *testing.T) {
+	target := setupModule()
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{sc.NewOption[Scheduled](newTestTask(0))}, nil)
+
+	err := target.doCancel(otherCaller, 15, 0)
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageAgenda.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Module_doCancel_NotFound(t *testing.T) {
+	target := setupModule()
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{sc.NewOption[Scheduled](nil)}, nil)
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), target.doCancel(signedCaller, 15, 0))
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), target.doCancel(signedCaller, 15, 1))
+}
+
+func Test_Module_doCancelNamed(t *testing.T) {
+	target := setupModule()
+	task := newTestTask(0)
+	task.MaybeId = sc.NewOption[TaskName](taskName)
+	mockStorageLookup.On("Exists", taskName).Return(true)
+	mockStorageLookup.On("Get", taskName).Return(TaskAddress{When: 15, Index: 0}, nil)
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{sc.NewOption[Scheduled](task)}, nil)
+	mockStorageAgenda.On("Remove", sc.U64(15)).Return()
+	mockStorageLookup.On("Remove", taskName).Return()
+
+	err := target.doCancelNamed(signedCaller, taskName)
+
+	assert.NoError(t, err)
+	mockStorageAgenda.AssertCalled(t, "Remove", sc.U64(15))
+	mockStorageLookup.AssertCalled(t, "Remove", taskName)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventCanceled(moduleId, 15, 0))
+}
+
+func Test_Module_doCancelNamed_BadOrigin(t *testing.T) {
+	target := setupModule()
+	mockStorageLookup.On("Exists", taskName).Return(true)
+	mockStorageLookup.On("Get", taskName).Return(TaskAddress{When: 15, Index: 0}, nil)
+	mockStorageAgenda.On("Get", sc.U64(15)).Return(Agenda{sc.NewOption[Scheduled](newTestTask(0))}, nil)
+
+	err := target.doCancelNamed(otherCaller, taskName)
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockStorageLookup.AssertNotCalled(t, "Remove", mock.Anything)
+}
+
+func Test_Module_doCancelNamed_NotFound(t *testing.T) {
+	target := setupModule()
+	mockStorageLookup.On("Exists", taskName).Return(false)
+
+	err := target.doCancelNamed(signedCaller, taskName)
+
+	assert.Equal(t, NewDispatchErrorNotFound(moduleId), err)
+}
+
+func Test_Module_blockNumberAfter(t *testing.T) {
+	target := setupModule()
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+
+	result, err := target.blockNumberAfter(5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, blockNumber+6, result)
+}
+
+func Test_normalizePeriodic(t *testing.T) {
+	assert.Equal(t, sc.NewOption[Period](nil), normalizePeriodic(sc.NewOption[Period](nil)))
+	assert.Equal(t, sc.NewOption[Period](nil), normalizePeriodic(sc.NewOption[Period](Period{Interval: 5, Count: 1})))
+	assert.Equal(t, sc.NewOption[Period](nil), normalizePeriodic(sc.NewOption[Period](Period{Interval: 0, Count: 3})))
+	assert.Equal(t, sc.NewOption[Period](Period{Interval: 5, Count: 2}), normalizePeriodic(sc.NewOption[Period](Period{Interval: 5, Count: 3})))
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	result := target.Metadata()
+
+	assert.Equal(t, primitives.ModuleVersion14, result.Version)
+	assert.Equal(t, sc.Str("Scheduler"), result.ModuleV14.Name)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSchedulerCalls)), result.ModuleV14.Call)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSchedulerEvent)), result.ModuleV14.Event)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSchedulerErrors)), result.ModuleV14.Error)
+	assert.Equal(t, sc.Str("Scheduler"), result.ModuleV14.Storage.Value.Prefix)
+	assert.Equal(t, 3, len(result.ModuleV14.Storage.Value.Items))
+	assert.Equal(t, 2, len(result.ModuleV14.Constants))
+	assert.Equal(t, sc.U8(moduleId), result.ModuleV14.Index)
+}
+
+func setupModule() Module {
+	mockStorage = new(mocks.IoStorage)
+	mockTransactionBroker = new(mocks.IoTransactionBroker)
+	mockSystemModule = new(mocks.SystemModule)
+	mockRuntimeDecoder = new(mocks.RuntimeDecoder)
+	mockTransactional = new(mocks.IoTransactional[primitives.PostDispatchInfo])
+	mockStorageIncompleteSince = new(mocks.StorageValue[sc.U64])
+	mockStorageAgenda = new(mocks.StorageMap[sc.U64, Agenda])
+	mockStorageLookup = new(mocks.StorageMap[TaskName, TaskAddress])
+	mockCall = new(mocks.Call)
+
+	config := NewConfig(
+		mockStorage,
+		mockTransactionBroker,
+		dbWeight,
+		mockSystemModule,
+		mockRuntimeDecoder,
+		maximumWeight,
+		maxScheduledPerBlock,
+		signedOrRootOrigin,
+		maxCallSize,
+	)
+
+	target := New(moduleId, config, mdGenerator, log.NewLogger())
+	target.storage.IncompleteSince = mockStorageIncompleteSince
+	target.storage.Agenda = mockStorageAgenda
+	target.storage.Lookup = mockStorageLookup
+	target.transactional = mockTransactional
+
+	mockSystemModule.On("DepositEvent", mock.Anything)
+
+	return target
+}
+
+// newTestTask returns an anonymous task, scheduled by a signed origin.
+func newTestTask(priority sc.U8) Scheduled {
+	return Scheduled{
+		MaybeId:       sc.NewOption[TaskName](nil),
+		Priority:      priority,
+		Call:          sc.BytesToSequenceU8(callBytes),
+		MaybePeriodic: sc.NewOption[Period](nil),
+		Origin:        signedCaller,
+	}
+}
+
+// expectCallWeight expects the dispatch info of the call to be calculated.
+func expectCallWeight(weight primitives.Weight) {
+	mockCall.On("BaseWeight").Return(weight)
+	mockCall.On("WeighData", weight).Return(weight)
+	mockCall.On("ClassifyDispatch", weight).Return(primitives.NewDispatchClassNormal())
+	mockCall.On("PaysFee", weight).Return(primitives.PaysYes)
+}
+
+// expectDispatch expects the call to be dispatched on behalf of the signed origin in a new storage layer.
+func expectDispatch(result primitives.PostDispatchInfo, err error) {
+	expectCallWeight(callWeight)
+	mockCall.On("Args").Return(callArgs)
+	mockCall.On("Dispatch", signedOrigin, callArgs).Return(result, err)
+	mockTransactional.On("WithStorageLayer", mock.Anything).Run(func(args mock.Arguments) {
+		fn := args.Get(0).(func() (primitives.PostDispatchInfo, error))
+		fn()
+	}).Return(result, err)
+}
+
+func dispatchOutcomeOk() primitives.DispatchOutcome {
+	outcome, _ := primitives.NewDispatchOutcome(sc.Empty{})
+	return outcome
+}
@@ -0,0 +1,11 @@
+package scheduler
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func serviceAgendasBaseWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(4000000, 0).
+		SaturatingAdd(dbWeight.Reads(1)).
+		SaturatingAdd(dbWeight.Writes(1))
+}
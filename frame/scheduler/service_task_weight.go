@@ -0,0 +1,18 @@
+package scheduler
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func serviceTaskWeight(dbWeight primitives.RuntimeDbWeight, named bool, periodic bool) primitives.Weight {
+	weight := primitives.WeightFromParts(3000000, 0)
+	if named {
+		weight = weight.
+			SaturatingAdd(primitives.WeightFromParts(4000000, 0)).
+			SaturatingAdd(dbWeight.Writes(1))
+	}
+	if periodic {
+		weight = weight.SaturatingAdd(primitives.WeightFromParts(3000000, 0))
+	}
+	return weight
+}
@@ -0,0 +1,34 @@
+package scheduler
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/io"
+)
+
+var (
+	keyScheduler       = []byte("Scheduler")
+	keyIncompleteSince = []byte("IncompleteSince")
+	keyAgenda          = []byte("Agenda")
+	keyLookup          = []byte("Lookup")
+)
+
+var (
+	defaultAgenda = Agenda{}
+)
+
+type storage struct {
+	IncompleteSince support.StorageValue[sc.U64]
+	Agenda          support.StorageMap[sc.U64, Agenda]
+	Lookup          support.StorageMap[TaskName, TaskAddress]
+}
+
+func newStorage(s io.Storage) *storage {
+	hashing := io.NewHashing()
+
+	return &storage{
+		IncompleteSince: support.NewHashStorageValue(s, keyScheduler, keyIncompleteSince, sc.DecodeU64),
+		Agenda:          support.NewHashStorageMapWithDefault[sc.U64, Agenda](s, keyScheduler, keyAgenda, hashing.Twox64, DecodeAgenda, &defaultAgenda),
+		Lookup:          support.NewHashStorageMap[TaskName, TaskAddress](s, keyScheduler, keyLookup, hashing.Twox64, DecodeTaskAddress),
+	}
+}
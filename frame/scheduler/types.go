@@ -0,0 +1,147 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// taskNameLength is the length of the name of a named task.
+	taskNameLength = 32
+)
+
+// TaskName is the unique name of a named task.
+type TaskName = sc.FixedSequence[sc.U8]
+
+func DecodeTaskName(buffer *bytes.Buffer) (TaskName, error) {
+	return sc.DecodeFixedSequence[sc.U8](taskNameLength, buffer)
+}
+
+// TaskAddress is the location of a task in the agenda of a block.
+type TaskAddress struct {
+	// When is the block number of the agenda.
+	When sc.U64
+	// Index is the index of the task in the agenda.
+	Index sc.U32
+}
+
+func (ta TaskAddress) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, ta.When, ta.Index)
+}
+
+func DecodeTaskAddress(buffer *bytes.Buffer) (TaskAddress, error) {
+	when, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return TaskAddress{}, err
+	}
+	index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return TaskAddress{}, err
+	}
+
+	return TaskAddress{
+		When:  when,
+		Index: index,
+	}, nil
+}
+
+func (ta TaskAddress) Bytes() []byte {
+	return sc.EncodedBytes(ta)
+}
+
+// Period describes the repetitions of a periodic task.
+type Period struct {
+	// Interval is the number of blocks between two dispatches of the task.
+	Interval sc.U64
+	// Count is the number of remaining dispatches of the task.
+	Count sc.U32
+}
+
+func (p Period) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, p.Interval, p.Count)
+}
+
+func DecodePeriod(buffer *bytes.Buffer) (Period, error) {
+	interval, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return Period{}, err
+	}
+	count, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return Period{}, err
+	}
+
+	return Period{
+		Interval: interval,
+		Count:    count,
+	}, nil
+}
+
+func (p Period) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+// Scheduled is a task, which dispatches a call on behalf of an origin at a given block.
+type Scheduled struct {
+	// MaybeId is the name of the task, if it is named.
+	MaybeId sc.Option[TaskName]
+	// Priority of the task. Tasks with a lower value are dispatched first.
+	Priority sc.U8
+	// Call is the encoded call to be dispatched.
+	Call sc.Sequence[sc.U8]
+	// MaybePeriodic describes the repetitions of the task, if it is periodic.
+	MaybePeriodic sc.Option[Period]
+	// Origin is the origin, on behalf of which the call is dispatched.
+	Origin primitives.OriginCaller
+}
+
+func (s Scheduled) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, s.MaybeId, s.Priority, s.Call, s.MaybePeriodic, s.Origin)
+}
+
+func DecodeScheduled(buffer *bytes.Buffer) (Scheduled, error) {
+	maybeId, err := sc.DecodeOptionWith(buffer, DecodeTaskName)
+	if err != nil {
+		return Scheduled{}, err
+	}
+	priority, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return Scheduled{}, err
+	}
+	call, err := sc.DecodeSequence[sc.U8](buffer)
+	if err != nil {
+		return Scheduled{}, err
+	}
+	maybePeriodic, err := sc.DecodeOptionWith(buffer, DecodePeriod)
+	if err != nil {
+		return Scheduled{}, err
+	}
+	origin, err := primitives.DecodeOriginCaller(buffer)
+	if err != nil {
+		return Scheduled{}, err
+	}
+
+	return Scheduled{
+		MaybeId:       maybeId,
+		Priority:      priority,
+		Call:          call,
+		MaybePeriodic: maybePeriodic,
+		Origin:        origin,
+	}, nil
+}
+
+func (s Scheduled) Bytes() []byte {
+	return sc.EncodedBytes(s)
+}
+
+// Agenda contains the tasks scheduled for a block. Cancelled and dispatched tasks leave an empty slot,
+// so that the addresses of the other tasks remain valid.
+type Agenda = sc.Sequence[sc.Option[Scheduled]]
+
+func DecodeAgenda(buffer *bytes.Buffer) (Agenda, error) {
+	return sc.DecodeSequenceWith(buffer, func(buffer *bytes.Buffer) (sc.Option[Scheduled], error) {
+		return sc.DecodeOptionWith(buffer, DecodeScheduled)
+	})
+}
@@ -34,6 +34,10 @@ type Module interface {
 	CurrentIndex() (sc.U32, error)
 	Validators() (sc.Sequence[primitives.AccountId], error)
 	IsDisabled(index sc.U32) (bool, error)
+	DisableIndex(index sc.U32) (bool, error)
+	Disable(who primitives.AccountId) (bool, error)
+	LoadKeys(who primitives.AccountId) (sc.Option[sc.Sequence[primitives.SessionKey]], error)
+	KeyOwner(key primitives.SessionKey) (sc.Option[primitives.AccountId], error)
 	DecodeKeys(buffer *bytes.Buffer) (sc.FixedSequence[primitives.Sr25519PublicKey], error)
 
 	AppendHandlers(module sessiontypes.OneSessionHandler)
@@ -250,6 +254,86 @@ func (m module) IsDisabled(index sc.U32) (bool, error) {
 	return false, nil
 }
 
+// DisableIndex disables the validator at index `index` until the end of the current era.
+// Returns `false` if the validator was already disabled.
+func (m module) DisableIndex(index sc.U32) (bool, error) {
+	disabledValidators, err := m.storage.DisabledValidators.Get()
+	if err != nil {
+		return false, err
+	}
+
+	position := len(disabledValidators)
+	for i, disabledValidator := range disabledValidators {
+		if disabledValidator == index {
+			return false, nil
+		}
+		if disabledValidator > index {
+			position = i
+			break
+		}
+	}
+
+	result := make(sc.Sequence[sc.U32], 0, len(disabledValidators)+1)
+	result = append(result, disabledValidators[:position]...)
+	result = append(result, index)
+	result = append(result, disabledValidators[position:]...)
+	m.storage.DisabledValidators.Put(result)
+
+	m.handler.OnDisabled(index)
+
+	return true, nil
+}
+
+// Disable disables the validator `who` until the end of the current era.
+// Returns `false` if `who` is not an active validator or was already disabled.
+func (m module) Disable(who primitives.AccountId) (bool, error) {
+	validators, err := m.storage.Validators.Get()
+	if err != nil {
+		return false, err
+	}
+
+	for i, validator := range validators {
+		if reflect.DeepEqual(validator, who) {
+			return m.DisableIndex(sc.U32(i))
+		}
+	}
+
+	return false, nil
+}
+
+// LoadKeys returns the next session keys of `who`, if any.
+func (m module) LoadKeys(who primitives.AccountId) (sc.Option[sc.Sequence[primitives.SessionKey]], error) {
+	if !m.storage.NextKeys.Exists(who) {
+		return sc.NewOption[sc.Sequence[primitives.SessionKey]](nil), nil
+	}
+
+	nextKeys, err := m.storage.NextKeys.Get(who)
+	if err != nil {
+		return sc.Option[sc.Sequence[primitives.SessionKey]]{}, err
+	}
+
+	sessionKeys, err := toSessionKeys(m.handler.KeyTypeIds(), nextKeys)
+	if err != nil {
+		return sc.Option[sc.Sequence[primitives.SessionKey]]{}, err
+	}
+
+	return sc.NewOption[sc.Sequence[primitives.SessionKey]](sessionKeys), nil
+}
+
+// KeyOwner returns the owner of the session key `key`, if any.
+func (m module) KeyOwner(key primitives.SessionKey) (sc.Option[primitives.AccountId], error) {
+	if !m.storage.KeyOwner.Exists(key) {
+		return sc.NewOption[primitives.AccountId](nil), nil
+	}
+
+	owner, err := m.storage.KeyOwner.Get(key)
+	if err != nil {
+		return sc.Option[primitives.AccountId]{}, err
+	}
+
+	return sc.NewOption[primitives.AccountId](owner), nil
+}
+
 func (m module) StorageDisabledValidators() (sc.Sequence[sc.U32], error) {
 	return m.storage.DisabledValidators.Get()
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 const (
@@ -382,6 +383,108 @@ func Test_Module_IsDisabled_NotFound(t *testing.T) {
 	mockStorageDisabledValidators.AssertCalled(t, "Get")
 }
 
+func Test_Module_DisableIndex(t *testing.T) {
+	target := setupModule()
+
+	mockStorageDisabledValidators.On("Get").Return(sc.Sequence[sc.U32]{sc.U32(1), sc.U32(5)}, nil)
+	mockStorageDisabledValidators.On("Put", sc.Sequence[sc.U32]{sc.U32(1), sc.U32(3), sc.U32(5)}).Return()
+	mockSessionHandler.On("OnDisabled", sc.U32(3)).Return()
+
+	result, err := target.DisableIndex(sc.U32(3))
+	assert.Nil(t, err)
+	assert.Equal(t, true, result)
+
+	mockStorageDisabledValidators.AssertCalled(t, "Put", sc.Sequence[sc.U32]{sc.U32(1), sc.U32(3), sc.U32(5)})
+	mockSessionHandler.AssertCalled(t, "OnDisabled", sc.U32(3))
+}
+
+func Test_Module_DisableIndex_AlreadyDisabled(t *testing.T) {
+	target := setupModule()
+
+	mockStorageDisabledValidators.On("Get").Return(sc.Sequence[sc.U32]{sc.U32(5)}, nil)
+
+	result, err := target.DisableIndex(sc.U32(5))
+	assert.Nil(t, err)
+	assert.Equal(t, false, result)
+
+	mockStorageDisabledValidators.AssertNotCalled(t, "Put", mock.Anything)
+	mockSessionHandler.AssertNotCalled(t, "OnDisabled", mock.Anything)
+}
+
+func Test_Module_Disable(t *testing.T) {
+	target := setupModule()
+
+	mockStorageValidators.On("Get").Return(sc.Sequence[primitives.AccountId]{constants.OneAccountId, constants.TwoAccountId}, nil)
+	mockStorageDisabledValidators.On("Get").Return(sc.Sequence[sc.U32]{}, nil)
+	mockStorageDisabledValidators.On("Put", sc.Sequence[sc.U32]{sc.U32(1)}).Return()
+	mockSessionHandler.On("OnDisabled", sc.U32(1)).Return()
+
+	result, err := target.Disable(constants.TwoAccountId)
+	assert.Nil(t, err)
+	assert.Equal(t, true, result)
+
+	mockSessionHandler.AssertCalled(t, "OnDisabled", sc.U32(1))
+}
+
+func Test_Module_Disable_NotValidator(t *testing.T) {
+	target := setupModule()
+
+	mockStorageValidators.On("Get").Return(sc.Sequence[primitives.AccountId]{constants.OneAccountId}, nil)
+
+	result, err := target.Disable(constants.TwoAccountId)
+	assert.Nil(t, err)
+	assert.Equal(t, false, result)
+
+	mockStorageDisabledValidators.AssertNotCalled(t, "Get")
+}
+
+func Test_Module_LoadKeys(t *testing.T) {
+	target := setupModule()
+
+	mockStorageNextKeys.On("Exists", constants.OneAccountId).Return(true)
+	mockStorageNextKeys.On("Get", constants.OneAccountId).Return(nextKeys, nil)
+	mockSessionHandler.On("KeyTypeIds").Return(keyTypeIds)
+
+	result, err := target.LoadKeys(constants.OneAccountId)
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewOption[sc.Sequence[primitives.SessionKey]](sessionKeys), result)
+}
+
+func Test_Module_LoadKeys_None(t *testing.T) {
+	target := setupModule()
+
+	mockStorageNextKeys.On("Exists", constants.OneAccountId).Return(false)
+
+	result, err := target.LoadKeys(constants.OneAccountId)
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewOption[sc.Sequence[primitives.SessionKey]](nil), result)
+
+	mockStorageNextKeys.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_KeyOwner(t *testing.T) {
+	target := setupModule()
+
+	mockStorageKeyOwner.On("Exists", sessionKey).Return(true)
+	mockStorageKeyOwner.On("Get", sessionKey).Return(constants.OneAccountId, nil)
+
+	result, err := target.KeyOwner(sessionKey)
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewOption[primitives.AccountId](constants.OneAccountId), result)
+}
+
+func Test_Module_KeyOwner_None(t *testing.T) {
+	target := setupModule()
+
+	mockStorageKeyOwner.On("Exists", sessionKey).Return(false)
+
+	result, err := target.KeyOwner(sessionKey)
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewOption[primitives.AccountId](nil), result)
+
+	mockStorageKeyOwner.AssertNotCalled(t, "Get", mock.Anything)
+}
+
 func Test_Module_StorageDisabledValidators(t *testing.T) {
 	expect := sc.Sequence[sc.U32]{sc.U32(5)}
 	target := setupModule()
@@ -32,7 +32,7 @@ type handler struct {
 }
 
 func NewHandler(modules []session.OneSessionHandler) Handler {
-	return handler{modules: modules}
+	return &handler{modules: modules}
 }
 
 // KeyTypeIds returns all the key type ids this session can process.
@@ -137,6 +137,6 @@ func takeOutKeys(module session.OneSessionHandler, validators sc.Sequence[queued
 	return result, nil
 }
 
-func (h handler) AppendHandlers(module session.OneSessionHandler) {
+func (h *handler) AppendHandlers(module session.OneSessionHandler) {
 	h.modules = append(h.modules, module)
 }
@@ -131,6 +131,22 @@ func Test_Handler_OnDisabled(t *testing.T) {
 	mockOneSessionHandler.AssertCalled(t, "OnDisabled", validatorIndex)
 }
 
+func Test_Handler_AppendHandlers(t *testing.T) {
+	otherKeyTypeId := [4]byte{'o', 't', 'h', 'r'}
+	otherOneSessionHandler := new(mocks.OneSessionHandler)
+	target := setupHandler()
+
+	mockOneSessionHandler.On("KeyTypeId").Return(keyTypeId)
+	otherOneSessionHandler.On("KeyTypeId").Return(otherKeyTypeId)
+
+	target.AppendHandlers(otherOneSessionHandler)
+
+	assert.Equal(t, sc.Sequence[sc.FixedSequence[sc.U8]]{
+		sc.BytesToFixedSequenceU8(keyTypeId[:]),
+		sc.BytesToFixedSequenceU8(otherKeyTypeId[:]),
+	}, target.KeyTypeIds())
+}
+
 func setupHandler() Handler {
 	mockOneSessionHandler = new(mocks.OneSessionHandler)
 
@@ -1,15 +1,29 @@
 package session_historical
 
 import (
+	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
+// FullIdentificationOf converts a validator into its full identification, i.e. its exposure.
+type FullIdentificationOf interface {
+	ExposureOf(validator primitives.AccountId) (sc.Option[staking.Exposure], error)
+}
+
 type Config struct {
-	SessionModule session.Module
+	Storage io.Storage
+	// SessionManager is the inner session manager, whose new validator sets are noted in the historical sessions.
+	SessionManager       session.Manager
+	FullIdentificationOf FullIdentificationOf
 }
 
-func NewConfig(sessionModule session.Module) *Config {
+func NewConfig(storage io.Storage, sessionManager session.Manager, fullIdentificationOf FullIdentificationOf) *Config {
 	return &Config{
-		SessionModule: sessionModule,
+		Storage:              storage,
+		SessionManager:       sessionManager,
+		FullIdentificationOf: fullIdentificationOf,
 	}
 }
@@ -0,0 +1,22 @@
+package session_historical
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockFullIdentificationOf struct {
+	mock.Mock
+}
+
+func (m *MockFullIdentificationOf) ExposureOf(validator primitives.AccountId) (sc.Option[staking.Exposure], error) {
+	args := m.Called(validator)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(sc.Option[staking.Exposure]), args.Get(1).(error)
+	}
+
+	return args.Get(0).(sc.Option[staking.Exposure]), nil
+}
@@ -0,0 +1,55 @@
+package session_historical
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func (m module) Metadata() primitives.MetadataModule {
+	dataV14 := primitives.MetadataModuleV14{
+		Name:      m.name(),
+		Storage:   m.metadataStorage(),
+		Call:      sc.NewOption[sc.Compact](nil),
+		CallDef:   sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+		Event:     sc.NewOption[sc.Compact](nil),
+		EventDef:  sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](nil),
+		ErrorDef:  sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+		Index:     m.index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"HistoricalSessions",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.PrimitiveTypesU32),
+					sc.ToCompact(metadata.TypesTupleH256U32)),
+				"Mapping from historical session indices to session-data root hash and validator count."),
+		},
+	})
+}
+
+func (m module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataType(metadata.TypesTupleH256U32, "(H256, ValidatorCount)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.TypesH256),
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+			})),
+	}
+}
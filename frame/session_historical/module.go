@@ -16,7 +16,9 @@ const name = sc.Str("SessionHistorical")
 
 type Module interface {
 	primitives.Module
+	session.Manager
 
+	SetSessionModule(sessionModule session.Module)
 	Prove(key [4]byte, authorityId primitives.AccountId) sc.Option[sessiontypes.MembershipProof]
 	CheckProof(key [4]byte, authorityId primitives.AccountId, proof sessiontypes.MembershipProof) sc.Option[IdentificationTuple]
 }
@@ -24,29 +26,44 @@ type Module interface {
 type module struct {
 	primitives.DefaultInherentProvider
 	hooks.DefaultDispatchModule
-	index         sc.U8
-	config        *Config
-	storage       *storage
-	constants     *consts
-	sessionModule session.Module
-	mdGenerator   *primitives.MetadataTypeGenerator
-	logger        log.RuntimeLogger
+	index                sc.U8
+	config               *Config
+	storage              *storage
+	constants            *consts
+	sessionModule        *sessionModuleRef
+	sessionManager       session.Manager
+	fullIdentificationOf FullIdentificationOf
+	mdGenerator          *primitives.MetadataTypeGenerator
+	logger               log.RuntimeLogger
 }
 
 func New(index sc.U8, config *Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.RuntimeLogger) Module {
-	storage := newStorage()
+	storage := newStorage(config.Storage)
 
 	return module{
-		index:         index,
-		config:        config,
-		storage:       storage,
-		constants:     newConstants(),
-		sessionModule: config.SessionModule,
-		mdGenerator:   mdGenerator,
-		logger:        logger,
+		index:                index,
+		config:               config,
+		storage:              storage,
+		constants:            newConstants(),
+		sessionModule:        &sessionModuleRef{},
+		sessionManager:       config.SessionManager,
+		fullIdentificationOf: config.FullIdentificationOf,
+		mdGenerator:          mdGenerator,
+		logger:               logger,
 	}
 }
 
+// sessionModuleRef references the session module, which is set after construction, as the session module itself
+// depends on the historical module as its session manager.
+type sessionModuleRef struct {
+	session.Module
+}
+
+// SetSessionModule sets the session module, whose validators and keys are noted in the historical sessions.
+func (m module) SetSessionModule(sessionModule session.Module) {
+	m.sessionModule.Module = sessionModule
+}
+
 func (m module) GetIndex() sc.U8 {
 	return m.index
 }
@@ -88,97 +105,220 @@ func (m module) OnBeforeSessionEnding() {}
 
 func (m module) OnDisabled(validatorIndex sc.U32) {}
 
-func (m module) Metadata() primitives.MetadataModule {
-	dataV14 := primitives.MetadataModuleV14{
-		Name:      m.name(),
-		Storage:   sc.Option[primitives.MetadataModuleStorage]{},
-		Call:      sc.NewOption[sc.Compact](nil),
-		CallDef:   sc.NewOption[primitives.MetadataDefinitionVariant](nil),
-		Event:     sc.NewOption[sc.Compact](nil),
-		EventDef:  sc.NewOption[primitives.MetadataDefinitionVariant](nil),
-		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
-		Error:     sc.NewOption[sc.Compact](nil),
-		ErrorDef:  sc.NewOption[primitives.MetadataDefinitionVariant](nil),
-		Index:     m.index,
+// NewSession notes the root of the proving trie of the new validators of session `newIndex`,
+// as returned by the inner session manager.
+func (m module) NewSession(newIndex sc.U32) sc.Option[sc.Sequence[primitives.AccountId]] {
+	return m.doNewSession(newIndex, false)
+}
+
+// NewSessionGenesis notes the root of the proving trie of the genesis validators of session `newIndex`.
+func (m module) NewSessionGenesis(newIndex sc.U32) sc.Option[sc.Sequence[primitives.AccountId]] {
+	return m.doNewSession(newIndex, true)
+}
+
+func (m module) EndSession(index sc.U32) {
+	m.sessionManager.EndSession(index)
+}
+
+func (m module) StartSession(index sc.U32) {
+	m.sessionManager.StartSession(index)
+}
+
+func (m module) doNewSession(newIndex sc.U32, isGenesis bool) sc.Option[sc.Sequence[primitives.AccountId]] {
+	var newValidators sc.Option[sc.Sequence[primitives.AccountId]]
+	if isGenesis {
+		newValidators = m.sessionManager.NewSessionGenesis(newIndex)
+	} else {
+		newValidators = m.sessionManager.NewSession(newIndex)
+	}
+
+	if !newValidators.HasValue {
+		// the validator set is unchanged, so is the root of the previous session.
+		previousIndex := sc.SaturatingSubU32(newIndex, 1)
+		if m.storage.HistoricalSessions.Exists(previousIndex) {
+			previousSession, err := m.storage.HistoricalSessions.Get(previousIndex)
+			if err != nil {
+				m.logger.Critical(err.Error())
+			}
+			m.storage.HistoricalSessions.Put(newIndex, previousSession)
+		}
+
+		return newValidators
 	}
 
-	return primitives.MetadataModule{
-		Version:   primitives.ModuleVersion14,
-		ModuleV14: dataV14,
+	members, err := m.sessionMembers(newValidators.Value)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	trie, err := generateProvingTrie(members)
+	if err != nil {
+		m.logger.Warnf("failed to generate historical ancestry-inclusion proof: [%s]", err.Error())
+		return newValidators
 	}
+
+	m.storage.HistoricalSessions.Put(newIndex, HistoricalSession{
+		Root:           trie.root,
+		ValidatorCount: sc.U32(len(members)),
+	})
+
+	return newValidators
 }
 
-// A trie instance for checking and generating proofs.
-type ProvingTrie struct {
-	// db   MemoryDB // TODO
-	root primitives.H256
+// sessionMembers returns the `validators`, which have a full identification, together with their session keys.
+func (m module) sessionMembers(validators sc.Sequence[primitives.AccountId]) ([]sessionMember, error) {
+	members := make([]sessionMember, 0, len(validators))
+
+	for _, validator := range validators {
+		fullIdentification, err := m.fullIdentificationOf.ExposureOf(validator)
+		if err != nil {
+			return nil, err
+		}
+		if !fullIdentification.HasValue {
+			continue
+		}
+
+		keys, err := m.sessionModule.LoadKeys(validator)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, sessionMember{
+			IdentificationTuple: IdentificationTuple{
+				Validator:          validator,
+				FullIdentification: fullIdentification.Value,
+			},
+			Keys: keys.Value,
+		})
+	}
+
+	return members, nil
 }
 
 // KeyOwnerProofSystem interface
 
+// Prove proves the membership of the owner of the session key `authorityId` of type `key` in the current session.
+//
+// This should typically only be called off-chain, since it is computationally heavy.
 func (m module) Prove(key [4]byte, authorityId primitives.AccountId) sc.Option[sessiontypes.MembershipProof] {
-	// sessionIndex, err := m.sessionModule.CurrentIndex()
-	// if err != nil {
-	// 	return sc.NewOption[sessiontypes.MembershipProof](nil)
-	// }
+	proof, err := m.prove(primitives.NewSessionKey(sc.FixedSequenceU8ToBytes(authorityId.FixedSequence), key))
+	if err != nil {
+		m.logger.Warnf("failed to prove key ownership: [%s]", err.Error())
+		return sc.NewOption[sessiontypes.MembershipProof](nil)
+	}
+
+	return proof
+}
+
+func (m module) prove(sessionKey primitives.SessionKey) (sc.Option[sessiontypes.MembershipProof], error) {
+	sessionIndex, err := m.sessionModule.CurrentIndex()
+	if err != nil {
+		return sc.Option[sessiontypes.MembershipProof]{}, err
+	}
+
+	validators, err := m.sessionModule.Validators()
+	if err != nil {
+		return sc.Option[sessiontypes.MembershipProof]{}, err
+	}
+
+	members, err := m.sessionMembers(validators)
+	if err != nil {
+		return sc.Option[sessiontypes.MembershipProof]{}, err
+	}
+
+	trie, err := generateProvingTrie(members)
+	if err != nil {
+		return sc.Option[sessiontypes.MembershipProof]{}, err
+	}
+
+	trieNodes, err := trie.prove(sessionKey)
+	if err != nil {
+		return sc.Option[sessiontypes.MembershipProof]{}, err
+	}
+	if !trieNodes.HasValue {
+		return sc.NewOption[sessiontypes.MembershipProof](nil), nil
+	}
+
+	return sc.NewOption[sessiontypes.MembershipProof](sessiontypes.MembershipProof{
+		SessionIndex: sessionIndex,
+		TrieNodes:    trieNodes.Value,
+		Validators:   sc.U32(len(members)),
+	}), nil
+}
+
+// CheckProof checks the membership `proof` of the owner of the session key `authorityId` of type `key`.
+// Returns the identification of the owner, if the proof is valid and the session is recent enough to be checked.
+func (m module) CheckProof(key [4]byte, authorityId primitives.AccountId, proof sessiontypes.MembershipProof) sc.Option[IdentificationTuple] {
+	identification, err := m.checkProof(primitives.NewSessionKey(sc.FixedSequenceU8ToBytes(authorityId.FixedSequence), key), proof)
+	if err != nil {
+		m.logger.Debugf("invalid key ownership proof: [%s]", err.Error())
+		return sc.NewOption[IdentificationTuple](nil)
+	}
 
-	// validators, err := m.sessionModule.Validators()
-	// if err != nil {
-	// 	return sc.NewOption[sessiontypes.MembershipProof](nil)
-	// }
+	return identification
+}
 
-	// TODO: once we implement staking
-	// 	validators.into_iter()
-	// 	.filter_map(|validator| {
-	// 		T::FullIdentificationOf::convert(validator.clone())
-	// 			.map(|full_id| (validator, full_id))
-	// 	})
-	// 	.collect::<Vec<_>>();
+func (m module) checkProof(sessionKey primitives.SessionKey, proof sessiontypes.MembershipProof) (sc.Option[IdentificationTuple], error) {
+	currentIndex, err := m.sessionModule.CurrentIndex()
+	if err != nil {
+		return sc.Option[IdentificationTuple]{}, err
+	}
+
+	if proof.SessionIndex == currentIndex {
+		return m.checkCurrentSession(sessionKey, proof)
+	}
 
-	// count := sc.U32(len(validators))
+	if !m.storage.HistoricalSessions.Exists(proof.SessionIndex) {
+		return sc.NewOption[IdentificationTuple](nil), nil
+	}
 
-	// 	trie, err := ProvingTrieGenerateFor(validators)
-	// 	if err != nil {
-	// 		return sc.NewOption[session.MembershipProof](nil)
-	// 	}
+	historicalSession, err := m.storage.HistoricalSessions.Get(proof.SessionIndex)
+	if err != nil {
+		return sc.Option[IdentificationTuple]{}, err
+	}
 
-	// 	// (id, data) = key
+	if historicalSession.ValidatorCount != proof.Validators {
+		return sc.NewOption[IdentificationTuple](nil), nil
+	}
 
-	//	trie.prove(id, data.as_ref()).map(|trie_nodes| MembershipProof {
-	//		sessionIndex,
-	//		trie_nodes,
-	//		validator_count: count,
-	//	})
+	trie, err := provingTrieFromNodes(historicalSession.Root, proof.TrieNodes)
+	if err != nil {
+		return sc.Option[IdentificationTuple]{}, err
+	}
 
-	return sc.NewOption[sessiontypes.MembershipProof](nil)
+	return trie.query(sessionKey)
 }
 
-type IdentificationTuple = sc.Bool
+// checkCurrentSession checks the membership `proof` against the keys of the current session, which are still in
+// storage.
+func (m module) checkCurrentSession(sessionKey primitives.SessionKey, proof sessiontypes.MembershipProof) (sc.Option[IdentificationTuple], error) {
+	owner, err := m.sessionModule.KeyOwner(sessionKey)
+	if err != nil {
+		return sc.Option[IdentificationTuple]{}, err
+	}
+	if !owner.HasValue {
+		return sc.NewOption[IdentificationTuple](nil), nil
+	}
 
-func (m module) CheckProof(key [4]byte, authorityId primitives.AccountId, proof sessiontypes.MembershipProof) sc.Option[IdentificationTuple] {
-	// TODO:
-	// if proof.session == <Session<T>>::current_index() {
-	// 	<Session<T>>::key_owner(key, authorityId.as_ref()).and_then(|owner| {
-	// 		T::FullIdentificationOf::convert(owner.clone()).and_then(move |id| {
-	// 			let count = <Session<T>>::validators().len() as ValidatorCount;
-
-	// 			if count != proof.validator_count {
-	// 				return None
-	// 			}
-
-	// 			Some((owner, key))
-	// 		})
-	// 	})
-	// } else {
-	// 	let (root, count) = <HistoricalSessions<T>>::get(&proof.session)?;
-
-	// 	if count != proof.validator_count {
-	// 		return None
-	// 	}
-
-	// 	let trie = ProvingTrie::<T>::from_nodes(root, &proof.trie_nodes);
-	// 	trie.query(key, authorityId.as_ref())
-	// }
-
-	return sc.NewOption[IdentificationTuple](nil)
+	fullIdentification, err := m.fullIdentificationOf.ExposureOf(owner.Value)
+	if err != nil {
+		return sc.Option[IdentificationTuple]{}, err
+	}
+	if !fullIdentification.HasValue {
+		return sc.NewOption[IdentificationTuple](nil), nil
+	}
+
+	validators, err := m.sessionModule.Validators()
+	if err != nil {
+		return sc.Option[IdentificationTuple]{}, err
+	}
+
+	if sc.U32(len(validators)) != proof.Validators {
+		return sc.NewOption[IdentificationTuple](nil), nil
+	}
+
+	return sc.NewOption[IdentificationTuple](IdentificationTuple{
+		Validator:          owner.Value,
+		FullIdentification: fullIdentification.Value,
+	}), nil
 }
@@ -0,0 +1,321 @@
+package session_historical
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	sessiontypes "github.com/LimeChain/gosemble/primitives/session"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId = 9
+)
+
+var (
+	keyTypeId         = [4]byte{'g', 'r', 'a', 'n'}
+	validator         = constants.OneAccountId
+	otherValidator    = constants.TwoAccountId
+	authorityId, _    = primitives.NewAccountId(sc.BytesToSequenceU8(bytes.Repeat([]byte{0xaa}, 32))...)
+	otherAuthority, _ = primitives.NewAccountId(sc.BytesToSequenceU8(bytes.Repeat([]byte{0xbb}, 32))...)
+	sessionKey        = primitives.NewSessionKey(sc.FixedSequenceU8ToBytes(authorityId.FixedSequence), keyTypeId)
+	otherKey          = primitives.NewSessionKey(sc.FixedSequenceU8ToBytes(otherAuthority.FixedSequence), keyTypeId)
+	exposure          = staking.Exposure{
+		Total:  sc.NewU128(15),
+		Own:    sc.NewU128(10),
+		Others: sc.Sequence[staking.IndividualExposure]{{Who: constants.ZeroAccountId, Value: sc.NewU128(5)}},
+	}
+	otherExposure = staking.Exposure{
+		Total:  sc.NewU128(20),
+		Own:    sc.NewU128(20),
+		Others: sc.Sequence[staking.IndividualExposure]{},
+	}
+	identification = IdentificationTuple{Validator: validator, FullIdentification: exposure}
+	members        = []sessionMember{
+		{IdentificationTuple: identification, Keys: sc.Sequence[primitives.SessionKey]{sessionKey}},
+		{IdentificationTuple: IdentificationTuple{Validator: otherValidator, FullIdentification: otherExposure}, Keys: sc.Sequence[primitives.SessionKey]{otherKey}},
+	}
+	errPanic = errors.New("panic")
+)
+
+var (
+	mdGenerator = primitives.NewMetadataTypeGenerator()
+)
+
+var (
+	mockStorage                  *mocks.IoStorage
+	mockSessionModule            *mocks.SessionModule
+	mockSessionManager           *MockSessionManager
+	mockFullIdentificationOf     *MockFullIdentificationOf
+	mockStorageHistoricalSession *mocks.StorageMap[sc.U32, HistoricalSession]
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, 0, len(target.Functions()))
+}
+
+func Test_Module_NewSession(t *testing.T) {
+	newValidators := sc.NewOption[sc.Sequence[primitives.AccountId]](sc.Sequence[primitives.AccountId]{validator, otherValidator})
+	target := setupModule()
+	mockSessionManager.On("NewSession", sc.U32(2)).Return(newValidators)
+	expectMembers()
+	mockStorageHistoricalSession.On("Put", sc.U32(2), mock.Anything).Return()
+
+	result := target.NewSession(2)
+
+	assert.Equal(t, newValidators, result)
+	mockStorageHistoricalSession.AssertCalled(t, "Put", sc.U32(2), HistoricalSession{Root: expectRoot(t), ValidatorCount: 2})
+}
+
+func Test_Module_NewSession_SkipsUnidentifiedValidators(t *testing.T) {
+	newValidators := sc.NewOption[sc.Sequence[primitives.AccountId]](sc.Sequence[primitives.AccountId]{validator, otherValidator})
+	target := setupModule()
+	mockSessionManager.On("NewSession", sc.U32(2)).Return(newValidators)
+	mockFullIdentificationOf.On("ExposureOf", validator).Return(sc.NewOption[staking.Exposure](exposure), nil)
+	mockFullIdentificationOf.On("ExposureOf", otherValidator).Return(sc.NewOption[staking.Exposure](nil), nil)
+	mockSessionModule.On("LoadKeys", validator).Return(sc.NewOption[sc.Sequence[primitives.SessionKey]](sc.Sequence[primitives.SessionKey]{sessionKey}), nil)
+	mockStorageHistoricalSession.On("Put", sc.U32(2), mock.Anything).Return()
+
+	target.NewSession(2)
+
+	mockSessionModule.AssertNotCalled(t, "LoadKeys", otherValidator)
+	mockStorageHistoricalSession.AssertCalled(t, "Put", sc.U32(2), mock.MatchedBy(func(session HistoricalSession) bool {
+		return session.ValidatorCount == 1
+	}))
+}
+
+func Test_Module_NewSession_Unchanged(t *testing.T) {
+	previousSession := HistoricalSession{Root: primitives.H256{FixedSequence: constants.OneAccountId.FixedSequence}, ValidatorCount: 2}
+	target := setupModule()
+	mockSessionManager.On("NewSession", sc.U32(2)).Return(sc.NewOption[sc.Sequence[primitives.AccountId]](nil))
+	mockStorageHistoricalSession.On("Exists", sc.U32(1)).Return(true)
+	mockStorageHistoricalSession.On("Get", sc.U32(1)).Return(previousSession, nil)
+	mockStorageHistoricalSession.On("Put", sc.U32(2), previousSession).Return()
+
+	result := target.NewSession(2)
+
+	assert.Equal(t, sc.NewOption[sc.Sequence[primitives.AccountId]](nil), result)
+	mockStorageHistoricalSession.AssertCalled(t, "Put", sc.U32(2), previousSession)
+	mockFullIdentificationOf.AssertNotCalled(t, "ExposureOf", mock.Anything)
+}
+
+func Test_Module_NewSession_Unchanged_NoPreviousSession(t *testing.T) {
+	target := setupModule()
+	mockSessionManager.On("NewSession", sc.U32(2)).Return(sc.NewOption[sc.Sequence[primitives.AccountId]](nil))
+	mockStorageHistoricalSession.On("Exists", sc.U32(1)).Return(false)
+
+	target.NewSession(2)
+
+	mockStorageHistoricalSession.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_NewSessionGenesis(t *testing.T) {
+	newValidators := sc.NewOption[sc.Sequence[primitives.AccountId]](sc.Sequence[primitives.AccountId]{validator, otherValidator})
+	target := setupModule()
+	mockSessionManager.On("NewSessionGenesis", sc.U32(0)).Return(newValidators)
+	expectMembers()
+	mockStorageHistoricalSession.On("Put", sc.U32(0), mock.Anything).Return()
+
+	result := target.NewSessionGenesis(0)
+
+	assert.Equal(t, newValidators, result)
+	mockSessionManager.AssertNotCalled(t, "NewSession", mock.Anything)
+	mockStorageHistoricalSession.AssertCalled(t, "Put", sc.U32(0), HistoricalSession{Root: expectRoot(t), ValidatorCount: 2})
+}
+
+func Test_Module_EndSession(t *testing.T) {
+	target := setupModule()
+	mockSessionManager.On("EndSession", sc.U32(3)).Return()
+
+	target.EndSession(3)
+
+	mockSessionManager.AssertCalled(t, "EndSession", sc.U32(3))
+}
+
+func Test_Module_StartSession(t *testing.T) {
+	target := setupModule()
+	mockSessionManager.On("StartSession", sc.U32(3)).Return()
+
+	target.StartSession(3)
+
+	mockSessionManager.AssertCalled(t, "StartSession", sc.U32(3))
+}
+
+func Test_Module_Prove_CheckProof(t *testing.T) {
+	target := setupModule()
+	mockSessionModule.On("CurrentIndex").Return(sc.U32(1), nil).Once()
+	mockSessionModule.On("Validators").Return(sc.Sequence[primitives.AccountId]{validator, otherValidator}, nil)
+	expectMembers()
+
+	proof := target.Prove(keyTypeId, authorityId)
+
+	assert.True(t, proof.HasValue)
+	assert.Equal(t, sc.U32(1), proof.Value.SessionIndex)
+	assert.Equal(t, sc.U32(2), proof.Value.Validators)
+
+	mockSessionModule.On("CurrentIndex").Return(sc.U32(2), nil)
+	mockStorageHistoricalSession.On("Exists", sc.U32(1)).Return(true)
+	mockStorageHistoricalSession.On("Get", sc.U32(1)).Return(HistoricalSession{Root: expectRoot(t), ValidatorCount: 2}, nil)
+
+	result := target.CheckProof(keyTypeId, authorityId, proof.Value)
+
+	assert.Equal(t, sc.NewOption[IdentificationTuple](identification), result)
+}
+
+func Test_Module_Prove_UnknownKey(t *testing.T) {
+	target := setupModule()
+	mockSessionModule.On("CurrentIndex").Return(sc.U32(1), nil)
+	mockSessionModule.On("Validators").Return(sc.Sequence[primitives.AccountId]{validator, otherValidator}, nil)
+	expectMembers()
+
+	result := target.Prove(keyTypeId, constants.ZeroAccountId)
+
+	assert.Equal(t, sc.NewOption[sessiontypes.MembershipProof](nil), result)
+}
+
+func Test_Module_Prove_Error(t *testing.T) {
+	target := setupModule()
+	mockSessionModule.On("CurrentIndex").Return(sc.U32(0), errPanic)
+
+	result := target.Prove(keyTypeId, authorityId)
+
+	assert.Equal(t, sc.NewOption[sessiontypes.MembershipProof](nil), result)
+}
+
+func Test_Module_CheckProof_CurrentSession(t *testing.T) {
+	target := setupModule()
+	mockSessionModule.On("CurrentIndex").Return(sc.U32(1), nil)
+	mockSessionModule.On("KeyOwner", sessionKey).Return(sc.NewOption[primitives.AccountId](validator), nil)
+	mockFullIdentificationOf.On("ExposureOf", validator).Return(sc.NewOption[staking.Exposure](exposure), nil)
+	mockSessionModule.On("Validators").Return(sc.Sequence[primitives.AccountId]{validator, otherValidator}, nil)
+
+	result := target.CheckProof(keyTypeId, authorityId, sessiontypes.MembershipProof{SessionIndex: 1, Validators: 2})
+
+	assert.Equal(t, sc.NewOption[IdentificationTuple](identification), result)
+	mockStorageHistoricalSession.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_CheckProof_CurrentSession_UnknownKey(t *testing.T) {
+	target := setupModule()
+	mockSessionModule.On("CurrentIndex").Return(sc.U32(1), nil)
+	mockSessionModule.On("KeyOwner", sessionKey).Return(sc.NewOption[primitives.AccountId](nil), nil)
+
+	result := target.CheckProof(keyTypeId, authorityId, sessiontypes.MembershipProof{SessionIndex: 1, Validators: 2})
+
+	assert.Equal(t, sc.NewOption[IdentificationTuple](nil), result)
+}
+
+func Test_Module_CheckProof_CurrentSession_ValidatorCountMismatch(t *testing.T) {
+	target := setupModule()
+	mockSessionModule.On("CurrentIndex").Return(sc.U32(1), nil)
+	mockSessionModule.On("KeyOwner", sessionKey).Return(sc.NewOption[primitives.AccountId](validator), nil)
+	mockFullIdentificationOf.On("ExposureOf", validator).Return(sc.NewOption[staking.Exposure](exposure), nil)
+	mockSessionModule.On("Validators").Return(sc.Sequence[primitives.AccountId]{validator}, nil)
+
+	result := target.CheckProof(keyTypeId, authorityId, sessiontypes.MembershipProof{SessionIndex: 1, Validators: 2})
+
+	assert.Equal(t, sc.NewOption[IdentificationTuple](nil), result)
+}
+
+func Test_Module_CheckProof_UnknownSession(t *testing.T) {
+	target := setupModule()
+	mockSessionModule.On("CurrentIndex").Return(sc.U32(5), nil)
+	mockStorageHistoricalSession.On("Exists", sc.U32(1)).Return(false)
+
+	result := target.CheckProof(keyTypeId, authorityId, sessiontypes.MembershipProof{SessionIndex: 1, Validators: 2})
+
+	assert.Equal(t, sc.NewOption[IdentificationTuple](nil), result)
+}
+
+func Test_Module_CheckProof_ValidatorCountMismatch(t *testing.T) {
+	target := setupModule()
+	mockSessionModule.On("CurrentIndex").Return(sc.U32(5), nil)
+	mockStorageHistoricalSession.On("Exists", sc.U32(1)).Return(true)
+	mockStorageHistoricalSession.On("Get", sc.U32(1)).Return(HistoricalSession{Root: expectRoot(t), ValidatorCount: 3}, nil)
+
+	result := target.CheckProof(keyTypeId, authorityId, sessiontypes.MembershipProof{SessionIndex: 1, Validators: 2})
+
+	assert.Equal(t, sc.NewOption[IdentificationTuple](nil), result)
+}
+
+func Test_Module_CheckProof_InvalidProof(t *testing.T) {
+	target := setupModule()
+	mockSessionModule.On("CurrentIndex").Return(sc.U32(5), nil)
+	mockStorageHistoricalSession.On("Exists", sc.U32(1)).Return(true)
+	mockStorageHistoricalSession.On("Get", sc.U32(1)).Return(HistoricalSession{Root: expectRoot(t), ValidatorCount: 2}, nil)
+
+	proof := sessiontypes.MembershipProof{
+		SessionIndex: 1,
+		TrieNodes:    sc.Sequence[sc.Sequence[sc.U8]]{{1, 2, 3}},
+		Validators:   2,
+	}
+
+	result := target.CheckProof(keyTypeId, authorityId, proof)
+
+	assert.Equal(t, sc.NewOption[IdentificationTuple](nil), result)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	result := target.Metadata()
+
+	assert.Equal(t, sc.Str("SessionHistorical"), result.ModuleV14.Name)
+	assert.Equal(t, sc.NewOption[sc.Compact](nil), result.ModuleV14.Call)
+	assert.Equal(t, sc.NewOption[sc.Compact](nil), result.ModuleV14.Event)
+	assert.Equal(t, sc.Str("SessionHistorical"), result.ModuleV14.Storage.Value.Prefix)
+	assert.Equal(t, 1, len(result.ModuleV14.Storage.Value.Items))
+	assert.Equal(t, sc.U8(moduleId), result.ModuleV14.Index)
+	assert.Contains(t, mdGenerator.GetMetadataTypes(), primitives.NewMetadataType(metadata.TypesTupleH256U32, "(H256, ValidatorCount)",
+		primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+			sc.ToCompact(metadata.TypesH256),
+			sc.ToCompact(metadata.PrimitiveTypesU32),
+		})))
+}
+
+func expectMembers() {
+	mockFullIdentificationOf.On("ExposureOf", validator).Return(sc.NewOption[staking.Exposure](exposure), nil)
+	mockFullIdentificationOf.On("ExposureOf", otherValidator).Return(sc.NewOption[staking.Exposure](otherExposure), nil)
+	mockSessionModule.On("LoadKeys", validator).Return(sc.NewOption[sc.Sequence[primitives.SessionKey]](sc.Sequence[primitives.SessionKey]{sessionKey}), nil)
+	mockSessionModule.On("LoadKeys", otherValidator).Return(sc.NewOption[sc.Sequence[primitives.SessionKey]](sc.Sequence[primitives.SessionKey]{otherKey}), nil)
+}
+
+func expectRoot(t *testing.T) primitives.H256 {
+	trie, err := generateProvingTrie(members)
+	assert.NoError(t, err)
+
+	return trie.root
+}
+
+func setupModule() module {
+	mockStorage = new(mocks.IoStorage)
+	mockSessionModule = new(mocks.SessionModule)
+	mockSessionManager = new(MockSessionManager)
+	mockFullIdentificationOf = new(MockFullIdentificationOf)
+	mockStorageHistoricalSession = new(mocks.StorageMap[sc.U32, HistoricalSession])
+
+	config := NewConfig(mockStorage, mockSessionManager, mockFullIdentificationOf)
+
+	target := New(moduleId, config, mdGenerator, log.NewLogger()).(module)
+	target.SetSessionModule(mockSessionModule)
+	target.storage.HistoricalSessions = mockStorageHistoricalSession
+
+	return target
+}
@@ -0,0 +1,164 @@
+package session_historical
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/pkg/trie/db"
+	"github.com/ChainSafe/gossamer/pkg/trie/inmemory"
+	"github.com/ChainSafe/gossamer/pkg/trie/inmemory/proof"
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/utils/decoder"
+)
+
+var (
+	errRootNotFound = errors.New("root node not found in the proving trie")
+)
+
+// sessionMember is an identified validator of a session, together with its session keys.
+type sessionMember struct {
+	IdentificationTuple
+	Keys sc.Sequence[primitives.SessionKey]
+}
+
+// ProvingTrie is a trie instance for checking and generating proofs.
+//
+// Each session key `(KeyTypeId, key)` maps to the index of its owner, which in turn maps to the
+// owner's identification tuple.
+type ProvingTrie struct {
+	db   *db.MemoryDB
+	root primitives.H256
+}
+
+// generateProvingTrie builds the proving trie of the session `members`. Members without session keys are skipped.
+func generateProvingTrie(members []sessionMember) (ProvingTrie, error) {
+	trie := inmemory.NewEmptyTrie()
+
+	for i, member := range members {
+		if len(member.Keys) == 0 {
+			continue
+		}
+
+		index := sc.U32(i).Bytes()
+
+		// map each key to the owner index.
+		for _, key := range member.Keys {
+			if err := trie.Put(ownerKey(key), index); err != nil {
+				return ProvingTrie{}, err
+			}
+		}
+
+		// map each owner index to the full identification.
+		if err := trie.Put(index, member.IdentificationTuple.Bytes()); err != nil {
+			return ProvingTrie{}, err
+		}
+	}
+
+	root, err := trie.Hash()
+	if err != nil {
+		return ProvingTrie{}, err
+	}
+
+	database := db.NewEmptyMemoryDB()
+	if err := trie.WriteDirty(database); err != nil {
+		return ProvingTrie{}, err
+	}
+
+	return ProvingTrie{
+		db:   database,
+		root: primitives.H256{FixedSequence: sc.BytesToFixedSequenceU8(root.ToBytes())},
+	}, nil
+}
+
+// provingTrieFromNodes builds a partial proving trie from the `nodes` of a proof against `root`.
+func provingTrieFromNodes(root primitives.H256, nodes sc.Sequence[sc.Sequence[sc.U8]]) (ProvingTrie, error) {
+	encodedNodes := make([][]byte, 0, len(nodes))
+	for _, node := range nodes {
+		encodedNodes = append(encodedNodes, sc.SequenceU8ToBytes(node))
+	}
+
+	database, err := db.NewMemoryDBFromProof(encodedNodes)
+	if err != nil {
+		return ProvingTrie{}, err
+	}
+
+	return ProvingTrie{
+		db:   database,
+		root: root,
+	}, nil
+}
+
+// prove returns the trie nodes proving the ownership of `key`, if it is part of the trie.
+func (t ProvingTrie) prove(key primitives.SessionKey) (sc.Option[sc.Sequence[sc.Sequence[sc.U8]]], error) {
+	trie, err := t.load()
+	if err != nil {
+		return sc.Option[sc.Sequence[sc.Sequence[sc.U8]]]{}, err
+	}
+
+	index := trie.Get(ownerKey(key))
+	if index == nil {
+		return sc.NewOption[sc.Sequence[sc.Sequence[sc.U8]]](nil), nil
+	}
+
+	encodedNodes, err := proof.Generate(t.root.Bytes(), [][]byte{ownerKey(key), index}, t.db)
+	if err != nil {
+		return sc.Option[sc.Sequence[sc.Sequence[sc.U8]]]{}, err
+	}
+
+	nodes := make(sc.Sequence[sc.Sequence[sc.U8]], 0, len(encodedNodes))
+	for _, node := range encodedNodes {
+		nodes = append(nodes, sc.BytesToSequenceU8(node))
+	}
+
+	return sc.NewOption[sc.Sequence[sc.Sequence[sc.U8]]](nodes), nil
+}
+
+// query returns the identification tuple of the owner of `key`, if it is part of the trie.
+func (t ProvingTrie) query(key primitives.SessionKey) (sc.Option[IdentificationTuple], error) {
+	trie, err := t.load()
+	if err != nil {
+		return sc.Option[IdentificationTuple]{}, err
+	}
+
+	encodedIndex := trie.Get(ownerKey(key))
+	if encodedIndex == nil {
+		return sc.NewOption[IdentificationTuple](nil), nil
+	}
+
+	index, err := sc.DecodeU32(bytes.NewBuffer(encodedIndex))
+	if err != nil {
+		return sc.Option[IdentificationTuple]{}, err
+	}
+
+	value := trie.Get(index.Bytes())
+	if value == nil {
+		return sc.NewOption[IdentificationTuple](nil), nil
+	}
+
+	identification, err := DecodeIdentificationTuple(bytes.NewBuffer(value))
+	if err != nil {
+		return sc.Option[IdentificationTuple]{}, err
+	}
+
+	return sc.NewOption[IdentificationTuple](identification), nil
+}
+
+func (t ProvingTrie) load() (*inmemory.InMemoryTrie, error) {
+	if _, err := t.db.Get(t.root.Bytes()); err != nil {
+		return nil, errRootNotFound
+	}
+
+	trie := inmemory.NewEmptyTrie()
+	if err := trie.LoadWithDecoder(t.db, common.BytesToHash(t.root.Bytes()), decoder.DecodeNode); err != nil {
+		return nil, err
+	}
+
+	return trie, nil
+}
+
+// ownerKey returns the trie key of a session key, i.e. the encoded `(KeyTypeId, key)` tuple.
+func ownerKey(key primitives.SessionKey) []byte {
+	return append(sc.FixedSequenceU8ToBytes(key.TypeId), key.Key.Bytes()...)
+}
@@ -0,0 +1,85 @@
+package session_historical
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ProvingTrie_query(t *testing.T) {
+	trie, err := generateProvingTrie(members)
+	assert.NoError(t, err)
+
+	result, err := trie.query(otherKey)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[IdentificationTuple](members[1].IdentificationTuple), result)
+}
+
+func Test_ProvingTrie_query_UnknownKey(t *testing.T) {
+	trie, err := generateProvingTrie(members)
+	assert.NoError(t, err)
+
+	result, err := trie.query(primitives.NewSessionKey(constants.ZeroAccountId.Bytes(), keyTypeId))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[IdentificationTuple](nil), result)
+}
+
+func Test_ProvingTrie_generate_SkipsMembersWithoutKeys(t *testing.T) {
+	trie, err := generateProvingTrie([]sessionMember{{IdentificationTuple: identification}})
+	assert.NoError(t, err)
+
+	result, err := trie.query(sessionKey)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[IdentificationTuple](nil), result)
+}
+
+func Test_ProvingTrie_prove_FromNodes(t *testing.T) {
+	trie, err := generateProvingTrie(members)
+	assert.NoError(t, err)
+
+	nodes, err := trie.prove(sessionKey)
+	assert.NoError(t, err)
+	assert.True(t, nodes.HasValue)
+
+	partialTrie, err := provingTrieFromNodes(trie.root, nodes.Value)
+	assert.NoError(t, err)
+
+	result, err := partialTrie.query(sessionKey)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[IdentificationTuple](identification), result)
+}
+
+func Test_ProvingTrie_prove_UnknownKey(t *testing.T) {
+	trie, err := generateProvingTrie(members)
+	assert.NoError(t, err)
+
+	result, err := trie.prove(primitives.NewSessionKey(constants.ZeroAccountId.Bytes(), keyTypeId))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[sc.Sequence[sc.Sequence[sc.U8]]](nil), result)
+}
+
+func Test_ProvingTrie_FromNodes_RootNotFound(t *testing.T) {
+	trie, err := generateProvingTrie(members)
+	assert.NoError(t, err)
+
+	otherTrie, err := generateProvingTrie(members[1:])
+	assert.NoError(t, err)
+
+	nodes, err := otherTrie.prove(otherKey)
+	assert.NoError(t, err)
+
+	partialTrie, err := provingTrieFromNodes(trie.root, nodes.Value)
+	assert.NoError(t, err)
+
+	_, err = partialTrie.query(otherKey)
+
+	assert.Equal(t, errRootNotFound, err)
+}
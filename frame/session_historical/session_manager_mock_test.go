@@ -0,0 +1,29 @@
+package session_historical
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSessionManager struct {
+	mock.Mock
+}
+
+func (m *MockSessionManager) NewSession(newIndex sc.U32) sc.Option[sc.Sequence[primitives.AccountId]] {
+	args := m.Called(newIndex)
+	return args.Get(0).(sc.Option[sc.Sequence[primitives.AccountId]])
+}
+
+func (m *MockSessionManager) NewSessionGenesis(newIndex sc.U32) sc.Option[sc.Sequence[primitives.AccountId]] {
+	args := m.Called(newIndex)
+	return args.Get(0).(sc.Option[sc.Sequence[primitives.AccountId]])
+}
+
+func (m *MockSessionManager) EndSession(index sc.U32) {
+	m.Called(index)
+}
+
+func (m *MockSessionManager) StartSession(index sc.U32) {
+	m.Called(index)
+}
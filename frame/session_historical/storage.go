@@ -1,10 +1,24 @@
 package session_historical
 
-var ()
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/io"
+)
+
+var (
+	keySessionHistorical  = []byte("SessionHistorical")
+	keyHistoricalSessions = []byte("HistoricalSessions")
+)
 
 type storage struct {
+	HistoricalSessions support.StorageMap[sc.U32, HistoricalSession]
 }
 
-func newStorage() *storage {
-	return &storage{}
+func newStorage(s io.Storage) *storage {
+	hashing := io.NewHashing()
+
+	return &storage{
+		HistoricalSessions: support.NewHashStorageMap[sc.U32, HistoricalSession](s, keySessionHistorical, keyHistoricalSessions, hashing.Twox64, DecodeHistoricalSession),
+	}
 }
@@ -0,0 +1,71 @@
+package session_historical
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// IdentificationTuple is a validator together with its full identification.
+type IdentificationTuple struct {
+	Validator          primitives.AccountId
+	FullIdentification staking.Exposure
+}
+
+func (it IdentificationTuple) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, it.Validator, it.FullIdentification)
+}
+
+func DecodeIdentificationTuple(buffer *bytes.Buffer) (IdentificationTuple, error) {
+	validator, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return IdentificationTuple{}, err
+	}
+
+	fullIdentification, err := staking.DecodeExposure(buffer)
+	if err != nil {
+		return IdentificationTuple{}, err
+	}
+
+	return IdentificationTuple{
+		Validator:          validator,
+		FullIdentification: fullIdentification,
+	}, nil
+}
+
+func (it IdentificationTuple) Bytes() []byte {
+	return sc.EncodedBytes(it)
+}
+
+// HistoricalSession is the root of the proving trie of a session, together with the validator count of the session.
+type HistoricalSession struct {
+	Root           primitives.H256
+	ValidatorCount sc.U32
+}
+
+func (hs HistoricalSession) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, hs.Root, hs.ValidatorCount)
+}
+
+func DecodeHistoricalSession(buffer *bytes.Buffer) (HistoricalSession, error) {
+	root, err := primitives.DecodeH256(buffer)
+	if err != nil {
+		return HistoricalSession{}, err
+	}
+
+	validatorCount, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return HistoricalSession{}, err
+	}
+
+	return HistoricalSession{
+		Root:           root,
+		ValidatorCount: validatorCount,
+	}, nil
+}
+
+func (hs HistoricalSession) Bytes() []byte {
+	return sc.EncodedBytes(hs)
+}
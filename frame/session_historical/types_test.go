@@ -0,0 +1,70 @@
+package session_historical
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	historicalSession = HistoricalSession{
+		Root:           primitives.H256{FixedSequence: constants.OneAccountId.FixedSequence},
+		ValidatorCount: 2,
+	}
+)
+
+func Test_IdentificationTuple_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := identification.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, append(validator.Bytes(), exposure.Bytes()...), buffer.Bytes())
+}
+
+func Test_IdentificationTuple_Bytes(t *testing.T) {
+	assert.Equal(t, append(validator.Bytes(), exposure.Bytes()...), identification.Bytes())
+}
+
+func Test_DecodeIdentificationTuple(t *testing.T) {
+	result, err := DecodeIdentificationTuple(bytes.NewBuffer(identification.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, identification, result)
+}
+
+func Test_DecodeIdentificationTuple_Fails(t *testing.T) {
+	_, err := DecodeIdentificationTuple(bytes.NewBuffer(validator.Bytes()))
+
+	assert.Error(t, err)
+}
+
+func Test_HistoricalSession_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := historicalSession.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, append(historicalSession.Root.Bytes(), sc.U32(2).Bytes()...), buffer.Bytes())
+}
+
+func Test_HistoricalSession_Bytes(t *testing.T) {
+	assert.Equal(t, append(historicalSession.Root.Bytes(), sc.U32(2).Bytes()...), historicalSession.Bytes())
+}
+
+func Test_DecodeHistoricalSession(t *testing.T) {
+	result, err := DecodeHistoricalSession(bytes.NewBuffer(historicalSession.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, historicalSession, result)
+}
+
+func Test_DecodeHistoricalSession_Fails(t *testing.T) {
+	_, err := DecodeHistoricalSession(bytes.NewBuffer(historicalSession.Root.Bytes()))
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package staking
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callBond bonds `value` of the free balance of the sender.
+type callBond struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallBond(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callBond{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Compact{Number: sc.U128{}}, RewardDestination{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callBond) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	value, err := sc.DecodeCompact[sc.U128](buffer)
+	if err != nil {
+		return nil, err
+	}
+	payee, err := DecodeRewardDestination(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(value, payee)
+
+	return c, nil
+}
+
+func (c callBond) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callBond) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callBond) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callBond) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callBond) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callBond) BaseWeight() primitives.Weight {
+	return callBondWeight(c.dbWeight)
+}
+
+func (_ callBond) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callBond) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callBond) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callBond) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doBond(who.Value, args[0].(sc.Compact).Number.(sc.U128), args[1].(RewardDestination))
+}
+
+func (_ callBond) Docs() string {
+	return "Take the origin account as a stash and lock up `value` of its balance. " +
+		"The dispatch origin for this call must be _Signed_ by the stash account. " +
+		"`value` is reduced to the free balance of the stash and must be at least the existential deposit. " +
+		"`payee`: The destination of the staking rewards of the stash. " +
+		"Emits `Bonded`."
+}
@@ -0,0 +1,84 @@
+package staking
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callBondExtra bonds up to `max_additional` more of the free balance of the sender.
+type callBondExtra struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallBondExtra(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callBondExtra{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Compact{Number: sc.U128{}}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callBondExtra) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	maxAdditional, err := sc.DecodeCompact[sc.U128](buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(maxAdditional)
+
+	return c, nil
+}
+
+func (c callBondExtra) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callBondExtra) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callBondExtra) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callBondExtra) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callBondExtra) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callBondExtra) BaseWeight() primitives.Weight {
+	return callBondExtraWeight(c.dbWeight)
+}
+
+func (_ callBondExtra) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callBondExtra) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callBondExtra) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callBondExtra) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doBondExtra(who.Value, args[0].(sc.Compact).Number.(sc.U128))
+}
+
+func (_ callBondExtra) Docs() string {
+	return "Add some extra amount that have appeared in the stash `free_balance` into the balance up for staking. " +
+		"The dispatch origin for this call must be _Signed_ by the stash. " +
+		"Use this if there are additional funds in your stash account that you wish to bond. " +
+		"Emits `Bonded`."
+}
@@ -0,0 +1,67 @@
+package staking
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_BondExtra_DecodeArgs(t *testing.T) {
+	target := setupCallBondExtra()
+	value := sc.ToCompact(sc.NewU128(200))
+
+	buffer := bytes.NewBuffer(value.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(value), result.Args())
+}
+
+func Test_Call_BondExtra_BaseWeight(t *testing.T) {
+	target := setupCallBondExtra()
+
+	assert.Equal(t, callBondExtraWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_BondExtra_Dispatch(t *testing.T) {
+	target := setupCallBondExtra()
+	expectLedger(who, ledger)
+	mockCurrency.On("FreeBalance", who).Return(sc.NewU128(1300), nil)
+	expected := StakingLedger{Stash: who, Total: sc.NewU128(1200), Active: sc.NewU128(1200), Unlocking: sc.Sequence[UnlockChunk]{}}
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(1200), primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", who, expected).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), sc.NewVaryingData(sc.ToCompact(sc.NewU128(200))))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageLedger.AssertCalled(t, "Put", who, expected)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventBonded(moduleId, who, sc.NewU128(200)))
+}
+
+func Test_Call_BondExtra_Dispatch_NotStash(t *testing.T) {
+	target := setupCallBondExtra()
+	mockStorageLedger.On("Exists", who).Return(false)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), sc.NewVaryingData(sc.ToCompact(sc.NewU128(200))))
+
+	assert.Equal(t, NewDispatchErrorNotStash(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "SetLock", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Call_BondExtra_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallBondExtra()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(sc.ToCompact(sc.NewU128(200))))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallBondExtra() callBondExtra {
+	return newCallBondExtra(moduleId, FunctionBondExtra, dbWeight, setupModule()).(callBondExtra)
+}
@@ -0,0 +1,11 @@
+package staking
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callBondExtraWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(38120000, 0).
+		SaturatingAdd(dbWeight.Reads(3)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
@@ -0,0 +1,57 @@
+package staking
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Call_Bond_DecodeArgs(t *testing.T) {
+	target := setupCallBond()
+	value := sc.ToCompact(sc.NewU128(1000))
+
+	buffer := bytes.NewBuffer(append(value.Bytes(), NewRewardDestinationStaked().Bytes()...))
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(value, NewRewardDestinationStaked()), result.Args())
+}
+
+func Test_Call_Bond_BaseWeight(t *testing.T) {
+	target := setupCallBond()
+
+	assert.Equal(t, callBondWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_Bond_Dispatch(t *testing.T) {
+	target := setupCallBond()
+	mockStorageLedger.On("Exists", who).Return(false)
+	mockCurrency.On("FreeBalance", who).Return(sc.NewU128(1500), nil)
+	mockSystemModule.On("IncConsumers", who).Return(nil)
+	mockStoragePayee.On("Put", who, NewRewardDestinationStaked()).Return()
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(1000), primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", who, ledger).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), sc.NewVaryingData(sc.ToCompact(sc.NewU128(1000)), NewRewardDestinationStaked()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageLedger.AssertCalled(t, "Put", who, ledger)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventBonded(moduleId, who, sc.NewU128(1000)))
+}
+
+func Test_Call_Bond_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallBond()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(sc.ToCompact(sc.NewU128(1000)), NewRewardDestinationStaked()))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallBond() callBond {
+	return newCallBond(moduleId, FunctionBond, dbWeight, setupModule()).(callBond)
+}
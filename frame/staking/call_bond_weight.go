@@ -0,0 +1,11 @@
+package staking
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callBondWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(43750000, 0).
+		SaturatingAdd(dbWeight.Reads(4)).
+		SaturatingAdd(dbWeight.Writes(4))
+}
@@ -0,0 +1,80 @@
+package staking
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callChill declares that the sender neither validates nor nominates anymore.
+type callChill struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallChill(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callChill{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callChill) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	c.Arguments = sc.NewVaryingData()
+
+	return c, nil
+}
+
+func (c callChill) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callChill) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callChill) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callChill) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callChill) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callChill) BaseWeight() primitives.Weight {
+	return callChillWeight(c.dbWeight)
+}
+
+func (_ callChill) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callChill) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callChill) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callChill) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doChill(who.Value)
+}
+
+func (_ callChill) Docs() string {
+	return "Declare no desire to either validate or nominate. " +
+		"Effects will be felt at the beginning of the next era. " +
+		"The dispatch origin for this call must be _Signed_ by the stash. " +
+		"Emits `Chilled`."
+}
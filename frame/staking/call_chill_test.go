@@ -0,0 +1,54 @@
+package staking
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Call_Chill_DecodeArgs(t *testing.T) {
+	target := setupCallChill()
+
+	buffer := bytes.NewBuffer([]byte{})
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(), result.Args())
+}
+
+func Test_Call_Chill_BaseWeight(t *testing.T) {
+	target := setupCallChill()
+
+	assert.Equal(t, callChillWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_Chill_Dispatch(t *testing.T) {
+	target := setupCallChill()
+	expectLedger(who, ledger)
+	expectRemoveValidator(who, sc.Sequence[primitives.AccountId]{other, who}, sc.Sequence[primitives.AccountId]{other})
+	mockStorageNominators.On("Exists", who).Return(false)
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), sc.NewVaryingData())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageValidators.AssertCalled(t, "Remove", who)
+	mockStorageValidatorStashes.AssertCalled(t, "Put", sc.Sequence[primitives.AccountId]{other})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventChilled(moduleId, who))
+}
+
+func Test_Call_Chill_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallChill()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallChill() callChill {
+	return newCallChill(moduleId, FunctionChill, dbWeight, setupModule()).(callChill)
+}
@@ -0,0 +1,11 @@
+package staking
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callChillWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(30450000, 0).
+		SaturatingAdd(dbWeight.Reads(5)).
+		SaturatingAdd(dbWeight.Writes(4))
+}
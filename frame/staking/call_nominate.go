@@ -0,0 +1,94 @@
+package staking
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callNominate declares the intention of the sender to nominate validators.
+type callNominate struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallNominate(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callNominate{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.MultiAddress]{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callNominate) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	targets, err := sc.DecodeSequenceWith(buffer, primitives.DecodeMultiAddress)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(targets)
+
+	return c, nil
+}
+
+func (c callNominate) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callNominate) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callNominate) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callNominate) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callNominate) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callNominate) BaseWeight() primitives.Weight {
+	return callNominateWeight(c.dbWeight, sc.U64(c.module.maxNominations))
+}
+
+func (_ callNominate) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callNominate) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callNominate) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callNominate) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	targets := sc.Sequence[primitives.AccountId]{}
+	for _, address := range args[0].(sc.Sequence[primitives.MultiAddress]) {
+		target, err := lookup(address)
+		if err != nil {
+			return primitives.PostDispatchInfo{}, err
+		}
+		targets = append(targets, target)
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doNominate(who.Value, targets)
+}
+
+func (_ callNominate) Docs() string {
+	return "Declare the desire to nominate `targets` for the origin stash. " +
+		"Effects will be felt at the beginning of the next era. " +
+		"The dispatch origin for this call must be _Signed_ by the stash. " +
+		"No more than `MaxNominations` targets may be nominated, and validators, which block new nominations, " +
+		"may only be nominated if they are already nominated by the stash."
+}
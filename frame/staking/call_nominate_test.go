@@ -0,0 +1,76 @@
+package staking
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	targets = sc.Sequence[primitives.MultiAddress]{whoAddress}
+)
+
+func Test_Call_Nominate_DecodeArgs(t *testing.T) {
+	target := setupCallNominate()
+
+	buffer := bytes.NewBuffer(targets.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(targets), result.Args())
+}
+
+func Test_Call_Nominate_BaseWeight(t *testing.T) {
+	target := setupCallNominate()
+
+	assert.Equal(t, callNominateWeight(dbWeight, sc.U64(maxNominations)), target.BaseWeight())
+}
+
+func Test_Call_Nominate_Dispatch(t *testing.T) {
+	target := setupCallNominate()
+	expectLedger(nominator, ledger)
+	mockStorageNominators.On("Exists", nominator).Return(false)
+	mockStorageValidators.On("Exists", who).Return(true)
+	mockStorageValidators.On("Get", who).Return(prefs, nil)
+	mockStorageNominatorStashes.On("Get").Return(sc.Sequence[primitives.AccountId]{}, nil)
+	mockStorageNominatorStashes.On("Put", sc.Sequence[primitives.AccountId]{nominator}).Return()
+	mockStorageValidators.On("Exists", nominator).Return(false)
+	expectCurrentEra(5)
+	expected := Nominations{Targets: sc.Sequence[primitives.AccountId]{who}, SubmittedIn: 5, Suppressed: false}
+	mockStorageNominators.On("Put", nominator, expected).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(nominator), sc.NewVaryingData(targets))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageNominators.AssertCalled(t, "Put", nominator, expected)
+}
+
+func Test_Call_Nominate_Dispatch_CannotLookup(t *testing.T) {
+	target := setupCallNominate()
+
+	_, err := target.Dispatch(
+		primitives.NewRawOriginSigned(nominator),
+		sc.NewVaryingData(sc.Sequence[primitives.MultiAddress]{primitives.NewMultiAddress20(primitives.Address20{})}),
+	)
+
+	assert.Equal(t, primitives.NewDispatchErrorCannotLookup(), err)
+	mockStorageNominators.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Call_Nominate_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallNominate()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(targets))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallNominate() callNominate {
+	return newCallNominate(moduleId, FunctionNominate, dbWeight, setupModule()).(callNominate)
+}
@@ -0,0 +1,14 @@
+package staking
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callNominateWeight(dbWeight primitives.RuntimeDbWeight, targets sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(41230000, 0).
+		SaturatingAdd(primitives.WeightFromParts(3452000, 0).SaturatingMul(targets)).
+		SaturatingAdd(dbWeight.Reads(6)).
+		SaturatingAdd(dbWeight.Reads(1).SaturatingMul(targets)).
+		SaturatingAdd(dbWeight.Writes(3))
+}
@@ -0,0 +1,88 @@
+package staking
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callPayoutStakers pays out the rewards of a validator and its nominators for an era.
+type callPayoutStakers struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallPayoutStakers(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callPayoutStakers{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.AccountId{}, sc.U32(0)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callPayoutStakers) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	validatorStash, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return nil, err
+	}
+	era, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(validatorStash, era)
+
+	return c, nil
+}
+
+func (c callPayoutStakers) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callPayoutStakers) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callPayoutStakers) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callPayoutStakers) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callPayoutStakers) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callPayoutStakers) BaseWeight() primitives.Weight {
+	return callPayoutStakersWeight(c.dbWeight, sc.U64(c.module.maxNominatorRewardedPerValidator))
+}
+
+func (_ callPayoutStakers) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callPayoutStakers) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callPayoutStakers) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callPayoutStakers) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if _, err := system.EnsureSigned(origin); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doPayoutStakers(args[0].(primitives.AccountId), args[1].(sc.U32))
+}
+
+func (_ callPayoutStakers) Docs() string {
+	return "Pay out all the stakers behind a single validator for a single era. " +
+		"`validator_stash` is the stash account of the validator. Their nominators, up to `MaxNominatorRewardedPerValidator`, will also receive their rewards. " +
+		"`era` may be any era between `[current_era - history_depth; current_era]`. " +
+		"The origin of this call must be _Signed_. Any account can call this function, even if it is not one of the stakers. " +
+		"Emits `PayoutStarted` and `Rewarded`."
+}
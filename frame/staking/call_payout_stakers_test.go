@@ -0,0 +1,73 @@
+package staking
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_PayoutStakers_DecodeArgs(t *testing.T) {
+	target := setupCallPayoutStakers()
+
+	buffer := bytes.NewBuffer(append(who.Bytes(), sc.U32(3).Bytes()...))
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(who, sc.U32(3)), result.Args())
+}
+
+func Test_Call_PayoutStakers_BaseWeight(t *testing.T) {
+	target := setupCallPayoutStakers()
+
+	assert.Equal(t, callPayoutStakersWeight(dbWeight, sc.U64(maxNominatorRewardedPerValidator)), target.BaseWeight())
+}
+
+func Test_Call_PayoutStakers_Dispatch(t *testing.T) {
+	target := setupCallPayoutStakers()
+	key := EraStash{Era: 3, Stash: who}
+	expectActiveEra(4)
+	mockStorageErasValidatorReward.On("Exists", sc.U32(3)).Return(true)
+	mockStorageLedger.On("Exists", who).Return(true)
+	mockStorageClaimedRewards.On("Get", key).Return(sc.Bool(false), nil)
+	mockStorageErasValidatorReward.On("Get", sc.U32(3)).Return(sc.NewU128(1000), nil)
+	mockStorageErasRewardPoints.On("Get", sc.U32(3)).Return(EraRewardPoints{
+		Total:      20,
+		Individual: sc.Sequence[IndividualRewardPoints]{{Validator: who, Points: 20}},
+	}, nil)
+	mockStorageErasStakersClipped.On("Get", key).Return(Exposure{
+		Total:  sc.NewU128(1000),
+		Own:    sc.NewU128(1000),
+		Others: sc.Sequence[IndividualExposure]{},
+	}, nil)
+	mockStorageErasValidatorPrefs.On("Get", key).Return(prefs, nil)
+	mockStorageClaimedRewards.On("Put", key, sc.Bool(true)).Return()
+	mockStoragePayee.On("Exists", who).Return(true)
+	mockStoragePayee.On("Get", who).Return(NewRewardDestinationStash(), nil)
+	mockCurrency.On("DepositIntoExisting", who, sc.NewU128(1000)).Return(sc.NewU128(1000), nil)
+
+	// any signed origin may trigger the payout
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(nominator), sc.NewVaryingData(who, sc.U32(3)))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageClaimedRewards.AssertCalled(t, "Put", key, sc.Bool(true))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventRewarded(moduleId, who, NewRewardDestinationStash(), sc.NewU128(1000)))
+}
+
+func Test_Call_PayoutStakers_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallPayoutStakers()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(who, sc.U32(3)))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockCurrency.AssertNotCalled(t, "DepositIntoExisting", mock.Anything, mock.Anything)
+}
+
+func setupCallPayoutStakers() callPayoutStakers {
+	return newCallPayoutStakers(moduleId, FunctionPayoutStakers, dbWeight, setupModule()).(callPayoutStakers)
+}
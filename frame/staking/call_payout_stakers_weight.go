@@ -0,0 +1,14 @@
+package staking
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callPayoutStakersWeight(dbWeight primitives.RuntimeDbWeight, nominators sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(81260000, 0).
+		SaturatingAdd(primitives.WeightFromParts(43850000, 0).SaturatingMul(nominators)).
+		SaturatingAdd(dbWeight.Reads(9)).
+		SaturatingAdd(dbWeight.Reads(4).SaturatingMul(nominators)).
+		SaturatingAdd(dbWeight.Writes(3))
+}
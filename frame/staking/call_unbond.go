@@ -0,0 +1,87 @@
+package staking
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callUnbond schedules a part of the active bond of the sender to be unlocked.
+type callUnbond struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallUnbond(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callUnbond{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Compact{Number: sc.U128{}}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callUnbond) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	value, err := sc.DecodeCompact[sc.U128](buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(value)
+
+	return c, nil
+}
+
+func (c callUnbond) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callUnbond) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callUnbond) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callUnbond) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callUnbond) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callUnbond) BaseWeight() primitives.Weight {
+	return callUnbondWeight(c.dbWeight)
+}
+
+func (_ callUnbond) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callUnbond) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callUnbond) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callUnbond) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doUnbond(who.Value, args[0].(sc.Compact).Number.(sc.U128))
+}
+
+func (_ callUnbond) Docs() string {
+	return "Schedule a portion of the stash to be unlocked ready for transfer out after the bond period ends. " +
+		"If this leaves an amount actively bonded less than the existential deposit, then it is increased to the full amount. " +
+		"The dispatch origin for this call must be _Signed_ by the stash. " +
+		"Once the unlock period is done, you can call `withdraw_unbonded` to actually move the funds out of management ready for transfer. " +
+		"No more than `MaxUnlockingChunks` unlocking chunks can co-exist at the same time. " +
+		"If all funds are unbonded, the stash is chilled. " +
+		"Emits `Unbonded`."
+}
@@ -0,0 +1,61 @@
+package staking
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Call_Unbond_DecodeArgs(t *testing.T) {
+	target := setupCallUnbond()
+	value := sc.ToCompact(sc.NewU128(400))
+
+	buffer := bytes.NewBuffer(value.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(value), result.Args())
+}
+
+func Test_Call_Unbond_BaseWeight(t *testing.T) {
+	target := setupCallUnbond()
+
+	assert.Equal(t, callUnbondWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_Unbond_Dispatch(t *testing.T) {
+	target := setupCallUnbond()
+	expectLedger(who, ledger)
+	expectCurrentEra(5)
+	expected := StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(1000),
+		Active:    sc.NewU128(600),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(400), Era: 5 + bondingDuration}},
+	}
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(1000), primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", who, expected).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), sc.NewVaryingData(sc.ToCompact(sc.NewU128(400))))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageLedger.AssertCalled(t, "Put", who, expected)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventUnbonded(moduleId, who, sc.NewU128(400)))
+}
+
+func Test_Call_Unbond_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallUnbond()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(sc.ToCompact(sc.NewU128(400))))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallUnbond() callUnbond {
+	return newCallUnbond(moduleId, FunctionUnbond, dbWeight, setupModule()).(callUnbond)
+}
@@ -0,0 +1,11 @@
+package staking
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callUnbondWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(52340000, 0).
+		SaturatingAdd(dbWeight.Reads(6)).
+		SaturatingAdd(dbWeight.Writes(5))
+}
@@ -0,0 +1,84 @@
+package staking
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callValidate declares the intention of the sender to validate.
+type callValidate struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallValidate(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callValidate{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(ValidatorPrefs{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callValidate) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	prefs, err := DecodeValidatorPrefs(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(prefs)
+
+	return c, nil
+}
+
+func (c callValidate) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callValidate) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callValidate) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callValidate) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callValidate) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callValidate) BaseWeight() primitives.Weight {
+	return callValidateWeight(c.dbWeight)
+}
+
+func (_ callValidate) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callValidate) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callValidate) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callValidate) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doValidate(who.Value, args[0].(ValidatorPrefs))
+}
+
+func (_ callValidate) Docs() string {
+	return "Declare the desire to validate for the origin stash. " +
+		"Effects will be felt at the beginning of the next era. " +
+		"The dispatch origin for this call must be _Signed_ by the stash. " +
+		"Emits `ValidatorPrefsSet`."
+}
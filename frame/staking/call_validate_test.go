@@ -0,0 +1,56 @@
+package staking
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Call_Validate_DecodeArgs(t *testing.T) {
+	target := setupCallValidate()
+
+	buffer := bytes.NewBuffer(prefs.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(prefs), result.Args())
+}
+
+func Test_Call_Validate_BaseWeight(t *testing.T) {
+	target := setupCallValidate()
+
+	assert.Equal(t, callValidateWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_Validate_Dispatch(t *testing.T) {
+	target := setupCallValidate()
+	expectLedger(who, ledger)
+	mockStorageValidators.On("Exists", who).Return(false)
+	mockStorageValidatorStashes.On("Get").Return(sc.Sequence[primitives.AccountId]{}, nil)
+	mockStorageValidatorStashes.On("Put", sc.Sequence[primitives.AccountId]{who}).Return()
+	mockStorageNominators.On("Exists", who).Return(false)
+	mockStorageValidators.On("Put", who, prefs).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), sc.NewVaryingData(prefs))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageValidators.AssertCalled(t, "Put", who, prefs)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventValidatorPrefsSet(moduleId, who, prefs))
+}
+
+func Test_Call_Validate_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallValidate()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(prefs))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallValidate() callValidate {
+	return newCallValidate(moduleId, FunctionValidate, dbWeight, setupModule()).(callValidate)
+}
@@ -0,0 +1,81 @@
+package staking
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callWithdrawUnbonded unlocks the funds of the sender, whose bonding duration has passed.
+type callWithdrawUnbonded struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallWithdrawUnbonded(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callWithdrawUnbonded{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callWithdrawUnbonded) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	c.Arguments = sc.NewVaryingData()
+
+	return c, nil
+}
+
+func (c callWithdrawUnbonded) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callWithdrawUnbonded) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callWithdrawUnbonded) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callWithdrawUnbonded) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callWithdrawUnbonded) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callWithdrawUnbonded) BaseWeight() primitives.Weight {
+	return callWithdrawUnbondedWeight(c.dbWeight)
+}
+
+func (_ callWithdrawUnbonded) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callWithdrawUnbonded) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callWithdrawUnbonded) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callWithdrawUnbonded) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doWithdrawUnbonded(who.Value)
+}
+
+func (_ callWithdrawUnbonded) Docs() string {
+	return "Remove any unlocked chunks from the `unlocking` queue from our management. " +
+		"This essentially frees up that balance to be used by the stash account to do whatever it wants. " +
+		"The dispatch origin for this call must be _Signed_ by the stash. " +
+		"If nothing is bonded or unlocking anymore, the stash is removed. " +
+		"Emits `Withdrawn`."
+}
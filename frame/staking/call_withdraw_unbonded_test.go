@@ -0,0 +1,65 @@
+package staking
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Call_WithdrawUnbonded_DecodeArgs(t *testing.T) {
+	target := setupCallWithdrawUnbonded()
+
+	buffer := bytes.NewBuffer([]byte{})
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(), result.Args())
+}
+
+func Test_Call_WithdrawUnbonded_BaseWeight(t *testing.T) {
+	target := setupCallWithdrawUnbonded()
+
+	assert.Equal(t, callWithdrawUnbondedWeight(dbWeight), target.BaseWeight())
+}
+
+func Test_Call_WithdrawUnbonded_Dispatch(t *testing.T) {
+	target := setupCallWithdrawUnbonded()
+	expectLedger(who, StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(1000),
+		Active:    sc.NewU128(600),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(400), Era: 4}},
+	})
+	expectCurrentEra(5)
+	expected := StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(600),
+		Active:    sc.NewU128(600),
+		Unlocking: sc.Sequence[UnlockChunk]{},
+	}
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(600), primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", who, expected).Return()
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), sc.NewVaryingData())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageLedger.AssertCalled(t, "Put", who, expected)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventWithdrawn(moduleId, who, sc.NewU128(400)))
+}
+
+func Test_Call_WithdrawUnbonded_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallWithdrawUnbonded()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallWithdrawUnbonded() callWithdrawUnbonded {
+	return newCallWithdrawUnbonded(moduleId, FunctionWithdrawUnbonded, dbWeight, setupModule()).(callWithdrawUnbonded)
+}
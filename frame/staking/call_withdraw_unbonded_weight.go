@@ -0,0 +1,11 @@
+package staking
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callWithdrawUnbondedWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(56780000, 0).
+		SaturatingAdd(dbWeight.Reads(7)).
+		SaturatingAdd(dbWeight.Writes(6))
+}
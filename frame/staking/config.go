@@ -0,0 +1,88 @@
+package staking
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/npos_elections"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Currency is the currency, in which funds are bonded and rewards are paid out.
+type Currency interface {
+	primitives.Currency
+	primitives.LockableCurrency
+
+	DepositIntoExisting(who primitives.AccountId, value sc.U128) (primitives.Balance, error)
+	DepositCreating(who primitives.AccountId, value sc.U128) (primitives.Balance, error)
+	TotalIssuance() support.StorageValue[sc.U128]
+	ExistentialDeposit() sc.U128
+	Slash(who primitives.AccountId, value primitives.Balance) (primitives.Balance, error)
+}
+
+// SessionInterface disables offending validators for the rest of the session.
+type SessionInterface interface {
+	Disable(who primitives.AccountId) (bool, error)
+}
+
+type Config struct {
+	Storage      io.Storage
+	DbWeight     primitives.RuntimeDbWeight
+	Currency     Currency
+	SystemModule system.Module
+	EraPayout    EraPayout
+	// ElectionProvider elects the validators of each era, with the staking module as data provider.
+	ElectionProvider npos_elections.ElectionProvider
+	// SessionsPerEra is the number of sessions in an era.
+	SessionsPerEra sc.U32
+	// BondingDuration is the number of eras, for which unbonded funds remain locked.
+	BondingDuration sc.U32
+	// HistoryDepth is the number of past eras, for which rewards can be claimed.
+	HistoryDepth sc.U32
+	// MaxNominations is the maximum number of validators a nominator may nominate.
+	MaxNominations sc.U32
+	// MaxNominatorRewardedPerValidator is the maximum number of nominators of a validator, which are rewarded.
+	// Only the ones with the highest stake are rewarded.
+	MaxNominatorRewardedPerValidator sc.U32
+	// MaxUnlockingChunks is the maximum number of unbonding chunks of a ledger.
+	MaxUnlockingChunks sc.U32
+	// MaxValidators is the maximum number of validator candidates.
+	MaxValidators sc.U32
+	// MaxNominators is the maximum number of nominators.
+	MaxNominators sc.U32
+}
+
+func NewConfig(
+	storage io.Storage,
+	dbWeight primitives.RuntimeDbWeight,
+	currency Currency,
+	systemModule system.Module,
+	eraPayout EraPayout,
+	electionProvider npos_elections.ElectionProvider,
+	sessionsPerEra sc.U32,
+	bondingDuration sc.U32,
+	historyDepth sc.U32,
+	maxNominations sc.U32,
+	maxNominatorRewardedPerValidator sc.U32,
+	maxUnlockingChunks sc.U32,
+	maxValidators sc.U32,
+	maxNominators sc.U32,
+) Config {
+	return Config{
+		Storage:                          storage,
+		DbWeight:                         dbWeight,
+		Currency:                         currency,
+		SystemModule:                     systemModule,
+		EraPayout:                        eraPayout,
+		ElectionProvider:                 electionProvider,
+		SessionsPerEra:                   sessionsPerEra,
+		BondingDuration:                  bondingDuration,
+		HistoryDepth:                     historyDepth,
+		MaxNominations:                   maxNominations,
+		MaxNominatorRewardedPerValidator: maxNominatorRewardedPerValidator,
+		MaxUnlockingChunks:               maxUnlockingChunks,
+		MaxValidators:                    maxValidators,
+		MaxNominators:                    maxNominators,
+	}
+}
@@ -0,0 +1,54 @@
+package staking
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type MockCurrency struct {
+	mocks.LockableCurrency
+}
+
+func (m *MockCurrency) DepositIntoExisting(who primitives.AccountId, value sc.U128) (primitives.Balance, error) {
+	args := m.Called(who, value)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(primitives.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(primitives.Balance), nil
+}
+
+func (m *MockCurrency) DepositCreating(who primitives.AccountId, value sc.U128) (primitives.Balance, error) {
+	args := m.Called(who, value)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(primitives.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(primitives.Balance), nil
+}
+
+func (m *MockCurrency) TotalIssuance() support.StorageValue[sc.U128] {
+	args := m.Called()
+
+	return args.Get(0).(support.StorageValue[sc.U128])
+}
+
+func (m *MockCurrency) ExistentialDeposit() sc.U128 {
+	args := m.Called()
+
+	return args.Get(0).(sc.U128)
+}
+
+func (m *MockCurrency) Slash(who primitives.AccountId, value primitives.Balance) (primitives.Balance, error) {
+	args := m.Called(who, value)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(primitives.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(primitives.Balance), nil
+}
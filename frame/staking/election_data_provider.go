@@ -0,0 +1,65 @@
+package staking
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/npos_elections"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// DesiredTargets returns the number of validators to elect.
+func (m Module) DesiredTargets() (sc.U32, error) {
+	return m.storage.ValidatorCount.Get()
+}
+
+// ElectableTargets returns the validator candidates.
+func (m Module) ElectableTargets() (sc.Sequence[primitives.AccountId], error) {
+	return m.storage.ValidatorStashes.Get()
+}
+
+// ElectingVoters returns the validator candidates, which vote for themselves with their active bond,
+// followed by the nominators, which vote for their targets with their active bond.
+func (m Module) ElectingVoters() ([]npos_elections.Voter, error) {
+	validators, err := m.storage.ValidatorStashes.Get()
+	if err != nil {
+		return nil, err
+	}
+	nominators, err := m.storage.NominatorStashes.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	voters := make([]npos_elections.Voter, 0, len(validators)+len(nominators))
+
+	for _, validator := range validators {
+		ledger, err := m.storage.Ledger.Get(validator)
+		if err != nil {
+			return nil, err
+		}
+		voters = append(voters, npos_elections.Voter{
+			Who:     validator,
+			Stake:   ledger.Active,
+			Targets: sc.Sequence[primitives.AccountId]{validator},
+		})
+	}
+
+	for _, nominator := range nominators {
+		nominations, err := m.storage.Nominators.Get(nominator)
+		if err != nil {
+			return nil, err
+		}
+		if nominations.Suppressed {
+			continue
+		}
+		ledger, err := m.storage.Ledger.Get(nominator)
+		if err != nil {
+			return nil, err
+		}
+		voters = append(voters, npos_elections.Voter{
+			Who:     nominator,
+			Stake:   ledger.Active,
+			Targets: nominations.Targets,
+		})
+	}
+
+	return voters, nil
+}
@@ -0,0 +1,49 @@
+package staking
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/npos_elections"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Module_DesiredTargets(t *testing.T) {
+	target := setupModule()
+	mockStorageValidatorCount.On("Get").Return(sc.U32(2), nil)
+
+	result, err := target.DesiredTargets()
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U32(2), result)
+}
+
+func Test_Module_ElectableTargets(t *testing.T) {
+	target := setupModule()
+	mockStorageValidatorStashes.On("Get").Return(sc.Sequence[primitives.AccountId]{who, other}, nil)
+
+	result, err := target.ElectableTargets()
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.Sequence[primitives.AccountId]{who, other}, result)
+}
+
+func Test_Module_ElectingVoters(t *testing.T) {
+	target := setupModule()
+	mockStorageValidatorStashes.On("Get").Return(sc.Sequence[primitives.AccountId]{who}, nil)
+	mockStorageNominatorStashes.On("Get").Return(sc.Sequence[primitives.AccountId]{nominator, other}, nil)
+	mockStorageLedger.On("Get", who).Return(ledger, nil)
+	mockStorageLedger.On("Get", nominator).Return(StakingLedger{Stash: nominator, Total: sc.NewU128(500), Active: sc.NewU128(300)}, nil)
+	mockStorageNominators.On("Get", nominator).Return(Nominations{Targets: sc.Sequence[primitives.AccountId]{who}}, nil)
+	mockStorageNominators.On("Get", other).Return(Nominations{Targets: sc.Sequence[primitives.AccountId]{who}, Suppressed: true}, nil)
+
+	result, err := target.ElectingVoters()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []npos_elections.Voter{
+		{Who: who, Stake: sc.NewU128(1000), Targets: sc.Sequence[primitives.AccountId]{who}},
+		{Who: nominator, Stake: sc.NewU128(300), Targets: sc.Sequence[primitives.AccountId]{who}},
+	}, result)
+	mockStorageLedger.AssertNotCalled(t, "Get", other)
+}
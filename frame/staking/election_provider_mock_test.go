@@ -0,0 +1,20 @@
+package staking
+
+import (
+	"github.com/LimeChain/gosemble/primitives/npos_elections"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockElectionProvider struct {
+	mock.Mock
+}
+
+func (m *MockElectionProvider) Elect(dataProvider npos_elections.ElectionDataProvider) (npos_elections.Supports, error) {
+	args := m.Called(dataProvider)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(npos_elections.Supports), nil
+	}
+
+	return args.Get(0).(npos_elections.Supports), args.Get(1).(error)
+}
@@ -0,0 +1,32 @@
+package staking
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// EraPayout computes the rewards, which are paid out at the end of an era.
+type EraPayout interface {
+	// EraPayout returns the payout to the validators and nominators of an era, in which `totalStaked`
+	// of the `totalIssuance` was at stake, and the remainder, which is not paid out to stakers.
+	EraPayout(totalStaked primitives.Balance, totalIssuance primitives.Balance) (primitives.Balance, primitives.Balance)
+}
+
+// FixedInflation pays out a fixed share of the total issuance to the stakers of each era.
+type FixedInflation struct {
+	// InflationPerEra is the share of the total issuance paid out per era.
+	InflationPerEra primitives.Perbill
+}
+
+func NewFixedInflation(inflationPerEra primitives.Perbill) FixedInflation {
+	return FixedInflation{InflationPerEra: inflationPerEra}
+}
+
+func (fi FixedInflation) EraPayout(_ primitives.Balance, totalIssuance primitives.Balance) (primitives.Balance, primitives.Balance) {
+	payout, err := fi.InflationPerEra.Mul(totalIssuance)
+	if err != nil {
+		return sc.NewU128(0), sc.NewU128(0)
+	}
+
+	return payout.(primitives.Balance), sc.NewU128(0)
+}
@@ -0,0 +1,16 @@
+package staking
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockEraPayout struct {
+	mock.Mock
+}
+
+func (m *MockEraPayout) EraPayout(totalStaked primitives.Balance, totalIssuance primitives.Balance) (primitives.Balance, primitives.Balance) {
+	args := m.Called(totalStaked, totalIssuance)
+
+	return args.Get(0).(primitives.Balance), args.Get(1).(primitives.Balance)
+}
@@ -0,0 +1,18 @@
+package staking
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FixedInflation_EraPayout(t *testing.T) {
+	target := NewFixedInflation(primitives.NewPerbillFromPercent(2))
+
+	payout, remainder := target.EraPayout(sc.NewU128(500), sc.NewU128(10_000))
+
+	assert.Equal(t, sc.NewU128(200), payout)
+	assert.Equal(t, sc.NewU128(0), remainder)
+}
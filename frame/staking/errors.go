@@ -0,0 +1,73 @@
+package staking
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Staking module errors.
+const (
+	ErrorNotStash sc.U8 = iota
+	ErrorAlreadyBonded
+	ErrorEmptyTargets
+	ErrorInsufficientBond
+	ErrorNoMoreChunks
+	ErrorInvalidEraToReward
+	ErrorAlreadyClaimed
+	ErrorTooManyTargets
+	ErrorBadTarget
+	ErrorTooManyNominators
+	ErrorTooManyValidators
+)
+
+func NewDispatchErrorNotStash(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNotStash)
+}
+
+func NewDispatchErrorAlreadyBonded(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorAlreadyBonded)
+}
+
+func NewDispatchErrorEmptyTargets(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorEmptyTargets)
+}
+
+func NewDispatchErrorInsufficientBond(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorInsufficientBond)
+}
+
+func NewDispatchErrorNoMoreChunks(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNoMoreChunks)
+}
+
+func NewDispatchErrorInvalidEraToReward(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorInvalidEraToReward)
+}
+
+func NewDispatchErrorAlreadyClaimed(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorAlreadyClaimed)
+}
+
+func NewDispatchErrorTooManyTargets(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorTooManyTargets)
+}
+
+func NewDispatchErrorBadTarget(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorBadTarget)
+}
+
+func NewDispatchErrorTooManyNominators(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorTooManyNominators)
+}
+
+func NewDispatchErrorTooManyValidators(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorTooManyValidators)
+}
+
+func newDispatchError(moduleId sc.U8, err sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
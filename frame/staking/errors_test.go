@@ -0,0 +1,33 @@
+package staking
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewDispatchErrors(t *testing.T) {
+	for err, constructor := range map[sc.U8]func(sc.U8) primitives.DispatchError{
+		ErrorNotStash:           NewDispatchErrorNotStash,
+		ErrorAlreadyBonded:      NewDispatchErrorAlreadyBonded,
+		ErrorEmptyTargets:       NewDispatchErrorEmptyTargets,
+		ErrorInsufficientBond:   NewDispatchErrorInsufficientBond,
+		ErrorNoMoreChunks:       NewDispatchErrorNoMoreChunks,
+		ErrorInvalidEraToReward: NewDispatchErrorInvalidEraToReward,
+		ErrorAlreadyClaimed:     NewDispatchErrorAlreadyClaimed,
+		ErrorTooManyTargets:     NewDispatchErrorTooManyTargets,
+		ErrorBadTarget:          NewDispatchErrorBadTarget,
+		ErrorTooManyNominators:  NewDispatchErrorTooManyNominators,
+		ErrorTooManyValidators:  NewDispatchErrorTooManyValidators,
+	} {
+		expect := primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+			Index:   moduleId,
+			Err:     sc.U32(err),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+
+		assert.Equal(t, expect, constructor(moduleId))
+	}
+}
@@ -0,0 +1,147 @@
+package staking
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Staking module events.
+const (
+	EventEraPaid sc.U8 = iota
+	EventRewarded
+	EventBonded
+	EventUnbonded
+	EventWithdrawn
+	EventChilled
+	EventPayoutStarted
+	EventValidatorPrefsSet
+	EventSlashed
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid staking.Event module")
+	errInvalidEventType   = errors.New("invalid staking.Event type")
+)
+
+func newEventEraPaid(moduleIndex sc.U8, era sc.U32, validatorPayout primitives.Balance, remainder primitives.Balance) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventEraPaid, era, validatorPayout, remainder)
+}
+
+func newEventRewarded(moduleIndex sc.U8, stash primitives.AccountId, dest RewardDestination, amount primitives.Balance) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventRewarded, stash, dest, amount)
+}
+
+func newEventBonded(moduleIndex sc.U8, stash primitives.AccountId, amount primitives.Balance) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventBonded, stash, amount)
+}
+
+func newEventUnbonded(moduleIndex sc.U8, stash primitives.AccountId, amount primitives.Balance) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventUnbonded, stash, amount)
+}
+
+func newEventWithdrawn(moduleIndex sc.U8, stash primitives.AccountId, amount primitives.Balance) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventWithdrawn, stash, amount)
+}
+
+func newEventChilled(moduleIndex sc.U8, stash primitives.AccountId) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventChilled, stash)
+}
+
+func newEventPayoutStarted(moduleIndex sc.U8, era sc.U32, validatorStash primitives.AccountId) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventPayoutStarted, era, validatorStash)
+}
+
+func newEventValidatorPrefsSet(moduleIndex sc.U8, stash primitives.AccountId, prefs ValidatorPrefs) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventValidatorPrefsSet, stash, prefs)
+}
+
+func newEventSlashed(moduleIndex sc.U8, staker primitives.AccountId, amount primitives.Balance) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventSlashed, staker, amount)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventEraPaid:
+		era, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		validatorPayout, err := sc.DecodeU128(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		remainder, err := sc.DecodeU128(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventEraPaid(moduleIndex, era, validatorPayout, remainder), nil
+	case EventRewarded:
+		stash, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		dest, err := DecodeRewardDestination(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		amount, err := sc.DecodeU128(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventRewarded(moduleIndex, stash, dest, amount), nil
+	case EventBonded, EventUnbonded, EventWithdrawn, EventSlashed:
+		stash, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		amount, err := sc.DecodeU128(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return primitives.NewEvent(moduleIndex, b, stash, amount), nil
+	case EventChilled:
+		stash, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventChilled(moduleIndex, stash), nil
+	case EventPayoutStarted:
+		era, err := sc.DecodeU32(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		validatorStash, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventPayoutStarted(moduleIndex, era, validatorStash), nil
+	case EventValidatorPrefsSet:
+		stash, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		prefs, err := DecodeValidatorPrefs(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventValidatorPrefsSet(moduleIndex, stash, prefs), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
@@ -0,0 +1,48 @@
+package staking
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DecodeEvent(t *testing.T) {
+	for _, event := range []primitives.Event{
+		newEventEraPaid(moduleId, 3, sc.NewU128(2000), sc.NewU128(0)),
+		newEventRewarded(moduleId, who, NewRewardDestinationAccount(other), sc.NewU128(100)),
+		newEventBonded(moduleId, who, sc.NewU128(1000)),
+		newEventUnbonded(moduleId, who, sc.NewU128(400)),
+		newEventWithdrawn(moduleId, who, sc.NewU128(300)),
+		newEventChilled(moduleId, who),
+		newEventPayoutStarted(moduleId, 3, who),
+		newEventValidatorPrefsSet(moduleId, who, prefs),
+		newEventSlashed(moduleId, who, sc.NewU128(100)),
+	} {
+		result, err := DecodeEvent(moduleId, bytes.NewBuffer(event.Bytes()))
+		assert.Nil(t, err)
+
+		assert.Equal(t, event, result)
+	}
+}
+
+func Test_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId + 1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
@@ -0,0 +1,160 @@
+package staking
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/vedhavyas/go-subkey"
+)
+
+const (
+	stakerStatusValidator = "Validator"
+	stakerStatusIdle      = "Idle"
+	stakerStatusNominator = "Nominator"
+)
+
+var (
+	errInvalidAddrValue         = errors.New("invalid address in genesis config json")
+	errInvalidBalanceValue      = errors.New("invalid balance in genesis config json")
+	errInvalidStakerStatusValue = errors.New("invalid staker status in genesis config json")
+)
+
+type genesisConfigStaker struct {
+	Stash     types.AccountId
+	Value     types.Balance
+	Validator bool
+	Targets   sc.Sequence[types.AccountId]
+}
+
+type GenesisConfig struct {
+	ValidatorCount        sc.U32
+	MinimumValidatorCount sc.U32
+	Stakers               []genesisConfigStaker
+}
+
+type genesisConfigJsonStruct struct {
+	StakingGenesisConfig struct {
+		ValidatorCount        uint32           `json:"validatorCount"`
+		MinimumValidatorCount uint32           `json:"minimumValidatorCount"`
+		Stakers               [][3]interface{} `json:"stakers"`
+	} `json:"staking"`
+}
+
+func (gc *GenesisConfig) UnmarshalJSON(data []byte) error {
+	gcJson := genesisConfigJsonStruct{}
+
+	jsonDecoder := json.NewDecoder(bytes.NewReader(data))
+	jsonDecoder.UseNumber()
+	if err := jsonDecoder.Decode(&gcJson); err != nil {
+		return err
+	}
+
+	gc.ValidatorCount = sc.U32(gcJson.StakingGenesisConfig.ValidatorCount)
+	gc.MinimumValidatorCount = sc.U32(gcJson.StakingGenesisConfig.MinimumValidatorCount)
+
+	for _, s := range gcJson.StakingGenesisConfig.Stakers {
+		stash, err := decodeAccountId(s[0])
+		if err != nil {
+			return err
+		}
+
+		value, ok := s[1].(json.Number)
+		if !ok {
+			return errInvalidBalanceValue
+		}
+		valueU128, err := sc.NewU128FromString(value.String())
+		if err != nil {
+			return err
+		}
+
+		staker := genesisConfigStaker{
+			Stash:   stash,
+			Value:   valueU128,
+			Targets: sc.Sequence[types.AccountId]{},
+		}
+
+		switch status := s[2].(type) {
+		case string:
+			switch status {
+			case stakerStatusValidator:
+				staker.Validator = true
+			case stakerStatusIdle:
+			default:
+				return errInvalidStakerStatusValue
+			}
+		case map[string]interface{}:
+			targets, ok := status[stakerStatusNominator].([]interface{})
+			if !ok || len(status) != 1 {
+				return errInvalidStakerStatusValue
+			}
+			for _, t := range targets {
+				target, err := decodeAccountId(t)
+				if err != nil {
+					return err
+				}
+				staker.Targets = append(staker.Targets, target)
+			}
+		default:
+			return errInvalidStakerStatusValue
+		}
+
+		gc.Stakers = append(gc.Stakers, staker)
+	}
+
+	return nil
+}
+
+func (m Module) CreateDefaultConfig() ([]byte, error) {
+	gc := &genesisConfigJsonStruct{}
+	gc.StakingGenesisConfig.Stakers = [][3]interface{}{}
+
+	return json.Marshal(gc)
+}
+
+// BuildConfig bonds the stake of each staker in the genesis config, paying out its rewards as additional stake,
+// and declares it a validator or a nominator. The balances of the stakers must already be initialized.
+func (m Module) BuildConfig(config []byte) error {
+	gc := GenesisConfig{}
+	if err := json.Unmarshal(config, &gc); err != nil {
+		return err
+	}
+
+	m.storage.ValidatorCount.Put(gc.ValidatorCount)
+	m.storage.MinimumValidatorCount.Put(gc.MinimumValidatorCount)
+
+	for _, staker := range gc.Stakers {
+		if err := m.doBond(staker.Stash, staker.Value, NewRewardDestinationStaked()); err != nil {
+			return err
+		}
+
+		switch {
+		case staker.Validator:
+			if err := m.doValidate(staker.Stash, ValidatorPrefs{}); err != nil {
+				return err
+			}
+		case len(staker.Targets) > 0:
+			if err := m.doNominate(staker.Stash, staker.Targets); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func decodeAccountId(value interface{}) (types.AccountId, error) {
+	addrString, ok := value.(string)
+	if !ok {
+		return types.AccountId{}, errInvalidAddrValue
+	}
+
+	_, publicKey, err := subkey.SS58Decode(addrString)
+	if err != nil {
+		return types.AccountId{}, err
+	}
+
+	return types.NewAccountId(sc.BytesToSequenceU8(publicKey)...)
+}
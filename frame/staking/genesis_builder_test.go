@@ -0,0 +1,108 @@
+package staking
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	validGcJson = "{\"staking\":{\"validatorCount\":2,\"minimumValidatorCount\":1,\"stakers\":[" +
+		"[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",1000,\"Validator\"]," +
+		"[\"5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty\",500,{\"Nominator\":[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\"]}]]}}"
+	alice, _ = primitives.NewAccountId(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...)
+	bob, _   = decodeAccountId("5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty")
+)
+
+func Test_GenesisConfig_CreateDefaultConfig(t *testing.T) {
+	target := setupModule()
+
+	expectedGc := []byte("{\"staking\":{\"validatorCount\":0,\"minimumValidatorCount\":0,\"stakers\":[]}}")
+
+	gc, err := target.CreateDefaultConfig()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedGc, gc)
+}
+
+func Test_GenesisConfig_BuildConfig(t *testing.T) {
+	target := setupModule()
+	aliceLedger := StakingLedger{Stash: alice, Total: sc.NewU128(1000), Active: sc.NewU128(1000), Unlocking: sc.Sequence[UnlockChunk]{}}
+	bobLedger := StakingLedger{Stash: bob, Total: sc.NewU128(500), Active: sc.NewU128(500), Unlocking: sc.Sequence[UnlockChunk]{}}
+	bobNominations := Nominations{Targets: sc.Sequence[primitives.AccountId]{alice}, SubmittedIn: 0, Suppressed: false}
+
+	mockStorageValidatorCount.On("Put", sc.U32(2)).Return()
+	mockStorageMinimumValidatorCount.On("Put", sc.U32(1)).Return()
+	for _, staker := range []StakingLedger{aliceLedger, bobLedger} {
+		mockStorageLedger.On("Exists", staker.Stash).Return(false).Once()
+		mockStorageLedger.On("Exists", staker.Stash).Return(true)
+		mockStorageLedger.On("Get", staker.Stash).Return(staker, nil)
+		mockStorageLedger.On("Put", staker.Stash, staker).Return()
+		mockCurrency.On("FreeBalance", staker.Stash).Return(staker.Total, nil)
+		mockSystemModule.On("IncConsumers", staker.Stash).Return(nil)
+		mockStoragePayee.On("Put", staker.Stash, NewRewardDestinationStaked()).Return()
+		mockCurrency.On("SetLock", LockId, staker.Stash, staker.Total, primitives.ReasonsAll).Return(nil)
+		mockStorageNominators.On("Exists", staker.Stash).Return(false)
+	}
+	mockStorageValidators.On("Exists", alice).Return(false).Once()
+	mockStorageValidators.On("Exists", alice).Return(true)
+	mockStorageValidators.On("Exists", bob).Return(false)
+	mockStorageValidators.On("Get", alice).Return(ValidatorPrefs{}, nil)
+	mockStorageValidatorStashes.On("Get").Return(sc.Sequence[primitives.AccountId]{}, nil)
+	mockStorageValidatorStashes.On("Put", sc.Sequence[primitives.AccountId]{alice}).Return()
+	mockStorageValidators.On("Put", alice, ValidatorPrefs{}).Return()
+	mockStorageNominatorStashes.On("Get").Return(sc.Sequence[primitives.AccountId]{}, nil)
+	mockStorageNominatorStashes.On("Put", sc.Sequence[primitives.AccountId]{bob}).Return()
+	mockStorageCurrentEra.On("Exists").Return(false)
+	mockStorageNominators.On("Put", bob, bobNominations).Return()
+
+	err := target.BuildConfig([]byte(validGcJson))
+
+	assert.NoError(t, err)
+	mockStorageValidatorCount.AssertCalled(t, "Put", sc.U32(2))
+	mockStorageMinimumValidatorCount.AssertCalled(t, "Put", sc.U32(1))
+	mockStorageLedger.AssertCalled(t, "Put", alice, aliceLedger)
+	mockStorageLedger.AssertCalled(t, "Put", bob, bobLedger)
+	mockStorageValidators.AssertCalled(t, "Put", alice, ValidatorPrefs{})
+	mockStorageNominators.AssertCalled(t, "Put", bob, bobNominations)
+}
+
+func Test_GenesisConfig_BuildConfig_Invalid(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		gcJson      string
+		expectedErr error
+	}{
+		{
+			name:        "invalid genesis address",
+			gcJson:      "{\"staking\":{\"stakers\":[[1,1000,\"Validator\"]]}}",
+			expectedErr: errInvalidAddrValue,
+		},
+		{
+			name:        "invalid genesis balance",
+			gcJson:      "{\"staking\":{\"stakers\":[[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",\"invalid\",\"Validator\"]]}}",
+			expectedErr: errInvalidBalanceValue,
+		},
+		{
+			name:        "invalid genesis staker status",
+			gcJson:      "{\"staking\":{\"stakers\":[[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",1000,\"Chilled\"]]}}",
+			expectedErr: errInvalidStakerStatusValue,
+		},
+		{
+			name:        "invalid genesis nominator targets",
+			gcJson:      "{\"staking\":{\"stakers\":[[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",1000,{\"Nominator\":1}]]}}",
+			expectedErr: errInvalidStakerStatusValue,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			target := setupModule()
+
+			err := target.BuildConfig([]byte(tt.gcJson))
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,600 @@
+package staking
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func (m Module) Metadata() primitives.MetadataModule {
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesStakingCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesStakingCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Staking, Runtime>"),
+				},
+				m.index,
+				"Call.Staking")),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesStakingEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesStakingEvent, "pallet_staking::Event<Runtime>"),
+				},
+				m.index,
+				"Events.Staking"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"SessionsPerEra",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.sessionsPerEra.Bytes()),
+				"Number of sessions per era.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"BondingDuration",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.bondingDuration.Bytes()),
+				"Number of eras that staked funds must remain bonded for.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"HistoryDepth",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.historyDepth.Bytes()),
+				"Number of eras to keep in history. Rewards of older eras can no longer be claimed.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxNominations",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.maxNominations.Bytes()),
+				"Maximum number of nominations per nominator.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxNominatorRewardedPerValidator",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.maxNominatorRewardedPerValidator.Bytes()),
+				"The maximum number of nominators rewarded for each validator. Only the nominators with the highest stake are rewarded.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxUnlockingChunks",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.maxUnlockingChunks.Bytes()),
+				"The maximum number of `unlocking` chunks a `StakingLedger` can have.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesStakingErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesStakingErrors),
+				},
+				m.index,
+				"Errors.Staking"),
+		),
+		Index: m.index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"ValidatorCount",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+				"The ideal number of active validators."),
+			primitives.NewMetadataModuleStorageEntry(
+				"MinimumValidatorCount",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+				"Minimum number of staking participants before emergency conditions are imposed."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Ledger",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesStakingLedger)),
+				"Map from all (unlocked) stash accounts to the info regarding the staking."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Payee",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesStakingRewardDestination)),
+				"Where the reward payment should be made. Keyed by stash."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Validators",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesStakingValidatorPrefs)),
+				"The map from (wannabe) validator stash key to the preferences of that validator."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ValidatorStashes",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceAddress32)),
+				"The stash accounts of all validator candidates, bounded by `MaxValidators`."),
+			primitives.NewMetadataModuleStorageEntry(
+				"Nominators",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesStakingNominations)),
+				"The map from nominator stash key to their nomination preferences, namely the validators that they wish to support."),
+			primitives.NewMetadataModuleStorageEntry(
+				"NominatorStashes",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceAddress32)),
+				"The stash accounts of all nominators, bounded by `MaxNominators`."),
+			primitives.NewMetadataModuleStorageEntry(
+				"CurrentEra",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+				"The current era index. This is the latest planned era, depending on how the Session pallet queues the validator set, it might be active or not."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ActiveEra",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+				"The active era index. The active era is the era being currently rewarded."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ErasStartSessionIndex",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.PrimitiveTypesU32),
+					sc.ToCompact(metadata.PrimitiveTypesU32)),
+				"The session index at which the era start for the last `HistoryDepth` eras."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ErasElected",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.PrimitiveTypesU32),
+					sc.ToCompact(metadata.TypesSequenceAddress32)),
+				"The validators elected for the last `HistoryDepth` eras."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ErasStakers",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesTupleU32Address32),
+					sc.ToCompact(metadata.TypesStakingExposure)),
+				"Exposure of validator at era, with all the nominators backing it. Used for slashing."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ErasStakersClipped",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesTupleU32Address32),
+					sc.ToCompact(metadata.TypesStakingExposure)),
+				"Clipped exposure of validator at era, limited to the top `MaxNominatorRewardedPerValidator` nominators. Used for rewards."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ErasValidatorPrefs",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesTupleU32Address32),
+					sc.ToCompact(metadata.TypesStakingValidatorPrefs)),
+				"Similar to `ErasStakers`, this holds the preferences of validators. This is keyed first by the era index to allow bulk deletion and then the stash account."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ErasValidatorReward",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.PrimitiveTypesU32),
+					sc.ToCompact(metadata.PrimitiveTypesU128)),
+				"The total validator era payout for the last `HistoryDepth` eras."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ErasRewardPoints",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.PrimitiveTypesU32),
+					sc.ToCompact(metadata.TypesStakingEraRewardPoints)),
+				"Rewards for the last `HistoryDepth` eras. If reward hasn't been set or has been removed then 0 reward is returned."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ErasTotalStake",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.PrimitiveTypesU32),
+					sc.ToCompact(metadata.PrimitiveTypesU128)),
+				"The total amount staked for the last `HistoryDepth` eras. If total hasn't been set or has been removed then 0 stake is returned."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ClaimedRewards",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesTupleU32Address32),
+					sc.ToCompact(metadata.PrimitiveTypesBool)),
+				"Whether the rewards of a validator have been claimed for an era."),
+			primitives.NewMetadataModuleStorageEntry(
+				"ValidatorSlashInEra",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesTupleU32Address32),
+					sc.ToCompact(metadata.TypesPerbill)),
+				"The highest fraction, by which the exposure of a validator has been slashed in an era."),
+			primitives.NewMetadataModuleStorageEntry(
+				"NominatorSlashInEra",
+				primitives.MetadataModuleStorageEntryModifierOptional,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesTupleU32Address32),
+					sc.ToCompact(metadata.PrimitiveTypesU128)),
+				"The highest amount, by which a nominator has been slashed in an era."),
+		},
+	})
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParam(metadata.TypesStakingRewardDestination,
+			"RewardDestination",
+			sc.Sequence[sc.Str]{"pallet_staking", "RewardDestination"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Staked",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						RewardDestinationStaked,
+						"RewardDestination.Staked"),
+					primitives.NewMetadataDefinitionVariant(
+						"Stash",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						RewardDestinationStash,
+						"RewardDestination.Stash"),
+					primitives.NewMetadataDefinitionVariant(
+						"Controller",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						RewardDestinationController,
+						"RewardDestination.Controller"),
+					primitives.NewMetadataDefinitionVariant(
+						"Account",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.TypesAddress32),
+						},
+						RewardDestinationAccount,
+						"RewardDestination.Account"),
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						RewardDestinationNone,
+						"RewardDestination.None"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "AccountId")),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesStakingUnlockChunk,
+			"UnlockChunk",
+			sc.Sequence[sc.Str]{"pallet_staking", "UnlockChunk"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "value", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "era", "EraIndex"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance")),
+
+		primitives.NewMetadataType(metadata.TypesSequenceStakingUnlockChunk,
+			"BoundedVec<UnlockChunk<Balance>, MaxUnlockingChunks>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesStakingUnlockChunk))),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesStakingLedger,
+			"StakingLedger",
+			sc.Sequence[sc.Str]{"pallet_staking", "StakingLedger"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "stash", "T::AccountId"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "total", "BalanceOf<T>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "active", "BalanceOf<T>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceStakingUnlockChunk, "unlocking", "BoundedVec<UnlockChunk<BalanceOf<T>>, T::MaxUnlockingChunks>"),
+				}),
+			primitives.NewMetadataEmptyTypeParameter("T")),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesStakingValidatorPrefs,
+			"ValidatorPrefs",
+			sc.Sequence[sc.Str]{"pallet_staking", "ValidatorPrefs"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesPerbill, "commission", "Perbill"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "blocked", "bool"),
+				})),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesStakingNominations,
+			"Nominations",
+			sc.Sequence[sc.Str]{"pallet_staking", "Nominations"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceAddress32, "targets", "BoundedVec<T::AccountId, MaxNominationsOf<T>>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "submitted_in", "EraIndex"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "suppressed", "bool"),
+				}),
+			primitives.NewMetadataEmptyTypeParameter("T")),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesStakingIndividualExposure,
+			"IndividualExposure",
+			sc.Sequence[sc.Str]{"sp_staking", "IndividualExposure"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "AccountId"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "value", "Balance"),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "AccountId"),
+				primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance"),
+			}),
+
+		primitives.NewMetadataType(metadata.TypesSequenceStakingIndividualExposure,
+			"Vec<IndividualExposure<AccountId, Balance>>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesStakingIndividualExposure))),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesStakingExposure,
+			"Exposure",
+			sc.Sequence[sc.Str]{"sp_staking", "Exposure"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "total", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "own", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceStakingIndividualExposure, "others", "Vec<IndividualExposure<AccountId, Balance>>"),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "AccountId"),
+				primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance"),
+			}),
+
+		primitives.NewMetadataType(metadata.TypesTupleAddress32U32, "(AccountId, u32)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.TypesAddress32),
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+			})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleAddress32U32,
+			"BTreeMap<AccountId, u32>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleAddress32U32))),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesStakingEraRewardPoints,
+			"EraRewardPoints",
+			sc.Sequence[sc.Str]{"pallet_staking", "EraRewardPoints"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "total", "RewardPoint"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceTupleAddress32U32, "individual", "BTreeMap<AccountId, RewardPoint>"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "AccountId")),
+
+		primitives.NewMetadataType(metadata.TypesTupleU32Address32, "(EraIndex, AccountId)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.ToCompact(metadata.TypesAddress32),
+			})),
+
+		primitives.NewMetadataType(metadata.TypesSequenceMultiAddress,
+			"Vec<AccountIdLookupOf<T>>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesMultiAddress))),
+
+		primitives.NewMetadataTypeWithPath(
+			metadata.TypesStakingEvent,
+			"pallet_staking pallet Event",
+			sc.Sequence[sc.Str]{"pallet_staking", "pallet", "Event"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"EraPaid",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "era_index", "EraIndex"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "validator_payout", "BalanceOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "remainder", "BalanceOf<T>"),
+						},
+						EventEraPaid,
+						"The era payout has been set; the first balance is the validator-payout; the second is the remainder from the maximum amount of reward."),
+					primitives.NewMetadataDefinitionVariant(
+						"Rewarded",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "stash", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesStakingRewardDestination, "dest", "RewardDestination<T::AccountId>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "BalanceOf<T>"),
+						},
+						EventRewarded,
+						"The nominator has been rewarded by this amount to this destination."),
+					primitives.NewMetadataDefinitionVariant(
+						"Bonded",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "stash", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "BalanceOf<T>"),
+						},
+						EventBonded,
+						"An account has bonded this amount."),
+					primitives.NewMetadataDefinitionVariant(
+						"Unbonded",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "stash", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "BalanceOf<T>"),
+						},
+						EventUnbonded,
+						"An account has unbonded this amount."),
+					primitives.NewMetadataDefinitionVariant(
+						"Withdrawn",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "stash", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "BalanceOf<T>"),
+						},
+						EventWithdrawn,
+						"An account has called `withdraw_unbonded` and removed unbonding chunks worth `Balance` from the unlocking queue."),
+					primitives.NewMetadataDefinitionVariant(
+						"Chilled",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "stash", "T::AccountId"),
+						},
+						EventChilled,
+						"An account has stopped participating as either a validator or nominator."),
+					primitives.NewMetadataDefinitionVariant(
+						"PayoutStarted",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "era_index", "EraIndex"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "validator_stash", "T::AccountId"),
+						},
+						EventPayoutStarted,
+						"The stakers' rewards are getting paid."),
+					primitives.NewMetadataDefinitionVariant(
+						"ValidatorPrefsSet",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "stash", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesStakingValidatorPrefs, "prefs", "ValidatorPrefs"),
+						},
+						EventValidatorPrefsSet,
+						"A validator has set their preferences."),
+					primitives.NewMetadataDefinitionVariant(
+						"Slashed",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "staker", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "BalanceOf<T>"),
+						},
+						EventSlashed,
+						"A staker (validator or nominator) has been slashed by the given amount."),
+				})),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesStakingErrors,
+			"pallet_staking pallet Error",
+			sc.Sequence[sc.Str]{"pallet_staking", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"NotStash",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNotStash,
+						"Not a stash account."),
+					primitives.NewMetadataDefinitionVariant(
+						"AlreadyBonded",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorAlreadyBonded,
+						"Stash is already bonded."),
+					primitives.NewMetadataDefinitionVariant(
+						"EmptyTargets",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorEmptyTargets,
+						"Targets cannot be empty."),
+					primitives.NewMetadataDefinitionVariant(
+						"InsufficientBond",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorInsufficientBond,
+						"Cannot have a validator or nominator role, with value less than the existential deposit. If unbonding is the intention, `chill` first to remove one's role as validator/nominator."),
+					primitives.NewMetadataDefinitionVariant(
+						"NoMoreChunks",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNoMoreChunks,
+						"Can not schedule more unlock chunks."),
+					primitives.NewMetadataDefinitionVariant(
+						"InvalidEraToReward",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorInvalidEraToReward,
+						"Invalid era to reward."),
+					primitives.NewMetadataDefinitionVariant(
+						"AlreadyClaimed",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorAlreadyClaimed,
+						"Rewards for this era have already been claimed for this validator."),
+					primitives.NewMetadataDefinitionVariant(
+						"TooManyTargets",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManyTargets,
+						"Too many nomination targets supplied."),
+					primitives.NewMetadataDefinitionVariant(
+						"BadTarget",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorBadTarget,
+						"A nomination target was supplied that was blocked or otherwise not a validator."),
+					primitives.NewMetadataDefinitionVariant(
+						"TooManyNominators",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManyNominators,
+						"There are too many nominators in the system. Governance needs to adjust the staking settings to keep things safe for the runtime."),
+					primitives.NewMetadataDefinitionVariant(
+						"TooManyValidators",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManyValidators,
+						"There are too many validator candidates in the system. Governance needs to adjust the staking settings to keep things safe for the runtime."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesStakingCalls,
+			"Staking calls",
+			sc.Sequence[sc.Str]{"pallet_staking", "pallet", "Call"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"bond",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "value", "BalanceOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesStakingRewardDestination, "payee", "RewardDestination<T::AccountId>"),
+						},
+						FunctionBond,
+						"Take the origin account as a stash and lock up `value` of its balance."),
+					primitives.NewMetadataDefinitionVariant(
+						"bond_extra",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "max_additional", "BalanceOf<T>"),
+						},
+						FunctionBondExtra,
+						"Add some extra amount that have appeared in the stash `free_balance` into the balance up for staking."),
+					primitives.NewMetadataDefinitionVariant(
+						"unbond",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "value", "BalanceOf<T>"),
+						},
+						FunctionUnbond,
+						"Schedule a portion of the stash to be unlocked ready for transfer out after the bond period ends."),
+					primitives.NewMetadataDefinitionVariant(
+						"withdraw_unbonded",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						FunctionWithdrawUnbonded,
+						"Remove any unlocked chunks from the `unlocking` queue from our management."),
+					primitives.NewMetadataDefinitionVariant(
+						"validate",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesStakingValidatorPrefs, "prefs", "ValidatorPrefs"),
+						},
+						FunctionValidate,
+						"Declare the desire to validate for the origin stash."),
+					primitives.NewMetadataDefinitionVariant(
+						"nominate",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceMultiAddress, "targets", "Vec<AccountIdLookupOf<T>>"),
+						},
+						FunctionNominate,
+						"Declare the desire to nominate `targets` for the origin stash."),
+					primitives.NewMetadataDefinitionVariant(
+						"chill",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						FunctionChill,
+						"Declare no desire to either validate or nominate."),
+					primitives.NewMetadataDefinitionVariant(
+						"payout_stakers",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "validator_stash", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "era", "EraIndex"),
+						},
+						FunctionPayoutStakers,
+						"Pay out all the stakers behind a single validator for a single era."),
+				}),
+			primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+}
@@ -0,0 +1,722 @@
+package staking
+
+import (
+	"bytes"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/npos_elections"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	FunctionBond = iota
+	FunctionBondExtra
+	FunctionUnbond
+	FunctionWithdrawUnbonded
+	FunctionValidate
+	FunctionNominate
+	FunctionChill
+	FunctionPayoutStakers
+)
+
+const (
+	name = sc.Str("Staking")
+	// rewardPointsPerBlock is the number of reward points a validator earns for authoring a block.
+	rewardPointsPerBlock sc.U32 = 20
+)
+
+var (
+	// LockId is the id of the balance lock, which holds the bonded funds of a stash.
+	LockId = sc.BytesToFixedSequenceU8([]byte("staking "))
+)
+
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	index                            sc.U8
+	dbWeight                         primitives.RuntimeDbWeight
+	sessionsPerEra                   sc.U32
+	bondingDuration                  sc.U32
+	historyDepth                     sc.U32
+	maxNominations                   sc.U32
+	maxNominatorRewardedPerValidator sc.U32
+	maxUnlockingChunks               sc.U32
+	maxValidators                    sc.U32
+	maxNominators                    sc.U32
+	functions                        map[sc.U8]primitives.Call
+	storage                          *storage
+	currency                         Currency
+	systemModule                     system.Module
+	eraPayout                        EraPayout
+	electionProvider                 npos_elections.ElectionProvider
+	session                          *sessionRef
+	mdGenerator                      *primitives.MetadataTypeGenerator
+	logger                           log.RuntimeLogger
+}
+
+func New(index sc.U8, config Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.RuntimeLogger) Module {
+	functions := make(map[sc.U8]primitives.Call)
+
+	module := Module{
+		index:                            index,
+		dbWeight:                         config.DbWeight,
+		sessionsPerEra:                   config.SessionsPerEra,
+		bondingDuration:                  config.BondingDuration,
+		historyDepth:                     config.HistoryDepth,
+		maxNominations:                   config.MaxNominations,
+		maxNominatorRewardedPerValidator: config.MaxNominatorRewardedPerValidator,
+		maxUnlockingChunks:               config.MaxUnlockingChunks,
+		maxValidators:                    config.MaxValidators,
+		maxNominators:                    config.MaxNominators,
+		storage:                          newStorage(config.Storage),
+		currency:                         config.Currency,
+		systemModule:                     config.SystemModule,
+		eraPayout:                        config.EraPayout,
+		electionProvider:                 config.ElectionProvider,
+		session:                          &sessionRef{},
+		mdGenerator:                      mdGenerator,
+		logger:                           logger,
+	}
+
+	functions[FunctionBond] = newCallBond(index, FunctionBond, config.DbWeight, module)
+	functions[FunctionBondExtra] = newCallBondExtra(index, FunctionBondExtra, config.DbWeight, module)
+	functions[FunctionUnbond] = newCallUnbond(index, FunctionUnbond, config.DbWeight, module)
+	functions[FunctionWithdrawUnbonded] = newCallWithdrawUnbonded(index, FunctionWithdrawUnbonded, config.DbWeight, module)
+	functions[FunctionValidate] = newCallValidate(index, FunctionValidate, config.DbWeight, module)
+	functions[FunctionNominate] = newCallNominate(index, FunctionNominate, config.DbWeight, module)
+	functions[FunctionChill] = newCallChill(index, FunctionChill, config.DbWeight, module)
+	functions[FunctionPayoutStakers] = newCallPayoutStakers(index, FunctionPayoutStakers, config.DbWeight, module)
+
+	module.functions = functions
+
+	return module
+}
+
+// sessionRef references the session interface, which is set after construction, as the session module itself
+// depends on the staking module.
+type sessionRef struct {
+	SessionInterface
+}
+
+// SetSessionInterface sets the session interface, through which offending validators are disabled.
+func (m Module) SetSessionInterface(session SessionInterface) {
+	m.session.SessionInterface = session
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) GetIndex() sc.U8 { return m.index }
+
+func (m Module) Functions() map[sc.U8]primitives.Call { return m.functions }
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) { return sc.Empty{}, nil }
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// Ledger returns the staking ledger of `stash`, if it is bonded.
+func (m Module) Ledger(stash primitives.AccountId) (sc.Option[StakingLedger], error) {
+	if !m.storage.Ledger.Exists(stash) {
+		return sc.NewOption[StakingLedger](nil), nil
+	}
+
+	ledger, err := m.storage.Ledger.Get(stash)
+	if err != nil {
+		return sc.Option[StakingLedger]{}, err
+	}
+
+	return sc.NewOption[StakingLedger](ledger), nil
+}
+
+// ExposureOf returns the exposure of `validator` in the active era, which fully identifies it when reporting offences.
+func (m Module) ExposureOf(validator primitives.AccountId) (sc.Option[Exposure], error) {
+	activeEra, err := m.activeEra()
+	if err != nil {
+		return sc.Option[Exposure]{}, err
+	}
+	if !activeEra.HasValue {
+		return sc.NewOption[Exposure](nil), nil
+	}
+
+	exposure, err := m.storage.ErasStakers.Get(EraStash{Era: activeEra.Value, Stash: validator})
+	if err != nil {
+		return sc.Option[Exposure]{}, err
+	}
+
+	return sc.NewOption[Exposure](exposure), nil
+}
+
+// NoteAuthor rewards the `author` of a block with reward points in the active era.
+func (m Module) NoteAuthor(author primitives.AccountId) {
+	activeEra, err := m.activeEra()
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+	if !activeEra.HasValue {
+		return
+	}
+
+	points, err := m.storage.ErasRewardPoints.Get(activeEra.Value)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	m.storage.ErasRewardPoints.Put(activeEra.Value, points.add(author, rewardPointsPerBlock))
+}
+
+// doBond bonds `value` of the free balance of `stash`, paying out its rewards to `payee`.
+func (m Module) doBond(stash primitives.AccountId, value primitives.Balance, payee RewardDestination) error {
+	if m.storage.Ledger.Exists(stash) {
+		return NewDispatchErrorAlreadyBonded(m.index)
+	}
+
+	free, err := m.currency.FreeBalance(stash)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	value = sc.Min128(value, free)
+	if value.Lt(m.currency.ExistentialDeposit()) {
+		return NewDispatchErrorInsufficientBond(m.index)
+	}
+
+	if err := m.systemModule.IncConsumers(stash); err != nil {
+		return err
+	}
+
+	m.storage.Payee.Put(stash, payee)
+
+	ledger := StakingLedger{
+		Stash:     stash,
+		Total:     value,
+		Active:    value,
+		Unlocking: sc.Sequence[UnlockChunk]{},
+	}
+	if err := m.updateLedger(ledger); err != nil {
+		return err
+	}
+
+	m.systemModule.DepositEvent(newEventBonded(m.index, stash, value))
+
+	return nil
+}
+
+// doBondExtra bonds up to `maxAdditional` of the free balance of `stash`, which is not bonded yet.
+func (m Module) doBondExtra(stash primitives.AccountId, maxAdditional primitives.Balance) error {
+	ledger, err := m.ledger(stash)
+	if err != nil {
+		return err
+	}
+
+	free, err := m.currency.FreeBalance(stash)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	extra := sc.Min128(maxAdditional, sc.SaturatingSubU128(free, ledger.Total))
+	ledger.Total = sc.SaturatingAddU128(ledger.Total, extra)
+	ledger.Active = sc.SaturatingAddU128(ledger.Active, extra)
+
+	if ledger.Active.Lt(m.currency.ExistentialDeposit()) {
+		return NewDispatchErrorInsufficientBond(m.index)
+	}
+
+	if err := m.updateLedger(ledger); err != nil {
+		return err
+	}
+
+	m.systemModule.DepositEvent(newEventBonded(m.index, stash, extra))
+
+	return nil
+}
+
+// doUnbond schedules `value` of the active bond of `stash` to be unlocked after the bonding duration.
+// If the remaining active bond falls below the existential deposit, all of it is unbonded and the stash is chilled.
+func (m Module) doUnbond(stash primitives.AccountId, value primitives.Balance) error {
+	ledger, err := m.ledger(stash)
+	if err != nil {
+		return err
+	}
+	if sc.U32(len(ledger.Unlocking)) >= m.maxUnlockingChunks {
+		return NewDispatchErrorNoMoreChunks(m.index)
+	}
+
+	value = sc.Min128(value, ledger.Active)
+	ledger.Active = sc.SaturatingSubU128(ledger.Active, value)
+
+	if ledger.Active.Lt(m.currency.ExistentialDeposit()) {
+		value = sc.SaturatingAddU128(value, ledger.Active)
+		ledger.Active = sc.NewU128(0)
+	}
+
+	if value.Gt(sc.NewU128(0)) {
+		currentEra, err := m.currentEra()
+		if err != nil {
+			return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+		}
+		era := currentEra.Value + m.bondingDuration
+
+		merged := false
+		for i, chunk := range ledger.Unlocking {
+			if chunk.Era == era {
+				ledger.Unlocking[i].Value = sc.SaturatingAddU128(chunk.Value, value)
+				merged = true
+			}
+		}
+		if !merged {
+			ledger.Unlocking = append(ledger.Unlocking, UnlockChunk{Value: value, Era: era})
+		}
+	}
+
+	if err := m.updateLedger(ledger); err != nil {
+		return err
+	}
+
+	if ledger.Active.Eq(sc.NewU128(0)) {
+		if err := m.chill(stash); err != nil {
+			return err
+		}
+	}
+
+	m.systemModule.DepositEvent(newEventUnbonded(m.index, stash, value))
+
+	return nil
+}
+
+// doWithdrawUnbonded unlocks the funds of `stash`, whose bonding duration has passed.
+// Once nothing is bonded or unlocking anymore, the stash is removed.
+func (m Module) doWithdrawUnbonded(stash primitives.AccountId) error {
+	ledger, err := m.ledger(stash)
+	if err != nil {
+		return err
+	}
+
+	currentEra, err := m.currentEra()
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	oldTotal := ledger.Total
+	ledger = ledger.consolidateUnlocked(currentEra.Value)
+
+	if len(ledger.Unlocking) == 0 && ledger.Active.Lt(m.currency.ExistentialDeposit()) {
+		if err := m.killStash(stash); err != nil {
+			return err
+		}
+	} else {
+		if err := m.updateLedger(ledger); err != nil {
+			return err
+		}
+	}
+
+	withdrawn := sc.SaturatingSubU128(oldTotal, ledger.Total)
+	if withdrawn.Gt(sc.NewU128(0)) {
+		m.systemModule.DepositEvent(newEventWithdrawn(m.index, stash, withdrawn))
+	}
+
+	return nil
+}
+
+// doValidate declares the intention of `stash` to validate with preferences `prefs`.
+func (m Module) doValidate(stash primitives.AccountId, prefs ValidatorPrefs) error {
+	ledger, err := m.ledger(stash)
+	if err != nil {
+		return err
+	}
+	if ledger.Active.Lt(m.currency.ExistentialDeposit()) {
+		return NewDispatchErrorInsufficientBond(m.index)
+	}
+
+	if !m.storage.Validators.Exists(stash) {
+		stashes, err := m.storage.ValidatorStashes.Get()
+		if err != nil {
+			return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+		}
+		if sc.U32(len(stashes)) >= m.maxValidators {
+			return NewDispatchErrorTooManyValidators(m.index)
+		}
+		m.storage.ValidatorStashes.Put(append(stashes, stash))
+	}
+
+	if _, err := m.removeNominator(stash); err != nil {
+		return err
+	}
+
+	m.storage.Validators.Put(stash, prefs)
+	m.systemModule.DepositEvent(newEventValidatorPrefsSet(m.index, stash, prefs))
+
+	return nil
+}
+
+// doNominate declares the intention of `stash` to nominate `targets`.
+// Validators, which do not accept new nominations, may only be nominated if they are already nominated by `stash`.
+func (m Module) doNominate(stash primitives.AccountId, targets sc.Sequence[primitives.AccountId]) error {
+	ledger, err := m.ledger(stash)
+	if err != nil {
+		return err
+	}
+	if ledger.Active.Lt(m.currency.ExistentialDeposit()) {
+		return NewDispatchErrorInsufficientBond(m.index)
+	}
+	if len(targets) == 0 {
+		return NewDispatchErrorEmptyTargets(m.index)
+	}
+	if sc.U32(len(targets)) > m.maxNominations {
+		return NewDispatchErrorTooManyTargets(m.index)
+	}
+
+	oldTargets := sc.Sequence[primitives.AccountId]{}
+	if m.storage.Nominators.Exists(stash) {
+		nominations, err := m.storage.Nominators.Get(stash)
+		if err != nil {
+			return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+		}
+		oldTargets = nominations.Targets
+	}
+
+	uniqueTargets := sc.Sequence[primitives.AccountId]{}
+	for _, target := range targets {
+		if containsAccountId(uniqueTargets, target) {
+			continue
+		}
+
+		if !containsAccountId(oldTargets, target) && m.storage.Validators.Exists(target) {
+			prefs, err := m.storage.Validators.Get(target)
+			if err != nil {
+				return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+			}
+			if prefs.Blocked {
+				return NewDispatchErrorBadTarget(m.index)
+			}
+		}
+
+		uniqueTargets = append(uniqueTargets, target)
+	}
+
+	if !m.storage.Nominators.Exists(stash) {
+		stashes, err := m.storage.NominatorStashes.Get()
+		if err != nil {
+			return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+		}
+		if sc.U32(len(stashes)) >= m.maxNominators {
+			return NewDispatchErrorTooManyNominators(m.index)
+		}
+		m.storage.NominatorStashes.Put(append(stashes, stash))
+	}
+
+	if _, err := m.removeValidator(stash); err != nil {
+		return err
+	}
+
+	currentEra, err := m.currentEra()
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	m.storage.Nominators.Put(stash, Nominations{
+		Targets:     uniqueTargets,
+		SubmittedIn: currentEra.Value,
+		Suppressed:  false,
+	})
+
+	return nil
+}
+
+// doChill declares the intention of `stash` to neither validate nor nominate.
+func (m Module) doChill(stash primitives.AccountId) error {
+	if _, err := m.ledger(stash); err != nil {
+		return err
+	}
+
+	return m.chill(stash)
+}
+
+// doPayoutStakers pays out the rewards of `validatorStash` and its top nominators for `era`.
+//
+// The era payout is split between the validators proportionally to their reward points. The validator takes its
+// commission first, and the rest is shared between the validator and its nominators proportionally to their stake.
+func (m Module) doPayoutStakers(validatorStash primitives.AccountId, era sc.U32) error {
+	activeEra, err := m.activeEra()
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if !activeEra.HasValue || era >= activeEra.Value || sc.SaturatingAddU32(era, m.historyDepth) < activeEra.Value {
+		return NewDispatchErrorInvalidEraToReward(m.index)
+	}
+	if !m.storage.ErasValidatorReward.Exists(era) {
+		return NewDispatchErrorInvalidEraToReward(m.index)
+	}
+	if !m.storage.Ledger.Exists(validatorStash) {
+		return NewDispatchErrorNotStash(m.index)
+	}
+
+	key := EraStash{Era: era, Stash: validatorStash}
+	claimed, err := m.storage.ClaimedRewards.Get(key)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if claimed {
+		return NewDispatchErrorAlreadyClaimed(m.index)
+	}
+
+	reward, err := m.storage.ErasValidatorReward.Get(era)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	points, err := m.storage.ErasRewardPoints.Get(era)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	exposure, err := m.storage.ErasStakersClipped.Get(key)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	prefs, err := m.storage.ErasValidatorPrefs.Get(key)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	m.storage.ClaimedRewards.Put(key, true)
+
+	validatorPoints := points.pointsOf(validatorStash)
+	if validatorPoints == 0 || points.Total == 0 {
+		return nil
+	}
+
+	validatorTotalPayout := multiplyByRational(reward, sc.NewU128(validatorPoints), sc.NewU128(points.Total))
+
+	commission, err := prefs.Commission.Mul(validatorTotalPayout)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	validatorCommissionPayout := sc.Min128(commission.(primitives.Balance), validatorTotalPayout)
+	validatorLeftoverPayout := sc.SaturatingSubU128(validatorTotalPayout, validatorCommissionPayout)
+
+	m.systemModule.DepositEvent(newEventPayoutStarted(m.index, era, validatorStash))
+
+	validatorStakingPayout := sc.SaturatingAddU128(
+		validatorCommissionPayout,
+		multiplyByRational(validatorLeftoverPayout, exposure.Own, exposure.Total),
+	)
+	if err := m.makePayout(validatorStash, validatorStakingPayout); err != nil {
+		return err
+	}
+
+	for _, nominator := range exposure.Others {
+		nominatorPayout := multiplyByRational(validatorLeftoverPayout, nominator.Value, exposure.Total)
+		if err := m.makePayout(nominator.Who, nominatorPayout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// makePayout pays out `amount` to the reward destination of `stash`.
+func (m Module) makePayout(stash primitives.AccountId, amount primitives.Balance) error {
+	if amount.Eq(sc.NewU128(0)) || !m.storage.Payee.Exists(stash) {
+		return nil
+	}
+
+	dest, err := m.storage.Payee.Get(stash)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	switch dest.VaryingData[0] {
+	case RewardDestinationStaked:
+		ledger, err := m.ledger(stash)
+		if err != nil {
+			return err
+		}
+		if _, err := m.currency.DepositIntoExisting(stash, amount); err != nil {
+			return err
+		}
+		ledger.Total = sc.SaturatingAddU128(ledger.Total, amount)
+		ledger.Active = sc.SaturatingAddU128(ledger.Active, amount)
+		if err := m.updateLedger(ledger); err != nil {
+			return err
+		}
+	case RewardDestinationStash, RewardDestinationController:
+		if _, err := m.currency.DepositIntoExisting(stash, amount); err != nil {
+			return err
+		}
+	case RewardDestinationAccount:
+		if _, err := m.currency.DepositCreating(dest.VaryingData[1].(primitives.AccountId), amount); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	m.systemModule.DepositEvent(newEventRewarded(m.index, stash, dest, amount))
+
+	return nil
+}
+
+// ledger returns the staking ledger of `stash`, or NotStash if it is not bonded.
+func (m Module) ledger(stash primitives.AccountId) (StakingLedger, error) {
+	if !m.storage.Ledger.Exists(stash) {
+		return StakingLedger{}, NewDispatchErrorNotStash(m.index)
+	}
+
+	ledger, err := m.storage.Ledger.Get(stash)
+	if err != nil {
+		return StakingLedger{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	return ledger, nil
+}
+
+// updateLedger stores `ledger` and locks its total in the balance of the stash.
+func (m Module) updateLedger(ledger StakingLedger) error {
+	if err := m.currency.SetLock(LockId, ledger.Stash, ledger.Total, primitives.ReasonsAll); err != nil {
+		return err
+	}
+
+	m.storage.Ledger.Put(ledger.Stash, ledger)
+
+	return nil
+}
+
+// killStash removes all staking information of `stash` and unlocks its balance.
+func (m Module) killStash(stash primitives.AccountId) error {
+	m.storage.Ledger.Remove(stash)
+	m.storage.Payee.Remove(stash)
+
+	if err := m.chill(stash); err != nil {
+		return err
+	}
+
+	if err := m.currency.RemoveLock(LockId, stash); err != nil {
+		return err
+	}
+
+	return m.systemModule.DecConsumers(stash)
+}
+
+// chill removes `stash` from the validator and nominator candidates.
+func (m Module) chill(stash primitives.AccountId) error {
+	removedValidator, err := m.removeValidator(stash)
+	if err != nil {
+		return err
+	}
+	removedNominator, err := m.removeNominator(stash)
+	if err != nil {
+		return err
+	}
+
+	if removedValidator || removedNominator {
+		m.systemModule.DepositEvent(newEventChilled(m.index, stash))
+	}
+
+	return nil
+}
+
+// removeValidator removes `stash` from the validator candidates. Returns whether it was one.
+func (m Module) removeValidator(stash primitives.AccountId) (bool, error) {
+	if !m.storage.Validators.Exists(stash) {
+		return false, nil
+	}
+
+	stashes, err := m.storage.ValidatorStashes.Get()
+	if err != nil {
+		return false, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	m.storage.Validators.Remove(stash)
+	m.storage.ValidatorStashes.Put(removeAccountId(stashes, stash))
+
+	return true, nil
+}
+
+// removeNominator removes `stash` from the nominators. Returns whether it was one.
+func (m Module) removeNominator(stash primitives.AccountId) (bool, error) {
+	if !m.storage.Nominators.Exists(stash) {
+		return false, nil
+	}
+
+	stashes, err := m.storage.NominatorStashes.Get()
+	if err != nil {
+		return false, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	m.storage.Nominators.Remove(stash)
+	m.storage.NominatorStashes.Put(removeAccountId(stashes, stash))
+
+	return true, nil
+}
+
+func (m Module) currentEra() (sc.Option[sc.U32], error) {
+	if !m.storage.CurrentEra.Exists() {
+		return sc.NewOption[sc.U32](nil), nil
+	}
+
+	era, err := m.storage.CurrentEra.Get()
+	if err != nil {
+		return sc.Option[sc.U32]{}, err
+	}
+
+	return sc.NewOption[sc.U32](era), nil
+}
+
+func (m Module) activeEra() (sc.Option[sc.U32], error) {
+	if !m.storage.ActiveEra.Exists() {
+		return sc.NewOption[sc.U32](nil), nil
+	}
+
+	era, err := m.storage.ActiveEra.Get()
+	if err != nil {
+		return sc.Option[sc.U32]{}, err
+	}
+
+	return sc.NewOption[sc.U32](era), nil
+}
+
+// multiplyByRational computes `value * numerator / denominator` without overflowing.
+func multiplyByRational(value, numerator, denominator primitives.Balance) primitives.Balance {
+	if denominator.Eq(sc.NewU128(0)) {
+		return sc.NewU128(0)
+	}
+
+	result := new(big.Int).Mul(value.ToBigInt(), numerator.ToBigInt())
+	return sc.NewU128(result.Quo(result, denominator.ToBigInt()))
+}
+
+func compareAccountIds(a, b primitives.AccountId) int {
+	return bytes.Compare(a.Bytes(), b.Bytes())
+}
+
+func containsAccountId(accounts sc.Sequence[primitives.AccountId], who primitives.AccountId) bool {
+	for _, account := range accounts {
+		if compareAccountIds(account, who) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func removeAccountId(accounts sc.Sequence[primitives.AccountId], who primitives.AccountId) sc.Sequence[primitives.AccountId] {
+	remaining := sc.Sequence[primitives.AccountId]{}
+	for _, account := range accounts {
+		if compareAccountIds(account, who) != 0 {
+			remaining = append(remaining, account)
+		}
+	}
+	return remaining
+}
+
+func lookup(address primitives.MultiAddress) (primitives.AccountId, error) {
+	who, err := primitives.Lookup(address)
+	if err != nil {
+		return primitives.AccountId{}, primitives.NewDispatchErrorCannotLookup()
+	}
+	return who, nil
+}
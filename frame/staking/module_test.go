@@ -0,0 +1,752 @@
+package staking
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId                         = 15
+	sessionsPerEra                   = sc.U32(6)
+	bondingDuration                  = sc.U32(3)
+	historyDepth                     = sc.U32(84)
+	maxNominations                   = sc.U32(2)
+	maxNominatorRewardedPerValidator = sc.U32(1)
+	maxUnlockingChunks               = sc.U32(2)
+	maxValidators                    = sc.U32(2)
+	maxNominators                    = sc.U32(2)
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	existentialDeposit = sc.NewU128(10)
+
+	who              = constants.OneAccountId
+	nominator        = constants.TwoAccountId
+	other            = constants.ZeroAccountId
+	whoAddress       = primitives.NewMultiAddressId(who)
+	nominatorAddress = primitives.NewMultiAddressId(nominator)
+
+	prefs   = ValidatorPrefs{Commission: primitives.NewPerbillFromPercent(10), Blocked: false}
+	blocked = ValidatorPrefs{Commission: primitives.NewPerbillFromPercent(10), Blocked: true}
+
+	ledger = StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(1000),
+		Active:    sc.NewU128(1000),
+		Unlocking: sc.Sequence[UnlockChunk]{},
+	}
+
+	mdGenerator                           = primitives.NewMetadataTypeGenerator()
+	unknownTransactionNoUnsignedValidator = primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+)
+
+var (
+	mockStorage                      *mocks.IoStorage
+	mockCurrency                     *MockCurrency
+	mockSystemModule                 *mocks.SystemModule
+	mockEraPayout                    *MockEraPayout
+	mockElectionProvider             *MockElectionProvider
+	mockTotalIssuance                *mocks.StorageValue[sc.U128]
+	mockStorageValidatorCount        *mocks.StorageValue[sc.U32]
+	mockStorageMinimumValidatorCount *mocks.StorageValue[sc.U32]
+	mockStorageLedger                *mocks.StorageMap[primitives.AccountId, StakingLedger]
+	mockStoragePayee                 *mocks.StorageMap[primitives.AccountId, RewardDestination]
+	mockStorageValidators            *mocks.StorageMap[primitives.AccountId, ValidatorPrefs]
+	mockStorageValidatorStashes      *mocks.StorageValue[sc.Sequence[primitives.AccountId]]
+	mockStorageNominators            *mocks.StorageMap[primitives.AccountId, Nominations]
+	mockStorageNominatorStashes      *mocks.StorageValue[sc.Sequence[primitives.AccountId]]
+	mockStorageCurrentEra            *mocks.StorageValue[sc.U32]
+	mockStorageActiveEra             *mocks.StorageValue[sc.U32]
+	mockStorageErasStartSessionIndex *mocks.StorageMap[sc.U32, sc.U32]
+	mockStorageErasElected           *mocks.StorageMap[sc.U32, sc.Sequence[primitives.AccountId]]
+	mockStorageErasStakers           *mocks.StorageMap[EraStash, Exposure]
+	mockStorageErasStakersClipped    *mocks.StorageMap[EraStash, Exposure]
+	mockStorageErasValidatorPrefs    *mocks.StorageMap[EraStash, ValidatorPrefs]
+	mockStorageErasValidatorReward   *mocks.StorageMap[sc.U32, primitives.Balance]
+	mockStorageErasRewardPoints      *mocks.StorageMap[sc.U32, EraRewardPoints]
+	mockStorageErasTotalStake        *mocks.StorageMap[sc.U32, primitives.Balance]
+	mockStorageClaimedRewards        *mocks.StorageMap[EraStash, sc.Bool]
+	mockStorageValidatorSlashInEra   *mocks.StorageMap[EraStash, primitives.Perbill]
+	mockStorageNominatorSlashInEra   *mocks.StorageMap[EraStash, primitives.Balance]
+	mockSessionInterface             *MockSessionInterface
+	mockCall                         *mocks.Call
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, 8, len(target.Functions()))
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.NewTransactionSourceLocal(), mockCall)
+
+	assert.Equal(t, unknownTransactionNoUnsignedValidator, err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_Ledger(t *testing.T) {
+	target := setupModule()
+	mockStorageLedger.On("Exists", who).Return(true)
+	mockStorageLedger.On("Get", who).Return(ledger, nil)
+
+	result, err := target.Ledger(who)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[StakingLedger](ledger), result)
+}
+
+func Test_Module_Ledger_NotBonded(t *testing.T) {
+	target := setupModule()
+	mockStorageLedger.On("Exists", who).Return(false)
+
+	result, err := target.Ledger(who)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[StakingLedger](nil), result)
+}
+
+func Test_Module_ExposureOf(t *testing.T) {
+	exposure := Exposure{Total: sc.NewU128(10), Own: sc.NewU128(10), Others: sc.Sequence[IndividualExposure]{}}
+	target := setupModule()
+	mockStorageActiveEra.On("Exists").Return(true)
+	mockStorageActiveEra.On("Get").Return(sc.U32(2), nil)
+	mockStorageErasStakers.On("Get", EraStash{Era: 2, Stash: who}).Return(exposure, nil)
+
+	result, err := target.ExposureOf(who)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[Exposure](exposure), result)
+}
+
+func Test_Module_ExposureOf_NoActiveEra(t *testing.T) {
+	target := setupModule()
+	mockStorageActiveEra.On("Exists").Return(false)
+
+	result, err := target.ExposureOf(who)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[Exposure](nil), result)
+	mockStorageErasStakers.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_NoteAuthor(t *testing.T) {
+	target := setupModule()
+	mockStorageActiveEra.On("Exists").Return(true)
+	mockStorageActiveEra.On("Get").Return(sc.U32(2), nil)
+	mockStorageErasRewardPoints.On("Get", sc.U32(2)).Return(EraRewardPoints{}, nil)
+	mockStorageErasRewardPoints.On("Put", sc.U32(2), mock.Anything).Return()
+
+	target.NoteAuthor(who)
+
+	mockStorageErasRewardPoints.AssertCalled(t, "Put", sc.U32(2), EraRewardPoints{
+		Total:      rewardPointsPerBlock,
+		Individual: sc.Sequence[IndividualRewardPoints]{{Validator: who, Points: rewardPointsPerBlock}},
+	})
+}
+
+func Test_Module_NoteAuthor_NoActiveEra(t *testing.T) {
+	target := setupModule()
+	mockStorageActiveEra.On("Exists").Return(false)
+
+	target.NoteAuthor(who)
+
+	mockStorageErasRewardPoints.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_doBond(t *testing.T) {
+	target := setupModule()
+	mockStorageLedger.On("Exists", who).Return(false)
+	mockCurrency.On("FreeBalance", who).Return(sc.NewU128(1500), nil)
+	mockSystemModule.On("IncConsumers", who).Return(nil)
+	mockStoragePayee.On("Put", who, NewRewardDestinationStaked()).Return()
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(1000), primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", who, ledger).Return()
+
+	err := target.doBond(who, sc.NewU128(1000), NewRewardDestinationStaked())
+
+	assert.NoError(t, err)
+	mockSystemModule.AssertCalled(t, "IncConsumers", who)
+	mockStoragePayee.AssertCalled(t, "Put", who, NewRewardDestinationStaked())
+	mockCurrency.AssertCalled(t, "SetLock", LockId, who, sc.NewU128(1000), primitives.ReasonsAll)
+	mockStorageLedger.AssertCalled(t, "Put", who, ledger)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventBonded(moduleId, who, sc.NewU128(1000)))
+}
+
+func Test_Module_doBond_CappedByFreeBalance(t *testing.T) {
+	target := setupModule()
+	mockStorageLedger.On("Exists", who).Return(false)
+	mockCurrency.On("FreeBalance", who).Return(sc.NewU128(1000), nil)
+	mockSystemModule.On("IncConsumers", who).Return(nil)
+	mockStoragePayee.On("Put", who, NewRewardDestinationStash()).Return()
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(1000), primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", who, ledger).Return()
+
+	err := target.doBond(who, sc.NewU128(5000), NewRewardDestinationStash())
+
+	assert.NoError(t, err)
+	mockStorageLedger.AssertCalled(t, "Put", who, ledger)
+}
+
+func Test_Module_doBond_AlreadyBonded(t *testing.T) {
+	target := setupModule()
+	mockStorageLedger.On("Exists", who).Return(true)
+
+	err := target.doBond(who, sc.NewU128(1000), NewRewardDestinationStaked())
+
+	assert.Equal(t, NewDispatchErrorAlreadyBonded(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "SetLock", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Module_doBond_InsufficientBond(t *testing.T) {
+	target := setupModule()
+	mockStorageLedger.On("Exists", who).Return(false)
+	mockCurrency.On("FreeBalance", who).Return(sc.NewU128(5), nil)
+
+	err := target.doBond(who, sc.NewU128(1000), NewRewardDestinationStaked())
+
+	assert.Equal(t, NewDispatchErrorInsufficientBond(moduleId), err)
+	mockSystemModule.AssertNotCalled(t, "IncConsumers", mock.Anything)
+}
+
+func Test_Module_doBondExtra(t *testing.T) {
+	target := setupModule()
+	expectLedger(who, ledger)
+	mockCurrency.On("FreeBalance", who).Return(sc.NewU128(1300), nil)
+	expected := StakingLedger{Stash: who, Total: sc.NewU128(1200), Active: sc.NewU128(1200), Unlocking: sc.Sequence[UnlockChunk]{}}
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(1200), primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", who, expected).Return()
+
+	err := target.doBondExtra(who, sc.NewU128(200))
+
+	assert.NoError(t, err)
+	mockStorageLedger.AssertCalled(t, "Put", who, expected)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventBonded(moduleId, who, sc.NewU128(200)))
+}
+
+func Test_Module_doBondExtra_NotStash(t *testing.T) {
+	target := setupModule()
+	mockStorageLedger.On("Exists", who).Return(false)
+
+	err := target.doBondExtra(who, sc.NewU128(200))
+
+	assert.Equal(t, NewDispatchErrorNotStash(moduleId), err)
+}
+
+func Test_Module_doUnbond(t *testing.T) {
+	target := setupModule()
+	expectLedger(who, ledger)
+	expectCurrentEra(5)
+	expected := StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(1000),
+		Active:    sc.NewU128(600),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(400), Era: 5 + bondingDuration}},
+	}
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(1000), primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", who, expected).Return()
+
+	err := target.doUnbond(who, sc.NewU128(400))
+
+	assert.NoError(t, err)
+	mockStorageLedger.AssertCalled(t, "Put", who, expected)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventUnbonded(moduleId, who, sc.NewU128(400)))
+}
+
+func Test_Module_doUnbond_MergesChunksOfSameEra(t *testing.T) {
+	target := setupModule()
+	expectLedger(who, StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(1000),
+		Active:    sc.NewU128(600),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(400), Era: 5 + bondingDuration}},
+	})
+	expectCurrentEra(5)
+	expected := StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(1000),
+		Active:    sc.NewU128(500),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(500), Era: 5 + bondingDuration}},
+	}
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(1000), primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", who, expected).Return()
+
+	err := target.doUnbond(who, sc.NewU128(100))
+
+	assert.NoError(t, err)
+	mockStorageLedger.AssertCalled(t, "Put", who, expected)
+}
+
+func Test_Module_doUnbond_All_Chills(t *testing.T) {
+	target := setupModule()
+	expectLedger(who, ledger)
+	expectCurrentEra(5)
+	expected := StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(1000),
+		Active:    sc.NewU128(0),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(1000), Era: 5 + bondingDuration}},
+	}
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(1000), primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", who, expected).Return()
+	expectRemoveValidator(who, sc.Sequence[primitives.AccountId]{who, other}, sc.Sequence[primitives.AccountId]{other})
+	mockStorageNominators.On("Exists", who).Return(false)
+
+	// the remaining active bond is below the existential deposit, so it is unbonded as well
+	err := target.doUnbond(who, sc.NewU128(995))
+
+	assert.NoError(t, err)
+	mockStorageLedger.AssertCalled(t, "Put", who, expected)
+	mockStorageValidators.AssertCalled(t, "Remove", who)
+	mockStorageValidatorStashes.AssertCalled(t, "Put", sc.Sequence[primitives.AccountId]{other})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventChilled(moduleId, who))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventUnbonded(moduleId, who, sc.NewU128(1000)))
+}
+
+func Test_Module_doUnbond_NoMoreChunks(t *testing.T) {
+	target := setupModule()
+	expectLedger(who, StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(1000),
+		Active:    sc.NewU128(600),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(200), Era: 6}, {Value: sc.NewU128(200), Era: 7}},
+	})
+
+	err := target.doUnbond(who, sc.NewU128(100))
+
+	assert.Equal(t, NewDispatchErrorNoMoreChunks(moduleId), err)
+}
+
+func Test_Module_doWithdrawUnbonded(t *testing.T) {
+	target := setupModule()
+	expectLedger(who, StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(1000),
+		Active:    sc.NewU128(600),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(300), Era: 4}, {Value: sc.NewU128(100), Era: 8}},
+	})
+	expectCurrentEra(5)
+	expected := StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(700),
+		Active:    sc.NewU128(600),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(100), Era: 8}},
+	}
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(700), primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", who, expected).Return()
+
+	err := target.doWithdrawUnbonded(who)
+
+	assert.NoError(t, err)
+	mockStorageLedger.AssertCalled(t, "Put", who, expected)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventWithdrawn(moduleId, who, sc.NewU128(300)))
+}
+
+func Test_Module_doWithdrawUnbonded_KillsStash(t *testing.T) {
+	target := setupModule()
+	expectLedger(who, StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(1000),
+		Active:    sc.NewU128(0),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(1000), Era: 4}},
+	})
+	expectCurrentEra(5)
+	mockStorageLedger.On("Remove", who).Return()
+	mockStoragePayee.On("Remove", who).Return()
+	mockStorageValidators.On("Exists", who).Return(false)
+	mockStorageNominators.On("Exists", who).Return(false)
+	mockCurrency.On("RemoveLock", LockId, who).Return(nil)
+	mockSystemModule.On("DecConsumers", who).Return(nil)
+
+	err := target.doWithdrawUnbonded(who)
+
+	assert.NoError(t, err)
+	mockStorageLedger.AssertCalled(t, "Remove", who)
+	mockStoragePayee.AssertCalled(t, "Remove", who)
+	mockCurrency.AssertCalled(t, "RemoveLock", LockId, who)
+	mockSystemModule.AssertCalled(t, "DecConsumers", who)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventWithdrawn(moduleId, who, sc.NewU128(1000)))
+}
+
+func Test_Module_doValidate(t *testing.T) {
+	target := setupModule()
+	expectLedger(who, ledger)
+	mockStorageValidators.On("Exists", who).Return(false)
+	mockStorageValidatorStashes.On("Get").Return(sc.Sequence[primitives.AccountId]{other}, nil)
+	mockStorageValidatorStashes.On("Put", sc.Sequence[primitives.AccountId]{other, who}).Return()
+	expectRemoveNominator(who, sc.Sequence[primitives.AccountId]{who}, sc.Sequence[primitives.AccountId]{})
+	mockStorageValidators.On("Put", who, prefs).Return()
+
+	err := target.doValidate(who, prefs)
+
+	assert.NoError(t, err)
+	mockStorageValidatorStashes.AssertCalled(t, "Put", sc.Sequence[primitives.AccountId]{other, who})
+	mockStorageNominators.AssertCalled(t, "Remove", who)
+	mockStorageValidators.AssertCalled(t, "Put", who, prefs)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventValidatorPrefsSet(moduleId, who, prefs))
+}
+
+func Test_Module_doValidate_TooManyValidators(t *testing.T) {
+	target := setupModule()
+	expectLedger(who, ledger)
+	mockStorageValidators.On("Exists", who).Return(false)
+	mockStorageValidatorStashes.On("Get").Return(sc.Sequence[primitives.AccountId]{other, nominator}, nil)
+
+	err := target.doValidate(who, prefs)
+
+	assert.Equal(t, NewDispatchErrorTooManyValidators(moduleId), err)
+	mockStorageValidators.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_doNominate(t *testing.T) {
+	target := setupModule()
+	expectLedger(nominator, ledger)
+	mockStorageNominators.On("Exists", nominator).Return(false)
+	mockStorageValidators.On("Exists", who).Return(true)
+	mockStorageValidators.On("Get", who).Return(prefs, nil)
+	mockStorageValidators.On("Exists", other).Return(false)
+	mockStorageValidators.On("Exists", nominator).Return(false)
+	mockStorageNominatorStashes.On("Get").Return(sc.Sequence[primitives.AccountId]{}, nil)
+	mockStorageNominatorStashes.On("Put", sc.Sequence[primitives.AccountId]{nominator}).Return()
+	expectCurrentEra(5)
+	expected := Nominations{Targets: sc.Sequence[primitives.AccountId]{who, other}, SubmittedIn: 5, Suppressed: false}
+	mockStorageNominators.On("Put", nominator, expected).Return()
+
+	err := target.doNominate(nominator, sc.Sequence[primitives.AccountId]{who, other})
+
+	assert.NoError(t, err)
+	mockStorageNominatorStashes.AssertCalled(t, "Put", sc.Sequence[primitives.AccountId]{nominator})
+	mockStorageNominators.AssertCalled(t, "Put", nominator, expected)
+}
+
+func Test_Module_doNominate_Deduplicates(t *testing.T) {
+	target := setupModule()
+	expectLedger(nominator, ledger)
+	mockStorageNominators.On("Exists", nominator).Return(false)
+	mockStorageValidators.On("Exists", who).Return(false)
+	mockStorageValidators.On("Exists", nominator).Return(false)
+	mockStorageNominatorStashes.On("Get").Return(sc.Sequence[primitives.AccountId]{}, nil)
+	mockStorageNominatorStashes.On("Put", mock.Anything).Return()
+	expectCurrentEra(5)
+	expected := Nominations{Targets: sc.Sequence[primitives.AccountId]{who}, SubmittedIn: 5, Suppressed: false}
+	mockStorageNominators.On("Put", nominator, expected).Return()
+
+	err := target.doNominate(nominator, sc.Sequence[primitives.AccountId]{who, who})
+
+	assert.NoError(t, err)
+	mockStorageNominators.AssertCalled(t, "Put", nominator, expected)
+}
+
+func Test_Module_doNominate_EmptyTargets(t *testing.T) {
+	target := setupModule()
+	expectLedger(nominator, ledger)
+
+	err := target.doNominate(nominator, sc.Sequence[primitives.AccountId]{})
+
+	assert.Equal(t, NewDispatchErrorEmptyTargets(moduleId), err)
+}
+
+func Test_Module_doNominate_TooManyTargets(t *testing.T) {
+	target := setupModule()
+	expectLedger(nominator, ledger)
+
+	err := target.doNominate(nominator, sc.Sequence[primitives.AccountId]{who, other, nominator})
+
+	assert.Equal(t, NewDispatchErrorTooManyTargets(moduleId), err)
+}
+
+func Test_Module_doNominate_BadTarget(t *testing.T) {
+	target := setupModule()
+	expectLedger(nominator, ledger)
+	mockStorageNominators.On("Exists", nominator).Return(false)
+	mockStorageValidators.On("Exists", who).Return(true)
+	mockStorageValidators.On("Get", who).Return(blocked, nil)
+
+	err := target.doNominate(nominator, sc.Sequence[primitives.AccountId]{who})
+
+	assert.Equal(t, NewDispatchErrorBadTarget(moduleId), err)
+	mockStorageNominators.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_doNominate_BlockedAlreadyNominated(t *testing.T) {
+	target := setupModule()
+	expectLedger(nominator, ledger)
+	mockStorageNominators.On("Exists", nominator).Return(true)
+	mockStorageNominators.On("Get", nominator).Return(Nominations{Targets: sc.Sequence[primitives.AccountId]{who}}, nil)
+	mockStorageValidators.On("Exists", nominator).Return(false)
+	expectCurrentEra(5)
+	expected := Nominations{Targets: sc.Sequence[primitives.AccountId]{who}, SubmittedIn: 5, Suppressed: false}
+	mockStorageNominators.On("Put", nominator, expected).Return()
+
+	err := target.doNominate(nominator, sc.Sequence[primitives.AccountId]{who})
+
+	assert.NoError(t, err)
+	mockStorageValidators.AssertNotCalled(t, "Get", who)
+	mockStorageNominators.AssertCalled(t, "Put", nominator, expected)
+}
+
+func Test_Module_doChill(t *testing.T) {
+	target := setupModule()
+	expectLedger(nominator, ledger)
+	mockStorageValidators.On("Exists", nominator).Return(false)
+	expectRemoveNominator(nominator, sc.Sequence[primitives.AccountId]{nominator}, sc.Sequence[primitives.AccountId]{})
+
+	err := target.doChill(nominator)
+
+	assert.NoError(t, err)
+	mockStorageNominators.AssertCalled(t, "Remove", nominator)
+	mockStorageNominatorStashes.AssertCalled(t, "Put", sc.Sequence[primitives.AccountId]{})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventChilled(moduleId, nominator))
+}
+
+func Test_Module_doChill_NotStash(t *testing.T) {
+	target := setupModule()
+	mockStorageLedger.On("Exists", nominator).Return(false)
+
+	err := target.doChill(nominator)
+
+	assert.Equal(t, NewDispatchErrorNotStash(moduleId), err)
+}
+
+func Test_Module_doPayoutStakers(t *testing.T) {
+	target := setupModule()
+	key := EraStash{Era: 3, Stash: who}
+	expectActiveEra(4)
+	mockStorageErasValidatorReward.On("Exists", sc.U32(3)).Return(true)
+	mockStorageLedger.On("Exists", who).Return(true)
+	mockStorageClaimedRewards.On("Get", key).Return(sc.Bool(false), nil)
+	mockStorageErasValidatorReward.On("Get", sc.U32(3)).Return(sc.NewU128(2000), nil)
+	mockStorageErasRewardPoints.On("Get", sc.U32(3)).Return(EraRewardPoints{
+		Total: 40,
+		Individual: sc.Sequence[IndividualRewardPoints]{
+			{Validator: who, Points: 20},
+			{Validator: other, Points: 20},
+		},
+	}, nil)
+	mockStorageErasStakersClipped.On("Get", key).Return(Exposure{
+		Total:  sc.NewU128(1000),
+		Own:    sc.NewU128(750),
+		Others: sc.Sequence[IndividualExposure]{{Who: nominator, Value: sc.NewU128(250)}},
+	}, nil)
+	mockStorageErasValidatorPrefs.On("Get", key).Return(prefs, nil)
+	mockStorageClaimedRewards.On("Put", key, sc.Bool(true)).Return()
+	mockStoragePayee.On("Exists", who).Return(true)
+	mockStoragePayee.On("Get", who).Return(NewRewardDestinationStash(), nil)
+	mockStoragePayee.On("Exists", nominator).Return(true)
+	mockStoragePayee.On("Get", nominator).Return(NewRewardDestinationAccount(other), nil)
+	// validator: 1000 share, 100 commission, 3/4 of the remaining 900
+	mockCurrency.On("DepositIntoExisting", who, sc.NewU128(775)).Return(sc.NewU128(775), nil)
+	// nominator: 1/4 of the remaining 900
+	mockCurrency.On("DepositCreating", other, sc.NewU128(225)).Return(sc.NewU128(225), nil)
+
+	err := target.doPayoutStakers(who, 3)
+
+	assert.NoError(t, err)
+	mockStorageClaimedRewards.AssertCalled(t, "Put", key, sc.Bool(true))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventPayoutStarted(moduleId, 3, who))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventRewarded(moduleId, who, NewRewardDestinationStash(), sc.NewU128(775)))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventRewarded(moduleId, nominator, NewRewardDestinationAccount(other), sc.NewU128(225)))
+}
+
+func Test_Module_doPayoutStakers_InvalidEraToReward(t *testing.T) {
+	for _, era := range []sc.U32{4, 5} {
+		target := setupModule()
+		expectActiveEra(4)
+
+		err := target.doPayoutStakers(who, era)
+
+		assert.Equal(t, NewDispatchErrorInvalidEraToReward(moduleId), err)
+	}
+}
+
+func Test_Module_doPayoutStakers_OutsideHistoryDepth(t *testing.T) {
+	target := setupModule()
+	expectActiveEra(historyDepth + 4)
+
+	err := target.doPayoutStakers(who, 3)
+
+	assert.Equal(t, NewDispatchErrorInvalidEraToReward(moduleId), err)
+}
+
+func Test_Module_doPayoutStakers_AlreadyClaimed(t *testing.T) {
+	target := setupModule()
+	key := EraStash{Era: 3, Stash: who}
+	expectActiveEra(4)
+	mockStorageErasValidatorReward.On("Exists", sc.U32(3)).Return(true)
+	mockStorageLedger.On("Exists", who).Return(true)
+	mockStorageClaimedRewards.On("Get", key).Return(sc.Bool(true), nil)
+
+	err := target.doPayoutStakers(who, 3)
+
+	assert.Equal(t, NewDispatchErrorAlreadyClaimed(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "DepositIntoExisting", mock.Anything, mock.Anything)
+}
+
+func Test_Module_makePayout_Staked(t *testing.T) {
+	target := setupModule()
+	mockStoragePayee.On("Exists", who).Return(true)
+	mockStoragePayee.On("Get", who).Return(NewRewardDestinationStaked(), nil)
+	expectLedger(who, ledger)
+	mockCurrency.On("DepositIntoExisting", who, sc.NewU128(100)).Return(sc.NewU128(100), nil)
+	expected := StakingLedger{Stash: who, Total: sc.NewU128(1100), Active: sc.NewU128(1100), Unlocking: sc.Sequence[UnlockChunk]{}}
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(1100), primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", who, expected).Return()
+
+	err := target.makePayout(who, sc.NewU128(100))
+
+	assert.NoError(t, err)
+	mockStorageLedger.AssertCalled(t, "Put", who, expected)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventRewarded(moduleId, who, NewRewardDestinationStaked(), sc.NewU128(100)))
+}
+
+func Test_Module_makePayout_None(t *testing.T) {
+	target := setupModule()
+	mockStoragePayee.On("Exists", who).Return(true)
+	mockStoragePayee.On("Get", who).Return(NewRewardDestinationNone(), nil)
+
+	err := target.makePayout(who, sc.NewU128(100))
+
+	assert.NoError(t, err)
+	mockSystemModule.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func Test_multiplyByRational(t *testing.T) {
+	assert.Equal(t, sc.NewU128(250), multiplyByRational(sc.NewU128(1000), sc.NewU128(1), sc.NewU128(4)))
+	assert.Equal(t, sc.NewU128(0), multiplyByRational(sc.NewU128(1000), sc.NewU128(1), sc.NewU128(0)))
+}
+
+func expectLedger(stash primitives.AccountId, l StakingLedger) {
+	mockStorageLedger.On("Exists", stash).Return(true)
+	mockStorageLedger.On("Get", stash).Return(l, nil)
+}
+
+func expectCurrentEra(era sc.U32) {
+	mockStorageCurrentEra.On("Exists").Return(true)
+	mockStorageCurrentEra.On("Get").Return(era, nil)
+}
+
+func expectActiveEra(era sc.U32) {
+	mockStorageActiveEra.On("Exists").Return(true)
+	mockStorageActiveEra.On("Get").Return(era, nil)
+}
+
+func expectRemoveValidator(stash primitives.AccountId, stashes, remaining sc.Sequence[primitives.AccountId]) {
+	mockStorageValidators.On("Exists", stash).Return(true)
+	mockStorageValidatorStashes.On("Get").Return(stashes, nil)
+	mockStorageValidators.On("Remove", stash).Return()
+	mockStorageValidatorStashes.On("Put", remaining).Return()
+}
+
+func expectRemoveNominator(stash primitives.AccountId, stashes, remaining sc.Sequence[primitives.AccountId]) {
+	mockStorageNominators.On("Exists", stash).Return(true)
+	mockStorageNominatorStashes.On("Get").Return(stashes, nil)
+	mockStorageNominators.On("Remove", stash).Return()
+	mockStorageNominatorStashes.On("Put", remaining).Return()
+}
+
+func setupModule() Module {
+	mockStorage = new(mocks.IoStorage)
+	mockCurrency = new(MockCurrency)
+	mockSystemModule = new(mocks.SystemModule)
+	mockEraPayout = new(MockEraPayout)
+	mockElectionProvider = new(MockElectionProvider)
+	mockTotalIssuance = new(mocks.StorageValue[sc.U128])
+	mockStorageValidatorCount = new(mocks.StorageValue[sc.U32])
+	mockStorageMinimumValidatorCount = new(mocks.StorageValue[sc.U32])
+	mockStorageLedger = new(mocks.StorageMap[primitives.AccountId, StakingLedger])
+	mockStoragePayee = new(mocks.StorageMap[primitives.AccountId, RewardDestination])
+	mockStorageValidators = new(mocks.StorageMap[primitives.AccountId, ValidatorPrefs])
+	mockStorageValidatorStashes = new(mocks.StorageValue[sc.Sequence[primitives.AccountId]])
+	mockStorageNominators = new(mocks.StorageMap[primitives.AccountId, Nominations])
+	mockStorageNominatorStashes = new(mocks.StorageValue[sc.Sequence[primitives.AccountId]])
+	mockStorageCurrentEra = new(mocks.StorageValue[sc.U32])
+	mockStorageActiveEra = new(mocks.StorageValue[sc.U32])
+	mockStorageErasStartSessionIndex = new(mocks.StorageMap[sc.U32, sc.U32])
+	mockStorageErasElected = new(mocks.StorageMap[sc.U32, sc.Sequence[primitives.AccountId]])
+	mockStorageErasStakers = new(mocks.StorageMap[EraStash, Exposure])
+	mockStorageErasStakersClipped = new(mocks.StorageMap[EraStash, Exposure])
+	mockStorageErasValidatorPrefs = new(mocks.StorageMap[EraStash, ValidatorPrefs])
+	mockStorageErasValidatorReward = new(mocks.StorageMap[sc.U32, primitives.Balance])
+	mockStorageErasRewardPoints = new(mocks.StorageMap[sc.U32, EraRewardPoints])
+	mockStorageErasTotalStake = new(mocks.StorageMap[sc.U32, primitives.Balance])
+	mockStorageClaimedRewards = new(mocks.StorageMap[EraStash, sc.Bool])
+	mockStorageValidatorSlashInEra = new(mocks.StorageMap[EraStash, primitives.Perbill])
+	mockStorageNominatorSlashInEra = new(mocks.StorageMap[EraStash, primitives.Balance])
+	mockSessionInterface = new(MockSessionInterface)
+	mockCall = new(mocks.Call)
+
+	config := NewConfig(
+		mockStorage,
+		dbWeight,
+		mockCurrency,
+		mockSystemModule,
+		mockEraPayout,
+		mockElectionProvider,
+		sessionsPerEra,
+		bondingDuration,
+		historyDepth,
+		maxNominations,
+		maxNominatorRewardedPerValidator,
+		maxUnlockingChunks,
+		maxValidators,
+		maxNominators,
+	)
+
+	target := New(moduleId, config, mdGenerator, log.NewLogger())
+	target.storage.ValidatorCount = mockStorageValidatorCount
+	target.storage.MinimumValidatorCount = mockStorageMinimumValidatorCount
+	target.storage.Ledger = mockStorageLedger
+	target.storage.Payee = mockStoragePayee
+	target.storage.Validators = mockStorageValidators
+	target.storage.ValidatorStashes = mockStorageValidatorStashes
+	target.storage.Nominators = mockStorageNominators
+	target.storage.NominatorStashes = mockStorageNominatorStashes
+	target.storage.CurrentEra = mockStorageCurrentEra
+	target.storage.ActiveEra = mockStorageActiveEra
+	target.storage.ErasStartSessionIndex = mockStorageErasStartSessionIndex
+	target.storage.ErasElected = mockStorageErasElected
+	target.storage.ErasStakers = mockStorageErasStakers
+	target.storage.ErasStakersClipped = mockStorageErasStakersClipped
+	target.storage.ErasValidatorPrefs = mockStorageErasValidatorPrefs
+	target.storage.ErasValidatorReward = mockStorageErasValidatorReward
+	target.storage.ErasRewardPoints = mockStorageErasRewardPoints
+	target.storage.ErasTotalStake = mockStorageErasTotalStake
+	target.storage.ClaimedRewards = mockStorageClaimedRewards
+	target.storage.ValidatorSlashInEra = mockStorageValidatorSlashInEra
+	target.storage.NominatorSlashInEra = mockStorageNominatorSlashInEra
+	target.SetSessionInterface(mockSessionInterface)
+
+	mockCurrency.On("ExistentialDeposit").Return(existentialDeposit)
+	mockCurrency.On("TotalIssuance").Return(mockTotalIssuance)
+	mockSystemModule.On("DepositEvent", mock.Anything)
+
+	return target
+}
@@ -0,0 +1,186 @@
+package staking
+
+import (
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	stakingtypes "github.com/LimeChain/gosemble/primitives/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	errSlashFractionsMismatch = errors.New("number of slash fractions does not match number of offenders")
+)
+
+// OnOffence slashes the exposure of each of the `offenders` by the respective `slashFraction` in the era of session
+// `sessionIndex`. Offenders in the active era are also chilled and disabled for the rest of the session. Offences
+// older than the bonding duration are ignored, as the funds at stake may already be withdrawn.
+func (m Module) OnOffence(offenders []stakingtypes.OffenceDetails, slashFraction []primitives.Perbill, sessionIndex sc.U32) (primitives.Weight, error) {
+	if len(slashFraction) != len(offenders) {
+		return primitives.WeightZero(), errSlashFractionsMismatch
+	}
+
+	weight := m.dbWeight.Reads(1)
+
+	activeEra, err := m.activeEra()
+	if err != nil {
+		return weight, err
+	}
+	if !activeEra.HasValue {
+		return weight, nil
+	}
+
+	slashEra, err := m.slashEra(activeEra.Value, sessionIndex)
+	if err != nil {
+		return weight, err
+	}
+	if !slashEra.HasValue {
+		return weight, nil
+	}
+
+	for i, details := range offenders {
+		stash := details.Offender
+		key := EraStash{Era: slashEra.Value, Stash: stash}
+
+		weight = weight.SaturatingAdd(m.dbWeight.Reads(2))
+		if !m.storage.ErasStakers.Exists(key) {
+			continue
+		}
+		exposure, err := m.storage.ErasStakers.Get(key)
+		if err != nil {
+			return weight, err
+		}
+
+		slashWeight, err := m.slashExposure(key, exposure, slashFraction[i])
+		weight = weight.SaturatingAdd(slashWeight)
+		if err != nil {
+			return weight, err
+		}
+
+		if slashEra.Value != activeEra.Value {
+			continue
+		}
+
+		weight = weight.SaturatingAdd(m.dbWeight.ReadsWrites(3, 3))
+		if err := m.chill(stash); err != nil {
+			return weight, err
+		}
+		if m.session.SessionInterface != nil {
+			if _, err := m.session.Disable(stash); err != nil {
+				return weight, err
+			}
+		}
+	}
+
+	return weight, nil
+}
+
+// slashEra returns the era, in which session `sessionIndex` started, if it is within the bonding duration.
+func (m Module) slashEra(activeEra sc.U32, sessionIndex sc.U32) (sc.Option[sc.U32], error) {
+	for era := activeEra; activeEra-era < m.bondingDuration; era-- {
+		if !m.storage.ErasStartSessionIndex.Exists(era) {
+			break
+		}
+		start, err := m.storage.ErasStartSessionIndex.Get(era)
+		if err != nil {
+			return sc.Option[sc.U32]{}, err
+		}
+		if start <= sessionIndex {
+			return sc.NewOption[sc.U32](era), nil
+		}
+		if era == 0 {
+			break
+		}
+	}
+
+	return sc.NewOption[sc.U32](nil), nil
+}
+
+// slashExposure slashes the validator and the nominators in `exposure` by `fraction` of their stake in the era of
+// `key`. Only the part of `fraction`, which exceeds earlier slashes of the same validator in that era, is slashed.
+// A nominator backing several offending validators is slashed only by the highest of the resulting amounts.
+func (m Module) slashExposure(key EraStash, exposure Exposure, fraction primitives.Perbill) (primitives.Weight, error) {
+	weight := m.dbWeight.Reads(1)
+
+	prior := primitives.Perbill{}
+	if m.storage.ValidatorSlashInEra.Exists(key) {
+		slashed, err := m.storage.ValidatorSlashInEra.Get(key)
+		if err != nil {
+			return weight, err
+		}
+		prior = slashed
+	}
+	if fraction.Parts <= prior.Parts {
+		return weight, nil
+	}
+
+	weight = weight.SaturatingAdd(m.dbWeight.Writes(1))
+	m.storage.ValidatorSlashInEra.Put(key, fraction)
+
+	difference := sc.NewU128(fraction.Parts - prior.Parts)
+	billion := sc.NewU128(1_000_000_000)
+
+	weight = weight.SaturatingAdd(m.dbWeight.ReadsWrites(3, 3))
+	if err := m.doSlash(key.Stash, multiplyByRational(exposure.Own, difference, billion)); err != nil {
+		return weight, err
+	}
+
+	for _, nominator := range exposure.Others {
+		weight = weight.SaturatingAdd(m.dbWeight.ReadsWrites(4, 4))
+		if err := m.slashNominator(EraStash{Era: key.Era, Stash: nominator.Who}, nominator.Value, fraction); err != nil {
+			return weight, err
+		}
+	}
+
+	return weight, nil
+}
+
+// slashNominator slashes the nominator of `key` by the part of `fraction` of `value`, which exceeds the amount
+// already slashed from it in the era of `key`.
+func (m Module) slashNominator(key EraStash, value primitives.Balance, fraction primitives.Perbill) error {
+	amount := multiplyByRational(value, sc.NewU128(fraction.Parts), sc.NewU128(1_000_000_000))
+
+	prior := sc.NewU128(0)
+	if m.storage.NominatorSlashInEra.Exists(key) {
+		slashed, err := m.storage.NominatorSlashInEra.Get(key)
+		if err != nil {
+			return err
+		}
+		prior = slashed
+	}
+	if amount.Lte(prior) {
+		return nil
+	}
+
+	m.storage.NominatorSlashInEra.Put(key, amount)
+
+	return m.doSlash(key.Stash, sc.SaturatingSubU128(amount, prior))
+}
+
+// doSlash reduces the bonded funds of `stash` by up to `value` and burns them.
+func (m Module) doSlash(stash primitives.AccountId, value primitives.Balance) error {
+	if value.Eq(sc.NewU128(0)) || !m.storage.Ledger.Exists(stash) {
+		return nil
+	}
+
+	ledger, err := m.storage.Ledger.Get(stash)
+	if err != nil {
+		return err
+	}
+
+	ledger, slashed := ledger.slash(value)
+	if slashed.Eq(sc.NewU128(0)) {
+		return nil
+	}
+
+	if err := m.updateLedger(ledger); err != nil {
+		return err
+	}
+	if _, err := m.currency.Slash(stash, slashed); err != nil {
+		return err
+	}
+
+	m.systemModule.DepositEvent(newEventSlashed(m.index, stash, slashed))
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package staking
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	stakingtypes "github.com/LimeChain/gosemble/primitives/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	offenders = []stakingtypes.OffenceDetails{
+		{Offender: who, Reporters: sc.Sequence[primitives.AccountId]{other}},
+	}
+	slashFractions = []primitives.Perbill{primitives.NewPerbillFromPercent(10)}
+
+	offenderExposure = Exposure{
+		Total:  sc.NewU128(1500),
+		Own:    sc.NewU128(1000),
+		Others: sc.Sequence[IndividualExposure]{{Who: nominator, Value: sc.NewU128(500)}},
+	}
+	nominatorLedger = StakingLedger{
+		Stash:     nominator,
+		Total:     sc.NewU128(500),
+		Active:    sc.NewU128(500),
+		Unlocking: sc.Sequence[UnlockChunk]{},
+	}
+)
+
+func Test_Module_OnOffence(t *testing.T) {
+	target := setupModule()
+	key := EraStash{Era: 2, Stash: who}
+	expectActiveEra(2)
+	expectEraStart(2, 12)
+	mockStorageErasStakers.On("Exists", key).Return(true)
+	mockStorageErasStakers.On("Get", key).Return(offenderExposure, nil)
+	mockStorageValidatorSlashInEra.On("Exists", key).Return(false)
+	mockStorageValidatorSlashInEra.On("Put", key, slashFractions[0]).Return()
+	expectNominatorSlashInEra(2, sc.NewOption[primitives.Balance](nil), sc.NewU128(50))
+	expectSlash(who, ledger, sc.NewU128(100))
+	expectSlash(nominator, nominatorLedger, sc.NewU128(50))
+	expectRemoveValidator(who, sc.Sequence[primitives.AccountId]{who}, sc.Sequence[primitives.AccountId]{})
+	mockStorageNominators.On("Exists", who).Return(false)
+	mockSessionInterface.On("Disable", who).Return(true, nil)
+
+	_, err := target.OnOffence(offenders, slashFractions, 13)
+	assert.NoError(t, err)
+
+	mockStorageValidatorSlashInEra.AssertCalled(t, "Put", key, slashFractions[0])
+	mockStorageNominatorSlashInEra.AssertCalled(t, "Put", EraStash{Era: 2, Stash: nominator}, sc.NewU128(50))
+	mockCurrency.AssertCalled(t, "Slash", who, sc.NewU128(100))
+	mockCurrency.AssertCalled(t, "Slash", nominator, sc.NewU128(50))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventSlashed(moduleId, who, sc.NewU128(100)))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventSlashed(moduleId, nominator, sc.NewU128(50)))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventChilled(moduleId, who))
+	mockSessionInterface.AssertCalled(t, "Disable", who)
+}
+
+func Test_Module_OnOffence_SlashFractionsMismatch(t *testing.T) {
+	target := setupModule()
+
+	weight, err := target.OnOffence(offenders, []primitives.Perbill{}, 13)
+
+	assert.Equal(t, errSlashFractionsMismatch, err)
+	assert.Equal(t, primitives.WeightZero(), weight)
+	mockStorageActiveEra.AssertNotCalled(t, "Get")
+}
+
+func Test_Module_OnOffence_PreviousEra(t *testing.T) {
+	target := setupModule()
+	key := EraStash{Era: 1, Stash: who}
+	expectActiveEra(2)
+	expectEraStart(2, 12)
+	expectEraStart(1, 6)
+	mockStorageErasStakers.On("Exists", key).Return(true)
+	mockStorageErasStakers.On("Get", key).Return(offenderExposure, nil)
+	mockStorageValidatorSlashInEra.On("Exists", key).Return(false)
+	mockStorageValidatorSlashInEra.On("Put", key, slashFractions[0]).Return()
+	expectNominatorSlashInEra(1, sc.NewOption[primitives.Balance](nil), sc.NewU128(50))
+	expectSlash(who, ledger, sc.NewU128(100))
+	expectSlash(nominator, nominatorLedger, sc.NewU128(50))
+
+	_, err := target.OnOffence(offenders, slashFractions, 7)
+	assert.NoError(t, err)
+
+	mockCurrency.AssertCalled(t, "Slash", who, sc.NewU128(100))
+	mockSessionInterface.AssertNotCalled(t, "Disable", mock.Anything)
+}
+
+func Test_Module_OnOffence_SlashesOnlyHigherFraction(t *testing.T) {
+	target := setupModule()
+	key := EraStash{Era: 2, Stash: who}
+	expectActiveEra(2)
+	expectEraStart(2, 12)
+	mockStorageErasStakers.On("Exists", key).Return(true)
+	mockStorageErasStakers.On("Get", key).Return(offenderExposure, nil)
+	mockStorageValidatorSlashInEra.On("Exists", key).Return(true)
+	mockStorageValidatorSlashInEra.On("Get", key).Return(primitives.NewPerbillFromPercent(4), nil)
+	mockStorageValidatorSlashInEra.On("Put", key, slashFractions[0]).Return()
+	expectNominatorSlashInEra(2, sc.NewOption[primitives.Balance](sc.NewU128(20)), sc.NewU128(50))
+	expectSlash(who, ledger, sc.NewU128(60))
+	expectSlash(nominator, nominatorLedger, sc.NewU128(30))
+	expectRemoveValidator(who, sc.Sequence[primitives.AccountId]{who}, sc.Sequence[primitives.AccountId]{})
+	mockStorageNominators.On("Exists", who).Return(false)
+	mockSessionInterface.On("Disable", who).Return(false, nil)
+
+	_, err := target.OnOffence(offenders, slashFractions, 12)
+	assert.NoError(t, err)
+
+	mockCurrency.AssertCalled(t, "Slash", who, sc.NewU128(60))
+	mockCurrency.AssertCalled(t, "Slash", nominator, sc.NewU128(30))
+}
+
+func Test_Module_OnOffence_NominatorAlreadySlashedHigher(t *testing.T) {
+	target := setupModule()
+	key := EraStash{Era: 2, Stash: who}
+	expectActiveEra(2)
+	expectEraStart(2, 12)
+	mockStorageErasStakers.On("Exists", key).Return(true)
+	mockStorageErasStakers.On("Get", key).Return(offenderExposure, nil)
+	mockStorageValidatorSlashInEra.On("Exists", key).Return(false)
+	mockStorageValidatorSlashInEra.On("Put", key, slashFractions[0]).Return()
+	expectNominatorSlashInEra(2, sc.NewOption[primitives.Balance](sc.NewU128(80)), sc.NewU128(50))
+	expectSlash(who, ledger, sc.NewU128(100))
+	expectRemoveValidator(who, sc.Sequence[primitives.AccountId]{who}, sc.Sequence[primitives.AccountId]{})
+	mockStorageNominators.On("Exists", who).Return(false)
+	mockSessionInterface.On("Disable", who).Return(false, nil)
+
+	_, err := target.OnOffence(offenders, slashFractions, 12)
+	assert.NoError(t, err)
+
+	mockCurrency.AssertCalled(t, "Slash", who, sc.NewU128(100))
+	mockStorageNominatorSlashInEra.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockCurrency.AssertNotCalled(t, "Slash", nominator, mock.Anything)
+}
+
+func Test_Module_OnOffence_AlreadySlashed(t *testing.T) {
+	target := setupModule()
+	key := EraStash{Era: 2, Stash: who}
+	expectActiveEra(2)
+	expectEraStart(2, 12)
+	mockStorageErasStakers.On("Exists", key).Return(true)
+	mockStorageErasStakers.On("Get", key).Return(offenderExposure, nil)
+	mockStorageValidatorSlashInEra.On("Exists", key).Return(true)
+	mockStorageValidatorSlashInEra.On("Get", key).Return(slashFractions[0], nil)
+	expectRemoveValidator(who, sc.Sequence[primitives.AccountId]{who}, sc.Sequence[primitives.AccountId]{})
+	mockStorageNominators.On("Exists", who).Return(false)
+	mockSessionInterface.On("Disable", who).Return(false, nil)
+
+	_, err := target.OnOffence(offenders, slashFractions, 12)
+	assert.NoError(t, err)
+
+	mockStorageValidatorSlashInEra.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockCurrency.AssertNotCalled(t, "Slash", mock.Anything, mock.Anything)
+}
+
+func Test_Module_OnOffence_NotExposed(t *testing.T) {
+	target := setupModule()
+	key := EraStash{Era: 2, Stash: who}
+	expectActiveEra(2)
+	expectEraStart(2, 12)
+	mockStorageErasStakers.On("Exists", key).Return(false)
+
+	_, err := target.OnOffence(offenders, slashFractions, 12)
+	assert.NoError(t, err)
+
+	mockCurrency.AssertNotCalled(t, "Slash", mock.Anything, mock.Anything)
+	mockSessionInterface.AssertNotCalled(t, "Disable", mock.Anything)
+}
+
+func Test_Module_OnOffence_OlderThanBondingDuration(t *testing.T) {
+	target := setupModule()
+	expectActiveEra(4)
+	expectEraStart(4, 24)
+	expectEraStart(3, 18)
+	expectEraStart(2, 12)
+
+	_, err := target.OnOffence(offenders, slashFractions, 7)
+	assert.NoError(t, err)
+
+	mockStorageErasStartSessionIndex.AssertNotCalled(t, "Exists", sc.U32(1))
+	mockStorageErasStakers.AssertNotCalled(t, "Exists", mock.Anything)
+}
+
+func Test_Module_OnOffence_NoActiveEra(t *testing.T) {
+	target := setupModule()
+	mockStorageActiveEra.On("Exists").Return(false)
+
+	_, err := target.OnOffence(offenders, slashFractions, 7)
+	assert.NoError(t, err)
+
+	mockStorageErasStakers.AssertNotCalled(t, "Exists", mock.Anything)
+}
+
+func Test_Module_doSlash_NotBonded(t *testing.T) {
+	target := setupModule()
+	mockStorageLedger.On("Exists", who).Return(false)
+
+	err := target.doSlash(who, sc.NewU128(100))
+	assert.NoError(t, err)
+
+	mockCurrency.AssertNotCalled(t, "Slash", mock.Anything, mock.Anything)
+}
+
+func expectEraStart(era sc.U32, start sc.U32) {
+	mockStorageErasStartSessionIndex.On("Exists", era).Return(true)
+	mockStorageErasStartSessionIndex.On("Get", era).Return(start, nil)
+}
+
+func expectSlash(stash primitives.AccountId, l StakingLedger, value primitives.Balance) {
+	slashed, _ := l.slash(value)
+
+	expectLedger(stash, l)
+	mockCurrency.On("SetLock", LockId, stash, slashed.Total, primitives.ReasonsAll).Return(nil)
+	mockStorageLedger.On("Put", stash, slashed).Return()
+	mockCurrency.On("Slash", stash, value).Return(sc.NewU128(0), nil)
+}
+
+func expectNominatorSlashInEra(era sc.U32, prior sc.Option[primitives.Balance], slashed primitives.Balance) {
+	key := EraStash{Era: era, Stash: nominator}
+	mockStorageNominatorSlashInEra.On("Exists", key).Return(prior.HasValue)
+	if prior.HasValue {
+		mockStorageNominatorSlashInEra.On("Get", key).Return(prior.Value, nil)
+	}
+	mockStorageNominatorSlashInEra.On("Put", key, slashed).Return()
+}
@@ -0,0 +1,20 @@
+package staking
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSessionInterface struct {
+	mock.Mock
+}
+
+func (m *MockSessionInterface) Disable(who primitives.AccountId) (bool, error) {
+	args := m.Called(who)
+
+	if args.Get(1) != nil {
+		return args.Bool(0), args.Get(1).(error)
+	}
+
+	return args.Bool(0), nil
+}
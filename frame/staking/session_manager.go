@@ -0,0 +1,253 @@
+package staking
+
+import (
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/npos_elections"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// NewSession plans a new era once the current one has lasted for `sessionsPerEra` sessions,
+// returning the validators elected for it, which become active in session `newIndex`.
+func (m Module) NewSession(newIndex sc.U32) sc.Option[sc.Sequence[primitives.AccountId]] {
+	return m.newSession(newIndex, false)
+}
+
+// NewSessionGenesis plans the first era at genesis. The election result is accepted, even if it
+// has less validators than the minimum validator count.
+func (m Module) NewSessionGenesis(newIndex sc.U32) sc.Option[sc.Sequence[primitives.AccountId]] {
+	return m.newSession(newIndex, true)
+}
+
+// StartSession activates the planned era, if it starts at session `index`.
+func (m Module) StartSession(index sc.U32) {
+	activeEra, err := m.activeEra()
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	nextActiveEra := sc.U32(0)
+	if activeEra.HasValue {
+		nextActiveEra = activeEra.Value + 1
+	}
+
+	if !m.storage.ErasStartSessionIndex.Exists(nextActiveEra) {
+		return
+	}
+
+	startIndex, err := m.storage.ErasStartSessionIndex.Get(nextActiveEra)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	if startIndex == index {
+		m.storage.ActiveEra.Put(nextActiveEra)
+	}
+}
+
+// EndSession ends the active era, if the planned era starts at the next session, and computes its payout.
+func (m Module) EndSession(index sc.U32) {
+	activeEra, err := m.activeEra()
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+	if !activeEra.HasValue || !m.storage.ErasStartSessionIndex.Exists(activeEra.Value+1) {
+		return
+	}
+
+	nextStartIndex, err := m.storage.ErasStartSessionIndex.Get(activeEra.Value + 1)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	if nextStartIndex == index+1 {
+		m.endEra(activeEra.Value)
+	}
+}
+
+func (m Module) newSession(index sc.U32, isGenesis bool) sc.Option[sc.Sequence[primitives.AccountId]] {
+	currentEra, err := m.currentEra()
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	if currentEra.HasValue {
+		startIndex, err := m.storage.ErasStartSessionIndex.Get(currentEra.Value)
+		if err != nil {
+			m.logger.Critical(err.Error())
+		}
+
+		eraLength := sc.SaturatingSubU32(index, startIndex)
+		if eraLength < m.sessionsPerEra {
+			return sc.NewOption[sc.Sequence[primitives.AccountId]](nil)
+		}
+	}
+
+	return m.tryTriggerNewEra(index, isGenesis)
+}
+
+// tryTriggerNewEra elects the validators of a new era starting at session `startIndex`.
+// Outside of genesis, the new era is not planned if too few validators are elected.
+func (m Module) tryTriggerNewEra(startIndex sc.U32, isGenesis bool) sc.Option[sc.Sequence[primitives.AccountId]] {
+	minimumValidatorCount, err := m.storage.MinimumValidatorCount.Get()
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	supports, err := m.electionProvider.Elect(m)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	if len(supports) == 0 || (!isGenesis && sc.U32(len(supports)) < minimumValidatorCount) {
+		m.logger.Warn("Staking election failed, keeping the current validator set.")
+		return sc.NewOption[sc.Sequence[primitives.AccountId]](nil)
+	}
+
+	currentEra, err := m.currentEra()
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	newEra := sc.U32(0)
+	if currentEra.HasValue {
+		newEra = currentEra.Value + 1
+	}
+
+	m.storage.CurrentEra.Put(newEra)
+	m.storage.ErasStartSessionIndex.Put(newEra, startIndex)
+
+	if newEra > m.historyDepth {
+		if err := m.clearEraInformation(newEra - m.historyDepth - 1); err != nil {
+			m.logger.Critical(err.Error())
+		}
+	}
+
+	elected, err := m.storeStakers(newEra, exposuresFromSupports(supports))
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	return sc.NewOption[sc.Sequence[primitives.AccountId]](elected)
+}
+
+// endEra computes the payout of `activeEra`, which is paid out to its stakers on demand.
+func (m Module) endEra(activeEra sc.U32) {
+	totalStaked, err := m.storage.ErasTotalStake.Get(activeEra)
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+	totalIssuance, err := m.currency.TotalIssuance().Get()
+	if err != nil {
+		m.logger.Critical(err.Error())
+	}
+
+	validatorPayout, remainder := m.eraPayout.EraPayout(totalStaked, totalIssuance)
+
+	m.systemModule.DepositEvent(newEventEraPaid(m.index, activeEra, validatorPayout, remainder))
+	m.storage.ErasValidatorReward.Put(activeEra, validatorPayout)
+}
+
+// validatorExposure is the exposure of an elected validator.
+type validatorExposure struct {
+	stash    primitives.AccountId
+	exposure Exposure
+}
+
+// exposuresFromSupports converts the supports of the elected validators to their exposures. The stake, which a
+// validator votes for itself with, is its own stake.
+func exposuresFromSupports(supports npos_elections.Supports) []validatorExposure {
+	exposures := make([]validatorExposure, 0, len(supports))
+
+	for _, ts := range supports {
+		exposure := Exposure{
+			Total:  ts.Support.Total,
+			Own:    sc.NewU128(0),
+			Others: sc.Sequence[IndividualExposure]{},
+		}
+		for _, voter := range ts.Support.Voters {
+			if compareAccountIds(voter.Who, ts.Target) == 0 {
+				exposure.Own = sc.SaturatingAddU128(exposure.Own, voter.Value)
+				continue
+			}
+			exposure.Others = append(exposure.Others, IndividualExposure{Who: voter.Who, Value: voter.Value})
+		}
+
+		exposures = append(exposures, validatorExposure{stash: ts.Target, exposure: exposure})
+	}
+
+	return exposures
+}
+
+// storeStakers stores the exposures and preferences of the validators elected for `era`. The full exposures are
+// kept for slashing, while only the nominators with the highest stake, up to `maxNominatorRewardedPerValidator`,
+// are kept for the rewards.
+func (m Module) storeStakers(era sc.U32, exposures []validatorExposure) (sc.Sequence[primitives.AccountId], error) {
+	elected := sc.Sequence[primitives.AccountId]{}
+	totalStake := sc.NewU128(0)
+
+	for _, ve := range exposures {
+		others := make(sc.Sequence[IndividualExposure], len(ve.exposure.Others))
+		copy(others, ve.exposure.Others)
+		sort.SliceStable(others, func(i, j int) bool {
+			return others[i].Value.Gt(others[j].Value)
+		})
+		if sc.U32(len(others)) > m.maxNominatorRewardedPerValidator {
+			others = others[:m.maxNominatorRewardedPerValidator]
+		}
+		clipped := Exposure{Total: ve.exposure.Total, Own: ve.exposure.Own, Others: others}
+
+		prefs, err := m.storage.Validators.Get(ve.stash)
+		if err != nil {
+			return nil, err
+		}
+
+		key := EraStash{Era: era, Stash: ve.stash}
+		m.storage.ErasStakers.Put(key, ve.exposure)
+		m.storage.ErasStakersClipped.Put(key, clipped)
+		m.storage.ErasValidatorPrefs.Put(key, prefs)
+
+		totalStake = sc.SaturatingAddU128(totalStake, ve.exposure.Total)
+		elected = append(elected, ve.stash)
+	}
+
+	m.storage.ErasTotalStake.Put(era, totalStake)
+	m.storage.ErasElected.Put(era, elected)
+
+	return elected, nil
+}
+
+// clearEraInformation removes the information of `era`, which is older than the history depth.
+func (m Module) clearEraInformation(era sc.U32) error {
+	elected, err := m.storage.ErasElected.Get(era)
+	if err != nil {
+		return err
+	}
+
+	for _, stash := range elected {
+		key := EraStash{Era: era, Stash: stash}
+		if m.storage.ErasStakers.Exists(key) {
+			exposure, err := m.storage.ErasStakers.Get(key)
+			if err != nil {
+				return err
+			}
+			for _, nominator := range exposure.Others {
+				m.storage.NominatorSlashInEra.Remove(EraStash{Era: era, Stash: nominator.Who})
+			}
+		}
+		m.storage.ErasStakers.Remove(key)
+		m.storage.ErasStakersClipped.Remove(key)
+		m.storage.ErasValidatorPrefs.Remove(key)
+		m.storage.ClaimedRewards.Remove(key)
+		m.storage.ValidatorSlashInEra.Remove(key)
+	}
+
+	m.storage.ErasElected.Remove(era)
+	m.storage.ErasValidatorReward.Remove(era)
+	m.storage.ErasRewardPoints.Remove(era)
+	m.storage.ErasTotalStake.Remove(era)
+	m.storage.ErasStartSessionIndex.Remove(era)
+
+	return nil
+}
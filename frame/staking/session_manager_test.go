@@ -0,0 +1,270 @@
+package staking
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/npos_elections"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	noValidators = sc.NewOption[sc.Sequence[primitives.AccountId]](nil)
+
+	// supports elects `who` with 1000 of own stake and `other` with 500 of own stake, nominated by `nominator` with 300
+	supports = npos_elections.Supports{
+		{Target: who, Support: npos_elections.Support{
+			Total:  sc.NewU128(1000),
+			Voters: sc.Sequence[npos_elections.IndividualSupport]{{Who: who, Value: sc.NewU128(1000)}},
+		}},
+		{Target: other, Support: npos_elections.Support{
+			Total: sc.NewU128(800),
+			Voters: sc.Sequence[npos_elections.IndividualSupport]{
+				{Who: other, Value: sc.NewU128(500)},
+				{Who: nominator, Value: sc.NewU128(300)},
+			},
+		}},
+	}
+)
+
+func Test_Module_NewSession_EraNotEnded(t *testing.T) {
+	target := setupModule()
+	expectCurrentEra(2)
+	mockStorageErasStartSessionIndex.On("Get", sc.U32(2)).Return(sc.U32(10), nil)
+
+	result := target.NewSession(10 + sessionsPerEra - 1)
+
+	assert.Equal(t, noValidators, result)
+	mockStorageCurrentEra.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_NewSession_TriggersNewEra(t *testing.T) {
+	target := setupModule()
+	expectCurrentEra(0)
+	mockStorageErasStartSessionIndex.On("Get", sc.U32(0)).Return(sc.U32(0), nil)
+	expectElection(supports, 1)
+	mockStorageCurrentEra.On("Put", sc.U32(1)).Return()
+	mockStorageErasStartSessionIndex.On("Put", sc.U32(1), sessionsPerEra).Return()
+	expectStoreStakers()
+
+	result := target.NewSession(sessionsPerEra)
+
+	assert.Equal(t, sc.NewOption[sc.Sequence[primitives.AccountId]](sc.Sequence[primitives.AccountId]{who, other}), result)
+	mockStorageCurrentEra.AssertCalled(t, "Put", sc.U32(1))
+	mockStorageErasStartSessionIndex.AssertCalled(t, "Put", sc.U32(1), sessionsPerEra)
+	mockStorageErasStakers.AssertCalled(t, "Put", EraStash{Era: 1, Stash: who}, Exposure{
+		Total:  sc.NewU128(1000),
+		Own:    sc.NewU128(1000),
+		Others: sc.Sequence[IndividualExposure]{},
+	})
+	mockStorageErasStakers.AssertCalled(t, "Put", EraStash{Era: 1, Stash: other}, Exposure{
+		Total:  sc.NewU128(800),
+		Own:    sc.NewU128(500),
+		Others: sc.Sequence[IndividualExposure]{{Who: nominator, Value: sc.NewU128(300)}},
+	})
+	mockStorageErasStakersClipped.AssertCalled(t, "Put", EraStash{Era: 1, Stash: other}, Exposure{
+		Total:  sc.NewU128(800),
+		Own:    sc.NewU128(500),
+		Others: sc.Sequence[IndividualExposure]{{Who: nominator, Value: sc.NewU128(300)}},
+	})
+	mockStorageErasValidatorPrefs.AssertCalled(t, "Put", EraStash{Era: 1, Stash: who}, prefs)
+	mockStorageErasTotalStake.AssertCalled(t, "Put", sc.U32(1), sc.NewU128(1800))
+	mockStorageErasElected.AssertCalled(t, "Put", sc.U32(1), sc.Sequence[primitives.AccountId]{who, other})
+}
+
+func Test_Module_NewSession_ElectionFailed(t *testing.T) {
+	target := setupModule()
+	expectCurrentEra(0)
+	mockStorageErasStartSessionIndex.On("Get", sc.U32(0)).Return(sc.U32(0), nil)
+	expectElection(npos_elections.Supports{}, 1)
+
+	result := target.NewSession(sessionsPerEra)
+
+	assert.Equal(t, noValidators, result)
+	mockStorageCurrentEra.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_NewSession_BelowMinimumValidatorCount(t *testing.T) {
+	target := setupModule()
+	expectCurrentEra(0)
+	mockStorageErasStartSessionIndex.On("Get", sc.U32(0)).Return(sc.U32(0), nil)
+	expectElection(supports, 3)
+
+	result := target.NewSession(sessionsPerEra)
+
+	assert.Equal(t, noValidators, result)
+	mockStorageCurrentEra.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_NewSessionGenesis(t *testing.T) {
+	target := setupModule()
+	mockStorageCurrentEra.On("Exists").Return(false)
+	expectElection(supports, 3)
+	mockStorageCurrentEra.On("Put", sc.U32(0)).Return()
+	mockStorageErasStartSessionIndex.On("Put", sc.U32(0), sc.U32(0)).Return()
+	expectStoreStakers()
+
+	result := target.NewSessionGenesis(0)
+
+	assert.Equal(t, sc.NewOption[sc.Sequence[primitives.AccountId]](sc.Sequence[primitives.AccountId]{who, other}), result)
+	mockStorageCurrentEra.AssertCalled(t, "Put", sc.U32(0))
+	mockStorageErasStartSessionIndex.AssertCalled(t, "Put", sc.U32(0), sc.U32(0))
+}
+
+func Test_Module_StartSession(t *testing.T) {
+	target := setupModule()
+	expectActiveEra(0)
+	mockStorageErasStartSessionIndex.On("Exists", sc.U32(1)).Return(true)
+	mockStorageErasStartSessionIndex.On("Get", sc.U32(1)).Return(sessionsPerEra, nil)
+	mockStorageActiveEra.On("Put", sc.U32(1)).Return()
+
+	target.StartSession(sessionsPerEra)
+
+	mockStorageActiveEra.AssertCalled(t, "Put", sc.U32(1))
+}
+
+func Test_Module_StartSession_Genesis(t *testing.T) {
+	target := setupModule()
+	mockStorageActiveEra.On("Exists").Return(false)
+	mockStorageErasStartSessionIndex.On("Exists", sc.U32(0)).Return(true)
+	mockStorageErasStartSessionIndex.On("Get", sc.U32(0)).Return(sc.U32(0), nil)
+	mockStorageActiveEra.On("Put", sc.U32(0)).Return()
+
+	target.StartSession(0)
+
+	mockStorageActiveEra.AssertCalled(t, "Put", sc.U32(0))
+}
+
+func Test_Module_StartSession_NoEraStart(t *testing.T) {
+	target := setupModule()
+	expectActiveEra(0)
+	mockStorageErasStartSessionIndex.On("Exists", sc.U32(1)).Return(true)
+	mockStorageErasStartSessionIndex.On("Get", sc.U32(1)).Return(sessionsPerEra, nil)
+
+	target.StartSession(sessionsPerEra - 1)
+
+	mockStorageActiveEra.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_EndSession(t *testing.T) {
+	target := setupModule()
+	expectActiveEra(0)
+	mockStorageErasStartSessionIndex.On("Exists", sc.U32(1)).Return(true)
+	mockStorageErasStartSessionIndex.On("Get", sc.U32(1)).Return(sessionsPerEra, nil)
+	mockStorageErasTotalStake.On("Get", sc.U32(0)).Return(sc.NewU128(1800), nil)
+	mockTotalIssuance.On("Get").Return(sc.NewU128(10_000), nil)
+	mockEraPayout.On("EraPayout", sc.NewU128(1800), sc.NewU128(10_000)).Return(sc.NewU128(200), sc.NewU128(50))
+	mockStorageErasValidatorReward.On("Put", sc.U32(0), sc.NewU128(200)).Return()
+
+	target.EndSession(sessionsPerEra - 1)
+
+	mockStorageErasValidatorReward.AssertCalled(t, "Put", sc.U32(0), sc.NewU128(200))
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventEraPaid(moduleId, 0, sc.NewU128(200), sc.NewU128(50)))
+}
+
+func Test_Module_EndSession_EraNotEnded(t *testing.T) {
+	target := setupModule()
+	expectActiveEra(0)
+	mockStorageErasStartSessionIndex.On("Exists", sc.U32(1)).Return(true)
+	mockStorageErasStartSessionIndex.On("Get", sc.U32(1)).Return(sessionsPerEra, nil)
+
+	target.EndSession(sessionsPerEra - 2)
+
+	mockEraPayout.AssertNotCalled(t, "EraPayout", mock.Anything, mock.Anything)
+	mockStorageErasValidatorReward.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_exposuresFromSupports(t *testing.T) {
+	assert.Equal(t, []validatorExposure{
+		{stash: who, exposure: Exposure{Total: sc.NewU128(1000), Own: sc.NewU128(1000), Others: sc.Sequence[IndividualExposure]{}}},
+		{stash: other, exposure: Exposure{
+			Total:  sc.NewU128(800),
+			Own:    sc.NewU128(500),
+			Others: sc.Sequence[IndividualExposure]{{Who: nominator, Value: sc.NewU128(300)}},
+		}},
+	}, exposuresFromSupports(supports))
+}
+
+func Test_Module_storeStakers_ClipsNominators(t *testing.T) {
+	target := setupModule()
+	expectStoreStakers()
+
+	_, err := target.storeStakers(1, []validatorExposure{{
+		stash: who,
+		exposure: Exposure{
+			Total: sc.NewU128(1500),
+			Own:   sc.NewU128(1000),
+			Others: sc.Sequence[IndividualExposure]{
+				{Who: other, Value: sc.NewU128(200)},
+				{Who: nominator, Value: sc.NewU128(300)},
+			},
+		},
+	}})
+
+	assert.NoError(t, err)
+	mockStorageErasStakers.AssertCalled(t, "Put", EraStash{Era: 1, Stash: who}, Exposure{
+		Total: sc.NewU128(1500),
+		Own:   sc.NewU128(1000),
+		Others: sc.Sequence[IndividualExposure]{
+			{Who: other, Value: sc.NewU128(200)},
+			{Who: nominator, Value: sc.NewU128(300)},
+		},
+	})
+	mockStorageErasStakersClipped.AssertCalled(t, "Put", EraStash{Era: 1, Stash: who}, Exposure{
+		Total:  sc.NewU128(1500),
+		Own:    sc.NewU128(1000),
+		Others: sc.Sequence[IndividualExposure]{{Who: nominator, Value: sc.NewU128(300)}},
+	})
+	mockStorageErasTotalStake.AssertCalled(t, "Put", sc.U32(1), sc.NewU128(1500))
+}
+
+func Test_Module_clearEraInformation(t *testing.T) {
+	target := setupModule()
+	key := EraStash{Era: 2, Stash: who}
+	nominatorKey := EraStash{Era: 2, Stash: nominator}
+	mockStorageErasElected.On("Get", sc.U32(2)).Return(sc.Sequence[primitives.AccountId]{who}, nil)
+	mockStorageErasStakers.On("Exists", key).Return(true)
+	mockStorageErasStakers.On("Get", key).Return(offenderExposure, nil)
+	mockStorageNominatorSlashInEra.On("Remove", nominatorKey).Return()
+	mockStorageErasStakers.On("Remove", key).Return()
+	mockStorageErasStakersClipped.On("Remove", key).Return()
+	mockStorageErasValidatorPrefs.On("Remove", key).Return()
+	mockStorageClaimedRewards.On("Remove", key).Return()
+	mockStorageValidatorSlashInEra.On("Remove", key).Return()
+	mockStorageErasElected.On("Remove", sc.U32(2)).Return()
+	mockStorageErasValidatorReward.On("Remove", sc.U32(2)).Return()
+	mockStorageErasRewardPoints.On("Remove", sc.U32(2)).Return()
+	mockStorageErasTotalStake.On("Remove", sc.U32(2)).Return()
+	mockStorageErasStartSessionIndex.On("Remove", sc.U32(2)).Return()
+
+	err := target.clearEraInformation(2)
+
+	assert.NoError(t, err)
+	mockStorageNominatorSlashInEra.AssertCalled(t, "Remove", nominatorKey)
+	mockStorageErasStakers.AssertCalled(t, "Remove", key)
+	mockStorageErasStakersClipped.AssertCalled(t, "Remove", key)
+	mockStorageErasValidatorPrefs.AssertCalled(t, "Remove", key)
+	mockStorageClaimedRewards.AssertCalled(t, "Remove", key)
+	mockStorageValidatorSlashInEra.AssertCalled(t, "Remove", key)
+	mockStorageErasElected.AssertCalled(t, "Remove", sc.U32(2))
+	mockStorageErasValidatorReward.AssertCalled(t, "Remove", sc.U32(2))
+	mockStorageErasRewardPoints.AssertCalled(t, "Remove", sc.U32(2))
+	mockStorageErasTotalStake.AssertCalled(t, "Remove", sc.U32(2))
+	mockStorageErasStartSessionIndex.AssertCalled(t, "Remove", sc.U32(2))
+}
+
+func expectElection(supports npos_elections.Supports, minimumValidatorCount sc.U32) {
+	mockStorageMinimumValidatorCount.On("Get").Return(minimumValidatorCount, nil)
+	mockElectionProvider.On("Elect", mock.Anything).Return(supports, nil)
+}
+
+func expectStoreStakers() {
+	mockStorageValidators.On("Get", mock.Anything).Return(prefs, nil)
+	mockStorageErasStakers.On("Put", mock.Anything, mock.Anything).Return()
+	mockStorageErasStakersClipped.On("Put", mock.Anything, mock.Anything).Return()
+	mockStorageErasValidatorPrefs.On("Put", mock.Anything, mock.Anything).Return()
+	mockStorageErasTotalStake.On("Put", mock.Anything, mock.Anything).Return()
+	mockStorageErasElected.On("Put", mock.Anything, mock.Anything).Return()
+}
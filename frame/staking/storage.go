@@ -0,0 +1,89 @@
+package staking
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	keyStaking               = []byte("Staking")
+	keyValidatorCount        = []byte("ValidatorCount")
+	keyMinimumValidatorCount = []byte("MinimumValidatorCount")
+	keyLedger                = []byte("Ledger")
+	keyPayee                 = []byte("Payee")
+	keyValidators            = []byte("Validators")
+	keyValidatorStashes      = []byte("ValidatorStashes")
+	keyNominators            = []byte("Nominators")
+	keyNominatorStashes      = []byte("NominatorStashes")
+	keyCurrentEra            = []byte("CurrentEra")
+	keyActiveEra             = []byte("ActiveEra")
+	keyErasStartSessionIndex = []byte("ErasStartSessionIndex")
+	keyErasElected           = []byte("ErasElected")
+	keyErasStakers           = []byte("ErasStakers")
+	keyErasStakersClipped    = []byte("ErasStakersClipped")
+	keyErasValidatorPrefs    = []byte("ErasValidatorPrefs")
+	keyErasValidatorReward   = []byte("ErasValidatorReward")
+	keyErasRewardPoints      = []byte("ErasRewardPoints")
+	keyErasTotalStake        = []byte("ErasTotalStake")
+	keyClaimedRewards        = []byte("ClaimedRewards")
+	keyValidatorSlashInEra   = []byte("ValidatorSlashInEra")
+	keyNominatorSlashInEra   = []byte("NominatorSlashInEra")
+)
+
+var (
+	defaultAccounts = sc.Sequence[primitives.AccountId]{}
+)
+
+type storage struct {
+	ValidatorCount        support.StorageValue[sc.U32]
+	MinimumValidatorCount support.StorageValue[sc.U32]
+	Ledger                support.StorageMap[primitives.AccountId, StakingLedger]
+	Payee                 support.StorageMap[primitives.AccountId, RewardDestination]
+	Validators            support.StorageMap[primitives.AccountId, ValidatorPrefs]
+	ValidatorStashes      support.StorageValue[sc.Sequence[primitives.AccountId]]
+	Nominators            support.StorageMap[primitives.AccountId, Nominations]
+	NominatorStashes      support.StorageValue[sc.Sequence[primitives.AccountId]]
+	CurrentEra            support.StorageValue[sc.U32]
+	ActiveEra             support.StorageValue[sc.U32]
+	ErasStartSessionIndex support.StorageMap[sc.U32, sc.U32]
+	ErasElected           support.StorageMap[sc.U32, sc.Sequence[primitives.AccountId]]
+	ErasStakers           support.StorageMap[EraStash, Exposure]
+	ErasStakersClipped    support.StorageMap[EraStash, Exposure]
+	ErasValidatorPrefs    support.StorageMap[EraStash, ValidatorPrefs]
+	ErasValidatorReward   support.StorageMap[sc.U32, primitives.Balance]
+	ErasRewardPoints      support.StorageMap[sc.U32, EraRewardPoints]
+	ErasTotalStake        support.StorageMap[sc.U32, primitives.Balance]
+	ClaimedRewards        support.StorageMap[EraStash, sc.Bool]
+	ValidatorSlashInEra   support.StorageMap[EraStash, primitives.Perbill]
+	NominatorSlashInEra   support.StorageMap[EraStash, primitives.Balance]
+}
+
+func newStorage(s io.Storage) *storage {
+	hashing := io.NewHashing()
+
+	return &storage{
+		ValidatorCount:        support.NewHashStorageValue(s, keyStaking, keyValidatorCount, sc.DecodeU32),
+		MinimumValidatorCount: support.NewHashStorageValue(s, keyStaking, keyMinimumValidatorCount, sc.DecodeU32),
+		Ledger:                support.NewHashStorageMap[primitives.AccountId, StakingLedger](s, keyStaking, keyLedger, hashing.Twox64, DecodeStakingLedger),
+		Payee:                 support.NewHashStorageMap[primitives.AccountId, RewardDestination](s, keyStaking, keyPayee, hashing.Twox64, DecodeRewardDestination),
+		Validators:            support.NewHashStorageMap[primitives.AccountId, ValidatorPrefs](s, keyStaking, keyValidators, hashing.Twox64, DecodeValidatorPrefs),
+		ValidatorStashes:      support.NewHashStorageValueWithDefault(s, keyStaking, keyValidatorStashes, primitives.DecodeSequenceAccountId, &defaultAccounts),
+		Nominators:            support.NewHashStorageMap[primitives.AccountId, Nominations](s, keyStaking, keyNominators, hashing.Twox64, DecodeNominations),
+		NominatorStashes:      support.NewHashStorageValueWithDefault(s, keyStaking, keyNominatorStashes, primitives.DecodeSequenceAccountId, &defaultAccounts),
+		CurrentEra:            support.NewHashStorageValue(s, keyStaking, keyCurrentEra, sc.DecodeU32),
+		ActiveEra:             support.NewHashStorageValue(s, keyStaking, keyActiveEra, sc.DecodeU32),
+		ErasStartSessionIndex: support.NewHashStorageMap[sc.U32, sc.U32](s, keyStaking, keyErasStartSessionIndex, hashing.Twox64, sc.DecodeU32),
+		ErasElected:           support.NewHashStorageMapWithDefault[sc.U32, sc.Sequence[primitives.AccountId]](s, keyStaking, keyErasElected, hashing.Twox64, primitives.DecodeSequenceAccountId, &defaultAccounts),
+		ErasStakers:           support.NewHashStorageMap[EraStash, Exposure](s, keyStaking, keyErasStakers, hashing.Twox64, DecodeExposure),
+		ErasStakersClipped:    support.NewHashStorageMap[EraStash, Exposure](s, keyStaking, keyErasStakersClipped, hashing.Twox64, DecodeExposure),
+		ErasValidatorPrefs:    support.NewHashStorageMap[EraStash, ValidatorPrefs](s, keyStaking, keyErasValidatorPrefs, hashing.Twox64, DecodeValidatorPrefs),
+		ErasValidatorReward:   support.NewHashStorageMap[sc.U32, primitives.Balance](s, keyStaking, keyErasValidatorReward, hashing.Twox64, sc.DecodeU128),
+		ErasRewardPoints:      support.NewHashStorageMap[sc.U32, EraRewardPoints](s, keyStaking, keyErasRewardPoints, hashing.Twox64, DecodeEraRewardPoints),
+		ErasTotalStake:        support.NewHashStorageMap[sc.U32, primitives.Balance](s, keyStaking, keyErasTotalStake, hashing.Twox64, sc.DecodeU128),
+		ClaimedRewards:        support.NewHashStorageMap[EraStash, sc.Bool](s, keyStaking, keyClaimedRewards, hashing.Twox64, sc.DecodeBool),
+		ValidatorSlashInEra:   support.NewHashStorageMap[EraStash, primitives.Perbill](s, keyStaking, keyValidatorSlashInEra, hashing.Twox64, primitives.DecodePerbill),
+		NominatorSlashInEra:   support.NewHashStorageMap[EraStash, primitives.Balance](s, keyStaking, keyNominatorSlashInEra, hashing.Twox64, sc.DecodeU128),
+	}
+}
@@ -0,0 +1,446 @@
+package staking
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// RewardDestinationStaked pays the rewards into the stash account and adds them to the bond.
+	RewardDestinationStaked sc.U8 = iota
+	// RewardDestinationStash pays the rewards into the stash account without adding them to the bond.
+	RewardDestinationStash
+	// RewardDestinationController pays the rewards into the controller account. Since each stash is its own
+	// controller, this is the same as RewardDestinationStash.
+	RewardDestinationController
+	// RewardDestinationAccount pays the rewards into a specified account.
+	RewardDestinationAccount
+	// RewardDestinationNone does not pay out the rewards.
+	RewardDestinationNone
+)
+
+var (
+	errInvalidRewardDestinationType = errors.New("invalid reward destination type")
+)
+
+// RewardDestination is the destination of the staking rewards of a stash.
+type RewardDestination struct {
+	sc.VaryingData
+}
+
+func NewRewardDestinationStaked() RewardDestination {
+	return RewardDestination{sc.NewVaryingData(RewardDestinationStaked)}
+}
+
+func NewRewardDestinationStash() RewardDestination {
+	return RewardDestination{sc.NewVaryingData(RewardDestinationStash)}
+}
+
+func NewRewardDestinationController() RewardDestination {
+	return RewardDestination{sc.NewVaryingData(RewardDestinationController)}
+}
+
+func NewRewardDestinationAccount(account primitives.AccountId) RewardDestination {
+	return RewardDestination{sc.NewVaryingData(RewardDestinationAccount, account)}
+}
+
+func NewRewardDestinationNone() RewardDestination {
+	return RewardDestination{sc.NewVaryingData(RewardDestinationNone)}
+}
+
+func DecodeRewardDestination(buffer *bytes.Buffer) (RewardDestination, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return RewardDestination{}, err
+	}
+
+	switch b {
+	case RewardDestinationStaked:
+		return NewRewardDestinationStaked(), nil
+	case RewardDestinationStash:
+		return NewRewardDestinationStash(), nil
+	case RewardDestinationController:
+		return NewRewardDestinationController(), nil
+	case RewardDestinationAccount:
+		account, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return RewardDestination{}, err
+		}
+		return NewRewardDestinationAccount(account), nil
+	case RewardDestinationNone:
+		return NewRewardDestinationNone(), nil
+	default:
+		return RewardDestination{}, errInvalidRewardDestinationType
+	}
+}
+
+// UnlockChunk is an amount of unbonded funds, which can be withdrawn once era `Era` is reached.
+type UnlockChunk struct {
+	Value primitives.Balance
+	Era   sc.U32
+}
+
+func (uc UnlockChunk) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, uc.Value, uc.Era)
+}
+
+func DecodeUnlockChunk(buffer *bytes.Buffer) (UnlockChunk, error) {
+	value, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return UnlockChunk{}, err
+	}
+	era, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return UnlockChunk{}, err
+	}
+
+	return UnlockChunk{
+		Value: value,
+		Era:   era,
+	}, nil
+}
+
+func (uc UnlockChunk) Bytes() []byte {
+	return sc.EncodedBytes(uc)
+}
+
+// StakingLedger holds the bonded funds of a stash.
+type StakingLedger struct {
+	// Stash is the account, whose balance is bonded.
+	Stash primitives.AccountId
+	// Total is the amount of funds locked by the ledger, including the funds being unbonded.
+	Total primitives.Balance
+	// Active is the amount of funds at stake in any forthcoming era.
+	Active primitives.Balance
+	// Unlocking holds the funds, which are being unbonded.
+	Unlocking sc.Sequence[UnlockChunk]
+}
+
+func (sl StakingLedger) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, sl.Stash, sl.Total, sl.Active, sl.Unlocking)
+}
+
+func DecodeStakingLedger(buffer *bytes.Buffer) (StakingLedger, error) {
+	stash, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return StakingLedger{}, err
+	}
+	total, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return StakingLedger{}, err
+	}
+	active, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return StakingLedger{}, err
+	}
+	unlocking, err := sc.DecodeSequenceWith(buffer, DecodeUnlockChunk)
+	if err != nil {
+		return StakingLedger{}, err
+	}
+
+	return StakingLedger{
+		Stash:     stash,
+		Total:     total,
+		Active:    active,
+		Unlocking: unlocking,
+	}, nil
+}
+
+func (sl StakingLedger) Bytes() []byte {
+	return sc.EncodedBytes(sl)
+}
+
+// consolidateUnlocked removes the chunks, which are unlocked by era `currentEra`, and reduces the total accordingly.
+func (sl StakingLedger) consolidateUnlocked(currentEra sc.U32) StakingLedger {
+	total := sl.Total
+	unlocking := sc.Sequence[UnlockChunk]{}
+
+	for _, chunk := range sl.Unlocking {
+		if chunk.Era > currentEra {
+			unlocking = append(unlocking, chunk)
+			continue
+		}
+		total = sc.SaturatingSubU128(total, chunk.Value)
+	}
+
+	sl.Total = total
+	sl.Unlocking = unlocking
+
+	return sl
+}
+
+// slash reduces the bonded funds by up to `value`, first from the active bond and then from the unlocking chunks.
+// Returns the reduced ledger and the amount, by which it was reduced.
+func (sl StakingLedger) slash(value primitives.Balance) (StakingLedger, primitives.Balance) {
+	remaining := value
+
+	fromActive := sc.Min128(remaining, sl.Active)
+	sl.Active = sl.Active.Sub(fromActive)
+	remaining = remaining.Sub(fromActive)
+
+	unlocking := sc.Sequence[UnlockChunk]{}
+	for _, chunk := range sl.Unlocking {
+		fromChunk := sc.Min128(remaining, chunk.Value)
+		chunk.Value = chunk.Value.Sub(fromChunk)
+		remaining = remaining.Sub(fromChunk)
+
+		if chunk.Value.Gt(sc.NewU128(0)) {
+			unlocking = append(unlocking, chunk)
+		}
+	}
+
+	slashed := value.Sub(remaining)
+	sl.Total = sc.SaturatingSubU128(sl.Total, slashed)
+	sl.Unlocking = unlocking
+
+	return sl, slashed
+}
+
+// ValidatorPrefs are the preferences of a validator.
+type ValidatorPrefs struct {
+	// Commission is the share of the rewards, which the validator takes before sharing them with its nominators.
+	Commission primitives.Perbill
+	// Blocked marks that the validator does not accept new nominations.
+	Blocked sc.Bool
+}
+
+func (vp ValidatorPrefs) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, vp.Commission, vp.Blocked)
+}
+
+func DecodeValidatorPrefs(buffer *bytes.Buffer) (ValidatorPrefs, error) {
+	commission, err := primitives.DecodePerbill(buffer)
+	if err != nil {
+		return ValidatorPrefs{}, err
+	}
+	blocked, err := sc.DecodeBool(buffer)
+	if err != nil {
+		return ValidatorPrefs{}, err
+	}
+
+	return ValidatorPrefs{
+		Commission: commission,
+		Blocked:    blocked,
+	}, nil
+}
+
+func (vp ValidatorPrefs) Bytes() []byte {
+	return sc.EncodedBytes(vp)
+}
+
+// Nominations are the validators nominated by a nominator.
+type Nominations struct {
+	// Targets are the nominated validators.
+	Targets sc.Sequence[primitives.AccountId]
+	// SubmittedIn is the era, in which the nominations were submitted.
+	SubmittedIn sc.U32
+	// Suppressed marks nominations, which are ignored in the elections.
+	Suppressed sc.Bool
+}
+
+func (n Nominations) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, n.Targets, n.SubmittedIn, n.Suppressed)
+}
+
+func DecodeNominations(buffer *bytes.Buffer) (Nominations, error) {
+	targets, err := primitives.DecodeSequenceAccountId(buffer)
+	if err != nil {
+		return Nominations{}, err
+	}
+	submittedIn, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return Nominations{}, err
+	}
+	suppressed, err := sc.DecodeBool(buffer)
+	if err != nil {
+		return Nominations{}, err
+	}
+
+	return Nominations{
+		Targets:     targets,
+		SubmittedIn: submittedIn,
+		Suppressed:  suppressed,
+	}, nil
+}
+
+func (n Nominations) Bytes() []byte {
+	return sc.EncodedBytes(n)
+}
+
+// IndividualExposure is the stake of a nominator behind a validator.
+type IndividualExposure struct {
+	Who   primitives.AccountId
+	Value primitives.Balance
+}
+
+func (ie IndividualExposure) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, ie.Who, ie.Value)
+}
+
+func DecodeIndividualExposure(buffer *bytes.Buffer) (IndividualExposure, error) {
+	who, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return IndividualExposure{}, err
+	}
+	value, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return IndividualExposure{}, err
+	}
+
+	return IndividualExposure{
+		Who:   who,
+		Value: value,
+	}, nil
+}
+
+func (ie IndividualExposure) Bytes() []byte {
+	return sc.EncodedBytes(ie)
+}
+
+// Exposure is the stake behind an elected validator in an era.
+type Exposure struct {
+	// Total is the total stake behind the validator.
+	Total primitives.Balance
+	// Own is the stake of the validator itself.
+	Own primitives.Balance
+	// Others is the stake of the nominators of the validator.
+	Others sc.Sequence[IndividualExposure]
+}
+
+func (e Exposure) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, e.Total, e.Own, e.Others)
+}
+
+func DecodeExposure(buffer *bytes.Buffer) (Exposure, error) {
+	total, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return Exposure{}, err
+	}
+	own, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return Exposure{}, err
+	}
+	others, err := sc.DecodeSequenceWith(buffer, DecodeIndividualExposure)
+	if err != nil {
+		return Exposure{}, err
+	}
+
+	return Exposure{
+		Total:  total,
+		Own:    own,
+		Others: others,
+	}, nil
+}
+
+func (e Exposure) Bytes() []byte {
+	return sc.EncodedBytes(e)
+}
+
+// IndividualRewardPoints are the reward points earned by a validator in an era.
+type IndividualRewardPoints struct {
+	Validator primitives.AccountId
+	Points    sc.U32
+}
+
+func (irp IndividualRewardPoints) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, irp.Validator, irp.Points)
+}
+
+func DecodeIndividualRewardPoints(buffer *bytes.Buffer) (IndividualRewardPoints, error) {
+	validator, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return IndividualRewardPoints{}, err
+	}
+	points, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return IndividualRewardPoints{}, err
+	}
+
+	return IndividualRewardPoints{
+		Validator: validator,
+		Points:    points,
+	}, nil
+}
+
+func (irp IndividualRewardPoints) Bytes() []byte {
+	return sc.EncodedBytes(irp)
+}
+
+// EraRewardPoints are the reward points earned by the validators in an era.
+// The era payout is split between the validators proportionally to their points.
+type EraRewardPoints struct {
+	Total      sc.U32
+	Individual sc.Sequence[IndividualRewardPoints]
+}
+
+func (erp EraRewardPoints) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, erp.Total, erp.Individual)
+}
+
+func DecodeEraRewardPoints(buffer *bytes.Buffer) (EraRewardPoints, error) {
+	total, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return EraRewardPoints{}, err
+	}
+	individual, err := sc.DecodeSequenceWith(buffer, DecodeIndividualRewardPoints)
+	if err != nil {
+		return EraRewardPoints{}, err
+	}
+
+	return EraRewardPoints{
+		Total:      total,
+		Individual: individual,
+	}, nil
+}
+
+func (erp EraRewardPoints) Bytes() []byte {
+	return sc.EncodedBytes(erp)
+}
+
+// pointsOf returns the reward points of `validator`.
+func (erp EraRewardPoints) pointsOf(validator primitives.AccountId) sc.U32 {
+	for _, individual := range erp.Individual {
+		if compareAccountIds(individual.Validator, validator) == 0 {
+			return individual.Points
+		}
+	}
+	return 0
+}
+
+// add adds `points` to the reward points of `validator`.
+func (erp EraRewardPoints) add(validator primitives.AccountId, points sc.U32) EraRewardPoints {
+	erp.Total = sc.SaturatingAddU32(erp.Total, points)
+
+	individual := sc.Sequence[IndividualRewardPoints]{}
+	found := false
+	for _, ip := range erp.Individual {
+		if compareAccountIds(ip.Validator, validator) == 0 {
+			ip.Points = sc.SaturatingAddU32(ip.Points, points)
+			found = true
+		}
+		individual = append(individual, ip)
+	}
+	if !found {
+		individual = append(individual, IndividualRewardPoints{Validator: validator, Points: points})
+	}
+	erp.Individual = individual
+
+	return erp
+}
+
+// EraStash is the key of the per-era information of a validator stash.
+type EraStash struct {
+	Era   sc.U32
+	Stash primitives.AccountId
+}
+
+func (es EraStash) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, es.Era, es.Stash)
+}
+
+func (es EraStash) Bytes() []byte {
+	return sc.EncodedBytes(es)
+}
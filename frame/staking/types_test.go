@@ -0,0 +1,171 @@
+package staking
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DecodeRewardDestination(t *testing.T) {
+	for _, dest := range []RewardDestination{
+		NewRewardDestinationStaked(),
+		NewRewardDestinationStash(),
+		NewRewardDestinationController(),
+		NewRewardDestinationAccount(other),
+		NewRewardDestinationNone(),
+	} {
+		result, err := DecodeRewardDestination(bytes.NewBuffer(dest.Bytes()))
+
+		assert.NoError(t, err)
+		assert.Equal(t, dest, result)
+	}
+}
+
+func Test_DecodeRewardDestination_InvalidType(t *testing.T) {
+	_, err := DecodeRewardDestination(bytes.NewBuffer([]byte{5}))
+
+	assert.Equal(t, errInvalidRewardDestinationType, err)
+}
+
+func Test_StakingLedger_Encode_Decode(t *testing.T) {
+	l := StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(1000),
+		Active:    sc.NewU128(600),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(400), Era: 8}},
+	}
+
+	buffer := &bytes.Buffer{}
+	err := l.Encode(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, l.Bytes(), buffer.Bytes())
+
+	result, err := DecodeStakingLedger(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, l, result)
+}
+
+func Test_StakingLedger_consolidateUnlocked(t *testing.T) {
+	l := StakingLedger{
+		Stash:  who,
+		Total:  sc.NewU128(1000),
+		Active: sc.NewU128(400),
+		Unlocking: sc.Sequence[UnlockChunk]{
+			{Value: sc.NewU128(100), Era: 3},
+			{Value: sc.NewU128(200), Era: 5},
+			{Value: sc.NewU128(300), Era: 6},
+		},
+	}
+
+	result := l.consolidateUnlocked(5)
+
+	assert.Equal(t, StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(700),
+		Active:    sc.NewU128(400),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(300), Era: 6}},
+	}, result)
+}
+
+func Test_StakingLedger_slash(t *testing.T) {
+	l := StakingLedger{
+		Stash:  who,
+		Total:  sc.NewU128(1000),
+		Active: sc.NewU128(400),
+		Unlocking: sc.Sequence[UnlockChunk]{
+			{Value: sc.NewU128(100), Era: 3},
+			{Value: sc.NewU128(500), Era: 5},
+		},
+	}
+
+	result, slashed := l.slash(sc.NewU128(600))
+
+	assert.Equal(t, sc.NewU128(600), slashed)
+	assert.Equal(t, StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(400),
+		Active:    sc.NewU128(0),
+		Unlocking: sc.Sequence[UnlockChunk]{{Value: sc.NewU128(400), Era: 5}},
+	}, result)
+}
+
+func Test_StakingLedger_slash_MoreThanBonded(t *testing.T) {
+	result, slashed := ledger.slash(sc.NewU128(1500))
+
+	assert.Equal(t, sc.NewU128(1000), slashed)
+	assert.Equal(t, StakingLedger{
+		Stash:     who,
+		Total:     sc.NewU128(0),
+		Active:    sc.NewU128(0),
+		Unlocking: sc.Sequence[UnlockChunk]{},
+	}, result)
+}
+
+func Test_ValidatorPrefs_Encode_Decode(t *testing.T) {
+	result, err := DecodeValidatorPrefs(bytes.NewBuffer(blocked.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, blocked, result)
+}
+
+func Test_Nominations_Encode_Decode(t *testing.T) {
+	n := Nominations{Targets: sc.Sequence[primitives.AccountId]{who, other}, SubmittedIn: 4, Suppressed: true}
+
+	result, err := DecodeNominations(bytes.NewBuffer(n.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, n, result)
+}
+
+func Test_Exposure_Encode_Decode(t *testing.T) {
+	e := Exposure{
+		Total:  sc.NewU128(1000),
+		Own:    sc.NewU128(750),
+		Others: sc.Sequence[IndividualExposure]{{Who: nominator, Value: sc.NewU128(250)}},
+	}
+
+	result, err := DecodeExposure(bytes.NewBuffer(e.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, e, result)
+}
+
+func Test_EraRewardPoints_Encode_Decode(t *testing.T) {
+	points := EraRewardPoints{
+		Total:      30,
+		Individual: sc.Sequence[IndividualRewardPoints]{{Validator: who, Points: 30}},
+	}
+
+	result, err := DecodeEraRewardPoints(bytes.NewBuffer(points.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, points, result)
+}
+
+func Test_EraRewardPoints_add(t *testing.T) {
+	points := EraRewardPoints{}.
+		add(who, 20).
+		add(other, 20).
+		add(who, 20)
+
+	assert.Equal(t, EraRewardPoints{
+		Total: 60,
+		Individual: sc.Sequence[IndividualRewardPoints]{
+			{Validator: who, Points: 40},
+			{Validator: other, Points: 20},
+		},
+	}, points)
+	assert.Equal(t, sc.U32(40), points.pointsOf(who))
+	assert.Equal(t, sc.U32(20), points.pointsOf(other))
+	assert.Equal(t, sc.U32(0), points.pointsOf(nominator))
+}
+
+func Test_EraStash_Bytes(t *testing.T) {
+	key := EraStash{Era: 3, Stash: who}
+
+	assert.Equal(t, append(sc.U32(3).Bytes(), who.Bytes()...), key.Bytes())
+}
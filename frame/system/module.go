@@ -82,6 +82,8 @@ type Module interface {
 	StorageBlockNumber() (sc.U64, error)
 	StorageBlockNumberSet(sc.U64)
 
+	StorageExtrinsicIndex() (sc.U32, error)
+
 	StorageLastRuntimeUpgrade() (types.LastRuntimeUpgradeInfo, error)
 	StorageLastRuntimeUpgradeSet(lrui types.LastRuntimeUpgradeInfo)
 
@@ -245,6 +247,10 @@ func (m module) StorageBlockNumberSet(blockNumber sc.U64) {
 	m.storage.BlockNumber.Put(blockNumber)
 }
 
+func (m module) StorageExtrinsicIndex() (sc.U32, error) {
+	return m.storage.ExtrinsicIndex.Get()
+}
+
 func (m module) StorageLastRuntimeUpgrade() (types.LastRuntimeUpgradeInfo, error) {
 	return m.storage.LastRuntimeUpgrade.Get()
 }
@@ -330,6 +330,18 @@ func Test_Module_StorageBlockNumber(t *testing.T) {
 	mockStorageBlockNumber.AssertCalled(t, "Get")
 }
 
+func Test_Module_StorageExtrinsicIndex(t *testing.T) {
+	target := setupModule()
+
+	mockStorageExtrinsicIndex.On("Get").Return(sc.U32(2), nil)
+
+	result, err := target.StorageExtrinsicIndex()
+	assert.Nil(t, err)
+
+	assert.Equal(t, sc.U32(2), result)
+	mockStorageExtrinsicIndex.AssertCalled(t, "Get")
+}
+
 func Test_Module_StorageBlockNumberSet(t *testing.T) {
 	target := setupModule()
 
@@ -1547,6 +1559,16 @@ func Test_Module_Metadata(t *testing.T) {
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
 						primitives.InvalidTransactionBadSigner,
 						""),
+					primitives.NewMetadataDefinitionVariant(
+						"IndeterminateImplicit",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						primitives.InvalidTransactionIndeterminateImplicit,
+						""),
+					primitives.NewMetadataDefinitionVariant(
+						"UnknownOrigin",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						primitives.InvalidTransactionUnknownOrigin,
+						""),
 				},
 			)),
 		// type 872
@@ -12,14 +12,16 @@ type Config struct {
 	WeightToFee              types.WeightToFee
 	LengthToFee              types.WeightToFee
 	BlockWeights             types.BlockWeights
+	MultiplierUpdate         MultiplierUpdate
 }
 
-func NewConfig(storage io.Storage, operationalFeeMultiplier sc.U8, weightToFee, lengthToFee types.WeightToFee, blockWeights types.BlockWeights) *Config {
+func NewConfig(storage io.Storage, operationalFeeMultiplier sc.U8, weightToFee, lengthToFee types.WeightToFee, blockWeights types.BlockWeights, multiplierUpdate MultiplierUpdate) *Config {
 	return &Config{
 		storage,
 		operationalFeeMultiplier,
 		weightToFee,
 		lengthToFee,
 		blockWeights,
+		multiplierUpdate,
 	}
 }
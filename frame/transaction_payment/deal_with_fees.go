@@ -0,0 +1,74 @@
+package transaction_payment
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// AuthorProvider returns the author of the current block, if known.
+type AuthorProvider interface {
+	Author() (sc.Option[primitives.AccountId], error)
+}
+
+// DealWithFees is an OnUnbalanced handler that gives a share of the transaction
+// fee and the whole tip to the block author, and burns the rest of the fee.
+// If the author is unknown or cannot receive the funds, they are burned as well.
+type DealWithFees struct {
+	currencyAdapter primitives.CurrencyAdapter
+	authorProvider  AuthorProvider
+	authorShare     primitives.Permill
+}
+
+func NewDealWithFees(currencyAdapter primitives.CurrencyAdapter, authorProvider AuthorProvider, authorShare primitives.Permill) DealWithFees {
+	return DealWithFees{
+		currencyAdapter: currencyAdapter,
+		authorProvider:  authorProvider,
+		authorShare:     authorShare,
+	}
+}
+
+func (d DealWithFees) OnUnbalanceds(imbalances sc.Sequence[primitives.Balance]) error {
+	if len(imbalances) == 0 {
+		return nil
+	}
+
+	fee := imbalances[0]
+	share, err := d.authorShare.Mul(fee)
+	if err != nil {
+		return err
+	}
+	toAuthor := share.(sc.U128)
+	toBurn := fee.Sub(toAuthor)
+
+	for _, tip := range imbalances[1:] {
+		toAuthor = sc.SaturatingAddU128(toAuthor, tip)
+	}
+
+	notDeposited, err := d.depositToAuthor(toAuthor)
+	if err != nil {
+		return err
+	}
+
+	return d.currencyAdapter.DropNegativeImbalance(sc.SaturatingAddU128(toBurn, notDeposited))
+}
+
+// depositToAuthor deposits value into the account of the block author and
+// returns the part of it that could not be deposited.
+func (d DealWithFees) depositToAuthor(value primitives.Balance) (primitives.Balance, error) {
+	if value.Eq(sc.NewU128(0)) {
+		return value, nil
+	}
+
+	author, err := d.authorProvider.Author()
+	if err != nil {
+		return primitives.Balance{}, err
+	}
+	if !author.HasValue {
+		return value, nil
+	}
+
+	if _, err := d.currencyAdapter.DepositIntoExisting(author.Value, value); err != nil {
+		return value, nil
+	}
+	return sc.NewU128(0), nil
+}
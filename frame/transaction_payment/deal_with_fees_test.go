@@ -0,0 +1,111 @@
+package transaction_payment
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockAuthorProvider struct {
+	mock.Mock
+}
+
+func (m *mockAuthorProvider) Author() (sc.Option[primitives.AccountId], error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.Option[primitives.AccountId]), nil
+	}
+	return args.Get(0).(sc.Option[primitives.AccountId]), args.Get(1).(error)
+}
+
+var (
+	dwfAuthor       = constants.OneAccountId
+	dwfFee          = sc.NewU128(100)
+	dwfTip          = sc.NewU128(7)
+	dwfAuthorShare  = primitives.NewPermillFromPercent(20)
+	dwfCurrency     *mocks.CurrencyAdapter
+	dwfAuthorLookup *mockAuthorProvider
+)
+
+func setupDealWithFees() DealWithFees {
+	dwfCurrency = new(mocks.CurrencyAdapter)
+	dwfAuthorLookup = new(mockAuthorProvider)
+
+	return NewDealWithFees(dwfCurrency, dwfAuthorLookup, dwfAuthorShare)
+}
+
+func Test_DealWithFees_OnUnbalanceds(t *testing.T) {
+	target := setupDealWithFees()
+	dwfAuthorLookup.On("Author").Return(sc.NewOption[primitives.AccountId](dwfAuthor), nil)
+	dwfCurrency.On("DepositIntoExisting", dwfAuthor, sc.NewU128(27)).Return(sc.NewU128(27), nil)
+	dwfCurrency.On("DropNegativeImbalance", sc.NewU128(80)).Return(nil)
+
+	err := target.OnUnbalanceds(sc.Sequence[primitives.Balance]{dwfFee, dwfTip})
+
+	assert.NoError(t, err)
+	dwfCurrency.AssertCalled(t, "DepositIntoExisting", dwfAuthor, sc.NewU128(27))
+	dwfCurrency.AssertCalled(t, "DropNegativeImbalance", sc.NewU128(80))
+}
+
+func Test_DealWithFees_OnUnbalanceds_Empty(t *testing.T) {
+	target := setupDealWithFees()
+
+	err := target.OnUnbalanceds(sc.Sequence[primitives.Balance]{})
+
+	assert.NoError(t, err)
+	dwfAuthorLookup.AssertNotCalled(t, "Author")
+	dwfCurrency.AssertNotCalled(t, "DropNegativeImbalance", mock.Anything)
+}
+
+func Test_DealWithFees_OnUnbalanceds_NoAuthor(t *testing.T) {
+	target := setupDealWithFees()
+	dwfAuthorLookup.On("Author").Return(sc.NewOption[primitives.AccountId](nil), nil)
+	dwfCurrency.On("DropNegativeImbalance", sc.NewU128(107)).Return(nil)
+
+	err := target.OnUnbalanceds(sc.Sequence[primitives.Balance]{dwfFee, dwfTip})
+
+	assert.NoError(t, err)
+	dwfCurrency.AssertNotCalled(t, "DepositIntoExisting", mock.Anything, mock.Anything)
+	dwfCurrency.AssertCalled(t, "DropNegativeImbalance", sc.NewU128(107))
+}
+
+func Test_DealWithFees_OnUnbalanceds_DepositFails(t *testing.T) {
+	target := setupDealWithFees()
+	dwfAuthorLookup.On("Author").Return(sc.NewOption[primitives.AccountId](dwfAuthor), nil)
+	dwfCurrency.On("DepositIntoExisting", dwfAuthor, sc.NewU128(27)).
+		Return(sc.NewU128(0), primitives.NewDispatchErrorCannotLookup())
+	dwfCurrency.On("DropNegativeImbalance", sc.NewU128(107)).Return(nil)
+
+	err := target.OnUnbalanceds(sc.Sequence[primitives.Balance]{dwfFee, dwfTip})
+
+	assert.NoError(t, err)
+	dwfCurrency.AssertCalled(t, "DropNegativeImbalance", sc.NewU128(107))
+}
+
+func Test_DealWithFees_OnUnbalanceds_ZeroShareAndTip(t *testing.T) {
+	target := setupDealWithFees()
+	dwfCurrency.On("DropNegativeImbalance", sc.NewU128(4)).Return(nil)
+
+	err := target.OnUnbalanceds(sc.Sequence[primitives.Balance]{sc.NewU128(4), sc.NewU128(0)})
+
+	assert.NoError(t, err)
+	dwfAuthorLookup.AssertNotCalled(t, "Author")
+	dwfCurrency.AssertCalled(t, "DropNegativeImbalance", sc.NewU128(4))
+}
+
+func Test_DealWithFees_OnUnbalanceds_AuthorFails(t *testing.T) {
+	target := setupDealWithFees()
+	expectErr := errors.New("author")
+	dwfAuthorLookup.On("Author").Return(sc.NewOption[primitives.AccountId](nil), expectErr)
+
+	err := target.OnUnbalanceds(sc.Sequence[primitives.Balance]{dwfFee, dwfTip})
+
+	assert.Equal(t, expectErr, err)
+	dwfCurrency.AssertNotCalled(t, "DropNegativeImbalance", mock.Anything)
+}
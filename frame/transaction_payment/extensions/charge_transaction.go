@@ -3,15 +3,20 @@ package extensions
 import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/hooks"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 type chargeTransaction struct {
 	currencyAdapter primitives.CurrencyAdapter
+	onUnbalanced    hooks.OnUnbalanced
 }
 
-func newChargeTransaction(currencyAdapter primitives.CurrencyAdapter) chargeTransaction {
-	return chargeTransaction{currencyAdapter: currencyAdapter}
+func newChargeTransaction(currencyAdapter primitives.CurrencyAdapter, onUnbalanced hooks.OnUnbalanced) chargeTransaction {
+	return chargeTransaction{
+		currencyAdapter: currencyAdapter,
+		onUnbalanced:    onUnbalanced,
+	}
 }
 
 func (ct chargeTransaction) WithdrawFee(who primitives.AccountId, call primitives.Call, info *primitives.DispatchInfo, fee primitives.Balance, tip primitives.Balance) (sc.Option[primitives.Balance], error) {
@@ -47,6 +52,13 @@ func (ct chargeTransaction) CorrectAndDepositFee(who primitives.AccountId, corre
 		if alreadyPaidNegativeImbalance.Lt(refundPositiveImbalance) {
 			return primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment())
 		}
+
+		// Hand over what remains from the withdrawn amount, split into fee and tip.
+		adjustedPaid := alreadyPaidNegativeImbalance.Sub(refundPositiveImbalance)
+		tipPaid := sc.Min128(adjustedPaid, tip)
+		feePaid := adjustedPaid.Sub(tipPaid)
+
+		return ct.onUnbalanced.OnUnbalanceds(sc.Sequence[primitives.Balance]{feePaid, tipPaid})
 	}
 	return nil
 }
@@ -22,11 +22,11 @@ type ChargeTransactionPayment struct {
 	typesInfoAdditionalSignedData sc.VaryingData
 }
 
-func NewChargeTransactionPayment(module system.Module, txPaymentModule transaction_payment.Module, currencyAdapter primitives.CurrencyAdapter) primitives.SignedExtension {
+func NewChargeTransactionPayment(module system.Module, txPaymentModule transaction_payment.Module, currencyAdapter primitives.CurrencyAdapter, onUnbalanced hooks.OnUnbalanced) primitives.SignedExtension {
 	return &ChargeTransactionPayment{
 		systemModule:                  module,
 		txPaymentModule:               txPaymentModule,
-		onChargeTransaction:           newChargeTransaction(currencyAdapter),
+		onChargeTransaction:           newChargeTransaction(currencyAdapter, onUnbalanced),
 		typesInfoAdditionalSignedData: sc.NewVaryingData(),
 	}
 }
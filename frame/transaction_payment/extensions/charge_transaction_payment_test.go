@@ -64,6 +64,7 @@ var (
 	mockTxPaymentModule                   *mocks.TransactionPaymentModule
 	mockOnChargeTransaction               *mocks.OnChargeTransaction
 	mockCurrencyAdapterForChargeTxPayment *mocks.CurrencyAdapter
+	mockOnUnbalancedForChargeTxPayment    *mocks.OnUnbalanced
 	mockCall                              *mocks.Call
 )
 
@@ -72,12 +73,13 @@ func setup(fee types.Balance) {
 	mockTxPaymentModule = new(mocks.TransactionPaymentModule)
 	mockOnChargeTransaction = new(mocks.OnChargeTransaction)
 	mockCurrencyAdapterForChargeTxPayment = new(mocks.CurrencyAdapter)
+	mockOnUnbalancedForChargeTxPayment = new(mocks.OnUnbalanced)
 	mockCall = new(mocks.Call)
 
 	targetChargeTxPayment = ChargeTransactionPayment{
 		systemModule:        mockSystemModule,
 		txPaymentModule:     mockTxPaymentModule,
-		onChargeTransaction: newChargeTransaction(mockCurrencyAdapterForChargeTxPayment),
+		onChargeTransaction: newChargeTransaction(mockCurrencyAdapterForChargeTxPayment, mockOnUnbalancedForChargeTxPayment),
 	}
 
 	targetChargeTxPayment.onChargeTransaction = mockOnChargeTransaction
@@ -403,9 +405,9 @@ func Test_NewChargeTxPayment(t *testing.T) {
 	expected := &ChargeTransactionPayment{
 		systemModule:                  mockSystemModule,
 		txPaymentModule:               mockTxPaymentModule,
-		onChargeTransaction:           newChargeTransaction(mockCurrencyAdapterForChargeTxPayment),
+		onChargeTransaction:           newChargeTransaction(mockCurrencyAdapterForChargeTxPayment, mockOnUnbalancedForChargeTxPayment),
 		typesInfoAdditionalSignedData: sc.NewVaryingData(),
 	}
-	txPayment := NewChargeTransactionPayment(mockSystemModule, mockTxPaymentModule, mockCurrencyAdapterForChargeTxPayment)
+	txPayment := NewChargeTransactionPayment(mockSystemModule, mockTxPaymentModule, mockCurrencyAdapterForChargeTxPayment, mockOnUnbalancedForChargeTxPayment)
 	assert.Equal(t, txPayment, expected)
 }
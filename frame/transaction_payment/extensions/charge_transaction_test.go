@@ -1,6 +1,7 @@
 package extensions
 
 import (
+	"errors"
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
@@ -8,10 +9,12 @@ import (
 	"github.com/LimeChain/gosemble/mocks"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
 	mockCurrencyAdapter *mocks.CurrencyAdapter
+	mockOnUnbalanced    *mocks.OnUnbalanced
 	target              chargeTransaction
 
 	who               = constants.ZeroAccountId
@@ -81,11 +84,36 @@ func Test_ChargeTransaction_WithdrawFee_Fail(t *testing.T) {
 func Test_ChargeTransaction_CorrectAndDepositFee_AlreadyWithdrawn_Success(t *testing.T) {
 	setUp()
 	mockCurrencyAdapter.On("DepositIntoExisting", who, refundAmount).Return(refundAmount, nil)
+	mockOnUnbalanced.On("OnUnbalanceds", sc.Sequence[primitives.Balance]{correctedFee, tip}).Return(nil)
 
 	result := target.CorrectAndDepositFee(who, correctedFee, tip, alreadyWithdrawn)
 
 	assert.Nil(t, result)
 	mockCurrencyAdapter.AssertCalled(t, "DepositIntoExisting", who, refundAmount)
+	mockOnUnbalanced.AssertCalled(t, "OnUnbalanceds", sc.Sequence[primitives.Balance]{correctedFee, tip})
+}
+
+func Test_ChargeTransaction_CorrectAndDepositFee_AlreadyWithdrawn_SplitsTip(t *testing.T) {
+	setUp()
+	tip := sc.NewU128(4)
+	mockCurrencyAdapter.On("DepositIntoExisting", who, refundAmount).Return(refundAmount, nil)
+	mockOnUnbalanced.On("OnUnbalanceds", sc.Sequence[primitives.Balance]{sc.NewU128(6), tip}).Return(nil)
+
+	result := target.CorrectAndDepositFee(who, correctedFee, tip, alreadyWithdrawn)
+
+	assert.Nil(t, result)
+	mockOnUnbalanced.AssertCalled(t, "OnUnbalanceds", sc.Sequence[primitives.Balance]{sc.NewU128(6), tip})
+}
+
+func Test_ChargeTransaction_CorrectAndDepositFee_AlreadyWithdrawn_OnUnbalancedFail(t *testing.T) {
+	setUp()
+	expectErr := errors.New("on unbalanced")
+	mockCurrencyAdapter.On("DepositIntoExisting", who, refundAmount).Return(refundAmount, nil)
+	mockOnUnbalanced.On("OnUnbalanceds", sc.Sequence[primitives.Balance]{correctedFee, tip}).Return(expectErr)
+
+	result := target.CorrectAndDepositFee(who, correctedFee, tip, alreadyWithdrawn)
+
+	assert.Equal(t, expectErr, result)
 }
 
 func Test_ChargeTransaction_CorrectAndDepositFee_NotWithdrawn(t *testing.T) {
@@ -96,6 +124,7 @@ func Test_ChargeTransaction_CorrectAndDepositFee_NotWithdrawn(t *testing.T) {
 
 	assert.Nil(t, result)
 	mockCurrencyAdapter.AssertNotCalled(t, "DepositIntoExisting")
+	mockOnUnbalanced.AssertNotCalled(t, "OnUnbalanceds", mock.Anything)
 }
 
 func Test_ChargeTransaction_CorrectAndDepositFee_AlreadyWithdrawn_DepositIntoExisting_Fail(t *testing.T) {
@@ -121,5 +150,6 @@ func Test_ChargeTransaction_CorrectAndDepositFee_AlreadyWithdrawn_Fail(t *testin
 
 func setUp() {
 	mockCurrencyAdapter = new(mocks.CurrencyAdapter)
-	target = newChargeTransaction(mockCurrencyAdapter)
+	mockOnUnbalanced = new(mocks.OnUnbalanced)
+	target = newChargeTransaction(mockCurrencyAdapter, mockOnUnbalanced)
 }
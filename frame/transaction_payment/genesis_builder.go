@@ -2,7 +2,9 @@ package transaction_payment
 
 import (
 	"encoding/json"
+
 	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 type GenesisConfig struct {
@@ -33,7 +35,7 @@ func (gc *GenesisConfig) UnmarshalJSON(data []byte) error {
 
 func (m module) CreateDefaultConfig() ([]byte, error) {
 	gc := &genesisConfigJsonStruct{}
-	gc.TransactionPaymentGenesisConfig.Multiplier = defaultMultiplierValue.ToBigInt().String()
+	gc.TransactionPaymentGenesisConfig.Multiplier = defaultMultiplierValue.Inner.ToBigInt().String()
 
 	return json.Marshal(gc)
 }
@@ -47,7 +49,7 @@ func (m module) BuildConfig(config []byte) error {
 	// todo missing
 	// StorageVersion::<T>::put(Releases::V2);
 
-	m.storage.NextFeeMultiplier.Put(sc.NewU128(gc.Multiplier))
+	m.storage.NextFeeMultiplier.Put(primitives.NewFixedU128FromInner(gc.Multiplier))
 
 	return nil
 }
@@ -6,18 +6,19 @@ import (
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/assert"
 )
 
 var (
-	gcJson = []byte("{\"transactionPayment\":{\"multiplier\":\"1\"}}")
+	gcJson = []byte("{\"transactionPayment\":{\"multiplier\":\"1000000000000000000\"}}")
 )
 
 func Test_GenesisConfig_UnmarshalJSON(t *testing.T) {
 	transactionPaymentGc := GenesisConfig{}
 	err := json.Unmarshal(gcJson, &transactionPaymentGc)
 	assert.NoError(t, err)
-	assert.Equal(t, sc.NewU128(1), transactionPaymentGc.Multiplier)
+	assert.Equal(t, sc.NewU128(uint64(1_000_000_000_000_000_000)), transactionPaymentGc.Multiplier)
 }
 
 func Test_GenesisConfig_UnmarshalJSON_InvalidGenesisMultiplier(t *testing.T) {
@@ -36,9 +37,9 @@ func Test_CreateDefaultConfig(t *testing.T) {
 
 func Test_BuildConfig(t *testing.T) {
 	setup()
-	mockNextFeeMultiplier.On("Put", sc.NewU128(1)).Return()
+	mockNextFeeMultiplier.On("Put", primitives.NewFixedU128FromInteger(sc.NewU128(1))).Return()
 
 	err := target.BuildConfig(gcJson)
 	assert.NoError(t, err)
-	mockNextFeeMultiplier.AssertCalled(t, "Put", sc.NewU128(1))
+	mockNextFeeMultiplier.AssertCalled(t, "Put", primitives.NewFixedU128FromInteger(sc.NewU128(1)))
 }
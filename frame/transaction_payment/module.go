@@ -73,17 +73,10 @@ func (m module) Metadata() primitives.MetadataModule {
 				m.index,
 				"Events.TransactionPayment"),
 		),
-		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
-			primitives.NewMetadataModuleConstant(
-				"OperationalFeeMultiplier",
-				sc.ToCompact(metadata.PrimitiveTypesU8),
-				sc.BytesToSequenceU8(m.constants.OperationalFeeMultiplier.Bytes()),
-				"A fee multiplier for `Operational` extrinsics to compute \"virtual tip\" to boost their  `priority` ",
-			),
-		},
-		Error:    sc.NewOption[sc.Compact](nil),
-		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](nil),
-		Index:    m.index,
+		Constants: m.metadataConstants(),
+		Error:     sc.NewOption[sc.Compact](nil),
+		ErrorDef:  sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+		Index:     m.index,
 	}
 
 	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
@@ -94,6 +87,37 @@ func (m module) Metadata() primitives.MetadataModule {
 	}
 }
 
+func (m module) metadataConstants() sc.Sequence[primitives.MetadataModuleConstant] {
+	constants := sc.Sequence[primitives.MetadataModuleConstant]{
+		primitives.NewMetadataModuleConstant(
+			"OperationalFeeMultiplier",
+			sc.ToCompact(metadata.PrimitiveTypesU8),
+			sc.BytesToSequenceU8(m.constants.OperationalFeeMultiplier.Bytes()),
+			"A fee multiplier for `Operational` extrinsics to compute \"virtual tip\" to boost their  `priority` ",
+		),
+	}
+
+	if polynomial, ok := m.config.WeightToFee.(primitives.FeePolynomial); ok {
+		constants = append(constants, primitives.NewMetadataModuleConstant(
+			"WeightToFee",
+			sc.ToCompact(metadata.TypesSequenceTransactionPaymentWeightToFeeCoefficient),
+			sc.BytesToSequenceU8(polynomial.Polynomial().Bytes()),
+			"The polynomial that is applied in order to derive fee from weight.",
+		))
+	}
+
+	if polynomial, ok := m.config.LengthToFee.(primitives.FeePolynomial); ok {
+		constants = append(constants, primitives.NewMetadataModuleConstant(
+			"LengthToFee",
+			sc.ToCompact(metadata.TypesSequenceTransactionPaymentWeightToFeeCoefficient),
+			sc.BytesToSequenceU8(polynomial.Polynomial().Bytes()),
+			"The polynomial that is applied in order to derive fee from the length of an extrinsic.",
+		))
+	}
+
+	return constants
+}
+
 func (m module) metadataTypes() sc.Sequence[primitives.MetadataType] {
 	return sc.Sequence[primitives.MetadataType]{
 		primitives.NewMetadataTypeWithPath(metadata.TypesTransactionPaymentReleases, "Releases", sc.Sequence[sc.Str]{"pallet_transaction_payment", "Releases"}, primitives.NewMetadataTypeDefinitionVariant(
@@ -166,6 +190,23 @@ func (m module) metadataTypes() sc.Sequence[primitives.MetadataType] {
 				primitives.NewMetadataTypeDefinitionFieldWithName(metadata.PrimitiveTypesU128, "Balance")}),
 			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance"),
 		),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesPerbill, "Perbill", sc.Sequence[sc.Str]{"sp_arithmetic", "per_things", "Perbill"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithName(metadata.PrimitiveTypesU32, "u32"),
+			})),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesTransactionPaymentWeightToFeeCoefficient, "WeightToFeeCoefficient", sc.Sequence[sc.Str]{"sp_weights", "weight_to_fee", "WeightToFeeCoefficient"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "coeff_integer", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesPerbill, "coeff_frac", "Perbill"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "negative", "bool"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "degree", "u8"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance"),
+		),
+
+		primitives.NewMetadataType(metadata.TypesSequenceTransactionPaymentWeightToFeeCoefficient, "[]WeightToFeeCoefficient", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTransactionPaymentWeightToFeeCoefficient))),
 	}
 }
 
@@ -187,6 +228,22 @@ func (m module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
 	})
 }
 
+// OnFinalize updates the fee multiplier for the next block, based on the weight of the current one.
+func (m module) OnFinalize(_ sc.U64) error {
+	multiplier, err := m.storage.NextFeeMultiplier.Get()
+	if err != nil {
+		return err
+	}
+
+	next, err := m.config.MultiplierUpdate.Convert(multiplier)
+	if err != nil {
+		return err
+	}
+	m.storage.NextFeeMultiplier.Put(next)
+
+	return nil
+}
+
 func (m module) ComputeFee(len sc.U32, info primitives.DispatchInfo, tip primitives.Balance) (primitives.Balance, error) {
 	fee, err := m.ComputeFeeDetails(len, info, tip)
 	return fee.FinalFee(), err
@@ -212,13 +269,7 @@ func (m module) computeFeeRaw(len sc.U32, weight primitives.Weight, tip primitiv
 		if err != nil {
 			return types.FeeDetails{}, err
 		}
-		// Storage value is FixedU128, which is different from U128.
-		// It implements a decimal fixed point number, which is `1 / VALUE`
-		// Example: FixedU128, VALUE is 1_000_000_000_000_000_000.
-		// FixedU64, VALUE is 1_000_000_000.
-		fixedU128Div := sc.NewU128(uint64(1_000_000_000_000_000_000))
-		bnAdjustedWeightFee := multiplier.Mul(unadjustedWeightFee)
-		adjustedWeightFee := bnAdjustedWeightFee.Div(fixedU128Div) // TODO: Create FixedU128 type
+		adjustedWeightFee := multiplier.SaturatingMulInt(unadjustedWeightFee)
 
 		dispatchClass, err := m.config.BlockWeights.Get(class)
 		if err != nil {
@@ -1,6 +1,7 @@
 package transaction_payment
 
 import (
+	"errors"
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
@@ -10,6 +11,7 @@ import (
 	"github.com/LimeChain/gosemble/primitives/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 const (
@@ -120,6 +122,23 @@ var (
 				primitives.NewMetadataTypeDefinitionFieldWithName(metadata.PrimitiveTypesU128, "Balance")}),
 			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance"),
 		),
+
+		types.NewMetadataTypeWithPath(metadata.TypesPerbill, "Perbill", sc.Sequence[sc.Str]{"sp_arithmetic", "per_things", "Perbill"}, types.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[types.MetadataTypeDefinitionField]{
+				types.NewMetadataTypeDefinitionFieldWithName(metadata.PrimitiveTypesU32, "u32"),
+			})),
+
+		types.NewMetadataTypeWithParam(metadata.TypesTransactionPaymentWeightToFeeCoefficient, "WeightToFeeCoefficient", sc.Sequence[sc.Str]{"sp_weights", "weight_to_fee", "WeightToFeeCoefficient"}, types.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[types.MetadataTypeDefinitionField]{
+				types.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "coeff_integer", "Balance"),
+				types.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesPerbill, "coeff_frac", "Perbill"),
+				types.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "negative", "bool"),
+				types.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "degree", "u8"),
+			}),
+			types.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance"),
+		),
+
+		types.NewMetadataType(metadata.TypesSequenceTransactionPaymentWeightToFeeCoefficient, "[]WeightToFeeCoefficient", types.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTransactionPaymentWeightToFeeCoefficient))),
 	}
 
 	moduleV14 = types.MetadataModuleV14{
@@ -158,6 +177,18 @@ var (
 				sc.BytesToSequenceU8(operationalFeeMultiplier.Bytes()),
 				"A fee multiplier for `Operational` extrinsics to compute \"virtual tip\" to boost their  `priority` ",
 			),
+			types.NewMetadataModuleConstant(
+				"WeightToFee",
+				sc.ToCompact(metadata.TypesSequenceTransactionPaymentWeightToFeeCoefficient),
+				sc.BytesToSequenceU8(types.IdentityFee{}.Polynomial().Bytes()),
+				"The polynomial that is applied in order to derive fee from weight.",
+			),
+			types.NewMetadataModuleConstant(
+				"LengthToFee",
+				sc.ToCompact(metadata.TypesSequenceTransactionPaymentWeightToFeeCoefficient),
+				sc.BytesToSequenceU8(types.IdentityFee{}.Polynomial().Bytes()),
+				"The polynomial that is applied in order to derive fee from the length of an extrinsic.",
+			),
 		},
 		Error:    sc.NewOption[sc.Compact](nil),
 		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](nil),
@@ -174,14 +205,16 @@ var (
 	target                module
 	mockStorage           *mocks.IoStorage
 	mockCall              *mocks.Call
-	mockNextFeeMultiplier *mocks.StorageValue[sc.U128]
+	mockNextFeeMultiplier *mocks.StorageValue[primitives.FixedU128]
+	mockMultiplierUpdate  *mocks.MultiplierUpdate
 )
 
 func setup() {
 	mockStorage = new(mocks.IoStorage)
-	mockNextFeeMultiplier = new(mocks.StorageValue[sc.U128])
+	mockNextFeeMultiplier = new(mocks.StorageValue[primitives.FixedU128])
+	mockMultiplierUpdate = new(mocks.MultiplierUpdate)
 
-	config := NewConfig(mockStorage, operationalFeeMultiplier, weightToFee, lengthToFee, blockWeights)
+	config := NewConfig(mockStorage, operationalFeeMultiplier, weightToFee, lengthToFee, blockWeights, mockMultiplierUpdate)
 	target = New(moduleId, config, mdGenerator).(module)
 	target.storage.NextFeeMultiplier = mockNextFeeMultiplier
 }
@@ -228,6 +261,39 @@ func Test_Metadata(t *testing.T) {
 	assert.Equal(t, expectedMetadataModule, metadataModule)
 }
 
+func Test_OnFinalize(t *testing.T) {
+	setup()
+
+	previous := primitives.NewFixedU128FromInteger(sc.NewU128(1))
+	next := primitives.NewFixedU128FromRational(sc.NewU128(3), sc.NewU128(2))
+
+	mockNextFeeMultiplier.On("Get").Return(previous, nil)
+	mockMultiplierUpdate.On("Convert", previous).Return(next, nil)
+	mockNextFeeMultiplier.On("Put", next).Return()
+
+	err := target.OnFinalize(1)
+	assert.NoError(t, err)
+
+	mockNextFeeMultiplier.AssertCalled(t, "Get")
+	mockMultiplierUpdate.AssertCalled(t, "Convert", previous)
+	mockNextFeeMultiplier.AssertCalled(t, "Put", next)
+}
+
+func Test_OnFinalize_ConvertFails(t *testing.T) {
+	setup()
+
+	previous := primitives.NewFixedU128FromInteger(sc.NewU128(1))
+	expectErr := errors.New("convert")
+
+	mockNextFeeMultiplier.On("Get").Return(previous, nil)
+	mockMultiplierUpdate.On("Convert", previous).Return(primitives.FixedU128{}, expectErr)
+
+	err := target.OnFinalize(1)
+	assert.Equal(t, expectErr, err)
+
+	mockNextFeeMultiplier.AssertNotCalled(t, "Put", mock.Anything)
+}
+
 func Test_ComputeFee_TipOnlyNoFee(t *testing.T) {
 	setup()
 
@@ -253,7 +319,7 @@ func Test_ComputeFee_NoTipOnlyBaseFee(t *testing.T) {
 		PaysFee: primitives.PaysYes,
 	}
 
-	mockNextFeeMultiplier.On("Get").Return(sc.NewU128(0), nil)
+	mockNextFeeMultiplier.On("Get").Return(primitives.NewFixedU128FromInner(sc.NewU128(0)), nil)
 
 	fee, err := target.ComputeFee(0, info, sc.NewU128(0))
 	assert.Nil(t, err)
@@ -271,7 +337,7 @@ func Test_ComputeFee_TipPlusBaseFee(t *testing.T) {
 		PaysFee: primitives.PaysYes,
 	}
 
-	mockNextFeeMultiplier.On("Get").Return(sc.NewU128(2), nil)
+	mockNextFeeMultiplier.On("Get").Return(primitives.NewFixedU128FromInner(sc.NewU128(2)), nil)
 
 	fee, err := target.ComputeFee(0, info, sc.NewU128(69))
 	assert.Nil(t, err)
@@ -289,7 +355,7 @@ func Test_ComputeFee_ByteFeePlusBaseFee(t *testing.T) {
 		PaysFee: primitives.PaysYes,
 	}
 
-	mockNextFeeMultiplier.On("Get").Return(sc.NewU128(0), nil)
+	mockNextFeeMultiplier.On("Get").Return(primitives.NewFixedU128FromInner(sc.NewU128(0)), nil)
 
 	fee, err := target.ComputeFee(42, info, sc.NewU128(0))
 	assert.Nil(t, err)
@@ -307,7 +373,7 @@ func Test_ComputeFee_WeightFeePlusBaseFee(t *testing.T) {
 		PaysFee: primitives.PaysYes,
 	}
 
-	mockNextFeeMultiplier.On("Get").Return(sc.NewU128(0), nil)
+	mockNextFeeMultiplier.On("Get").Return(primitives.NewFixedU128FromInner(sc.NewU128(0)), nil)
 
 	fee, err := target.ComputeFee(0, info, sc.NewU128(0))
 	assert.Nil(t, err)
@@ -316,6 +382,24 @@ func Test_ComputeFee_WeightFeePlusBaseFee(t *testing.T) {
 	assert.Equal(t, sc.NewU128(100), fee)
 }
 
+func Test_ComputeFee_AdjustedWeightFeePlusBaseFee(t *testing.T) {
+	setup()
+
+	info := primitives.DispatchInfo{
+		Weight:  primitives.WeightFromParts(1000, 0),
+		Class:   primitives.NewDispatchClassOperational(),
+		PaysFee: primitives.PaysYes,
+	}
+
+	mockNextFeeMultiplier.On("Get").Return(primitives.NewFixedU128FromRational(sc.NewU128(3), sc.NewU128(2)), nil)
+
+	fee, err := target.ComputeFee(0, info, sc.NewU128(0))
+	assert.Nil(t, err)
+
+	mockNextFeeMultiplier.AssertCalled(t, "Get")
+	assert.Equal(t, sc.NewU128(1600), fee)
+}
+
 func Test_ComputeFeeDetails(t *testing.T) {
 	setup()
 
@@ -325,7 +409,7 @@ func Test_ComputeFeeDetails(t *testing.T) {
 		PaysFee: primitives.PaysYes,
 	}
 
-	mockNextFeeMultiplier.On("Get").Return(sc.NewU128(0), nil)
+	mockNextFeeMultiplier.On("Get").Return(primitives.NewFixedU128FromInner(sc.NewU128(0)), nil)
 
 	result, err := target.ComputeFeeDetails(5, info, sc.NewU128(3))
 	assert.NoError(t, err)
@@ -350,7 +434,7 @@ func Test_ComputeActualFee(t *testing.T) {
 		ActualWeight: sc.NewOption[types.Weight](primitives.WeightFromParts(0, 0)),
 		PaysFee:      0,
 	}
-	mockNextFeeMultiplier.On("Get").Return(sc.NewU128(0), nil)
+	mockNextFeeMultiplier.On("Get").Return(primitives.NewFixedU128FromInner(sc.NewU128(0)), nil)
 
 	result, err := target.ComputeActualFee(0, info, postInfo, sc.NewU128(0))
 	assert.Nil(t, err)
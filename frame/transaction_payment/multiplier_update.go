@@ -0,0 +1,9 @@
+package transaction_payment
+
+import primitives "github.com/LimeChain/gosemble/primitives/types"
+
+// MultiplierUpdate computes the fee multiplier for the next block from the
+// multiplier of the current one. It is applied at the end of every block.
+type MultiplierUpdate interface {
+	Convert(previous primitives.FixedU128) (primitives.FixedU128, error)
+}
@@ -4,6 +4,7 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 var (
@@ -11,10 +12,10 @@ var (
 	keyNextFeeMultiplier  = []byte("NextFeeMultiplier")
 )
 
-var defaultMultiplierValue = sc.NewU128(1)
+var defaultMultiplierValue = primitives.NewFixedU128FromInteger(sc.NewU128(1))
 
 type storage struct {
-	NextFeeMultiplier support.StorageValue[sc.U128]
+	NextFeeMultiplier support.StorageValue[primitives.FixedU128]
 }
 
 func newStorage(s io.Storage) *storage {
@@ -23,7 +24,7 @@ func newStorage(s io.Storage) *storage {
 			s,
 			keyTransactionPayment,
 			keyNextFeeMultiplier,
-			sc.DecodeU128,
+			primitives.DecodeFixedU128,
 			&defaultMultiplierValue,
 		),
 	}
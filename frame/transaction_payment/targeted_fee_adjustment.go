@@ -0,0 +1,106 @@
+package transaction_payment
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// TargetedFeeAdjustment updates the fee multiplier based on how full the previous block was,
+// relative to a target block fullness.
+//
+// With `s` the target block fullness, `v` the adjustment variable and `diff = (b - s*m) / m`,
+// where `b` is the normal dispatch class weight of the block and `m` the maximum normal weight,
+// the next multiplier is:
+//
+//	next = previous * (1 + v*diff + (v*diff)^2 / 2)
+//
+// The result is clamped between the minimum and the maximum multiplier.
+// Of the ref time and proof size dimensions, the one that is more utilized is used.
+type TargetedFeeAdjustment struct {
+	systemModule        system.Module
+	targetBlockFullness primitives.Perquintill
+	adjustmentVariable  primitives.FixedU128
+	minimumMultiplier   primitives.FixedU128
+	maximumMultiplier   primitives.FixedU128
+}
+
+func NewTargetedFeeAdjustment(systemModule system.Module, targetBlockFullness primitives.Perquintill, adjustmentVariable, minimumMultiplier, maximumMultiplier primitives.FixedU128) TargetedFeeAdjustment {
+	return TargetedFeeAdjustment{
+		systemModule:        systemModule,
+		targetBlockFullness: targetBlockFullness,
+		adjustmentVariable:  adjustmentVariable,
+		minimumMultiplier:   minimumMultiplier,
+		maximumMultiplier:   maximumMultiplier,
+	}
+}
+
+func (tfa TargetedFeeAdjustment) Convert(previous primitives.FixedU128) (primitives.FixedU128, error) {
+	// The multiplier can be lower than the minimum if the minimum was changed by a runtime upgrade.
+	if previous.Lt(tfa.minimumMultiplier) {
+		previous = tfa.minimumMultiplier
+	}
+
+	weights := tfa.systemModule.BlockWeights()
+	normalWeights, err := weights.Get(primitives.NewDispatchClassNormal())
+	if err != nil {
+		return primitives.FixedU128{}, err
+	}
+	normalMaxWeight := weights.MaxBlock
+	if normalWeights.MaxTotal.HasValue {
+		normalMaxWeight = normalWeights.MaxTotal.Value
+	}
+
+	currentBlockWeight, err := tfa.systemModule.StorageBlockWeight()
+	if err != nil {
+		return primitives.FixedU128{}, err
+	}
+	normalBlockWeight, err := currentBlockWeight.Get(primitives.NewDispatchClassNormal())
+	if err != nil {
+		return primitives.FixedU128{}, err
+	}
+	normalWeight := normalBlockWeight.Min(normalMaxWeight)
+
+	normalizedRefTime := normalizedWeight(normalWeight.RefTime, normalMaxWeight.RefTime)
+	normalizedProofSize := normalizedWeight(normalWeight.ProofSize, normalMaxWeight.ProofSize)
+
+	normalLimitingDimension, maxLimitingDimension := normalWeight.RefTime, normalMaxWeight.RefTime
+	if normalizedRefTime.Lt(normalizedProofSize) {
+		normalLimitingDimension, maxLimitingDimension = normalWeight.ProofSize, normalMaxWeight.ProofSize
+	}
+
+	target, err := tfa.targetBlockFullness.Mul(maxLimitingDimension)
+	if err != nil {
+		return primitives.FixedU128{}, err
+	}
+	targetWeight := sc.NewU128(target.(sc.U64))
+	blockWeight := sc.NewU128(normalLimitingDimension)
+
+	positive := blockWeight.Gte(targetWeight)
+	diffAbs := sc.Max128(blockWeight, targetWeight).Sub(sc.Min128(blockWeight, targetWeight))
+
+	diff := primitives.NewFixedU128FromRational(diffAbs, sc.NewU128(sc.Max64(maxLimitingDimension, 1)))
+	diffSquared := diff.SaturatingMul(diff)
+	vSquared := tfa.adjustmentVariable.SaturatingMul(tfa.adjustmentVariable)
+	vSquared2 := primitives.NewFixedU128FromInner(vSquared.Inner.Div(sc.NewU128(2)))
+
+	firstTerm := tfa.adjustmentVariable.SaturatingMul(diff)
+	secondTerm := vSquared2.SaturatingMul(diffSquared)
+
+	if positive {
+		excess := firstTerm.SaturatingAdd(secondTerm).SaturatingMul(previous)
+		return previous.SaturatingAdd(excess).Clamp(tfa.minimumMultiplier, tfa.maximumMultiplier), nil
+	}
+
+	negative := firstTerm.SaturatingSub(secondTerm).SaturatingMul(previous)
+	return previous.SaturatingSub(negative).Clamp(tfa.minimumMultiplier, tfa.maximumMultiplier), nil
+}
+
+// normalizedWeight returns the utilization `weight / max` of a weight dimension.
+// A dimension without a limit is considered fully utilized.
+func normalizedWeight(weight, max sc.U64) primitives.FixedU128 {
+	if max == 0 {
+		return primitives.NewFixedU128FromInteger(sc.NewU128(1))
+	}
+	return primitives.NewFixedU128FromRational(sc.NewU128(weight), sc.NewU128(max))
+}
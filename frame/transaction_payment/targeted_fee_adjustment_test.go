@@ -0,0 +1,130 @@
+package transaction_payment
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	tfaBlockWeights = primitives.BlockWeights{
+		MaxBlock: primitives.WeightFromParts(2000, 2000),
+		PerClass: primitives.PerDispatchClassWeightsPerClass{
+			Normal: primitives.WeightsPerClass{
+				MaxTotal: sc.NewOption[primitives.Weight](primitives.WeightFromParts(1000, 1000)),
+			},
+		},
+	}
+
+	targetBlockFullness = primitives.NewPerquintillFromPercent(25)
+	adjustmentVariable  = primitives.NewFixedU128FromRational(sc.NewU128(1), sc.NewU128(10))
+	minimumMultiplier   = primitives.NewFixedU128FromRational(sc.NewU128(1), sc.NewU128(2))
+	maximumMultiplier   = primitives.NewFixedU128FromInteger(sc.NewU128(2))
+
+	multiplierOne = primitives.NewFixedU128FromInteger(sc.NewU128(1))
+)
+
+var (
+	mockSystemModule *mocks.SystemModule
+)
+
+func setupTargetedFeeAdjustment(normalBlockWeight primitives.Weight) TargetedFeeAdjustment {
+	mockSystemModule = new(mocks.SystemModule)
+
+	mockSystemModule.On("BlockWeights").Return(tfaBlockWeights)
+	mockSystemModule.On("StorageBlockWeight").Return(primitives.ConsumedWeight{Normal: normalBlockWeight}, nil)
+
+	return NewTargetedFeeAdjustment(mockSystemModule, targetBlockFullness, adjustmentVariable, minimumMultiplier, maximumMultiplier)
+}
+
+func Test_TargetedFeeAdjustment_Convert_EmptyBlock(t *testing.T) {
+	target := setupTargetedFeeAdjustment(primitives.WeightZero())
+
+	result, err := target.Convert(multiplierOne)
+	assert.NoError(t, err)
+
+	// diff = 0.25, 1 - (0.1 * 0.25 - 0.005 * 0.0625)
+	assert.Equal(t, sc.NewU128(uint64(975_312_500_000_000_000)), result.Inner)
+	mockSystemModule.AssertCalled(t, "BlockWeights")
+	mockSystemModule.AssertCalled(t, "StorageBlockWeight")
+}
+
+func Test_TargetedFeeAdjustment_Convert_FullBlock(t *testing.T) {
+	target := setupTargetedFeeAdjustment(primitives.WeightFromParts(1000, 1000))
+
+	result, err := target.Convert(multiplierOne)
+	assert.NoError(t, err)
+
+	// diff = 0.75, 1 + (0.1 * 0.75 + 0.005 * 0.5625)
+	assert.Equal(t, sc.NewU128(uint64(1_077_812_500_000_000_000)), result.Inner)
+}
+
+func Test_TargetedFeeAdjustment_Convert_TargetBlock(t *testing.T) {
+	target := setupTargetedFeeAdjustment(primitives.WeightFromParts(250, 0))
+
+	result, err := target.Convert(multiplierOne)
+	assert.NoError(t, err)
+
+	assert.Equal(t, multiplierOne, result)
+}
+
+func Test_TargetedFeeAdjustment_Convert_CapsBlockWeightAtNormalMaxTotal(t *testing.T) {
+	target := setupTargetedFeeAdjustment(primitives.WeightFromParts(1500, 1500))
+
+	result, err := target.Convert(multiplierOne)
+	assert.NoError(t, err)
+
+	assert.Equal(t, sc.NewU128(uint64(1_077_812_500_000_000_000)), result.Inner)
+}
+
+func Test_TargetedFeeAdjustment_Convert_ProofSizeLimiting(t *testing.T) {
+	target := setupTargetedFeeAdjustment(primitives.WeightFromParts(250, 1000))
+
+	result, err := target.Convert(multiplierOne)
+	assert.NoError(t, err)
+
+	assert.Equal(t, sc.NewU128(uint64(1_077_812_500_000_000_000)), result.Inner)
+}
+
+func Test_TargetedFeeAdjustment_Convert_RaisesPreviousToMinimum(t *testing.T) {
+	target := setupTargetedFeeAdjustment(primitives.WeightFromParts(250, 0))
+
+	result, err := target.Convert(primitives.NewFixedU128FromInner(sc.NewU128(1)))
+	assert.NoError(t, err)
+
+	assert.Equal(t, minimumMultiplier, result)
+}
+
+func Test_TargetedFeeAdjustment_Convert_ClampsToMinimum(t *testing.T) {
+	target := setupTargetedFeeAdjustment(primitives.WeightZero())
+
+	result, err := target.Convert(minimumMultiplier)
+	assert.NoError(t, err)
+
+	assert.Equal(t, minimumMultiplier, result)
+}
+
+func Test_TargetedFeeAdjustment_Convert_ClampsToMaximum(t *testing.T) {
+	target := setupTargetedFeeAdjustment(primitives.WeightFromParts(1000, 1000))
+
+	result, err := target.Convert(maximumMultiplier)
+	assert.NoError(t, err)
+
+	assert.Equal(t, maximumMultiplier, result)
+}
+
+func Test_TargetedFeeAdjustment_Convert_StorageBlockWeightFails(t *testing.T) {
+	expectErr := errors.New("block weight")
+	mockSystemModule = new(mocks.SystemModule)
+	mockSystemModule.On("BlockWeights").Return(tfaBlockWeights)
+	mockSystemModule.On("StorageBlockWeight").Return(primitives.ConsumedWeight{}, expectErr)
+	target := NewTargetedFeeAdjustment(mockSystemModule, targetBlockFullness, adjustmentVariable, minimumMultiplier, maximumMultiplier)
+
+	_, err := target.Convert(multiplierOne)
+
+	assert.Equal(t, expectErr, err)
+}
@@ -0,0 +1,121 @@
+package utility
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callAsDerivative dispatches a call through an indexed pseudonym of the sender.
+type callAsDerivative struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallAsDerivative(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callAsDerivative{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U16(0)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callAsDerivative) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	index, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	call, err := decodeCallFunc(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(index, call)
+
+	return c, nil
+}
+
+func (c callAsDerivative) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	c.module.logger.Critical("not implemented")
+	return nil, nil
+}
+
+func (c callAsDerivative) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callAsDerivative) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callAsDerivative) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callAsDerivative) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callAsDerivative) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callAsDerivative) BaseWeight() primitives.Weight {
+	call := c.Args()[1].(primitives.Call)
+
+	return callAsDerivativeWeight(c.dbWeight).
+		SaturatingAdd(call.WeighData(call.BaseWeight())).
+		SaturatingAdd(c.dbWeight.ReadsWrites(1, 1))
+}
+
+func (_ callAsDerivative) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callAsDerivative) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	call := c.Args()[1].(primitives.Call)
+
+	return call.ClassifyDispatch(baseWeight)
+}
+
+func (_ callAsDerivative) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callAsDerivative) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	index := args[0].(sc.U16)
+	call := args[1].(primitives.Call)
+
+	pseudonym, err := c.module.DerivativeAccountId(who.Value, index)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	postInfo, err := call.Dispatch(primitives.NewRawOriginSigned(pseudonym), call.Args())
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	// Always take into account the base weight of this call.
+	weight := callAsDerivativeWeight(c.dbWeight).
+		SaturatingAdd(c.dbWeight.ReadsWrites(1, 1)).
+		SaturatingAdd(actualWeight(call, postInfo))
+
+	return primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](weight),
+		PaysFee:      primitives.PaysYes,
+	}, nil
+}
+
+func (_ callAsDerivative) Docs() string {
+	return "Send a call through an indexed pseudonym of the sender. " +
+		"Filter from origin are passed along. The call will be dispatched with an origin which " +
+		"use the same filter as the origin of this call. " +
+		"The dispatch origin for this call must be `Signed`."
+}
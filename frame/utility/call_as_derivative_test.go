@@ -0,0 +1,94 @@
+package utility
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	derivativeIndex = sc.U16(1)
+	derivativeHash  = constants.TwoAccountId.Bytes()
+)
+
+func Test_Call_AsDerivative_DecodeNestedArgs(t *testing.T) {
+	target := setupCallAsDerivative()
+	buffer := bytes.NewBuffer(derivativeIndex.Bytes())
+
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(derivativeIndex, mockCall), result.Args())
+}
+
+func Test_Call_AsDerivative_BaseWeight(t *testing.T) {
+	target := setupCallAsDerivative()
+	target.Arguments = sc.NewVaryingData(derivativeIndex, mockCall)
+	expectCallInfo(mockCall, primitives.NewDispatchClassNormal(), primitives.PaysYes)
+
+	expect := callAsDerivativeWeight(dbWeight).
+		Add(callWeight).
+		Add(dbWeight.ReadsWrites(1, 1))
+	assert.Equal(t, expect, target.BaseWeight())
+}
+
+func Test_Call_AsDerivative_ClassifyDispatch(t *testing.T) {
+	target := setupCallAsDerivative()
+	target.Arguments = sc.NewVaryingData(derivativeIndex, mockCall)
+	mockCall.On("ClassifyDispatch", callWeight).Return(primitives.NewDispatchClassOperational())
+
+	assert.Equal(t, primitives.NewDispatchClassOperational(), target.ClassifyDispatch(callWeight))
+}
+
+func Test_Call_AsDerivative_Dispatch(t *testing.T) {
+	target := setupCallAsDerivative()
+	target.Arguments = sc.NewVaryingData(derivativeIndex, mockCall)
+	mockHashing.On("Blake256", mock.Anything).Return(derivativeHash)
+	expectCallDispatch(mockCall, primitives.NewRawOriginSigned(constants.TwoAccountId), nil)
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.NoError(t, err)
+	expectWeight := callAsDerivativeWeight(dbWeight).
+		Add(dbWeight.ReadsWrites(1, 1)).
+		Add(primitives.WeightFromParts(40, 0))
+	assert.Equal(t,
+		primitives.PostDispatchInfo{
+			ActualWeight: sc.NewOption[primitives.Weight](expectWeight),
+			PaysFee:      primitives.PaysYes,
+		},
+		result)
+	mockCall.AssertCalled(t, "Dispatch", primitives.NewRawOriginSigned(constants.TwoAccountId), callArgs)
+}
+
+func Test_Call_AsDerivative_Dispatch_Fails(t *testing.T) {
+	target := setupCallAsDerivative()
+	target.Arguments = sc.NewVaryingData(derivativeIndex, mockCall)
+	mockHashing.On("Blake256", mock.Anything).Return(derivativeHash)
+	expectCallDispatch(mockCall, primitives.NewRawOriginSigned(constants.TwoAccountId), dispatchErrOther)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, dispatchErrOther, err)
+}
+
+func Test_Call_AsDerivative_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallAsDerivative()
+	target.Arguments = sc.NewVaryingData(derivativeIndex, mockCall)
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func setupCallAsDerivative() callAsDerivative {
+	return newCallAsDerivative(moduleId, functionAsDerivative, dbWeight, setupModule()).(callAsDerivative)
+}
@@ -0,0 +1,11 @@
+package utility
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callAsDerivativeWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(4755000, 0).
+		SaturatingAdd(dbWeight.Reads(0)).
+		SaturatingAdd(dbWeight.Writes(0))
+}
@@ -0,0 +1,122 @@
+package utility
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callBatch dispatches a batch of calls. Dispatching stops at the first failing call.
+type callBatch struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallBatch(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callBatch{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.Call]{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callBatch) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	calls, err := c.module.decodeCalls(buffer, decodeCallFunc)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(calls)
+	return c, nil
+}
+
+func (c callBatch) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	c.module.logger.Critical("not implemented")
+	return nil, nil
+}
+
+func (c callBatch) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callBatch) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callBatch) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callBatch) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callBatch) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callBatch) BaseWeight() primitives.Weight {
+	calls := c.Args()[0].(sc.Sequence[primitives.Call])
+	weight, _ := weightAndDispatchClass(calls)
+
+	return callBatchWeight(c.dbWeight, sc.U64(len(calls))).
+		SaturatingAdd(weight)
+}
+
+func (_ callBatch) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callBatch) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	_, class := weightAndDispatchClass(c.Args()[0].(sc.Sequence[primitives.Call]))
+	return class
+}
+
+func (_ callBatch) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callBatch) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if err := ensureNotNone(origin); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	calls := args[0].(sc.Sequence[primitives.Call])
+	if err := c.module.ensureCallsLimit(calls); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	weight := primitives.WeightZero()
+	for i, call := range calls {
+		postInfo, err := c.module.dispatch(origin, call)
+		weight = weight.SaturatingAdd(actualWeight(call, postInfo))
+		if err != nil {
+			c.module.eventDepositor.DepositEvent(newEventBatchInterrupted(c.ModuleId, sc.U32(i), toDispatchError(err)))
+
+			// Take the weight of this function itself into account.
+			baseWeight := callBatchWeight(c.dbWeight, sc.U64(i+1))
+			return primitives.PostDispatchInfo{
+				ActualWeight: sc.NewOption[primitives.Weight](baseWeight.SaturatingAdd(weight)),
+				PaysFee:      primitives.PaysYes,
+			}, nil
+		}
+		c.module.eventDepositor.DepositEvent(newEventItemCompleted(c.ModuleId))
+	}
+	c.module.eventDepositor.DepositEvent(newEventBatchCompleted(c.ModuleId))
+
+	baseWeight := callBatchWeight(c.dbWeight, sc.U64(len(calls)))
+	return primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](baseWeight.SaturatingAdd(weight)),
+		PaysFee:      primitives.PaysYes,
+	}, nil
+}
+
+func (_ callBatch) Docs() string {
+	return "Send a batch of dispatch calls. " +
+		"May be called from any origin except `None`. " +
+		"If origin is root then the calls are dispatched without checking origin filter. " +
+		"This will return `Ok` in all circumstances. To determine the success of the batch, an " +
+		"event is deposited. If a call failed and the batch was interrupted, then the " +
+		"`BatchInterrupted` event is deposited, along with the number of successful calls made " +
+		"and the error of the failed call. If all were successful, then the `BatchCompleted` " +
+		"event is deposited."
+}
@@ -0,0 +1,127 @@
+package utility
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callBatchAll dispatches a batch of calls atomically. All changes are reverted if any of the calls fails.
+type callBatchAll struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallBatchAll(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callBatchAll{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.Call]{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callBatchAll) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	calls, err := c.module.decodeCalls(buffer, decodeCallFunc)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(calls)
+	return c, nil
+}
+
+func (c callBatchAll) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	c.module.logger.Critical("not implemented")
+	return nil, nil
+}
+
+func (c callBatchAll) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callBatchAll) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callBatchAll) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callBatchAll) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callBatchAll) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callBatchAll) BaseWeight() primitives.Weight {
+	calls := c.Args()[0].(sc.Sequence[primitives.Call])
+	weight, _ := weightAndDispatchClass(calls)
+
+	return callBatchAllWeight(c.dbWeight, sc.U64(len(calls))).
+		SaturatingAdd(weight)
+}
+
+func (_ callBatchAll) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callBatchAll) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	_, class := weightAndDispatchClass(c.Args()[0].(sc.Sequence[primitives.Call]))
+	return class
+}
+
+func (_ callBatchAll) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callBatchAll) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if err := ensureNotNone(origin); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	calls := args[0].(sc.Sequence[primitives.Call])
+	if err := c.module.ensureCallsLimit(calls); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	// The post dispatch info of a rolled back layer is discarded, so the weight consumed until the failure is
+	// kept outside of it.
+	failedWeight := sc.NewOption[primitives.Weight](nil)
+	postInfo, err := c.module.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		weight := primitives.WeightZero()
+		for i, call := range calls {
+			postInfo, err := c.module.dispatch(origin, call)
+			weight = weight.SaturatingAdd(actualWeight(call, postInfo))
+			if err != nil {
+				// Take the weight of this function itself into account.
+				baseWeight := callBatchAllWeight(c.dbWeight, sc.U64(i+1))
+				failedWeight = sc.NewOption[primitives.Weight](baseWeight.SaturatingAdd(weight))
+				return primitives.PostDispatchInfo{}, toDispatchError(err)
+			}
+			c.module.eventDepositor.DepositEvent(newEventItemCompleted(c.ModuleId))
+		}
+		c.module.eventDepositor.DepositEvent(newEventBatchCompleted(c.ModuleId))
+
+		baseWeight := callBatchAllWeight(c.dbWeight, sc.U64(len(calls)))
+		return primitives.PostDispatchInfo{
+			ActualWeight: sc.NewOption[primitives.Weight](baseWeight.SaturatingAdd(weight)),
+			PaysFee:      primitives.PaysYes,
+		}, nil
+	})
+	if err != nil {
+		return primitives.PostDispatchInfo{
+			ActualWeight: failedWeight,
+			PaysFee:      primitives.PaysYes,
+		}, err
+	}
+
+	return postInfo, nil
+}
+
+func (_ callBatchAll) Docs() string {
+	return "Send a batch of dispatch calls and atomically execute them. " +
+		"The whole transaction will rollback and fail if any of the calls failed. " +
+		"May be called from any origin except `None`. " +
+		"If origin is root then the calls are dispatched without checking origin filter."
+}
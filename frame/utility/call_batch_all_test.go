@@ -0,0 +1,104 @@
+package utility
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_BatchAll_DecodeNestedArgs(t *testing.T) {
+	target := setupCallBatchAll()
+	buffer := bytes.NewBuffer(sc.ToCompact(1).Bytes())
+
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall}), result.Args())
+}
+
+func Test_Call_BatchAll_BaseWeight(t *testing.T) {
+	target := setupCallBatchAll()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCallOther})
+	expectCallInfo(mockCall, primitives.NewDispatchClassNormal(), primitives.PaysYes)
+	expectCallInfo(mockCallOther, primitives.NewDispatchClassNormal(), primitives.PaysYes)
+
+	assert.Equal(t, callBatchAllWeight(dbWeight, 2).Add(callWeight).Add(callWeight), target.BaseWeight())
+}
+
+func Test_Call_BatchAll_ClassifyDispatch(t *testing.T) {
+	target := setupCallBatchAll()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCallOther})
+	expectCallInfo(mockCall, primitives.NewDispatchClassOperational(), primitives.PaysYes)
+	expectCallInfo(mockCallOther, primitives.NewDispatchClassNormal(), primitives.PaysYes)
+
+	assert.Equal(t, primitives.NewDispatchClassNormal(), target.ClassifyDispatch(callWeight))
+}
+
+func Test_Call_BatchAll_Dispatch(t *testing.T) {
+	target := setupCallBatchAll()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCallOther})
+	expectCallDispatch(mockCall, signedOrigin, nil)
+	expectCallDispatch(mockCallOther, signedOrigin, nil)
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.PostDispatchInfo{
+			ActualWeight: sc.NewOption[primitives.Weight](callBatchAllWeight(dbWeight, 2).Add(primitives.WeightFromParts(80, 0))),
+			PaysFee:      primitives.PaysYes,
+		},
+		result)
+	// One layer for the whole batch and one for each call.
+	assert.Equal(t, 3, mockTransactional.count)
+	mockEventDepositor.AssertNumberOfCalls(t, "DepositEvent", 3)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventBatchCompleted(moduleId))
+}
+
+func Test_Call_BatchAll_Dispatch_Fails(t *testing.T) {
+	target := setupCallBatchAll()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCallOther})
+	expectCallDispatch(mockCall, signedOrigin, nil)
+	expectCallDispatch(mockCallOther, signedOrigin, dispatchErrOther)
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, dispatchErrOther, err)
+	assert.Equal(t,
+		primitives.PostDispatchInfo{
+			ActualWeight: sc.NewOption[primitives.Weight](callBatchAllWeight(dbWeight, 2).Add(primitives.WeightFromParts(40, 0)).Add(callWeight)),
+			PaysFee:      primitives.PaysYes,
+		},
+		result)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", newEventBatchCompleted(moduleId))
+}
+
+func Test_Call_BatchAll_Dispatch_NoneOrigin(t *testing.T) {
+	target := setupCallBatchAll()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall})
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	assert.Equal(t, 0, mockTransactional.count)
+}
+
+func Test_Call_BatchAll_Dispatch_TooManyCalls(t *testing.T) {
+	target := setupCallBatchAll()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCall, mockCall, mockCall})
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorTooManyCalls(moduleId), err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func setupCallBatchAll() callBatchAll {
+	return newCallBatchAll(moduleId, functionBatchAll, dbWeight, setupModule()).(callBatchAll)
+}
@@ -0,0 +1,157 @@
+package utility
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_Batch_New(t *testing.T) {
+	target := setupCallBatch()
+	expected := callBatch{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionBatch,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.Call]{}),
+		},
+		dbWeight: dbWeight,
+		module:   target.module,
+	}
+
+	assert.Equal(t, expected, target)
+}
+
+func Test_Call_Batch_DecodeNestedArgs(t *testing.T) {
+	target := setupCallBatch()
+	buffer := bytes.NewBuffer(sc.ToCompact(2).Bytes())
+
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCall}), result.Args())
+}
+
+func Test_Call_Batch_DecodeNestedArgs_Fails(t *testing.T) {
+	target := setupCallBatch()
+	buffer := bytes.NewBuffer(sc.ToCompact(1).Bytes())
+	expectErr := errors.New("decode call")
+
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return nil, expectErr }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.Equal(t, expectErr, err)
+	assert.Nil(t, result)
+}
+
+func Test_Call_Batch_DecodeNestedArgs_TooManyCalls(t *testing.T) {
+	target := setupCallBatch()
+	buffer := bytes.NewBuffer(sc.ToCompact(sc.U32(1_000_000)).Bytes())
+
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.Equal(t, NewDispatchErrorTooManyCalls(moduleId), err)
+	assert.Nil(t, result)
+}
+
+func Test_Call_Batch_BaseWeight(t *testing.T) {
+	target := setupCallBatch()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCallOther})
+	expectCallInfo(mockCall, primitives.NewDispatchClassNormal(), primitives.PaysYes)
+	expectCallInfo(mockCallOther, primitives.NewDispatchClassNormal(), primitives.PaysNo)
+
+	assert.Equal(t, callBatchWeight(dbWeight, 2).Add(callWeight), target.BaseWeight())
+}
+
+func Test_Call_Batch_ClassifyDispatch(t *testing.T) {
+	target := setupCallBatch()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall})
+	expectCallInfo(mockCall, primitives.NewDispatchClassOperational(), primitives.PaysYes)
+
+	assert.Equal(t, primitives.NewDispatchClassOperational(), target.ClassifyDispatch(callWeight))
+}
+
+func Test_Call_Batch_PaysFee(t *testing.T) {
+	target := setupCallBatch()
+
+	assert.Equal(t, primitives.PaysYes, target.PaysFee(callWeight))
+}
+
+func Test_Call_Batch_Dispatch(t *testing.T) {
+	target := setupCallBatch()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCallOther})
+	expectCallDispatch(mockCall, signedOrigin, nil)
+	expectCallDispatch(mockCallOther, signedOrigin, nil)
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.PostDispatchInfo{
+			ActualWeight: sc.NewOption[primitives.Weight](callBatchWeight(dbWeight, 2).Add(primitives.WeightFromParts(80, 0))),
+			PaysFee:      primitives.PaysYes,
+		},
+		result)
+	assert.Equal(t, 2, mockTransactional.count)
+	mockEventDepositor.AssertNumberOfCalls(t, "DepositEvent", 3)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventItemCompleted(moduleId))
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventBatchCompleted(moduleId))
+}
+
+func Test_Call_Batch_Dispatch_Interrupted(t *testing.T) {
+	target := setupCallBatch()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCallOther})
+	expectCallDispatch(mockCall, signedOrigin, dispatchErrOther)
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.PostDispatchInfo{
+			ActualWeight: sc.NewOption[primitives.Weight](callBatchWeight(dbWeight, 1).Add(callWeight)),
+			PaysFee:      primitives.PaysYes,
+		},
+		result)
+	mockCallOther.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+	mockEventDepositor.AssertNumberOfCalls(t, "DepositEvent", 1)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventBatchInterrupted(moduleId, 0, dispatchErrOther))
+}
+
+func Test_Call_Batch_Dispatch_NoneOrigin(t *testing.T) {
+	target := setupCallBatch()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall})
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func Test_Call_Batch_Dispatch_TooManyCalls(t *testing.T) {
+	target := setupCallBatch()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCall, mockCall, mockCall})
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, NewDispatchErrorTooManyCalls(moduleId), err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+}
+
+func Test_Call_Batch_Docs(t *testing.T) {
+	target := setupCallBatch()
+
+	assert.Contains(t, target.Docs(), "Send a batch of dispatch calls.")
+}
+
+func setupCallBatch() callBatch {
+	return newCallBatch(moduleId, functionBatch, dbWeight, setupModule()).(callBatch)
+}
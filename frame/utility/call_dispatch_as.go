@@ -0,0 +1,112 @@
+package utility
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callDispatchAs dispatches a call with the provided origin.
+type callDispatchAs struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallDispatchAs(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callDispatchAs{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.OriginCaller{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callDispatchAs) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	asOrigin, err := primitives.DecodeOriginCaller(buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	call, err := decodeCallFunc(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(asOrigin, call)
+
+	return c, nil
+}
+
+func (c callDispatchAs) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	c.module.logger.Critical("not implemented")
+	return nil, nil
+}
+
+func (c callDispatchAs) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callDispatchAs) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callDispatchAs) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callDispatchAs) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callDispatchAs) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callDispatchAs) BaseWeight() primitives.Weight {
+	call := c.Args()[1].(primitives.Call)
+
+	return callDispatchAsWeight(c.dbWeight).
+		SaturatingAdd(call.WeighData(call.BaseWeight()))
+}
+
+func (_ callDispatchAs) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callDispatchAs) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
+	call := c.Args()[1].(primitives.Call)
+
+	return call.ClassifyDispatch(baseWeight)
+}
+
+func (_ callDispatchAs) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callDispatchAs) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if err := system.EnsureRoot(origin); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	asOrigin := args[0].(primitives.OriginCaller)
+	call := args[1].(primitives.Call)
+
+	var outcome primitives.DispatchOutcome
+	_, err := c.module.dispatch(asOrigin.RawOrigin, call)
+	if err != nil {
+		outcome, err = primitives.NewDispatchOutcome(toDispatchError(err))
+	} else {
+		outcome, err = primitives.NewDispatchOutcome(sc.Empty{})
+	}
+	if err != nil {
+		return primitives.PostDispatchInfo{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	c.module.eventDepositor.DepositEvent(newEventDispatchedAs(c.ModuleId, outcome))
+
+	return primitives.PostDispatchInfo{}, nil
+}
+
+func (_ callDispatchAs) Docs() string {
+	return "Dispatches a function call with a provided origin. " +
+		"The dispatch origin for this call must be `Root`."
+}
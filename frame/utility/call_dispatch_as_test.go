@@ -0,0 +1,89 @@
+package utility
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	asOrigin = primitives.NewOriginCallerSystem(primitives.NewRawOriginSigned(constants.TwoAccountId))
+)
+
+func Test_Call_DispatchAs_DecodeNestedArgs(t *testing.T) {
+	target := setupCallDispatchAs()
+	buffer := bytes.NewBuffer(asOrigin.Bytes())
+
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(asOrigin, mockCall), result.Args())
+}
+
+func Test_Call_DispatchAs_DecodeNestedArgs_InvalidOrigin(t *testing.T) {
+	target := setupCallDispatchAs()
+	buffer := bytes.NewBuffer([]byte{0x00})
+
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func Test_Call_DispatchAs_BaseWeight(t *testing.T) {
+	target := setupCallDispatchAs()
+	target.Arguments = sc.NewVaryingData(asOrigin, mockCall)
+	expectCallInfo(mockCall, primitives.NewDispatchClassNormal(), primitives.PaysYes)
+
+	assert.Equal(t, callDispatchAsWeight(dbWeight).Add(callWeight), target.BaseWeight())
+}
+
+func Test_Call_DispatchAs_Dispatch(t *testing.T) {
+	target := setupCallDispatchAs()
+	target.Arguments = sc.NewVaryingData(asOrigin, mockCall)
+	expectCallDispatch(mockCall, asOrigin.RawOrigin, nil)
+	outcome, _ := primitives.NewDispatchOutcome(sc.Empty{})
+
+	result, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCall.AssertCalled(t, "Dispatch", asOrigin.RawOrigin, callArgs)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventDispatchedAs(moduleId, outcome))
+}
+
+func Test_Call_DispatchAs_Dispatch_CallFails(t *testing.T) {
+	target := setupCallDispatchAs()
+	target.Arguments = sc.NewVaryingData(asOrigin, mockCall)
+	expectCallDispatch(mockCall, asOrigin.RawOrigin, dispatchErrOther)
+	outcome, _ := primitives.NewDispatchOutcome(dispatchErrOther)
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.NoError(t, err)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventDispatchedAs(moduleId, outcome))
+}
+
+func Test_Call_DispatchAs_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallDispatchAs()
+	target.Arguments = sc.NewVaryingData(asOrigin, mockCall)
+
+	_, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockCall.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", mock.Anything)
+}
+
+func setupCallDispatchAs() callDispatchAs {
+	return newCallDispatchAs(moduleId, functionDispatchAs, dbWeight, setupModule()).(callDispatchAs)
+}
@@ -0,0 +1,11 @@
+package utility
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callDispatchAsWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(6991000, 0).
+		SaturatingAdd(dbWeight.Reads(0)).
+		SaturatingAdd(dbWeight.Writes(0))
+}
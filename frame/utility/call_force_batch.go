@@ -0,0 +1,119 @@
+package utility
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callForceBatch dispatches a batch of calls, continuing with the rest of them when a call fails.
+type callForceBatch struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallForceBatch(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callForceBatch{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.Sequence[primitives.Call]{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callForceBatch) DecodeNestedArgs(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (primitives.Call, error) {
+	calls, err := c.module.decodeCalls(buffer, decodeCallFunc)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(calls)
+	return c, nil
+}
+
+func (c callForceBatch) DecodeArgs(_ *bytes.Buffer) (primitives.Call, error) {
+	c.module.logger.Critical("not implemented")
+	return nil, nil
+}
+
+func (c callForceBatch) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callForceBatch) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callForceBatch) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callForceBatch) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callForceBatch) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callForceBatch) BaseWeight() primitives.Weight {
+	calls := c.Args()[0].(sc.Sequence[primitives.Call])
+	weight, _ := weightAndDispatchClass(calls)
+
+	return callForceBatchWeight(c.dbWeight, sc.U64(len(calls))).
+		SaturatingAdd(weight)
+}
+
+func (_ callForceBatch) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (c callForceBatch) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	_, class := weightAndDispatchClass(c.Args()[0].(sc.Sequence[primitives.Call]))
+	return class
+}
+
+func (_ callForceBatch) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callForceBatch) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if err := ensureNotNone(origin); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	calls := args[0].(sc.Sequence[primitives.Call])
+	if err := c.module.ensureCallsLimit(calls); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	weight := primitives.WeightZero()
+	hasError := false
+	for _, call := range calls {
+		postInfo, err := c.module.dispatch(origin, call)
+		weight = weight.SaturatingAdd(actualWeight(call, postInfo))
+		if err != nil {
+			hasError = true
+			c.module.eventDepositor.DepositEvent(newEventItemFailed(c.ModuleId, toDispatchError(err)))
+		} else {
+			c.module.eventDepositor.DepositEvent(newEventItemCompleted(c.ModuleId))
+		}
+	}
+
+	if hasError {
+		c.module.eventDepositor.DepositEvent(newEventBatchCompletedWithErrors(c.ModuleId))
+	} else {
+		c.module.eventDepositor.DepositEvent(newEventBatchCompleted(c.ModuleId))
+	}
+
+	baseWeight := callForceBatchWeight(c.dbWeight, sc.U64(len(calls)))
+	return primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](baseWeight.SaturatingAdd(weight)),
+		PaysFee:      primitives.PaysYes,
+	}, nil
+}
+
+func (_ callForceBatch) Docs() string {
+	return "Send a batch of dispatch calls. " +
+		"Unlike `batch`, it allows errors and won't interrupt. " +
+		"May be called from any origin except `None`. " +
+		"If origin is root then the calls are dispatch without checking origin filter."
+}
@@ -0,0 +1,92 @@
+package utility
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Call_ForceBatch_DecodeNestedArgs(t *testing.T) {
+	target := setupCallForceBatch()
+	buffer := bytes.NewBuffer(sc.ToCompact(1).Bytes())
+
+	decodeCallFunc := func(_ *bytes.Buffer) (primitives.Call, error) { return mockCall, nil }
+
+	result, err := target.DecodeNestedArgs(buffer, decodeCallFunc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall}), result.Args())
+}
+
+func Test_Call_ForceBatch_BaseWeight(t *testing.T) {
+	target := setupCallForceBatch()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall})
+	expectCallInfo(mockCall, primitives.NewDispatchClassNormal(), primitives.PaysYes)
+
+	assert.Equal(t, callForceBatchWeight(dbWeight, 1).Add(callWeight), target.BaseWeight())
+}
+
+func Test_Call_ForceBatch_Dispatch(t *testing.T) {
+	target := setupCallForceBatch()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCallOther})
+	expectCallDispatch(mockCall, signedOrigin, nil)
+	expectCallDispatch(mockCallOther, signedOrigin, nil)
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.PostDispatchInfo{
+			ActualWeight: sc.NewOption[primitives.Weight](callForceBatchWeight(dbWeight, 2).Add(primitives.WeightFromParts(80, 0))),
+			PaysFee:      primitives.PaysYes,
+		},
+		result)
+	mockEventDepositor.AssertNumberOfCalls(t, "DepositEvent", 3)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventBatchCompleted(moduleId))
+}
+
+func Test_Call_ForceBatch_Dispatch_WithErrors(t *testing.T) {
+	target := setupCallForceBatch()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCallOther})
+	expectCallDispatch(mockCall, signedOrigin, dispatchErrOther)
+	expectCallDispatch(mockCallOther, signedOrigin, nil)
+
+	result, err := target.Dispatch(signedOrigin, target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.PostDispatchInfo{
+			ActualWeight: sc.NewOption[primitives.Weight](callForceBatchWeight(dbWeight, 2).Add(primitives.WeightFromParts(140, 0))),
+			PaysFee:      primitives.PaysYes,
+		},
+		result)
+	mockCallOther.AssertCalled(t, "Dispatch", signedOrigin, callArgs)
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventItemFailed(moduleId, dispatchErrOther))
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventItemCompleted(moduleId))
+	mockEventDepositor.AssertCalled(t, "DepositEvent", newEventBatchCompletedWithErrors(moduleId))
+	mockEventDepositor.AssertNotCalled(t, "DepositEvent", newEventBatchCompleted(moduleId))
+}
+
+func Test_Call_ForceBatch_Dispatch_NoneOrigin(t *testing.T) {
+	target := setupCallForceBatch()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func Test_Call_ForceBatch_Dispatch_TooManyCalls(t *testing.T) {
+	target := setupCallForceBatch()
+	target.Arguments = sc.NewVaryingData(sc.Sequence[primitives.Call]{mockCall, mockCall, mockCall, mockCall})
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), target.Args())
+
+	assert.Equal(t, NewDispatchErrorTooManyCalls(moduleId), err)
+}
+
+func setupCallForceBatch() callForceBatch {
+	return newCallForceBatch(moduleId, functionForceBatch, dbWeight, setupModule()).(callForceBatch)
+}
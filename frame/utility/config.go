@@ -0,0 +1,25 @@
+package utility
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	Storage           io.Storage
+	TransactionBroker io.TransactionBroker
+	DbWeight          primitives.RuntimeDbWeight
+	BatchedCallsLimit sc.U32
+	EventDepositor    primitives.EventDepositor
+}
+
+func NewConfig(storage io.Storage, transactionBroker io.TransactionBroker, dbWeight primitives.RuntimeDbWeight, batchedCallsLimit sc.U32, eventDepositor primitives.EventDepositor) Config {
+	return Config{
+		storage,
+		transactionBroker,
+		dbWeight,
+		batchedCallsLimit,
+		eventDepositor,
+	}
+}
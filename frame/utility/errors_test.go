@@ -0,0 +1,19 @@
+package utility
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewDispatchErrorTooManyCalls(t *testing.T) {
+	expect := primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(ErrorTooManyCalls),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+
+	assert.Equal(t, expect, NewDispatchErrorTooManyCalls(moduleId))
+}
@@ -0,0 +1,80 @@
+package utility
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DecodeEvent_BatchInterrupted(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventBatchInterrupted.Bytes())
+	buffer.Write(sc.U32(2).Bytes())
+	buffer.Write(dispatchErrOther.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, newEventBatchInterrupted(moduleId, 2, dispatchErrOther), result)
+}
+
+func Test_DecodeEvent_WithoutFields(t *testing.T) {
+	for _, event := range []primitives.Event{
+		newEventBatchCompleted(moduleId),
+		newEventBatchCompletedWithErrors(moduleId),
+		newEventItemCompleted(moduleId),
+	} {
+		result, err := DecodeEvent(moduleId, bytes.NewBuffer(event.Bytes()))
+		assert.Nil(t, err)
+
+		assert.Equal(t, event, result)
+	}
+}
+
+func Test_DecodeEvent_ItemFailed(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventItemFailed.Bytes())
+	buffer.Write(dispatchErrOther.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, newEventItemFailed(moduleId, dispatchErrOther), result)
+}
+
+func Test_DecodeEvent_DispatchedAs(t *testing.T) {
+	outcome, _ := primitives.NewDispatchOutcome(sc.Empty{})
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.Write(EventDispatchedAs.Bytes())
+	buffer.Write(outcome.Bytes())
+
+	result, err := DecodeEvent(moduleId, buffer)
+	assert.Nil(t, err)
+
+	assert.Equal(t, newEventDispatchedAs(moduleId, outcome), result)
+}
+
+func Test_DecodeEvent_InvalidModule(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId + 1)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventModule, err)
+}
+
+func Test_DecodeEvent_InvalidType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(moduleId)
+	buffer.WriteByte(255)
+
+	_, err := DecodeEvent(moduleId, buffer)
+
+	assert.Equal(t, errInvalidEventType, err)
+}
@@ -0,0 +1,384 @@
+package utility
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	functionBatch = iota
+	functionAsDerivative
+	functionBatchAll
+	functionDispatchAs
+	functionForceBatch
+)
+
+const (
+	name = sc.Str("Utility")
+)
+
+var (
+	// derivativeAccountPrefix is the prefix of the entropy from which derivative accounts are generated.
+	derivativeAccountPrefix = []byte("modlpy/utilisuba")
+)
+
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	index             sc.U8
+	batchedCallsLimit sc.U32
+	functions         map[sc.U8]primitives.Call
+	transactional     support.Transactional[primitives.PostDispatchInfo]
+	hashing           io.Hashing
+	eventDepositor    primitives.EventDepositor
+	mdGenerator       *primitives.MetadataTypeGenerator
+	logger            log.RuntimeLogger
+}
+
+func New(index sc.U8, config Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.RuntimeLogger) Module {
+	functions := make(map[sc.U8]primitives.Call)
+
+	module := Module{
+		index:             index,
+		batchedCallsLimit: config.BatchedCallsLimit,
+		transactional:     support.NewTransactional[primitives.PostDispatchInfo](config.Storage, config.TransactionBroker, logger),
+		hashing:           io.NewHashing(),
+		eventDepositor:    config.EventDepositor,
+		mdGenerator:       mdGenerator,
+		logger:            logger,
+	}
+
+	functions[functionBatch] = newCallBatch(index, functionBatch, config.DbWeight, module)
+	functions[functionAsDerivative] = newCallAsDerivative(index, functionAsDerivative, config.DbWeight, module)
+	functions[functionBatchAll] = newCallBatchAll(index, functionBatchAll, config.DbWeight, module)
+	functions[functionDispatchAs] = newCallDispatchAs(index, functionDispatchAs, config.DbWeight, module)
+	functions[functionForceBatch] = newCallForceBatch(index, functionForceBatch, config.DbWeight, module)
+
+	module.functions = functions
+
+	return module
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) GetIndex() sc.U8 { return m.index }
+
+func (m Module) Functions() map[sc.U8]primitives.Call { return m.functions }
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) { return sc.Empty{}, nil }
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// BatchedCallsLimit returns the maximum number of calls that can be batched.
+func (m Module) BatchedCallsLimit() sc.U32 {
+	return m.batchedCallsLimit
+}
+
+// DerivativeAccountId derives a sub-account id from the account `who` and the given `index`.
+func (m Module) DerivativeAccountId(who primitives.AccountId, index sc.U16) (primitives.AccountId, error) {
+	entropy := append([]byte{}, derivativeAccountPrefix...)
+	entropy = append(entropy, who.Bytes()...)
+	entropy = append(entropy, index.Bytes()...)
+
+	hash := m.hashing.Blake256(entropy)
+
+	return primitives.NewAccountId(sc.BytesToSequenceU8(hash)...)
+}
+
+// dispatch dispatches the call in a new storage layer, so that the changes of a failed call are discarded.
+func (m Module) dispatch(origin primitives.RuntimeOrigin, call primitives.Call) (primitives.PostDispatchInfo, error) {
+	return m.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		postInfo, err := call.Dispatch(origin, call.Args())
+		if err != nil {
+			return primitives.PostDispatchInfo{}, toDispatchError(err)
+		}
+		return postInfo, nil
+	})
+}
+
+func (m Module) ensureCallsLimit(calls sc.Sequence[primitives.Call]) error {
+	if sc.U32(len(calls)) > m.batchedCallsLimit {
+		return NewDispatchErrorTooManyCalls(m.index)
+	}
+	return nil
+}
+
+// ensureNotNone returns an error for the `None` origin, which is not allowed to dispatch batches.
+func ensureNotNone(origin primitives.RuntimeOrigin) error {
+	if origin.IsNoneOrigin() {
+		return primitives.NewDispatchErrorBadOrigin()
+	}
+	return nil
+}
+
+func toDispatchError(err error) primitives.DispatchError {
+	dispatchErr, ok := err.(primitives.DispatchError)
+	if !ok {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	return dispatchErr
+}
+
+// actualWeight returns the weight consumed by a dispatched call.
+func actualWeight(call primitives.Call, postInfo primitives.PostDispatchInfo) primitives.Weight {
+	info := primitives.GetDispatchInfo(call)
+	return postInfo.CalcActualWeight(&info)
+}
+
+// weightAndDispatchClass returns the total weight of the calls which pay fees, along with
+// the dispatch class of the batch, which is `Operational` only if no call is `Normal`.
+func weightAndDispatchClass(calls sc.Sequence[primitives.Call]) (primitives.Weight, primitives.DispatchClass) {
+	weight := primitives.WeightZero()
+	class := primitives.NewDispatchClassOperational()
+
+	for _, call := range calls {
+		info := primitives.GetDispatchInfo(call)
+		if info.PaysFee == primitives.PaysYes {
+			weight = weight.SaturatingAdd(info.Weight)
+		}
+		if isNormal, _ := info.Class.Is(primitives.DispatchClassNormal); isNormal {
+			class = info.Class
+		}
+	}
+
+	return weight, class
+}
+
+// decodeCalls decodes a sequence of calls. The length is checked against the batched calls limit before decoding,
+// and the calls are appended one at a time, so that an untrusted length cannot force a large allocation.
+func (m Module) decodeCalls(buffer *bytes.Buffer, decodeCallFunc func(buffer *bytes.Buffer) (primitives.Call, error)) (sc.Sequence[primitives.Call], error) {
+	compact, err := sc.DecodeCompact[sc.U32](buffer)
+	if err != nil {
+		return nil, err
+	}
+	length := sc.U32(compact.ToBigInt().Uint64())
+	if length > m.batchedCallsLimit {
+		return nil, NewDispatchErrorTooManyCalls(m.index)
+	}
+
+	calls := sc.Sequence[primitives.Call]{}
+	for i := sc.U32(0); i < length; i++ {
+		call, err := decodeCallFunc(buffer)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, call)
+	}
+
+	return calls, nil
+}
+
+func (m Module) Metadata() primitives.MetadataModule {
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](nil),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesUtilityCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesUtilityCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Utility, Runtime>"),
+				},
+				m.index,
+				"Call.Utility")),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesUtilityEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesUtilityEvent, "pallet_utility::Event"),
+				},
+				m.index,
+				"Events.Utility"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"BatchedCallsLimit",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.batchedCallsLimit.Bytes()),
+				"The limit on the number of batched calls.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesUtilityErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesUtilityErrors),
+				},
+				m.index,
+				"Errors.Utility"),
+		),
+		Index: m.index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataType(metadata.TypesSequenceRuntimeCall,
+			"[]RuntimeCall",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.RuntimeCall))),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesRawOrigin,
+			"RawOrigin",
+			sc.Sequence[sc.Str]{"frame_support", "dispatch", "RawOrigin"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Root",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						primitives.RawOriginRoot,
+						"RawOrigin.Root"),
+					primitives.NewMetadataDefinitionVariant(
+						"Signed",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesAddress32, "AccountId"),
+						},
+						primitives.RawOriginSigned,
+						"RawOrigin.Signed"),
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						primitives.RawOriginNone,
+						"RawOrigin.None"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "AccountId")),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesOriginCaller,
+			"OriginCaller",
+			sc.Sequence[sc.Str]{"node_template_runtime", "OriginCaller"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"system",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesRawOrigin, "frame_system::Origin<Runtime>"),
+						},
+						primitives.OriginCallerSystem,
+						"OriginCaller.system"),
+				})),
+
+		primitives.NewMetadataTypeWithPath(
+			metadata.TypesUtilityEvent,
+			"pallet_utility pallet Event",
+			sc.Sequence[sc.Str]{"pallet_utility", "pallet", "Event"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"BatchInterrupted",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "u32"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchError, "error", "DispatchError"),
+						},
+						EventBatchInterrupted,
+						"Batch of dispatches did not complete fully. Index of first failing dispatch given, as well as the error."),
+					primitives.NewMetadataDefinitionVariant(
+						"BatchCompleted",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						EventBatchCompleted,
+						"Batch of dispatches completed fully with no error."),
+					primitives.NewMetadataDefinitionVariant(
+						"BatchCompletedWithErrors",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						EventBatchCompletedWithErrors,
+						"Batch of dispatches completed but has errors."),
+					primitives.NewMetadataDefinitionVariant(
+						"ItemCompleted",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						EventItemCompleted,
+						"A single item within a Batch of dispatches has completed with no error."),
+					primitives.NewMetadataDefinitionVariant(
+						"ItemFailed",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchError, "error", "DispatchError"),
+						},
+						EventItemFailed,
+						"A single item within a Batch of dispatches has completed with error."),
+					primitives.NewMetadataDefinitionVariant(
+						"DispatchedAs",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchOutcome, "result", "DispatchResult"),
+						},
+						EventDispatchedAs,
+						"A call was dispatched."),
+				})),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesUtilityErrors,
+			"pallet_utility pallet Error",
+			sc.Sequence[sc.Str]{"pallet_utility", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"TooManyCalls",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManyCalls,
+						"Too many calls batched."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesUtilityCalls,
+			"Utility calls",
+			sc.Sequence[sc.Str]{"pallet_utility", "pallet", "Call"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"batch",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceRuntimeCall, "calls", "Vec<<T as Config>::RuntimeCall>"),
+						},
+						functionBatch,
+						"Send a batch of dispatch calls. Dispatching stops at the first failing call."),
+					primitives.NewMetadataDefinitionVariant(
+						"as_derivative",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU16, "index", "u16"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+						},
+						functionAsDerivative,
+						"Send a call through an indexed pseudonym of the sender."),
+					primitives.NewMetadataDefinitionVariant(
+						"batch_all",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceRuntimeCall, "calls", "Vec<<T as Config>::RuntimeCall>"),
+						},
+						functionBatchAll,
+						"Send a batch of dispatch calls and atomically execute them. The whole transaction will rollback and fail if any of the calls failed."),
+					primitives.NewMetadataDefinitionVariant(
+						"dispatch_as",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOriginCaller, "as_origin", "Box<T::PalletsOrigin>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+						},
+						functionDispatchAs,
+						"Dispatches a function call with a provided origin. The dispatch origin for this call must be `Root`."),
+					primitives.NewMetadataDefinitionVariant(
+						"force_batch",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceRuntimeCall, "calls", "Vec<<T as Config>::RuntimeCall>"),
+						},
+						functionForceBatch,
+						"Send a batch of dispatch calls. Unlike `batch`, it allows errors and won't interrupt."),
+				}),
+			primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+}
@@ -0,0 +1,219 @@
+package utility
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId          = 9
+	batchedCallsLimit = 3
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+
+	signedOrigin = primitives.NewRawOriginSigned(constants.OneAccountId)
+	callArgs     = sc.NewVaryingData(sc.U8(1))
+	callWeight   = primitives.WeightFromParts(100, 0)
+	postInfo     = primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](primitives.WeightFromParts(40, 0))}
+
+	mdGenerator                           = primitives.NewMetadataTypeGenerator()
+	unknownTransactionNoUnsignedValidator = primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+	dispatchErrOther                      = primitives.NewDispatchErrorOther("error")
+)
+
+var (
+	mockStorage           *mocks.IoStorage
+	mockTransactionBroker *mocks.IoTransactionBroker
+	mockEventDepositor    *mocks.EventDepositor
+	mockHashing           *mocks.IoHashing
+	mockTransactional     *transactionalLayers
+	mockCall              *mocks.Call
+	mockCallOther         *mocks.Call
+)
+
+// transactionalLayers runs the given function in place and counts the storage layers which were opened.
+type transactionalLayers struct {
+	count int
+}
+
+func (t *transactionalLayers) WithStorageLayer(fn func() (primitives.PostDispatchInfo, error)) (primitives.PostDispatchInfo, error) {
+	t.count++
+	return fn()
+}
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, 5, len(target.Functions()))
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.NewTransactionSourceLocal(), mockCall)
+
+	assert.Equal(t, unknownTransactionNoUnsignedValidator, err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_BatchedCallsLimit(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U32(batchedCallsLimit), target.BatchedCallsLimit())
+}
+
+func Test_Module_DerivativeAccountId(t *testing.T) {
+	target := setupModule()
+	hash := make([]byte, 32)
+	hash[0] = 7
+
+	expectEntropy := append([]byte("modlpy/utilisuba"), constants.OneAccountId.Bytes()...)
+	expectEntropy = append(expectEntropy, 0x02, 0x00)
+	mockHashing.On("Blake256", expectEntropy).Return(hash)
+
+	result, err := target.DerivativeAccountId(constants.OneAccountId, 2)
+
+	assert.NoError(t, err)
+	expect, _ := primitives.NewAccountId(sc.BytesToSequenceU8(hash)...)
+	assert.Equal(t, expect, result)
+	mockHashing.AssertCalled(t, "Blake256", expectEntropy)
+}
+
+func Test_Module_dispatch_ConvertsError(t *testing.T) {
+	target := setupModule()
+	mockCall.On("Args").Return(callArgs)
+	mockCall.On("Dispatch", signedOrigin, callArgs).Return(primitives.PostDispatchInfo{}, assert.AnError)
+
+	_, err := target.dispatch(signedOrigin, mockCall)
+
+	assert.Equal(t, primitives.NewDispatchErrorOther(sc.Str(assert.AnError.Error())), err)
+	assert.Equal(t, 1, mockTransactional.count)
+}
+
+func Test_weightAndDispatchClass(t *testing.T) {
+	setupModule()
+	expectCallInfo(mockCall, primitives.NewDispatchClassOperational(), primitives.PaysYes)
+	expectCallInfo(mockCallOther, primitives.NewDispatchClassNormal(), primitives.PaysNo)
+
+	weight, class := weightAndDispatchClass(sc.Sequence[primitives.Call]{mockCall, mockCallOther})
+
+	assert.Equal(t, callWeight, weight)
+	assert.Equal(t, primitives.NewDispatchClassNormal(), class)
+}
+
+func Test_weightAndDispatchClass_AllOperational(t *testing.T) {
+	setupModule()
+	expectCallInfo(mockCall, primitives.NewDispatchClassOperational(), primitives.PaysYes)
+	expectCallInfo(mockCallOther, primitives.NewDispatchClassMandatory(), primitives.PaysYes)
+
+	weight, class := weightAndDispatchClass(sc.Sequence[primitives.Call]{mockCall, mockCallOther})
+
+	assert.Equal(t, callWeight.Add(callWeight), weight)
+	assert.Equal(t, primitives.NewDispatchClassOperational(), class)
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	result := target.Metadata()
+
+	assert.Equal(t, primitives.ModuleVersion14, result.Version)
+	assert.Equal(t, sc.Str("Utility"), result.ModuleV14.Name)
+	assert.Equal(t, sc.NewOption[primitives.MetadataModuleStorage](nil), result.ModuleV14.Storage)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesUtilityCalls)), result.ModuleV14.Call)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesUtilityEvent)), result.ModuleV14.Event)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesUtilityErrors)), result.ModuleV14.Error)
+	assert.Equal(t,
+		sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"BatchedCallsLimit",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(batchedCallsLimit).Bytes()),
+				"The limit on the number of batched calls.",
+			),
+		},
+		result.ModuleV14.Constants)
+	assert.Equal(t, sc.U8(moduleId), result.ModuleV14.Index)
+}
+
+func Test_Module_metadataTypes(t *testing.T) {
+	target := setupModule()
+
+	types := target.metadataTypes()
+
+	ids := []sc.Compact{}
+	for _, mdType := range types {
+		ids = append(ids, mdType.Id)
+	}
+	assert.Equal(t,
+		[]sc.Compact{
+			sc.ToCompact(metadata.TypesSequenceRuntimeCall),
+			sc.ToCompact(metadata.TypesRawOrigin),
+			sc.ToCompact(metadata.TypesOriginCaller),
+			sc.ToCompact(metadata.TypesUtilityEvent),
+			sc.ToCompact(metadata.TypesUtilityErrors),
+			sc.ToCompact(metadata.TypesUtilityCalls),
+		},
+		ids)
+}
+
+func setupModule() Module {
+	mockStorage = new(mocks.IoStorage)
+	mockTransactionBroker = new(mocks.IoTransactionBroker)
+	mockEventDepositor = new(mocks.EventDepositor)
+	mockHashing = new(mocks.IoHashing)
+	mockTransactional = &transactionalLayers{}
+	mockCall = new(mocks.Call)
+	mockCallOther = new(mocks.Call)
+
+	config := NewConfig(mockStorage, mockTransactionBroker, dbWeight, batchedCallsLimit, mockEventDepositor)
+
+	target := New(moduleId, config, mdGenerator, log.NewLogger())
+	target.transactional = mockTransactional
+	target.hashing = mockHashing
+
+	mockEventDepositor.On("DepositEvent", mock.Anything)
+
+	return target
+}
+
+func expectCallInfo(call *mocks.Call, class primitives.DispatchClass, pays primitives.Pays) {
+	call.On("BaseWeight").Return(callWeight)
+	call.On("WeighData", callWeight).Return(callWeight)
+	call.On("ClassifyDispatch", callWeight).Return(class)
+	call.On("PaysFee", callWeight).Return(pays)
+}
+
+func expectCallDispatch(call *mocks.Call, origin primitives.RuntimeOrigin, err error) {
+	expectCallInfo(call, primitives.NewDispatchClassNormal(), primitives.PaysYes)
+	call.On("Args").Return(callArgs)
+	call.On("Dispatch", origin, callArgs).Return(postInfo, err)
+}
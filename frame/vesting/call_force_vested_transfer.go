@@ -0,0 +1,105 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callForceVestedTransfer forces a vested transfer between two accounts.
+type callForceVestedTransfer struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallForceVestedTransfer(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callForceVestedTransfer{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, primitives.MultiAddress{}, VestingInfo{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callForceVestedTransfer) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	source, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	target, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := DecodeVestingInfo(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(source, target, schedule)
+
+	return c, nil
+}
+
+func (c callForceVestedTransfer) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callForceVestedTransfer) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callForceVestedTransfer) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callForceVestedTransfer) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callForceVestedTransfer) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callForceVestedTransfer) BaseWeight() primitives.Weight {
+	return callForceVestedTransferWeight(c.dbWeight, sc.U64(c.module.maxVestingSchedules))
+}
+
+func (_ callForceVestedTransfer) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callForceVestedTransfer) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callForceVestedTransfer) PaysFee(_ primitives.Weight) primitives.Pays {
+	return primitives.PaysYes
+}
+
+func (c callForceVestedTransfer) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if err := system.EnsureRoot(origin); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	source, err := lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+	target, err := lookup(args[1].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doVestedTransfer(source, target, args[2].(VestingInfo))
+}
+
+func (_ callForceVestedTransfer) Docs() string {
+	return "Force a vested transfer. " +
+		"The dispatch origin for this call must be _Root_. " +
+		"`source`: The account whose funds should be transferred. " +
+		"`target`: The account that should be transferred the vested funds. " +
+		"`schedule`: The vesting schedule attached to the transfer. " +
+		"Emits `VestingUpdated`. " +
+		"NOTE: This will unlock all schedules through the current block."
+}
@@ -0,0 +1,63 @@
+package vesting
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	forceVestedTransferArgs = sc.NewVaryingData(whoAddress, receiverAddress, scheduleOngoing)
+)
+
+func Test_Call_ForceVestedTransfer_DecodeArgs(t *testing.T) {
+	target := setupCallForceVestedTransfer()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(whoAddress.Bytes())
+	buffer.Write(receiverAddress.Bytes())
+	buffer.Write(scheduleOngoing.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, forceVestedTransferArgs, result.Args())
+}
+
+func Test_Call_ForceVestedTransfer_BaseWeight(t *testing.T) {
+	target := setupCallForceVestedTransfer()
+
+	assert.Equal(t, callForceVestedTransferWeight(dbWeight, maxVestingSchedules), target.BaseWeight())
+}
+
+func Test_Call_ForceVestedTransfer_Dispatch(t *testing.T) {
+	target := setupCallForceVestedTransfer()
+	mockStorageVesting.On("Get", receiver).Return(sc.Sequence[VestingInfo]{}, nil)
+	mockCurrency.On("Transfer", who, receiver, sc.NewU128(1000), primitives.ExistenceRequirementAllowDeath).Return(nil)
+	mockStorageVesting.On("Put", receiver, mock.Anything).Return()
+	mockCurrency.On("SetLock", LockId, receiver, sc.NewU128(900), primitives.ReasonsMisc).Return(nil)
+
+	result, err := target.Dispatch(primitives.NewRawOriginRoot(), forceVestedTransferArgs)
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Transfer", who, receiver, sc.NewU128(1000), primitives.ExistenceRequirementAllowDeath)
+	mockStorageVesting.AssertCalled(t, "Put", receiver, sc.Sequence[VestingInfo]{scheduleOngoing})
+}
+
+func Test_Call_ForceVestedTransfer_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallForceVestedTransfer()
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), forceVestedTransferArgs)
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func setupCallForceVestedTransfer() callForceVestedTransfer {
+	return newCallForceVestedTransfer(moduleId, FunctionForceVestedTransfer, dbWeight, setupModule()).(callForceVestedTransfer)
+}
@@ -0,0 +1,13 @@
+package vesting
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callForceVestedTransferWeight(dbWeight primitives.RuntimeDbWeight, schedules sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(72331000, 0).
+		SaturatingAdd(primitives.WeightFromParts(89941, 0).SaturatingMul(schedules)).
+		SaturatingAdd(dbWeight.Reads(4)).
+		SaturatingAdd(dbWeight.Writes(4))
+}
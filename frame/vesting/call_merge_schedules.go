@@ -0,0 +1,100 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callMergeSchedules merges two vesting schedules of the sender into one.
+type callMergeSchedules struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallMergeSchedules(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callMergeSchedules{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(sc.U32(0), sc.U32(0)),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callMergeSchedules) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	schedule1Index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	schedule2Index, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(schedule1Index, schedule2Index)
+
+	return c, nil
+}
+
+func (c callMergeSchedules) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callMergeSchedules) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callMergeSchedules) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callMergeSchedules) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callMergeSchedules) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callMergeSchedules) BaseWeight() primitives.Weight {
+	return callMergeSchedulesWeight(c.dbWeight, sc.U64(c.module.maxVestingSchedules))
+}
+
+func (_ callMergeSchedules) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callMergeSchedules) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callMergeSchedules) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callMergeSchedules) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doMergeSchedules(who.Value, args[0].(sc.U32), args[1].(sc.U32))
+}
+
+func (_ callMergeSchedules) Docs() string {
+	return "Merge two vesting schedules together, creating a new vesting schedule that unlocks over " +
+		"the highest possible start and end blocks. If both schedules have already started the " +
+		"current block will be used as the schedule start; with the caveat that if one schedule " +
+		"is finished by the current block, the other will be treated as the new merged schedule, " +
+		"unmodified. " +
+		"NOTE: If `schedule1_index == schedule2_index` this is a no-op. " +
+		"NOTE: This will unlock all schedules through the current block prior to merging. " +
+		"NOTE: If both schedules have ended by the current block, no new schedule will be created " +
+		"and both will be removed. " +
+		"Merged schedule attributes: " +
+		"- `starting_block`: `MAX(schedule1.starting_block, scheduled2.starting_block, current_block)`. " +
+		"- `ending_block`: `MAX(schedule1.ending_block, schedule2.ending_block)`. " +
+		"- `locked`: `schedule1.locked_at(current_block) + schedule2.locked_at(current_block)`. " +
+		"The dispatch origin for this call must be _Signed_. " +
+		"`schedule1_index`: index of the first schedule to merge. " +
+		"`schedule2_index`: index of the second schedule to merge."
+}
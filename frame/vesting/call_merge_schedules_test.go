@@ -0,0 +1,60 @@
+package vesting
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	mergeSchedulesArgs = sc.NewVaryingData(sc.U32(0), sc.U32(1))
+)
+
+func Test_Call_MergeSchedules_DecodeArgs(t *testing.T) {
+	target := setupCallMergeSchedules()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(sc.U32(0).Bytes())
+	buffer.Write(sc.U32(1).Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, mergeSchedulesArgs, result.Args())
+}
+
+func Test_Call_MergeSchedules_BaseWeight(t *testing.T) {
+	target := setupCallMergeSchedules()
+
+	assert.Equal(t, callMergeSchedulesWeight(dbWeight, maxVestingSchedules), target.BaseWeight())
+}
+
+func Test_Call_MergeSchedules_Dispatch(t *testing.T) {
+	target := setupCallMergeSchedules()
+	mockStorageVesting.On("Get", who).Return(sc.Sequence[VestingInfo]{scheduleEnded, scheduleFuture}, nil)
+	mockStorageVesting.On("Put", who, mock.Anything).Return()
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(500), primitives.ReasonsMisc).Return(nil)
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), mergeSchedulesArgs)
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockStorageVesting.AssertCalled(t, "Put", who, sc.Sequence[VestingInfo]{scheduleFuture})
+	mockCurrency.AssertCalled(t, "SetLock", LockId, who, sc.NewU128(500), primitives.ReasonsMisc)
+}
+
+func Test_Call_MergeSchedules_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallMergeSchedules()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), mergeSchedulesArgs)
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallMergeSchedules() callMergeSchedules {
+	return newCallMergeSchedules(moduleId, FunctionMergeSchedules, dbWeight, setupModule()).(callMergeSchedules)
+}
@@ -0,0 +1,13 @@
+package vesting
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func callMergeSchedulesWeight(dbWeight primitives.RuntimeDbWeight, schedules sc.U64) primitives.Weight {
+	return primitives.WeightFromParts(36541000, 0).
+		SaturatingAdd(primitives.WeightFromParts(81256, 0).SaturatingMul(schedules)).
+		SaturatingAdd(dbWeight.Reads(3)).
+		SaturatingAdd(dbWeight.Writes(3))
+}
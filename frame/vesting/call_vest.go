@@ -0,0 +1,78 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callVest unlocks the vested funds of the sender.
+type callVest struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallVest(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callVest{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callVest) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	return c, nil
+}
+
+func (c callVest) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callVest) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callVest) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callVest) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callVest) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callVest) BaseWeight() primitives.Weight {
+	return callVestWeight(c.dbWeight, sc.U64(c.module.maxVestingSchedules))
+}
+
+func (_ callVest) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callVest) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callVest) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callVest) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doVest(who.Value)
+}
+
+func (_ callVest) Docs() string {
+	return "Unlock any vested funds of the sender account. " +
+		"The dispatch origin for this call must be _Signed_ and the sender must have funds still " +
+		"locked under this pallet. " +
+		"Emits either `VestingCompleted` or `VestingUpdated`."
+}
@@ -0,0 +1,89 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callVestOther unlocks the vested funds of a target account.
+type callVestOther struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallVestOther(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callVestOther{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callVestOther) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	target, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(target)
+
+	return c, nil
+}
+
+func (c callVestOther) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callVestOther) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callVestOther) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callVestOther) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callVestOther) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callVestOther) BaseWeight() primitives.Weight {
+	return callVestOtherWeight(c.dbWeight, sc.U64(c.module.maxVestingSchedules))
+}
+
+func (_ callVestOther) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callVestOther) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callVestOther) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callVestOther) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	if _, err := system.EnsureSigned(origin); err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	target, err := lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doVest(target)
+}
+
+func (_ callVestOther) Docs() string {
+	return "Unlock any vested funds of a `target` account. " +
+		"The dispatch origin for this call must be _Signed_. " +
+		"`target`: The account whose vested funds should be unlocked. Must have funds still " +
+		"locked under this pallet. " +
+		"Emits either `VestingCompleted` or `VestingUpdated`."
+}
@@ -0,0 +1,64 @@
+package vesting
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_VestOther_DecodeArgs(t *testing.T) {
+	target := setupCallVestOther()
+
+	buffer := bytes.NewBuffer(receiverAddress.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(receiverAddress), result.Args())
+}
+
+func Test_Call_VestOther_BaseWeight(t *testing.T) {
+	target := setupCallVestOther()
+
+	assert.Equal(t, callVestOtherWeight(dbWeight, maxVestingSchedules), target.BaseWeight())
+}
+
+func Test_Call_VestOther_Dispatch(t *testing.T) {
+	target := setupCallVestOther()
+	mockStorageVesting.On("Get", receiver).Return(sc.Sequence[VestingInfo]{scheduleEnded}, nil)
+	mockStorageVesting.On("Remove", receiver).Return()
+	mockCurrency.On("RemoveLock", LockId, receiver).Return(nil)
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), sc.NewVaryingData(receiverAddress))
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "RemoveLock", LockId, receiver)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventVestingCompleted(moduleId, receiver))
+}
+
+func Test_Call_VestOther_Dispatch_NotVesting(t *testing.T) {
+	target := setupCallVestOther()
+	mockStorageVesting.On("Get", receiver).Return(sc.Sequence[VestingInfo]{}, nil)
+
+	_, err := target.Dispatch(primitives.NewRawOriginSigned(who), sc.NewVaryingData(receiverAddress))
+
+	assert.Equal(t, NewDispatchErrorNotVesting(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "RemoveLock", mock.Anything, mock.Anything)
+}
+
+func Test_Call_VestOther_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallVestOther()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), sc.NewVaryingData(receiverAddress))
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallVestOther() callVestOther {
+	return newCallVestOther(moduleId, FunctionVestOther, dbWeight, setupModule()).(callVestOther)
+}
@@ -0,0 +1,51 @@
+package vesting
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Call_Vest_DecodeArgs(t *testing.T) {
+	target := setupCallVest()
+
+	result, err := target.DecodeArgs(&bytes.Buffer{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewVaryingData(), result.Args())
+}
+
+func Test_Call_Vest_BaseWeight(t *testing.T) {
+	target := setupCallVest()
+
+	assert.Equal(t, callVestWeight(dbWeight, maxVestingSchedules), target.BaseWeight())
+}
+
+func Test_Call_Vest_Dispatch(t *testing.T) {
+	target := setupCallVest()
+	mockStorageVesting.On("Get", who).Return(sc.Sequence[VestingInfo]{scheduleOngoing}, nil)
+	mockStorageVesting.On("Put", who, mock.Anything).Return()
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(900), primitives.ReasonsMisc).Return(nil)
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), target.Args())
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "SetLock", LockId, who, sc.NewU128(900), primitives.ReasonsMisc)
+}
+
+func Test_Call_Vest_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallVest()
+
+	_, err := target.Dispatch(primitives.NewRawOriginNone(), target.Args())
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+}
+
+func setupCallVest() callVest {
+	return newCallVest(moduleId, FunctionVest, dbWeight, setupModule()).(callVest)
+}
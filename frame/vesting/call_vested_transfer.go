@@ -0,0 +1,95 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callVestedTransfer creates a vested transfer from the sender to a target account.
+type callVestedTransfer struct {
+	primitives.Callable
+	dbWeight primitives.RuntimeDbWeight
+	module   Module
+}
+
+func newCallVestedTransfer(moduleId sc.U8, functionId sc.U8, dbWeight primitives.RuntimeDbWeight, module Module) primitives.Call {
+	call := callVestedTransfer{
+		Callable: primitives.Callable{
+			ModuleId:   moduleId,
+			FunctionId: functionId,
+			Arguments:  sc.NewVaryingData(primitives.MultiAddress{}, VestingInfo{}),
+		},
+		dbWeight: dbWeight,
+		module:   module,
+	}
+
+	return call
+}
+
+func (c callVestedTransfer) DecodeArgs(buffer *bytes.Buffer) (primitives.Call, error) {
+	target, err := primitives.DecodeMultiAddress(buffer)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := DecodeVestingInfo(buffer)
+	if err != nil {
+		return nil, err
+	}
+	c.Arguments = sc.NewVaryingData(target, schedule)
+
+	return c, nil
+}
+
+func (c callVestedTransfer) Encode(buffer *bytes.Buffer) error {
+	return c.Callable.Encode(buffer)
+}
+
+func (c callVestedTransfer) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c callVestedTransfer) ModuleIndex() sc.U8 { return c.Callable.ModuleIndex() }
+
+func (c callVestedTransfer) FunctionIndex() sc.U8 { return c.Callable.FunctionIndex() }
+
+func (c callVestedTransfer) Args() sc.VaryingData { return c.Callable.Args() }
+
+func (c callVestedTransfer) BaseWeight() primitives.Weight {
+	return callVestedTransferWeight(c.dbWeight, sc.U64(c.module.maxVestingSchedules))
+}
+
+func (_ callVestedTransfer) WeighData(baseWeight primitives.Weight) primitives.Weight {
+	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ callVestedTransfer) ClassifyDispatch(_ primitives.Weight) primitives.DispatchClass {
+	return primitives.NewDispatchClassNormal()
+}
+
+func (_ callVestedTransfer) PaysFee(_ primitives.Weight) primitives.Pays { return primitives.PaysYes }
+
+func (c callVestedTransfer) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData) (primitives.PostDispatchInfo, error) {
+	who, err := system.EnsureSigned(origin)
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	target, err := lookup(args[0].(primitives.MultiAddress))
+	if err != nil {
+		return primitives.PostDispatchInfo{}, err
+	}
+
+	return primitives.PostDispatchInfo{}, c.module.doVestedTransfer(who.Value, target, args[1].(VestingInfo))
+}
+
+func (_ callVestedTransfer) Docs() string {
+	return "Create a vested transfer. " +
+		"The dispatch origin for this call must be _Signed_. " +
+		"`target`: The account receiving the vested funds. " +
+		"`schedule`: The vesting schedule attached to the transfer. " +
+		"Emits `VestingUpdated`. " +
+		"NOTE: This will unlock all schedules through the current block."
+}
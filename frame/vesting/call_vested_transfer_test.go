@@ -0,0 +1,63 @@
+package vesting
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	vestedTransferArgs = sc.NewVaryingData(receiverAddress, scheduleOngoing)
+)
+
+func Test_Call_VestedTransfer_DecodeArgs(t *testing.T) {
+	target := setupCallVestedTransfer()
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(receiverAddress.Bytes())
+	buffer.Write(scheduleOngoing.Bytes())
+
+	result, err := target.DecodeArgs(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, vestedTransferArgs, result.Args())
+}
+
+func Test_Call_VestedTransfer_BaseWeight(t *testing.T) {
+	target := setupCallVestedTransfer()
+
+	assert.Equal(t, callVestedTransferWeight(dbWeight, maxVestingSchedules), target.BaseWeight())
+}
+
+func Test_Call_VestedTransfer_Dispatch(t *testing.T) {
+	target := setupCallVestedTransfer()
+	mockStorageVesting.On("Get", receiver).Return(sc.Sequence[VestingInfo]{}, nil)
+	mockCurrency.On("Transfer", who, receiver, sc.NewU128(1000), primitives.ExistenceRequirementAllowDeath).Return(nil)
+	mockStorageVesting.On("Put", receiver, mock.Anything).Return()
+	mockCurrency.On("SetLock", LockId, receiver, sc.NewU128(900), primitives.ReasonsMisc).Return(nil)
+
+	result, err := target.Dispatch(primitives.NewRawOriginSigned(who), vestedTransferArgs)
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.PostDispatchInfo{}, result)
+	mockCurrency.AssertCalled(t, "Transfer", who, receiver, sc.NewU128(1000), primitives.ExistenceRequirementAllowDeath)
+	mockStorageVesting.AssertCalled(t, "Put", receiver, sc.Sequence[VestingInfo]{scheduleOngoing})
+	mockCurrency.AssertCalled(t, "SetLock", LockId, receiver, sc.NewU128(900), primitives.ReasonsMisc)
+}
+
+func Test_Call_VestedTransfer_Dispatch_BadOrigin(t *testing.T) {
+	target := setupCallVestedTransfer()
+
+	_, err := target.Dispatch(primitives.NewRawOriginRoot(), vestedTransferArgs)
+
+	assert.Equal(t, primitives.NewDispatchErrorBadOrigin(), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func setupCallVestedTransfer() callVestedTransfer {
+	return newCallVestedTransfer(moduleId, FunctionVestedTransfer, dbWeight, setupModule()).(callVestedTransfer)
+}
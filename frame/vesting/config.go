@@ -0,0 +1,41 @@
+package vesting
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Currency is the currency, in which vested funds are transferred and locked.
+type Currency interface {
+	primitives.Currency
+	primitives.LockableCurrency
+}
+
+type Config struct {
+	Storage             io.Storage
+	DbWeight            primitives.RuntimeDbWeight
+	Currency            Currency
+	SystemModule        system.Module
+	MinVestedTransfer   primitives.Balance
+	MaxVestingSchedules sc.U32
+}
+
+func NewConfig(
+	storage io.Storage,
+	dbWeight primitives.RuntimeDbWeight,
+	currency Currency,
+	systemModule system.Module,
+	minVestedTransfer primitives.Balance,
+	maxVestingSchedules sc.U32,
+) Config {
+	return Config{
+		Storage:             storage,
+		DbWeight:            dbWeight,
+		Currency:            currency,
+		SystemModule:        systemModule,
+		MinVestedTransfer:   minVestedTransfer,
+		MaxVestingSchedules: maxVestingSchedules,
+	}
+}
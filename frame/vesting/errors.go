@@ -0,0 +1,43 @@
+package vesting
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Vesting module errors.
+const (
+	ErrorNotVesting sc.U8 = iota
+	ErrorAtMaxVestingSchedules
+	ErrorAmountLow
+	ErrorScheduleIndexOutOfBounds
+	ErrorInvalidScheduleParams
+)
+
+func NewDispatchErrorNotVesting(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNotVesting)
+}
+
+func NewDispatchErrorAtMaxVestingSchedules(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorAtMaxVestingSchedules)
+}
+
+func NewDispatchErrorAmountLow(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorAmountLow)
+}
+
+func NewDispatchErrorScheduleIndexOutOfBounds(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorScheduleIndexOutOfBounds)
+}
+
+func NewDispatchErrorInvalidScheduleParams(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorInvalidScheduleParams)
+}
+
+func newDispatchError(moduleId sc.U8, err sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,27 @@
+package vesting
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewDispatchErrors(t *testing.T) {
+	for err, constructor := range map[sc.U8]func(sc.U8) primitives.DispatchError{
+		ErrorNotVesting:               NewDispatchErrorNotVesting,
+		ErrorAtMaxVestingSchedules:    NewDispatchErrorAtMaxVestingSchedules,
+		ErrorAmountLow:                NewDispatchErrorAmountLow,
+		ErrorScheduleIndexOutOfBounds: NewDispatchErrorScheduleIndexOutOfBounds,
+		ErrorInvalidScheduleParams:    NewDispatchErrorInvalidScheduleParams,
+	} {
+		expect := primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+			Index:   moduleId,
+			Err:     sc.U32(err),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+
+		assert.Equal(t, expect, constructor(moduleId))
+	}
+}
@@ -0,0 +1,64 @@
+package vesting
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Vesting module events.
+const (
+	EventVestingUpdated sc.U8 = iota
+	EventVestingCompleted
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid vesting.Event module")
+	errInvalidEventType   = errors.New("invalid vesting.Event type")
+)
+
+func newEventVestingUpdated(moduleIndex sc.U8, account primitives.AccountId, unvested primitives.Balance) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventVestingUpdated, account, unvested)
+}
+
+func newEventVestingCompleted(moduleIndex sc.U8, account primitives.AccountId) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventVestingCompleted, account)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventVestingUpdated:
+		account, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		unvested, err := sc.DecodeU128(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventVestingUpdated(moduleIndex, account, unvested), nil
+	case EventVestingCompleted:
+		account, err := primitives.DecodeAccountId(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventVestingCompleted(moduleIndex, account), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
@@ -0,0 +1,160 @@
+package vesting
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/vedhavyas/go-subkey"
+)
+
+var (
+	errCurrencyNotInitialized      = errors.New("currencies must be initialized before vesting.")
+	errInvalidVestingInfoInGenesis = errors.New("invalid vesting info params in genesis.")
+	errTooManySchedulesInGenesis   = errors.New("too many vesting schedules in genesis.")
+	errInvalidAddrValue            = errors.New("invalid address in genesis config json")
+	errInvalidBlockNumberValue     = errors.New("invalid block number in genesis config json")
+	errInvalidLiquidValue          = errors.New("invalid liquid balance in genesis config json")
+)
+
+type genesisConfigAccountVesting struct {
+	AccountId types.AccountId
+	Begin     sc.U64
+	Length    sc.U64
+	Liquid    types.Balance
+}
+
+type GenesisConfig struct {
+	Vesting []genesisConfigAccountVesting
+}
+
+type genesisConfigJsonStruct struct {
+	VestingGenesisConfig struct {
+		Vesting [][4]interface{} `json:"vesting"`
+	} `json:"vesting"`
+}
+
+func (gc *GenesisConfig) UnmarshalJSON(data []byte) error {
+	gcJson := genesisConfigJsonStruct{}
+
+	jsonDecoder := json.NewDecoder(bytes.NewReader(data))
+	jsonDecoder.UseNumber()
+	if err := jsonDecoder.Decode(&gcJson); err != nil {
+		return err
+	}
+
+	for _, v := range gcJson.VestingGenesisConfig.Vesting {
+		addrString, ok := v[0].(string)
+		if !ok {
+			return errInvalidAddrValue
+		}
+
+		_, publicKey, err := subkey.SS58Decode(addrString)
+		if err != nil {
+			return err
+		}
+
+		accId, err := types.NewAccountId(sc.BytesToSequenceU8(publicKey)...)
+		if err != nil {
+			return err
+		}
+
+		begin, err := decodeBlockNumber(v[1])
+		if err != nil {
+			return err
+		}
+
+		length, err := decodeBlockNumber(v[2])
+		if err != nil {
+			return err
+		}
+
+		liquid, ok := v[3].(json.Number)
+		if !ok {
+			return errInvalidLiquidValue
+		}
+
+		liquidU128, err := sc.NewU128FromString(liquid.String())
+		if err != nil {
+			return err
+		}
+
+		gc.Vesting = append(gc.Vesting, genesisConfigAccountVesting{
+			AccountId: accId,
+			Begin:     begin,
+			Length:    length,
+			Liquid:    liquidU128,
+		})
+	}
+
+	return nil
+}
+
+func (m Module) CreateDefaultConfig() ([]byte, error) {
+	gc := &genesisConfigJsonStruct{}
+	gc.VestingGenesisConfig.Vesting = [][4]interface{}{}
+
+	return json.Marshal(gc)
+}
+
+// BuildConfig locks the balance of each account in the genesis config, except for its liquid amount,
+// under a vesting schedule, which starts at block `begin` and unlocks the funds linearly over `length` blocks.
+// The balances of the accounts must already be initialized.
+func (m Module) BuildConfig(config []byte) error {
+	gc := GenesisConfig{}
+	if err := json.Unmarshal(config, &gc); err != nil {
+		return err
+	}
+
+	for _, v := range gc.Vesting {
+		balance, err := m.currency.FreeBalance(v.AccountId)
+		if err != nil {
+			return err
+		}
+		if balance.Eq(sc.NewU128(0)) {
+			return errCurrencyNotInitialized
+		}
+
+		locked := sc.SaturatingSubU128(balance, v.Liquid)
+		length := sc.Max128(sc.NewU128(uint64(v.Length)), sc.NewU128(1))
+		schedule := VestingInfo{
+			Locked:        locked,
+			PerBlock:      locked.Div(length),
+			StartingBlock: v.Begin,
+		}
+		if !schedule.IsValid() {
+			return errInvalidVestingInfoInGenesis
+		}
+
+		schedules, err := m.storage.Vesting.Get(v.AccountId)
+		if err != nil {
+			return err
+		}
+		if sc.U32(len(schedules)) >= m.maxVestingSchedules {
+			return errTooManySchedulesInGenesis
+		}
+		m.storage.Vesting.Put(v.AccountId, append(schedules, schedule))
+
+		if err := m.currency.SetLock(LockId, v.AccountId, locked, types.ReasonsMisc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decodeBlockNumber(value interface{}) (sc.U64, error) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, errInvalidBlockNumberValue
+	}
+
+	n, err := number.Int64()
+	if err != nil || n < 0 {
+		return 0, errInvalidBlockNumberValue
+	}
+
+	return sc.U64(n), nil
+}
@@ -0,0 +1,101 @@
+package vesting
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	validGcJson  = "{\"vesting\":{\"vesting\":[[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",10,100,100]]}}"
+	accountId, _ = primitives.NewAccountId(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...)
+	genesisInfo  = VestingInfo{Locked: sc.NewU128(1000), PerBlock: sc.NewU128(10), StartingBlock: 10}
+)
+
+func Test_GenesisConfig_CreateDefaultConfig(t *testing.T) {
+	target := setupModule()
+
+	expectedGc := []byte("{\"vesting\":{\"vesting\":[]}}")
+
+	gc, err := target.CreateDefaultConfig()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedGc, gc)
+}
+
+func Test_GenesisConfig_BuildConfig(t *testing.T) {
+	for _, tt := range []struct {
+		name               string
+		gcJson             string
+		freeBalance        primitives.Balance
+		schedules          sc.Sequence[VestingInfo]
+		expectedErr        error
+		shouldAssertCalled bool
+	}{
+		{
+			name:               "valid",
+			gcJson:             validGcJson,
+			freeBalance:        sc.NewU128(1100),
+			schedules:          sc.Sequence[VestingInfo]{},
+			shouldAssertCalled: true,
+		},
+		{
+			name:   "zero vesting",
+			gcJson: "{\"balances\":{\"balances\":[]}}",
+		},
+		{
+			name:        "invalid genesis address",
+			gcJson:      "{\"vesting\":{\"vesting\":[[1,10,100,100]]}}",
+			expectedErr: errInvalidAddrValue,
+		},
+		{
+			name:        "invalid genesis block number",
+			gcJson:      "{\"vesting\":{\"vesting\":[[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",-1,100,100]]}}",
+			expectedErr: errInvalidBlockNumberValue,
+		},
+		{
+			name:        "invalid genesis liquid balance",
+			gcJson:      "{\"vesting\":{\"vesting\":[[\"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",10,100,\"invalid\"]]}}",
+			expectedErr: errInvalidLiquidValue,
+		},
+		{
+			name:        "currency not initialized",
+			gcJson:      validGcJson,
+			freeBalance: sc.NewU128(0),
+			expectedErr: errCurrencyNotInitialized,
+		},
+		{
+			name:        "invalid vesting info",
+			gcJson:      validGcJson,
+			freeBalance: sc.NewU128(100),
+			expectedErr: errInvalidVestingInfoInGenesis,
+		},
+		{
+			name:        "too many schedules",
+			gcJson:      validGcJson,
+			freeBalance: sc.NewU128(1100),
+			schedules:   sc.Sequence[VestingInfo]{scheduleOngoing, scheduleFuture},
+			expectedErr: errTooManySchedulesInGenesis,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			target := setupModule()
+
+			mockCurrency.On("FreeBalance", accountId).Return(tt.freeBalance, nil)
+			mockStorageVesting.On("Get", accountId).Return(tt.schedules, nil)
+			mockStorageVesting.On("Put", accountId, sc.Sequence[VestingInfo]{genesisInfo}).Return()
+			mockCurrency.On("SetLock", LockId, accountId, sc.NewU128(1000), primitives.ReasonsMisc).Return(nil)
+
+			err := target.BuildConfig([]byte(tt.gcJson))
+
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.shouldAssertCalled {
+				mockStorageVesting.AssertCalled(t, "Put", accountId, sc.Sequence[VestingInfo]{genesisInfo})
+				mockCurrency.AssertCalled(t, "SetLock", LockId, accountId, sc.NewU128(1000), primitives.ReasonsMisc)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package vesting
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func (m Module) Metadata() primitives.MetadataModule {
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesVestingCalls)),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesVestingCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Vesting, Runtime>"),
+				},
+				m.index,
+				"Call.Vesting")),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesVestingEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesVestingEvent, "pallet_vesting::Event<Runtime>"),
+				},
+				m.index,
+				"Events.Vesting"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"MinVestedTransfer",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(m.minVestedTransfer.Bytes()),
+				"The minimum amount transferred to call `vested_transfer`.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxVestingSchedules",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.maxVestingSchedules.Bytes()),
+				"The maximum number of vesting schedules an account may have at a given moment.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesVestingErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesVestingErrors),
+				},
+				m.index,
+				"Errors.Vesting"),
+		),
+		Index: m.index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"Vesting",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesAddress32),
+					sc.ToCompact(metadata.TypesSequenceVestingInfo)),
+				"Information regarding the vesting of a given account."),
+		},
+	})
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParams(metadata.TypesVestingInfo,
+			"VestingInfo",
+			sc.Sequence[sc.Str]{"pallet_vesting", "vesting_info", "VestingInfo"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "locked", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "per_block", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "starting_block", "BlockNumber"),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance"),
+				primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU64, "BlockNumber"),
+			}),
+
+		primitives.NewMetadataType(metadata.TypesSequenceVestingInfo,
+			"BoundedVec<VestingInfo<Balance, BlockNumber>, MaxVestingSchedules>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesVestingInfo))),
+
+		primitives.NewMetadataTypeWithPath(
+			metadata.TypesVestingEvent,
+			"pallet_vesting pallet Event",
+			sc.Sequence[sc.Str]{"pallet_vesting", "pallet", "Event"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"VestingUpdated",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "unvested", "BalanceOf<T>"),
+						},
+						EventVestingUpdated,
+						"The amount vested has been updated. This could indicate a change in funds available. The balance given is the amount which is left unvested (and thus locked)."),
+					primitives.NewMetadataDefinitionVariant(
+						"VestingCompleted",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
+						},
+						EventVestingCompleted,
+						"An account has become fully vested."),
+				})),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesVestingErrors,
+			"pallet_vesting pallet Error",
+			sc.Sequence[sc.Str]{"pallet_vesting", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"NotVesting",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNotVesting,
+						"The account given is not vesting."),
+					primitives.NewMetadataDefinitionVariant(
+						"AtMaxVestingSchedules",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorAtMaxVestingSchedules,
+						"The account already has `MaxVestingSchedules` count of schedules and thus cannot add another one. Consider merging existing schedules in order to add another."),
+					primitives.NewMetadataDefinitionVariant(
+						"AmountLow",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorAmountLow,
+						"Amount being transferred is too low to create a vesting schedule."),
+					primitives.NewMetadataDefinitionVariant(
+						"ScheduleIndexOutOfBounds",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorScheduleIndexOutOfBounds,
+						"An index was out of bounds of the vesting schedules."),
+					primitives.NewMetadataDefinitionVariant(
+						"InvalidScheduleParams",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorInvalidScheduleParams,
+						"Failed to create a new schedule because some parameter was invalid."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesVestingCalls,
+			"Vesting calls",
+			sc.Sequence[sc.Str]{"pallet_vesting", "pallet", "Call"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"vest",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						FunctionVest,
+						"Unlock any vested funds of the sender account."),
+					primitives.NewMetadataDefinitionVariant(
+						"vest_other",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "target", "AccountIdLookupOf<T>"),
+						},
+						FunctionVestOther,
+						"Unlock any vested funds of a `target` account."),
+					primitives.NewMetadataDefinitionVariant(
+						"vested_transfer",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "target", "AccountIdLookupOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesVestingInfo, "schedule", "VestingInfo<BalanceOf<T>, BlockNumberFor<T>>"),
+						},
+						FunctionVestedTransfer,
+						"Create a vested transfer."),
+					primitives.NewMetadataDefinitionVariant(
+						"force_vested_transfer",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "source", "AccountIdLookupOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "target", "AccountIdLookupOf<T>"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesVestingInfo, "schedule", "VestingInfo<BalanceOf<T>, BlockNumberFor<T>>"),
+						},
+						FunctionForceVestedTransfer,
+						"Force a vested transfer."),
+					primitives.NewMetadataDefinitionVariant(
+						"merge_schedules",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "schedule1_index", "u32"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "schedule2_index", "u32"),
+						},
+						FunctionMergeSchedules,
+						"Merge two vesting schedules together, creating a new vesting schedule that unlocks over the highest possible start and end blocks."),
+				}),
+			primitives.NewMetadataEmptyTypeParameter("T")),
+	}
+}
@@ -0,0 +1,302 @@
+package vesting
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	FunctionVest = iota
+	FunctionVestOther
+	FunctionVestedTransfer
+	FunctionForceVestedTransfer
+	FunctionMergeSchedules
+)
+
+const (
+	name = sc.Str("Vesting")
+)
+
+var (
+	// LockId is the id of the balance lock, which holds the unvested funds of an account.
+	LockId = sc.BytesToFixedSequenceU8([]byte("vesting "))
+)
+
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	index               sc.U8
+	dbWeight            primitives.RuntimeDbWeight
+	minVestedTransfer   primitives.Balance
+	maxVestingSchedules sc.U32
+	functions           map[sc.U8]primitives.Call
+	storage             *storage
+	currency            Currency
+	systemModule        system.Module
+	mdGenerator         *primitives.MetadataTypeGenerator
+	logger              log.RuntimeLogger
+}
+
+func New(index sc.U8, config Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.RuntimeLogger) Module {
+	functions := make(map[sc.U8]primitives.Call)
+
+	module := Module{
+		index:               index,
+		dbWeight:            config.DbWeight,
+		minVestedTransfer:   config.MinVestedTransfer,
+		maxVestingSchedules: config.MaxVestingSchedules,
+		storage:             newStorage(config.Storage),
+		currency:            config.Currency,
+		systemModule:        config.SystemModule,
+		mdGenerator:         mdGenerator,
+		logger:              logger,
+	}
+
+	functions[FunctionVest] = newCallVest(index, FunctionVest, config.DbWeight, module)
+	functions[FunctionVestOther] = newCallVestOther(index, FunctionVestOther, config.DbWeight, module)
+	functions[FunctionVestedTransfer] = newCallVestedTransfer(index, FunctionVestedTransfer, config.DbWeight, module)
+	functions[FunctionForceVestedTransfer] = newCallForceVestedTransfer(index, FunctionForceVestedTransfer, config.DbWeight, module)
+	functions[FunctionMergeSchedules] = newCallMergeSchedules(index, FunctionMergeSchedules, config.DbWeight, module)
+
+	module.functions = functions
+
+	return module
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) GetIndex() sc.U8 { return m.index }
+
+func (m Module) Functions() map[sc.U8]primitives.Call { return m.functions }
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) { return sc.Empty{}, nil }
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// Vesting returns the vesting schedules of `who`.
+func (m Module) Vesting(who primitives.AccountId) (sc.Sequence[VestingInfo], error) {
+	return m.storage.Vesting.Get(who)
+}
+
+// VestingBalance returns the amount of `who`'s balance, which is still locked by its vesting schedules.
+func (m Module) VestingBalance(who primitives.AccountId) (primitives.Balance, error) {
+	schedules, err := m.storage.Vesting.Get(who)
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	now, err := m.systemModule.StorageBlockNumber()
+	if err != nil {
+		return primitives.Balance{}, primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	_, lockedNow := filterSchedules(schedules, now)
+	return lockedNow, nil
+}
+
+// doVest unlocks the vested funds of `who`.
+func (m Module) doVest(who primitives.AccountId) error {
+	schedules, err := m.storage.Vesting.Get(who)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if len(schedules) == 0 {
+		return NewDispatchErrorNotVesting(m.index)
+	}
+
+	now, err := m.systemModule.StorageBlockNumber()
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	return m.updateSchedules(who, schedules, now)
+}
+
+// doVestedTransfer transfers the funds locked by `schedule` from `source` to `target`
+// and adds the schedule to the vesting schedules of `target`.
+func (m Module) doVestedTransfer(source primitives.AccountId, target primitives.AccountId, schedule VestingInfo) error {
+	if schedule.Locked.Lt(m.minVestedTransfer) {
+		return NewDispatchErrorAmountLow(m.index)
+	}
+	if !schedule.IsValid() {
+		return NewDispatchErrorInvalidScheduleParams(m.index)
+	}
+
+	schedules, err := m.storage.Vesting.Get(target)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if sc.U32(len(schedules)) >= m.maxVestingSchedules {
+		return NewDispatchErrorAtMaxVestingSchedules(m.index)
+	}
+
+	if err := m.currency.Transfer(source, target, schedule.Locked, primitives.ExistenceRequirementAllowDeath); err != nil {
+		return err
+	}
+
+	now, err := m.systemModule.StorageBlockNumber()
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	return m.updateSchedules(target, append(schedules, schedule), now)
+}
+
+// doMergeSchedules merges the schedules at `index1` and `index2` of `who` into a new schedule,
+// which unlocks the remaining funds of both by the ending block of the later one.
+func (m Module) doMergeSchedules(who primitives.AccountId, index1 sc.U32, index2 sc.U32) error {
+	if index1 == index2 {
+		return nil
+	}
+
+	schedules, err := m.storage.Vesting.Get(who)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if len(schedules) == 0 {
+		return NewDispatchErrorNotVesting(m.index)
+	}
+	if index1 >= sc.U32(len(schedules)) || index2 >= sc.U32(len(schedules)) {
+		return NewDispatchErrorScheduleIndexOutOfBounds(m.index)
+	}
+
+	now, err := m.systemModule.StorageBlockNumber()
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	remaining := sc.Sequence[VestingInfo]{}
+	for i, schedule := range schedules {
+		if sc.U32(i) != index1 && sc.U32(i) != index2 {
+			remaining = append(remaining, schedule)
+		}
+	}
+
+	merged := mergeVestingInfo(now, schedules[index1], schedules[index2])
+	if merged.HasValue {
+		remaining = append(remaining, merged.Value)
+	}
+
+	return m.updateSchedules(who, remaining, now)
+}
+
+// updateSchedules drops the `schedules` of `who` completed by block number `now`, stores the rest
+// and locks the funds, which are still unvested.
+func (m Module) updateSchedules(who primitives.AccountId, schedules sc.Sequence[VestingInfo], now sc.U64) error {
+	schedules, lockedNow := filterSchedules(schedules, now)
+
+	if err := m.writeVesting(who, schedules); err != nil {
+		return err
+	}
+
+	return m.writeLock(who, lockedNow)
+}
+
+// writeVesting stores the vesting `schedules` of `who`, or removes them if there are none.
+func (m Module) writeVesting(who primitives.AccountId, schedules sc.Sequence[VestingInfo]) error {
+	if sc.U32(len(schedules)) > m.maxVestingSchedules {
+		return NewDispatchErrorAtMaxVestingSchedules(m.index)
+	}
+
+	if len(schedules) == 0 {
+		m.storage.Vesting.Remove(who)
+	} else {
+		m.storage.Vesting.Put(who, schedules)
+	}
+
+	return nil
+}
+
+// writeLock locks `lockedNow` of the balance of `who`, or removes the lock once all funds are vested.
+func (m Module) writeLock(who primitives.AccountId, lockedNow primitives.Balance) error {
+	if lockedNow.Eq(sc.NewU128(0)) {
+		if err := m.currency.RemoveLock(LockId, who); err != nil {
+			return err
+		}
+		m.systemModule.DepositEvent(newEventVestingCompleted(m.index, who))
+		return nil
+	}
+
+	if err := m.currency.SetLock(LockId, who, lockedNow, primitives.ReasonsMisc); err != nil {
+		return err
+	}
+	m.systemModule.DepositEvent(newEventVestingUpdated(m.index, who, lockedNow))
+
+	return nil
+}
+
+// filterSchedules drops the schedules, which have no locked funds left at block number `now`.
+// Returns the remaining schedules and the sum of their locked funds.
+func filterSchedules(schedules sc.Sequence[VestingInfo], now sc.U64) (sc.Sequence[VestingInfo], primitives.Balance) {
+	remaining := sc.Sequence[VestingInfo]{}
+	lockedNow := sc.NewU128(0)
+
+	for _, schedule := range schedules {
+		locked := schedule.LockedAt(now)
+		if locked.Eq(sc.NewU128(0)) {
+			continue
+		}
+		remaining = append(remaining, schedule)
+		lockedNow = sc.SaturatingAddU128(lockedNow, locked)
+	}
+
+	return remaining, lockedNow
+}
+
+// mergeVestingInfo merges two schedules into a new one, which starts at block number `now` at the earliest
+// and unlocks the remaining funds of both by the later ending block.
+// If a schedule has already ended, the other one is returned unchanged.
+func mergeVestingInfo(now sc.U64, schedule1 VestingInfo, schedule2 VestingInfo) sc.Option[VestingInfo] {
+	nowBalance := sc.NewU128(uint64(now))
+	ending1 := schedule1.EndingBlock()
+	ending2 := schedule2.EndingBlock()
+
+	switch {
+	case ending1.Lte(nowBalance) && ending2.Lte(nowBalance):
+		return sc.NewOption[VestingInfo](nil)
+	case ending1.Lte(nowBalance):
+		return sc.NewOption[VestingInfo](schedule2)
+	case ending2.Lte(nowBalance):
+		return sc.NewOption[VestingInfo](schedule1)
+	}
+
+	locked := sc.SaturatingAddU128(schedule1.LockedAt(now), schedule2.LockedAt(now))
+	endingBlock := sc.Max128(ending1, ending2)
+	startingBlock := now
+	if schedule1.StartingBlock > startingBlock {
+		startingBlock = schedule1.StartingBlock
+	}
+	if schedule2.StartingBlock > startingBlock {
+		startingBlock = schedule2.StartingBlock
+	}
+
+	one := sc.NewU128(1)
+	duration := sc.Max128(sc.SaturatingSubU128(endingBlock, sc.NewU128(uint64(startingBlock))), one)
+	perBlock := sc.Max128(locked.Div(duration), one)
+
+	schedule := VestingInfo{
+		Locked:        locked,
+		PerBlock:      perBlock,
+		StartingBlock: startingBlock,
+	}
+	if !schedule.IsValid() {
+		return sc.NewOption[VestingInfo](nil)
+	}
+
+	return sc.NewOption[VestingInfo](schedule)
+}
+
+func lookup(address primitives.MultiAddress) (primitives.AccountId, error) {
+	who, err := primitives.Lookup(address)
+	if err != nil {
+		return primitives.AccountId{}, primitives.NewDispatchErrorCannotLookup()
+	}
+	return who, nil
+}
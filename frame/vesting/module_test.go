@@ -0,0 +1,297 @@
+package vesting
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId            = 14
+	maxVestingSchedules = 2
+	blockNumber         = sc.U64(10)
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	minVestedTransfer = sc.NewU128(100)
+
+	who             = constants.OneAccountId
+	receiver        = constants.TwoAccountId
+	whoAddress      = primitives.NewMultiAddressId(who)
+	receiverAddress = primitives.NewMultiAddressId(receiver)
+
+	// scheduleOngoing has 900 locked at blockNumber and ends at block 100.
+	scheduleOngoing = VestingInfo{Locked: sc.NewU128(1000), PerBlock: sc.NewU128(10), StartingBlock: 0}
+	// scheduleFuture has not started at blockNumber and ends at block 30.
+	scheduleFuture = VestingInfo{Locked: sc.NewU128(500), PerBlock: sc.NewU128(50), StartingBlock: 20}
+	// scheduleEnded is fully vested at blockNumber.
+	scheduleEnded = VestingInfo{Locked: sc.NewU128(100), PerBlock: sc.NewU128(10), StartingBlock: 0}
+
+	mdGenerator                           = primitives.NewMetadataTypeGenerator()
+	unknownTransactionNoUnsignedValidator = primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+)
+
+var (
+	mockStorage        *mocks.IoStorage
+	mockCurrency       *mocks.LockableCurrency
+	mockSystemModule   *mocks.SystemModule
+	mockStorageVesting *mocks.StorageMap[primitives.AccountId, sc.Sequence[VestingInfo]]
+	mockCall           *mocks.Call
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, 5, len(target.Functions()))
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.NewTransactionSourceLocal(), mockCall)
+
+	assert.Equal(t, unknownTransactionNoUnsignedValidator, err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_Vesting(t *testing.T) {
+	target := setupModule()
+	schedules := sc.Sequence[VestingInfo]{scheduleOngoing}
+	mockStorageVesting.On("Get", who).Return(schedules, nil)
+
+	result, err := target.Vesting(who)
+
+	assert.NoError(t, err)
+	assert.Equal(t, schedules, result)
+}
+
+func Test_Module_VestingBalance(t *testing.T) {
+	target := setupModule()
+	mockStorageVesting.On("Get", who).Return(sc.Sequence[VestingInfo]{scheduleOngoing, scheduleFuture, scheduleEnded}, nil)
+
+	result, err := target.VestingBalance(who)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(1400), result)
+}
+
+func Test_Module_doVest(t *testing.T) {
+	target := setupModule()
+	mockStorageVesting.On("Get", who).Return(sc.Sequence[VestingInfo]{scheduleOngoing, scheduleEnded}, nil)
+	mockStorageVesting.On("Put", who, mock.Anything).Return()
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(900), primitives.ReasonsMisc).Return(nil)
+
+	err := target.doVest(who)
+
+	assert.NoError(t, err)
+	mockStorageVesting.AssertCalled(t, "Put", who, sc.Sequence[VestingInfo]{scheduleOngoing})
+	mockCurrency.AssertCalled(t, "SetLock", LockId, who, sc.NewU128(900), primitives.ReasonsMisc)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventVestingUpdated(moduleId, who, sc.NewU128(900)))
+}
+
+func Test_Module_doVest_Completed(t *testing.T) {
+	target := setupModule()
+	mockStorageVesting.On("Get", who).Return(sc.Sequence[VestingInfo]{scheduleEnded}, nil)
+	mockStorageVesting.On("Remove", who).Return()
+	mockCurrency.On("RemoveLock", LockId, who).Return(nil)
+
+	err := target.doVest(who)
+
+	assert.NoError(t, err)
+	mockStorageVesting.AssertCalled(t, "Remove", who)
+	mockCurrency.AssertCalled(t, "RemoveLock", LockId, who)
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventVestingCompleted(moduleId, who))
+}
+
+func Test_Module_doVest_NotVesting(t *testing.T) {
+	target := setupModule()
+	mockStorageVesting.On("Get", who).Return(sc.Sequence[VestingInfo]{}, nil)
+
+	err := target.doVest(who)
+
+	assert.Equal(t, NewDispatchErrorNotVesting(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "SetLock", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Module_doVestedTransfer(t *testing.T) {
+	target := setupModule()
+	mockStorageVesting.On("Get", receiver).Return(sc.Sequence[VestingInfo]{scheduleFuture}, nil)
+	mockCurrency.On("Transfer", who, receiver, sc.NewU128(1000), primitives.ExistenceRequirementAllowDeath).Return(nil)
+	mockStorageVesting.On("Put", receiver, mock.Anything).Return()
+	mockCurrency.On("SetLock", LockId, receiver, sc.NewU128(1400), primitives.ReasonsMisc).Return(nil)
+
+	err := target.doVestedTransfer(who, receiver, scheduleOngoing)
+
+	assert.NoError(t, err)
+	mockCurrency.AssertCalled(t, "Transfer", who, receiver, sc.NewU128(1000), primitives.ExistenceRequirementAllowDeath)
+	mockStorageVesting.AssertCalled(t, "Put", receiver, sc.Sequence[VestingInfo]{scheduleFuture, scheduleOngoing})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventVestingUpdated(moduleId, receiver, sc.NewU128(1400)))
+}
+
+func Test_Module_doVestedTransfer_AmountLow(t *testing.T) {
+	target := setupModule()
+	schedule := VestingInfo{Locked: sc.NewU128(99), PerBlock: sc.NewU128(1), StartingBlock: 0}
+
+	err := target.doVestedTransfer(who, receiver, schedule)
+
+	assert.Equal(t, NewDispatchErrorAmountLow(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Module_doVestedTransfer_InvalidScheduleParams(t *testing.T) {
+	target := setupModule()
+	schedule := VestingInfo{Locked: sc.NewU128(1000), PerBlock: sc.NewU128(0), StartingBlock: 0}
+
+	err := target.doVestedTransfer(who, receiver, schedule)
+
+	assert.Equal(t, NewDispatchErrorInvalidScheduleParams(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Module_doVestedTransfer_AtMaxVestingSchedules(t *testing.T) {
+	target := setupModule()
+	mockStorageVesting.On("Get", receiver).Return(sc.Sequence[VestingInfo]{scheduleOngoing, scheduleFuture}, nil)
+
+	err := target.doVestedTransfer(who, receiver, scheduleOngoing)
+
+	assert.Equal(t, NewDispatchErrorAtMaxVestingSchedules(moduleId), err)
+	mockCurrency.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Module_doVestedTransfer_TransferError(t *testing.T) {
+	target := setupModule()
+	mockStorageVesting.On("Get", receiver).Return(sc.Sequence[VestingInfo]{}, nil)
+	mockCurrency.On("Transfer", who, receiver, sc.NewU128(1000), primitives.ExistenceRequirementAllowDeath).Return(primitives.NewDispatchErrorOther("error"))
+
+	err := target.doVestedTransfer(who, receiver, scheduleOngoing)
+
+	assert.Equal(t, primitives.NewDispatchErrorOther("error"), err)
+	mockStorageVesting.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_doMergeSchedules(t *testing.T) {
+	target := setupModule()
+	mockStorageVesting.On("Get", who).Return(sc.Sequence[VestingInfo]{scheduleOngoing, scheduleFuture}, nil)
+	mockStorageVesting.On("Put", who, mock.Anything).Return()
+	mockCurrency.On("SetLock", LockId, who, sc.NewU128(1400), primitives.ReasonsMisc).Return(nil)
+
+	err := target.doMergeSchedules(who, 0, 1)
+
+	assert.NoError(t, err)
+	merged := VestingInfo{Locked: sc.NewU128(1400), PerBlock: sc.NewU128(17), StartingBlock: 20}
+	mockStorageVesting.AssertCalled(t, "Put", who, sc.Sequence[VestingInfo]{merged})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventVestingUpdated(moduleId, who, sc.NewU128(1400)))
+}
+
+func Test_Module_doMergeSchedules_SameIndex(t *testing.T) {
+	target := setupModule()
+
+	err := target.doMergeSchedules(who, 1, 1)
+
+	assert.NoError(t, err)
+	mockStorageVesting.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func Test_Module_doMergeSchedules_NotVesting(t *testing.T) {
+	target := setupModule()
+	mockStorageVesting.On("Get", who).Return(sc.Sequence[VestingInfo]{}, nil)
+
+	err := target.doMergeSchedules(who, 0, 1)
+
+	assert.Equal(t, NewDispatchErrorNotVesting(moduleId), err)
+}
+
+func Test_Module_doMergeSchedules_ScheduleIndexOutOfBounds(t *testing.T) {
+	target := setupModule()
+	mockStorageVesting.On("Get", who).Return(sc.Sequence[VestingInfo]{scheduleOngoing, scheduleFuture}, nil)
+
+	err := target.doMergeSchedules(who, 0, 2)
+
+	assert.Equal(t, NewDispatchErrorScheduleIndexOutOfBounds(moduleId), err)
+	mockStorageVesting.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_Module_writeVesting_AtMaxVestingSchedules(t *testing.T) {
+	target := setupModule()
+
+	err := target.writeVesting(who, sc.Sequence[VestingInfo]{scheduleOngoing, scheduleFuture, scheduleEnded})
+
+	assert.Equal(t, NewDispatchErrorAtMaxVestingSchedules(moduleId), err)
+	mockStorageVesting.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
+
+func Test_mergeVestingInfo(t *testing.T) {
+	merged := VestingInfo{Locked: sc.NewU128(1400), PerBlock: sc.NewU128(17), StartingBlock: 20}
+
+	assert.Equal(t, sc.NewOption[VestingInfo](merged), mergeVestingInfo(blockNumber, scheduleOngoing, scheduleFuture))
+	assert.Equal(t, sc.NewOption[VestingInfo](scheduleFuture), mergeVestingInfo(blockNumber, scheduleEnded, scheduleFuture))
+	assert.Equal(t, sc.NewOption[VestingInfo](scheduleOngoing), mergeVestingInfo(blockNumber, scheduleOngoing, scheduleEnded))
+	assert.Equal(t, sc.NewOption[VestingInfo](nil), mergeVestingInfo(blockNumber, scheduleEnded, scheduleEnded))
+}
+
+func Test_Module_Metadata(t *testing.T) {
+	target := setupModule()
+
+	result := target.Metadata()
+
+	assert.Equal(t, primitives.ModuleVersion14, result.Version)
+	assert.Equal(t, sc.Str("Vesting"), result.ModuleV14.Name)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesVestingCalls)), result.ModuleV14.Call)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesVestingEvent)), result.ModuleV14.Event)
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesVestingErrors)), result.ModuleV14.Error)
+	assert.Equal(t, sc.Str("Vesting"), result.ModuleV14.Storage.Value.Prefix)
+	assert.Equal(t, 1, len(result.ModuleV14.Storage.Value.Items))
+	assert.Equal(t, 2, len(result.ModuleV14.Constants))
+	assert.Equal(t, sc.U8(moduleId), result.ModuleV14.Index)
+}
+
+func setupModule() Module {
+	mockStorage = new(mocks.IoStorage)
+	mockCurrency = new(mocks.LockableCurrency)
+	mockSystemModule = new(mocks.SystemModule)
+	mockStorageVesting = new(mocks.StorageMap[primitives.AccountId, sc.Sequence[VestingInfo]])
+	mockCall = new(mocks.Call)
+
+	config := NewConfig(
+		mockStorage,
+		dbWeight,
+		mockCurrency,
+		mockSystemModule,
+		minVestedTransfer,
+		maxVestingSchedules,
+	)
+
+	target := New(moduleId, config, mdGenerator, log.NewLogger())
+	target.storage.Vesting = mockStorageVesting
+
+	mockSystemModule.On("StorageBlockNumber").Return(blockNumber, nil)
+	mockSystemModule.On("DepositEvent", mock.Anything)
+
+	return target
+}
@@ -0,0 +1,34 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	keyVesting = []byte("Vesting")
+)
+
+var (
+	defaultVestingSchedules = sc.Sequence[VestingInfo]{}
+)
+
+type storage struct {
+	Vesting support.StorageMap[primitives.AccountId, sc.Sequence[VestingInfo]]
+}
+
+func newStorage(s io.Storage) *storage {
+	hashing := io.NewHashing()
+
+	return &storage{
+		Vesting: support.NewHashStorageMapWithDefault[primitives.AccountId, sc.Sequence[VestingInfo]](s, keyVesting, keyVesting, hashing.Twox64, decodeVestingSchedules, &defaultVestingSchedules),
+	}
+}
+
+func decodeVestingSchedules(buffer *bytes.Buffer) (sc.Sequence[VestingInfo], error) {
+	return sc.DecodeSequenceWith(buffer, DecodeVestingInfo)
+}
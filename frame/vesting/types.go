@@ -0,0 +1,91 @@
+package vesting
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// VestingInfo is a linear release schedule of locked funds.
+type VestingInfo struct {
+	// Locked is the amount locked at the start of the schedule.
+	Locked primitives.Balance
+	// PerBlock is the amount unlocked each block after StartingBlock.
+	PerBlock primitives.Balance
+	// StartingBlock is the block number from which the funds start to unlock.
+	StartingBlock sc.U64
+}
+
+func (vi VestingInfo) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, vi.Locked, vi.PerBlock, vi.StartingBlock)
+}
+
+func DecodeVestingInfo(buffer *bytes.Buffer) (VestingInfo, error) {
+	locked, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return VestingInfo{}, err
+	}
+	perBlock, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return VestingInfo{}, err
+	}
+	startingBlock, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return VestingInfo{}, err
+	}
+
+	return VestingInfo{
+		Locked:        locked,
+		PerBlock:      perBlock,
+		StartingBlock: startingBlock,
+	}, nil
+}
+
+func (vi VestingInfo) Bytes() []byte {
+	return sc.EncodedBytes(vi)
+}
+
+// IsValid checks that the schedule locks a non-zero amount, which unlocks at a non-zero rate.
+func (vi VestingInfo) IsValid() bool {
+	zero := sc.NewU128(0)
+	return !vi.Locked.Eq(zero) && !vi.PerBlock.Eq(zero)
+}
+
+// LockedAt returns the amount, which is still locked at block number `n`.
+func (vi VestingInfo) LockedAt(n sc.U64) primitives.Balance {
+	if n <= vi.StartingBlock {
+		return vi.Locked
+	}
+
+	blocks := sc.NewU128(uint64(n - vi.StartingBlock))
+	vested := vi.PerBlock.Mul(blocks)
+	if !vested.Div(blocks).Eq(vi.PerBlock) {
+		// The vested amount overflows, so all funds are vested.
+		return sc.NewU128(0)
+	}
+
+	return sc.SaturatingSubU128(vi.Locked, vested)
+}
+
+// EndingBlock returns the block number, at which all funds of the schedule are unlocked.
+func (vi VestingInfo) EndingBlock() primitives.Balance {
+	zero := sc.NewU128(0)
+	one := sc.NewU128(1)
+	startingBlock := sc.NewU128(uint64(vi.StartingBlock))
+
+	var duration primitives.Balance
+	switch {
+	case vi.PerBlock.Gte(vi.Locked):
+		duration = one
+	case vi.PerBlock.Eq(zero):
+		duration = vi.Locked
+	default:
+		duration = vi.Locked.Div(vi.PerBlock)
+		if !vi.Locked.Sub(duration.Mul(vi.PerBlock)).Eq(zero) {
+			duration = duration.Add(one)
+		}
+	}
+
+	return sc.SaturatingAddU128(startingBlock, duration)
+}
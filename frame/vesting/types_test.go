@@ -0,0 +1,53 @@
+package vesting
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	expectedVestingInfoBytes, _ = hex.DecodeString("e8030000000000000000000000000000" + "0a000000000000000000000000000000" + "1400000000000000")
+)
+
+func Test_VestingInfo_Encode(t *testing.T) {
+	schedule := VestingInfo{Locked: sc.NewU128(1000), PerBlock: sc.NewU128(10), StartingBlock: 20}
+
+	buffer := &bytes.Buffer{}
+	err := schedule.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedVestingInfoBytes, buffer.Bytes())
+	assert.Equal(t, expectedVestingInfoBytes, schedule.Bytes())
+}
+
+func Test_DecodeVestingInfo(t *testing.T) {
+	result, err := DecodeVestingInfo(bytes.NewBuffer(expectedVestingInfoBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, VestingInfo{Locked: sc.NewU128(1000), PerBlock: sc.NewU128(10), StartingBlock: 20}, result)
+}
+
+func Test_VestingInfo_IsValid(t *testing.T) {
+	assert.True(t, scheduleOngoing.IsValid())
+	assert.False(t, VestingInfo{Locked: sc.NewU128(0), PerBlock: sc.NewU128(10)}.IsValid())
+	assert.False(t, VestingInfo{Locked: sc.NewU128(1000), PerBlock: sc.NewU128(0)}.IsValid())
+}
+
+func Test_VestingInfo_LockedAt(t *testing.T) {
+	assert.Equal(t, sc.NewU128(500), scheduleFuture.LockedAt(10))
+	assert.Equal(t, sc.NewU128(500), scheduleFuture.LockedAt(20))
+	assert.Equal(t, sc.NewU128(250), scheduleFuture.LockedAt(25))
+	assert.Equal(t, sc.NewU128(0), scheduleFuture.LockedAt(30))
+	assert.Equal(t, sc.NewU128(0), scheduleFuture.LockedAt(100))
+}
+
+func Test_VestingInfo_EndingBlock(t *testing.T) {
+	assert.Equal(t, sc.NewU128(30), scheduleFuture.EndingBlock())
+	assert.Equal(t, sc.NewU128(21), VestingInfo{Locked: sc.NewU128(10), PerBlock: sc.NewU128(20), StartingBlock: 20}.EndingBlock())
+	assert.Equal(t, sc.NewU128(30), VestingInfo{Locked: sc.NewU128(10), PerBlock: sc.NewU128(0), StartingBlock: 20}.EndingBlock())
+	assert.Equal(t, sc.NewU128(24), VestingInfo{Locked: sc.NewU128(10), PerBlock: sc.NewU128(3), StartingBlock: 20}.EndingBlock())
+}
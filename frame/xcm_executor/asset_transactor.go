@@ -0,0 +1,89 @@
+package xcm_executor
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+)
+
+// AssetTransactor moves assets between the holding register and local accounts.
+type AssetTransactor interface {
+	// WithdrawAsset withdraws `what` from `who` and returns the withdrawn assets, which are placed in the
+	// holding register.
+	WithdrawAsset(what xcm.Asset, who xcm.Location) (xcm.Assets, error)
+	// DepositAsset deposits `what` from the holding register into `who`.
+	DepositAsset(what xcm.Asset, who xcm.Location) error
+}
+
+// Currency is the fungible currency, on which the FungibleAdapter is built. It is usually the balances module.
+type Currency interface {
+	Withdraw(who primitives.AccountId, value sc.U128, reasons sc.U8, liveness primitives.ExistenceRequirement) (primitives.Balance, error)
+	DropNegativeImbalance(value primitives.Balance) error
+	DepositCreating(who primitives.AccountId, value sc.U128) (primitives.Balance, error)
+}
+
+// FungibleAdapter transacts the fungible asset, identified by `assetId`, with the accounts of a currency.
+// Withdrawn assets are burned and deposited assets are minted, which keeps the total issuance in line with
+// the assets, which are held locally.
+type FungibleAdapter struct {
+	currency          Currency
+	assetId           xcm.AssetId
+	locationConverter LocationConverter
+}
+
+func NewFungibleAdapter(currency Currency, assetId xcm.AssetId, locationConverter LocationConverter) FungibleAdapter {
+	return FungibleAdapter{
+		currency:          currency,
+		assetId:           assetId,
+		locationConverter: locationConverter,
+	}
+}
+
+func (fa FungibleAdapter) WithdrawAsset(what xcm.Asset, who xcm.Location) (xcm.Assets, error) {
+	amount, err := fa.matchFungible(what)
+	if err != nil {
+		return nil, err
+	}
+	account := fa.locationConverter.ConvertLocation(who)
+	if !account.HasValue {
+		return nil, xcm.NewXcmErrorFailedToTransactAsset()
+	}
+
+	withdrawn, err := fa.currency.Withdraw(account.Value, amount, sc.U8(primitives.WithdrawReasonsTransfer), primitives.ExistenceRequirementAllowDeath)
+	if err != nil {
+		return nil, xcm.NewXcmErrorFailedToTransactAsset()
+	}
+	if err := fa.currency.DropNegativeImbalance(withdrawn); err != nil {
+		return nil, err
+	}
+
+	return xcm.Assets{what}, nil
+}
+
+func (fa FungibleAdapter) DepositAsset(what xcm.Asset, who xcm.Location) error {
+	amount, err := fa.matchFungible(what)
+	if err != nil {
+		return err
+	}
+	account := fa.locationConverter.ConvertLocation(who)
+	if !account.HasValue {
+		return xcm.NewXcmErrorFailedToTransactAsset()
+	}
+
+	if _, err := fa.currency.DepositCreating(account.Value, amount); err != nil {
+		return xcm.NewXcmErrorFailedToTransactAsset()
+	}
+	return nil
+}
+
+// matchFungible returns the amount of `what`, if it is the fungible asset of the adapter.
+func (fa FungibleAdapter) matchFungible(what xcm.Asset) (sc.U128, error) {
+	if !what.Id.Equal(fa.assetId) {
+		return sc.U128{}, xcm.NewXcmErrorAssetNotFound()
+	}
+	amount, err := what.Fun.AsFungible()
+	if err != nil {
+		return sc.U128{}, xcm.NewXcmErrorAssetNotFound()
+	}
+	return amount, nil
+}
@@ -0,0 +1,30 @@
+package xcm_executor
+
+import (
+	"github.com/LimeChain/gosemble/primitives/xcm"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAssetTransactor struct {
+	mock.Mock
+}
+
+func (m *MockAssetTransactor) WithdrawAsset(what xcm.Asset, who xcm.Location) (xcm.Assets, error) {
+	args := m.Called(what, who)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(xcm.Assets), args.Get(1).(error)
+	}
+
+	return args.Get(0).(xcm.Assets), nil
+}
+
+func (m *MockAssetTransactor) DepositAsset(what xcm.Asset, who xcm.Location) error {
+	args := m.Called(what, who)
+
+	if args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package xcm_executor
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockCurrency *MockCurrency
+)
+
+func Test_FungibleAdapter_WithdrawAsset(t *testing.T) {
+	target := setupFungibleAdapter()
+	mockCurrency.On("Withdraw", accountId, sc.NewU128(10), sc.U8(primitives.WithdrawReasonsTransfer), primitives.ExistenceRequirementAllowDeath).Return(sc.NewU128(10), nil)
+	mockCurrency.On("DropNegativeImbalance", sc.NewU128(10)).Return(nil)
+
+	result, err := target.WithdrawAsset(newNativeAsset(10), xcm.NewLocationAccountId32(accountIdBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, xcm.Assets{newNativeAsset(10)}, result)
+	mockCurrency.AssertExpectations(t)
+}
+
+func Test_FungibleAdapter_WithdrawAsset_AssetNotFound(t *testing.T) {
+	target := setupFungibleAdapter()
+
+	_, err := target.WithdrawAsset(newOtherAsset(10), xcm.NewLocationAccountId32(accountIdBytes))
+
+	assert.Equal(t, xcm.NewXcmErrorAssetNotFound(), err)
+	mockCurrency.AssertNotCalled(t, "Withdraw")
+}
+
+func Test_FungibleAdapter_WithdrawAsset_UnknownLocation(t *testing.T) {
+	target := setupFungibleAdapter()
+
+	_, err := target.WithdrawAsset(newNativeAsset(10), xcm.NewLocationHere())
+
+	assert.Equal(t, xcm.NewXcmErrorFailedToTransactAsset(), err)
+	mockCurrency.AssertNotCalled(t, "Withdraw")
+}
+
+func Test_FungibleAdapter_WithdrawAsset_Fails(t *testing.T) {
+	target := setupFungibleAdapter()
+	mockCurrency.On("Withdraw", accountId, sc.NewU128(10), sc.U8(primitives.WithdrawReasonsTransfer), primitives.ExistenceRequirementAllowDeath).Return(sc.NewU128(0), primitives.NewDispatchErrorToken(primitives.NewTokenErrorFundsUnavailable()))
+
+	_, err := target.WithdrawAsset(newNativeAsset(10), xcm.NewLocationAccountId32(accountIdBytes))
+
+	assert.Equal(t, xcm.NewXcmErrorFailedToTransactAsset(), err)
+	mockCurrency.AssertNotCalled(t, "DropNegativeImbalance")
+}
+
+func Test_FungibleAdapter_DepositAsset(t *testing.T) {
+	target := setupFungibleAdapter()
+	mockCurrency.On("DepositCreating", accountId, sc.NewU128(10)).Return(sc.NewU128(10), nil)
+
+	err := target.DepositAsset(newNativeAsset(10), xcm.NewLocationAccountId32(accountIdBytes))
+
+	assert.NoError(t, err)
+	mockCurrency.AssertExpectations(t)
+}
+
+func Test_FungibleAdapter_DepositAsset_Fails(t *testing.T) {
+	target := setupFungibleAdapter()
+	mockCurrency.On("DepositCreating", accountId, sc.NewU128(1)).Return(sc.NewU128(0), primitives.NewDispatchErrorToken(primitives.NewTokenErrorBelowMinimum()))
+
+	err := target.DepositAsset(newNativeAsset(1), xcm.NewLocationAccountId32(accountIdBytes))
+
+	assert.Equal(t, xcm.NewXcmErrorFailedToTransactAsset(), err)
+}
+
+func setupFungibleAdapter() FungibleAdapter {
+	mockCurrency = new(MockCurrency)
+
+	return NewFungibleAdapter(mockCurrency, nativeAssetId, AccountId32Aliases{Network: sc.NewOption[xcm.NetworkId](nil)})
+}
@@ -0,0 +1,67 @@
+package xcm_executor
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+)
+
+// Barrier decides, which programs are allowed to be executed.
+type Barrier interface {
+	// ShouldExecute returns an error, if `message` from `origin`, whose weight is `maxWeight`, must not be executed.
+	ShouldExecute(origin xcm.Location, message xcm.Xcm, maxWeight primitives.Weight) error
+}
+
+// AllowTopLevelPaidExecution allows the execution of programs, which pay for their weight. Such a program
+// places assets into the holding register, optionally clears its origin and then buys execution with a
+// weight limit, which covers the weight of the whole program.
+type AllowTopLevelPaidExecution struct{}
+
+func (AllowTopLevelPaidExecution) ShouldExecute(_ xcm.Location, message xcm.Xcm, maxWeight primitives.Weight) error {
+	if len(message) == 0 {
+		return xcm.NewXcmErrorBarrier()
+	}
+	switch message[0].VaryingData[0] {
+	case xcm.InstructionWithdrawAsset, xcm.InstructionReceiveTeleportedAsset:
+	default:
+		return xcm.NewXcmErrorBarrier()
+	}
+
+	i := 1
+	if i < len(message) && message[i].VaryingData[0] == xcm.InstructionClearOrigin {
+		i++
+	}
+	if i >= len(message) || message[i].VaryingData[0] != xcm.InstructionBuyExecution {
+		return xcm.NewXcmErrorBarrier()
+	}
+
+	limit := message[i].VaryingData[2].(xcm.WeightLimit).Limit()
+	if !limit.HasValue || maxWeight.AnyGt(limit.Value) {
+		return xcm.NewXcmErrorBarrier()
+	}
+
+	return nil
+}
+
+// AllowUnpaidExecutionFrom allows the execution of any program from `Origin`.
+type AllowUnpaidExecutionFrom struct {
+	Origin xcm.Location
+}
+
+func (b AllowUnpaidExecutionFrom) ShouldExecute(origin xcm.Location, _ xcm.Xcm, _ primitives.Weight) error {
+	if !origin.Equal(b.Origin) {
+		return xcm.NewXcmErrorBarrier()
+	}
+	return nil
+}
+
+// Barriers allows the execution of a program, if any of its barriers allows it.
+type Barriers []Barrier
+
+func (b Barriers) ShouldExecute(origin xcm.Location, message xcm.Xcm, maxWeight primitives.Weight) error {
+	for _, barrier := range b {
+		if err := barrier.ShouldExecute(origin, message, maxWeight); err == nil {
+			return nil
+		}
+	}
+	return xcm.NewXcmErrorBarrier()
+}
@@ -0,0 +1,87 @@
+package xcm_executor
+
+import (
+	"testing"
+
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+	"github.com/stretchr/testify/assert"
+)
+
+var paidWeight = primitives.WeightFromParts(3_000, 30)
+
+func Test_AllowTopLevelPaidExecution_ShouldExecute(t *testing.T) {
+	fees := newNativeAsset(100)
+	buyExecution := xcm.NewInstructionBuyExecution(fees, xcm.NewWeightLimitLimited(paidWeight))
+
+	for _, tt := range []struct {
+		name     string
+		message  xcm.Xcm
+		expected error
+	}{
+		{
+			name:    "ReceiveTeleportedAsset",
+			message: xcm.Xcm{xcm.NewInstructionReceiveTeleportedAsset(xcm.Assets{fees}), buyExecution},
+		},
+		{
+			name:    "WithdrawAsset with ClearOrigin",
+			message: xcm.Xcm{xcm.NewInstructionWithdrawAsset(xcm.Assets{fees}), xcm.NewInstructionClearOrigin(), buyExecution},
+		},
+		{
+			name:     "Empty",
+			message:  xcm.Xcm{},
+			expected: xcm.NewXcmErrorBarrier(),
+		},
+		{
+			name:     "Without assets",
+			message:  xcm.Xcm{buyExecution},
+			expected: xcm.NewXcmErrorBarrier(),
+		},
+		{
+			name:     "Without BuyExecution",
+			message:  xcm.Xcm{xcm.NewInstructionWithdrawAsset(xcm.Assets{fees}), xcm.NewInstructionClearOrigin()},
+			expected: xcm.NewXcmErrorBarrier(),
+		},
+		{
+			name:     "BuyExecution after other instructions",
+			message:  xcm.Xcm{xcm.NewInstructionWithdrawAsset(xcm.Assets{fees}), xcm.NewInstructionClearTopic(), buyExecution},
+			expected: xcm.NewXcmErrorBarrier(),
+		},
+		{
+			name:     "Unlimited",
+			message:  xcm.Xcm{xcm.NewInstructionWithdrawAsset(xcm.Assets{fees}), xcm.NewInstructionBuyExecution(fees, xcm.NewWeightLimitUnlimited())},
+			expected: xcm.NewXcmErrorBarrier(),
+		},
+		{
+			name:     "Limited below the weight",
+			message:  xcm.Xcm{xcm.NewInstructionWithdrawAsset(xcm.Assets{fees}), xcm.NewInstructionBuyExecution(fees, xcm.NewWeightLimitLimited(primitives.WeightFromParts(3_000, 29)))},
+			expected: xcm.NewXcmErrorBarrier(),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AllowTopLevelPaidExecution{}.ShouldExecute(xcm.NewLocationSibling(1000), tt.message, paidWeight)
+
+			assert.Equal(t, tt.expected, err)
+		})
+	}
+}
+
+func Test_AllowUnpaidExecutionFrom_ShouldExecute(t *testing.T) {
+	target := AllowUnpaidExecutionFrom{Origin: xcm.NewLocationParent()}
+	message := xcm.Xcm{xcm.NewInstructionClearOrigin()}
+
+	assert.NoError(t, target.ShouldExecute(xcm.NewLocationParent(), message, paidWeight))
+	assert.Equal(t, xcm.NewXcmErrorBarrier(), target.ShouldExecute(xcm.NewLocationSibling(1000), message, paidWeight))
+}
+
+func Test_Barriers_ShouldExecute(t *testing.T) {
+	target := Barriers{
+		AllowUnpaidExecutionFrom{Origin: xcm.NewLocationParent()},
+		AllowUnpaidExecutionFrom{Origin: xcm.NewLocationSibling(1000)},
+	}
+	message := xcm.Xcm{xcm.NewInstructionClearOrigin()}
+
+	assert.NoError(t, target.ShouldExecute(xcm.NewLocationParent(), message, paidWeight))
+	assert.NoError(t, target.ShouldExecute(xcm.NewLocationSibling(1000), message, paidWeight))
+	assert.Equal(t, xcm.NewXcmErrorBarrier(), target.ShouldExecute(xcm.NewLocationSibling(2000), message, paidWeight))
+}
@@ -0,0 +1,13 @@
+package xcm_executor
+
+import (
+	"bytes"
+
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// CallDecoder decodes the calls, which are dispatched by `Transact`. It is usually implemented by the
+// runtime decoder.
+type CallDecoder interface {
+	DecodeCall(buffer *bytes.Buffer) (primitives.Call, error)
+}
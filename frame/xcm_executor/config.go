@@ -0,0 +1,41 @@
+package xcm_executor
+
+import (
+	"github.com/LimeChain/gosemble/primitives/io"
+)
+
+type Config struct {
+	Storage           io.Storage
+	TransactionBroker io.TransactionBroker
+	AssetTransactor   AssetTransactor
+	OriginConverter   OriginConverter
+	IsTeleporter      TeleportFilter
+	Barrier           Barrier
+	Weigher           Weigher
+	Trader            WeightTrader
+	CallDecoder       CallDecoder
+}
+
+func NewConfig(
+	storage io.Storage,
+	transactionBroker io.TransactionBroker,
+	assetTransactor AssetTransactor,
+	originConverter OriginConverter,
+	isTeleporter TeleportFilter,
+	barrier Barrier,
+	weigher Weigher,
+	trader WeightTrader,
+	callDecoder CallDecoder,
+) Config {
+	return Config{
+		Storage:           storage,
+		TransactionBroker: transactionBroker,
+		AssetTransactor:   assetTransactor,
+		OriginConverter:   originConverter,
+		IsTeleporter:      isTeleporter,
+		Barrier:           barrier,
+		Weigher:           weigher,
+		Trader:            trader,
+		CallDecoder:       callDecoder,
+	}
+}
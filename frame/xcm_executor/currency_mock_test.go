@@ -0,0 +1,41 @@
+package xcm_executor
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCurrency struct {
+	mock.Mock
+}
+
+func (m *MockCurrency) Withdraw(who primitives.AccountId, value sc.U128, reasons sc.U8, liveness primitives.ExistenceRequirement) (primitives.Balance, error) {
+	args := m.Called(who, value, reasons, liveness)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(primitives.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(primitives.Balance), nil
+}
+
+func (m *MockCurrency) DropNegativeImbalance(value primitives.Balance) error {
+	args := m.Called(value)
+
+	if args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+
+	return nil
+}
+
+func (m *MockCurrency) DepositCreating(who primitives.AccountId, value sc.U128) (primitives.Balance, error) {
+	args := m.Called(who, value)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(primitives.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(primitives.Balance), nil
+}
@@ -0,0 +1,240 @@
+package xcm_executor
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+)
+
+// Executor executes XCM programs against the local chain. It supports the instructions, which are needed to
+// move fungible assets between chains and to dispatch calls on behalf of other locations.
+type Executor struct {
+	assetTransactor AssetTransactor
+	originConverter OriginConverter
+	isTeleporter    TeleportFilter
+	barrier         Barrier
+	weigher         Weigher
+	trader          WeightTrader
+	callDecoder     CallDecoder
+	transactional   support.Transactional[primitives.PostDispatchInfo]
+	logger          log.RuntimeLogger
+}
+
+func New(config Config, logger log.RuntimeLogger) Executor {
+	return Executor{
+		assetTransactor: config.AssetTransactor,
+		originConverter: config.OriginConverter,
+		isTeleporter:    config.IsTeleporter,
+		barrier:         config.Barrier,
+		weigher:         config.Weigher,
+		trader:          config.Trader,
+		callDecoder:     config.CallDecoder,
+		transactional:   support.NewTransactional[primitives.PostDispatchInfo](config.Storage, config.TransactionBroker, logger),
+		logger:          logger,
+	}
+}
+
+// executionContext is the state of the executor during the execution of a single program.
+type executionContext struct {
+	origin  sc.Option[xcm.Location]
+	holding holding
+	topic   sc.Option[sc.FixedSequence[sc.U8]]
+	// surplus is the weight, which was paid for in advance, but was not used by the execution.
+	surplus primitives.Weight
+}
+
+// Weight returns the weight of `message`.
+func (e Executor) Weight(message xcm.Xcm) (primitives.Weight, error) {
+	return e.weigher.Weight(message)
+}
+
+// Execute executes `message` from `origin`, if its weight does not exceed `weightLimit` and the barrier allows
+// it. The instructions are executed in order, until one of them fails. If the message sets a topic, it
+// overwrites `id`. The returned outcome holds the weight, which was actually used, i.e. the weight of
+// `message` without the surplus of its dispatched calls.
+func (e Executor) Execute(origin xcm.Location, message xcm.Xcm, weightLimit primitives.Weight, id *primitives.H256) xcm.Outcome {
+	weight, err := e.weigher.Weight(message)
+	if err != nil {
+		return xcm.NewOutcomeError(xcm.NewXcmErrorWeightNotComputable())
+	}
+	if weight.AnyGt(weightLimit) {
+		return xcm.NewOutcomeError(xcm.NewXcmErrorWeightLimitReached(weight))
+	}
+	if err := e.barrier.ShouldExecute(origin, message, weight); err != nil {
+		return xcm.NewOutcomeError(xcm.NewXcmErrorBarrier())
+	}
+
+	ctx := &executionContext{
+		origin: sc.NewOption[xcm.Location](origin),
+		topic:  sc.NewOption[sc.FixedSequence[sc.U8]](nil),
+	}
+
+	for _, instruction := range message {
+		if err := e.processInstruction(ctx, instruction); err != nil {
+			e.dropHolding(ctx)
+			return xcm.NewOutcomeIncomplete(weight.SaturatingSub(ctx.surplus), toXcmError(err))
+		}
+	}
+	e.dropHolding(ctx)
+
+	if ctx.topic.HasValue {
+		*id = primitives.H256{FixedSequence: ctx.topic.Value}
+	}
+
+	return xcm.NewOutcomeComplete(weight.SaturatingSub(ctx.surplus))
+}
+
+func (e Executor) processInstruction(ctx *executionContext, instruction xcm.Instruction) error {
+	switch instruction.VaryingData[0] {
+	case xcm.InstructionWithdrawAsset:
+		return e.withdrawAsset(ctx, instruction.VaryingData[1].(xcm.Assets))
+	case xcm.InstructionReceiveTeleportedAsset:
+		return e.receiveTeleportedAsset(ctx, instruction.VaryingData[1].(xcm.Assets))
+	case xcm.InstructionTransact:
+		return e.transact(ctx, instruction.VaryingData[1].(sc.U8), instruction.VaryingData[2].(primitives.Weight), instruction.VaryingData[3].(sc.Sequence[sc.U8]))
+	case xcm.InstructionClearOrigin:
+		ctx.origin = sc.NewOption[xcm.Location](nil)
+		return nil
+	case xcm.InstructionDepositAsset:
+		return e.depositAsset(ctx, instruction.VaryingData[1].(xcm.AssetFilter), instruction.VaryingData[2].(xcm.Location))
+	case xcm.InstructionBuyExecution:
+		return e.buyExecution(ctx, instruction.VaryingData[1].(xcm.Asset), instruction.VaryingData[2].(xcm.WeightLimit))
+	case xcm.InstructionSetTopic:
+		ctx.topic = sc.NewOption[sc.FixedSequence[sc.U8]](instruction.VaryingData[1].(sc.FixedSequence[sc.U8]))
+		return nil
+	case xcm.InstructionClearTopic:
+		ctx.topic = sc.NewOption[sc.FixedSequence[sc.U8]](nil)
+		return nil
+	default:
+		return xcm.NewXcmErrorUnimplemented()
+	}
+}
+
+// withdrawAsset withdraws `assets` from the origin into the holding register.
+func (e Executor) withdrawAsset(ctx *executionContext, assets xcm.Assets) error {
+	if !ctx.origin.HasValue {
+		return xcm.NewXcmErrorBadOrigin()
+	}
+
+	for _, asset := range assets {
+		withdrawn, err := e.assetTransactor.WithdrawAsset(asset, ctx.origin.Value)
+		if err != nil {
+			return err
+		}
+		ctx.holding.subsumeAssets(withdrawn)
+	}
+
+	return nil
+}
+
+// receiveTeleportedAsset places `assets` into the holding register, if the origin is trusted to teleport them.
+func (e Executor) receiveTeleportedAsset(ctx *executionContext, assets xcm.Assets) error {
+	if !ctx.origin.HasValue {
+		return xcm.NewXcmErrorBadOrigin()
+	}
+
+	for _, asset := range assets {
+		if !e.isTeleporter.Contains(asset, ctx.origin.Value) {
+			return xcm.NewXcmErrorUntrustedTeleportLocation()
+		}
+	}
+	ctx.holding.subsumeAssets(assets)
+
+	return nil
+}
+
+// transact dispatches the encoded `call` with the origin of `originKind`. A call, which fails to dispatch,
+// does not fail the instruction. The weight, which was required, but not used by the call, is added to the
+// surplus.
+func (e Executor) transact(ctx *executionContext, originKind sc.U8, requireWeightAtMost primitives.Weight, encodedCall sc.Sequence[sc.U8]) error {
+	if !ctx.origin.HasValue {
+		return xcm.NewXcmErrorBadOrigin()
+	}
+
+	call, err := e.callDecoder.DecodeCall(bytes.NewBuffer(sc.SequenceU8ToBytes(encodedCall)))
+	if err != nil {
+		return xcm.NewXcmErrorFailedToDecode()
+	}
+
+	dispatchOrigin := e.originConverter.ConvertOrigin(ctx.origin.Value, originKind)
+	if !dispatchOrigin.HasValue {
+		return xcm.NewXcmErrorBadOrigin()
+	}
+
+	info := primitives.GetDispatchInfo(call)
+	if info.Weight.AnyGt(requireWeightAtMost) {
+		return xcm.NewXcmErrorMaxWeightInvalid()
+	}
+
+	// The post dispatch info is captured here, since it is discarded when the storage layer is rolled back.
+	var postInfo primitives.PostDispatchInfo
+	_, dispatchErr := e.transactional.WithStorageLayer(func() (primitives.PostDispatchInfo, error) {
+		result, err := call.Dispatch(dispatchOrigin.Value, call.Args())
+		postInfo = result
+		return result, err
+	})
+	if dispatchErr != nil {
+		e.logger.Debugf("transact dispatch failed: %v", dispatchErr)
+	}
+
+	actualWeight := postInfo.CalcActualWeight(&info)
+	ctx.surplus = ctx.surplus.SaturatingAdd(requireWeightAtMost.SaturatingSub(actualWeight))
+
+	return nil
+}
+
+// depositAsset deposits the assets in the holding register, which are matched by `filter`, into `beneficiary`.
+func (e Executor) depositAsset(ctx *executionContext, filter xcm.AssetFilter, beneficiary xcm.Location) error {
+	assets := ctx.holding.saturatingTake(filter)
+
+	for _, asset := range assets {
+		if err := e.assetTransactor.DepositAsset(asset, beneficiary); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buyExecution pays for the execution of the message with `fees` from the holding register. The unspent
+// fees are returned to the register.
+func (e Executor) buyExecution(ctx *executionContext, fees xcm.Asset, weightLimit xcm.WeightLimit) error {
+	limit := weightLimit.Limit()
+	if !limit.HasValue {
+		return nil
+	}
+
+	payment, ok := ctx.holding.tryTake(fees)
+	if !ok {
+		return xcm.NewXcmErrorNotHoldingFees()
+	}
+
+	unspent, err := e.trader.BuyWeight(limit.Value, payment)
+	if err != nil {
+		ctx.holding.subsume(payment)
+		return err
+	}
+	ctx.holding.subsumeAssets(unspent)
+
+	return nil
+}
+
+// dropHolding drops the assets, which are left in the holding register at the end of the execution.
+func (e Executor) dropHolding(ctx *executionContext) {
+	if !ctx.holding.isEmpty() {
+		e.logger.Debugf("dropping %d assets left in the holding register", len(ctx.holding.assets))
+		ctx.holding = holding{}
+	}
+}
+
+func toXcmError(err error) xcm.XcmError {
+	xcmErr, ok := err.(xcm.XcmError)
+	if !ok {
+		return xcm.NewXcmErrorFailedToTransactAsset()
+	}
+	return xcmErr
+}
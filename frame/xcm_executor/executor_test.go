@@ -0,0 +1,344 @@
+package xcm_executor
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	maxInstructions = sc.U32(10)
+	weightLimit     = primitives.WeightFromParts(100_000, 1_000)
+
+	accountLocation     = xcm.NewLocationAccountId32(accountIdBytes)
+	beneficiaryLocation = xcm.NewLocationAccountId32(sc.BytesToFixedSequenceU8(make([]byte, 32)))
+
+	encodedCall = sc.Sequence[sc.U8]{1, 2, 3}
+	callArgs    = sc.NewVaryingData(sc.U8(1))
+	callWeight  = primitives.WeightFromParts(500, 0)
+
+	// targetBarrier allows unpaid execution from the account, so that the instructions can be tested alone.
+	targetBarrier = Barriers{
+		AllowTopLevelPaidExecution{},
+		AllowUnpaidExecutionFrom{Origin: xcm.NewLocationParent()},
+		AllowUnpaidExecutionFrom{Origin: accountLocation},
+	}
+
+	topic     = sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{7}, 32))
+	messageId = primitives.H256{FixedSequence: sc.BytesToFixedSequenceU8(make([]byte, 32))}
+)
+
+var (
+	mockStorage           *mocks.IoStorage
+	mockTransactionBroker *mocks.IoTransactionBroker
+	mockAssetTransactor   *MockAssetTransactor
+	mockRuntimeDecoder    *mocks.RuntimeDecoder
+	mockTransactional     *mocks.IoTransactional[primitives.PostDispatchInfo]
+	mockCall              *mocks.Call
+)
+
+func Test_Executor_Execute_WeightNotComputable(t *testing.T) {
+	target := setupExecutor()
+	message := make(xcm.Xcm, maxInstructions+1)
+	for i := range message {
+		message[i] = xcm.NewInstructionClearOrigin()
+	}
+
+	result := target.Execute(accountLocation, message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeError(xcm.NewXcmErrorWeightNotComputable()), result)
+}
+
+func Test_Executor_Execute_WeightLimitReached(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{xcm.NewInstructionClearOrigin(), xcm.NewInstructionClearTopic()}
+
+	result := target.Execute(accountLocation, message, unitWeightCost, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeError(xcm.NewXcmErrorWeightLimitReached(unitWeightCost.SaturatingMul(2))), result)
+}
+
+func Test_Executor_Execute_Barrier(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{
+		xcm.NewInstructionWithdrawAsset(xcm.Assets{newNativeAsset(5_000)}),
+		xcm.NewInstructionBuyExecution(newNativeAsset(5_000), xcm.NewWeightLimitUnlimited()),
+	}
+
+	result := target.Execute(xcm.NewLocationSibling(1000), message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeError(xcm.NewXcmErrorBarrier()), result)
+	mockAssetTransactor.AssertNotCalled(t, "WithdrawAsset", mock.Anything, mock.Anything)
+}
+
+func Test_Executor_Execute_PaidExecution(t *testing.T) {
+	target := setupExecutor()
+	sibling := xcm.NewLocationSibling(1000)
+	message := xcm.Xcm{
+		xcm.NewInstructionWithdrawAsset(xcm.Assets{newNativeAsset(5_000)}),
+		xcm.NewInstructionBuyExecution(newNativeAsset(5_000), xcm.NewWeightLimitLimited(unitWeightCost.SaturatingMul(3))),
+		xcm.NewInstructionDepositAsset(xcm.NewAssetFilterWild(xcm.NewWildAssetAll()), beneficiaryLocation),
+	}
+	mockAssetTransactor.On("WithdrawAsset", newNativeAsset(5_000), sibling).Return(xcm.Assets{newNativeAsset(5_000)}, nil)
+	mockAssetTransactor.On("DepositAsset", newNativeAsset(2_000), beneficiaryLocation).Return(nil)
+
+	result := target.Execute(sibling, message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeComplete(unitWeightCost.SaturatingMul(3)), result)
+	mockAssetTransactor.AssertExpectations(t)
+}
+
+func Test_Executor_Execute_Unimplemented(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{xcm.Instruction{VaryingData: sc.NewVaryingData(sc.U8(3))}}
+
+	result := target.Execute(xcm.NewLocationParent(), message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeIncomplete(unitWeightCost, xcm.NewXcmErrorUnimplemented()), result)
+}
+
+func Test_Executor_Execute_Teleport(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{
+		xcm.NewInstructionReceiveTeleportedAsset(xcm.Assets{newNativeAsset(1_000)}),
+		xcm.NewInstructionBuyExecution(newNativeAsset(500), xcm.NewWeightLimitLimited(primitives.WeightFromParts(300, 0))),
+		xcm.NewInstructionDepositAsset(xcm.NewAssetFilterWild(xcm.NewWildAssetAllCounted(1)), beneficiaryLocation),
+	}
+	mockAssetTransactor.On("DepositAsset", newNativeAsset(700), beneficiaryLocation).Return(nil)
+
+	result := target.Execute(xcm.NewLocationParent(), message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeComplete(unitWeightCost.SaturatingMul(3)), result)
+	mockAssetTransactor.AssertExpectations(t)
+}
+
+func Test_Executor_Execute_Teleport_UntrustedLocation(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{
+		xcm.NewInstructionReceiveTeleportedAsset(xcm.Assets{newNativeAsset(1_000)}),
+		xcm.NewInstructionDepositAsset(xcm.NewAssetFilterWild(xcm.NewWildAssetAll()), beneficiaryLocation),
+	}
+
+	result := target.Execute(xcm.NewLocationSibling(1000), message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeIncomplete(unitWeightCost.SaturatingMul(2), xcm.NewXcmErrorUntrustedTeleportLocation()), result)
+	mockAssetTransactor.AssertNotCalled(t, "DepositAsset", mock.Anything, mock.Anything)
+}
+
+func Test_Executor_Execute_WithdrawAndDeposit(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{
+		xcm.NewInstructionWithdrawAsset(xcm.Assets{newNativeAsset(100)}),
+		xcm.NewInstructionBuyExecution(newNativeAsset(100), xcm.NewWeightLimitUnlimited()),
+		xcm.NewInstructionDepositAsset(xcm.NewAssetFilterDefinite(xcm.Assets{newNativeAsset(100)}), beneficiaryLocation),
+	}
+	mockAssetTransactor.On("WithdrawAsset", newNativeAsset(100), accountLocation).Return(xcm.Assets{newNativeAsset(100)}, nil)
+	mockAssetTransactor.On("DepositAsset", newNativeAsset(100), beneficiaryLocation).Return(nil)
+
+	result := target.Execute(accountLocation, message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeComplete(unitWeightCost.SaturatingMul(3)), result)
+	mockAssetTransactor.AssertExpectations(t)
+}
+
+func Test_Executor_Execute_WithdrawAsset_Fails(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{xcm.NewInstructionWithdrawAsset(xcm.Assets{newNativeAsset(100)})}
+	mockAssetTransactor.On("WithdrawAsset", newNativeAsset(100), accountLocation).Return(xcm.Assets{}, xcm.NewXcmErrorFailedToTransactAsset())
+
+	result := target.Execute(accountLocation, message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeIncomplete(unitWeightCost, xcm.NewXcmErrorFailedToTransactAsset()), result)
+}
+
+func Test_Executor_Execute_WithdrawAsset_ClearedOrigin(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{
+		xcm.NewInstructionClearOrigin(),
+		xcm.NewInstructionWithdrawAsset(xcm.Assets{newNativeAsset(100)}),
+	}
+
+	result := target.Execute(accountLocation, message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeIncomplete(unitWeightCost.SaturatingMul(2), xcm.NewXcmErrorBadOrigin()), result)
+	mockAssetTransactor.AssertNotCalled(t, "WithdrawAsset", mock.Anything, mock.Anything)
+}
+
+func Test_Executor_Execute_BuyExecution_NotHoldingFees(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{
+		xcm.NewInstructionReceiveTeleportedAsset(xcm.Assets{newNativeAsset(100)}),
+		xcm.NewInstructionBuyExecution(newNativeAsset(101), xcm.NewWeightLimitLimited(primitives.WeightFromParts(1, 0))),
+	}
+
+	result := target.Execute(xcm.NewLocationParent(), message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeIncomplete(unitWeightCost.SaturatingMul(2), xcm.NewXcmErrorNotHoldingFees()), result)
+}
+
+func Test_Executor_Execute_BuyExecution_TooExpensive(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{
+		xcm.NewInstructionReceiveTeleportedAsset(xcm.Assets{newNativeAsset(100)}),
+		xcm.NewInstructionBuyExecution(newNativeAsset(100), xcm.NewWeightLimitLimited(primitives.WeightFromParts(101, 0))),
+	}
+
+	result := target.Execute(xcm.NewLocationParent(), message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeIncomplete(unitWeightCost.SaturatingMul(2), xcm.NewXcmErrorTooExpensive()), result)
+}
+
+func Test_Executor_Execute_Transact(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{xcm.NewInstructionTransact(xcm.OriginKindSovereignAccount, callWeight, encodedCall)}
+	mockRuntimeDecoder.On("DecodeCall", bytes.NewBuffer(sc.SequenceU8ToBytes(encodedCall))).Return(mockCall, nil)
+	expectDispatch(primitives.NewRawOriginSigned(accountId), primitives.PostDispatchInfo{}, nil)
+
+	result := target.Execute(accountLocation, message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeComplete(unitWeightCost.Add(callWeight)), result)
+	mockCall.AssertCalled(t, "Dispatch", primitives.NewRawOriginSigned(accountId), callArgs)
+}
+
+func Test_Executor_Execute_Transact_Surplus(t *testing.T) {
+	target := setupExecutor()
+	requireWeightAtMost := callWeight.Add(primitives.WeightFromParts(200, 0))
+	message := xcm.Xcm{xcm.NewInstructionTransact(xcm.OriginKindSovereignAccount, requireWeightAtMost, encodedCall)}
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, nil)
+	expectDispatch(primitives.NewRawOriginSigned(accountId), primitives.PostDispatchInfo{ActualWeight: sc.NewOption[primitives.Weight](primitives.WeightFromParts(100, 0))}, nil)
+
+	result := target.Execute(accountLocation, message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeComplete(unitWeightCost.Add(primitives.WeightFromParts(100, 0))), result)
+}
+
+func Test_Executor_Execute_Transact_Superuser(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{xcm.NewInstructionTransact(xcm.OriginKindSuperuser, callWeight, encodedCall)}
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, nil)
+	expectDispatch(primitives.NewRawOriginRoot(), primitives.PostDispatchInfo{}, nil)
+
+	result := target.Execute(xcm.NewLocationParent(), message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeComplete(unitWeightCost.Add(callWeight)), result)
+	mockCall.AssertCalled(t, "Dispatch", primitives.NewRawOriginRoot(), callArgs)
+}
+
+func Test_Executor_Execute_Transact_DispatchFails(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{xcm.NewInstructionTransact(xcm.OriginKindSovereignAccount, callWeight, encodedCall)}
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, nil)
+	expectDispatch(primitives.NewRawOriginSigned(accountId), primitives.PostDispatchInfo{}, primitives.NewDispatchErrorBadOrigin())
+
+	result := target.Execute(accountLocation, message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeComplete(unitWeightCost.Add(callWeight)), result)
+}
+
+func Test_Executor_Execute_Transact_FailedToDecode(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{xcm.NewInstructionTransact(xcm.OriginKindSovereignAccount, callWeight, encodedCall)}
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, assert.AnError)
+
+	result := target.Execute(accountLocation, message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeIncomplete(unitWeightCost.Add(callWeight), xcm.NewXcmErrorFailedToDecode()), result)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func Test_Executor_Execute_Transact_BadOrigin(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{xcm.NewInstructionTransact(xcm.OriginKindSuperuser, callWeight, encodedCall)}
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, nil)
+
+	result := target.Execute(accountLocation, message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeIncomplete(unitWeightCost.Add(callWeight), xcm.NewXcmErrorBadOrigin()), result)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func Test_Executor_Execute_Transact_MaxWeightInvalid(t *testing.T) {
+	target := setupExecutor()
+	message := xcm.Xcm{xcm.NewInstructionTransact(xcm.OriginKindSovereignAccount, callWeight.Sub(primitives.WeightFromParts(1, 0)), encodedCall)}
+	mockRuntimeDecoder.On("DecodeCall", mock.Anything).Return(mockCall, nil)
+	expectCallWeight(callWeight)
+
+	result := target.Execute(accountLocation, message, weightLimit, &messageId)
+
+	assert.Equal(t, xcm.NewOutcomeIncomplete(unitWeightCost.Add(callWeight.Sub(primitives.WeightFromParts(1, 0))), xcm.NewXcmErrorMaxWeightInvalid()), result)
+	mockTransactional.AssertNotCalled(t, "WithStorageLayer", mock.Anything)
+}
+
+func Test_Executor_Execute_SetTopic(t *testing.T) {
+	target := setupExecutor()
+	id := messageId
+	message := xcm.Xcm{xcm.NewInstructionSetTopic(topic)}
+
+	result := target.Execute(accountLocation, message, weightLimit, &id)
+
+	assert.Equal(t, xcm.NewOutcomeComplete(unitWeightCost), result)
+	assert.Equal(t, primitives.H256{FixedSequence: topic}, id)
+}
+
+func Test_Executor_Execute_ClearTopic(t *testing.T) {
+	target := setupExecutor()
+	id := messageId
+	message := xcm.Xcm{xcm.NewInstructionSetTopic(topic), xcm.NewInstructionClearTopic()}
+
+	result := target.Execute(accountLocation, message, weightLimit, &id)
+
+	assert.Equal(t, xcm.NewOutcomeComplete(unitWeightCost.SaturatingMul(2)), result)
+	assert.Equal(t, messageId, id)
+}
+
+func setupExecutor() Executor {
+	mockStorage = new(mocks.IoStorage)
+	mockTransactionBroker = new(mocks.IoTransactionBroker)
+	mockAssetTransactor = new(MockAssetTransactor)
+	mockRuntimeDecoder = new(mocks.RuntimeDecoder)
+	mockTransactional = new(mocks.IoTransactional[primitives.PostDispatchInfo])
+	mockCall = new(mocks.Call)
+
+	config := NewConfig(
+		mockStorage,
+		mockTransactionBroker,
+		mockAssetTransactor,
+		targetOriginConverter,
+		Case{AssetId: nativeAssetId, Origin: xcm.NewLocationParent()},
+		targetBarrier,
+		FixedWeightBounds{UnitWeightCost: unitWeightCost, MaxInstructions: maxInstructions},
+		targetTrader,
+		mockRuntimeDecoder,
+	)
+
+	target := New(config, log.NewLogger())
+	target.transactional = mockTransactional
+
+	return target
+}
+
+// expectCallWeight expects the dispatch info of the call to be calculated.
+func expectCallWeight(weight primitives.Weight) {
+	mockCall.On("BaseWeight").Return(weight)
+	mockCall.On("WeighData", weight).Return(weight)
+	mockCall.On("ClassifyDispatch", weight).Return(primitives.NewDispatchClassNormal())
+	mockCall.On("PaysFee", weight).Return(primitives.PaysYes)
+}
+
+// expectDispatch expects the call to be dispatched on behalf of `origin` in a new storage layer.
+func expectDispatch(origin primitives.RawOrigin, result primitives.PostDispatchInfo, err error) {
+	expectCallWeight(callWeight)
+	mockCall.On("Args").Return(callArgs)
+	mockCall.On("Dispatch", origin, callArgs).Return(result, err)
+	mockTransactional.On("WithStorageLayer", mock.Anything).Run(func(args mock.Arguments) {
+		fn := args.Get(0).(func() (primitives.PostDispatchInfo, error))
+		fn()
+	}).Return(result, err)
+}
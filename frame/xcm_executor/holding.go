@@ -0,0 +1,127 @@
+package xcm_executor
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+)
+
+// holding is the holding register of the executor. It holds the assets, which are withdrawn or received
+// during the execution of a message, until they are deposited. Fungible assets of the same class are merged
+// into a single asset.
+type holding struct {
+	assets xcm.Assets
+}
+
+func (h *holding) isEmpty() bool {
+	return len(h.assets) == 0
+}
+
+// subsume places `asset` in the register.
+func (h *holding) subsume(asset xcm.Asset) {
+	if amount, err := asset.Fun.AsFungible(); err == nil {
+		for i, held := range h.assets {
+			if heldAmount, err := held.Fun.AsFungible(); err == nil && held.Id.Equal(asset.Id) {
+				h.assets[i] = xcm.NewAssetFungible(held.Id, sc.SaturatingAddU128(heldAmount, amount))
+				return
+			}
+		}
+		if amount.Eq(sc.NewU128(0)) {
+			return
+		}
+	} else {
+		for _, held := range h.assets {
+			if bytes.Equal(held.Bytes(), asset.Bytes()) {
+				return
+			}
+		}
+	}
+
+	h.assets = append(h.assets, asset)
+}
+
+func (h *holding) subsumeAssets(assets xcm.Assets) {
+	for _, asset := range assets {
+		h.subsume(asset)
+	}
+}
+
+// tryTake takes `asset` from the register. Fails if the register does not hold all of it.
+func (h *holding) tryTake(asset xcm.Asset) (xcm.Asset, bool) {
+	taken, ok := h.take(asset, false)
+	return taken, ok
+}
+
+// saturatingTake takes the assets, which are matched by `filter`, from the register. Definite assets are
+// taken up to the held amount.
+func (h *holding) saturatingTake(filter xcm.AssetFilter) xcm.Assets {
+	taken := xcm.Assets{}
+
+	if assets, err := filter.AsDefinite(); err == nil {
+		for _, asset := range assets {
+			if result, ok := h.take(asset, true); ok {
+				taken = append(taken, result)
+			}
+		}
+		return taken
+	}
+
+	wild, err := filter.AsWild()
+	if err != nil {
+		return taken
+	}
+
+	limit := wild.Limit()
+	retained := xcm.Assets{}
+	for _, held := range h.assets {
+		if wild.Matches(held) && (!limit.HasValue || sc.U32(len(taken)) < limit.Value) {
+			taken = append(taken, held)
+		} else {
+			retained = append(retained, held)
+		}
+	}
+	h.assets = retained
+
+	return taken
+}
+
+// take removes `asset` from the register. If `saturating` is set, fungible assets are taken up to the held
+// amount, otherwise the register must hold all of it.
+func (h *holding) take(asset xcm.Asset, saturating bool) (xcm.Asset, bool) {
+	amount, err := asset.Fun.AsFungible()
+	for i, held := range h.assets {
+		if err != nil {
+			if bytes.Equal(held.Bytes(), asset.Bytes()) {
+				h.remove(i)
+				return held, true
+			}
+			continue
+		}
+
+		heldAmount, heldErr := held.Fun.AsFungible()
+		if heldErr != nil || !held.Id.Equal(asset.Id) {
+			continue
+		}
+		if heldAmount.Lt(amount) {
+			if !saturating {
+				return xcm.Asset{}, false
+			}
+			amount = heldAmount
+		}
+
+		remaining := heldAmount.Sub(amount)
+		if remaining.Eq(sc.NewU128(0)) {
+			h.remove(i)
+		} else {
+			h.assets[i] = xcm.NewAssetFungible(held.Id, remaining)
+		}
+		return xcm.NewAssetFungible(held.Id, amount), true
+	}
+
+	return xcm.Asset{}, false
+}
+
+func (h *holding) remove(index int) {
+	h.assets = append(h.assets[:index], h.assets[index+1:]...)
+}
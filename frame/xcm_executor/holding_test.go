@@ -0,0 +1,105 @@
+package xcm_executor
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	nativeAssetId = xcm.NewAssetId(xcm.NewLocationParent())
+	otherAssetId  = xcm.NewAssetId(xcm.NewLocationSibling(2000))
+)
+
+func newNativeAsset(amount uint64) xcm.Asset {
+	return xcm.NewAssetFungible(nativeAssetId, sc.NewU128(amount))
+}
+
+func newOtherAsset(amount uint64) xcm.Asset {
+	return xcm.NewAssetFungible(otherAssetId, sc.NewU128(amount))
+}
+
+func Test_Holding_Subsume(t *testing.T) {
+	target := holding{}
+
+	target.subsume(newNativeAsset(10))
+	target.subsume(newOtherAsset(5))
+	target.subsume(newNativeAsset(20))
+	target.subsume(newOtherAsset(0))
+
+	assert.Equal(t, xcm.Assets{newNativeAsset(30), newOtherAsset(5)}, target.assets)
+}
+
+func Test_Holding_Subsume_ZeroAmount(t *testing.T) {
+	target := holding{}
+
+	target.subsume(newNativeAsset(0))
+
+	assert.True(t, target.isEmpty())
+}
+
+func Test_Holding_TryTake(t *testing.T) {
+	target := holding{assets: xcm.Assets{newNativeAsset(30), newOtherAsset(5)}}
+
+	taken, ok := target.tryTake(newNativeAsset(10))
+
+	assert.True(t, ok)
+	assert.Equal(t, newNativeAsset(10), taken)
+	assert.Equal(t, xcm.Assets{newNativeAsset(20), newOtherAsset(5)}, target.assets)
+
+	taken, ok = target.tryTake(newOtherAsset(5))
+
+	assert.True(t, ok)
+	assert.Equal(t, newOtherAsset(5), taken)
+	assert.Equal(t, xcm.Assets{newNativeAsset(20)}, target.assets)
+}
+
+func Test_Holding_TryTake_NotEnough(t *testing.T) {
+	target := holding{assets: xcm.Assets{newNativeAsset(30)}}
+
+	_, ok := target.tryTake(newNativeAsset(31))
+	assert.False(t, ok)
+
+	_, ok = target.tryTake(newOtherAsset(1))
+	assert.False(t, ok)
+
+	assert.Equal(t, xcm.Assets{newNativeAsset(30)}, target.assets)
+}
+
+func Test_Holding_SaturatingTake_Definite(t *testing.T) {
+	target := holding{assets: xcm.Assets{newNativeAsset(30), newOtherAsset(5)}}
+
+	result := target.saturatingTake(xcm.NewAssetFilterDefinite(xcm.Assets{newNativeAsset(10), newOtherAsset(10)}))
+
+	assert.Equal(t, xcm.Assets{newNativeAsset(10), newOtherAsset(5)}, result)
+	assert.Equal(t, xcm.Assets{newNativeAsset(20)}, target.assets)
+}
+
+func Test_Holding_SaturatingTake_WildAll(t *testing.T) {
+	target := holding{assets: xcm.Assets{newNativeAsset(30), newOtherAsset(5)}}
+
+	result := target.saturatingTake(xcm.NewAssetFilterWild(xcm.NewWildAssetAll()))
+
+	assert.Equal(t, xcm.Assets{newNativeAsset(30), newOtherAsset(5)}, result)
+	assert.True(t, target.isEmpty())
+}
+
+func Test_Holding_SaturatingTake_WildAllCounted(t *testing.T) {
+	target := holding{assets: xcm.Assets{newNativeAsset(30), newOtherAsset(5)}}
+
+	result := target.saturatingTake(xcm.NewAssetFilterWild(xcm.NewWildAssetAllCounted(1)))
+
+	assert.Equal(t, xcm.Assets{newNativeAsset(30)}, result)
+	assert.Equal(t, xcm.Assets{newOtherAsset(5)}, target.assets)
+}
+
+func Test_Holding_SaturatingTake_WildAllOf(t *testing.T) {
+	target := holding{assets: xcm.Assets{newNativeAsset(30), newOtherAsset(5)}}
+
+	result := target.saturatingTake(xcm.NewAssetFilterWild(xcm.NewWildAssetAllOf(otherAssetId, xcm.WildFungibilityFungible)))
+
+	assert.Equal(t, xcm.Assets{newOtherAsset(5)}, result)
+	assert.Equal(t, xcm.Assets{newNativeAsset(30)}, target.assets)
+}
@@ -0,0 +1,88 @@
+package xcm_executor
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+)
+
+// LocationConverter converts a location to the local account, which it controls.
+type LocationConverter interface {
+	// ConvertLocation returns the account of `location`, if it is recognised by the converter.
+	ConvertLocation(location xcm.Location) sc.Option[primitives.AccountId]
+}
+
+// AccountId32Aliases converts local 32 byte accounts without a network, or on `Network` if it is set,
+// into the account with the same id.
+type AccountId32Aliases struct {
+	Network sc.Option[xcm.NetworkId]
+}
+
+func (c AccountId32Aliases) ConvertLocation(location xcm.Location) sc.Option[primitives.AccountId] {
+	if location.Parents != 0 || len(location.Interior) != 1 {
+		return sc.NewOption[primitives.AccountId](nil)
+	}
+
+	network, id, err := location.Interior[0].AsAccountId32()
+	if err != nil {
+		return sc.NewOption[primitives.AccountId](nil)
+	}
+	if network.HasValue && !(c.Network.HasValue && bytes.Equal(network.Value.Bytes(), c.Network.Value.Bytes())) {
+		return sc.NewOption[primitives.AccountId](nil)
+	}
+
+	return toAccountId(sc.FixedSequenceU8ToBytes(id))
+}
+
+// ParentIsPreset converts the parent location into the account, whose id is "Parent", followed by zeros.
+type ParentIsPreset struct{}
+
+func (c ParentIsPreset) ConvertLocation(location xcm.Location) sc.Option[primitives.AccountId] {
+	if !location.IsParent() {
+		return sc.NewOption[primitives.AccountId](nil)
+	}
+	return toAccountId([]byte("Parent"))
+}
+
+// SiblingParachainConvertsVia converts the location of a sibling parachain into its sovereign account, whose id is
+// "sibl", followed by the encoded parachain id and zeros.
+type SiblingParachainConvertsVia struct{}
+
+func (c SiblingParachainConvertsVia) ConvertLocation(location xcm.Location) sc.Option[primitives.AccountId] {
+	if location.Parents != 1 || len(location.Interior) != 1 {
+		return sc.NewOption[primitives.AccountId](nil)
+	}
+
+	paraId, err := location.Interior[0].AsParachain()
+	if err != nil {
+		return sc.NewOption[primitives.AccountId](nil)
+	}
+
+	return toAccountId(append([]byte("sibl"), paraId.Bytes()...))
+}
+
+// LocationConverters tries each converter in order and returns the first converted account.
+type LocationConverters []LocationConverter
+
+func (c LocationConverters) ConvertLocation(location xcm.Location) sc.Option[primitives.AccountId] {
+	for _, converter := range c {
+		if account := converter.ConvertLocation(location); account.HasValue {
+			return account
+		}
+	}
+	return sc.NewOption[primitives.AccountId](nil)
+}
+
+// toAccountId returns the account, whose id is `prefix` followed by zeros.
+func toAccountId(prefix []byte) sc.Option[primitives.AccountId] {
+	id := make([]byte, 32)
+	copy(id, prefix)
+
+	accountId, err := primitives.NewAccountId(sc.BytesToSequenceU8(id)...)
+	if err != nil {
+		return sc.NewOption[primitives.AccountId](nil)
+	}
+	return sc.NewOption[primitives.AccountId](accountId)
+}
@@ -0,0 +1,72 @@
+package xcm_executor
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	accountIdBytes = sc.BytesToFixedSequenceU8([]byte{
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	})
+	accountId, _ = primitives.NewAccountId(accountIdBytes...)
+
+	parentAccountId, _  = primitives.NewAccountId(sc.BytesToSequenceU8(append([]byte("Parent"), make([]byte, 26)...))...)
+	siblingAccountId, _ = primitives.NewAccountId(sc.BytesToSequenceU8(append([]byte{'s', 'i', 'b', 'l', 0xe8, 0x03, 0, 0}, make([]byte, 24)...))...)
+)
+
+func Test_AccountId32Aliases_ConvertLocation(t *testing.T) {
+	target := AccountId32Aliases{Network: sc.NewOption[xcm.NetworkId](xcm.NewNetworkIdPolkadot())}
+
+	assert.Equal(t, sc.NewOption[primitives.AccountId](accountId), target.ConvertLocation(xcm.NewLocationAccountId32(accountIdBytes)))
+
+	onNetwork := xcm.Location{Interior: xcm.Junctions{xcm.NewJunctionAccountId32(sc.NewOption[xcm.NetworkId](xcm.NewNetworkIdPolkadot()), accountIdBytes)}}
+	assert.Equal(t, sc.NewOption[primitives.AccountId](accountId), target.ConvertLocation(onNetwork))
+}
+
+func Test_AccountId32Aliases_ConvertLocation_OtherNetwork(t *testing.T) {
+	target := AccountId32Aliases{Network: sc.NewOption[xcm.NetworkId](nil)}
+
+	location := xcm.Location{Interior: xcm.Junctions{xcm.NewJunctionAccountId32(sc.NewOption[xcm.NetworkId](xcm.NewNetworkIdKusama()), accountIdBytes)}}
+
+	assert.Equal(t, sc.NewOption[primitives.AccountId](nil), target.ConvertLocation(location))
+}
+
+func Test_AccountId32Aliases_ConvertLocation_NotLocal(t *testing.T) {
+	target := AccountId32Aliases{Network: sc.NewOption[xcm.NetworkId](nil)}
+
+	location := xcm.NewLocationAccountId32(accountIdBytes)
+	location.Parents = 1
+
+	assert.Equal(t, sc.NewOption[primitives.AccountId](nil), target.ConvertLocation(location))
+	assert.Equal(t, sc.NewOption[primitives.AccountId](nil), target.ConvertLocation(xcm.NewLocationParent()))
+}
+
+func Test_ParentIsPreset_ConvertLocation(t *testing.T) {
+	target := ParentIsPreset{}
+
+	assert.Equal(t, sc.NewOption[primitives.AccountId](parentAccountId), target.ConvertLocation(xcm.NewLocationParent()))
+	assert.Equal(t, sc.NewOption[primitives.AccountId](nil), target.ConvertLocation(xcm.NewLocationSibling(1000)))
+}
+
+func Test_SiblingParachainConvertsVia_ConvertLocation(t *testing.T) {
+	target := SiblingParachainConvertsVia{}
+
+	assert.Equal(t, sc.NewOption[primitives.AccountId](siblingAccountId), target.ConvertLocation(xcm.NewLocationSibling(1000)))
+	assert.Equal(t, sc.NewOption[primitives.AccountId](nil), target.ConvertLocation(xcm.NewLocationParent()))
+	assert.Equal(t, sc.NewOption[primitives.AccountId](nil), target.ConvertLocation(xcm.NewLocationAccountId32(accountIdBytes)))
+}
+
+func Test_LocationConverters_ConvertLocation(t *testing.T) {
+	target := LocationConverters{ParentIsPreset{}, SiblingParachainConvertsVia{}, AccountId32Aliases{Network: sc.NewOption[xcm.NetworkId](nil)}}
+
+	assert.Equal(t, sc.NewOption[primitives.AccountId](parentAccountId), target.ConvertLocation(xcm.NewLocationParent()))
+	assert.Equal(t, sc.NewOption[primitives.AccountId](siblingAccountId), target.ConvertLocation(xcm.NewLocationSibling(1000)))
+	assert.Equal(t, sc.NewOption[primitives.AccountId](accountId), target.ConvertLocation(xcm.NewLocationAccountId32(accountIdBytes)))
+	assert.Equal(t, sc.NewOption[primitives.AccountId](nil), target.ConvertLocation(xcm.NewLocationHere()))
+}
@@ -0,0 +1,78 @@
+package xcm_executor
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/message_queue"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+)
+
+// ProcessXcmMessage processes the messages of the message queue with the XCM executor. A message may
+// consist of several concatenated versioned XCM programs, as it is enqueued by the XCMP queue.
+type ProcessXcmMessage struct {
+	executor Executor
+}
+
+func NewProcessXcmMessage(executor Executor) ProcessXcmMessage {
+	return ProcessXcmMessage{executor}
+}
+
+func (p ProcessXcmMessage) ProcessMessage(message sc.Sequence[sc.U8], origin message_queue.MessageOrigin, meter *primitives.WeightMeter, id *primitives.H256) (bool, error) {
+	programs, err := decodeVersionedXcms(message)
+	if err != nil {
+		return false, message_queue.NewProcessMessageErrorCorrupt()
+	}
+
+	location := toLocation(origin)
+
+	required := primitives.WeightZero()
+	for _, program := range programs {
+		weight, err := p.executor.Weight(program.Xcm)
+		if err != nil {
+			return false, message_queue.NewProcessMessageErrorUnsupported()
+		}
+		required = required.SaturatingAdd(weight)
+	}
+	if !meter.CanConsume(required) {
+		return false, message_queue.NewProcessMessageErrorOverweight(required)
+	}
+
+	success := true
+	for _, program := range programs {
+		outcome := p.executor.Execute(location, program.Xcm, meter.Remaining(), id)
+		meter.Consume(outcome.WeightUsed())
+		success = success && outcome.IsComplete()
+	}
+
+	return success, nil
+}
+
+// decodeVersionedXcms decodes the versioned programs of `message`, until it is consumed.
+func decodeVersionedXcms(message sc.Sequence[sc.U8]) ([]xcm.VersionedXcm, error) {
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(message))
+
+	var programs []xcm.VersionedXcm
+	for buffer.Len() > 0 {
+		program, err := xcm.DecodeVersionedXcm(buffer)
+		if err != nil {
+			return nil, err
+		}
+		programs = append(programs, program)
+	}
+
+	return programs, nil
+}
+
+// toLocation returns the location of the queue `origin`, relative to the local chain.
+func toLocation(origin message_queue.MessageOrigin) xcm.Location {
+	switch origin.VaryingData[0] {
+	case message_queue.MessageOriginParent:
+		return xcm.NewLocationParent()
+	case message_queue.MessageOriginSibling:
+		return xcm.NewLocationSibling(origin.VaryingData[1].(sc.U32))
+	default:
+		return xcm.NewLocationHere()
+	}
+}
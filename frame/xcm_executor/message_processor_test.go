@@ -0,0 +1,103 @@
+package xcm_executor
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/message_queue"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ProcessXcmMessage_ProcessMessage(t *testing.T) {
+	target := NewProcessXcmMessage(setupExecutor())
+	message := encodeVersionedXcms(
+		xcm.Xcm{xcm.NewInstructionClearOrigin()},
+		xcm.Xcm{xcm.NewInstructionSetTopic(topic), xcm.NewInstructionClearOrigin()},
+	)
+	meter := primitives.NewWeightMeter(weightLimit)
+	id := messageId
+
+	result, err := target.ProcessMessage(message, message_queue.NewMessageOriginParent(), &meter, &id)
+
+	assert.NoError(t, err)
+	assert.True(t, result)
+	assert.Equal(t, unitWeightCost.SaturatingMul(3), meter.Consumed)
+	assert.Equal(t, primitives.H256{FixedSequence: topic}, id)
+}
+
+func Test_ProcessXcmMessage_ProcessMessage_Incomplete(t *testing.T) {
+	target := NewProcessXcmMessage(setupExecutor())
+	message := encodeVersionedXcms(
+		xcm.Xcm{
+			xcm.NewInstructionReceiveTeleportedAsset(xcm.Assets{newNativeAsset(5_000)}),
+			xcm.NewInstructionBuyExecution(newNativeAsset(5_000), xcm.NewWeightLimitLimited(unitWeightCost.SaturatingMul(2))),
+		},
+		xcm.Xcm{xcm.NewInstructionClearOrigin()},
+	)
+	meter := primitives.NewWeightMeter(weightLimit)
+
+	result, err := target.ProcessMessage(message, message_queue.NewMessageOriginSibling(1000), &meter, &messageId)
+
+	assert.NoError(t, err)
+	assert.False(t, result)
+	assert.Equal(t, unitWeightCost.SaturatingMul(2), meter.Consumed)
+}
+
+func Test_ProcessXcmMessage_ProcessMessage_Corrupt(t *testing.T) {
+	target := NewProcessXcmMessage(setupExecutor())
+	message := append(encodeVersionedXcms(xcm.Xcm{xcm.NewInstructionClearOrigin()}), xcm.VersionV4)
+	meter := primitives.NewWeightMeter(weightLimit)
+
+	result, err := target.ProcessMessage(message, message_queue.NewMessageOriginParent(), &meter, &messageId)
+
+	assert.Equal(t, message_queue.NewProcessMessageErrorCorrupt(), err)
+	assert.False(t, result)
+	assert.Equal(t, primitives.WeightZero(), meter.Consumed)
+}
+
+func Test_ProcessXcmMessage_ProcessMessage_Unsupported(t *testing.T) {
+	target := NewProcessXcmMessage(setupExecutor())
+	program := make(xcm.Xcm, maxInstructions+1)
+	for i := range program {
+		program[i] = xcm.NewInstructionClearOrigin()
+	}
+	meter := primitives.NewWeightMeter(weightLimit)
+
+	result, err := target.ProcessMessage(encodeVersionedXcms(program), message_queue.NewMessageOriginParent(), &meter, &messageId)
+
+	assert.Equal(t, message_queue.NewProcessMessageErrorUnsupported(), err)
+	assert.False(t, result)
+}
+
+func Test_ProcessXcmMessage_ProcessMessage_Overweight(t *testing.T) {
+	target := NewProcessXcmMessage(setupExecutor())
+	message := encodeVersionedXcms(
+		xcm.Xcm{xcm.NewInstructionClearOrigin()},
+		xcm.Xcm{xcm.NewInstructionClearOrigin()},
+	)
+	meter := primitives.NewWeightMeter(unitWeightCost)
+
+	result, err := target.ProcessMessage(message, message_queue.NewMessageOriginParent(), &meter, &messageId)
+
+	assert.Equal(t, message_queue.NewProcessMessageErrorOverweight(unitWeightCost.SaturatingMul(2)), err)
+	assert.False(t, result)
+	assert.Equal(t, primitives.WeightZero(), meter.Consumed)
+}
+
+func Test_toLocation(t *testing.T) {
+	assert.Equal(t, xcm.NewLocationHere(), toLocation(message_queue.NewMessageOriginHere()))
+	assert.Equal(t, xcm.NewLocationParent(), toLocation(message_queue.NewMessageOriginParent()))
+	assert.Equal(t, xcm.NewLocationSibling(1000), toLocation(message_queue.NewMessageOriginSibling(1000)))
+}
+
+// encodeVersionedXcms concatenates `programs` as versioned programs, in the way they are enqueued by the XCMP queue.
+func encodeVersionedXcms(programs ...xcm.Xcm) sc.Sequence[sc.U8] {
+	var message []byte
+	for _, program := range programs {
+		versioned, _ := xcm.NewVersionedXcm(xcm.VersionV4, program)
+		message = append(message, versioned.Bytes()...)
+	}
+	return sc.BytesToSequenceU8(message)
+}
@@ -0,0 +1,39 @@
+package xcm_executor
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+)
+
+// OriginConverter converts the origin of a message into the origin, with which `Transact` dispatches calls.
+type OriginConverter interface {
+	// ConvertOrigin returns the dispatch origin of `origin` for `kind`, if it is allowed.
+	ConvertOrigin(origin xcm.Location, kind sc.U8) sc.Option[primitives.RawOrigin]
+}
+
+// SovereignSignedViaLocation converts the origin into the signed origin of its sovereign account for the
+// `SovereignAccount` kind. If `ParentIsSuperuser` is set, the parent is converted into the root origin for
+// the `Superuser` kind.
+type SovereignSignedViaLocation struct {
+	LocationConverter LocationConverter
+	ParentIsSuperuser bool
+}
+
+func (c SovereignSignedViaLocation) ConvertOrigin(origin xcm.Location, kind sc.U8) sc.Option[primitives.RawOrigin] {
+	switch kind {
+	case xcm.OriginKindSovereignAccount:
+		account := c.LocationConverter.ConvertLocation(origin)
+		if !account.HasValue {
+			return sc.NewOption[primitives.RawOrigin](nil)
+		}
+		return sc.NewOption[primitives.RawOrigin](primitives.NewRawOriginSigned(account.Value))
+	case xcm.OriginKindSuperuser:
+		if c.ParentIsSuperuser && origin.IsParent() {
+			return sc.NewOption[primitives.RawOrigin](primitives.NewRawOriginRoot())
+		}
+		return sc.NewOption[primitives.RawOrigin](nil)
+	default:
+		return sc.NewOption[primitives.RawOrigin](nil)
+	}
+}
@@ -0,0 +1,55 @@
+package xcm_executor
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	targetOriginConverter = SovereignSignedViaLocation{
+		LocationConverter: LocationConverters{ParentIsPreset{}, AccountId32Aliases{Network: sc.NewOption[xcm.NetworkId](nil)}},
+		ParentIsSuperuser: true,
+	}
+)
+
+func Test_SovereignSignedViaLocation_ConvertOrigin_SovereignAccount(t *testing.T) {
+	result := targetOriginConverter.ConvertOrigin(xcm.NewLocationAccountId32(accountIdBytes), xcm.OriginKindSovereignAccount)
+
+	assert.Equal(t, sc.NewOption[primitives.RawOrigin](primitives.NewRawOriginSigned(accountId)), result)
+}
+
+func Test_SovereignSignedViaLocation_ConvertOrigin_SovereignAccount_Unknown(t *testing.T) {
+	result := targetOriginConverter.ConvertOrigin(xcm.NewLocationSibling(1000), xcm.OriginKindSovereignAccount)
+
+	assert.Equal(t, sc.NewOption[primitives.RawOrigin](nil), result)
+}
+
+func Test_SovereignSignedViaLocation_ConvertOrigin_Superuser(t *testing.T) {
+	result := targetOriginConverter.ConvertOrigin(xcm.NewLocationParent(), xcm.OriginKindSuperuser)
+
+	assert.Equal(t, sc.NewOption[primitives.RawOrigin](primitives.NewRawOriginRoot()), result)
+}
+
+func Test_SovereignSignedViaLocation_ConvertOrigin_Superuser_NotParent(t *testing.T) {
+	result := targetOriginConverter.ConvertOrigin(xcm.NewLocationAccountId32(accountIdBytes), xcm.OriginKindSuperuser)
+
+	assert.Equal(t, sc.NewOption[primitives.RawOrigin](nil), result)
+}
+
+func Test_SovereignSignedViaLocation_ConvertOrigin_Superuser_Disabled(t *testing.T) {
+	target := SovereignSignedViaLocation{LocationConverter: ParentIsPreset{}}
+
+	result := target.ConvertOrigin(xcm.NewLocationParent(), xcm.OriginKindSuperuser)
+
+	assert.Equal(t, sc.NewOption[primitives.RawOrigin](nil), result)
+}
+
+func Test_SovereignSignedViaLocation_ConvertOrigin_Native(t *testing.T) {
+	result := targetOriginConverter.ConvertOrigin(xcm.NewLocationAccountId32(accountIdBytes), xcm.OriginKindNative)
+
+	assert.Equal(t, sc.NewOption[primitives.RawOrigin](nil), result)
+}
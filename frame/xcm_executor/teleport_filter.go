@@ -0,0 +1,28 @@
+package xcm_executor
+
+import (
+	"github.com/LimeChain/gosemble/primitives/xcm"
+)
+
+// TeleportFilter decides, which origins are trusted to teleport assets.
+type TeleportFilter interface {
+	// Contains returns whether `origin` is trusted to teleport `asset`.
+	Contains(asset xcm.Asset, origin xcm.Location) bool
+}
+
+// Case trusts `Origin` to teleport the asset `AssetId`.
+type Case struct {
+	AssetId xcm.AssetId
+	Origin  xcm.Location
+}
+
+func (c Case) Contains(asset xcm.Asset, origin xcm.Location) bool {
+	return asset.Id.Equal(c.AssetId) && origin.Equal(c.Origin)
+}
+
+// NoTeleports does not trust any origin to teleport assets.
+type NoTeleports struct{}
+
+func (NoTeleports) Contains(_ xcm.Asset, _ xcm.Location) bool {
+	return false
+}
@@ -0,0 +1,36 @@
+package xcm_executor
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+)
+
+// Weigher computes the weight of XCM programs before they are executed.
+type Weigher interface {
+	// Weight returns the weight of `message`, or an error if it cannot be computed.
+	Weight(message xcm.Xcm) (primitives.Weight, error)
+}
+
+// FixedWeightBounds weighs each instruction with `UnitWeightCost` and rejects programs with more than
+// `MaxInstructions` instructions. A Transact instruction is additionally weighed with the weight, which
+// its call requires at most.
+type FixedWeightBounds struct {
+	UnitWeightCost  primitives.Weight
+	MaxInstructions sc.U32
+}
+
+func (w FixedWeightBounds) Weight(message xcm.Xcm) (primitives.Weight, error) {
+	if sc.U32(len(message)) > w.MaxInstructions {
+		return primitives.Weight{}, xcm.NewXcmErrorWeightNotComputable()
+	}
+
+	weight := w.UnitWeightCost.SaturatingMul(sc.U64(len(message)))
+	for _, instruction := range message {
+		if instruction.VaryingData[0] == xcm.InstructionTransact {
+			weight = weight.SaturatingAdd(instruction.VaryingData[2].(primitives.Weight))
+		}
+	}
+
+	return weight, nil
+}
@@ -0,0 +1,37 @@
+package xcm_executor
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	unitWeightCost = primitives.WeightFromParts(1_000, 10)
+	targetWeigher  = FixedWeightBounds{UnitWeightCost: unitWeightCost, MaxInstructions: 2}
+)
+
+func Test_FixedWeightBounds_Weight(t *testing.T) {
+	result, err := targetWeigher.Weight(xcm.Xcm{xcm.NewInstructionClearOrigin(), xcm.NewInstructionClearTopic()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.WeightFromParts(2_000, 20), result)
+}
+
+func Test_FixedWeightBounds_Weight_Transact(t *testing.T) {
+	requireWeightAtMost := primitives.WeightFromParts(500, 5)
+
+	result, err := targetWeigher.Weight(xcm.Xcm{xcm.NewInstructionTransact(xcm.OriginKindSovereignAccount, requireWeightAtMost, sc.Sequence[sc.U8]{})})
+
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.WeightFromParts(1_500, 15), result)
+}
+
+func Test_FixedWeightBounds_Weight_TooManyInstructions(t *testing.T) {
+	_, err := targetWeigher.Weight(xcm.Xcm{xcm.NewInstructionClearOrigin(), xcm.NewInstructionClearOrigin(), xcm.NewInstructionClearTopic()})
+
+	assert.Equal(t, xcm.NewXcmErrorWeightNotComputable(), err)
+}
@@ -0,0 +1,41 @@
+package xcm_executor
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+)
+
+// WeightTrader sells the weight for the execution of a message.
+type WeightTrader interface {
+	// BuyWeight buys `weight` with `payment` and returns the unspent part of `payment`.
+	BuyWeight(weight primitives.Weight, payment xcm.Asset) (xcm.Assets, error)
+}
+
+// UsingComponents sells weight for the fungible asset `AssetId`, at the price of `WeightToFee`.
+// The fees are burned, since the payment has already been withdrawn from its owner.
+type UsingComponents struct {
+	WeightToFee primitives.WeightToFee
+	AssetId     xcm.AssetId
+}
+
+func (t UsingComponents) BuyWeight(weight primitives.Weight, payment xcm.Asset) (xcm.Assets, error) {
+	if !payment.Id.Equal(t.AssetId) {
+		return nil, xcm.NewXcmErrorTooExpensive()
+	}
+	amount, err := payment.Fun.AsFungible()
+	if err != nil {
+		return nil, xcm.NewXcmErrorTooExpensive()
+	}
+
+	fee := t.WeightToFee.WeightToFee(weight)
+	if amount.Lt(fee) {
+		return nil, xcm.NewXcmErrorTooExpensive()
+	}
+
+	unspent := amount.Sub(fee)
+	if unspent.Eq(sc.NewU128(0)) {
+		return xcm.Assets{}, nil
+	}
+	return xcm.Assets{xcm.NewAssetFungible(t.AssetId, unspent)}, nil
+}
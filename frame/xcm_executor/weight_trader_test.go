@@ -0,0 +1,39 @@
+package xcm_executor
+
+import (
+	"testing"
+
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	targetTrader = UsingComponents{WeightToFee: primitives.IdentityFee{}, AssetId: nativeAssetId}
+)
+
+func Test_UsingComponents_BuyWeight(t *testing.T) {
+	result, err := targetTrader.BuyWeight(primitives.WeightFromParts(30, 0), newNativeAsset(100))
+
+	assert.NoError(t, err)
+	assert.Equal(t, xcm.Assets{newNativeAsset(70)}, result)
+}
+
+func Test_UsingComponents_BuyWeight_AllSpent(t *testing.T) {
+	result, err := targetTrader.BuyWeight(primitives.WeightFromParts(100, 0), newNativeAsset(100))
+
+	assert.NoError(t, err)
+	assert.Equal(t, xcm.Assets{}, result)
+}
+
+func Test_UsingComponents_BuyWeight_TooExpensive(t *testing.T) {
+	_, err := targetTrader.BuyWeight(primitives.WeightFromParts(101, 0), newNativeAsset(100))
+
+	assert.Equal(t, xcm.NewXcmErrorTooExpensive(), err)
+}
+
+func Test_UsingComponents_BuyWeight_OtherAsset(t *testing.T) {
+	_, err := targetTrader.BuyWeight(primitives.WeightFromParts(1, 0), newOtherAsset(100))
+
+	assert.Equal(t, xcm.NewXcmErrorTooExpensive(), err)
+}
@@ -0,0 +1,14 @@
+package xcmp_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/parachain"
+)
+
+// ChannelInfo provides the state of the outbound HRMP channels of the parachain.
+type ChannelInfo interface {
+	// GetChannelStatus returns the status of the outbound channel to `recipient`.
+	GetChannelStatus(recipient sc.U32) (parachain.ChannelStatus, error)
+	// GetChannelInfo returns the outbound channel to `recipient`, if it exists.
+	GetChannelInfo(recipient sc.U32) (sc.Option[parachain.AbridgedHRMPChannel], error)
+}
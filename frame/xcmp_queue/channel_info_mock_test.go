@@ -0,0 +1,31 @@
+package xcmp_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/parachain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockChannelInfo struct {
+	mock.Mock
+}
+
+func (m *MockChannelInfo) GetChannelStatus(recipient sc.U32) (parachain.ChannelStatus, error) {
+	args := m.Called(recipient)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(parachain.ChannelStatus), args.Get(1).(error)
+	}
+
+	return args.Get(0).(parachain.ChannelStatus), nil
+}
+
+func (m *MockChannelInfo) GetChannelInfo(recipient sc.U32) (sc.Option[parachain.AbridgedHRMPChannel], error) {
+	args := m.Called(recipient)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(sc.Option[parachain.AbridgedHRMPChannel]), args.Get(1).(error)
+	}
+
+	return args.Get(0).(sc.Option[parachain.AbridgedHRMPChannel]), nil
+}
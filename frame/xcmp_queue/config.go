@@ -0,0 +1,44 @@
+package xcmp_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/io"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Config struct {
+	Storage      io.Storage
+	DbWeight     primitives.RuntimeDbWeight
+	SystemModule system.Module
+	ChannelInfo  ChannelInfo
+	MessageQueue MessageQueue
+	// MaxActiveOutboundChannels is the maximum number of outbound channels, which can have queued messages or signals.
+	MaxActiveOutboundChannels sc.U32
+	// MaxPageSize is the maximum size of an outbound page. It bounds the maximum length of a message.
+	MaxPageSize sc.U32
+	// QueueConfig are the thresholds of the inbound queues.
+	QueueConfig QueueConfigData
+}
+
+func NewConfig(
+	storage io.Storage,
+	dbWeight primitives.RuntimeDbWeight,
+	systemModule system.Module,
+	channelInfo ChannelInfo,
+	messageQueue MessageQueue,
+	maxActiveOutboundChannels sc.U32,
+	maxPageSize sc.U32,
+	queueConfig QueueConfigData,
+) Config {
+	return Config{
+		Storage:                   storage,
+		DbWeight:                  dbWeight,
+		SystemModule:              systemModule,
+		ChannelInfo:               channelInfo,
+		MessageQueue:              messageQueue,
+		MaxActiveOutboundChannels: maxActiveOutboundChannels,
+		MaxPageSize:               maxPageSize,
+		QueueConfig:               queueConfig,
+	}
+}
@@ -0,0 +1,11 @@
+package xcmp_queue
+
+import primitives "github.com/LimeChain/gosemble/primitives/types"
+
+// enqueueXcmpMessageWeight is the worst case weight of handling an inbound horizontal message, which includes
+// enqueuing it in the message queue and signaling its sender to suspend the channel.
+func enqueueXcmpMessageWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(32_000_000, 0).
+		SaturatingAdd(dbWeight.Reads(7)).
+		SaturatingAdd(dbWeight.Writes(7))
+}
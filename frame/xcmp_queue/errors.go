@@ -0,0 +1,33 @@
+package xcmp_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// XCMP queue module errors.
+const (
+	ErrorTooManyActiveOutboundChannels sc.U8 = iota
+	ErrorTooBig
+	ErrorNoChannel
+)
+
+func NewDispatchErrorTooManyActiveOutboundChannels(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorTooManyActiveOutboundChannels)
+}
+
+func NewDispatchErrorTooBig(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorTooBig)
+}
+
+func NewDispatchErrorNoChannel(moduleId sc.U8) primitives.DispatchError {
+	return newDispatchError(moduleId, ErrorNoChannel)
+}
+
+func newDispatchError(moduleId sc.U8, err sc.U8) primitives.DispatchError {
+	return primitives.NewDispatchErrorModule(primitives.CustomModuleError{
+		Index:   moduleId,
+		Err:     sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,49 @@
+package xcmp_queue
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// XCMP queue module events.
+const (
+	EventXcmpMessageSent sc.U8 = iota
+)
+
+var (
+	errInvalidEventModule = errors.New("invalid xcmp_queue.Event module")
+	errInvalidEventType   = errors.New("invalid xcmp_queue.Event type")
+)
+
+func newEventXcmpMessageSent(moduleIndex sc.U8, messageHash primitives.H256) primitives.Event {
+	return primitives.NewEvent(moduleIndex, EventXcmpMessageSent, messageHash)
+}
+
+func DecodeEvent(moduleIndex sc.U8, buffer *bytes.Buffer) (primitives.Event, error) {
+	decodedModuleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+	if decodedModuleIndex != moduleIndex {
+		return primitives.Event{}, errInvalidEventModule
+	}
+
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return primitives.Event{}, err
+	}
+
+	switch b {
+	case EventXcmpMessageSent:
+		messageHash, err := primitives.DecodeH256(buffer)
+		if err != nil {
+			return primitives.Event{}, err
+		}
+		return newEventXcmpMessageSent(moduleIndex, messageHash), nil
+	default:
+		return primitives.Event{}, errInvalidEventType
+	}
+}
@@ -0,0 +1,14 @@
+package xcmp_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/message_queue"
+)
+
+// MessageQueue stores the inbound messages until they are processed.
+type MessageQueue interface {
+	// EnqueueMessage appends `message` to the queue of `origin`.
+	EnqueueMessage(message sc.Sequence[sc.U8], origin message_queue.MessageOrigin) error
+	// BookState returns the state of the queue of `origin`.
+	BookState(origin message_queue.MessageOrigin) (message_queue.BookState, error)
+}
@@ -0,0 +1,31 @@
+package xcmp_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/message_queue"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockMessageQueue struct {
+	mock.Mock
+}
+
+func (m *MockMessageQueue) EnqueueMessage(message sc.Sequence[sc.U8], origin message_queue.MessageOrigin) error {
+	args := m.Called(message, origin)
+
+	if args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+
+	return nil
+}
+
+func (m *MockMessageQueue) BookState(origin message_queue.MessageOrigin) (message_queue.BookState, error) {
+	args := m.Called(origin)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(message_queue.BookState), args.Get(1).(error)
+	}
+
+	return args.Get(0).(message_queue.BookState), nil
+}
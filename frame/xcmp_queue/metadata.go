@@ -0,0 +1,175 @@
+package xcmp_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func (m Module) Metadata() primitives.MetadataModule {
+	dataV14 := primitives.MetadataModuleV14{
+		Name:    m.name(),
+		Storage: m.metadataStorage(),
+		Call:    sc.NewOption[sc.Compact](nil),
+		CallDef: sc.NewOption[primitives.MetadataDefinitionVariant](nil),
+		Event:   sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesXcmpQueueEvent)),
+		EventDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesXcmpQueueEvent, "cumulus_pallet_xcmp_queue::Event<Runtime>"),
+				},
+				m.index,
+				"Events.XcmpQueue"),
+		),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"MaxActiveOutboundChannels",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.maxActiveOutboundChannels.Bytes()),
+				"The maximum number of outbound XCMP channels that can have messages queued at the same time.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxPageSize",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(m.maxPageSize.Bytes()),
+				"The maximal page size for HRMP message pages.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesXcmpQueueErrors)),
+		ErrorDef: sc.NewOption[primitives.MetadataDefinitionVariant](
+			primitives.NewMetadataDefinitionVariantStr(
+				m.name(),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesXcmpQueueErrors),
+				},
+				m.index,
+				"Errors.XcmpQueue"),
+		),
+		Index: m.index,
+	}
+
+	m.mdGenerator.AppendMetadataTypes(m.metadataTypes())
+
+	return primitives.MetadataModule{
+		Version:   primitives.ModuleVersion14,
+		ModuleV14: dataV14,
+	}
+}
+
+func (m Module) metadataStorage() sc.Option[primitives.MetadataModuleStorage] {
+	return sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+		Prefix: m.name(),
+		Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+			primitives.NewMetadataModuleStorageEntry(
+				"InboundXcmpSuspended",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceU32)),
+				"The suspended inbound XCMP channels."),
+			primitives.NewMetadataModuleStorageEntry(
+				"OutboundXcmpStatus",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceXcmpQueueOutboundChannelDetails)),
+				"The non-empty XCMP channels in order of becoming non-empty, and the index of the first and last outbound message."),
+			primitives.NewMetadataModuleStorageEntry(
+				"OutboundXcmpMessages",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.TypesXcmpQueueTupleU32U16),
+					sc.ToCompact(metadata.TypesSequenceU8)),
+				"The messages outbound in a given XCMP channel."),
+			primitives.NewMetadataModuleStorageEntry(
+				"SignalMessages",
+				primitives.MetadataModuleStorageEntryModifierDefault,
+				primitives.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+					sc.ToCompact(metadata.PrimitiveTypesU32),
+					sc.ToCompact(metadata.TypesSequenceU8)),
+				"Any signal messages waiting to be sent."),
+		},
+	})
+}
+
+func (m Module) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesXcmpQueueOutboundState,
+			"OutboundState",
+			sc.Sequence[sc.Str]{"cumulus_pallet_xcmp_queue", "OutboundState"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Ok",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						OutboundStateOk,
+						""),
+					primitives.NewMetadataDefinitionVariant(
+						"Suspended",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						OutboundStateSuspended,
+						""),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesXcmpQueueOutboundChannelDetails,
+			"OutboundChannelDetails",
+			sc.Sequence[sc.Str]{"cumulus_pallet_xcmp_queue", "OutboundChannelDetails"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "recipient", "ParaId"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesXcmpQueueOutboundState, "state", "OutboundState"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "signals_exist", "bool"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU16, "first_index", "u16"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU16, "last_index", "u16"),
+				})),
+
+		primitives.NewMetadataType(metadata.TypesSequenceXcmpQueueOutboundChannelDetails,
+			"[]OutboundChannelDetails",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesXcmpQueueOutboundChannelDetails))),
+
+		primitives.NewMetadataType(metadata.TypesXcmpQueueTupleU32U16, "(ParaId, u16)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.ToCompact(metadata.PrimitiveTypesU16),
+			})),
+
+		primitives.NewMetadataTypeWithPath(
+			metadata.TypesXcmpQueueEvent,
+			"cumulus_pallet_xcmp_queue pallet Event",
+			sc.Sequence[sc.Str]{"cumulus_pallet_xcmp_queue", "pallet", "Event"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"XcmpMessageSent",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "message_hash", "XcmHash"),
+						},
+						EventXcmpMessageSent,
+						"An HRMP message was sent to a sibling parachain."),
+				})),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesXcmpQueueErrors,
+			"cumulus_pallet_xcmp_queue pallet Error",
+			sc.Sequence[sc.Str]{"cumulus_pallet_xcmp_queue", "pallet", "Error"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"TooManyActiveOutboundChannels",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooManyActiveOutboundChannels,
+						"There are too many active outbound channels."),
+					primitives.NewMetadataDefinitionVariant(
+						"TooBig",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorTooBig,
+						"The message is too big."),
+					primitives.NewMetadataDefinitionVariant(
+						"NoChannel",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						ErrorNoChannel,
+						"There is no channel to the recipient."),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataEmptyTypeParameter("T"),
+			}),
+	}
+}
@@ -0,0 +1,512 @@
+package xcmp_queue
+
+import (
+	"bytes"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/message_queue"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/hooks"
+	"github.com/LimeChain/gosemble/primitives/io"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/parachain"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	name = sc.Str("XcmpQueue")
+)
+
+type Module struct {
+	primitives.DefaultInherentProvider
+	hooks.DefaultDispatchModule
+	index                     sc.U8
+	dbWeight                  primitives.RuntimeDbWeight
+	maxActiveOutboundChannels sc.U32
+	maxPageSize               sc.U32
+	queueConfig               QueueConfigData
+	storage                   *storage
+	systemModule              system.Module
+	channelInfo               ChannelInfo
+	messageQueue              MessageQueue
+	hashing                   io.Hashing
+	mdGenerator               *primitives.MetadataTypeGenerator
+	logger                    log.RuntimeLogger
+}
+
+func New(index sc.U8, config Config, mdGenerator *primitives.MetadataTypeGenerator, logger log.RuntimeLogger) Module {
+	return Module{
+		index:                     index,
+		dbWeight:                  config.DbWeight,
+		maxActiveOutboundChannels: config.MaxActiveOutboundChannels,
+		maxPageSize:               config.MaxPageSize,
+		queueConfig:               config.QueueConfig,
+		storage:                   newStorage(config.Storage),
+		systemModule:              config.SystemModule,
+		channelInfo:               config.ChannelInfo,
+		messageQueue:              config.MessageQueue,
+		hashing:                   io.NewHashing(),
+		mdGenerator:               mdGenerator,
+		logger:                    logger,
+	}
+}
+
+func (m Module) name() sc.Str {
+	return name
+}
+
+func (m Module) GetIndex() sc.U8 { return m.index }
+
+func (m Module) Functions() map[sc.U8]primitives.Call { return map[sc.U8]primitives.Call{} }
+
+func (m Module) PreDispatch(_ primitives.Call) (sc.Empty, error) { return sc.Empty{}, nil }
+
+func (m Module) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, error) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnInitialize signals the suspended senders, whose inbound queues dropped to the resume threshold, to resume
+// their channels.
+func (m Module) OnInitialize(_ sc.U64) (primitives.Weight, error) {
+	weight := m.dbWeight.Reads(1)
+
+	suspended, err := m.storage.InboundXcmpSuspended.Get()
+	if err != nil {
+		return weight, err
+	}
+
+	remaining := sc.Sequence[sc.U32]{}
+	for _, sender := range suspended {
+		weight = weight.SaturatingAdd(resumeChannelWeight(m.dbWeight))
+
+		bookState, err := m.messageQueue.BookState(message_queue.NewMessageOriginSibling(sender))
+		if err != nil {
+			return weight, err
+		}
+		if readyPages(bookState) > m.queueConfig.ResumeThreshold {
+			remaining = append(remaining, sender)
+			continue
+		}
+
+		sent, err := m.sendSignal(sender, ChannelSignalResume)
+		if err != nil {
+			return weight, err
+		}
+		if !sent {
+			// Retried in the next block.
+			m.logger.Warnf("failed to signal [%d] to resume its channel: too many active outbound channels", sender)
+			remaining = append(remaining, sender)
+		}
+	}
+
+	if len(remaining) != len(suspended) {
+		m.storage.InboundXcmpSuspended.Put(remaining)
+	}
+
+	return weight, nil
+}
+
+// SendXcmpMessage queues the encoded `VersionedXcm` `message` to be sent to the sibling parachain `recipient`.
+// The message is sent in a later block, once the channel has enough capacity.
+func (m Module) SendXcmpMessage(recipient sc.U32, message sc.Sequence[sc.U8]) error {
+	if err := m.sendFragment(recipient, XcmpMessageFormatConcatenatedVersionedXcm, message); err != nil {
+		return err
+	}
+
+	hash := m.hashing.Blake256(sc.SequenceU8ToBytes(message))
+	messageHash, err := primitives.NewH256(sc.BytesToFixedSequenceU8(hash)...)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	m.systemModule.DepositEvent(newEventXcmpMessageSent(m.index, messageHash))
+
+	return nil
+}
+
+// HandleXcmpMessages handles the horizontal `messages`. The signals suspend or resume the outbound channels to
+// their senders. The XCMs are enqueued in the message queue of their senders, unless it is above the drop
+// threshold. The senders, whose queues reach the suspend threshold, are signaled to suspend their channels.
+// Signals, which cannot be handled or sent, are dropped with a warning. Only storage errors abort.
+func (m Module) HandleXcmpMessages(messages sc.Sequence[parachain.InboundXcmpMessage], _ primitives.Weight) primitives.Weight {
+	weight := primitives.WeightZero()
+	for _, message := range messages {
+		if err := m.handleXcmpMessage(message.Sender, message.Data); err != nil {
+			m.logger.Criticalf("failed to handle horizontal message: [%s]", err.Error())
+		}
+		weight = weight.SaturatingAdd(enqueueXcmpMessageWeight(m.dbWeight))
+	}
+
+	return weight
+}
+
+// TakeOutboundMessages takes at most `maxMessageCount` pages, at most one per recipient, which fit in their
+// outbound channels. The signals of a recipient are sent before its messages. The queues of closed channels are
+// discarded. The pages are ordered by recipient.
+func (m Module) TakeOutboundMessages(maxMessageCount sc.U32) (sc.Sequence[parachain.OutboundHrmpMessage], error) {
+	statuses, err := m.storage.OutboundXcmpStatus.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	maxCount := len(statuses)
+	if int(maxMessageCount) < maxCount {
+		maxCount = int(maxMessageCount)
+	}
+
+	result := sc.Sequence[parachain.OutboundHrmpMessage]{}
+	for i, status := range statuses {
+		channelStatus, err := m.channelInfo.GetChannelStatus(status.Recipient)
+		if err != nil {
+			return nil, err
+		}
+		if channelStatus.IsClosed() {
+			// The channel no longer exists, thus its pages and signals are discarded.
+			for index := status.FirstIndex; index < status.LastIndex; index++ {
+				m.storage.OutboundXcmpMessages.Remove(pageKey{Recipient: status.Recipient, Index: index})
+			}
+			if status.SignalsExist {
+				m.storage.SignalMessages.Remove(status.Recipient)
+			}
+			statuses[i] = newOutboundChannelDetails(status.Recipient)
+			continue
+		}
+		if channelStatus.IsFull() {
+			continue
+		}
+		maxSizeNow, maxSizeEver := channelStatus.MaxSizes()
+
+		// The maximum count is a limit of the host configuration, which even the signals can not bypass.
+		if len(result) == maxCount {
+			break
+		}
+
+		var page sc.Sequence[sc.U8]
+		if status.SignalsExist {
+			page, err = m.storage.SignalMessages.Get(status.Recipient)
+			if err != nil {
+				return nil, err
+			}
+			if sc.U32(len(page)) >= maxSizeNow {
+				m.logger.Warnf("signals to [%d] do not fit in the channel", status.Recipient)
+				continue
+			}
+			m.storage.SignalMessages.Remove(status.Recipient)
+			status.SignalsExist = false
+		} else if status.State == OutboundStateSuspended {
+			continue
+		} else if status.LastIndex > status.FirstIndex {
+			key := pageKey{Recipient: status.Recipient, Index: status.FirstIndex}
+			page, err = m.storage.OutboundXcmpMessages.Get(key)
+			if err != nil {
+				return nil, err
+			}
+			if sc.U32(len(page)) >= maxSizeNow {
+				continue
+			}
+			m.storage.OutboundXcmpMessages.Remove(key)
+			status.FirstIndex++
+		} else {
+			continue
+		}
+
+		if status.FirstIndex == status.LastIndex {
+			status.FirstIndex, status.LastIndex = 0, 0
+		}
+
+		if sc.U32(len(page)) > maxSizeEver {
+			m.logger.Warnf("page of length [%d] never fits in the channel to [%d]; dropping", len(page), status.Recipient)
+		} else {
+			result = append(result, parachain.OutboundHrmpMessage{Id: status.Recipient, Data: page})
+		}
+
+		statuses[i] = status
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Id < result[j].Id
+	})
+
+	retained := sc.Sequence[OutboundChannelDetails]{}
+	for _, status := range statuses {
+		if !status.isEmpty() {
+			retained = append(retained, status)
+		}
+	}
+
+	// The serviced channels are rotated to the end, so that the channels at the end are not starved.
+	pruned := len(statuses) - len(retained)
+	rotation := len(result) - pruned
+	if rotation > 0 && rotation <= len(retained) {
+		rotated := make(sc.Sequence[OutboundChannelDetails], 0, len(retained))
+		rotated = append(rotated, retained[rotation:]...)
+		retained = append(rotated, retained[:rotation]...)
+	}
+
+	m.storage.OutboundXcmpStatus.Put(retained)
+
+	return result, nil
+}
+
+func (m Module) handleXcmpMessage(sender sc.U32, data sc.Sequence[sc.U8]) error {
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(data))
+
+	format, err := sc.DecodeU8(buffer)
+	if err != nil {
+		m.logger.Warnf("failed to decode the format of a message from [%d]; dropping", sender)
+		return nil
+	}
+
+	switch format {
+	case XcmpMessageFormatSignals:
+		for buffer.Len() > 0 {
+			signal, err := sc.DecodeU8(buffer)
+			if err != nil {
+				return err
+			}
+
+			switch signal {
+			case ChannelSignalSuspend:
+				err = m.suspendChannel(sender)
+			case ChannelSignalResume:
+				err = m.resumeChannel(sender)
+			default:
+				m.logger.Warnf("unknown channel signal [%d] from [%d]; dropping", signal, sender)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case XcmpMessageFormatConcatenatedVersionedXcm:
+		return m.enqueueXcmp(sender, sc.BytesToSequenceU8(buffer.Bytes()))
+	default:
+		m.logger.Warnf("unsupported message format [%d] from [%d]; dropping", format, sender)
+		return nil
+	}
+}
+
+// enqueueXcmp enqueues the concatenated `xcms` of `sender` in its message queue, unless the queue is above the
+// drop threshold. Splitting the concatenated messages requires decoding them, thus they are enqueued as a single
+// message.
+func (m Module) enqueueXcmp(sender sc.U32, xcms sc.Sequence[sc.U8]) error {
+	if len(xcms) == 0 {
+		return nil
+	}
+
+	origin := message_queue.NewMessageOriginSibling(sender)
+	bookState, err := m.messageQueue.BookState(origin)
+	if err != nil {
+		return err
+	}
+	if readyPages(bookState) >= m.queueConfig.DropThreshold {
+		m.logger.Warnf("queue of [%d] is above the drop threshold; dropping message", sender)
+		return nil
+	}
+
+	if err := m.messageQueue.EnqueueMessage(xcms, origin); err != nil {
+		return err
+	}
+
+	bookState, err = m.messageQueue.BookState(origin)
+	if err != nil {
+		return err
+	}
+	if readyPages(bookState) < m.queueConfig.SuspendThreshold {
+		return nil
+	}
+
+	suspended, err := m.storage.InboundXcmpSuspended.Get()
+	if err != nil {
+		return err
+	}
+	i := sort.Search(len(suspended), func(i int) bool {
+		return suspended[i] >= sender
+	})
+	if i < len(suspended) && suspended[i] == sender {
+		return nil
+	}
+
+	sent, err := m.sendSignal(sender, ChannelSignalSuspend)
+	if err != nil {
+		return err
+	}
+	if !sent {
+		// The message is already enqueued, thus only the signal is dropped. It is retried with the next message.
+		m.logger.Warnf("failed to signal [%d] to suspend its channel: too many active outbound channels", sender)
+		return nil
+	}
+
+	updated := make(sc.Sequence[sc.U32], 0, len(suspended)+1)
+	updated = append(updated, suspended[:i]...)
+	updated = append(updated, sender)
+	updated = append(updated, suspended[i:]...)
+	m.storage.InboundXcmpSuspended.Put(updated)
+
+	return nil
+}
+
+// sendFragment appends `fragment` to the last page of `recipient`, if it has the same `format` and there is enough
+// space left. Otherwise, a new page is added.
+func (m Module) sendFragment(recipient sc.U32, format sc.U8, fragment sc.Sequence[sc.U8]) error {
+	channel, err := m.channelInfo.GetChannelInfo(recipient)
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+	if !channel.HasValue {
+		return NewDispatchErrorNoChannel(m.index)
+	}
+
+	maxMessageSize := sc.Min32(channel.Value.MaxMessageSize, m.maxPageSize)
+	// The page is prefixed with the format.
+	if sc.U64(len(fragment))+1 > sc.U64(maxMessageSize) {
+		return NewDispatchErrorTooBig(m.index)
+	}
+
+	statuses, err := m.storage.OutboundXcmpStatus.Get()
+	if err != nil {
+		return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+	}
+
+	i := indexOfChannel(statuses, recipient)
+	if i < 0 {
+		if sc.U32(len(statuses)) >= m.maxActiveOutboundChannels {
+			return NewDispatchErrorTooManyActiveOutboundChannels(m.index)
+		}
+		statuses = append(statuses, newOutboundChannelDetails(recipient))
+		i = len(statuses) - 1
+	}
+	details := statuses[i]
+
+	appended := false
+	if details.LastIndex > details.FirstIndex {
+		key := pageKey{Recipient: recipient, Index: details.LastIndex - 1}
+		page, err := m.storage.OutboundXcmpMessages.Get(key)
+		if err != nil {
+			return primitives.NewDispatchErrorOther(sc.Str(err.Error()))
+		}
+		if len(page) > 0 && page[0] == format && len(page)+len(fragment) <= int(maxMessageSize) {
+			m.storage.OutboundXcmpMessages.Put(key, append(page, fragment...))
+			appended = true
+		}
+	}
+
+	if !appended {
+		page := make(sc.Sequence[sc.U8], 0, len(fragment)+1)
+		page = append(page, format)
+		page = append(page, fragment...)
+		m.storage.OutboundXcmpMessages.Put(pageKey{Recipient: recipient, Index: details.LastIndex}, page)
+		details.LastIndex++
+	}
+
+	statuses[i] = details
+	m.storage.OutboundXcmpStatus.Put(statuses)
+
+	return nil
+}
+
+// sendSignal queues `signal` to be sent to `recipient`, before any of its queued pages. Returns false, if there
+// is no room for a new outbound channel to `recipient`.
+func (m Module) sendSignal(recipient sc.U32, signal sc.U8) (bool, error) {
+	statuses, err := m.storage.OutboundXcmpStatus.Get()
+	if err != nil {
+		return false, err
+	}
+
+	i := indexOfChannel(statuses, recipient)
+	if i >= 0 {
+		statuses[i].SignalsExist = true
+	} else {
+		if sc.U32(len(statuses)) >= m.maxActiveOutboundChannels {
+			return false, nil
+		}
+		details := newOutboundChannelDetails(recipient)
+		details.SignalsExist = true
+		statuses = append(statuses, details)
+	}
+
+	page, err := m.storage.SignalMessages.Get(recipient)
+	if err != nil {
+		return false, err
+	}
+	if len(page) == 0 {
+		page = sc.Sequence[sc.U8]{XcmpMessageFormatSignals}
+	}
+	m.storage.SignalMessages.Put(recipient, append(page, signal))
+	m.storage.OutboundXcmpStatus.Put(statuses)
+
+	return true, nil
+}
+
+// suspendChannel stops sending the queued pages to `target`, as requested by it. The request is dropped, if there
+// is no room for a new outbound channel to `target`.
+func (m Module) suspendChannel(target sc.U32) error {
+	statuses, err := m.storage.OutboundXcmpStatus.Get()
+	if err != nil {
+		return err
+	}
+
+	i := indexOfChannel(statuses, target)
+	if i >= 0 {
+		if statuses[i].State == OutboundStateSuspended {
+			m.logger.Debugf("channel to [%d] is already suspended", target)
+			return nil
+		}
+		statuses[i].State = OutboundStateSuspended
+	} else {
+		if sc.U32(len(statuses)) >= m.maxActiveOutboundChannels {
+			m.logger.Warnf("failed to suspend the channel to [%d]: too many active outbound channels", target)
+			return nil
+		}
+		details := newOutboundChannelDetails(target)
+		details.State = OutboundStateSuspended
+		statuses = append(statuses, details)
+	}
+
+	m.storage.OutboundXcmpStatus.Put(statuses)
+
+	return nil
+}
+
+// resumeChannel resumes sending the queued pages to `target`, as requested by it.
+func (m Module) resumeChannel(target sc.U32) error {
+	statuses, err := m.storage.OutboundXcmpStatus.Get()
+	if err != nil {
+		return err
+	}
+
+	i := indexOfChannel(statuses, target)
+	if i < 0 {
+		m.logger.Debugf("channel to [%d] is not suspended", target)
+		return nil
+	}
+
+	statuses[i].State = OutboundStateOk
+	if statuses[i].isEmpty() {
+		statuses = append(statuses[:i], statuses[i+1:]...)
+	}
+
+	m.storage.OutboundXcmpStatus.Put(statuses)
+
+	return nil
+}
+
+// indexOfChannel returns the index of the channel to `recipient` in `statuses`, or -1 if it is not active.
+func indexOfChannel(statuses sc.Sequence[OutboundChannelDetails], recipient sc.U32) int {
+	for i, status := range statuses {
+		if status.Recipient == recipient {
+			return i
+		}
+	}
+	return -1
+}
+
+// readyPages returns the number of pages of a queue, which are not yet serviced.
+func readyPages(bookState message_queue.BookState) sc.U32 {
+	if bookState.End <= bookState.Begin {
+		return 0
+	}
+	return bookState.End - bookState.Begin
+}
@@ -0,0 +1,499 @@
+package xcmp_queue
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/message_queue"
+	"github.com/LimeChain/gosemble/mocks"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/parachain"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moduleId                  = 36
+	maxActiveOutboundChannels = sc.U32(2)
+	maxPageSize               = sc.U32(16)
+	recipient                 = sc.U32(2000)
+)
+
+var (
+	dbWeight = primitives.RuntimeDbWeight{
+		Read:  1,
+		Write: 2,
+	}
+	maxWeight = primitives.WeightFromParts(1_000_000, 1_000_000)
+
+	message       = sc.Sequence[sc.U8]{1, 2, 3}
+	originSibling = message_queue.NewMessageOriginSibling(recipient)
+	keyRecipient0 = pageKey{Recipient: recipient, Index: 0}
+	keyRecipient1 = pageKey{Recipient: recipient, Index: 1}
+	channel       = sc.NewOption[parachain.AbridgedHRMPChannel](parachain.AbridgedHRMPChannel{
+		MaxCapacity:    8,
+		MaxTotalSize:   1024,
+		MaxMessageSize: 100,
+	})
+	channelReady = parachain.NewChannelStatusReady(100, 200)
+
+	messageHash, _ = primitives.NewH256(sc.BytesToSequenceU8(make([]byte, 32))...)
+
+	mdGenerator                           = primitives.NewMetadataTypeGenerator()
+	unknownTransactionNoUnsignedValidator = primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+)
+
+var (
+	mockStorage                     *mocks.IoStorage
+	mockSystemModule                *mocks.SystemModule
+	mockHashing                     *mocks.IoHashing
+	mockChannelInfo                 *MockChannelInfo
+	mockMessageQueue                *MockMessageQueue
+	mockStorageInboundXcmpSuspended *mocks.StorageValue[sc.Sequence[sc.U32]]
+	mockStorageOutboundXcmpStatus   *mocks.StorageValue[sc.Sequence[OutboundChannelDetails]]
+	mockStorageOutboundXcmpMessages *mocks.StorageMap[pageKey, sc.Sequence[sc.U8]]
+	mockStorageSignalMessages       *mocks.StorageMap[sc.U32, sc.Sequence[sc.U8]]
+	mockCall                        *mocks.Call
+)
+
+func Test_Module_GetIndex(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, sc.U8(moduleId), target.GetIndex())
+}
+
+func Test_Module_Functions(t *testing.T) {
+	target := setupModule()
+
+	assert.Equal(t, 0, len(target.Functions()))
+}
+
+func Test_Module_PreDispatch(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.PreDispatch(mockCall)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Empty{}, result)
+}
+
+func Test_Module_ValidateUnsigned(t *testing.T) {
+	target := setupModule()
+
+	result, err := target.ValidateUnsigned(primitives.NewTransactionSourceLocal(), mockCall)
+
+	assert.Equal(t, unknownTransactionNoUnsignedValidator, err)
+	assert.Equal(t, primitives.ValidTransaction{}, result)
+}
+
+func Test_Module_SendXcmpMessage_NewPage(t *testing.T) {
+	target := setupModule()
+	mockChannelInfo.On("GetChannelInfo", recipient).Return(channel, nil)
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{}, nil)
+
+	err := target.SendXcmpMessage(recipient, message)
+
+	assert.NoError(t, err)
+	mockStorageOutboundXcmpMessages.AssertCalled(t, "Put", keyRecipient0, sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3})
+	mockStorageOutboundXcmpStatus.AssertCalled(t, "Put", sc.Sequence[OutboundChannelDetails]{
+		{Recipient: recipient, State: OutboundStateOk, LastIndex: 1},
+	})
+	mockSystemModule.AssertCalled(t, "DepositEvent", newEventXcmpMessageSent(moduleId, messageHash))
+}
+
+func Test_Module_SendXcmpMessage_AppendsToLastPage(t *testing.T) {
+	target := setupModule()
+	statuses := sc.Sequence[OutboundChannelDetails]{
+		{Recipient: recipient, State: OutboundStateOk, LastIndex: 1},
+	}
+	mockChannelInfo.On("GetChannelInfo", recipient).Return(channel, nil)
+	mockStorageOutboundXcmpStatus.On("Get").Return(statuses, nil)
+	mockStorageOutboundXcmpMessages.On("Get", keyRecipient0).Return(sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3}, nil)
+
+	err := target.SendXcmpMessage(recipient, message)
+
+	assert.NoError(t, err)
+	mockStorageOutboundXcmpMessages.AssertCalled(t, "Put", keyRecipient0, sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3, 1, 2, 3})
+	mockStorageOutboundXcmpStatus.AssertCalled(t, "Put", statuses)
+}
+
+func Test_Module_SendXcmpMessage_LastPageFull(t *testing.T) {
+	target := setupModule()
+	mockChannelInfo.On("GetChannelInfo", recipient).Return(channel, nil)
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{
+		{Recipient: recipient, State: OutboundStateOk, LastIndex: 1},
+	}, nil)
+	lastPage := append(sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm}, make(sc.Sequence[sc.U8], 14)...)
+	mockStorageOutboundXcmpMessages.On("Get", keyRecipient0).Return(lastPage, nil)
+
+	err := target.SendXcmpMessage(recipient, message)
+
+	assert.NoError(t, err)
+	mockStorageOutboundXcmpMessages.AssertCalled(t, "Put", keyRecipient1, sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3})
+	mockStorageOutboundXcmpStatus.AssertCalled(t, "Put", sc.Sequence[OutboundChannelDetails]{
+		{Recipient: recipient, State: OutboundStateOk, LastIndex: 2},
+	})
+}
+
+func Test_Module_SendXcmpMessage_NoChannel(t *testing.T) {
+	target := setupModule()
+	mockChannelInfo.On("GetChannelInfo", recipient).Return(sc.NewOption[parachain.AbridgedHRMPChannel](nil), nil)
+
+	err := target.SendXcmpMessage(recipient, message)
+
+	assert.Equal(t, NewDispatchErrorNoChannel(moduleId), err)
+	mockStorageOutboundXcmpStatus.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_SendXcmpMessage_TooBig(t *testing.T) {
+	target := setupModule()
+	mockChannelInfo.On("GetChannelInfo", recipient).Return(channel, nil)
+
+	err := target.SendXcmpMessage(recipient, make(sc.Sequence[sc.U8], maxPageSize))
+
+	assert.Equal(t, NewDispatchErrorTooBig(moduleId), err)
+	mockStorageOutboundXcmpStatus.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_SendXcmpMessage_TooManyActiveOutboundChannels(t *testing.T) {
+	target := setupModule()
+	mockChannelInfo.On("GetChannelInfo", recipient).Return(channel, nil)
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{
+		{Recipient: 1000, LastIndex: 1},
+		{Recipient: 3000, LastIndex: 1},
+	}, nil)
+
+	err := target.SendXcmpMessage(recipient, message)
+
+	assert.Equal(t, NewDispatchErrorTooManyActiveOutboundChannels(moduleId), err)
+	mockStorageOutboundXcmpStatus.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_HandleXcmpMessages_Enqueues(t *testing.T) {
+	target := setupModule()
+	mockMessageQueue.On("BookState", originSibling).Return(message_queue.BookState{}, nil)
+	mockMessageQueue.On("EnqueueMessage", message, originSibling).Return(nil)
+
+	result := target.HandleXcmpMessages(sc.Sequence[parachain.InboundXcmpMessage]{
+		{Sender: recipient, SentAt: 1, Data: sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3}},
+	}, maxWeight)
+
+	assert.Equal(t, enqueueXcmpMessageWeight(dbWeight), result)
+	mockMessageQueue.AssertCalled(t, "EnqueueMessage", message, originSibling)
+	mockStorageInboundXcmpSuspended.AssertNotCalled(t, "Get")
+}
+
+func Test_Module_HandleXcmpMessages_AboveDropThreshold(t *testing.T) {
+	target := setupModule()
+	mockMessageQueue.On("BookState", originSibling).Return(message_queue.BookState{End: DefaultQueueConfigData().DropThreshold}, nil)
+
+	target.HandleXcmpMessages(sc.Sequence[parachain.InboundXcmpMessage]{
+		{Sender: recipient, SentAt: 1, Data: sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3}},
+	}, maxWeight)
+
+	mockMessageQueue.AssertNotCalled(t, "EnqueueMessage", mock.Anything, mock.Anything)
+}
+
+func Test_Module_HandleXcmpMessages_SuspendsSender(t *testing.T) {
+	target := setupModule()
+	suspendThreshold := DefaultQueueConfigData().SuspendThreshold
+	mockMessageQueue.On("BookState", originSibling).Return(message_queue.BookState{End: suspendThreshold - 1}, nil).Once()
+	mockMessageQueue.On("BookState", originSibling).Return(message_queue.BookState{End: suspendThreshold}, nil).Once()
+	mockMessageQueue.On("EnqueueMessage", message, originSibling).Return(nil)
+	mockStorageInboundXcmpSuspended.On("Get").Return(sc.Sequence[sc.U32]{1000, 3000}, nil)
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{}, nil)
+	mockStorageSignalMessages.On("Get", recipient).Return(sc.Sequence[sc.U8]{}, nil)
+
+	target.HandleXcmpMessages(sc.Sequence[parachain.InboundXcmpMessage]{
+		{Sender: recipient, SentAt: 1, Data: sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3}},
+	}, maxWeight)
+
+	mockStorageSignalMessages.AssertCalled(t, "Put", recipient, sc.Sequence[sc.U8]{XcmpMessageFormatSignals, ChannelSignalSuspend})
+	mockStorageOutboundXcmpStatus.AssertCalled(t, "Put", sc.Sequence[OutboundChannelDetails]{
+		{Recipient: recipient, State: OutboundStateOk, SignalsExist: true},
+	})
+	mockStorageInboundXcmpSuspended.AssertCalled(t, "Put", sc.Sequence[sc.U32]{1000, recipient, 3000})
+}
+
+func Test_Module_HandleXcmpMessages_SuspendsSender_TooManyActiveOutboundChannels(t *testing.T) {
+	target := setupModule()
+	suspendThreshold := DefaultQueueConfigData().SuspendThreshold
+	mockMessageQueue.On("BookState", originSibling).Return(message_queue.BookState{End: suspendThreshold - 1}, nil).Once()
+	mockMessageQueue.On("BookState", originSibling).Return(message_queue.BookState{End: suspendThreshold}, nil).Once()
+	mockMessageQueue.On("EnqueueMessage", message, originSibling).Return(nil)
+	mockStorageInboundXcmpSuspended.On("Get").Return(sc.Sequence[sc.U32]{}, nil)
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{
+		{Recipient: 1000, LastIndex: 1},
+		{Recipient: 3000, LastIndex: 1},
+	}, nil)
+
+	assert.NotPanics(t, func() {
+		result := target.HandleXcmpMessages(sc.Sequence[parachain.InboundXcmpMessage]{
+			{Sender: recipient, SentAt: 1, Data: sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3}},
+		}, maxWeight)
+
+		assert.Equal(t, enqueueXcmpMessageWeight(dbWeight), result)
+	})
+
+	mockMessageQueue.AssertCalled(t, "EnqueueMessage", message, originSibling)
+	mockStorageSignalMessages.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockStorageOutboundXcmpStatus.AssertNotCalled(t, "Put", mock.Anything)
+	mockStorageInboundXcmpSuspended.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_HandleXcmpMessages_AlreadySuspended(t *testing.T) {
+	target := setupModule()
+	mockMessageQueue.On("BookState", originSibling).Return(message_queue.BookState{End: DefaultQueueConfigData().SuspendThreshold}, nil)
+	mockMessageQueue.On("EnqueueMessage", message, originSibling).Return(nil)
+	mockStorageInboundXcmpSuspended.On("Get").Return(sc.Sequence[sc.U32]{recipient}, nil)
+
+	target.HandleXcmpMessages(sc.Sequence[parachain.InboundXcmpMessage]{
+		{Sender: recipient, SentAt: 1, Data: sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3}},
+	}, maxWeight)
+
+	mockStorageSignalMessages.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockStorageInboundXcmpSuspended.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_HandleXcmpMessages_SuspendSignal(t *testing.T) {
+	target := setupModule()
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{}, nil)
+
+	target.HandleXcmpMessages(sc.Sequence[parachain.InboundXcmpMessage]{
+		{Sender: recipient, SentAt: 1, Data: sc.Sequence[sc.U8]{XcmpMessageFormatSignals, ChannelSignalSuspend}},
+	}, maxWeight)
+
+	mockStorageOutboundXcmpStatus.AssertCalled(t, "Put", sc.Sequence[OutboundChannelDetails]{
+		{Recipient: recipient, State: OutboundStateSuspended},
+	})
+	mockMessageQueue.AssertNotCalled(t, "EnqueueMessage", mock.Anything, mock.Anything)
+}
+
+func Test_Module_HandleXcmpMessages_SuspendSignal_TooManyActiveOutboundChannels(t *testing.T) {
+	target := setupModule()
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{
+		{Recipient: 1000, LastIndex: 1},
+		{Recipient: 3000, LastIndex: 1},
+	}, nil)
+
+	assert.NotPanics(t, func() {
+		target.HandleXcmpMessages(sc.Sequence[parachain.InboundXcmpMessage]{
+			{Sender: recipient, SentAt: 1, Data: sc.Sequence[sc.U8]{XcmpMessageFormatSignals, ChannelSignalSuspend}},
+		}, maxWeight)
+	})
+
+	mockStorageOutboundXcmpStatus.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_HandleXcmpMessages_ResumeSignal(t *testing.T) {
+	target := setupModule()
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{
+		{Recipient: 1000, State: OutboundStateOk, LastIndex: 1},
+		{Recipient: recipient, State: OutboundStateSuspended},
+	}, nil)
+
+	target.HandleXcmpMessages(sc.Sequence[parachain.InboundXcmpMessage]{
+		{Sender: recipient, SentAt: 1, Data: sc.Sequence[sc.U8]{XcmpMessageFormatSignals, ChannelSignalResume}},
+	}, maxWeight)
+
+	mockStorageOutboundXcmpStatus.AssertCalled(t, "Put", sc.Sequence[OutboundChannelDetails]{
+		{Recipient: 1000, State: OutboundStateOk, LastIndex: 1},
+	})
+}
+
+func Test_Module_HandleXcmpMessages_UnsupportedFormat(t *testing.T) {
+	target := setupModule()
+
+	result := target.HandleXcmpMessages(sc.Sequence[parachain.InboundXcmpMessage]{
+		{Sender: recipient, SentAt: 1, Data: sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedEncodedBlob, 1, 2, 3}},
+	}, maxWeight)
+
+	assert.Equal(t, enqueueXcmpMessageWeight(dbWeight), result)
+	mockMessageQueue.AssertNotCalled(t, "EnqueueMessage", mock.Anything, mock.Anything)
+}
+
+func Test_Module_OnInitialize_NoSuspended(t *testing.T) {
+	target := setupModule()
+	mockStorageInboundXcmpSuspended.On("Get").Return(sc.Sequence[sc.U32]{}, nil)
+
+	result, err := target.OnInitialize(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, dbWeight.Reads(1), result)
+	mockStorageInboundXcmpSuspended.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_OnInitialize_ResumesSender(t *testing.T) {
+	target := setupModule()
+	resumeThreshold := DefaultQueueConfigData().ResumeThreshold
+	mockStorageInboundXcmpSuspended.On("Get").Return(sc.Sequence[sc.U32]{recipient, 3000}, nil)
+	mockMessageQueue.On("BookState", originSibling).Return(message_queue.BookState{End: resumeThreshold}, nil)
+	mockMessageQueue.On("BookState", message_queue.NewMessageOriginSibling(3000)).Return(message_queue.BookState{End: resumeThreshold + 1}, nil)
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{}, nil)
+	mockStorageSignalMessages.On("Get", recipient).Return(sc.Sequence[sc.U8]{}, nil)
+
+	result, err := target.OnInitialize(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, dbWeight.Reads(1).SaturatingAdd(resumeChannelWeight(dbWeight)).SaturatingAdd(resumeChannelWeight(dbWeight)), result)
+	mockStorageSignalMessages.AssertCalled(t, "Put", recipient, sc.Sequence[sc.U8]{XcmpMessageFormatSignals, ChannelSignalResume})
+	mockStorageSignalMessages.AssertNotCalled(t, "Put", sc.U32(3000), mock.Anything)
+	mockStorageInboundXcmpSuspended.AssertCalled(t, "Put", sc.Sequence[sc.U32]{3000})
+}
+
+func Test_Module_OnInitialize_ResumesSender_TooManyActiveOutboundChannels(t *testing.T) {
+	target := setupModule()
+	mockStorageInboundXcmpSuspended.On("Get").Return(sc.Sequence[sc.U32]{recipient}, nil)
+	mockMessageQueue.On("BookState", originSibling).Return(message_queue.BookState{}, nil)
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{
+		{Recipient: 1000, LastIndex: 1},
+		{Recipient: 3000, LastIndex: 1},
+	}, nil)
+
+	_, err := target.OnInitialize(1)
+
+	assert.NoError(t, err)
+	mockStorageSignalMessages.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	mockStorageInboundXcmpSuspended.AssertNotCalled(t, "Put", mock.Anything)
+}
+
+func Test_Module_TakeOutboundMessages_SignalsFirst(t *testing.T) {
+	target := setupModule()
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{
+		{Recipient: 3000, State: OutboundStateOk, LastIndex: 1},
+		{Recipient: recipient, State: OutboundStateSuspended, SignalsExist: true, LastIndex: 1},
+	}, nil)
+	mockChannelInfo.On("GetChannelStatus", mock.Anything).Return(channelReady, nil)
+	mockStorageOutboundXcmpMessages.On("Get", pageKey{Recipient: 3000, Index: 0}).Return(sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3}, nil)
+	mockStorageSignalMessages.On("Get", recipient).Return(sc.Sequence[sc.U8]{XcmpMessageFormatSignals, ChannelSignalSuspend}, nil)
+
+	result, err := target.TakeOutboundMessages(5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.Sequence[parachain.OutboundHrmpMessage]{
+		{Id: recipient, Data: sc.Sequence[sc.U8]{XcmpMessageFormatSignals, ChannelSignalSuspend}},
+		{Id: 3000, Data: sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3}},
+	}, result)
+	mockStorageOutboundXcmpMessages.AssertCalled(t, "Remove", pageKey{Recipient: 3000, Index: 0})
+	mockStorageOutboundXcmpMessages.AssertNotCalled(t, "Get", keyRecipient0)
+	mockStorageSignalMessages.AssertCalled(t, "Remove", recipient)
+	mockStorageOutboundXcmpStatus.AssertCalled(t, "Put", sc.Sequence[OutboundChannelDetails]{
+		{Recipient: recipient, State: OutboundStateSuspended, LastIndex: 1},
+	})
+}
+
+func Test_Module_TakeOutboundMessages_RotatesServiced(t *testing.T) {
+	target := setupModule()
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{
+		{Recipient: 1000, State: OutboundStateOk, LastIndex: 2},
+		{Recipient: recipient, State: OutboundStateOk, LastIndex: 2},
+	}, nil)
+	mockChannelInfo.On("GetChannelStatus", mock.Anything).Return(channelReady, nil)
+	mockStorageOutboundXcmpMessages.On("Get", pageKey{Recipient: 1000, Index: 0}).Return(sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3}, nil)
+
+	result, err := target.TakeOutboundMessages(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.Sequence[parachain.OutboundHrmpMessage]{
+		{Id: 1000, Data: sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3}},
+	}, result)
+	mockStorageOutboundXcmpStatus.AssertCalled(t, "Put", sc.Sequence[OutboundChannelDetails]{
+		{Recipient: recipient, State: OutboundStateOk, LastIndex: 2},
+		{Recipient: 1000, State: OutboundStateOk, FirstIndex: 1, LastIndex: 2},
+	})
+}
+
+func Test_Module_TakeOutboundMessages_ClosedChannel(t *testing.T) {
+	target := setupModule()
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{
+		{Recipient: recipient, State: OutboundStateOk, SignalsExist: true, LastIndex: 2},
+	}, nil)
+	mockChannelInfo.On("GetChannelStatus", recipient).Return(parachain.NewChannelStatusClosed(), nil)
+
+	result, err := target.TakeOutboundMessages(5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.Sequence[parachain.OutboundHrmpMessage]{}, result)
+	mockStorageOutboundXcmpMessages.AssertCalled(t, "Remove", keyRecipient0)
+	mockStorageOutboundXcmpMessages.AssertCalled(t, "Remove", keyRecipient1)
+	mockStorageSignalMessages.AssertCalled(t, "Remove", recipient)
+	mockStorageOutboundXcmpStatus.AssertCalled(t, "Put", sc.Sequence[OutboundChannelDetails]{})
+}
+
+func Test_Module_TakeOutboundMessages_DoesNotFit(t *testing.T) {
+	target := setupModule()
+	statuses := sc.Sequence[OutboundChannelDetails]{
+		{Recipient: 1000, State: OutboundStateOk, LastIndex: 1},
+		{Recipient: recipient, State: OutboundStateOk, LastIndex: 1},
+	}
+	mockStorageOutboundXcmpStatus.On("Get").Return(statuses, nil)
+	mockChannelInfo.On("GetChannelStatus", sc.U32(1000)).Return(parachain.NewChannelStatusFull(), nil)
+	mockChannelInfo.On("GetChannelStatus", recipient).Return(parachain.NewChannelStatusReady(4, 200), nil)
+	mockStorageOutboundXcmpMessages.On("Get", keyRecipient0).Return(sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3}, nil)
+
+	result, err := target.TakeOutboundMessages(5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.Sequence[parachain.OutboundHrmpMessage]{}, result)
+	mockStorageOutboundXcmpMessages.AssertNotCalled(t, "Remove", mock.Anything)
+	mockStorageOutboundXcmpStatus.AssertCalled(t, "Put", statuses)
+}
+
+func Test_Module_TakeOutboundMessages_DropsPageTooBigEver(t *testing.T) {
+	target := setupModule()
+	mockStorageOutboundXcmpStatus.On("Get").Return(sc.Sequence[OutboundChannelDetails]{
+		{Recipient: recipient, State: OutboundStateOk, LastIndex: 1},
+	}, nil)
+	mockChannelInfo.On("GetChannelStatus", recipient).Return(parachain.NewChannelStatusReady(100, 2), nil)
+	mockStorageOutboundXcmpMessages.On("Get", keyRecipient0).Return(sc.Sequence[sc.U8]{XcmpMessageFormatConcatenatedVersionedXcm, 1, 2, 3}, nil)
+
+	result, err := target.TakeOutboundMessages(5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.Sequence[parachain.OutboundHrmpMessage]{}, result)
+	mockStorageOutboundXcmpMessages.AssertCalled(t, "Remove", keyRecipient0)
+	mockStorageOutboundXcmpStatus.AssertCalled(t, "Put", sc.Sequence[OutboundChannelDetails]{})
+}
+
+func setupModule() Module {
+	mockStorage = new(mocks.IoStorage)
+	mockSystemModule = new(mocks.SystemModule)
+	mockHashing = new(mocks.IoHashing)
+	mockChannelInfo = new(MockChannelInfo)
+	mockMessageQueue = new(MockMessageQueue)
+	mockStorageInboundXcmpSuspended = new(mocks.StorageValue[sc.Sequence[sc.U32]])
+	mockStorageOutboundXcmpStatus = new(mocks.StorageValue[sc.Sequence[OutboundChannelDetails]])
+	mockStorageOutboundXcmpMessages = new(mocks.StorageMap[pageKey, sc.Sequence[sc.U8]])
+	mockStorageSignalMessages = new(mocks.StorageMap[sc.U32, sc.Sequence[sc.U8]])
+	mockCall = new(mocks.Call)
+
+	config := NewConfig(
+		mockStorage,
+		dbWeight,
+		mockSystemModule,
+		mockChannelInfo,
+		mockMessageQueue,
+		maxActiveOutboundChannels,
+		maxPageSize,
+		DefaultQueueConfigData(),
+	)
+
+	target := New(moduleId, config, mdGenerator, log.NewLogger())
+	target.storage.InboundXcmpSuspended = mockStorageInboundXcmpSuspended
+	target.storage.OutboundXcmpStatus = mockStorageOutboundXcmpStatus
+	target.storage.OutboundXcmpMessages = mockStorageOutboundXcmpMessages
+	target.storage.SignalMessages = mockStorageSignalMessages
+	target.hashing = mockHashing
+
+	mockStorageInboundXcmpSuspended.On("Put", mock.Anything).Return()
+	mockStorageOutboundXcmpStatus.On("Put", mock.Anything).Return()
+	mockStorageOutboundXcmpMessages.On("Put", mock.Anything, mock.Anything).Return()
+	mockStorageOutboundXcmpMessages.On("Remove", mock.Anything).Return()
+	mockStorageSignalMessages.On("Put", mock.Anything, mock.Anything).Return()
+	mockStorageSignalMessages.On("Remove", mock.Anything).Return()
+	mockHashing.On("Blake256", mock.Anything).Return(messageHash.Bytes())
+	mockSystemModule.On("DepositEvent", mock.Anything)
+
+	return target
+}
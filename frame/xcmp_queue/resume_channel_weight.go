@@ -0,0 +1,11 @@
+package xcmp_queue
+
+import primitives "github.com/LimeChain/gosemble/primitives/types"
+
+// resumeChannelWeight is the worst case weight of checking whether a suspended sender can be signaled to
+// resume its channel.
+func resumeChannelWeight(dbWeight primitives.RuntimeDbWeight) primitives.Weight {
+	return primitives.WeightFromParts(8_000_000, 0).
+		SaturatingAdd(dbWeight.Reads(3)).
+		SaturatingAdd(dbWeight.Writes(2))
+}
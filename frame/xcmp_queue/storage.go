@@ -0,0 +1,40 @@
+package xcmp_queue
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/io"
+)
+
+var (
+	keyXcmpQueue            = []byte("XcmpQueue")
+	keyInboundXcmpSuspended = []byte("InboundXcmpSuspended")
+	keyOutboundXcmpStatus   = []byte("OutboundXcmpStatus")
+	keyOutboundXcmpMessages = []byte("OutboundXcmpMessages")
+	keySignalMessages       = []byte("SignalMessages")
+)
+
+var (
+	defaultInboundXcmpSuspended = sc.Sequence[sc.U32]{}
+	defaultOutboundXcmpStatus   = sc.Sequence[OutboundChannelDetails]{}
+	defaultPage                 = sc.Sequence[sc.U8]{}
+)
+
+type storage struct {
+	// InboundXcmpSuspended are the senders, which were signaled to suspend their channels, ordered by id.
+	InboundXcmpSuspended support.StorageValue[sc.Sequence[sc.U32]]
+	OutboundXcmpStatus   support.StorageValue[sc.Sequence[OutboundChannelDetails]]
+	OutboundXcmpMessages support.StorageMap[pageKey, sc.Sequence[sc.U8]]
+	SignalMessages       support.StorageMap[sc.U32, sc.Sequence[sc.U8]]
+}
+
+func newStorage(s io.Storage) *storage {
+	hashing := io.NewHashing()
+
+	return &storage{
+		InboundXcmpSuspended: support.NewHashStorageValueWithDefault(s, keyXcmpQueue, keyInboundXcmpSuspended, sc.DecodeSequence[sc.U32], &defaultInboundXcmpSuspended),
+		OutboundXcmpStatus:   support.NewHashStorageValueWithDefault(s, keyXcmpQueue, keyOutboundXcmpStatus, decodeOutboundChannelDetailsSequence, &defaultOutboundXcmpStatus),
+		OutboundXcmpMessages: support.NewHashStorageMapWithDefault[pageKey, sc.Sequence[sc.U8]](s, keyXcmpQueue, keyOutboundXcmpMessages, hashing.Twox64, sc.DecodeSequence[sc.U8], &defaultPage),
+		SignalMessages:       support.NewHashStorageMapWithDefault[sc.U32, sc.Sequence[sc.U8]](s, keyXcmpQueue, keySignalMessages, hashing.Twox64, sc.DecodeSequence[sc.U8], &defaultPage),
+	}
+}
@@ -0,0 +1,148 @@
+package xcmp_queue
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// XcmpMessageFormat is the format of the content of a horizontal message, which prefixes it.
+const (
+	// XcmpMessageFormatConcatenatedVersionedXcm means that the content is a sequence of encoded `VersionedXcm`.
+	XcmpMessageFormatConcatenatedVersionedXcm sc.U8 = iota
+	// XcmpMessageFormatConcatenatedEncodedBlob means that the content is a sequence of encoded blobs.
+	XcmpMessageFormatConcatenatedEncodedBlob
+	// XcmpMessageFormatSignals means that the content is a sequence of channel signals.
+	XcmpMessageFormatSignals
+)
+
+// Signals, which control the channel between two parachains.
+const (
+	// ChannelSignalSuspend requests the recipient to stop sending messages over the channel.
+	ChannelSignalSuspend sc.U8 = iota
+	// ChannelSignalResume requests the recipient to resume sending messages over the channel.
+	ChannelSignalResume
+)
+
+// The state of an outbound channel.
+const (
+	OutboundStateOk sc.U8 = iota
+	OutboundStateSuspended
+)
+
+// OutboundChannelDetails is the state of the outbound queue of a recipient. The queued messages are
+// stored in pages, indexed from FirstIndex (inclusive) to LastIndex (exclusive).
+type OutboundChannelDetails struct {
+	// Recipient is the id of the recipient parachain.
+	Recipient sc.U32
+	// State is whether the recipient suspended the channel.
+	State sc.U8
+	// SignalsExist is whether there is a signals page for the recipient.
+	SignalsExist sc.Bool
+	// FirstIndex is the index of the first page in the queue.
+	FirstIndex sc.U16
+	// LastIndex is the index after the last page in the queue.
+	LastIndex sc.U16
+}
+
+func newOutboundChannelDetails(recipient sc.U32) OutboundChannelDetails {
+	return OutboundChannelDetails{
+		Recipient: recipient,
+		State:     OutboundStateOk,
+	}
+}
+
+func (ocd OutboundChannelDetails) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		ocd.Recipient,
+		ocd.State,
+		ocd.SignalsExist,
+		ocd.FirstIndex,
+		ocd.LastIndex,
+	)
+}
+
+func DecodeOutboundChannelDetails(buffer *bytes.Buffer) (OutboundChannelDetails, error) {
+	recipient, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return OutboundChannelDetails{}, err
+	}
+	state, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return OutboundChannelDetails{}, err
+	}
+	signalsExist, err := sc.DecodeBool(buffer)
+	if err != nil {
+		return OutboundChannelDetails{}, err
+	}
+	firstIndex, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return OutboundChannelDetails{}, err
+	}
+	lastIndex, err := sc.DecodeU16(buffer)
+	if err != nil {
+		return OutboundChannelDetails{}, err
+	}
+
+	return OutboundChannelDetails{
+		Recipient:    recipient,
+		State:        state,
+		SignalsExist: signalsExist,
+		FirstIndex:   firstIndex,
+		LastIndex:    lastIndex,
+	}, nil
+}
+
+func (ocd OutboundChannelDetails) Bytes() []byte {
+	return sc.EncodedBytes(ocd)
+}
+
+// isEmpty returns whether the channel has neither queued pages, nor signals, and is not suspended.
+func (ocd OutboundChannelDetails) isEmpty() bool {
+	return ocd.State == OutboundStateOk && !ocd.SignalsExist && ocd.FirstIndex >= ocd.LastIndex
+}
+
+// QueueConfigData are the thresholds, in pages, of the inbound queue of a sender, which control its channel.
+type QueueConfigData struct {
+	// SuspendThreshold is the number of ready pages, at which the sender is signaled to suspend the channel.
+	SuspendThreshold sc.U32
+	// DropThreshold is the number of ready pages, at which the messages of the sender are dropped.
+	DropThreshold sc.U32
+	// ResumeThreshold is the number of ready pages, at which a suspended sender is signaled to resume the channel.
+	ResumeThreshold sc.U32
+}
+
+// DefaultQueueConfigData returns the default thresholds of the inbound queues.
+func DefaultQueueConfigData() QueueConfigData {
+	return QueueConfigData{
+		SuspendThreshold: 32,
+		DropThreshold:    48,
+		ResumeThreshold:  8,
+	}
+}
+
+func (qcd QueueConfigData) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, qcd.SuspendThreshold, qcd.DropThreshold, qcd.ResumeThreshold)
+}
+
+func (qcd QueueConfigData) Bytes() []byte {
+	return sc.EncodedBytes(qcd)
+}
+
+// pageKey is the key of an outbound page of a recipient.
+type pageKey struct {
+	Recipient sc.U32
+	Index     sc.U16
+}
+
+func (pk pageKey) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, pk.Recipient, pk.Index)
+}
+
+func (pk pageKey) Bytes() []byte {
+	return sc.EncodedBytes(pk)
+}
+
+func decodeOutboundChannelDetailsSequence(buffer *bytes.Buffer) (sc.Sequence[OutboundChannelDetails], error) {
+	return sc.DecodeSequenceWith(buffer, DecodeOutboundChannelDetails)
+}
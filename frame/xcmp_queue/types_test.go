@@ -0,0 +1,65 @@
+package xcmp_queue
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	expectedOutboundChannelDetailsBytes, _ = hex.DecodeString("d0070000" + "01" + "01" + "0200" + "0500")
+)
+
+func Test_OutboundChannelDetails_Encode(t *testing.T) {
+	details := OutboundChannelDetails{
+		Recipient:    2000,
+		State:        OutboundStateSuspended,
+		SignalsExist: true,
+		FirstIndex:   2,
+		LastIndex:    5,
+	}
+
+	buffer := &bytes.Buffer{}
+	err := details.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedOutboundChannelDetailsBytes, buffer.Bytes())
+	assert.Equal(t, expectedOutboundChannelDetailsBytes, details.Bytes())
+}
+
+func Test_DecodeOutboundChannelDetails(t *testing.T) {
+	result, err := DecodeOutboundChannelDetails(bytes.NewBuffer(expectedOutboundChannelDetailsBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, OutboundChannelDetails{
+		Recipient:    2000,
+		State:        OutboundStateSuspended,
+		SignalsExist: true,
+		FirstIndex:   2,
+		LastIndex:    5,
+	}, result)
+}
+
+func Test_OutboundChannelDetails_IsEmpty(t *testing.T) {
+	details := newOutboundChannelDetails(2000)
+	assert.True(t, details.isEmpty())
+
+	details.LastIndex = 1
+	assert.False(t, details.isEmpty())
+
+	details = newOutboundChannelDetails(2000)
+	details.SignalsExist = true
+	assert.False(t, details.isEmpty())
+
+	details = newOutboundChannelDetails(2000)
+	details.State = OutboundStateSuspended
+	assert.False(t, details.isEmpty())
+}
+
+func Test_QueueConfigData_Bytes(t *testing.T) {
+	expect, _ := hex.DecodeString("20000000" + "30000000" + "08000000")
+
+	assert.Equal(t, expect, DefaultQueueConfigData().Bytes())
+}
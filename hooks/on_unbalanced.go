@@ -0,0 +1,32 @@
+package hooks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnUnbalanced handles negative imbalances: funds that have been withdrawn from
+// an account, but have not yet been deposited elsewhere or removed from the total issuance.
+type OnUnbalanced interface {
+	// OnUnbalanceds handles a batch of imbalances. When charging transactions,
+	// the first imbalance is the fee and the second one is the tip.
+	OnUnbalanceds(imbalances sc.Sequence[primitives.Balance]) error
+}
+
+// DefaultOnUnbalanced burns the imbalances by removing them from the total issuance.
+type DefaultOnUnbalanced struct {
+	currencyAdapter primitives.CurrencyAdapter
+}
+
+func NewDefaultOnUnbalanced(currencyAdapter primitives.CurrencyAdapter) DefaultOnUnbalanced {
+	return DefaultOnUnbalanced{currencyAdapter: currencyAdapter}
+}
+
+func (d DefaultOnUnbalanced) OnUnbalanceds(imbalances sc.Sequence[primitives.Balance]) error {
+	total := sc.NewU128(0)
+	for _, imbalance := range imbalances {
+		total = sc.SaturatingAddU128(total, imbalance)
+	}
+
+	return d.currencyAdapter.DropNegativeImbalance(total)
+}
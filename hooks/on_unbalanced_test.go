@@ -0,0 +1,33 @@
+package hooks
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DefaultOnUnbalanced_OnUnbalanceds(t *testing.T) {
+	mockCurrencyAdapter := new(mocks.CurrencyAdapter)
+	target := NewDefaultOnUnbalanced(mockCurrencyAdapter)
+	mockCurrencyAdapter.On("DropNegativeImbalance", sc.NewU128(15)).Return(nil)
+
+	err := target.OnUnbalanceds(sc.Sequence[primitives.Balance]{sc.NewU128(10), sc.NewU128(5)})
+
+	assert.NoError(t, err)
+	mockCurrencyAdapter.AssertCalled(t, "DropNegativeImbalance", sc.NewU128(15))
+}
+
+func Test_DefaultOnUnbalanced_OnUnbalanceds_Fails(t *testing.T) {
+	mockCurrencyAdapter := new(mocks.CurrencyAdapter)
+	target := NewDefaultOnUnbalanced(mockCurrencyAdapter)
+	expectErr := errors.New("error")
+	mockCurrencyAdapter.On("DropNegativeImbalance", sc.NewU128(10)).Return(expectErr)
+
+	err := target.OnUnbalanceds(sc.Sequence[primitives.Balance]{sc.NewU128(10)})
+
+	assert.Equal(t, expectErr, err)
+}
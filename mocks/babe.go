@@ -5,6 +5,7 @@ import (
 
 	sc "github.com/LimeChain/goscale"
 	babetypes "github.com/LimeChain/gosemble/primitives/babe"
+	"github.com/LimeChain/gosemble/primitives/session"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/mock"
 )
@@ -277,3 +278,18 @@ func (m *BabeModule) NextEpoch() (babetypes.Epoch, error) {
 
 	return args.Get(0).(babetypes.Epoch), args.Error(1)
 }
+
+func (m *BabeModule) SubmitUnsignedEquivocationReport(equivocationProof babetypes.EquivocationProof, keyOwnerProof session.MembershipProof) error {
+	args := m.Called(equivocationProof, keyOwnerProof)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *BabeModule) HistoricalKeyOwnershipProof(authorityId primitives.AccountId) sc.Option[babetypes.OpaqueKeyOwnershipProof] {
+	args := m.Called(authorityId)
+	return args.Get(0).(sc.Option[babetypes.OpaqueKeyOwnershipProof])
+}
@@ -29,3 +29,13 @@ func (m *CurrencyAdapter) Withdraw(who types.AccountId, value sc.U128, reasons s
 
 	return args.Get(0).(types.Balance), nil
 }
+
+func (m *CurrencyAdapter) DropNegativeImbalance(value types.Balance) error {
+	args := m.Called(value)
+
+	if args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+
+	return nil
+}
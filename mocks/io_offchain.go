@@ -0,0 +1,12 @@
+package mocks
+
+import "github.com/stretchr/testify/mock"
+
+type IoOffchain struct {
+	mock.Mock
+}
+
+func (m *IoOffchain) SubmitTransaction(value []byte) []byte {
+	args := m.Called(value)
+	return args.Get(0).([]byte)
+}
@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type LockableCurrency struct {
+	mock.Mock
+}
+
+func (m *LockableCurrency) FreeBalance(who types.AccountId) (types.Balance, error) {
+	args := m.Called(who)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *LockableCurrency) Transfer(from types.AccountId, to types.AccountId, value types.Balance, liveness types.ExistenceRequirement) error {
+	args := m.Called(from, to, value, liveness)
+
+	if args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+
+	return nil
+}
+
+func (m *LockableCurrency) SetLock(id sc.FixedSequence[sc.U8], who types.AccountId, amount types.Balance, reasons types.Reasons) error {
+	args := m.Called(id, who, amount, reasons)
+
+	if args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+
+	return nil
+}
+
+func (m *LockableCurrency) ExtendLock(id sc.FixedSequence[sc.U8], who types.AccountId, amount types.Balance, reasons types.Reasons) error {
+	args := m.Called(id, who, amount, reasons)
+
+	if args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+
+	return nil
+}
+
+func (m *LockableCurrency) RemoveLock(id sc.FixedSequence[sc.U8], who types.AccountId) error {
+	args := m.Called(id, who)
+
+	if args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+
+	return nil
+}
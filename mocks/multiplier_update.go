@@ -0,0 +1,18 @@
+package mocks
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type MultiplierUpdate struct {
+	mock.Mock
+}
+
+func (m *MultiplierUpdate) Convert(previous primitives.FixedU128) (primitives.FixedU128, error) {
+	args := m.Called(previous)
+	if args.Get(1) == nil {
+		return args.Get(0).(primitives.FixedU128), nil
+	}
+	return args.Get(0).(primitives.FixedU128), args.Get(1).(error)
+}
@@ -0,0 +1,19 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type OnUnbalanced struct {
+	mock.Mock
+}
+
+func (m *OnUnbalanced) OnUnbalanceds(imbalances sc.Sequence[primitives.Balance]) error {
+	args := m.Called(imbalances)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
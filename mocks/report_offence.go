@@ -0,0 +1,22 @@
+package mocks
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type ReportOffence struct {
+	mock.Mock
+}
+
+func (m *ReportOffence) ReportOffence(reporters sc.Sequence[primitives.AccountId], offence staking.Offence) error {
+	args := m.Called(reporters, offence)
+	return args.Error(0)
+}
+
+func (m *ReportOffence) IsKnownOffence(kind sc.FixedSequence[sc.U8], offenders sc.Sequence[primitives.AccountId], timeSlot sc.Encodable) (bool, error) {
+	args := m.Called(kind, offenders, timeSlot)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,80 @@
+package mocks
+
+import (
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/mock"
+)
+
+type ReservableCurrency struct {
+	mock.Mock
+}
+
+func (m *ReservableCurrency) CanReserve(who types.AccountId, value types.Balance) (bool, error) {
+	args := m.Called(who, value)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(bool), args.Get(1).(error)
+	}
+
+	return args.Get(0).(bool), nil
+}
+
+func (m *ReservableCurrency) ReservedBalance(who types.AccountId) (types.Balance, error) {
+	args := m.Called(who)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *ReservableCurrency) Reserve(who types.AccountId, value types.Balance) error {
+	args := m.Called(who, value)
+
+	if args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+
+	return nil
+}
+
+func (m *ReservableCurrency) Unreserve(who types.AccountId, value types.Balance) (types.Balance, error) {
+	args := m.Called(who, value)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *ReservableCurrency) Slash(who types.AccountId, value types.Balance) (types.Balance, error) {
+	args := m.Called(who, value)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *ReservableCurrency) SlashReserved(who types.AccountId, value types.Balance) (types.Balance, error) {
+	args := m.Called(who, value)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
+
+func (m *ReservableCurrency) RepatriateReserved(slashed types.AccountId, beneficiary types.AccountId, value types.Balance, status types.BalanceStatus) (types.Balance, error) {
+	args := m.Called(slashed, beneficiary, value, status)
+
+	if args.Get(1) != nil {
+		return args.Get(0).(types.Balance), args.Get(1).(error)
+	}
+
+	return args.Get(0).(types.Balance), nil
+}
@@ -45,6 +45,46 @@ func (m *SessionModule) IsDisabled(index sc.U32) (bool, error) {
 	return args.Bool(0), args.Get(1).(error)
 }
 
+func (m *SessionModule) DisableIndex(index sc.U32) (bool, error) {
+	args := m.Called(index)
+
+	if args.Get(1) == nil {
+		return args.Bool(0), nil
+	}
+
+	return args.Bool(0), args.Get(1).(error)
+}
+
+func (m *SessionModule) Disable(who primitives.AccountId) (bool, error) {
+	args := m.Called(who)
+
+	if args.Get(1) == nil {
+		return args.Bool(0), nil
+	}
+
+	return args.Bool(0), args.Get(1).(error)
+}
+
+func (m *SessionModule) LoadKeys(who primitives.AccountId) (sc.Option[sc.Sequence[primitives.SessionKey]], error) {
+	args := m.Called(who)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.Option[sc.Sequence[primitives.SessionKey]]), nil
+	}
+
+	return args.Get(0).(sc.Option[sc.Sequence[primitives.SessionKey]]), args.Get(1).(error)
+}
+
+func (m *SessionModule) KeyOwner(key primitives.SessionKey) (sc.Option[primitives.AccountId], error) {
+	args := m.Called(key)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.Option[primitives.AccountId]), nil
+	}
+
+	return args.Get(0).(sc.Option[primitives.AccountId]), args.Get(1).(error)
+}
+
 func (m *SessionModule) DecodeKeys(buffer *bytes.Buffer) (sc.FixedSequence[primitives.Sr25519PublicKey], error) {
 	args := m.Called(buffer)
 
@@ -22,8 +22,14 @@ func (m *SignedExtra) Bytes() []byte {
 	return args.Get(0).([]byte)
 }
 
-func (m *SignedExtra) Decode(buffer *bytes.Buffer) {
-	m.Called(buffer)
+func (m *SignedExtra) Decode(buffer *bytes.Buffer) error {
+	args := m.Called(buffer)
+
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
 }
 
 func (m *SignedExtra) DeepCopy() types.SignedExtra {
@@ -89,6 +95,35 @@ func (m *SignedExtra) PostDispatch(pre sc.Option[sc.Sequence[types.Pre]], info *
 	return nil
 }
 
+func (m *SignedExtra) ValidateGeneral(origin types.RuntimeOrigin, call types.Call, info *types.DispatchInfo, length sc.Compact) (types.ValidTransaction, types.RuntimeOrigin, error) {
+	args := m.Called(origin, call, info, length)
+
+	if args.Get(2) != nil {
+		return args.Get(0).(types.ValidTransaction), args.Get(1).(types.RuntimeOrigin), args.Get(2).(error)
+	}
+
+	return args.Get(0).(types.ValidTransaction), args.Get(1).(types.RuntimeOrigin), nil
+}
+
+func (m *SignedExtra) PrepareGeneral(origin types.RuntimeOrigin, call types.Call, info *types.DispatchInfo, length sc.Compact) (sc.Sequence[sc.Option[types.Pre]], types.RuntimeOrigin, error) {
+	args := m.Called(origin, call, info, length)
+
+	if args.Get(2) != nil {
+		return args.Get(0).(sc.Sequence[sc.Option[types.Pre]]), args.Get(1).(types.RuntimeOrigin), args.Get(2).(error)
+	}
+
+	return args.Get(0).(sc.Sequence[sc.Option[types.Pre]]), args.Get(1).(types.RuntimeOrigin), nil
+}
+
+func (m *SignedExtra) PostDispatchGeneral(pre sc.Sequence[sc.Option[types.Pre]], info *types.DispatchInfo, postInfo *types.PostDispatchInfo, length sc.Compact, dispatchErr error) error {
+	args := m.Called(pre, info, postInfo, length, dispatchErr)
+
+	if args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+	return nil
+}
+
 func (m *SignedExtra) Metadata() sc.Sequence[types.MetadataSignedExtension] {
 	args := m.Called()
 
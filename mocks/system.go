@@ -362,6 +362,14 @@ func (m *SystemModule) StorageBlockNumberSet(blockNumber sc.U64) {
 	m.Called(blockNumber)
 }
 
+func (m *SystemModule) StorageExtrinsicIndex() (sc.U32, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).(sc.U32), nil
+	}
+	return args.Get(0).(sc.U32), args.Get(1).(error)
+}
+
 func (m *SystemModule) StorageLastRuntimeUpgrade() (types.LastRuntimeUpgradeInfo, error) {
 	args := m.Called()
 	if args.Get(1) == nil {
@@ -42,6 +42,16 @@ func (uxt *UncheckedExtrinsic) IsSigned() bool {
 	return args.Get(0).(bool)
 }
 
+func (uxt *UncheckedExtrinsic) IsGeneral() bool {
+	args := uxt.Called()
+	return args.Get(0).(bool)
+}
+
+func (uxt *UncheckedExtrinsic) IsBare() bool {
+	args := uxt.Called()
+	return args.Get(0).(bool)
+}
+
 func (uxt *UncheckedExtrinsic) Check() (primitives.CheckedExtrinsic, error) {
 	args := uxt.Called()
 
@@ -0,0 +1,73 @@
+package babe
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Represents an equivocation proof. An equivocation happens when a validator
+// produces more than one block on the same slot. The proof of equivocation
+// are the given distinct headers that were signed by the validator and which
+// include the slot number.
+type EquivocationProof struct {
+	// The authority id of the equivocator.
+	Offender types.Sr25519PublicKey
+	// The slot at which the equivocation happened.
+	Slot Slot
+	// The first header involved in the equivocation.
+	FirstHeader types.Header
+	// The second header involved in the equivocation.
+	SecondHeader types.Header
+}
+
+func (e EquivocationProof) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		e.Offender,
+		e.Slot,
+		e.FirstHeader,
+		e.SecondHeader,
+	)
+}
+
+func (e EquivocationProof) Bytes() []byte {
+	return sc.EncodedBytes(e)
+}
+
+func DecodeEquivocationProof(buffer *bytes.Buffer) (EquivocationProof, error) {
+	offender, err := types.DecodeSr25519PublicKey(buffer)
+	if err != nil {
+		return EquivocationProof{}, err
+	}
+
+	slot, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return EquivocationProof{}, err
+	}
+
+	firstHeader, err := types.DecodeHeader(buffer)
+	if err != nil {
+		return EquivocationProof{}, err
+	}
+
+	secondHeader, err := types.DecodeHeader(buffer)
+	if err != nil {
+		return EquivocationProof{}, err
+	}
+
+	return EquivocationProof{
+		Offender:     offender,
+		Slot:         slot,
+		FirstHeader:  firstHeader,
+		SecondHeader: secondHeader,
+	}, nil
+}
+
+// An opaque type used to represent the key ownership proof at the runtime API
+// boundary. The inner value is an encoded representation of the actual key
+// ownership proof which will be parameterized when defining the runtime. At
+// the runtime API boundary this type is unknown and as such we keep this
+// opaque representation, implementors of the runtime API will have to make
+// sure that all usages of `OpaqueKeyOwnershipProof` refer to the same type.
+type OpaqueKeyOwnershipProof = sc.Sequence[sc.U8]
@@ -0,0 +1,86 @@
+package babe
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	equivocationHeader = primitives.Header{
+		ParentHash:     primitives.Blake2bHash{FixedSequence: constants.ZeroAccountId.FixedSequence},
+		Number:         5,
+		StateRoot:      primitives.H256{FixedSequence: constants.OneAccountId.FixedSequence},
+		ExtrinsicsRoot: primitives.H256{FixedSequence: constants.TwoAccountId.FixedSequence},
+		Digest: primitives.NewDigest(sc.Sequence[primitives.DigestItem]{
+			primitives.NewDigestItemPreRuntime(
+				sc.BytesToFixedSequenceU8([]byte{'B', 'A', 'B', 'E'}),
+				sc.BytesToSequenceU8(expectedSecondaryPlainPreDigest.Bytes()),
+			),
+		}),
+	}
+
+	equivocationSecondHeader = primitives.Header{
+		ParentHash:     primitives.Blake2bHash{FixedSequence: constants.ZeroAccountId.FixedSequence},
+		Number:         5,
+		StateRoot:      primitives.H256{FixedSequence: constants.TwoAccountId.FixedSequence},
+		ExtrinsicsRoot: primitives.H256{FixedSequence: constants.OneAccountId.FixedSequence},
+		Digest:         equivocationHeader.Digest,
+	}
+
+	equivocationProof = EquivocationProof{
+		Offender:     primitives.Sr25519PublicKey{FixedSequence: constants.OneAccountId.FixedSequence},
+		Slot:         slot,
+		FirstHeader:  equivocationHeader,
+		SecondHeader: equivocationSecondHeader,
+	}
+)
+
+func Test_EquivocationProof_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := equivocationProof.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedEquivocationProofBytes(), buffer.Bytes())
+}
+
+func Test_EquivocationProof_Bytes(t *testing.T) {
+	assert.Equal(t, expectedEquivocationProofBytes(), equivocationProof.Bytes())
+}
+
+func Test_DecodeEquivocationProof(t *testing.T) {
+	buffer := bytes.NewBuffer(expectedEquivocationProofBytes())
+
+	result, err := DecodeEquivocationProof(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, equivocationProof.Bytes(), result.Bytes())
+	assert.Equal(t, equivocationProof.Offender, result.Offender)
+	assert.Equal(t, equivocationProof.Slot, result.Slot)
+}
+
+func Test_DecodeEquivocationProof_Fails(t *testing.T) {
+	proofBytes := expectedEquivocationProofBytes()
+
+	for _, length := range []int{0, 32, 40} {
+		_, err := DecodeEquivocationProof(bytes.NewBuffer(proofBytes[:length]))
+
+		assert.Equal(t, io.EOF, err)
+	}
+}
+
+func expectedEquivocationProofBytes() []byte {
+	buffer := &bytes.Buffer{}
+	buffer.Write(constants.OneAccountId.Bytes())
+	buffer.Write(slot.Bytes())
+	buffer.Write(equivocationHeader.Bytes())
+	buffer.Write(equivocationSecondHeader.Bytes())
+
+	return buffer.Bytes()
+}
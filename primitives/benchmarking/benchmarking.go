@@ -4,6 +4,7 @@ import (
 	"bytes"
 
 	"github.com/LimeChain/gosemble/env"
+	"github.com/LimeChain/gosemble/primitives/io"
 	"github.com/LimeChain/gosemble/primitives/types"
 	"github.com/LimeChain/gosemble/utils"
 
@@ -79,7 +80,8 @@ type BenchmarkResult struct {
 	// RepeatReads sc.U32
 	Writes sc.U32
 	// RepeatWrites sc.U32
-	// ProofSize sc.U32
+	// ProofSize is the size of the storage proof, which is recorded during the execution.
+	ProofSize sc.U32
 	// Keys Vec<(Vec<u8>, u32, u32, bool)> // Skip
 }
 
@@ -87,6 +89,7 @@ func (br BenchmarkResult) Encode(buffer *bytes.Buffer) {
 	br.Time.Encode(buffer)
 	br.Reads.Encode(buffer)
 	br.Writes.Encode(buffer)
+	br.ProofSize.Encode(buffer)
 }
 
 func (br BenchmarkResult) Bytes() []byte {
@@ -108,10 +111,15 @@ func DecodeBenchmarkResult(buffer *bytes.Buffer) (BenchmarkResult, error) {
 	if err != nil {
 		return BenchmarkResult{}, err
 	}
+	proofSize, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return BenchmarkResult{}, err
+	}
 	return BenchmarkResult{
-		Time:   time,
-		Reads:  reads,
-		Writes: writes,
+		Time:      time,
+		Reads:     reads,
+		Writes:    writes,
+		ProofSize: proofSize,
 	}, nil
 }
 
@@ -119,43 +127,45 @@ func CurrentTime() int64 {
 	return env.ExtBenchmarkingCurrentTimeVersion1()
 }
 
+// storageTracker counts the unique storage keys, which are read and written by the benchmarked
+// code. The host counts every storage access, including the repeated accesses of a cached key.
+var storageTracker = io.NewStorageTracker()
+
 func SetWhitelist(key []byte) {
+	storageTracker.Whitelist(key)
 	keyOffsetSize := utils.NewMemoryTranslator().BytesToOffsetAndSize(key)
 	env.ExtBenchmarkingSetWhitelistVersion1(keyOffsetSize)
 }
 
 func ResetReadWriteCount() {
+	storageTracker.Reset()
 	env.ExtBenchmarkingResetReadWriteCountVersion1()
 }
 
 func StartDbTracker() {
+	storageTracker.Start()
 	env.ExtBenchmarkingStartDbTrackerVersion1()
 }
 
 func StopDbTracker() {
+	storageTracker.Stop()
 	env.ExtBenchmarkingStopDbTrackerVersion1()
 }
 
+// DbReadCount returns the number of unique, non-whitelisted keys, which are read since the
+// last reset of the read/write count. A key, which is written before it is read, is not counted.
 func DbReadCount() int32 {
-	return env.ExtBenchmarkingDbReadCountVersion1()
+	return storageTracker.Reads()
 }
 
+// DbWriteCount returns the number of unique, non-whitelisted keys, which are written since the
+// last reset of the read/write count.
 func DbWriteCount() int32 {
-	return env.ExtBenchmarkingDbWriteCountVersion1()
-}
-
-func WipeDb() {
-	env.ExtBenchmarkingWipeDbVersion1()
-}
-
-func CommitDb() {
-	env.ExtBenchmarkingCommitDbVersion1()
-}
-
-func StoreSnapshotDb() {
-	env.ExtBenchmarkingStoreSnapshotDbVersion1()
+	return storageTracker.Writes()
 }
 
-func RestoreSnapshotDb() {
-	env.ExtBenchmarkingRestoreSnapshotDbVersion1()
+// DbProofSize returns the encoded size of the storage proof, which is recorded by the host
+// for the storage keys hit since the last reset of the read/write count.
+func DbProofSize() int32 {
+	return env.ExtBenchmarkingDbProofSizeVersion1()
 }
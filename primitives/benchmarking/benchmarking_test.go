@@ -0,0 +1,47 @@
+package benchmarking
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	targetBenchmarkResult = BenchmarkResult{
+		Time:      sc.NewU128(1_000),
+		Reads:     2,
+		Writes:    3,
+		ProofSize: 4,
+	}
+
+	expectedBenchmarkResultBytes = []byte{
+		0xe8, 0x3, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, // time
+		0x2, 0x0, 0x0, 0x0, // reads
+		0x3, 0x0, 0x0, 0x0, // writes
+		0x4, 0x0, 0x0, 0x0, // proof size
+	}
+)
+
+func Test_BenchmarkResult_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	targetBenchmarkResult.Encode(buffer)
+
+	assert.Equal(t, expectedBenchmarkResultBytes, buffer.Bytes())
+	assert.Equal(t, expectedBenchmarkResultBytes, targetBenchmarkResult.Bytes())
+}
+
+func Test_DecodeBenchmarkResult(t *testing.T) {
+	result, err := DecodeBenchmarkResult(bytes.NewBuffer(targetBenchmarkResult.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, targetBenchmarkResult, result)
+}
+
+func Test_DecodeBenchmarkResult_WithoutProofSize(t *testing.T) {
+	_, err := DecodeBenchmarkResult(bytes.NewBuffer(expectedBenchmarkResultBytes[:24]))
+
+	assert.Error(t, err)
+}
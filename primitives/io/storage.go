@@ -31,12 +31,14 @@ func NewStorage() Storage {
 }
 
 func (s storage) Append(key []byte, value []byte) {
+	trackWrite(key)
 	keyOffsetSize := s.memoryTranslator.BytesToOffsetAndSize(key)
 	valueOffsetSize := s.memoryTranslator.BytesToOffsetAndSize(value)
 	env.ExtStorageAppendVersion1(keyOffsetSize, valueOffsetSize)
 }
 
 func (s storage) Clear(key []byte) {
+	trackWrite(key)
 	keyOffsetSize := s.memoryTranslator.BytesToOffsetAndSize(key)
 	env.ExtStorageClearVersion1(keyOffsetSize)
 }
@@ -44,15 +46,18 @@ func (s storage) Clear(key []byte) {
 func (s storage) ClearPrefix(key []byte, limit []byte) {
 	keyOffsetSize := s.memoryTranslator.BytesToOffsetAndSize(key)
 	limitOffsetSize := s.memoryTranslator.BytesToOffsetAndSize(limit)
-	env.ExtStorageClearPrefixVersion2(keyOffsetSize, limitOffsetSize)
+	resultOffsetSize := env.ExtStorageClearPrefixVersion2(keyOffsetSize, limitOffsetSize)
+	trackClearPrefix(s.memoryTranslator, key, resultOffsetSize)
 }
 
 func (s storage) Exists(key []byte) bool {
+	trackRead(key)
 	keyOffsetSize := s.memoryTranslator.BytesToOffsetAndSize(key)
 	return env.ExtStorageExistsVersion1(keyOffsetSize) != 0
 }
 
 func (s storage) Get(key []byte) (sc.Option[sc.Sequence[sc.U8]], error) {
+	trackRead(key)
 	value := get(s.memoryTranslator, key)
 
 	buffer := &bytes.Buffer{}
@@ -62,6 +67,7 @@ func (s storage) Get(key []byte) (sc.Option[sc.Sequence[sc.U8]], error) {
 }
 
 func (s storage) NextKey(key []byte) (sc.Option[sc.Sequence[sc.U8]], error) {
+	trackRead(key)
 	keyOffsetSize := s.memoryTranslator.BytesToOffsetAndSize(key)
 
 	valueOffsetSize := env.ExtStorageNextKeyVersion1(keyOffsetSize)
@@ -75,6 +81,7 @@ func (s storage) NextKey(key []byte) (sc.Option[sc.Sequence[sc.U8]], error) {
 }
 
 func (s storage) Read(key []byte, valueOut []byte, offset int32) (sc.Option[sc.U32], error) {
+	trackRead(key)
 	value := read(s.memoryTranslator, key, valueOut, offset)
 
 	buffer := &bytes.Buffer{}
@@ -91,6 +98,7 @@ func (s storage) Root(version int32) []byte {
 }
 
 func (s storage) Set(key []byte, value []byte) {
+	trackWrite(key)
 	keyOffsetSize := s.memoryTranslator.BytesToOffsetAndSize(key)
 	valueOffsetSize := s.memoryTranslator.BytesToOffsetAndSize(value)
 	env.ExtStorageSetVersion1(keyOffsetSize, valueOffsetSize)
@@ -0,0 +1,119 @@
+package io
+
+import (
+	"strings"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// StorageTracker tracks the unique storage keys, which are read and written through Storage while the
+// tracker is started. It is used by benchmarks to count the database reads and writes of a single
+// execution, as if each key is read from a cold cache only once. Whitelisted keys are not counted.
+// A prefix clear counts a write for each removed key, which makes the count of writes an upper bound.
+type StorageTracker interface {
+	// Whitelist excludes `key` from the counts.
+	Whitelist(key []byte)
+	// Reset clears the tracked keys and the counts.
+	Reset()
+	Start()
+	Stop()
+	Reads() int32
+	Writes() int32
+}
+
+// keyAccess records how a key has been accessed since the tracker was reset.
+type keyAccess struct {
+	read    bool
+	written bool
+}
+
+type storageTracker struct {
+	started   bool
+	whitelist map[string]bool
+	keys      map[string]keyAccess
+	reads     int32
+	writes    int32
+}
+
+// tracker is shared by all instances of Storage, which are created by the runtime.
+var tracker = &storageTracker{
+	whitelist: map[string]bool{},
+	keys:      map[string]keyAccess{},
+}
+
+func NewStorageTracker() StorageTracker {
+	return tracker
+}
+
+func (t *storageTracker) Whitelist(key []byte) {
+	t.whitelist[string(key)] = true
+}
+
+func (t *storageTracker) Reset() {
+	t.keys = map[string]keyAccess{}
+	t.reads = 0
+	t.writes = 0
+}
+
+func (t *storageTracker) Start() {
+	t.started = true
+}
+
+func (t *storageTracker) Stop() {
+	t.started = false
+}
+
+func (t *storageTracker) Reads() int32 {
+	return t.reads
+}
+
+func (t *storageTracker) Writes() int32 {
+	return t.writes
+}
+
+// read counts a read of `key`, unless it has already been read or written.
+func (t *storageTracker) read(key []byte) {
+	if !t.started || t.whitelist[string(key)] {
+		return
+	}
+
+	access := t.keys[string(key)]
+	if !access.read && !access.written {
+		t.reads++
+	}
+	access.read = true
+	t.keys[string(key)] = access
+}
+
+// write counts a write of `key`, unless it has already been written.
+func (t *storageTracker) write(key []byte) {
+	if !t.started || t.whitelist[string(key)] {
+		return
+	}
+
+	access := t.keys[string(key)]
+	if !access.written {
+		t.writes++
+	}
+	access.written = true
+	t.keys[string(key)] = access
+}
+
+// clearPrefix counts a write for each of the `removed` keys under `prefix`. The host does not return, which
+// keys are removed, so each of them is counted, even if it has already been counted as written. This makes
+// the count an upper bound, which does not depend on the previously tracked keys. The tracked keys under
+// `prefix` are marked as written, so that writing them again is not counted.
+func (t *storageTracker) clearPrefix(prefix []byte, removed sc.U32) {
+	if !t.started || t.whitelist[string(prefix)] {
+		return
+	}
+
+	for key, access := range t.keys {
+		if strings.HasPrefix(key, string(prefix)) {
+			access.written = true
+			t.keys[key] = access
+		}
+	}
+
+	t.writes += int32(removed)
+}
@@ -0,0 +1,138 @@
+package io
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyOne         = []byte("prefix:one")
+	keyTwo         = []byte("prefix:two")
+	keyOther       = []byte("other")
+	keyPrefix      = []byte("prefix:")
+	keyWhitelisted = []byte("whitelisted")
+)
+
+func Test_StorageTracker(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		track          func(target *storageTracker)
+		expectedReads  int32
+		expectedWrites int32
+	}{
+		{
+			name: "Read once",
+			track: func(target *storageTracker) {
+				target.read(keyOne)
+				target.read(keyOne)
+				target.read(keyTwo)
+			},
+			expectedReads: 2,
+		},
+		{
+			name: "Write once",
+			track: func(target *storageTracker) {
+				target.write(keyOne)
+				target.write(keyOne)
+			},
+			expectedWrites: 1,
+		},
+		{
+			name: "Read after write",
+			track: func(target *storageTracker) {
+				target.write(keyOne)
+				target.read(keyOne)
+			},
+			expectedWrites: 1,
+		},
+		{
+			name: "Write after read",
+			track: func(target *storageTracker) {
+				target.read(keyOne)
+				target.write(keyOne)
+				target.read(keyOne)
+			},
+			expectedReads:  1,
+			expectedWrites: 1,
+		},
+		{
+			name: "Whitelisted",
+			track: func(target *storageTracker) {
+				target.read(keyWhitelisted)
+				target.write(keyWhitelisted)
+			},
+		},
+		{
+			name: "Stopped",
+			track: func(target *storageTracker) {
+				target.Stop()
+				target.read(keyOne)
+				target.write(keyTwo)
+				target.clearPrefix(keyPrefix, 2)
+			},
+		},
+		{
+			name: "Clear prefix",
+			track: func(target *storageTracker) {
+				target.clearPrefix(keyPrefix, 2)
+			},
+			expectedWrites: 2,
+		},
+		{
+			name: "Clear prefix after write",
+			track: func(target *storageTracker) {
+				target.write(keyOne)
+				target.clearPrefix(keyPrefix, 2)
+			},
+			expectedWrites: 3,
+		},
+		{
+			name: "Clear prefix after delete",
+			track: func(target *storageTracker) {
+				target.write(keyOne)
+				target.write(keyOther)
+				target.clearPrefix(keyPrefix, 0)
+			},
+			expectedWrites: 2,
+		},
+		{
+			name: "Write after clear prefix",
+			track: func(target *storageTracker) {
+				target.read(keyOne)
+				target.clearPrefix(keyPrefix, 1)
+				target.write(keyOne)
+				target.write(keyOther)
+			},
+			expectedReads:  1,
+			expectedWrites: 2,
+		},
+		{
+			name: "Clear whitelisted prefix",
+			track: func(target *storageTracker) {
+				target.clearPrefix(keyWhitelisted, 1)
+			},
+		},
+		{
+			name: "Reset",
+			track: func(target *storageTracker) {
+				target.read(keyOne)
+				target.write(keyTwo)
+				target.Reset()
+				target.read(keyTwo)
+			},
+			expectedReads: 1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &storageTracker{whitelist: map[string]bool{}, keys: map[string]keyAccess{}}
+			target.Whitelist(keyWhitelisted)
+			target.Start()
+
+			tt.track(target)
+
+			assert.Equal(t, tt.expectedReads, target.Reads())
+			assert.Equal(t, tt.expectedWrites, target.Writes())
+		})
+	}
+}
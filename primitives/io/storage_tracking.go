@@ -0,0 +1,35 @@
+//go:build benchmarking
+
+package io
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/utils"
+)
+
+func trackRead(key []byte) {
+	tracker.read(key)
+}
+
+func trackWrite(key []byte) {
+	tracker.write(key)
+}
+
+// trackClearPrefix counts the keys removed by a prefix clear, which the host returns
+// as a KillStorageResult: a variant byte followed by the number of removed keys.
+func trackClearPrefix(mem utils.WasmMemoryTranslator, prefix []byte, result int64) {
+	offset, size := mem.Int64ToOffsetAndSize(result)
+	buffer := bytes.NewBuffer(mem.GetWasmMemorySlice(offset, size))
+
+	if _, err := buffer.ReadByte(); err != nil {
+		return
+	}
+	removed, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return
+	}
+
+	tracker.clearPrefix(prefix, removed)
+}
@@ -0,0 +1,110 @@
+package npos_elections
+
+import (
+	"math/big"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// balance redistributes the stake of each voter between the winners it backs, so that the backing of the least
+// backed winners is increased, until the maximal difference in backing drops to the tolerance. Returns the number
+// of rounds executed.
+func balance(voters []*voter, config BalancingConfig) sc.U32 {
+	tolerance := config.Tolerance.ToBigInt()
+
+	for round := sc.U32(0); round < config.Iterations; round++ {
+		maxDifference := new(big.Int)
+		for _, v := range voters {
+			difference := balanceVoter(v, tolerance)
+			if difference.Cmp(maxDifference) > 0 {
+				maxDifference = difference
+			}
+		}
+
+		if maxDifference.Cmp(tolerance) <= 0 {
+			return round + 1
+		}
+	}
+
+	return config.Iterations
+}
+
+// balanceVoter redistributes the budget of `v` between the winners it votes for, so that their backings are as
+// even as possible. Returns the difference in backing before balancing.
+func balanceVoter(v *voter, tolerance *big.Int) *big.Int {
+	edges := []*edge{}
+	for _, e := range v.edges {
+		if e.candidate.elected {
+			edges = append(edges, e)
+		}
+	}
+	if len(edges) == 0 {
+		return new(big.Int)
+	}
+
+	used := new(big.Int)
+	var minBacked, maxBacking *big.Int
+	for _, e := range edges {
+		used.Add(used, e.weight)
+		if minBacked == nil || e.candidate.backedStake.Cmp(minBacked) < 0 {
+			minBacked = e.candidate.backedStake
+		}
+		if e.weight.Sign() > 0 && (maxBacking == nil || e.candidate.backedStake.Cmp(maxBacking) > 0) {
+			maxBacking = e.candidate.backedStake
+		}
+	}
+
+	difference := new(big.Int).Set(v.budget)
+	if maxBacking != nil {
+		difference = new(big.Int).Sub(maxBacking, minBacked)
+		unused := new(big.Int).Sub(v.budget, used)
+		if unused.Sign() > 0 {
+			difference.Add(difference, unused)
+		}
+		if difference.Cmp(tolerance) < 0 {
+			return difference
+		}
+	}
+
+	// take the whole budget back and fill up the least backed winners first
+	for _, e := range edges {
+		e.candidate.backedStake = new(big.Int).Sub(e.candidate.backedStake, e.weight)
+		e.weight = new(big.Int)
+	}
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].candidate.backedStake.Cmp(edges[j].candidate.backedStake) < 0
+	})
+
+	cumulative := new(big.Int)
+	lastIndex := len(edges) - 1
+	for i, e := range edges {
+		// the budget needed to raise the backing of the first i winners to that of winner i
+		needed := new(big.Int).Mul(e.candidate.backedStake, big.NewInt(int64(i)))
+		needed.Sub(needed, cumulative)
+		if needed.Cmp(v.budget) > 0 {
+			lastIndex = i - 1
+			break
+		}
+		cumulative.Add(cumulative, e.candidate.backedStake)
+	}
+
+	lastBacked := edges[lastIndex].candidate.backedStake
+	waysToSplit := big.NewInt(int64(lastIndex + 1))
+	excess := new(big.Int).Add(v.budget, cumulative)
+	excess.Sub(excess, new(big.Int).Mul(lastBacked, waysToSplit))
+	share, remainder := new(big.Int).QuoRem(excess, waysToSplit, new(big.Int))
+
+	for i, e := range edges[:lastIndex+1] {
+		weight := new(big.Int).Add(share, lastBacked)
+		weight.Sub(weight, e.candidate.backedStake)
+		// the rounding remainder goes to the least backed winner
+		if i == 0 {
+			weight.Add(weight, remainder)
+		}
+		e.weight = weight
+		e.candidate.backedStake = new(big.Int).Add(e.candidate.backedStake, e.weight)
+	}
+
+	return difference
+}
@@ -0,0 +1,64 @@
+package npos_elections
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ElectionDataProvider provides the data of an election, e.g. the staking module.
+type ElectionDataProvider interface {
+	// DesiredTargets returns the number of targets to elect.
+	DesiredTargets() (sc.U32, error)
+	// ElectableTargets returns the targets, which can be elected.
+	ElectableTargets() (sc.Sequence[primitives.AccountId], error)
+	// ElectingVoters returns the voters of the election, together with their stake and targets.
+	ElectingVoters() ([]Voter, error)
+}
+
+// ElectionProvider elects winners from the data of an ElectionDataProvider.
+type ElectionProvider interface {
+	Elect(dataProvider ElectionDataProvider) (Supports, error)
+}
+
+// Solver computes the result of an election, e.g. SequentialPhragmen or PhragMMS.
+type Solver func(toElect sc.U32, targets sc.Sequence[primitives.AccountId], voters []Voter, balancing sc.Option[BalancingConfig]) ElectionResult
+
+// OnChain is an ElectionProvider, which computes the election synchronously, when it is requested.
+type OnChain struct {
+	solver    Solver
+	balancing sc.Option[BalancingConfig]
+}
+
+func NewOnChain(solver Solver, balancing sc.Option[BalancingConfig]) OnChain {
+	return OnChain{
+		solver:    solver,
+		balancing: balancing,
+	}
+}
+
+func NewOnChainSequentialPhragmen(balancing sc.Option[BalancingConfig]) OnChain {
+	return NewOnChain(SequentialPhragmen, balancing)
+}
+
+func NewOnChainPhragMMS(balancing sc.Option[BalancingConfig]) OnChain {
+	return NewOnChain(PhragMMS, balancing)
+}
+
+func (oc OnChain) Elect(dataProvider ElectionDataProvider) (Supports, error) {
+	desiredTargets, err := dataProvider.DesiredTargets()
+	if err != nil {
+		return nil, err
+	}
+	targets, err := dataProvider.ElectableTargets()
+	if err != nil {
+		return nil, err
+	}
+	voters, err := dataProvider.ElectingVoters()
+	if err != nil {
+		return nil, err
+	}
+
+	result := oc.solver(desiredTargets, targets, voters, oc.balancing)
+
+	return result.Supports(), nil
+}
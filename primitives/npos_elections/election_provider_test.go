@@ -0,0 +1,50 @@
+package npos_elections
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type dataProvider struct {
+	desiredTargets sc.U32
+	targets        sc.Sequence[primitives.AccountId]
+	voters         []Voter
+	err            error
+}
+
+func (dp dataProvider) DesiredTargets() (sc.U32, error) {
+	return dp.desiredTargets, dp.err
+}
+
+func (dp dataProvider) ElectableTargets() (sc.Sequence[primitives.AccountId], error) {
+	return dp.targets, nil
+}
+
+func (dp dataProvider) ElectingVoters() ([]Voter, error) {
+	return dp.voters, nil
+}
+
+func Test_OnChain_Elect(t *testing.T) {
+	for _, target := range []OnChain{
+		NewOnChainSequentialPhragmen(noBalancing),
+		NewOnChainPhragMMS(noBalancing),
+	} {
+		result, err := target.Elect(dataProvider{desiredTargets: 2, targets: targets, voters: voters})
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedSupports, result)
+	}
+}
+
+func Test_OnChain_Elect_Error(t *testing.T) {
+	expectedErr := errors.New("error")
+	target := NewOnChainSequentialPhragmen(noBalancing)
+
+	_, err := target.Elect(dataProvider{err: expectedErr})
+
+	assert.Equal(t, expectedErr, err)
+}
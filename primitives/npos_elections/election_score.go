@@ -0,0 +1,98 @@
+package npos_elections
+
+import (
+	"bytes"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ElectionScore is the score of an election result, by which different results for the same election are compared.
+type ElectionScore struct {
+	// MinimalStake is the minimal backing of a winner. Higher is better.
+	MinimalStake primitives.Balance
+	// SumStake is the sum of the backings of all winners. Higher is better.
+	SumStake primitives.Balance
+	// SumStakeSquared is the sum of the squared backings of all winners. Lower is better.
+	SumStakeSquared primitives.Balance
+}
+
+func (es ElectionScore) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		es.MinimalStake,
+		es.SumStake,
+		es.SumStakeSquared,
+	)
+}
+
+func (es ElectionScore) Bytes() []byte {
+	return sc.EncodedBytes(es)
+}
+
+func DecodeElectionScore(buffer *bytes.Buffer) (ElectionScore, error) {
+	minimalStake, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return ElectionScore{}, err
+	}
+	sumStake, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return ElectionScore{}, err
+	}
+	sumStakeSquared, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return ElectionScore{}, err
+	}
+	return ElectionScore{
+		MinimalStake:    minimalStake,
+		SumStake:        sumStake,
+		SumStakeSquared: sumStakeSquared,
+	}, nil
+}
+
+// StrictlyBetter returns whether the score is better than `other`, comparing the minimal stake,
+// the sum of stake and the sum of squared stake, in that order.
+func (es ElectionScore) StrictlyBetter(other ElectionScore) bool {
+	if !es.MinimalStake.Eq(other.MinimalStake) {
+		return es.MinimalStake.Gt(other.MinimalStake)
+	}
+	if !es.SumStake.Eq(other.SumStake) {
+		return es.SumStake.Gt(other.SumStake)
+	}
+	return es.SumStakeSquared.Lt(other.SumStakeSquared)
+}
+
+// EvaluateSupport computes the score of the supports of an election. The sums saturate at the maximum balance.
+func EvaluateSupport(supports Supports) ElectionScore {
+	if len(supports) == 0 {
+		return ElectionScore{MinimalStake: sc.NewU128(0), SumStake: sc.NewU128(0), SumStakeSquared: sc.NewU128(0)}
+	}
+
+	minimalStake := supports[0].Support.Total.ToBigInt()
+	sumStake := new(big.Int)
+	sumStakeSquared := new(big.Int)
+
+	for _, ts := range supports {
+		total := ts.Support.Total.ToBigInt()
+		if total.Cmp(minimalStake) < 0 {
+			minimalStake = total
+		}
+		sumStake.Add(sumStake, total)
+		sumStakeSquared.Add(sumStakeSquared, new(big.Int).Mul(total, total))
+	}
+
+	return ElectionScore{
+		MinimalStake:    sc.NewU128(minimalStake),
+		SumStake:        saturatingU128(sumStake),
+		SumStakeSquared: saturatingU128(sumStakeSquared),
+	}
+}
+
+var maxU128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+func saturatingU128(value *big.Int) primitives.Balance {
+	if value.Cmp(maxU128) > 0 {
+		return sc.NewU128(maxU128)
+	}
+	return sc.NewU128(value)
+}
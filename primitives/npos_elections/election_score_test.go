@@ -0,0 +1,83 @@
+package npos_elections
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	electionScore = ElectionScore{
+		MinimalStake:    sc.NewU128(25),
+		SumStake:        sc.NewU128(60),
+		SumStakeSquared: sc.NewU128(1850),
+	}
+)
+
+func Test_ElectionScore_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := electionScore.Encode(buffer)
+
+	assert.NoError(t, err)
+	expected := append(append(sc.NewU128(25).Bytes(), sc.NewU128(60).Bytes()...), sc.NewU128(1850).Bytes()...)
+	assert.Equal(t, expected, buffer.Bytes())
+	assert.Equal(t, expected, electionScore.Bytes())
+}
+
+func Test_DecodeElectionScore(t *testing.T) {
+	buffer := bytes.NewBuffer(electionScore.Bytes())
+
+	result, err := DecodeElectionScore(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, electionScore, result)
+}
+
+func Test_ElectionScore_StrictlyBetter(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		other    ElectionScore
+		expected bool
+	}{
+		{
+			name:     "lower minimal stake",
+			other:    ElectionScore{MinimalStake: sc.NewU128(24), SumStake: sc.NewU128(100), SumStakeSquared: sc.NewU128(0)},
+			expected: true,
+		},
+		{
+			name:     "higher minimal stake",
+			other:    ElectionScore{MinimalStake: sc.NewU128(26), SumStake: sc.NewU128(0), SumStakeSquared: sc.NewU128(0)},
+			expected: false,
+		},
+		{
+			name:     "lower sum of stake",
+			other:    ElectionScore{MinimalStake: sc.NewU128(25), SumStake: sc.NewU128(59), SumStakeSquared: sc.NewU128(0)},
+			expected: true,
+		},
+		{
+			name:     "higher sum of squared stake",
+			other:    ElectionScore{MinimalStake: sc.NewU128(25), SumStake: sc.NewU128(60), SumStakeSquared: sc.NewU128(1851)},
+			expected: true,
+		},
+		{
+			name:     "equal",
+			other:    electionScore,
+			expected: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, electionScore.StrictlyBetter(tt.other))
+		})
+	}
+}
+
+func Test_EvaluateSupport(t *testing.T) {
+	assert.Equal(t, electionScore, EvaluateSupport(expectedResult.Supports()))
+}
+
+func Test_EvaluateSupport_Empty(t *testing.T) {
+	assert.Equal(t, ElectionScore{MinimalStake: sc.NewU128(0), SumStake: sc.NewU128(0), SumStakeSquared: sc.NewU128(0)}, EvaluateSupport(Supports{}))
+}
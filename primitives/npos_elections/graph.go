@@ -0,0 +1,134 @@
+package npos_elections
+
+import (
+	"math/big"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// candidate is a target in the election graph.
+type candidate struct {
+	who           primitives.AccountId
+	score         *big.Rat
+	approvalStake *big.Int
+	backedStake   *big.Int
+	elected       bool
+	round         int
+}
+
+// edge connects a voter to one of its targets.
+type edge struct {
+	candidate *candidate
+	load      *big.Rat
+	weight    *big.Int
+}
+
+// voter is a voter in the election graph.
+type voter struct {
+	who    primitives.AccountId
+	budget *big.Int
+	load   *big.Rat
+	edges  []*edge
+}
+
+// setup builds the election graph. Targets of voters, which are not candidates, and duplicate targets are ignored.
+func setup(targets sc.Sequence[primitives.AccountId], voters []Voter) ([]*candidate, []*voter) {
+	candidates := make([]*candidate, 0, len(targets))
+	indexOf := make(map[string]int, len(targets))
+
+	for _, target := range targets {
+		key := string(target.Bytes())
+		if _, ok := indexOf[key]; ok {
+			continue
+		}
+		indexOf[key] = len(candidates)
+		candidates = append(candidates, &candidate{
+			who:           target,
+			score:         new(big.Rat),
+			approvalStake: new(big.Int),
+			backedStake:   new(big.Int),
+		})
+	}
+
+	graph := make([]*voter, 0, len(voters))
+	for _, v := range voters {
+		budget := v.Stake.ToBigInt()
+		edges := []*edge{}
+		for _, target := range v.Targets {
+			i, ok := indexOf[string(target.Bytes())]
+			if !ok || containsCandidate(edges, candidates[i]) {
+				continue
+			}
+			candidates[i].approvalStake.Add(candidates[i].approvalStake, budget)
+			edges = append(edges, &edge{
+				candidate: candidates[i],
+				load:      new(big.Rat),
+				weight:    new(big.Int),
+			})
+		}
+
+		graph = append(graph, &voter{
+			who:    v.Who,
+			budget: budget,
+			load:   new(big.Rat),
+			edges:  edges,
+		})
+	}
+
+	return candidates, graph
+}
+
+// buildResult collects the winners, ordered by the round in which they were elected, and the stake of each
+// voter, which backs them.
+func buildResult(candidates []*candidate, voters []*voter) ElectionResult {
+	elected := []*candidate{}
+	for _, c := range candidates {
+		if c.elected {
+			elected = append(elected, c)
+		}
+	}
+	sort.SliceStable(elected, func(i, j int) bool {
+		return elected[i].round < elected[j].round
+	})
+
+	winners := sc.Sequence[Winner]{}
+	for _, c := range elected {
+		winners = append(winners, Winner{Who: c.who, Backing: sc.NewU128(c.backedStake)})
+	}
+
+	assignments := sc.Sequence[StakedAssignment]{}
+	for _, v := range voters {
+		distribution := sc.Sequence[StakedEdge]{}
+		for _, e := range v.edges {
+			if e.candidate.elected && e.weight.Sign() > 0 {
+				distribution = append(distribution, StakedEdge{Target: e.candidate.who, Value: sc.NewU128(e.weight)})
+			}
+		}
+		if len(distribution) > 0 {
+			assignments = append(assignments, StakedAssignment{Who: v.who, Distribution: distribution})
+		}
+	}
+
+	return ElectionResult{
+		Winners:     winners,
+		Assignments: assignments,
+	}
+}
+
+func containsCandidate(edges []*edge, c *candidate) bool {
+	for _, e := range edges {
+		if e.candidate == c {
+			return true
+		}
+	}
+	return false
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,102 @@
+package npos_elections
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// SequentialPhragmen elects up to `toElect` of the `targets` using the sequential Phragmén method. In each round
+// the candidate, which minimises the maximal load of its voters, is elected. The stake of each voter is then
+// distributed between the winners it votes for in proportion to its load on them, and optionally balanced.
+func SequentialPhragmen(toElect sc.U32, targets sc.Sequence[primitives.AccountId], voters []Voter, balancing sc.Option[BalancingConfig]) ElectionResult {
+	candidates, graph := setup(targets, voters)
+
+	seqPhragmenCore(minInt(int(toElect), len(candidates)), candidates, graph)
+
+	if balancing.HasValue {
+		balance(graph, balancing.Value)
+	}
+
+	return buildResult(candidates, graph)
+}
+
+func seqPhragmenCore(toElect int, candidates []*candidate, voters []*voter) {
+	for round := 0; round < toElect; round++ {
+		for _, c := range candidates {
+			if !c.elected && c.approvalStake.Sign() > 0 {
+				c.score = new(big.Rat).SetFrac(big.NewInt(1), c.approvalStake)
+			}
+		}
+
+		for _, v := range voters {
+			for _, e := range v.edges {
+				c := e.candidate
+				if c.elected || c.approvalStake.Sign() == 0 {
+					continue
+				}
+				// budget * load / approval
+				increase := new(big.Rat).Mul(new(big.Rat).SetInt(v.budget), v.load)
+				increase.Quo(increase, new(big.Rat).SetInt(c.approvalStake))
+				c.score = new(big.Rat).Add(c.score, increase)
+			}
+		}
+
+		var winner *candidate
+		for _, c := range candidates {
+			if c.elected || c.approvalStake.Sign() == 0 {
+				continue
+			}
+			if winner == nil || c.score.Cmp(winner.score) < 0 {
+				winner = c
+			}
+		}
+		if winner == nil {
+			break
+		}
+
+		winner.elected = true
+		winner.round = round
+
+		for _, v := range voters {
+			for _, e := range v.edges {
+				if e.candidate == winner {
+					e.load = new(big.Rat).Sub(winner.score, v.load)
+					v.load = winner.score
+				}
+			}
+		}
+	}
+
+	// distribute the budget of each voter in proportion to its load on each winner
+	for _, v := range voters {
+		if v.load.Sign() == 0 {
+			continue
+		}
+
+		var last *edge
+		distributed := new(big.Int)
+		for _, e := range v.edges {
+			if !e.candidate.elected || e.load.Sign() == 0 {
+				continue
+			}
+			share := new(big.Rat).Mul(new(big.Rat).SetInt(v.budget), e.load)
+			share.Quo(share, v.load)
+			e.weight = new(big.Int).Quo(share.Num(), share.Denom())
+			distributed.Add(distributed, e.weight)
+			last = e
+		}
+
+		// the rounding remainder is assigned to the last winner
+		if last != nil {
+			last.weight.Add(last.weight, new(big.Int).Sub(v.budget, distributed))
+		}
+
+		for _, e := range v.edges {
+			if e.candidate.elected {
+				e.candidate.backedStake.Add(e.candidate.backedStake, e.weight)
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package npos_elections
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	one, two, three = accountId(1), accountId(2), accountId(3)
+	voter10         = accountId(10)
+	voter20         = accountId(20)
+	voter30         = accountId(30)
+
+	noBalancing = sc.NewOption[BalancingConfig](nil)
+
+	targets = sc.Sequence[primitives.AccountId]{one, two, three}
+	voters  = []Voter{
+		{Who: voter10, Stake: sc.NewU128(10), Targets: sc.Sequence[primitives.AccountId]{one, two}},
+		{Who: voter20, Stake: sc.NewU128(20), Targets: sc.Sequence[primitives.AccountId]{one, three}},
+		{Who: voter30, Stake: sc.NewU128(30), Targets: sc.Sequence[primitives.AccountId]{two, three}},
+	}
+
+	expectedResult = ElectionResult{
+		Winners: sc.Sequence[Winner]{
+			{Who: three, Backing: sc.NewU128(35)},
+			{Who: two, Backing: sc.NewU128(25)},
+		},
+		Assignments: sc.Sequence[StakedAssignment]{
+			{Who: voter10, Distribution: sc.Sequence[StakedEdge]{{Target: two, Value: sc.NewU128(10)}}},
+			{Who: voter20, Distribution: sc.Sequence[StakedEdge]{{Target: three, Value: sc.NewU128(20)}}},
+			{Who: voter30, Distribution: sc.Sequence[StakedEdge]{
+				{Target: two, Value: sc.NewU128(15)},
+				{Target: three, Value: sc.NewU128(15)},
+			}},
+		},
+	}
+)
+
+func Test_SequentialPhragmen(t *testing.T) {
+	result := SequentialPhragmen(2, targets, voters, noBalancing)
+
+	assert.Equal(t, expectedResult, result)
+}
+
+func Test_SequentialPhragmen_ElectsAllTargets(t *testing.T) {
+	result := SequentialPhragmen(5, targets, voters, noBalancing)
+
+	assert.Equal(t, 3, len(result.Winners))
+	total := sc.NewU128(0)
+	for _, winner := range result.Winners {
+		total = sc.SaturatingAddU128(total, winner.Backing)
+	}
+	assert.Equal(t, sc.NewU128(60), total)
+}
+
+func Test_SequentialPhragmen_SkipsUnapprovedTargets(t *testing.T) {
+	result := SequentialPhragmen(2, sc.Sequence[primitives.AccountId]{one, two}, []Voter{
+		{Who: voter10, Stake: sc.NewU128(10), Targets: sc.Sequence[primitives.AccountId]{one, one, three}},
+	}, noBalancing)
+
+	assert.Equal(t, ElectionResult{
+		Winners: sc.Sequence[Winner]{{Who: one, Backing: sc.NewU128(10)}},
+		Assignments: sc.Sequence[StakedAssignment]{
+			{Who: voter10, Distribution: sc.Sequence[StakedEdge]{{Target: one, Value: sc.NewU128(10)}}},
+		},
+	}, result)
+}
+
+func Test_SequentialPhragmen_Balancing(t *testing.T) {
+	result := SequentialPhragmen(2, sc.Sequence[primitives.AccountId]{one, two}, []Voter{
+		{Who: voter10, Stake: sc.NewU128(10), Targets: sc.Sequence[primitives.AccountId]{one, two}},
+		{Who: voter20, Stake: sc.NewU128(5), Targets: sc.Sequence[primitives.AccountId]{one}},
+	}, sc.NewOption[BalancingConfig](BalancingConfig{Iterations: 10, Tolerance: sc.NewU128(0)}))
+
+	assert.Equal(t, ElectionResult{
+		Winners: sc.Sequence[Winner]{
+			{Who: one, Backing: sc.NewU128(7)},
+			{Who: two, Backing: sc.NewU128(8)},
+		},
+		Assignments: sc.Sequence[StakedAssignment]{
+			{Who: voter10, Distribution: sc.Sequence[StakedEdge]{
+				{Target: one, Value: sc.NewU128(2)},
+				{Target: two, Value: sc.NewU128(8)},
+			}},
+			{Who: voter20, Distribution: sc.Sequence[StakedEdge]{{Target: one, Value: sc.NewU128(5)}}},
+		},
+	}, result)
+}
+
+func Test_SequentialPhragmen_NoVoters(t *testing.T) {
+	result := SequentialPhragmen(2, targets, []Voter{}, noBalancing)
+
+	assert.Equal(t, ElectionResult{Winners: sc.Sequence[Winner]{}, Assignments: sc.Sequence[StakedAssignment]{}}, result)
+}
+
+func accountId(b byte) primitives.AccountId {
+	bytes := make([]byte, 32)
+	bytes[0] = b
+
+	who, err := primitives.NewAccountId(sc.BytesToSequenceU8(bytes)...)
+	if err != nil {
+		panic(err)
+	}
+	return who
+}
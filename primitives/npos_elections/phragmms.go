@@ -0,0 +1,121 @@
+package npos_elections
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// PhragMMS elects up to `toElect` of the `targets` using the PhragMMS method. In each round the candidate with
+// the maximal score is elected and stake is moved to it from the winners backed above its score. The stake of
+// the voters is optionally balanced after each round.
+func PhragMMS(toElect sc.U32, targets sc.Sequence[primitives.AccountId], voters []Voter, balancing sc.Option[BalancingConfig]) ElectionResult {
+	candidates, graph := setup(targets, voters)
+
+	rounds := minInt(int(toElect), len(candidates))
+	for round := 0; round < rounds; round++ {
+		winner := calculateMaxScore(candidates, graph)
+		if winner == nil {
+			break
+		}
+
+		applyElected(graph, winner)
+		winner.elected = true
+		winner.round = round
+
+		if balancing.HasValue {
+			balance(graph, balancing.Value)
+		}
+	}
+
+	return buildResult(candidates, graph)
+}
+
+// calculateMaxScore computes the score of each unelected candidate, which is its approval stake divided by one plus
+// the share of its voters' stake, which already backs winners. Returns the candidate with the maximal score.
+func calculateMaxScore(candidates []*candidate, voters []*voter) *candidate {
+	denominators := make(map[*candidate]*big.Rat, len(candidates))
+	for _, c := range candidates {
+		if !c.elected {
+			denominators[c] = big.NewRat(1, 1)
+		}
+	}
+
+	for _, v := range voters {
+		contribution := new(big.Rat)
+		for _, e := range v.edges {
+			if e.candidate.elected && e.candidate.backedStake.Sign() > 0 {
+				contribution.Add(contribution, new(big.Rat).SetFrac(e.weight, e.candidate.backedStake))
+			}
+		}
+
+		for _, e := range v.edges {
+			if d, ok := denominators[e.candidate]; ok {
+				d.Add(d, contribution)
+			}
+		}
+	}
+
+	var best *candidate
+	for _, c := range candidates {
+		if c.elected || c.approvalStake.Sign() == 0 {
+			continue
+		}
+
+		c.score = new(big.Rat).Quo(new(big.Rat).SetInt(c.approvalStake), denominators[c])
+		if best == nil || c.score.Cmp(best.score) > 0 {
+			best = c
+		}
+	}
+
+	return best
+}
+
+// applyElected assigns the unused budget of each voter of `winner` to it, and moves stake to it from the other
+// winners of the voter, which are backed above the score of `winner`.
+func applyElected(voters []*voter, winner *candidate) {
+	cutoff := new(big.Int).Quo(winner.score.Num(), winner.score.Denom())
+	backedStake := new(big.Int).Set(winner.backedStake)
+
+	for _, v := range voters {
+		var newEdge *edge
+		used := new(big.Int)
+		for _, e := range v.edges {
+			if e.candidate == winner {
+				newEdge = e
+			}
+			used.Add(used, e.weight)
+		}
+		if newEdge == nil {
+			continue
+		}
+
+		newWeight := new(big.Int).Sub(v.budget, used)
+		if newWeight.Sign() < 0 {
+			newWeight.SetInt64(0)
+		}
+		backedStake.Add(backedStake, newWeight)
+
+		for _, e := range v.edges {
+			if e == newEdge || e.weight.Sign() == 0 {
+				continue
+			}
+
+			c := e.candidate
+			if c.backedStake.Cmp(cutoff) > 0 {
+				take := new(big.Int).Mul(e.weight, cutoff)
+				take.Quo(take, c.backedStake)
+
+				e.weight.Sub(e.weight, take)
+				c.backedStake.Sub(c.backedStake, take)
+				backedStake.Add(backedStake, take)
+				newWeight.Add(newWeight, take)
+			}
+		}
+
+		newEdge.weight = newWeight
+	}
+
+	winner.backedStake = backedStake
+}
@@ -0,0 +1,46 @@
+package npos_elections
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PhragMMS(t *testing.T) {
+	result := PhragMMS(2, targets, voters, noBalancing)
+
+	assert.Equal(t, expectedResult, result)
+}
+
+func Test_PhragMMS_MovesStakeFromOverbackedWinners(t *testing.T) {
+	result := PhragMMS(2, sc.Sequence[primitives.AccountId]{one, two}, []Voter{
+		{Who: voter10, Stake: sc.NewU128(40), Targets: sc.Sequence[primitives.AccountId]{one, two}},
+		{Who: voter20, Stake: sc.NewU128(10), Targets: sc.Sequence[primitives.AccountId]{two}},
+	}, noBalancing)
+
+	// two is elected first with 50, then one with a score of 40 / (1 + 40/50) = 22,
+	// taking 40 * 22 / 50 = 17 from two
+	assert.Equal(t, ElectionResult{
+		Winners: sc.Sequence[Winner]{
+			{Who: two, Backing: sc.NewU128(33)},
+			{Who: one, Backing: sc.NewU128(17)},
+		},
+		Assignments: sc.Sequence[StakedAssignment]{
+			{Who: voter10, Distribution: sc.Sequence[StakedEdge]{
+				{Target: one, Value: sc.NewU128(17)},
+				{Target: two, Value: sc.NewU128(23)},
+			}},
+			{Who: voter20, Distribution: sc.Sequence[StakedEdge]{{Target: two, Value: sc.NewU128(10)}}},
+		},
+	}, result)
+}
+
+func Test_PhragMMS_SkipsUnapprovedTargets(t *testing.T) {
+	result := PhragMMS(3, targets, []Voter{
+		{Who: voter10, Stake: sc.NewU128(10), Targets: sc.Sequence[primitives.AccountId]{three}},
+	}, noBalancing)
+
+	assert.Equal(t, sc.Sequence[Winner]{{Who: three, Backing: sc.NewU128(10)}}, result.Winners)
+}
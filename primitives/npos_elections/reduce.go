@@ -0,0 +1,177 @@
+package npos_elections
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// reduceEdge is an edge of the graph of voters and targets, built from staked assignments.
+type reduceEdge struct {
+	voter  int
+	target int
+	weight *big.Int
+}
+
+// Reduce removes all cycles from the graph of voters and targets of the assignments, by moving stake around each
+// cycle until one of its edges is empty. The total stake of each voter and the support of each target are kept.
+// Returns the reduced assignments and the number of removed edges.
+func Reduce(assignments sc.Sequence[StakedAssignment]) (sc.Sequence[StakedAssignment], sc.U32) {
+	targets := []StakedEdge{}
+	targetIndex := map[string]int{}
+	edges := []*reduceEdge{}
+
+	for v, assignment := range assignments {
+		for _, se := range assignment.Distribution {
+			key := string(se.Target.Bytes())
+			t, ok := targetIndex[key]
+			if !ok {
+				t = len(targets)
+				targetIndex[key] = t
+				targets = append(targets, se)
+			}
+			edges = append(edges, &reduceEdge{voter: v, target: t, weight: se.Value.ToBigInt()})
+		}
+	}
+
+	removed := sc.U32(0)
+	for {
+		cycle := findCycle(edges, len(assignments), len(targets))
+		if cycle == nil {
+			break
+		}
+
+		// edges at even positions gain and edges at odd positions lose the minimal weight of the losing edges,
+		// which keeps the sums at every vertex of the cycle
+		minEven, minOdd := minWeight(cycle, 0), minWeight(cycle, 1)
+		gain, lose := 0, 1
+		if minEven.Cmp(minOdd) < 0 {
+			gain, lose = 1, 0
+		}
+		delta := minWeight(cycle, lose)
+
+		for i, e := range cycle {
+			switch i % 2 {
+			case gain:
+				e.weight = new(big.Int).Add(e.weight, delta)
+			case lose:
+				e.weight = new(big.Int).Sub(e.weight, delta)
+			}
+		}
+
+		remaining := edges[:0]
+		for _, e := range edges {
+			if e.weight.Sign() == 0 {
+				removed++
+				continue
+			}
+			remaining = append(remaining, e)
+		}
+		edges = remaining
+	}
+
+	result := make(sc.Sequence[StakedAssignment], 0, len(assignments))
+	for v, assignment := range assignments {
+		distribution := sc.Sequence[StakedEdge]{}
+		for _, e := range edges {
+			if e.voter == v {
+				distribution = append(distribution, StakedEdge{Target: targets[e.target].Target, Value: sc.NewU128(e.weight)})
+			}
+		}
+		if len(distribution) > 0 {
+			result = append(result, StakedAssignment{Who: assignment.Who, Distribution: distribution})
+		}
+	}
+
+	return result, removed
+}
+
+// findCycle returns the edges of a cycle in the graph, in order, or nil if the graph is a forest.
+// Vertices 0..voters-1 are the voters and vertices voters..voters+targets-1 are the targets.
+func findCycle(edges []*reduceEdge, voters, targets int) []*reduceEdge {
+	parent := make([]int, voters+targets)
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(x int) int {
+		for parent[x] != x {
+			parent[x] = parent[parent[x]]
+			x = parent[x]
+		}
+		return x
+	}
+
+	forest := make([][]*reduceEdge, voters+targets)
+	for _, e := range edges {
+		a, b := e.voter, voters+e.target
+		ra, rb := find(a), find(b)
+		if ra != rb {
+			parent[ra] = rb
+			forest[a] = append(forest[a], e)
+			forest[b] = append(forest[b], e)
+			continue
+		}
+
+		// `e` closes a cycle with the path from b to a in the forest
+		path := forestPath(forest, voters, b, a)
+		return append([]*reduceEdge{e}, path...)
+	}
+
+	return nil
+}
+
+// forestPath returns the edges on the path from vertex `from` to vertex `to` in the forest.
+func forestPath(forest [][]*reduceEdge, voters, from, to int) []*reduceEdge {
+	via := make(map[int]*reduceEdge, len(forest))
+	visited := map[int]bool{from: true}
+	queue := []int{from}
+
+	other := func(e *reduceEdge, vertex int) int {
+		if e.voter == vertex {
+			return voters + e.target
+		}
+		return e.voter
+	}
+
+	for len(queue) > 0 {
+		vertex := queue[0]
+		queue = queue[1:]
+		if vertex == to {
+			break
+		}
+		for _, e := range forest[vertex] {
+			next := other(e, vertex)
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			via[next] = e
+			queue = append(queue, next)
+		}
+	}
+
+	path := []*reduceEdge{}
+	for vertex := to; vertex != from; {
+		e := via[vertex]
+		path = append(path, e)
+		vertex = other(e, vertex)
+	}
+
+	// reverse, so that the path starts at `from`
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+func minWeight(cycle []*reduceEdge, parity int) *big.Int {
+	var result *big.Int
+	for i := parity; i < len(cycle); i += 2 {
+		if result == nil || cycle[i].weight.Cmp(result) < 0 {
+			result = cycle[i].weight
+		}
+	}
+	return result
+}
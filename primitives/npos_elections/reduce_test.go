@@ -0,0 +1,75 @@
+package npos_elections
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Reduce(t *testing.T) {
+	assignments := sc.Sequence[StakedAssignment]{
+		{Who: voter10, Distribution: sc.Sequence[StakedEdge]{
+			{Target: one, Value: sc.NewU128(5)},
+			{Target: two, Value: sc.NewU128(5)},
+		}},
+		{Who: voter20, Distribution: sc.Sequence[StakedEdge]{
+			{Target: one, Value: sc.NewU128(5)},
+			{Target: two, Value: sc.NewU128(5)},
+		}},
+	}
+
+	result, removed := Reduce(assignments)
+
+	assert.Equal(t, sc.U32(2), removed)
+	assert.Equal(t, sc.Sequence[StakedAssignment]{
+		{Who: voter10, Distribution: sc.Sequence[StakedEdge]{{Target: one, Value: sc.NewU128(10)}}},
+		{Who: voter20, Distribution: sc.Sequence[StakedEdge]{{Target: two, Value: sc.NewU128(10)}}},
+	}, result)
+	assert.Equal(t, EvaluateSupport(ToSupports(assignments)), EvaluateSupport(ToSupports(result)))
+}
+
+func Test_Reduce_KeepsSupports(t *testing.T) {
+	assignments := sc.Sequence[StakedAssignment]{
+		{Who: voter10, Distribution: sc.Sequence[StakedEdge]{
+			{Target: one, Value: sc.NewU128(3)},
+			{Target: two, Value: sc.NewU128(7)},
+		}},
+		{Who: voter20, Distribution: sc.Sequence[StakedEdge]{
+			{Target: two, Value: sc.NewU128(4)},
+			{Target: three, Value: sc.NewU128(6)},
+		}},
+		{Who: voter30, Distribution: sc.Sequence[StakedEdge]{
+			{Target: three, Value: sc.NewU128(2)},
+			{Target: one, Value: sc.NewU128(8)},
+		}},
+	}
+
+	result, removed := Reduce(assignments)
+
+	assert.Equal(t, sc.U32(1), removed)
+	supports := ToSupports(result)
+	for _, expected := range ToSupports(assignments) {
+		found := false
+		for _, ts := range supports {
+			if bytes.Equal(ts.Target.Bytes(), expected.Target.Bytes()) {
+				assert.Equal(t, expected.Support.Total, ts.Support.Total)
+				found = true
+			}
+		}
+		assert.True(t, found)
+	}
+	for i, assignment := range result {
+		assert.Equal(t, assignments[i].Total(), assignment.Total())
+	}
+}
+
+func Test_Reduce_Acyclic(t *testing.T) {
+	assignments := expectedResult.Assignments
+
+	result, removed := Reduce(assignments)
+
+	assert.Equal(t, sc.U32(0), removed)
+	assert.Equal(t, assignments, result)
+}
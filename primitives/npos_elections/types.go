@@ -0,0 +1,250 @@
+package npos_elections
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Voter is a voter in an election, which distributes its stake between its targets.
+type Voter struct {
+	Who     primitives.AccountId
+	Stake   primitives.Balance
+	Targets sc.Sequence[primitives.AccountId]
+}
+
+// Winner is an elected target, together with the total stake backing it.
+type Winner struct {
+	Who     primitives.AccountId
+	Backing primitives.Balance
+}
+
+func (w Winner) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		w.Who,
+		w.Backing,
+	)
+}
+
+func (w Winner) Bytes() []byte {
+	return sc.EncodedBytes(w)
+}
+
+// StakedEdge is the part of the stake of a voter, which backs a target.
+type StakedEdge struct {
+	Target primitives.AccountId
+	Value  primitives.Balance
+}
+
+func (se StakedEdge) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		se.Target,
+		se.Value,
+	)
+}
+
+func (se StakedEdge) Bytes() []byte {
+	return sc.EncodedBytes(se)
+}
+
+// StakedAssignment is the distribution of the stake of a voter between the winners it backs.
+type StakedAssignment struct {
+	Who          primitives.AccountId
+	Distribution sc.Sequence[StakedEdge]
+}
+
+func (sa StakedAssignment) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		sa.Who,
+		sa.Distribution,
+	)
+}
+
+func (sa StakedAssignment) Bytes() []byte {
+	return sc.EncodedBytes(sa)
+}
+
+// Total returns the total stake distributed by the assignment.
+func (sa StakedAssignment) Total() primitives.Balance {
+	total := sc.NewU128(0)
+	for _, edge := range sa.Distribution {
+		total = sc.SaturatingAddU128(total, edge.Value)
+	}
+	return total
+}
+
+// ElectionResult is the result of an election, which can be converted to Supports.
+type ElectionResult struct {
+	// Winners are the elected targets, in the order in which they were elected.
+	Winners sc.Sequence[Winner]
+	// Assignments are the distributions of the stake of each voter, which backs at least one winner.
+	Assignments sc.Sequence[StakedAssignment]
+}
+
+// Supports returns the supports of the winners, in the order in which they were elected.
+func (er ElectionResult) Supports() Supports {
+	supports := ToSupports(er.Assignments)
+
+	result := make(Supports, 0, len(supports))
+	for _, winner := range er.Winners {
+		for _, ts := range supports {
+			if bytes.Equal(ts.Target.Bytes(), winner.Who.Bytes()) {
+				result = append(result, ts)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// BalancingConfig configures the balancing of the stake of the voters after an election.
+type BalancingConfig struct {
+	// Iterations is the maximum number of balancing rounds.
+	Iterations sc.U32
+	// Tolerance is the difference in backing stake, below which balancing stops.
+	Tolerance primitives.Balance
+}
+
+func (bc BalancingConfig) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		bc.Iterations,
+		bc.Tolerance,
+	)
+}
+
+func (bc BalancingConfig) Bytes() []byte {
+	return sc.EncodedBytes(bc)
+}
+
+// IndividualSupport is the stake of a single voter, which backs a target.
+type IndividualSupport struct {
+	Who   primitives.AccountId
+	Value primitives.Balance
+}
+
+func (is IndividualSupport) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		is.Who,
+		is.Value,
+	)
+}
+
+func (is IndividualSupport) Bytes() []byte {
+	return sc.EncodedBytes(is)
+}
+
+func DecodeIndividualSupport(buffer *bytes.Buffer) (IndividualSupport, error) {
+	who, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return IndividualSupport{}, err
+	}
+	value, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return IndividualSupport{}, err
+	}
+	return IndividualSupport{
+		Who:   who,
+		Value: value,
+	}, nil
+}
+
+// Support is the total stake backing a target, together with the voters it consists of.
+type Support struct {
+	Total  primitives.Balance
+	Voters sc.Sequence[IndividualSupport]
+}
+
+func (s Support) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		s.Total,
+		s.Voters,
+	)
+}
+
+func (s Support) Bytes() []byte {
+	return sc.EncodedBytes(s)
+}
+
+func DecodeSupport(buffer *bytes.Buffer) (Support, error) {
+	total, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return Support{}, err
+	}
+	voters, err := sc.DecodeSequenceWith(buffer, DecodeIndividualSupport)
+	if err != nil {
+		return Support{}, err
+	}
+	return Support{
+		Total:  total,
+		Voters: voters,
+	}, nil
+}
+
+// TargetSupport is the support of a single winner.
+type TargetSupport struct {
+	Target  primitives.AccountId
+	Support Support
+}
+
+func (ts TargetSupport) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		ts.Target,
+		ts.Support,
+	)
+}
+
+func (ts TargetSupport) Bytes() []byte {
+	return sc.EncodedBytes(ts)
+}
+
+func DecodeTargetSupport(buffer *bytes.Buffer) (TargetSupport, error) {
+	target, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return TargetSupport{}, err
+	}
+	support, err := DecodeSupport(buffer)
+	if err != nil {
+		return TargetSupport{}, err
+	}
+	return TargetSupport{
+		Target:  target,
+		Support: support,
+	}, nil
+}
+
+// Supports are the supports of all winners of an election.
+type Supports = sc.Sequence[TargetSupport]
+
+func DecodeSupports(buffer *bytes.Buffer) (Supports, error) {
+	return sc.DecodeSequenceWith(buffer, DecodeTargetSupport)
+}
+
+// ToSupports aggregates the stake of the assignments into the support of each target, in the order
+// in which the targets first appear in the assignments.
+func ToSupports(assignments sc.Sequence[StakedAssignment]) Supports {
+	supports := Supports{}
+	indexOf := map[string]int{}
+
+	for _, assignment := range assignments {
+		for _, edge := range assignment.Distribution {
+			key := string(edge.Target.Bytes())
+			i, ok := indexOf[key]
+			if !ok {
+				i = len(supports)
+				indexOf[key] = i
+				supports = append(supports, TargetSupport{
+					Target:  edge.Target,
+					Support: Support{Total: sc.NewU128(0), Voters: sc.Sequence[IndividualSupport]{}},
+				})
+			}
+
+			support := &supports[i].Support
+			support.Total = sc.SaturatingAddU128(support.Total, edge.Value)
+			support.Voters = append(support.Voters, IndividualSupport{Who: assignment.Who, Value: edge.Value})
+		}
+	}
+
+	return supports
+}
@@ -0,0 +1,65 @@
+package npos_elections
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	expectedSupports = Supports{
+		{Target: three, Support: Support{
+			Total: sc.NewU128(35),
+			Voters: sc.Sequence[IndividualSupport]{
+				{Who: voter20, Value: sc.NewU128(20)},
+				{Who: voter30, Value: sc.NewU128(15)},
+			},
+		}},
+		{Target: two, Support: Support{
+			Total: sc.NewU128(25),
+			Voters: sc.Sequence[IndividualSupport]{
+				{Who: voter10, Value: sc.NewU128(10)},
+				{Who: voter30, Value: sc.NewU128(15)},
+			},
+		}},
+	}
+)
+
+func Test_Supports_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := expectedSupports.Encode(buffer)
+
+	assert.NoError(t, err)
+	expected := append(sc.ToCompact(sc.U32(2)).Bytes(), three.Bytes()...)
+	expected = append(expected, sc.NewU128(35).Bytes()...)
+	expected = append(expected, sc.ToCompact(sc.U32(2)).Bytes()...)
+	expected = append(expected, voter20.Bytes()...)
+	assert.Equal(t, expected, buffer.Bytes()[:len(expected)])
+}
+
+func Test_DecodeSupports(t *testing.T) {
+	buffer := bytes.NewBuffer(expectedSupports.Bytes())
+
+	result, err := DecodeSupports(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSupports, result)
+	assert.Equal(t, 0, buffer.Len())
+}
+
+func Test_ToSupports(t *testing.T) {
+	result := ToSupports(expectedResult.Assignments)
+
+	assert.Equal(t, Supports{expectedSupports[1], expectedSupports[0]}, result)
+}
+
+func Test_ElectionResult_Supports(t *testing.T) {
+	assert.Equal(t, expectedSupports, expectedResult.Supports())
+}
+
+func Test_StakedAssignment_Total(t *testing.T) {
+	assert.Equal(t, sc.NewU128(30), expectedResult.Assignments[2].Total())
+}
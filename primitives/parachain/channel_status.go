@@ -0,0 +1,64 @@
+package parachain
+
+import (
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// ChannelStatusClosed means that the channel does not exist.
+	ChannelStatusClosed sc.U8 = iota
+	// ChannelStatusFull means that the channel is at its capacity and cannot accept messages.
+	ChannelStatusFull
+	// ChannelStatusReady means that the channel can accept messages. It holds the maximum size of a message,
+	// which fits in the channel now, and the maximum size of a message, which is ever accepted by it.
+	ChannelStatusReady
+)
+
+// ChannelStatus is the status of an outbound HRMP channel.
+type ChannelStatus struct {
+	sc.VaryingData
+}
+
+func NewChannelStatusClosed() ChannelStatus {
+	return ChannelStatus{sc.NewVaryingData(ChannelStatusClosed)}
+}
+
+func NewChannelStatusFull() ChannelStatus {
+	return ChannelStatus{sc.NewVaryingData(ChannelStatusFull)}
+}
+
+func NewChannelStatusReady(maxSizeNow sc.U32, maxSizeEver sc.U32) ChannelStatus {
+	return ChannelStatus{sc.NewVaryingData(ChannelStatusReady, maxSizeNow, maxSizeEver)}
+}
+
+// NewChannelStatusFromChannel returns the status of `channel`, based on its capacity and size.
+func NewChannelStatusFromChannel(channel AbridgedHRMPChannel) ChannelStatus {
+	if channel.MsgCount >= channel.MaxCapacity {
+		return NewChannelStatusFull()
+	}
+
+	maxSizeNow := sc.U32(0)
+	if channel.MaxTotalSize > channel.TotalSize {
+		maxSizeNow = channel.MaxTotalSize - channel.TotalSize
+	}
+
+	return NewChannelStatusReady(maxSizeNow, channel.MaxMessageSize)
+}
+
+func (cs ChannelStatus) IsClosed() bool {
+	return cs.VaryingData[0] == ChannelStatusClosed
+}
+
+func (cs ChannelStatus) IsFull() bool {
+	return cs.VaryingData[0] == ChannelStatusFull
+}
+
+// MaxSizes returns the maximum size of a message, which fits in the channel now, and the maximum size of a message,
+// which is ever accepted by it. Both are zero, unless the channel is ready.
+func (cs ChannelStatus) MaxSizes() (sc.U32, sc.U32) {
+	if cs.VaryingData[0] != ChannelStatusReady {
+		return 0, 0
+	}
+
+	return cs.VaryingData[1].(sc.U32), cs.VaryingData[2].(sc.U32)
+}
@@ -0,0 +1,36 @@
+package parachain
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewChannelStatusFromChannel_Ready(t *testing.T) {
+	channel := AbridgedHRMPChannel{MaxCapacity: 2, MaxTotalSize: 10, MaxMessageSize: 8, MsgCount: 1, TotalSize: 4}
+
+	result := NewChannelStatusFromChannel(channel)
+
+	assert.Equal(t, NewChannelStatusReady(6, 8), result)
+	maxSizeNow, maxSizeEver := result.MaxSizes()
+	assert.Equal(t, sc.U32(6), maxSizeNow)
+	assert.Equal(t, sc.U32(8), maxSizeEver)
+}
+
+func Test_NewChannelStatusFromChannel_Full(t *testing.T) {
+	channel := AbridgedHRMPChannel{MaxCapacity: 2, MaxTotalSize: 10, MaxMessageSize: 8, MsgCount: 2, TotalSize: 4}
+
+	result := NewChannelStatusFromChannel(channel)
+
+	assert.True(t, result.IsFull())
+	assert.False(t, result.IsClosed())
+	maxSizeNow, maxSizeEver := result.MaxSizes()
+	assert.Equal(t, sc.U32(0), maxSizeNow)
+	assert.Equal(t, sc.U32(0), maxSizeEver)
+}
+
+func Test_ChannelStatus_Closed(t *testing.T) {
+	assert.True(t, NewChannelStatusClosed().IsClosed())
+	assert.False(t, NewChannelStatusClosed().IsFull())
+}
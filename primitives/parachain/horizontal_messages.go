@@ -2,6 +2,7 @@ package parachain
 
 import (
 	"bytes"
+	"sort"
 
 	sc "github.com/LimeChain/goscale"
 )
@@ -10,6 +11,10 @@ type HorizontalMessages struct {
 	messages sc.Dictionary[sc.U32, sc.Sequence[InboundDownwardMessage]]
 }
 
+func NewHorizontalMessages(messages sc.Dictionary[sc.U32, sc.Sequence[InboundDownwardMessage]]) HorizontalMessages {
+	return HorizontalMessages{messages: messages}
+}
+
 func (hm HorizontalMessages) Encode(buffer *bytes.Buffer) error {
 	return sc.EncodeEach(buffer, hm.messages)
 }
@@ -45,18 +50,59 @@ func (hm HorizontalMessages) Bytes() []byte {
 	return sc.EncodedBytes(hm)
 }
 
+// UnprocessedMessages returns the messages, which were sent after relay chain block `number`.
 func (hm HorizontalMessages) UnprocessedMessages(number RelayChainBlockNumber) HorizontalMessages {
 	messages := sc.Dictionary[sc.U32, sc.Sequence[InboundDownwardMessage]]{}
 	for paraChainId, horizontalMessages := range hm.messages {
+		resultMessages := sc.Sequence[InboundDownwardMessage]{}
 		for _, horizontalMessage := range horizontalMessages {
-			resultMessages := sc.Sequence[InboundDownwardMessage]{}
 			if horizontalMessage.SentAt > number {
 				resultMessages = append(resultMessages, horizontalMessage)
 			}
-
-			messages[paraChainId] = resultMessages
 		}
+
+		messages[paraChainId] = resultMessages
 	}
 
 	return HorizontalMessages{messages: messages}
 }
+
+// Senders returns the ids of the parachains, which have sent messages, in ascending order.
+func (hm HorizontalMessages) Senders() sc.Sequence[sc.U32] {
+	senders := sc.Sequence[sc.U32]{}
+	for paraChainId, horizontalMessages := range hm.messages {
+		if len(horizontalMessages) > 0 {
+			senders = append(senders, paraChainId)
+		}
+	}
+
+	sort.Slice(senders, func(i, j int) bool {
+		return senders[i] < senders[j]
+	})
+
+	return senders
+}
+
+// OrderedMessages returns all messages, ordered by the relay chain block number they were sent at
+// and then by the id of the sender. The messages of a single sender keep their relative order.
+func (hm HorizontalMessages) OrderedMessages() sc.Sequence[InboundXcmpMessage] {
+	result := sc.Sequence[InboundXcmpMessage]{}
+	for _, sender := range hm.Senders() {
+		for _, horizontalMessage := range hm.messages[sender] {
+			result = append(result, InboundXcmpMessage{
+				Sender: sender,
+				SentAt: horizontalMessage.SentAt,
+				Data:   horizontalMessage.Msg,
+			})
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].SentAt != result[j].SentAt {
+			return result[i].SentAt < result[j].SentAt
+		}
+		return result[i].Sender < result[j].Sender
+	})
+
+	return result
+}
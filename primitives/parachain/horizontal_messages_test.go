@@ -63,3 +63,52 @@ func Test_HorizontalMessages_UnprocessedMessages_Empty(t *testing.T) {
 
 	assert.Equal(t, expect, result)
 }
+
+func Test_HorizontalMessages_UnprocessedMessages_Partial(t *testing.T) {
+	messages := NewHorizontalMessages(sc.Dictionary[sc.U32, sc.Sequence[InboundDownwardMessage]]{
+		5: sc.Sequence[InboundDownwardMessage]{
+			{SentAt: 2, Msg: sc.Sequence[sc.U8]{1}},
+			{SentAt: 4, Msg: sc.Sequence[sc.U8]{2}},
+		},
+	})
+	expect := NewHorizontalMessages(sc.Dictionary[sc.U32, sc.Sequence[InboundDownwardMessage]]{
+		5: sc.Sequence[InboundDownwardMessage]{
+			{SentAt: 4, Msg: sc.Sequence[sc.U8]{2}},
+		},
+	})
+
+	result := messages.UnprocessedMessages(3)
+
+	assert.Equal(t, expect, result)
+}
+
+func Test_HorizontalMessages_Senders(t *testing.T) {
+	messages := NewHorizontalMessages(sc.Dictionary[sc.U32, sc.Sequence[InboundDownwardMessage]]{
+		7: sc.Sequence[InboundDownwardMessage]{{SentAt: 1, Msg: sc.Sequence[sc.U8]{1}}},
+		2: sc.Sequence[InboundDownwardMessage]{{SentAt: 1, Msg: sc.Sequence[sc.U8]{2}}},
+		5: sc.Sequence[InboundDownwardMessage]{},
+	})
+
+	assert.Equal(t, sc.Sequence[sc.U32]{2, 7}, messages.Senders())
+}
+
+func Test_HorizontalMessages_OrderedMessages(t *testing.T) {
+	messages := NewHorizontalMessages(sc.Dictionary[sc.U32, sc.Sequence[InboundDownwardMessage]]{
+		7: sc.Sequence[InboundDownwardMessage]{
+			{SentAt: 1, Msg: sc.Sequence[sc.U8]{1}},
+			{SentAt: 3, Msg: sc.Sequence[sc.U8]{2}},
+		},
+		2: sc.Sequence[InboundDownwardMessage]{
+			{SentAt: 3, Msg: sc.Sequence[sc.U8]{3}},
+			{SentAt: 3, Msg: sc.Sequence[sc.U8]{4}},
+		},
+	})
+	expect := sc.Sequence[InboundXcmpMessage]{
+		{Sender: 7, SentAt: 1, Data: sc.Sequence[sc.U8]{1}},
+		{Sender: 2, SentAt: 3, Data: sc.Sequence[sc.U8]{3}},
+		{Sender: 2, SentAt: 3, Data: sc.Sequence[sc.U8]{4}},
+		{Sender: 7, SentAt: 3, Data: sc.Sequence[sc.U8]{2}},
+	}
+
+	assert.Equal(t, expect, messages.OrderedMessages())
+}
@@ -2,10 +2,18 @@ package parachain
 
 import (
 	"bytes"
+	"errors"
 
 	sc "github.com/LimeChain/goscale"
 )
 
+var (
+	errHrmpNoChannel        = errors.New("no HRMP channel to the recipient")
+	errHrmpMessagesOverflow = errors.New("HRMP messages exceed the remaining channel capacity")
+	errHrmpBytesOverflow    = errors.New("HRMP bytes exceed the remaining channel size")
+)
+
+// HrmpChannelUpdate is the bandwidth used in an outbound HRMP channel.
 type HrmpChannelUpdate struct {
 	MsgCount   sc.U32
 	TotalBytes sc.U32
@@ -44,3 +52,27 @@ func (hcu *HrmpChannelUpdate) Subtract(other HrmpChannelUpdate) {
 func (hcu HrmpChannelUpdate) IsEmpty() bool {
 	return hcu.TotalBytes == 0 && hcu.MsgCount == 0
 }
+
+// Append adds the bandwidth used by `other` in the channel to `recipient`. Returns an error if the sum exceeds
+// the remaining limits of the channel, in which case the update is left unchanged.
+func (hcu *HrmpChannelUpdate) Append(other HrmpChannelUpdate, recipient sc.U32, limits OutboundBandwidthLimits) error {
+	channelLimits, ok := limits.HrmpOutgoing[recipient]
+	if !ok {
+		return errHrmpNoChannel
+	}
+
+	msgCount := sc.SaturatingAddU32(hcu.MsgCount, other.MsgCount)
+	if msgCount > channelLimits.MessagesRemaining {
+		return errHrmpMessagesOverflow
+	}
+
+	totalBytes := sc.SaturatingAddU32(hcu.TotalBytes, other.TotalBytes)
+	if totalBytes > channelLimits.BytesRemaining {
+		return errHrmpBytesOverflow
+	}
+
+	hcu.MsgCount = msgCount
+	hcu.TotalBytes = totalBytes
+
+	return nil
+}
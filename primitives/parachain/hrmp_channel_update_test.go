@@ -62,3 +62,39 @@ func Test_HrmpChannelUpdate_Subtract(t *testing.T) {
 	assert.Equal(t, expect, target)
 
 }
+
+func Test_HrmpChannelUpdate_Append(t *testing.T) {
+	update := HrmpChannelUpdate{MsgCount: 1, TotalBytes: 1}
+
+	err := update.Append(HrmpChannelUpdate{MsgCount: 2, TotalBytes: 2}, 1, targetOutboundBandwidthLimits)
+
+	assert.NoError(t, err)
+	assert.Equal(t, HrmpChannelUpdate{MsgCount: 3, TotalBytes: 3}, update)
+}
+
+func Test_HrmpChannelUpdate_Append_NoChannel(t *testing.T) {
+	update := HrmpChannelUpdate{}
+
+	err := update.Append(HrmpChannelUpdate{MsgCount: 1, TotalBytes: 1}, 2, targetOutboundBandwidthLimits)
+
+	assert.Equal(t, errHrmpNoChannel, err)
+	assert.Equal(t, HrmpChannelUpdate{}, update)
+}
+
+func Test_HrmpChannelUpdate_Append_MessagesOverflow(t *testing.T) {
+	update := HrmpChannelUpdate{MsgCount: 4}
+
+	err := update.Append(HrmpChannelUpdate{MsgCount: 1}, 1, targetOutboundBandwidthLimits)
+
+	assert.Equal(t, errHrmpMessagesOverflow, err)
+	assert.Equal(t, HrmpChannelUpdate{MsgCount: 4}, update)
+}
+
+func Test_HrmpChannelUpdate_Append_BytesOverflow(t *testing.T) {
+	update := HrmpChannelUpdate{TotalBytes: 3}
+
+	err := update.Append(HrmpChannelUpdate{MsgCount: 1, TotalBytes: 1}, 1, targetOutboundBandwidthLimits)
+
+	assert.Equal(t, errHrmpBytesOverflow, err)
+	assert.Equal(t, HrmpChannelUpdate{TotalBytes: 3}, update)
+}
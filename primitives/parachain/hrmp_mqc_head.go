@@ -0,0 +1,43 @@
+package parachain
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// HrmpMqcHead is the head of the message queue chain of the inbound HRMP channel from parachain `ParaId`.
+type HrmpMqcHead struct {
+	ParaId sc.U32
+	Head   MessageQueueChain
+}
+
+func (hmh HrmpMqcHead) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, hmh.ParaId, hmh.Head)
+}
+
+func DecodeHrmpMqcHead(buffer *bytes.Buffer) (HrmpMqcHead, error) {
+	paraId, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return HrmpMqcHead{}, err
+	}
+
+	head, err := DecodeMessageQueueChain(buffer)
+	if err != nil {
+		return HrmpMqcHead{}, err
+	}
+
+	return HrmpMqcHead{
+		ParaId: paraId,
+		Head:   head,
+	}, nil
+}
+
+func (hmh HrmpMqcHead) Bytes() []byte {
+	return sc.EncodedBytes(hmh)
+}
+
+// DecodeHrmpMqcHeads decodes a sequence of message queue chain heads, sorted by parachain id.
+func DecodeHrmpMqcHeads(buffer *bytes.Buffer) (sc.Sequence[HrmpMqcHead], error) {
+	return sc.DecodeSequenceWith(buffer, DecodeHrmpMqcHead)
+}
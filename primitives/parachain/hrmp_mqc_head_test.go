@@ -0,0 +1,50 @@
+package parachain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	expectedBytesHrmpMqcHead, _  = hex.DecodeString("e80300003aa96b0149b6ca3688878bdbd19464448624136398e3ce45b9e755d3ab61355c")
+	expectedBytesHrmpMqcHeads, _ = hex.DecodeString("04e80300003aa96b0149b6ca3688878bdbd19464448624136398e3ce45b9e755d3ab61355c")
+)
+
+var (
+	targetHrmpMqcHead = HrmpMqcHead{
+		ParaId: 1000,
+		Head:   targetMessageQueueChain,
+	}
+)
+
+func Test_HrmpMqcHead_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := targetHrmpMqcHead.Encode(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedBytesHrmpMqcHead, buffer.Bytes())
+}
+
+func Test_HrmpMqcHead_Decode(t *testing.T) {
+	buf := bytes.NewBuffer(expectedBytesHrmpMqcHead)
+
+	result, err := DecodeHrmpMqcHead(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, targetHrmpMqcHead, result)
+}
+
+func Test_HrmpMqcHead_Bytes(t *testing.T) {
+	assert.Equal(t, expectedBytesHrmpMqcHead, targetHrmpMqcHead.Bytes())
+}
+
+func Test_DecodeHrmpMqcHeads(t *testing.T) {
+	buf := bytes.NewBuffer(expectedBytesHrmpMqcHeads)
+
+	result, err := DecodeHrmpMqcHeads(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, sc.Sequence[HrmpMqcHead]{targetHrmpMqcHead}, result)
+}
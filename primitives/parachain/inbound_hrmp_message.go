@@ -15,6 +15,23 @@ func (ihm InboundHrmpMessage) Encode(buffer *bytes.Buffer) error {
 	return sc.EncodeEach(buffer, ihm.SentAt, ihm.Data)
 }
 
+func DecodeInboundHrmpMessage(buffer *bytes.Buffer) (InboundHrmpMessage, error) {
+	sentAt, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return InboundHrmpMessage{}, err
+	}
+
+	data, err := sc.DecodeSequence[sc.U8](buffer)
+	if err != nil {
+		return InboundHrmpMessage{}, err
+	}
+
+	return InboundHrmpMessage{
+		SentAt: sentAt,
+		Data:   data,
+	}, nil
+}
+
 func (ihm InboundHrmpMessage) Bytes() []byte {
 	return sc.EncodedBytes(ihm)
 }
@@ -0,0 +1,41 @@
+package parachain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	expectedBytesInboundHrmpMessage, _ = hex.DecodeString("070000000c010203")
+)
+
+var (
+	targetInboundHrmpMessage = InboundHrmpMessage{
+		SentAt: 7,
+		Data:   sc.Sequence[sc.U8]{1, 2, 3},
+	}
+)
+
+func Test_InboundHrmpMessage_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := targetInboundHrmpMessage.Encode(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedBytesInboundHrmpMessage, buffer.Bytes())
+}
+
+func Test_InboundHrmpMessage_Decode(t *testing.T) {
+	buf := bytes.NewBuffer(expectedBytesInboundHrmpMessage)
+
+	result, err := DecodeInboundHrmpMessage(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, targetInboundHrmpMessage, result)
+}
+
+func Test_InboundHrmpMessage_Bytes(t *testing.T) {
+	assert.Equal(t, expectedBytesInboundHrmpMessage, targetInboundHrmpMessage.Bytes())
+}
@@ -0,0 +1,45 @@
+package parachain
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// InboundXcmpMessage is an inbound HRMP message, together with the id of the parachain, which sent it.
+type InboundXcmpMessage struct {
+	Sender sc.U32
+	SentAt RelayChainBlockNumber
+	Data   sc.Sequence[sc.U8]
+}
+
+func (ixm InboundXcmpMessage) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, ixm.Sender, ixm.SentAt, ixm.Data)
+}
+
+func DecodeInboundXcmpMessage(buffer *bytes.Buffer) (InboundXcmpMessage, error) {
+	sender, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return InboundXcmpMessage{}, err
+	}
+
+	sentAt, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return InboundXcmpMessage{}, err
+	}
+
+	data, err := sc.DecodeSequence[sc.U8](buffer)
+	if err != nil {
+		return InboundXcmpMessage{}, err
+	}
+
+	return InboundXcmpMessage{
+		Sender: sender,
+		SentAt: sentAt,
+		Data:   data,
+	}, nil
+}
+
+func (ixm InboundXcmpMessage) Bytes() []byte {
+	return sc.EncodedBytes(ixm)
+}
@@ -0,0 +1,42 @@
+package parachain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	expectedBytesInboundXcmpMessage, _ = hex.DecodeString("e8030000070000000c010203")
+)
+
+var (
+	targetInboundXcmpMessage = InboundXcmpMessage{
+		Sender: 1000,
+		SentAt: 7,
+		Data:   sc.Sequence[sc.U8]{1, 2, 3},
+	}
+)
+
+func Test_InboundXcmpMessage_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := targetInboundXcmpMessage.Encode(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedBytesInboundXcmpMessage, buffer.Bytes())
+}
+
+func Test_InboundXcmpMessage_Decode(t *testing.T) {
+	buf := bytes.NewBuffer(expectedBytesInboundXcmpMessage)
+
+	result, err := DecodeInboundXcmpMessage(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, targetInboundXcmpMessage, result)
+}
+
+func Test_InboundXcmpMessage_Bytes(t *testing.T) {
+	assert.Equal(t, expectedBytesInboundXcmpMessage, targetInboundXcmpMessage.Bytes())
+}
@@ -12,6 +12,13 @@ type MessageQueueChain struct {
 	RelayHash types.H256
 }
 
+// NewEmptyMessageQueueChain returns the head of a message queue chain, which has no messages.
+func NewEmptyMessageQueueChain() MessageQueueChain {
+	return MessageQueueChain{
+		RelayHash: types.H256{FixedSequence: sc.BytesToFixedSequenceU8(make([]byte, 32))},
+	}
+}
+
 func (mqc MessageQueueChain) Encode(buffer *bytes.Buffer) error {
 	return sc.EncodeEach(buffer, mqc.RelayHash)
 }
@@ -29,10 +36,20 @@ func (mqc MessageQueueChain) Bytes() []byte {
 	return sc.EncodedBytes(mqc)
 }
 
+// ExtendDownward extends the message queue chain with a downward message.
 func (mqc *MessageQueueChain) ExtendDownward(downwardMessage InboundDownwardMessage, hashing io.Hashing) error {
+	return mqc.extend(downwardMessage.SentAt, downwardMessage.Msg, hashing)
+}
+
+// ExtendHrmp extends the message queue chain with a horizontal message.
+func (mqc *MessageQueueChain) ExtendHrmp(horizontalMessage InboundHrmpMessage, hashing io.Hashing) error {
+	return mqc.extend(horizontalMessage.SentAt, horizontalMessage.Data, hashing)
+}
+
+func (mqc *MessageQueueChain) extend(sentAt RelayChainBlockNumber, msg sc.Sequence[sc.U8], hashing io.Hashing) error {
 	prev := mqc.RelayHash
-	payload := append(prev.Bytes(), downwardMessage.SentAt.Bytes()...)
-	payload = append(payload, hashing.Blake256(downwardMessage.Msg.Bytes())...)
+	payload := append(prev.Bytes(), sentAt.Bytes()...)
+	payload = append(payload, hashing.Blake256(msg.Bytes())...)
 
 	newHash := hashing.Blake256(payload)
 
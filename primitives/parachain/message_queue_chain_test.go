@@ -41,3 +41,7 @@ func Test_MessageQueueChain_Decode(t *testing.T) {
 func Test_MessageQueueChain_Bytes(t *testing.T) {
 	assert.Equal(t, expectedBytesMessageQueueChain, targetMessageQueueChain.Bytes())
 }
+
+func Test_NewEmptyMessageQueueChain(t *testing.T) {
+	assert.Equal(t, make([]byte, 32), NewEmptyMessageQueueChain().Bytes())
+}
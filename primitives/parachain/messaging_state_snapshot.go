@@ -55,3 +55,14 @@ func DecodeMessagingStateSnapshot(buffer *bytes.Buffer) (MessagingStateSnapshot,
 func (mss MessagingStateSnapshot) Bytes() []byte {
 	return sc.EncodedBytes(mss)
 }
+
+// EgressChannel returns the outbound HRMP channel to `recipient`, if it exists.
+func (mss MessagingStateSnapshot) EgressChannel(recipient sc.U32) sc.Option[AbridgedHRMPChannel] {
+	for _, channel := range mss.EgressChannels {
+		if channel.ParachainId == recipient {
+			return sc.NewOption[AbridgedHRMPChannel](channel.AbridgedHRMPChannel)
+		}
+	}
+
+	return sc.NewOption[AbridgedHRMPChannel](nil)
+}
@@ -70,3 +70,10 @@ func Test_MessagingStateSnapshot_Decode(t *testing.T) {
 func Test_MessagingStateSnapshot_Bytes(t *testing.T) {
 	assert.Equal(t, expectedBytesMessagingStateSnapshot, targetMessagingSnapshot.Bytes())
 }
+
+func Test_MessagingStateSnapshot_EgressChannel(t *testing.T) {
+	result := targetMessagingSnapshot.EgressChannel(1)
+
+	assert.Equal(t, sc.NewOption[AbridgedHRMPChannel](targetMessagingSnapshot.EgressChannels[0].AbridgedHRMPChannel), result)
+	assert.Equal(t, sc.NewOption[AbridgedHRMPChannel](nil), targetMessagingSnapshot.EgressChannel(2))
+}
@@ -6,6 +6,8 @@ import (
 	sc "github.com/LimeChain/goscale"
 )
 
+// OutboundBandwidthLimits are the limits on the outbound messages of the parachain, which are left in the
+// relay chain queues, as of the relay parent.
 type OutboundBandwidthLimits struct {
 	UmpMessagesRemaining sc.U32
 	UmpBytesRemaining    sc.U32
@@ -13,7 +15,7 @@ type OutboundBandwidthLimits struct {
 }
 
 func NewOutboundBandwidthLimitsFromMessagingStateSnapshot(mss MessagingStateSnapshot) OutboundBandwidthLimits {
-	var hrmpOutgoing sc.Dictionary[sc.U32, HrmpOutboundLimits]
+	hrmpOutgoing := sc.Dictionary[sc.U32, HrmpOutboundLimits]{}
 	for _, abridgedHrmpChannel := range mss.EgressChannels {
 		bytesRemaining := sc.SaturatingSubU64(sc.U64(abridgedHrmpChannel.AbridgedHRMPChannel.MaxTotalSize), sc.U64(abridgedHrmpChannel.AbridgedHRMPChannel.TotalSize))
 		messagesRemaining := sc.SaturatingSubU64(sc.U64(abridgedHrmpChannel.AbridgedHRMPChannel.MaxCapacity), sc.U64(abridgedHrmpChannel.AbridgedHRMPChannel.MsgCount))
@@ -27,11 +29,12 @@ func NewOutboundBandwidthLimitsFromMessagingStateSnapshot(mss MessagingStateSnap
 	return OutboundBandwidthLimits{
 		UmpMessagesRemaining: mss.RelayDispatchQueueRemainingCapacity.RemainingCount,
 		UmpBytesRemaining:    mss.RelayDispatchQueueRemainingCapacity.RemainingSize,
+		HrmpOutgoing:         hrmpOutgoing,
 	}
 }
 
 func (obl OutboundBandwidthLimits) Encode(buffer *bytes.Buffer) error {
-	return sc.EncodeEach(buffer, obl.UmpBytesRemaining, obl.UmpBytesRemaining, obl.HrmpOutgoing)
+	return sc.EncodeEach(buffer, obl.UmpMessagesRemaining, obl.UmpBytesRemaining, obl.HrmpOutgoing)
 }
 
 func (obl OutboundBandwidthLimits) Bytes() []byte {
@@ -10,7 +10,7 @@ import (
 )
 
 var (
-	expectedBytesOutboundBandwidthLimits, _ = hex.DecodeString("040000000400000004010000000300000004000000")
+	expectedBytesOutboundBandwidthLimits, _ = hex.DecodeString("030000000400000004010000000300000004000000")
 )
 
 var (
@@ -37,3 +37,19 @@ func Test_OutboundBandwidthLimits_Encode(t *testing.T) {
 func Test_OutboundBandwidthLimits_Bytes(t *testing.T) {
 	assert.Equal(t, expectedBytesOutboundBandwidthLimits, targetOutboundBandwidthLimits.Bytes())
 }
+
+func Test_NewOutboundBandwidthLimitsFromMessagingStateSnapshot(t *testing.T) {
+	snapshot := MessagingStateSnapshot{
+		RelayDispatchQueueRemainingCapacity: RelayDispatchQueueRemainingCapacity{RemainingCount: 3, RemainingSize: 4},
+		EgressChannels: sc.Sequence[Channel]{
+			{
+				ParachainId:         1,
+				AbridgedHRMPChannel: AbridgedHRMPChannel{MaxCapacity: 5, MaxTotalSize: 10, MsgCount: 1, TotalSize: 7},
+			},
+		},
+	}
+
+	result := NewOutboundBandwidthLimitsFromMessagingStateSnapshot(snapshot)
+
+	assert.Equal(t, targetOutboundBandwidthLimits, result)
+}
@@ -20,6 +20,12 @@ var (
 	keyPrefixParaHead          = common.MustHexToBytes("0xcd710b30bd2eab0352ddcc26417aa1941b3c252fcb29d88eff4f3de5de4476c3")
 	keyPrefixRestrictionSignal = common.MustHexToBytes("0xcd710b30bd2eab0352ddcc26417aa194f27bbb460270642b5bcaf032ea04d56a")
 	keyActiveConfig            = common.MustHexToBytes("0x06de3d8a54d27e44a9d5ce189618f22db4b49d95320d9021994c850f25b8e385")
+	// keyPrefixRelayDispatchQueueSize is deprecated in favour of keyPrefixRelayDispatchQueueRemainingCapacity.
+	keyPrefixRelayDispatchQueueSize              = common.MustHexToBytes("0xf5207f03cfdce586301014700e2c2593fad157e461d71fd4c1f936839a5f1f3e")
+	keyPrefixRelayDispatchQueueRemainingCapacity = []byte(":relay_dispatch_queue_remaining_capacity")
+	keyPrefixHrmpIngressChannelIndex             = common.MustHexToBytes("0x6a0da05ca59913bc38a8630590f2627c1d3719f5b0b12c7105c073c507445948")
+	keyPrefixHrmpEgressChannelIndex              = common.MustHexToBytes("0x6a0da05ca59913bc38a8630590f2627cf12b746dcf32e843354583c9702cc020")
+	keyPrefixHrmpChannels                        = common.MustHexToBytes("0x6a0da05ca59913bc38a8630590f2627cb6604cff828a6e3f579ca6c59ace013d")
 )
 
 type RelayChainStateProof interface {
@@ -142,17 +148,135 @@ func (rlcsp relayChainStateProof) ReadIncludedParaHeadHash() sc.Option[sc.FixedS
 	return sc.NewOption[sc.FixedSequence[sc.U8]](sc.BytesToFixedSequenceU8(paraHeadHash))
 }
 
+// ReadMessagingStateSnapshot reads the state of the messaging queues and channels of the parachain, as of the
+// relay parent. The remaining capacity of the relay dispatch queue falls back to the deprecated queue size, which is
+// subtracted from the limits in `ahc`.
 func (rlcsp relayChainStateProof) ReadMessagingStateSnapshot(ahc AbridgedHostConfiguration) (MessagingStateSnapshot, error) {
-	// TODO: read and populate messaging state snapshot from the state proof.
+	dmqMqcHead := primitives.H256{FixedSequence: constants.ZeroAccountId.FixedSequence}
+	value := rlcsp.Trie.Get(rlcsp.twox64ConcatKey(keyPrefixDmqMqcHead, rlcsp.ParachainId.Bytes()))
+	if value != nil {
+		head, err := primitives.DecodeH256(bytes.NewBuffer(value))
+		if err != nil {
+			return MessagingStateSnapshot{}, NewErrorStateProofDmqMqcHead(ReadEntryErrorDecode)
+		}
+		dmqMqcHead = head
+	}
+
+	remainingCapacity, err := rlcsp.readRelayDispatchQueueRemainingCapacity(ahc)
+	if err != nil {
+		return MessagingStateSnapshot{}, err
+	}
+
+	ingressChannelIndex, err := rlcsp.readChannelIndex(keyPrefixHrmpIngressChannelIndex)
+	if err != nil {
+		return MessagingStateSnapshot{}, NewErrorStateProofHrmpIngressChannelIndex(ReadEntryErrorDecode)
+	}
+
+	egressChannelIndex, err := rlcsp.readChannelIndex(keyPrefixHrmpEgressChannelIndex)
+	if err != nil {
+		return MessagingStateSnapshot{}, NewErrorStateProofHrmpEgressChannelIndex(ReadEntryErrorDecode)
+	}
+
+	ingressChannels := make(sc.Sequence[Channel], 0, len(ingressChannelIndex))
+	for _, sender := range ingressChannelIndex {
+		channel, err := rlcsp.readHrmpChannel(sender, rlcsp.ParachainId)
+		if err != nil {
+			return MessagingStateSnapshot{}, err
+		}
+		ingressChannels = append(ingressChannels, Channel{ParachainId: sender, AbridgedHRMPChannel: channel})
+	}
+
+	egressChannels := make(sc.Sequence[Channel], 0, len(egressChannelIndex))
+	for _, recipient := range egressChannelIndex {
+		channel, err := rlcsp.readHrmpChannel(rlcsp.ParachainId, recipient)
+		if err != nil {
+			return MessagingStateSnapshot{}, err
+		}
+		egressChannels = append(egressChannels, Channel{ParachainId: recipient, AbridgedHRMPChannel: channel})
+	}
 
 	return MessagingStateSnapshot{
-		DmqMqcHead:                          primitives.H256{FixedSequence: constants.ZeroAccountId.FixedSequence},
-		RelayDispatchQueueRemainingCapacity: RelayDispatchQueueRemainingCapacity{},
-		IngressChannels:                     nil,
-		EgressChannels:                      nil,
+		DmqMqcHead:                          dmqMqcHead,
+		RelayDispatchQueueRemainingCapacity: remainingCapacity,
+		IngressChannels:                     ingressChannels,
+		EgressChannels:                      egressChannels,
 	}, nil
 }
 
+func (rlcsp relayChainStateProof) readRelayDispatchQueueRemainingCapacity(ahc AbridgedHostConfiguration) (RelayDispatchQueueRemainingCapacity, error) {
+	key := append(append([]byte{}, keyPrefixRelayDispatchQueueRemainingCapacity...), rlcsp.ParachainId.Bytes()...)
+	value := rlcsp.Trie.Get(key)
+	if value != nil {
+		remainingCapacity, err := DecodeRelayDispatchQueueRemainingCapacity(bytes.NewBuffer(value))
+		if err != nil {
+			return RelayDispatchQueueRemainingCapacity{}, NewErrorStateProofRelayDispatchQueueRemainingCapacity(ReadEntryErrorDecode)
+		}
+		return remainingCapacity, nil
+	}
+
+	// The queue is empty, unless its deprecated size is present.
+	value = rlcsp.Trie.Get(rlcsp.twox64ConcatKey(keyPrefixRelayDispatchQueueSize, rlcsp.ParachainId.Bytes()))
+	if value == nil {
+		return newRelayDispatchQueueRemainingCapacity(ahc, 0, 0), nil
+	}
+
+	buffer := bytes.NewBuffer(value)
+	count, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return RelayDispatchQueueRemainingCapacity{}, NewErrorStateProofRelayDispatchQueueRemainingCapacity(ReadEntryErrorDecode)
+	}
+	size, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return RelayDispatchQueueRemainingCapacity{}, NewErrorStateProofRelayDispatchQueueRemainingCapacity(ReadEntryErrorDecode)
+	}
+
+	return newRelayDispatchQueueRemainingCapacity(ahc, count, size), nil
+}
+
+// readChannelIndex reads the ids of the parachains, which have an HRMP channel with the parachain. An absent
+// index means that there are no channels.
+func (rlcsp relayChainStateProof) readChannelIndex(prefix []byte) (sc.Sequence[sc.U32], error) {
+	value := rlcsp.Trie.Get(rlcsp.twox64ConcatKey(prefix, rlcsp.ParachainId.Bytes()))
+	if value == nil {
+		return sc.Sequence[sc.U32]{}, nil
+	}
+
+	return sc.DecodeSequence[sc.U32](bytes.NewBuffer(value))
+}
+
+// readHrmpChannel reads the HRMP channel from `sender` to `recipient`, which must be present in the proof.
+func (rlcsp relayChainStateProof) readHrmpChannel(sender sc.U32, recipient sc.U32) (AbridgedHRMPChannel, error) {
+	channelId := append(sender.Bytes(), recipient.Bytes()...)
+
+	value := rlcsp.Trie.Get(rlcsp.twox64ConcatKey(keyPrefixHrmpChannels, channelId))
+	if value == nil {
+		return AbridgedHRMPChannel{}, NewErrorStateProofHrmpChannel(sender, recipient, ReadEntryErrorAbsent)
+	}
+
+	channel, err := DecodeAbridgedHRMPChannel(bytes.NewBuffer(value))
+	if err != nil {
+		return AbridgedHRMPChannel{}, NewErrorStateProofHrmpChannel(sender, recipient, ReadEntryErrorDecode)
+	}
+
+	return channel, nil
+}
+
+// twox64ConcatKey returns the key of a storage map entry, whose prefix is `prefix` and whose key is hashed
+// with Twox64Concat.
+func (rlcsp relayChainStateProof) twox64ConcatKey(prefix []byte, key []byte) []byte {
+	result := make([]byte, 0, len(prefix)+8+len(key))
+	result = append(result, prefix...)
+	result = append(result, rlcsp.hashing.Twox64(key)...)
+	return append(result, key...)
+}
+
+func newRelayDispatchQueueRemainingCapacity(ahc AbridgedHostConfiguration, count sc.U32, size sc.U32) RelayDispatchQueueRemainingCapacity {
+	return RelayDispatchQueueRemainingCapacity{
+		RemainingCount: sc.U32(sc.SaturatingSubU64(sc.U64(ahc.MaxUpwardQueueCount), sc.U64(count))),
+		RemainingSize:  sc.U32(sc.SaturatingSubU64(sc.U64(ahc.MaxUpwardQueueSize), sc.U64(size))),
+	}
+}
+
 // BuildTrie sets a partial trie based on the proof slice of encoded nodes.
 func BuildTrie(rootHash []byte, db db.Database) (t *inmemory.InMemoryTrie, err error) {
 	// buildTrie sets a partial trie based on the proof slice of encoded nodes.
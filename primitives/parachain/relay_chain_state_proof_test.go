@@ -0,0 +1,130 @@
+package parachain
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ChainSafe/gossamer/pkg/trie/inmemory"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/mocks"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	proofParachainId = sc.U32(1000)
+	proofSenderId    = sc.U32(2000)
+	proofRecipientId = sc.U32(3000)
+	proofTwox64Hash  = []byte{1, 2, 3, 4, 5, 6, 7, 8}
+
+	proofHostConfiguration = AbridgedHostConfiguration{
+		MaxUpwardQueueCount: 10,
+		MaxUpwardQueueSize:  1_000,
+	}
+
+	proofDmqMqcHead = primitives.H256{FixedSequence: sc.BytesToFixedSequenceU8(make([]byte, 32))}
+	proofChannel    = AbridgedHRMPChannel{
+		MaxCapacity:    8,
+		MaxTotalSize:   8_192,
+		MaxMessageSize: 1_024,
+		MsgCount:       1,
+		TotalSize:      16,
+		MqcHead:        sc.NewOption[primitives.H256](nil),
+	}
+)
+
+func Test_RelayChainStateProof_ReadMessagingStateSnapshot(t *testing.T) {
+	dmqMqcHead := primitives.H256{FixedSequence: sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{7}, 32))}
+	remainingCapacity := RelayDispatchQueueRemainingCapacity{RemainingCount: 5, RemainingSize: 500}
+	target := setupRelayChainStateProof(t, map[string][]byte{
+		proofKey(keyPrefixDmqMqcHead, proofParachainId.Bytes()):                                 dmqMqcHead.Bytes(),
+		string(keyPrefixRelayDispatchQueueRemainingCapacity) + string(proofParachainId.Bytes()): remainingCapacity.Bytes(),
+		proofKey(keyPrefixHrmpIngressChannelIndex, proofParachainId.Bytes()):                    sc.Sequence[sc.U32]{proofSenderId}.Bytes(),
+		proofKey(keyPrefixHrmpEgressChannelIndex, proofParachainId.Bytes()):                     sc.Sequence[sc.U32]{proofRecipientId}.Bytes(),
+		proofKey(keyPrefixHrmpChannels, proofChannelId(proofSenderId, proofParachainId)):        proofChannel.Bytes(),
+		proofKey(keyPrefixHrmpChannels, proofChannelId(proofParachainId, proofRecipientId)):     proofChannel.Bytes(),
+	})
+
+	result, err := target.ReadMessagingStateSnapshot(proofHostConfiguration)
+
+	assert.NoError(t, err)
+	assert.Equal(t, MessagingStateSnapshot{
+		DmqMqcHead:                          dmqMqcHead,
+		RelayDispatchQueueRemainingCapacity: remainingCapacity,
+		IngressChannels:                     sc.Sequence[Channel]{{ParachainId: proofSenderId, AbridgedHRMPChannel: proofChannel}},
+		EgressChannels:                      sc.Sequence[Channel]{{ParachainId: proofRecipientId, AbridgedHRMPChannel: proofChannel}},
+	}, result)
+}
+
+func Test_RelayChainStateProof_ReadMessagingStateSnapshot_DeprecatedQueueSize(t *testing.T) {
+	target := setupRelayChainStateProof(t, map[string][]byte{
+		proofKey(keyPrefixRelayDispatchQueueSize, proofParachainId.Bytes()): append(sc.U32(3).Bytes(), sc.U32(400).Bytes()...),
+	})
+
+	result, err := target.ReadMessagingStateSnapshot(proofHostConfiguration)
+
+	assert.NoError(t, err)
+	assert.Equal(t, RelayDispatchQueueRemainingCapacity{RemainingCount: 7, RemainingSize: 600}, result.RelayDispatchQueueRemainingCapacity)
+}
+
+func Test_RelayChainStateProof_ReadMessagingStateSnapshot_Absent(t *testing.T) {
+	target := setupRelayChainStateProof(t, map[string][]byte{})
+
+	result, err := target.ReadMessagingStateSnapshot(proofHostConfiguration)
+
+	assert.NoError(t, err)
+	assert.Equal(t, MessagingStateSnapshot{
+		DmqMqcHead:                          proofDmqMqcHead,
+		RelayDispatchQueueRemainingCapacity: RelayDispatchQueueRemainingCapacity{RemainingCount: 10, RemainingSize: 1_000},
+		IngressChannels:                     sc.Sequence[Channel]{},
+		EgressChannels:                      sc.Sequence[Channel]{},
+	}, result)
+}
+
+func Test_RelayChainStateProof_ReadMessagingStateSnapshot_HrmpChannelAbsent(t *testing.T) {
+	target := setupRelayChainStateProof(t, map[string][]byte{
+		proofKey(keyPrefixHrmpEgressChannelIndex, proofParachainId.Bytes()): sc.Sequence[sc.U32]{proofRecipientId}.Bytes(),
+	})
+
+	_, err := target.ReadMessagingStateSnapshot(proofHostConfiguration)
+
+	assert.Equal(t, NewErrorStateProofHrmpChannel(proofParachainId, proofRecipientId, ReadEntryErrorAbsent), err)
+}
+
+func Test_RelayChainStateProof_ReadMessagingStateSnapshot_HrmpChannelMalformed(t *testing.T) {
+	target := setupRelayChainStateProof(t, map[string][]byte{
+		proofKey(keyPrefixHrmpIngressChannelIndex, proofParachainId.Bytes()):             sc.Sequence[sc.U32]{proofSenderId}.Bytes(),
+		proofKey(keyPrefixHrmpChannels, proofChannelId(proofSenderId, proofParachainId)): {1, 2, 3},
+	})
+
+	_, err := target.ReadMessagingStateSnapshot(proofHostConfiguration)
+
+	assert.Equal(t, NewErrorStateProofHrmpChannel(proofSenderId, proofParachainId, ReadEntryErrorDecode), err)
+}
+
+// setupRelayChainStateProof returns a state proof, whose trie holds `entries`.
+func setupRelayChainStateProof(t *testing.T, entries map[string][]byte) relayChainStateProof {
+	mockHashing := new(mocks.IoHashing)
+	mockHashing.On("Twox64", mock.Anything).Return(proofTwox64Hash)
+
+	trie := inmemory.NewEmptyTrie()
+	for key, value := range entries {
+		assert.NoError(t, trie.Put([]byte(key), value))
+	}
+
+	return relayChainStateProof{
+		ParachainId: proofParachainId,
+		Trie:        trie,
+		hashing:     mockHashing,
+	}
+}
+
+// proofKey returns the key of a storage map entry, whose key is hashed with Twox64Concat.
+func proofKey(prefix []byte, key []byte) string {
+	return string(prefix) + string(proofTwox64Hash) + string(key)
+}
+
+func proofChannelId(sender sc.U32, recipient sc.U32) []byte {
+	return append(sender.Bytes(), recipient.Bytes()...)
+}
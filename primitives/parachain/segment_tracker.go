@@ -8,6 +8,12 @@ import (
 	sc "github.com/LimeChain/goscale"
 )
 
+var (
+	errInvalidHrmpWatermark           = errors.New("HRMP watermark is lower than the one of the unincluded segment")
+	errUpgradeGoAheadAlreadyProcessed = errors.New("upgrade go ahead signal is already processed by the unincluded segment")
+)
+
+// SegmentTracker is the aggregated state of the unincluded segment.
 type SegmentTracker struct {
 	UsedBandwidth         UsedBandwidth
 	HrmpWatermark         sc.Option[RelayChainBlockNumber]
@@ -64,3 +70,27 @@ func (st *SegmentTracker) Subtract(block *Ancestor) error {
 	// from the tail of the segment.
 	return nil
 }
+
+// Append adds `block` to the tracker, which has `hrmpWatermark` and whose parent is `relayParentNumber`.
+// Returns an error if the bandwidth used by the segment exceeds `limits`.
+func (st *SegmentTracker) Append(block Ancestor, hrmpWatermark RelayChainBlockNumber, relayParentNumber RelayChainBlockNumber, limits OutboundBandwidthLimits) error {
+	// The watermark of a block may only equal the one of its ancestors, if it is the relay parent (head).
+	if st.HrmpWatermark.HasValue && hrmpWatermark != relayParentNumber && hrmpWatermark < st.HrmpWatermark.Value {
+		return errInvalidHrmpWatermark
+	}
+
+	if err := st.UsedBandwidth.Append(block.UsedBandwidth, limits); err != nil {
+		return err
+	}
+
+	if block.ConsumedGoAheadSignal.HasValue {
+		if st.ConsumedGoAheadSignal.HasValue {
+			return errUpgradeGoAheadAlreadyProcessed
+		}
+		st.ConsumedGoAheadSignal = block.ConsumedGoAheadSignal
+	}
+
+	st.HrmpWatermark = sc.NewOption[RelayChainBlockNumber](hrmpWatermark)
+
+	return nil
+}
@@ -50,3 +50,45 @@ func Test_SegmentTracker_Decode(t *testing.T) {
 func Test_SegmentTracker_Bytes(t *testing.T) {
 	assert.Equal(t, expectedBytesSegmentTracker, targetSegmentTracker.Bytes())
 }
+
+func Test_SegmentTracker_Append(t *testing.T) {
+	tracker := SegmentTracker{HrmpWatermark: sc.NewOption[RelayChainBlockNumber](5)}
+	block := Ancestor{
+		UsedBandwidth:         UsedBandwidth{UmpMsgCount: 1, UmpTotalBytes: 2},
+		ConsumedGoAheadSignal: sc.NewOption[sc.U8](1),
+	}
+
+	err := tracker.Append(block, 6, 7, targetOutboundBandwidthLimits)
+
+	assert.NoError(t, err)
+	assert.Equal(t, SegmentTracker{
+		UsedBandwidth:         UsedBandwidth{UmpMsgCount: 1, UmpTotalBytes: 2, HrmpOutgoing: sc.Dictionary[sc.U32, HrmpChannelUpdate]{}},
+		HrmpWatermark:         sc.NewOption[RelayChainBlockNumber](6),
+		ConsumedGoAheadSignal: sc.NewOption[sc.U8](1),
+	}, tracker)
+}
+
+func Test_SegmentTracker_Append_InvalidHrmpWatermark(t *testing.T) {
+	tracker := SegmentTracker{HrmpWatermark: sc.NewOption[RelayChainBlockNumber](5)}
+
+	err := tracker.Append(Ancestor{}, 4, 7, targetOutboundBandwidthLimits)
+
+	assert.Equal(t, errInvalidHrmpWatermark, err)
+}
+
+func Test_SegmentTracker_Append_HrmpWatermarkOfRelayParent(t *testing.T) {
+	tracker := SegmentTracker{HrmpWatermark: sc.NewOption[RelayChainBlockNumber](5)}
+
+	err := tracker.Append(Ancestor{}, 4, 4, targetOutboundBandwidthLimits)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewOption[RelayChainBlockNumber](4), tracker.HrmpWatermark)
+}
+
+func Test_SegmentTracker_Append_UpgradeGoAheadAlreadyProcessed(t *testing.T) {
+	tracker := targetSegmentTracker
+
+	err := tracker.Append(Ancestor{ConsumedGoAheadSignal: sc.NewOption[sc.U8](1)}, 5, 5, targetOutboundBandwidthLimits)
+
+	assert.Equal(t, errUpgradeGoAheadAlreadyProcessed, err)
+}
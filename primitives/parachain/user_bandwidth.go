@@ -2,10 +2,16 @@ package parachain
 
 import (
 	"bytes"
+	"errors"
 
 	sc "github.com/LimeChain/goscale"
 )
 
+var (
+	errUmpMessagesOverflow = errors.New("UMP messages exceed the remaining relay dispatch queue capacity")
+	errUmpBytesOverflow    = errors.New("UMP bytes exceed the remaining relay dispatch queue size")
+)
+
 type UsedBandwidth struct {
 	UmpMsgCount   sc.U32
 	UmpTotalBytes sc.U32
@@ -64,6 +70,33 @@ func (ub *UsedBandwidth) Subtract(other *UsedBandwidth) error {
 	return nil
 }
 
+// Append adds the bandwidth used by `other`. Returns an error if the sum exceeds `limits`, in which case
+// the used bandwidth must be discarded.
+func (ub *UsedBandwidth) Append(other UsedBandwidth, limits OutboundBandwidthLimits) error {
+	ub.UmpMsgCount = sc.SaturatingAddU32(ub.UmpMsgCount, other.UmpMsgCount)
+	if ub.UmpMsgCount > limits.UmpMessagesRemaining {
+		return errUmpMessagesOverflow
+	}
+
+	ub.UmpTotalBytes = sc.SaturatingAddU32(ub.UmpTotalBytes, other.UmpTotalBytes)
+	if ub.UmpTotalBytes > limits.UmpBytesRemaining {
+		return errUmpBytesOverflow
+	}
+
+	if ub.HrmpOutgoing == nil {
+		ub.HrmpOutgoing = sc.Dictionary[sc.U32, HrmpChannelUpdate]{}
+	}
+	for recipient, channel := range other.HrmpOutgoing {
+		current := ub.HrmpOutgoing[recipient]
+		if err := current.Append(channel, recipient, limits); err != nil {
+			return err
+		}
+		ub.HrmpOutgoing[recipient] = current
+	}
+
+	return nil
+}
+
 func decodeHrmpOutgoing(buffer *bytes.Buffer) (sc.Dictionary[sc.U32, HrmpChannelUpdate], error) {
 	v, err := sc.DecodeCompact[sc.U128](buffer)
 	if err != nil {
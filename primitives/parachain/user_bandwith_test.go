@@ -45,3 +45,52 @@ func Test_UserBandwidth_Decode(t *testing.T) {
 func Test_UserBandwidth_Bytes(t *testing.T) {
 	assert.Equal(t, expectedBytesUserBandwidth, targetUserBandwidth.Bytes())
 }
+
+func Test_UserBandwidth_Append(t *testing.T) {
+	usedBandwidth := UsedBandwidth{UmpMsgCount: 1, UmpTotalBytes: 1}
+
+	err := usedBandwidth.Append(UsedBandwidth{
+		UmpMsgCount:   2,
+		UmpTotalBytes: 3,
+		HrmpOutgoing: sc.Dictionary[sc.U32, HrmpChannelUpdate]{
+			1: {MsgCount: 1, TotalBytes: 3},
+		},
+	}, targetOutboundBandwidthLimits)
+
+	assert.NoError(t, err)
+	assert.Equal(t, UsedBandwidth{
+		UmpMsgCount:   3,
+		UmpTotalBytes: 4,
+		HrmpOutgoing: sc.Dictionary[sc.U32, HrmpChannelUpdate]{
+			1: {MsgCount: 1, TotalBytes: 3},
+		},
+	}, usedBandwidth)
+}
+
+func Test_UserBandwidth_Append_UmpMessagesOverflow(t *testing.T) {
+	usedBandwidth := UsedBandwidth{UmpMsgCount: 3}
+
+	err := usedBandwidth.Append(UsedBandwidth{UmpMsgCount: 1}, targetOutboundBandwidthLimits)
+
+	assert.Equal(t, errUmpMessagesOverflow, err)
+}
+
+func Test_UserBandwidth_Append_UmpBytesOverflow(t *testing.T) {
+	usedBandwidth := UsedBandwidth{UmpTotalBytes: 4}
+
+	err := usedBandwidth.Append(UsedBandwidth{UmpTotalBytes: 1}, targetOutboundBandwidthLimits)
+
+	assert.Equal(t, errUmpBytesOverflow, err)
+}
+
+func Test_UserBandwidth_Append_HrmpNoChannel(t *testing.T) {
+	usedBandwidth := UsedBandwidth{}
+
+	err := usedBandwidth.Append(UsedBandwidth{
+		HrmpOutgoing: sc.Dictionary[sc.U32, HrmpChannelUpdate]{
+			2: {MsgCount: 1, TotalBytes: 1},
+		},
+	}, targetOutboundBandwidthLimits)
+
+	assert.Equal(t, errHrmpNoChannel, err)
+}
@@ -1,11 +1,86 @@
 package staking
 
 import (
+	"bytes"
+	"errors"
+
 	sc "github.com/LimeChain/goscale"
 	grandpatypes "github.com/LimeChain/gosemble/primitives/grandpa"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
+// OffenceKindLength is the length of the identifier of an offence kind.
+const OffenceKindLength = 16
+
+var (
+	// ErrDuplicateOffenceReport is returned when all offenders of a reported offence have already been reported.
+	ErrDuplicateOffenceReport = errors.New("duplicate offence report")
+)
+
+// Offence is a misbehaviour of one or more validators in a time slot.
+type Offence interface {
+	// Kind identifies the kind of the offence. It is exactly OffenceKindLength bytes long.
+	Kind() sc.FixedSequence[sc.U8]
+	// Offenders returns the validators, which committed the offence.
+	Offenders() sc.Sequence[primitives.AccountId]
+	// Session returns the index of the session, in which the offence occurred.
+	Session() sc.U32
+	// ValidatorSetSize returns the number of validators in the session, in which the offence occurred.
+	ValidatorSetSize() sc.U32
+	// Slot returns the time slot, in which the offence occurred.
+	// Offences of the same kind in the same time slot are concurrent.
+	Slot() sc.Encodable
+	// SlashFraction returns the fraction of the exposure of each offender to be slashed,
+	// given the number of concurrent offenders.
+	SlashFraction(offendersCount sc.U32) primitives.Perbill
+}
+
+// OffenceDetails holds an offender together with the accounts, which reported it.
+type OffenceDetails struct {
+	Offender  primitives.AccountId
+	Reporters sc.Sequence[primitives.AccountId]
+}
+
+func (od OffenceDetails) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer, od.Offender, od.Reporters)
+}
+
+func DecodeOffenceDetails(buffer *bytes.Buffer) (OffenceDetails, error) {
+	offender, err := primitives.DecodeAccountId(buffer)
+	if err != nil {
+		return OffenceDetails{}, err
+	}
+	reporters, err := primitives.DecodeSequenceAccountId(buffer)
+	if err != nil {
+		return OffenceDetails{}, err
+	}
+
+	return OffenceDetails{
+		Offender:  offender,
+		Reporters: reporters,
+	}, nil
+}
+
+func (od OffenceDetails) Bytes() []byte {
+	return sc.EncodedBytes(od)
+}
+
+// ReportOffence accepts reports of offences.
+type ReportOffence interface {
+	// ReportOffence reports `offence` on behalf of `reporters`.
+	// Returns ErrDuplicateOffenceReport if all its offenders have already been reported in the same time slot.
+	ReportOffence(reporters sc.Sequence[primitives.AccountId], offence Offence) error
+	// IsKnownOffence returns whether all `offenders` have already been reported for an offence of `kind` in `timeSlot`.
+	IsKnownOffence(kind sc.FixedSequence[sc.U8], offenders sc.Sequence[primitives.AccountId], timeSlot sc.Encodable) (bool, error)
+}
+
+// OnOffenceHandler acts on reported offences, e.g. by slashing and disabling the offenders.
+type OnOffenceHandler interface {
+	// OnOffence handles the concurrent `offenders`, committed in session `sessionIndex`.
+	// `slashFraction` holds the fraction to be slashed for each of the offenders and must be of the same length.
+	OnOffence(offenders []OffenceDetails, slashFraction []primitives.Perbill, sessionIndex sc.U32) (primitives.Weight, error)
+}
+
 type OffenceReportSystem interface {
 	PublishEvidence(equivocationProof grandpatypes.EquivocationProof, keyOwnerProof grandpatypes.KeyOwnerProof) error
 	// CheckEvidence(_evidence: Evidence) TransactionValidityError
@@ -21,3 +96,18 @@ func (d DefaultOffenceReportSystem) PublishEvidence(equivocationProof grandpatyp
 func (d DefaultOffenceReportSystem) ProcessEvidence(reporter sc.Option[primitives.AccountId], equivocationProof grandpatypes.EquivocationProof, keyOwnerProof grandpatypes.KeyOwnerProof) error {
 	return nil
 }
+
+// EquivocationSlashFraction returns the fraction to be slashed for `offendersCount` concurrent equivocations
+// in a validator set of size `validatorSetCount`, which is min((3k / n)^2, 1).
+func EquivocationSlashFraction(offendersCount sc.U32, validatorSetCount sc.U32) primitives.Perbill {
+	offenders := sc.U64(offendersCount) * 3
+	if offenders >= sc.U64(validatorSetCount) {
+		return primitives.NewPerbillFromPercent(100)
+	}
+
+	const accuracy = 1_000_000_000
+
+	x := offenders * accuracy / sc.U64(validatorSetCount)
+
+	return primitives.Perbill{Parts: sc.U32(x * x / accuracy)}
+}
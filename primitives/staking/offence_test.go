@@ -0,0 +1,64 @@
+package staking
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	offenceDetails = OffenceDetails{
+		Offender:  constants.OneAccountId,
+		Reporters: sc.Sequence[primitives.AccountId]{constants.TwoAccountId},
+	}
+)
+
+func Test_OffenceDetails_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := offenceDetails.Encode(buffer)
+	assert.NoError(t, err)
+
+	expect := append(constants.OneAccountId.Bytes(), sc.Sequence[primitives.AccountId]{constants.TwoAccountId}.Bytes()...)
+	assert.Equal(t, expect, buffer.Bytes())
+}
+
+func Test_OffenceDetails_Bytes(t *testing.T) {
+	expect := append(constants.OneAccountId.Bytes(), sc.Sequence[primitives.AccountId]{constants.TwoAccountId}.Bytes()...)
+
+	assert.Equal(t, expect, offenceDetails.Bytes())
+}
+
+func Test_DecodeOffenceDetails(t *testing.T) {
+	buffer := bytes.NewBuffer(offenceDetails.Bytes())
+
+	result, err := DecodeOffenceDetails(buffer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, offenceDetails, result)
+}
+
+func Test_EquivocationSlashFraction(t *testing.T) {
+	for _, tt := range []struct {
+		name              string
+		offendersCount    sc.U32
+		validatorSetCount sc.U32
+		expect            primitives.Perbill
+	}{
+		{name: "single offender in large set", offendersCount: 1, validatorSetCount: 100, expect: primitives.Perbill{Parts: 900_000}},
+		{name: "single offender in a set of 1000", offendersCount: 1, validatorSetCount: 1000, expect: primitives.Perbill{Parts: 9_000}},
+		{name: "rounds down", offendersCount: 1, validatorSetCount: 7, expect: primitives.Perbill{Parts: 183_673_468}},
+		{name: "single offender in small set", offendersCount: 1, validatorSetCount: 10, expect: primitives.NewPerbillFromPercent(9)},
+		{name: "a third of the set", offendersCount: 2, validatorSetCount: 6, expect: primitives.NewPerbillFromPercent(100)},
+		{name: "more than a third of the set", offendersCount: 3, validatorSetCount: 4, expect: primitives.NewPerbillFromPercent(100)},
+		{name: "empty set", offendersCount: 1, validatorSetCount: 0, expect: primitives.NewPerbillFromPercent(100)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, EquivocationSlashFraction(tt.offendersCount, tt.validatorSetCount))
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// LockIdentifierLength is the byte length of a balance lock identifier.
+const LockIdentifierLength = 8
+
+// BalanceLock is a single lock on an account balance. Locks with different
+// identifiers overlap instead of stacking.
+type BalanceLock struct {
+	Id      sc.FixedSequence[sc.U8]
+	Amount  Balance
+	Reasons Reasons
+}
+
+func (bl BalanceLock) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		bl.Id,
+		bl.Amount,
+		bl.Reasons,
+	)
+}
+
+func (bl BalanceLock) Bytes() []byte {
+	return sc.EncodedBytes(bl)
+}
+
+func DecodeBalanceLock(buffer *bytes.Buffer) (BalanceLock, error) {
+	id, err := sc.DecodeFixedSequence[sc.U8](LockIdentifierLength, buffer)
+	if err != nil {
+		return BalanceLock{}, err
+	}
+	amount, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return BalanceLock{}, err
+	}
+	reasons, err := DecodeReasons(buffer)
+	if err != nil {
+		return BalanceLock{}, err
+	}
+	return BalanceLock{
+		Id:      id,
+		Amount:  amount,
+		Reasons: reasons,
+	}, nil
+}
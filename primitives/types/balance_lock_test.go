@@ -0,0 +1,52 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	balanceLock = BalanceLock{
+		Id:      sc.BytesToFixedSequenceU8([]byte("staking ")),
+		Amount:  sc.NewU128(5),
+		Reasons: ReasonsMisc,
+	}
+
+	balanceLockBytes = []byte{
+		's', 't', 'a', 'k', 'i', 'n', 'g', ' ',
+		0x5, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x1,
+	}
+)
+
+func Test_BalanceLock_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := balanceLock.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, balanceLockBytes, buffer.Bytes())
+}
+
+func Test_BalanceLock_Bytes(t *testing.T) {
+	assert.Equal(t, balanceLockBytes, balanceLock.Bytes())
+}
+
+func Test_DecodeBalanceLock(t *testing.T) {
+	result, err := DecodeBalanceLock(bytes.NewBuffer(balanceLockBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, balanceLock, result)
+}
+
+func Test_DecodeBalanceLock_InvalidReasons(t *testing.T) {
+	invalidBytes := append(append([]byte{}, balanceLockBytes[:len(balanceLockBytes)-1]...), 3)
+
+	result, err := DecodeBalanceLock(bytes.NewBuffer(invalidBytes))
+
+	assert.Equal(t, newTypeError("Reasons"), err)
+	assert.Equal(t, BalanceLock{}, result)
+}
@@ -0,0 +1,28 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+// ConstantMultiplier implements WeightToFee and maps one unit of weight
+// to a constant amount of fee. It is typically used as a length to fee conversion.
+type ConstantMultiplier struct {
+	Multiplier Balance
+}
+
+func NewConstantMultiplier(multiplier Balance) ConstantMultiplier {
+	return ConstantMultiplier{multiplier}
+}
+
+func (c ConstantMultiplier) WeightToFee(weight Weight) Balance {
+	return saturatingMulU128(c.Multiplier, sc.NewU128(weight.RefTime))
+}
+
+func (c ConstantMultiplier) Polynomial() sc.Sequence[WeightToFeeCoefficient] {
+	return sc.Sequence[WeightToFeeCoefficient]{
+		{
+			CoeffInteger: c.Multiplier,
+			CoeffFrac:    Perbill{},
+			Negative:     false,
+			Degree:       1,
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	constantMultiplier = NewConstantMultiplier(sc.NewU128(10))
+)
+
+func Test_ConstantMultiplier_WeightToFee(t *testing.T) {
+	result := constantMultiplier.WeightToFee(WeightFromParts(7, 3))
+
+	assert.Equal(t, sc.NewU128(70), result)
+}
+
+func Test_ConstantMultiplier_WeightToFee_Saturates(t *testing.T) {
+	result := NewConstantMultiplier(MaxFixedU128().Inner).WeightToFee(WeightFromParts(2, 0))
+
+	assert.Equal(t, MaxFixedU128().Inner, result)
+}
+
+func Test_ConstantMultiplier_Polynomial(t *testing.T) {
+	expect := sc.Sequence[WeightToFeeCoefficient]{
+		{CoeffInteger: sc.NewU128(10), CoeffFrac: Perbill{}, Negative: false, Degree: 1},
+	}
+
+	assert.Equal(t, expect, constantMultiplier.Polynomial())
+}
@@ -0,0 +1,10 @@
+package types
+
+// Currency provides an abstraction over the free balances of accounts.
+type Currency interface {
+	// FreeBalance returns the free balance of `who`.
+	FreeBalance(who AccountId) (Balance, error)
+	// Transfer moves `value` from the free balance of `from` to the free balance of `to`.
+	// If `liveness` is ExistenceRequirementKeepAlive, the remaining balance of `from` must not be less than the existential deposit.
+	Transfer(from AccountId, to AccountId, value Balance, liveness ExistenceRequirement) error
+}
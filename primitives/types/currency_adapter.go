@@ -13,4 +13,7 @@ type CurrencyAdapter interface {
 	// Checks `who` for liquidity restrictions and returns an error if they are not met.
 	// Deposits a withdrawal event and returns `value`.
 	Withdraw(who AccountId, value sc.U128, reasons sc.U8, liveness ExistenceRequirement) (Balance, error)
+	// DropNegativeImbalance removes `value` from the total issuance.
+	// Used to burn funds that have been withdrawn and are not deposited anywhere else.
+	DropNegativeImbalance(value Balance) error
 }
@@ -11,7 +11,7 @@ import (
 type SignedExtra interface {
 	sc.Encodable
 
-	Decode(buffer *bytes.Buffer)
+	Decode(buffer *bytes.Buffer) error
 	DeepCopy() SignedExtra
 
 	AdditionalSigned() (AdditionalSigned, error)
@@ -21,6 +21,10 @@ type SignedExtra interface {
 	PreDispatchUnsigned(call Call, info *DispatchInfo, length sc.Compact) error
 	PostDispatch(pre sc.Option[sc.Sequence[Pre]], info *DispatchInfo, postInfo *PostDispatchInfo, length sc.Compact, dispatchErr error) error
 
+	ValidateGeneral(origin RuntimeOrigin, call Call, info *DispatchInfo, length sc.Compact) (ValidTransaction, RuntimeOrigin, error)
+	PrepareGeneral(origin RuntimeOrigin, call Call, info *DispatchInfo, length sc.Compact) (sc.Sequence[sc.Option[Pre]], RuntimeOrigin, error)
+	PostDispatchGeneral(pre sc.Sequence[sc.Option[Pre]], info *DispatchInfo, postInfo *PostDispatchInfo, length sc.Compact, dispatchErr error) error
+
 	Metadata() sc.Sequence[MetadataSignedExtension]
 }
 
@@ -51,10 +55,14 @@ func (e signedExtra) Bytes() []byte {
 	return sc.EncodedBytes(e)
 }
 
-func (e signedExtra) Decode(buffer *bytes.Buffer) {
+func (e signedExtra) Decode(buffer *bytes.Buffer) error {
 	for _, extra := range e.extras {
-		extra.Decode(buffer)
+		err := extra.Decode(buffer)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 func (e signedExtra) DeepCopy() SignedExtra {
@@ -158,6 +166,59 @@ func (e signedExtra) PostDispatch(pre sc.Option[sc.Sequence[Pre]], info *Dispatc
 	return nil
 }
 
+// ValidateGeneral validates a general transaction from `origin` with each extension as a TransactionExtension.
+// Returns the combined validity and the origin, which is returned by the last extension.
+func (e signedExtra) ValidateGeneral(origin RuntimeOrigin, call Call, info *DispatchInfo, length sc.Compact) (ValidTransaction, RuntimeOrigin, error) {
+	valid := DefaultValidTransaction()
+
+	for _, extra := range e.extras {
+		v, o, err := AsTransactionExtension(extra).ValidateTransaction(origin, call, info, length)
+		if err != nil {
+			return ValidTransaction{}, origin, err
+		}
+		valid = valid.CombineWith(v)
+		origin = o
+	}
+
+	return valid, origin, nil
+}
+
+// PrepareGeneral validates and prepares a general transaction from `origin` with each extension as a TransactionExtension.
+// Each extension is prepared with the origin, which it returns from validation.
+// Returns the values, which are passed to PostDispatchGeneral, and the origin to dispatch the transaction with.
+func (e signedExtra) PrepareGeneral(origin RuntimeOrigin, call Call, info *DispatchInfo, length sc.Compact) (sc.Sequence[sc.Option[Pre]], RuntimeOrigin, error) {
+	pre := sc.Sequence[sc.Option[Pre]]{}
+
+	for _, extra := range e.extras {
+		extension := AsTransactionExtension(extra)
+
+		_, o, err := extension.ValidateTransaction(origin, call, info, length)
+		if err != nil {
+			return nil, origin, err
+		}
+		origin = o
+
+		p, err := extension.PrepareTransaction(origin, call, info, length)
+		if err != nil {
+			return nil, origin, err
+		}
+		pre = append(pre, p)
+	}
+
+	return pre, origin, nil
+}
+
+func (e signedExtra) PostDispatchGeneral(pre sc.Sequence[sc.Option[Pre]], info *DispatchInfo, postInfo *PostDispatchInfo, length sc.Compact, dispatchErr error) error {
+	for i, extra := range e.extras {
+		err := extra.PostDispatch(pre[i], info, postInfo, length, dispatchErr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (e signedExtra) Metadata() sc.Sequence[MetadataSignedExtension] {
 	ids := sc.Sequence[sc.Compact]{}
 	signedExtensions := sc.Sequence[MetadataSignedExtension]{}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/hex"
+	"io"
 	"math"
 	"testing"
 
@@ -136,11 +137,20 @@ func Test_SignedExtra_Bytes(t *testing.T) {
 func Test_SignedExtra_Decode(t *testing.T) {
 	buf := bytes.NewBuffer(expectedSignedExtraOkBytes)
 
-	targetSignedExtraOk.Decode(buf)
+	err := targetSignedExtraOk.Decode(buf)
 
+	assert.NoError(t, err)
 	assert.Equal(t, signedExtra{extras: extraChecksWithOk, mdGenerator: mdGenerator}, targetSignedExtraOk)
 }
 
+func Test_SignedExtra_Decode_Fails(t *testing.T) {
+	target := NewSignedExtra(extraChecks, mdGenerator)
+
+	err := target.Decode(bytes.NewBuffer(expectedSignedExtraOkBytes[:3]))
+
+	assert.Equal(t, io.EOF, err)
+}
+
 func Test_SignedExtra_DeepCopy(t *testing.T) {
 	target := NewSignedExtra(extraChecks, mdGenerator)
 
@@ -240,6 +250,76 @@ func Test_SignedExtra_PostDispatch_Err(t *testing.T) {
 	assert.Equal(t, expectedTransactionValidityError, err)
 }
 
+func Test_SignedExtra_ValidateGeneral_Signed_Ok(t *testing.T) {
+	result, origin, err := targetSignedExtraOk.ValidateGeneral(signedOrigin, call, info, length)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedValidTransaction, result)
+	assert.Equal(t, signedOrigin, origin)
+}
+
+func Test_SignedExtra_ValidateGeneral_Signed_Err(t *testing.T) {
+	result, _, err := targetSignedExtraErr.ValidateGeneral(signedOrigin, call, info, length)
+
+	assert.Equal(t, ValidTransaction{}, result)
+	assert.Equal(t, expectedTransactionValidityError, err)
+}
+
+func Test_SignedExtra_ValidateGeneral_None(t *testing.T) {
+	result, origin, err := targetSignedExtraErr.ValidateGeneral(noneOrigin, call, info, length)
+
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultValidTransaction(), result)
+	assert.Equal(t, noneOrigin, origin)
+}
+
+func Test_SignedExtra_ValidateGeneral_Authorized(t *testing.T) {
+	target := NewSignedExtra([]SignedExtension{extensionAuthorize, extraCheckErr1}, mdGenerator)
+
+	result, origin, err := target.ValidateGeneral(noneOrigin, call, info, length)
+
+	assert.Equal(t, ValidTransaction{}, result)
+	assert.Equal(t, expectedTransactionValidityError, err)
+	assert.Equal(t, signedOrigin, origin)
+}
+
+func Test_SignedExtra_PrepareGeneral_None(t *testing.T) {
+	result, origin, err := targetSignedExtraErr.PrepareGeneral(noneOrigin, call, info, length)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Sequence[sc.Option[Pre]]{sc.NewOption[Pre](nil), sc.NewOption[Pre](nil), sc.NewOption[Pre](nil)}, result)
+	assert.Equal(t, noneOrigin, origin)
+}
+
+func Test_SignedExtra_PrepareGeneral_Authorized(t *testing.T) {
+	target := NewSignedExtra([]SignedExtension{extensionAuthorize, extraCheckOk2}, mdGenerator)
+
+	result, origin, err := target.PrepareGeneral(noneOrigin, call, info, length)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Sequence[sc.Option[Pre]]{sc.NewOption[Pre](sc.NewVaryingData(sc.U32(7))), sc.NewOption[Pre](Pre{})}, result)
+	assert.Equal(t, signedOrigin, origin)
+}
+
+func Test_SignedExtra_PrepareGeneral_Err(t *testing.T) {
+	result, _, err := targetSignedExtraErr.PrepareGeneral(signedOrigin, call, info, length)
+
+	assert.Equal(t, sc.Sequence[sc.Option[Pre]](nil), result)
+	assert.Equal(t, expectedTransactionValidityError, err)
+}
+
+func Test_SignedExtra_PostDispatchGeneral_Ok(t *testing.T) {
+	err := targetSignedExtraOk.PostDispatchGeneral(sc.Sequence[sc.Option[Pre]]{sc.NewOption[Pre](nil), sc.NewOption[Pre](nil)}, info, postInfo, length, nil)
+
+	assert.Nil(t, err)
+}
+
+func Test_SignedExtra_PostDispatchGeneral_Err(t *testing.T) {
+	err := targetSignedExtraErr.PostDispatchGeneral(sc.Sequence[sc.Option[Pre]]{sc.NewOption[Pre](nil), sc.NewOption[Pre](nil), sc.NewOption[Pre](nil)}, info, postInfo, length, nil)
+
+	assert.Equal(t, expectedTransactionValidityError, err)
+}
+
 func Test_SignedExtra_Metadata(t *testing.T) {
 	metadataSignedExtensions := targetSignedExtraOk.Metadata()
 	metadataTypes := mdGenerator.GetMetadataTypes()
@@ -38,7 +38,10 @@ func DecodeExtrinsicSignature(extra SignedExtra, buffer *bytes.Buffer) (Extrinsi
 	s.Signature = signature
 
 	s.Extra = extra
-	s.Extra.Decode(buffer)
+	err = s.Extra.Decode(buffer)
+	if err != nil {
+		return ExtrinsicSignature{}, err
+	}
 
 	return s, nil
 }
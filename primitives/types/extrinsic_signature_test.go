@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/hex"
+	"io"
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
@@ -75,3 +76,19 @@ func Test_DecodeExtrinsicSignature(t *testing.T) {
 
 	assert.Equal(t, targetExtrinsicSignature, result)
 }
+
+func Test_DecodeExtrinsicSignature_InvalidExtra(t *testing.T) {
+	signatureBytes := expectedExtrinsicSignatureBytes[:len(expectedExtrinsicSignatureBytes)-1]
+
+	signedExtraTemplate := NewSignedExtra(
+		[]SignedExtension{
+			newTestExtraCheck(false, sc.U32(0)),
+			newTestExtraCheck(false, sc.U32(0)),
+		},
+		mdGenerator,
+	)
+
+	_, err := DecodeExtrinsicSignature(signedExtraTemplate, bytes.NewBuffer(signatureBytes))
+
+	assert.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+var (
+	maxI128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	minI128 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+)
+
+// FixedI128 is a signed fixed point number with 18 decimal places.
+// It is represented by its inner value, which is the number multiplied by `10^18`,
+// and is SCALE encoded the same way as an I128.
+type FixedI128 struct {
+	Inner sc.I128
+}
+
+// NewFixedI128FromInner creates a FixedI128 from its raw inner representation.
+func NewFixedI128FromInner(inner sc.I128) FixedI128 {
+	return FixedI128{inner}
+}
+
+// NewFixedI128FromInteger creates a FixedI128 with the value of `n`.
+func NewFixedI128FromInteger(n sc.I64) FixedI128 {
+	return fixedI128FromBigInt(new(big.Int).Mul(big.NewInt(int64(n)), fixedU128Div))
+}
+
+// NewFixedI128FromRational creates a FixedI128 with the value of `n / d`, rounded towards zero.
+// A zero denominator results in the maximum or minimum value, depending on the sign of `n`.
+func NewFixedI128FromRational(n, d sc.I64) FixedI128 {
+	if d == 0 {
+		if n < 0 {
+			return MinFixedI128()
+		}
+		return MaxFixedI128()
+	}
+
+	numerator := new(big.Int).Mul(big.NewInt(int64(n)), fixedU128Div)
+	return fixedI128FromBigInt(numerator.Quo(numerator, big.NewInt(int64(d))))
+}
+
+// MaxFixedI128 returns the largest value a FixedI128 can represent.
+func MaxFixedI128() FixedI128 {
+	return FixedI128{sc.NewI128(maxI128)}
+}
+
+// MinFixedI128 returns the smallest value a FixedI128 can represent.
+func MinFixedI128() FixedI128 {
+	return FixedI128{sc.NewI128(minI128)}
+}
+
+func (f FixedI128) Encode(buffer *bytes.Buffer) error {
+	return f.Inner.Encode(buffer)
+}
+
+func DecodeFixedI128(buffer *bytes.Buffer) (FixedI128, error) {
+	inner, err := sc.DecodeI128(buffer)
+	if err != nil {
+		return FixedI128{}, err
+	}
+	return FixedI128{inner}, nil
+}
+
+func (f FixedI128) Bytes() []byte {
+	return sc.EncodedBytes(f)
+}
+
+// IsNegative returns true if the value is lower than zero.
+func (f FixedI128) IsNegative() bool {
+	return f.Inner.ToBigInt().Sign() < 0
+}
+
+// SaturatingAdd returns `f + other`, saturating at the bounds.
+func (f FixedI128) SaturatingAdd(other FixedI128) FixedI128 {
+	return fixedI128FromBigInt(new(big.Int).Add(f.Inner.ToBigInt(), other.Inner.ToBigInt()))
+}
+
+// SaturatingSub returns `f - other`, saturating at the bounds.
+func (f FixedI128) SaturatingSub(other FixedI128) FixedI128 {
+	return fixedI128FromBigInt(new(big.Int).Sub(f.Inner.ToBigInt(), other.Inner.ToBigInt()))
+}
+
+// SaturatingMul returns `f * other`, saturating at the bounds.
+// The result is rounded towards zero to the accuracy of FixedI128.
+func (f FixedI128) SaturatingMul(other FixedI128) FixedI128 {
+	product := new(big.Int).Mul(f.Inner.ToBigInt(), other.Inner.ToBigInt())
+	return fixedI128FromBigInt(product.Quo(product, fixedU128Div))
+}
+
+// SaturatingMulInt multiplies the unsigned integer `n` by `f`, truncating the fractional part.
+// Negative results saturate at zero.
+func (f FixedI128) SaturatingMulInt(n sc.U128) sc.U128 {
+	product := new(big.Int).Mul(f.Inner.ToBigInt(), n.ToBigInt())
+	return fixedU128FromBigInt(product.Quo(product, fixedU128Div)).Inner
+}
+
+func (f FixedI128) Cmp(other FixedI128) int {
+	return f.Inner.ToBigInt().Cmp(other.Inner.ToBigInt())
+}
+
+func (f FixedI128) Eq(other FixedI128) bool {
+	return f.Cmp(other) == 0
+}
+
+func (f FixedI128) Gt(other FixedI128) bool {
+	return f.Cmp(other) > 0
+}
+
+func (f FixedI128) Lt(other FixedI128) bool {
+	return f.Cmp(other) < 0
+}
+
+func fixedI128FromBigInt(value *big.Int) FixedI128 {
+	if value.Cmp(maxI128) > 0 {
+		return MaxFixedI128()
+	}
+	if value.Cmp(minI128) < 0 {
+		return MinFixedI128()
+	}
+	return FixedI128{sc.NewI128(value)}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	fixedI128One          = NewFixedI128FromInteger(1)
+	fixedI128MinusOneHalf = NewFixedI128FromRational(-1, 2)
+
+	fixedI128MinusOneHalfBytes = []byte{0x0, 0x0, 0x4e, 0x2c, 0xa6, 0xa4, 0xf, 0xf9, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+)
+
+func Test_NewFixedI128FromInteger(t *testing.T) {
+	assert.Equal(t, big.NewInt(1_000_000_000_000_000_000), fixedI128One.Inner.ToBigInt())
+	assert.Equal(t, big.NewInt(-2_000_000_000_000_000_000), NewFixedI128FromInteger(-2).Inner.ToBigInt())
+}
+
+func Test_NewFixedI128FromRational(t *testing.T) {
+	assert.Equal(t, big.NewInt(-500_000_000_000_000_000), fixedI128MinusOneHalf.Inner.ToBigInt())
+	assert.Equal(t, MaxFixedI128(), NewFixedI128FromRational(1, 0))
+	assert.Equal(t, MinFixedI128(), NewFixedI128FromRational(-1, 0))
+}
+
+func Test_FixedI128_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := fixedI128MinusOneHalf.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, fixedI128MinusOneHalfBytes, buffer.Bytes())
+	assert.Equal(t, fixedI128MinusOneHalfBytes, fixedI128MinusOneHalf.Bytes())
+}
+
+func Test_DecodeFixedI128(t *testing.T) {
+	result, err := DecodeFixedI128(bytes.NewBuffer(fixedI128MinusOneHalfBytes))
+
+	assert.NoError(t, err)
+	assert.True(t, fixedI128MinusOneHalf.Eq(result))
+}
+
+func Test_DecodeFixedI128_Fails(t *testing.T) {
+	_, err := DecodeFixedI128(&bytes.Buffer{})
+
+	assert.Equal(t, io.EOF, err)
+}
+
+func Test_FixedI128_SaturatingAdd(t *testing.T) {
+	assert.True(t, NewFixedI128FromRational(1, 2).Eq(fixedI128One.SaturatingAdd(fixedI128MinusOneHalf)))
+	assert.Equal(t, MaxFixedI128(), MaxFixedI128().SaturatingAdd(fixedI128One))
+}
+
+func Test_FixedI128_SaturatingSub(t *testing.T) {
+	assert.True(t, NewFixedI128FromRational(-3, 2).Eq(fixedI128MinusOneHalf.SaturatingSub(fixedI128One)))
+	assert.Equal(t, MinFixedI128(), MinFixedI128().SaturatingSub(fixedI128One))
+}
+
+func Test_FixedI128_SaturatingMul(t *testing.T) {
+	assert.True(t, NewFixedI128FromRational(1, 4).Eq(fixedI128MinusOneHalf.SaturatingMul(fixedI128MinusOneHalf)))
+	assert.Equal(t, MinFixedI128(), MaxFixedI128().SaturatingMul(NewFixedI128FromInteger(-2)))
+}
+
+func Test_FixedI128_SaturatingMulInt(t *testing.T) {
+	assert.Equal(t, sc.NewU128(15), NewFixedI128FromRational(3, 2).SaturatingMulInt(sc.NewU128(10)))
+	assert.Equal(t, sc.NewU128(0), fixedI128MinusOneHalf.SaturatingMulInt(sc.NewU128(10)))
+}
+
+func Test_FixedI128_Comparisons(t *testing.T) {
+	assert.True(t, fixedI128MinusOneHalf.IsNegative())
+	assert.False(t, fixedI128One.IsNegative())
+	assert.True(t, fixedI128One.Gt(fixedI128MinusOneHalf))
+	assert.True(t, fixedI128MinusOneHalf.Lt(fixedI128One))
+	assert.Equal(t, 0, fixedI128One.Cmp(NewFixedI128FromRational(-2, -2)))
+}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// FixedU128Decimals is the number of decimal places of a FixedU128.
+	FixedU128Decimals = 18
+)
+
+var (
+	// fixedU128Div is the accuracy of a FixedU128, `10^18`.
+	fixedU128Div = new(big.Int).Exp(big.NewInt(10), big.NewInt(FixedU128Decimals), nil)
+	maxU128      = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+)
+
+// FixedU128 is an unsigned fixed point number with 18 decimal places.
+// It is represented by its inner value, which is the number multiplied by `10^18`,
+// and is SCALE encoded the same way as a U128.
+type FixedU128 struct {
+	Inner sc.U128
+}
+
+// NewFixedU128FromInner creates a FixedU128 from its raw inner representation.
+func NewFixedU128FromInner(inner sc.U128) FixedU128 {
+	return FixedU128{inner}
+}
+
+// NewFixedU128FromInteger creates a FixedU128 with the value of `n`, saturating at the maximum.
+func NewFixedU128FromInteger(n sc.U128) FixedU128 {
+	return fixedU128FromBigInt(new(big.Int).Mul(n.ToBigInt(), fixedU128Div))
+}
+
+// NewFixedU128FromRational creates a FixedU128 with the value of `n / d`, saturating at the maximum.
+// A zero denominator results in the maximum value.
+func NewFixedU128FromRational(n, d sc.U128) FixedU128 {
+	denominator := d.ToBigInt()
+	if denominator.Sign() == 0 {
+		return MaxFixedU128()
+	}
+
+	numerator := new(big.Int).Mul(n.ToBigInt(), fixedU128Div)
+	return fixedU128FromBigInt(numerator.Quo(numerator, denominator))
+}
+
+// MaxFixedU128 returns the largest value a FixedU128 can represent.
+func MaxFixedU128() FixedU128 {
+	return FixedU128{sc.NewU128(maxU128)}
+}
+
+func (f FixedU128) Encode(buffer *bytes.Buffer) error {
+	return f.Inner.Encode(buffer)
+}
+
+func DecodeFixedU128(buffer *bytes.Buffer) (FixedU128, error) {
+	inner, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return FixedU128{}, err
+	}
+	return FixedU128{inner}, nil
+}
+
+func (f FixedU128) Bytes() []byte {
+	return sc.EncodedBytes(f)
+}
+
+// IsZero returns true if the value is zero.
+func (f FixedU128) IsZero() bool {
+	return f.Inner.ToBigInt().Sign() == 0
+}
+
+// SaturatingAdd returns `f + other`, saturating at the maximum.
+func (f FixedU128) SaturatingAdd(other FixedU128) FixedU128 {
+	return fixedU128FromBigInt(new(big.Int).Add(f.Inner.ToBigInt(), other.Inner.ToBigInt()))
+}
+
+// SaturatingSub returns `f - other`, saturating at zero.
+func (f FixedU128) SaturatingSub(other FixedU128) FixedU128 {
+	return fixedU128FromBigInt(new(big.Int).Sub(f.Inner.ToBigInt(), other.Inner.ToBigInt()))
+}
+
+// SaturatingMul returns `f * other`, saturating at the maximum.
+// The result is truncated to the accuracy of FixedU128.
+func (f FixedU128) SaturatingMul(other FixedU128) FixedU128 {
+	product := new(big.Int).Mul(f.Inner.ToBigInt(), other.Inner.ToBigInt())
+	return fixedU128FromBigInt(product.Quo(product, fixedU128Div))
+}
+
+// SaturatingDiv returns `f / other`, saturating at the maximum.
+// The result is truncated to the accuracy of FixedU128. Dividing by zero results in the maximum value.
+func (f FixedU128) SaturatingDiv(other FixedU128) FixedU128 {
+	if other.IsZero() {
+		return MaxFixedU128()
+	}
+
+	numerator := new(big.Int).Mul(f.Inner.ToBigInt(), fixedU128Div)
+	return fixedU128FromBigInt(numerator.Quo(numerator, other.Inner.ToBigInt()))
+}
+
+// SaturatingMulInt multiplies the integer `n` by `f`, truncating the fractional part
+// and saturating at the maximum U128.
+func (f FixedU128) SaturatingMulInt(n sc.U128) sc.U128 {
+	product := new(big.Int).Mul(f.Inner.ToBigInt(), n.ToBigInt())
+	product.Quo(product, fixedU128Div)
+	if product.Cmp(maxU128) > 0 {
+		return sc.NewU128(maxU128)
+	}
+	return sc.NewU128(product)
+}
+
+func (f FixedU128) Eq(other FixedU128) bool {
+	return f.Inner.Eq(other.Inner)
+}
+
+func (f FixedU128) Gt(other FixedU128) bool {
+	return f.Inner.Gt(other.Inner)
+}
+
+func (f FixedU128) Gte(other FixedU128) bool {
+	return f.Inner.Gte(other.Inner)
+}
+
+func (f FixedU128) Lt(other FixedU128) bool {
+	return f.Inner.Lt(other.Inner)
+}
+
+func (f FixedU128) Lte(other FixedU128) bool {
+	return f.Inner.Lte(other.Inner)
+}
+
+// Clamp restricts the value to the inclusive range [min, max].
+func (f FixedU128) Clamp(min, max FixedU128) FixedU128 {
+	if f.Lt(min) {
+		return min
+	}
+	if f.Gt(max) {
+		return max
+	}
+	return f
+}
+
+func fixedU128FromBigInt(value *big.Int) FixedU128 {
+	if value.Sign() < 0 {
+		return FixedU128{sc.NewU128(0)}
+	}
+	if value.Cmp(maxU128) > 0 {
+		return MaxFixedU128()
+	}
+	return FixedU128{sc.NewU128(value)}
+}
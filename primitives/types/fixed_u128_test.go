@@ -0,0 +1,105 @@
+package types
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	fixedU128One  = NewFixedU128FromInteger(sc.NewU128(1))
+	fixedU128Half = NewFixedU128FromRational(sc.NewU128(1), sc.NewU128(2))
+
+	fixedU128OneBytes = []byte{0x0, 0x0, 0x64, 0xa7, 0xb3, 0xb6, 0xe0, 0xd, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}
+)
+
+func Test_NewFixedU128FromInteger(t *testing.T) {
+	assert.Equal(t, sc.NewU128(uint64(1_000_000_000_000_000_000)), fixedU128One.Inner)
+	assert.Equal(t, MaxFixedU128(), NewFixedU128FromInteger(MaxFixedU128().Inner))
+}
+
+func Test_NewFixedU128FromRational(t *testing.T) {
+	assert.Equal(t, sc.NewU128(uint64(500_000_000_000_000_000)), fixedU128Half.Inner)
+	assert.Equal(t, sc.NewU128(uint64(333_333_333_333_333_333)), NewFixedU128FromRational(sc.NewU128(1), sc.NewU128(3)).Inner)
+}
+
+func Test_NewFixedU128FromRational_ZeroDenominator(t *testing.T) {
+	assert.Equal(t, MaxFixedU128(), NewFixedU128FromRational(sc.NewU128(1), sc.NewU128(0)))
+}
+
+func Test_FixedU128_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := fixedU128One.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, fixedU128OneBytes, buffer.Bytes())
+}
+
+func Test_FixedU128_Bytes(t *testing.T) {
+	assert.Equal(t, fixedU128OneBytes, fixedU128One.Bytes())
+}
+
+func Test_DecodeFixedU128(t *testing.T) {
+	result, err := DecodeFixedU128(bytes.NewBuffer(fixedU128OneBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, fixedU128One, result)
+}
+
+func Test_DecodeFixedU128_Fails(t *testing.T) {
+	_, err := DecodeFixedU128(&bytes.Buffer{})
+
+	assert.Equal(t, io.EOF, err)
+}
+
+func Test_FixedU128_SaturatingAdd(t *testing.T) {
+	assert.Equal(t, NewFixedU128FromRational(sc.NewU128(3), sc.NewU128(2)), fixedU128One.SaturatingAdd(fixedU128Half))
+	assert.Equal(t, MaxFixedU128(), MaxFixedU128().SaturatingAdd(fixedU128One))
+}
+
+func Test_FixedU128_SaturatingSub(t *testing.T) {
+	assert.Equal(t, fixedU128Half, fixedU128One.SaturatingSub(fixedU128Half))
+	assert.True(t, fixedU128Half.SaturatingSub(fixedU128One).IsZero())
+}
+
+func Test_FixedU128_SaturatingMul(t *testing.T) {
+	quarter := NewFixedU128FromRational(sc.NewU128(1), sc.NewU128(4))
+
+	assert.Equal(t, quarter, fixedU128Half.SaturatingMul(fixedU128Half))
+	assert.Equal(t, MaxFixedU128(), MaxFixedU128().SaturatingMul(NewFixedU128FromInteger(sc.NewU128(2))))
+}
+
+func Test_FixedU128_SaturatingDiv(t *testing.T) {
+	two := NewFixedU128FromInteger(sc.NewU128(2))
+
+	assert.Equal(t, fixedU128Half, fixedU128One.SaturatingDiv(two))
+	assert.Equal(t, two, fixedU128One.SaturatingDiv(fixedU128Half))
+	assert.Equal(t, MaxFixedU128(), MaxFixedU128().SaturatingDiv(fixedU128Half))
+	assert.Equal(t, MaxFixedU128(), fixedU128One.SaturatingDiv(FixedU128{sc.NewU128(0)}))
+}
+
+func Test_FixedU128_SaturatingMulInt(t *testing.T) {
+	assert.Equal(t, sc.NewU128(5), fixedU128Half.SaturatingMulInt(sc.NewU128(11)))
+	assert.Equal(t, sc.NewU128(0), NewFixedU128FromInner(sc.NewU128(1)).SaturatingMulInt(sc.NewU128(999)))
+	assert.Equal(t, MaxFixedU128().Inner, NewFixedU128FromInteger(sc.NewU128(2)).SaturatingMulInt(MaxFixedU128().Inner))
+}
+
+func Test_FixedU128_Comparisons(t *testing.T) {
+	assert.True(t, fixedU128One.Gt(fixedU128Half))
+	assert.True(t, fixedU128One.Gte(fixedU128One))
+	assert.True(t, fixedU128Half.Lt(fixedU128One))
+	assert.True(t, fixedU128Half.Lte(fixedU128Half))
+	assert.True(t, fixedU128One.Eq(NewFixedU128FromRational(sc.NewU128(2), sc.NewU128(2))))
+}
+
+func Test_FixedU128_Clamp(t *testing.T) {
+	two := NewFixedU128FromInteger(sc.NewU128(2))
+
+	assert.Equal(t, fixedU128One, fixedU128Half.Clamp(fixedU128One, two))
+	assert.Equal(t, two, MaxFixedU128().Clamp(fixedU128One, two))
+	assert.Equal(t, fixedU128One, fixedU128One.Clamp(fixedU128Half, two))
+}
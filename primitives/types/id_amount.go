@@ -0,0 +1,77 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// RuntimeReason identifies why funds are held or frozen. It is encoded as the
+// runtime-wide reason enum: the index of the module which owns the reason,
+// followed by the module-specific reason variant.
+type RuntimeReason struct {
+	ModuleIndex sc.U8
+	Reason      sc.U8
+}
+
+func NewRuntimeReason(moduleIndex sc.U8, reason sc.U8) RuntimeReason {
+	return RuntimeReason{
+		ModuleIndex: moduleIndex,
+		Reason:      reason,
+	}
+}
+
+func (rr RuntimeReason) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		rr.ModuleIndex,
+		rr.Reason,
+	)
+}
+
+func (rr RuntimeReason) Bytes() []byte {
+	return sc.EncodedBytes(rr)
+}
+
+func DecodeRuntimeReason(buffer *bytes.Buffer) (RuntimeReason, error) {
+	moduleIndex, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return RuntimeReason{}, err
+	}
+	reason, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return RuntimeReason{}, err
+	}
+	return NewRuntimeReason(moduleIndex, reason), nil
+}
+
+// IdAmount is an amount of balance held or frozen for a given reason.
+type IdAmount struct {
+	Id     RuntimeReason
+	Amount Balance
+}
+
+func (ia IdAmount) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		ia.Id,
+		ia.Amount,
+	)
+}
+
+func (ia IdAmount) Bytes() []byte {
+	return sc.EncodedBytes(ia)
+}
+
+func DecodeIdAmount(buffer *bytes.Buffer) (IdAmount, error) {
+	id, err := DecodeRuntimeReason(buffer)
+	if err != nil {
+		return IdAmount{}, err
+	}
+	amount, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return IdAmount{}, err
+	}
+	return IdAmount{
+		Id:     id,
+		Amount: amount,
+	}, nil
+}
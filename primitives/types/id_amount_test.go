@@ -0,0 +1,60 @@
+package types
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	idAmount = IdAmount{
+		Id:     NewRuntimeReason(5, 1),
+		Amount: sc.NewU128(7),
+	}
+
+	idAmountBytes = []byte{
+		0x5, 0x1,
+		0x7, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	}
+)
+
+func Test_RuntimeReason_Bytes(t *testing.T) {
+	assert.Equal(t, []byte{0x5, 0x1}, NewRuntimeReason(5, 1).Bytes())
+}
+
+func Test_DecodeRuntimeReason(t *testing.T) {
+	result, err := DecodeRuntimeReason(bytes.NewBuffer([]byte{0x5, 0x1}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, RuntimeReason{ModuleIndex: 5, Reason: 1}, result)
+}
+
+func Test_DecodeRuntimeReason_Empty(t *testing.T) {
+	result, err := DecodeRuntimeReason(bytes.NewBuffer([]byte{0x5}))
+
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, RuntimeReason{}, result)
+}
+
+func Test_IdAmount_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := idAmount.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, idAmountBytes, buffer.Bytes())
+}
+
+func Test_IdAmount_Bytes(t *testing.T) {
+	assert.Equal(t, idAmountBytes, idAmount.Bytes())
+}
+
+func Test_DecodeIdAmount(t *testing.T) {
+	result, err := DecodeIdAmount(bytes.NewBuffer(idAmountBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, idAmount, result)
+}
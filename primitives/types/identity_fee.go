@@ -10,3 +10,14 @@ type IdentityFee struct {
 func (i IdentityFee) WeightToFee(weight Weight) Balance {
 	return sc.NewU128(weight.RefTime)
 }
+
+func (i IdentityFee) Polynomial() sc.Sequence[WeightToFeeCoefficient] {
+	return sc.Sequence[WeightToFeeCoefficient]{
+		{
+			CoeffInteger: sc.NewU128(1),
+			CoeffFrac:    Perbill{},
+			Negative:     false,
+			Degree:       1,
+		},
+	}
+}
@@ -18,3 +18,11 @@ func Test_IdentityFee_Mul(t *testing.T) {
 
 	assert.Equal(t, expect, result)
 }
+
+func Test_IdentityFee_Polynomial(t *testing.T) {
+	expect := sc.Sequence[WeightToFeeCoefficient]{
+		{CoeffInteger: sc.NewU128(1), CoeffFrac: Perbill{}, Negative: false, Degree: 1},
+	}
+
+	assert.Equal(t, expect, IdentityFee{}.Polynomial())
+}
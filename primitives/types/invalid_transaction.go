@@ -63,6 +63,12 @@ const (
 
 	// The sending address is disabled or known to be invalid.
 	InvalidTransactionBadSigner
+
+	// The implicit data was unable to be calculated.
+	InvalidTransactionIndeterminateImplicit
+
+	// The transaction extension did not authorize any origin.
+	InvalidTransactionUnknownOrigin
 )
 
 type InvalidTransaction struct {
@@ -113,6 +119,14 @@ func NewInvalidTransactionBadSigner() InvalidTransaction {
 	return InvalidTransaction{sc.NewVaryingData(InvalidTransactionBadSigner)}
 }
 
+func NewInvalidTransactionIndeterminateImplicit() InvalidTransaction {
+	return InvalidTransaction{sc.NewVaryingData(InvalidTransactionIndeterminateImplicit)}
+}
+
+func NewInvalidTransactionUnknownOrigin() InvalidTransaction {
+	return InvalidTransaction{sc.NewVaryingData(InvalidTransactionUnknownOrigin)}
+}
+
 func (err InvalidTransaction) Error() string {
 	if len(err.VaryingData) == 0 {
 		return newTypeError("InvalidTransaction").Error()
@@ -141,6 +155,10 @@ func (err InvalidTransaction) Error() string {
 		return "Transaction dispatch is mandatory; transactions must not be validated."
 	case InvalidTransactionBadSigner:
 		return "Invalid signing address"
+	case InvalidTransactionIndeterminateImplicit:
+		return "The implicit data was unable to be calculated"
+	case InvalidTransactionUnknownOrigin:
+		return "The transaction extension did not authorize any origin"
 	default:
 		return newTypeError("InvalidTransaction").Error()
 	}
@@ -206,6 +224,16 @@ func (err InvalidTransaction) MetadataDefinition() *MetadataTypeDefinition {
 				sc.Sequence[MetadataTypeDefinitionField]{},
 				InvalidTransactionBadSigner,
 				""),
+			NewMetadataDefinitionVariant(
+				"IndeterminateImplicit",
+				sc.Sequence[MetadataTypeDefinitionField]{},
+				InvalidTransactionIndeterminateImplicit,
+				""),
+			NewMetadataDefinitionVariant(
+				"UnknownOrigin",
+				sc.Sequence[MetadataTypeDefinitionField]{},
+				InvalidTransactionUnknownOrigin,
+				""),
 		},
 	)
 	return &def
@@ -244,6 +272,10 @@ func DecodeInvalidTransaction(buffer *bytes.Buffer) (InvalidTransaction, error)
 		return NewInvalidTransactionMandatoryValidation(), nil
 	case InvalidTransactionBadSigner:
 		return NewInvalidTransactionBadSigner(), nil
+	case InvalidTransactionIndeterminateImplicit:
+		return NewInvalidTransactionIndeterminateImplicit(), nil
+	case InvalidTransactionUnknownOrigin:
+		return NewInvalidTransactionUnknownOrigin(), nil
 	default:
 		return InvalidTransaction{}, newTypeError("InvalidTransaction")
 	}
@@ -75,6 +75,18 @@ func Test_NewInvalidTransactionBadSigner(t *testing.T) {
 	assert.Equal(t, expect, NewInvalidTransactionBadSigner())
 }
 
+func Test_NewInvalidTransactionIndeterminateImplicit(t *testing.T) {
+	expect := InvalidTransaction{sc.NewVaryingData(InvalidTransactionIndeterminateImplicit)}
+
+	assert.Equal(t, expect, NewInvalidTransactionIndeterminateImplicit())
+}
+
+func Test_NewInvalidTransactionUnknownOrigin(t *testing.T) {
+	expect := InvalidTransaction{sc.NewVaryingData(InvalidTransactionUnknownOrigin)}
+
+	assert.Equal(t, expect, NewInvalidTransactionUnknownOrigin())
+}
+
 func Test_DecodeInvalidTransaction_Call(t *testing.T) {
 	buffer := &bytes.Buffer{}
 	buffer.WriteByte(0)
@@ -187,6 +199,26 @@ func Test_DecodeInvalidTransaction_BadSigner(t *testing.T) {
 	assert.Equal(t, NewInvalidTransactionBadSigner(), result)
 }
 
+func Test_DecodeInvalidTransaction_IndeterminateImplicit(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(11)
+
+	result, err := DecodeInvalidTransaction(buffer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, NewInvalidTransactionIndeterminateImplicit(), result)
+}
+
+func Test_DecodeInvalidTransaction_UnknownOrigin(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteByte(12)
+
+	result, err := DecodeInvalidTransaction(buffer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, NewInvalidTransactionUnknownOrigin(), result)
+}
+
 func Test_DecodeInvalidTransaction_TypeError(t *testing.T) {
 	buffer := &bytes.Buffer{}
 	buffer.WriteByte(50)
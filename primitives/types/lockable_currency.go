@@ -0,0 +1,45 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+// LockableCurrency provides an abstraction over placing withdrawal locks on account balances.
+// Locks are identified by an 8-byte id and overlap: the frozen amount of an account is the
+// largest amount among all of its locks and freezes.
+type LockableCurrency interface {
+	// SetLock creates or overwrites the lock `id` on the balance of `who`.
+	// A zero `amount` is a no-op.
+	SetLock(id sc.FixedSequence[sc.U8], who AccountId, amount Balance, reasons Reasons) error
+	// ExtendLock changes the lock `id` on the balance of `who` so that it is at least `amount`
+	// and covers at least `reasons`. Creates the lock if it does not exist.
+	ExtendLock(id sc.FixedSequence[sc.U8], who AccountId, amount Balance, reasons Reasons) error
+	// RemoveLock removes the lock `id` from the balance of `who`.
+	RemoveLock(id sc.FixedSequence[sc.U8], who AccountId) error
+}
+
+// MutateHold provides an abstraction over placing funds on hold for a specific reason.
+// Held funds are moved out of the free balance and count towards the reserved balance.
+type MutateHold interface {
+	// BalanceOnHold returns the amount of `who`'s balance held for `reason`.
+	BalanceOnHold(reason RuntimeReason, who AccountId) (Balance, error)
+	// Hold moves `amount` from the free balance of `who` onto hold for `reason`.
+	Hold(reason RuntimeReason, who AccountId, amount Balance) error
+	// Release moves up to `amount` held for `reason` back into the free balance of `who`.
+	// If `bestEffort` is false, releasing more than is on hold fails.
+	// Returns the amount actually released.
+	Release(reason RuntimeReason, who AccountId, amount Balance, bestEffort bool) (Balance, error)
+}
+
+// MutateFreeze provides an abstraction over freezing account balances for a specific reason.
+// Frozen funds stay in the account, but cannot be withdrawn.
+type MutateFreeze interface {
+	// BalanceFrozen returns the amount of `who`'s balance frozen for `id`.
+	BalanceFrozen(id RuntimeReason, who AccountId) (Balance, error)
+	// SetFreeze creates or overwrites the freeze `id` on the balance of `who`.
+	// A zero `amount` removes the freeze.
+	SetFreeze(id RuntimeReason, who AccountId, amount Balance) error
+	// ExtendFreeze changes the freeze `id` on the balance of `who` so that it is at least `amount`.
+	// Creates the freeze if it does not exist.
+	ExtendFreeze(id RuntimeReason, who AccountId, amount Balance) error
+	// Thaw removes the freeze `id` from the balance of `who`.
+	Thaw(id RuntimeReason, who AccountId) error
+}
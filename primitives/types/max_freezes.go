@@ -0,0 +1,11 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+type MaxFreezes struct {
+	sc.U32
+}
+
+func (mf MaxFreezes) Docs() string {
+	return "The maximum number of individual freeze locks that can exist on an account at any time."
+}
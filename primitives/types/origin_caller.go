@@ -0,0 +1,51 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	OriginCallerSystem sc.U8 = iota
+)
+
+// OriginCaller is the runtime origin caller, as encoded in calls which are
+// dispatched on behalf of a given origin. The System module is the only
+// origin provider, so the caller always wraps a RawOrigin.
+type OriginCaller struct {
+	RawOrigin
+}
+
+func NewOriginCallerSystem(origin RawOrigin) OriginCaller {
+	return OriginCaller{origin}
+}
+
+func (oc OriginCaller) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		OriginCallerSystem,
+		oc.RawOrigin,
+	)
+}
+
+func DecodeOriginCaller(buffer *bytes.Buffer) (OriginCaller, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return OriginCaller{}, err
+	}
+
+	switch b {
+	case OriginCallerSystem:
+		origin, err := DecodeRawOrigin(buffer)
+		if err != nil {
+			return OriginCaller{}, err
+		}
+		return NewOriginCallerSystem(origin), nil
+	default:
+		return OriginCaller{}, newTypeError("OriginCaller")
+	}
+}
+
+func (oc OriginCaller) Bytes() []byte {
+	return sc.EncodedBytes(oc)
+}
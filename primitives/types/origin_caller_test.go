@@ -0,0 +1,48 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OriginCaller_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := NewOriginCallerSystem(signedOrigin).Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, append([]byte{0x00}, signedOrigin.Bytes()...), buffer.Bytes())
+}
+
+func Test_OriginCaller_Bytes(t *testing.T) {
+	assert.Equal(t, []byte{0x00, 0x00}, NewOriginCallerSystem(rootOrigin).Bytes())
+}
+
+func Test_DecodeOriginCaller(t *testing.T) {
+	buffer := bytes.NewBuffer(NewOriginCallerSystem(signedOrigin).Bytes())
+
+	result, err := DecodeOriginCaller(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, NewOriginCallerSystem(signedOrigin), result)
+}
+
+func Test_DecodeOriginCaller_InvalidType(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{0x01, 0x00})
+
+	result, err := DecodeOriginCaller(buffer)
+
+	assert.Equal(t, newTypeError("OriginCaller"), err)
+	assert.Equal(t, OriginCaller{}, result)
+}
+
+func Test_DecodeOriginCaller_InvalidRawOrigin(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{0x00, 0x03})
+
+	result, err := DecodeOriginCaller(buffer)
+
+	assert.Equal(t, newTypeError("RawOrigin"), err)
+	assert.Equal(t, OriginCaller{}, result)
+}
@@ -3,40 +3,142 @@ package types
 import (
 	"bytes"
 	"errors"
+	"math/big"
 
 	sc "github.com/LimeChain/goscale"
 )
 
+// Perbill is a fixed point representation of a number in the range [0, 1],
+// expressed in parts per billion.
 type Perbill struct {
-	Percentage sc.U32
+	Parts sc.U32
+}
+
+const perbillAccuracy = 1_000_000_000
+
+// NewPerbillFromPercent creates a Perbill from a percentage, saturating at 100%.
+func NewPerbillFromPercent(percent sc.U32) Perbill {
+	return Perbill{sc.Min32(percent, 100) * (perbillAccuracy / 100)}
 }
 
 func (p Perbill) Encode(buffer *bytes.Buffer) error {
-	return p.Percentage.Encode(buffer)
+	return p.Parts.Encode(buffer)
 }
 
 func DecodePerbill(buffer *bytes.Buffer) (Perbill, error) {
-	p := Perbill{}
-	percent, err := sc.DecodeU32(buffer)
+	parts, err := sc.DecodeU32(buffer)
 	if err != nil {
 		return Perbill{}, err
 	}
-	p.Percentage = percent
-	return p, nil
+	return Perbill{parts}, nil
 }
 
 func (p Perbill) Bytes() []byte {
 	return sc.EncodedBytes(p)
 }
 
+// Mul multiplies `v` by the ratio, rounding down.
 func (p Perbill) Mul(v sc.Encodable) (sc.Encodable, error) {
+	return mulParts(v, sc.U64(p.Parts), perbillAccuracy)
+}
+
+// mulU128 multiplies `v` by the ratio, rounding down.
+func (p Perbill) mulU128(v sc.U128) sc.U128 {
+	result, _ := mulParts(v, sc.U64(p.Parts), perbillAccuracy)
+	return result.(sc.U128)
+}
+
+// Permill is a fixed point representation of a number in the range [0, 1],
+// expressed in parts per million.
+type Permill struct {
+	Parts sc.U32
+}
+
+const permillAccuracy = 1_000_000
+
+// NewPermillFromPercent creates a Permill from a percentage, saturating at 100%.
+func NewPermillFromPercent(percent sc.U32) Permill {
+	return Permill{sc.Min32(percent, 100) * (permillAccuracy / 100)}
+}
+
+func (p Permill) Encode(buffer *bytes.Buffer) error {
+	return p.Parts.Encode(buffer)
+}
+
+func DecodePermill(buffer *bytes.Buffer) (Permill, error) {
+	parts, err := sc.DecodeU32(buffer)
+	if err != nil {
+		return Permill{}, err
+	}
+	return Permill{parts}, nil
+}
+
+func (p Permill) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+// Mul multiplies `v` by the ratio, rounding down.
+func (p Permill) Mul(v sc.Encodable) (sc.Encodable, error) {
+	return mulParts(v, sc.U64(p.Parts), permillAccuracy)
+}
+
+// Perquintill is a fixed point representation of a number in the range [0, 1],
+// expressed in parts per quintillion (10^18).
+type Perquintill struct {
+	Parts sc.U64
+}
+
+const perquintillAccuracy = 1_000_000_000_000_000_000
+
+// NewPerquintillFromPercent creates a Perquintill from a percentage, saturating at 100%.
+func NewPerquintillFromPercent(percent sc.U64) Perquintill {
+	return Perquintill{sc.Min64(percent, 100) * (perquintillAccuracy / 100)}
+}
+
+func (p Perquintill) Encode(buffer *bytes.Buffer) error {
+	return p.Parts.Encode(buffer)
+}
+
+func DecodePerquintill(buffer *bytes.Buffer) (Perquintill, error) {
+	parts, err := sc.DecodeU64(buffer)
+	if err != nil {
+		return Perquintill{}, err
+	}
+	return Perquintill{parts}, nil
+}
+
+func (p Perquintill) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+// Mul multiplies `v` by the ratio, rounding down.
+func (p Perquintill) Mul(v sc.Encodable) (sc.Encodable, error) {
+	return mulParts(v, p.Parts, perquintillAccuracy)
+}
+
+// mulParts computes `v * parts / accuracy` for the supported numeric types.
+// Since `parts` never exceeds `accuracy`, the result fits into the type of `v`.
+func mulParts(v sc.Encodable, parts sc.U64, accuracy uint64) (sc.Encodable, error) {
+	if uint64(parts) > accuracy {
+		parts = sc.U64(accuracy)
+	}
+
+	mul := func(n *big.Int) *big.Int {
+		result := new(big.Int).Mul(n, new(big.Int).SetUint64(uint64(parts)))
+		return result.Quo(result, new(big.Int).SetUint64(accuracy))
+	}
+
 	switch v := v.(type) {
 	case sc.U32:
-		return (v / 100) * p.Percentage, nil
+		return sc.U32(mul(v.ToBigInt()).Uint64()), nil
+	case sc.U64:
+		return sc.U64(mul(v.ToBigInt()).Uint64()), nil
+	case sc.U128:
+		return sc.NewU128(mul(v.ToBigInt())), nil
 	case Weight:
 		return WeightFromParts(
-			(v.RefTime/100)*sc.U64(p.Percentage),
-			(v.ProofSize/100)*sc.U64(p.Percentage),
+			sc.U64(mul(v.RefTime.ToBigInt()).Uint64()),
+			sc.U64(mul(v.ProofSize.ToBigInt()).Uint64()),
 		), nil
 	default:
 		return nil, errors.New("unsupported type")
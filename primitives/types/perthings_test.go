@@ -0,0 +1,128 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	permill     = NewPermillFromPercent(25)
+	perquintill = NewPerquintillFromPercent(25)
+)
+
+func Test_NewPerbillFromPercent(t *testing.T) {
+	assert.Equal(t, Perbill{Parts: 750_000_000}, NewPerbillFromPercent(75))
+	assert.Equal(t, Perbill{Parts: 1_000_000_000}, NewPerbillFromPercent(200))
+}
+
+func Test_Perbill_Encode_Decode(t *testing.T) {
+	perbill := Perbill{Parts: 1}
+	buffer := &bytes.Buffer{}
+
+	err := perbill.Encode(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x1, 0x0, 0x0, 0x0}, buffer.Bytes())
+	assert.Equal(t, buffer.Bytes(), perbill.Bytes())
+
+	result, err := DecodePerbill(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, perbill, result)
+}
+
+func Test_Perbill_Mul_U128(t *testing.T) {
+	result, err := NewPerbillFromPercent(75).Mul(sc.NewU128(1000))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(750), result)
+}
+
+func Test_Perbill_Mul_MultipliesBeforeDividing(t *testing.T) {
+	for _, tc := range []struct {
+		value  sc.Encodable
+		expect sc.Encodable
+	}{
+		{value: sc.U32(99), expect: sc.U32(74)},
+		{value: sc.NewU128(1_000_000_000), expect: sc.NewU128(750_000_000)},
+		{value: WeightFromParts(99, 3), expect: WeightFromParts(74, 2)},
+	} {
+		result, err := NewPerbillFromPercent(75).Mul(tc.value)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expect, result)
+	}
+
+	result, err := Perbill{Parts: 1}.Mul(sc.NewU128(1_000_000_000))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(1), result)
+}
+
+func Test_NewPermillFromPercent(t *testing.T) {
+	assert.Equal(t, Permill{Parts: 250_000}, permill)
+	assert.Equal(t, Permill{Parts: 1_000_000}, NewPermillFromPercent(150))
+}
+
+func Test_Permill_Encode_Decode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := permill.Encode(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x90, 0xd0, 0x3, 0x0}, buffer.Bytes())
+	assert.Equal(t, buffer.Bytes(), permill.Bytes())
+
+	result, err := DecodePermill(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, permill, result)
+}
+
+func Test_Permill_Mul(t *testing.T) {
+	for _, tc := range []struct {
+		value  sc.Encodable
+		expect sc.Encodable
+	}{
+		{value: sc.U32(1001), expect: sc.U32(250)},
+		{value: sc.U64(4000), expect: sc.U64(1000)},
+		{value: sc.NewU128(8), expect: sc.NewU128(2)},
+		{value: WeightFromParts(100, 10), expect: WeightFromParts(25, 2)},
+	} {
+		result, err := permill.Mul(tc.value)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expect, result)
+	}
+}
+
+func Test_Permill_Mul_UnsupportedType(t *testing.T) {
+	_, err := permill.Mul(sc.U8(1))
+
+	assert.Equal(t, errors.New("unsupported type"), err)
+}
+
+func Test_NewPerquintillFromPercent(t *testing.T) {
+	assert.Equal(t, Perquintill{Parts: 250_000_000_000_000_000}, perquintill)
+	assert.Equal(t, Perquintill{Parts: 1_000_000_000_000_000_000}, NewPerquintillFromPercent(101))
+}
+
+func Test_Perquintill_Encode_Decode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := perquintill.Encode(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, perquintill.Parts.Bytes(), buffer.Bytes())
+	assert.Equal(t, buffer.Bytes(), perquintill.Bytes())
+
+	result, err := DecodePerquintill(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, perquintill, result)
+}
+
+func Test_Perquintill_Mul(t *testing.T) {
+	result, err := perquintill.Mul(sc.U64(1<<63 + 1))
+
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U64(1<<61), result)
+}
@@ -1,11 +1,53 @@
 package types
 
-import sc "github.com/LimeChain/goscale"
+import (
+	"bytes"
 
+	sc "github.com/LimeChain/goscale"
+)
+
+// Reasons describes which kinds of withdrawals a balance lock applies to.
 type Reasons sc.U8
 
 const (
+	// ReasonsFee restricts only the payment of fees.
 	ReasonsFee Reasons = iota
+	// ReasonsMisc restricts any withdrawal other than fee payment.
 	ReasonsMisc
+	// ReasonsAll restricts every kind of withdrawal.
 	ReasonsAll
 )
+
+func (r Reasons) Encode(buffer *bytes.Buffer) error {
+	return sc.U8(r).Encode(buffer)
+}
+
+func DecodeReasons(buffer *bytes.Buffer) (Reasons, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return 0, err
+	}
+
+	switch Reasons(b) {
+	case ReasonsFee:
+		return ReasonsFee, nil
+	case ReasonsMisc:
+		return ReasonsMisc, nil
+	case ReasonsAll:
+		return ReasonsAll, nil
+	default:
+		return 0, newTypeError("Reasons")
+	}
+}
+
+func (r Reasons) Bytes() []byte {
+	return sc.EncodedBytes(r)
+}
+
+// Union returns the reasons covering both `r` and `other`.
+func (r Reasons) Union(other Reasons) Reasons {
+	if r == other {
+		return r
+	}
+	return ReasonsAll
+}
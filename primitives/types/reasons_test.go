@@ -0,0 +1,52 @@
+package types
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Reasons_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := ReasonsMisc.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1}, buffer.Bytes())
+}
+
+func Test_Reasons_Bytes(t *testing.T) {
+	assert.Equal(t, []byte{2}, ReasonsAll.Bytes())
+}
+
+func Test_DecodeReasons(t *testing.T) {
+	for _, reasons := range []Reasons{ReasonsFee, ReasonsMisc, ReasonsAll} {
+		result, err := DecodeReasons(bytes.NewBuffer(reasons.Bytes()))
+
+		assert.NoError(t, err)
+		assert.Equal(t, reasons, result)
+	}
+}
+
+func Test_DecodeReasons_TypeError(t *testing.T) {
+	result, err := DecodeReasons(bytes.NewBuffer([]byte{3}))
+
+	assert.Equal(t, newTypeError("Reasons"), err)
+	assert.Equal(t, Reasons(0), result)
+}
+
+func Test_DecodeReasons_Empty(t *testing.T) {
+	result, err := DecodeReasons(&bytes.Buffer{})
+
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, Reasons(0), result)
+}
+
+func Test_Reasons_Union(t *testing.T) {
+	assert.Equal(t, ReasonsFee, ReasonsFee.Union(ReasonsFee))
+	assert.Equal(t, ReasonsMisc, ReasonsMisc.Union(ReasonsMisc))
+	assert.Equal(t, ReasonsAll, ReasonsFee.Union(ReasonsMisc))
+	assert.Equal(t, ReasonsAll, ReasonsMisc.Union(ReasonsAll))
+}
@@ -0,0 +1,51 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+// ReservableCurrency provides an abstraction over reserving account balances.
+// Reserved funds are moved out of the free balance, but still belong to the account
+// and can be slashed or repatriated to another account.
+type ReservableCurrency interface {
+	// CanReserve returns true if `value` can be moved from the free to the reserved balance of `who`.
+	CanReserve(who AccountId, value Balance) (bool, error)
+	// ReservedBalance returns the reserved balance of `who`.
+	ReservedBalance(who AccountId) (Balance, error)
+	// Reserve moves `value` from the free balance of `who` to its reserved balance.
+	// Fails if the free balance is too low or the withdrawal is restricted by locks.
+	Reserve(who AccountId, value Balance) error
+	// Unreserve moves up to `value` from the reserved balance of `who` back to its free balance.
+	// Returns the amount that could not be unreserved.
+	Unreserve(who AccountId, value Balance) (Balance, error)
+	// Slash deducts up to `value` from the free balance of `who`, without reaping the account
+	// if it cannot lose a provider reference. The slashed amount is burned.
+	// Returns the amount that could not be slashed.
+	Slash(who AccountId, value Balance) (Balance, error)
+	// SlashReserved deducts up to `value` from the reserved balance of `who`.
+	// The slashed amount is burned. Returns the amount that could not be slashed.
+	SlashReserved(who AccountId, value Balance) (Balance, error)
+	// RepatriateReserved moves up to `value` from the reserved balance of `slashed` to
+	// the balance of `beneficiary` described by `status`.
+	// Returns the amount that could not be moved.
+	RepatriateReserved(slashed AccountId, beneficiary AccountId, value Balance, status BalanceStatus) (Balance, error)
+}
+
+// NamedReservableCurrency extends ReservableCurrency with reserves tracked under an identifier,
+// so that independent reserves on the same account can be released separately.
+type NamedReservableCurrency interface {
+	ReservableCurrency
+
+	// ReservedBalanceNamed returns the amount of `who`'s balance reserved under `id`.
+	ReservedBalanceNamed(id sc.FixedSequence[sc.U8], who AccountId) (Balance, error)
+	// ReserveNamed moves `value` from the free balance of `who` to its reserved balance under `id`.
+	ReserveNamed(id sc.FixedSequence[sc.U8], who AccountId, value Balance) error
+	// UnreserveNamed moves up to `value` reserved under `id` back to the free balance of `who`.
+	// Returns the amount that could not be unreserved.
+	UnreserveNamed(id sc.FixedSequence[sc.U8], who AccountId, value Balance) (Balance, error)
+	// SlashReservedNamed deducts up to `value` reserved under `id` from the balance of `who`.
+	// Returns the amount that could not be slashed.
+	SlashReservedNamed(id sc.FixedSequence[sc.U8], who AccountId, value Balance) (Balance, error)
+	// RepatriateReservedNamed moves up to `value` reserved under `id` from `slashed` to the
+	// balance of `beneficiary` described by `status`. A reserved destination is tracked under the same `id`.
+	// Returns the amount that could not be moved.
+	RepatriateReservedNamed(id sc.FixedSequence[sc.U8], slashed AccountId, beneficiary AccountId, value Balance, status BalanceStatus) (Balance, error)
+}
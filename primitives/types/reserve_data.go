@@ -0,0 +1,42 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// ReserveIdentifierLength is the byte length of a named reserve identifier.
+const ReserveIdentifierLength = 8
+
+// ReserveData is a single named reserve on an account balance.
+type ReserveData struct {
+	Id     sc.FixedSequence[sc.U8]
+	Amount Balance
+}
+
+func (rd ReserveData) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		rd.Id,
+		rd.Amount,
+	)
+}
+
+func (rd ReserveData) Bytes() []byte {
+	return sc.EncodedBytes(rd)
+}
+
+func DecodeReserveData(buffer *bytes.Buffer) (ReserveData, error) {
+	id, err := sc.DecodeFixedSequence[sc.U8](ReserveIdentifierLength, buffer)
+	if err != nil {
+		return ReserveData{}, err
+	}
+	amount, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return ReserveData{}, err
+	}
+	return ReserveData{
+		Id:     id,
+		Amount: amount,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	reserveData = ReserveData{
+		Id:     sc.BytesToFixedSequenceU8([]byte("py/trsry")),
+		Amount: sc.NewU128(7),
+	}
+
+	reserveDataBytes = []byte{
+		'p', 'y', '/', 't', 'r', 's', 'r', 'y',
+		0x7, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	}
+)
+
+func Test_ReserveData_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := reserveData.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, reserveDataBytes, buffer.Bytes())
+}
+
+func Test_ReserveData_Bytes(t *testing.T) {
+	assert.Equal(t, reserveDataBytes, reserveData.Bytes())
+}
+
+func Test_DecodeReserveData(t *testing.T) {
+	result, err := DecodeReserveData(bytes.NewBuffer(reserveDataBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, reserveData, result)
+}
+
+func Test_DecodeReserveData_Empty(t *testing.T) {
+	result, err := DecodeReserveData(&bytes.Buffer{})
+
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, ReserveData{}, result)
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	sc "github.com/LimeChain/goscale"
+)
+
+// TransactionExtensionVersion is the version of the transaction extensions, which are included
+// in general extrinsics.
+const TransactionExtensionVersion sc.U8 = 0
+
+// TransactionExtension generalizes SignedExtension to transactions, which are not necessarily signed.
+// Instead of the signer, it receives the origin of the transaction, which it may authorize by
+// returning a different origin from ValidateTransaction. The returned origin is passed to the
+// following extensions and the transaction is dispatched with the final one.
+type TransactionExtension interface {
+	SignedExtension
+
+	// Validate a transaction from `origin` for the transaction queue.
+	//
+	// Returns the validity of the transaction and the origin, which is passed to the following
+	// extensions. Make sure to perform the same checks in `PrepareTransaction`.
+	ValidateTransaction(origin RuntimeOrigin, call Call, info *DispatchInfo, length sc.Compact) (ValidTransaction, RuntimeOrigin, error)
+
+	// Do any pre-flight stuff for a transaction from `origin`, which is the origin returned by
+	// `ValidateTransaction`. Returns the value, which is passed to `PostDispatch`, if any.
+	PrepareTransaction(origin RuntimeOrigin, call Call, info *DispatchInfo, length sc.Compact) (sc.Option[Pre], error)
+}
+
+// signedExtensionAdapter adapts a SignedExtension to a TransactionExtension. It validates only
+// the transactions with a signed origin and leaves the origin unchanged. A general transaction,
+// which is left with a none origin by its extensions, is rejected as not authorized.
+type signedExtensionAdapter struct {
+	SignedExtension
+}
+
+// AsTransactionExtension returns `extension` as a TransactionExtension. A SignedExtension, which
+// does not implement TransactionExtension, is adapted to validate and prepare only the transactions
+// with a signed origin, as it does for signed extrinsics.
+func AsTransactionExtension(extension SignedExtension) TransactionExtension {
+	if transactionExtension, ok := extension.(TransactionExtension); ok {
+		return transactionExtension
+	}
+	return signedExtensionAdapter{extension}
+}
+
+func (a signedExtensionAdapter) ValidateTransaction(origin RuntimeOrigin, call Call, info *DispatchInfo, length sc.Compact) (ValidTransaction, RuntimeOrigin, error) {
+	if !origin.IsSignedOrigin() {
+		return DefaultValidTransaction(), origin, nil
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return ValidTransaction{}, origin, err
+	}
+
+	valid, err := a.Validate(who, call, info, length)
+	if err != nil {
+		return ValidTransaction{}, origin, err
+	}
+
+	return valid, origin, nil
+}
+
+func (a signedExtensionAdapter) PrepareTransaction(origin RuntimeOrigin, call Call, info *DispatchInfo, length sc.Compact) (sc.Option[Pre], error) {
+	if !origin.IsSignedOrigin() {
+		return sc.NewOption[Pre](nil), nil
+	}
+
+	who, err := origin.AsSigned()
+	if err != nil {
+		return sc.Option[Pre]{}, err
+	}
+
+	pre, err := a.PreDispatch(who, call, info, length)
+	if err != nil {
+		return sc.Option[Pre]{}, err
+	}
+
+	return sc.NewOption[Pre](pre), nil
+}
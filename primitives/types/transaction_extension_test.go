@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	signedOrigin = NewRawOriginSigned(who)
+	noneOrigin   = NewRawOriginNone()
+
+	extensionAuthorize = testExtensionAuthorize{extraCheckOk1.(*testExtraCheck)}
+)
+
+// testExtensionAuthorize is a TransactionExtension, which authorizes any transaction as signed by `who`.
+type testExtensionAuthorize struct {
+	*testExtraCheck
+}
+
+func (e testExtensionAuthorize) ValidateTransaction(origin RuntimeOrigin, call Call, info *DispatchInfo, length sc.Compact) (ValidTransaction, RuntimeOrigin, error) {
+	return DefaultValidTransaction(), signedOrigin, nil
+}
+
+func (e testExtensionAuthorize) PrepareTransaction(origin RuntimeOrigin, call Call, info *DispatchInfo, length sc.Compact) (sc.Option[Pre], error) {
+	return sc.NewOption[Pre](sc.NewVaryingData(sc.U32(7))), nil
+}
+
+func Test_AsTransactionExtension_TransactionExtension(t *testing.T) {
+	assert.Equal(t, extensionAuthorize, AsTransactionExtension(extensionAuthorize))
+}
+
+func Test_AsTransactionExtension_SignedExtension(t *testing.T) {
+	assert.Equal(t, signedExtensionAdapter{extraCheckOk1}, AsTransactionExtension(extraCheckOk1))
+}
+
+func Test_SignedExtensionAdapter_ValidateTransaction_Signed(t *testing.T) {
+	expect := DefaultValidTransaction()
+	expect.Priority = 1
+
+	result, origin, err := AsTransactionExtension(extraCheckOk1).ValidateTransaction(signedOrigin, call, info, length)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expect, result)
+	assert.Equal(t, signedOrigin, origin)
+}
+
+func Test_SignedExtensionAdapter_ValidateTransaction_Signed_Err(t *testing.T) {
+	result, origin, err := AsTransactionExtension(extraCheckErr1).ValidateTransaction(signedOrigin, call, info, length)
+
+	assert.Equal(t, expectedTransactionValidityError, err)
+	assert.Equal(t, ValidTransaction{}, result)
+	assert.Equal(t, signedOrigin, origin)
+}
+
+func Test_SignedExtensionAdapter_ValidateTransaction_None(t *testing.T) {
+	result, origin, err := AsTransactionExtension(extraCheckErr1).ValidateTransaction(noneOrigin, call, info, length)
+
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultValidTransaction(), result)
+	assert.Equal(t, noneOrigin, origin)
+}
+
+func Test_SignedExtensionAdapter_PrepareTransaction_Signed(t *testing.T) {
+	result, err := AsTransactionExtension(extraCheckOk1).PrepareTransaction(signedOrigin, call, info, length)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewOption[Pre](Pre{}), result)
+}
+
+func Test_SignedExtensionAdapter_PrepareTransaction_Signed_Err(t *testing.T) {
+	result, err := AsTransactionExtension(extraCheckErr1).PrepareTransaction(signedOrigin, call, info, length)
+
+	assert.Equal(t, expectedTransactionValidityError, err)
+	assert.Equal(t, sc.Option[Pre]{}, result)
+}
+
+func Test_SignedExtensionAdapter_PrepareTransaction_None(t *testing.T) {
+	result, err := AsTransactionExtension(extraCheckErr1).PrepareTransaction(noneOrigin, call, info, length)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewOption[Pre](nil), result)
+}
@@ -10,5 +10,7 @@ type UncheckedExtrinsic interface {
 	Extra() SignedExtra
 
 	IsSigned() bool
+	IsGeneral() bool
+	IsBare() bool
 	Check() (CheckedExtrinsic, error)
 }
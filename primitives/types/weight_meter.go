@@ -0,0 +1,42 @@
+package types
+
+// WeightMeter tracks the weight consumed by an operation, which must not exceed a given limit.
+type WeightMeter struct {
+	// Consumed is the weight consumed so far.
+	Consumed Weight
+	// Limit is the maximum weight, which can be consumed.
+	Limit Weight
+}
+
+// NewWeightMeter creates a meter, which allows consuming up to `limit`.
+func NewWeightMeter(limit Weight) WeightMeter {
+	return WeightMeter{
+		Consumed: WeightZero(),
+		Limit:    limit,
+	}
+}
+
+// Remaining returns the weight, which can still be consumed.
+func (wm WeightMeter) Remaining() Weight {
+	return wm.Limit.SaturatingSub(wm.Consumed)
+}
+
+// CanConsume checks whether `weight` can be consumed without exceeding the limit.
+func (wm WeightMeter) CanConsume(weight Weight) bool {
+	total := wm.Consumed.CheckedAdd(weight)
+	return total.HasValue && !total.Value.AnyGt(wm.Limit)
+}
+
+// TryConsume consumes `weight` if it does not exceed the limit. Returns whether it was consumed.
+func (wm *WeightMeter) TryConsume(weight Weight) bool {
+	if !wm.CanConsume(weight) {
+		return false
+	}
+	wm.Consumed.SaturatingAccrue(weight)
+	return true
+}
+
+// Consume consumes `weight`, regardless of the limit.
+func (wm *WeightMeter) Consume(weight Weight) {
+	wm.Consumed.SaturatingAccrue(weight)
+}
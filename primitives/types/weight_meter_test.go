@@ -0,0 +1,46 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewWeightMeter(t *testing.T) {
+	target := NewWeightMeter(WeightFromParts(10, 20))
+
+	assert.Equal(t, WeightZero(), target.Consumed)
+	assert.Equal(t, WeightFromParts(10, 20), target.Limit)
+	assert.Equal(t, WeightFromParts(10, 20), target.Remaining())
+}
+
+func Test_WeightMeter_CanConsume(t *testing.T) {
+	target := NewWeightMeter(WeightFromParts(10, 20))
+	target.Consumed = WeightFromParts(5, 5)
+
+	assert.True(t, target.CanConsume(WeightFromParts(5, 15)))
+	assert.False(t, target.CanConsume(WeightFromParts(6, 0)))
+	assert.False(t, target.CanConsume(WeightFromParts(0, 16)))
+	assert.False(t, target.CanConsume(WeightFromParts(math.MaxUint64, 0)))
+}
+
+func Test_WeightMeter_TryConsume(t *testing.T) {
+	target := NewWeightMeter(WeightFromParts(10, 20))
+
+	assert.True(t, target.TryConsume(WeightFromParts(4, 10)))
+	assert.Equal(t, WeightFromParts(4, 10), target.Consumed)
+
+	assert.False(t, target.TryConsume(WeightFromParts(7, 0)))
+	assert.Equal(t, WeightFromParts(4, 10), target.Consumed)
+	assert.Equal(t, WeightFromParts(6, 10), target.Remaining())
+}
+
+func Test_WeightMeter_Consume(t *testing.T) {
+	target := NewWeightMeter(WeightFromParts(10, 20))
+
+	target.Consume(WeightFromParts(15, 10))
+
+	assert.Equal(t, WeightFromParts(15, 10), target.Consumed)
+	assert.Equal(t, WeightFromParts(0, 10), target.Remaining())
+}
@@ -1,5 +1,13 @@
 package types
 
+import sc "github.com/LimeChain/goscale"
+
 type WeightToFee interface {
 	WeightToFee(weight Weight) Balance
 }
+
+// FeePolynomial is implemented by WeightToFee conversions that can be described
+// as a polynomial over the ref time of a weight. The coefficients are exposed in the metadata.
+type FeePolynomial interface {
+	Polynomial() sc.Sequence[WeightToFeeCoefficient]
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// WeightToFeeCoefficient is a single term of a WeightToFeePolynomial.
+//
+// The term evaluates to `(CoeffInteger + CoeffFrac) * x^Degree`, which is
+// subtracted from the polynomial if Negative is set.
+type WeightToFeeCoefficient struct {
+	// The integral part of the coefficient.
+	CoeffInteger Balance
+	// The fractional part of the coefficient.
+	CoeffFrac Perbill
+	// Should the coefficient be subtracted.
+	Negative sc.Bool
+	// The degree/exponent of the term.
+	Degree sc.U8
+}
+
+func (c WeightToFeeCoefficient) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		c.CoeffInteger,
+		c.CoeffFrac,
+		c.Negative,
+		c.Degree,
+	)
+}
+
+func DecodeWeightToFeeCoefficient(buffer *bytes.Buffer) (WeightToFeeCoefficient, error) {
+	coeffInteger, err := sc.DecodeU128(buffer)
+	if err != nil {
+		return WeightToFeeCoefficient{}, err
+	}
+	coeffFrac, err := DecodePerbill(buffer)
+	if err != nil {
+		return WeightToFeeCoefficient{}, err
+	}
+	negative, err := sc.DecodeBool(buffer)
+	if err != nil {
+		return WeightToFeeCoefficient{}, err
+	}
+	degree, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return WeightToFeeCoefficient{}, err
+	}
+
+	return WeightToFeeCoefficient{
+		CoeffInteger: coeffInteger,
+		CoeffFrac:    coeffFrac,
+		Negative:     negative,
+		Degree:       degree,
+	}, nil
+}
+
+func (c WeightToFeeCoefficient) Bytes() []byte {
+	return sc.EncodedBytes(c)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	weightToFeeCoefficient = WeightToFeeCoefficient{
+		CoeffInteger: sc.NewU128(5),
+		CoeffFrac:    NewPerbillFromPercent(20),
+		Negative:     true,
+		Degree:       2,
+	}
+
+	weightToFeeCoefficientBytes = []byte{
+		0x5, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0xc2, 0xeb, 0xb,
+		0x1,
+		0x2,
+	}
+)
+
+func Test_WeightToFeeCoefficient_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := weightToFeeCoefficient.Encode(buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, weightToFeeCoefficientBytes, buffer.Bytes())
+}
+
+func Test_WeightToFeeCoefficient_Bytes(t *testing.T) {
+	assert.Equal(t, weightToFeeCoefficientBytes, weightToFeeCoefficient.Bytes())
+}
+
+func Test_DecodeWeightToFeeCoefficient(t *testing.T) {
+	result, err := DecodeWeightToFeeCoefficient(bytes.NewBuffer(weightToFeeCoefficientBytes))
+
+	assert.NoError(t, err)
+	assert.Equal(t, weightToFeeCoefficient, result)
+}
+
+func Test_DecodeWeightToFeeCoefficient_Fails(t *testing.T) {
+	_, err := DecodeWeightToFeeCoefficient(&bytes.Buffer{})
+
+	assert.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// WeightToFeePolynomial implements WeightToFee by evaluating a polynomial over
+// the ref time of a weight. Each term is saturated, and the result never goes below zero.
+type WeightToFeePolynomial struct {
+	Coefficients sc.Sequence[WeightToFeeCoefficient]
+}
+
+func NewWeightToFeePolynomial(coefficients ...WeightToFeeCoefficient) WeightToFeePolynomial {
+	return WeightToFeePolynomial{
+		Coefficients: coefficients,
+	}
+}
+
+func (p WeightToFeePolynomial) WeightToFee(weight Weight) Balance {
+	acc := sc.NewU128(0)
+
+	for _, coefficient := range p.Coefficients {
+		w := saturatingPowU128(sc.NewU128(weight.RefTime), coefficient.Degree)
+
+		frac := coefficient.CoeffFrac.mulU128(w)
+		integer := saturatingMulU128(coefficient.CoeffInteger, w)
+
+		if coefficient.Negative {
+			acc = sc.SaturatingSubU128(acc, frac)
+			acc = sc.SaturatingSubU128(acc, integer)
+		} else {
+			acc = sc.SaturatingAddU128(acc, frac)
+			acc = sc.SaturatingAddU128(acc, integer)
+		}
+	}
+
+	return acc
+}
+
+func (p WeightToFeePolynomial) Polynomial() sc.Sequence[WeightToFeeCoefficient] {
+	return p.Coefficients
+}
+
+func saturatingMulU128(a, b sc.U128) sc.U128 {
+	product := new(big.Int).Mul(a.ToBigInt(), b.ToBigInt())
+	if product.Cmp(maxU128) > 0 {
+		return sc.NewU128(maxU128)
+	}
+	return sc.NewU128(product)
+}
+
+func saturatingPowU128(base sc.U128, exp sc.U8) sc.U128 {
+	result := new(big.Int).Exp(base.ToBigInt(), big.NewInt(int64(exp)), nil)
+	if result.Cmp(maxU128) > 0 {
+		return sc.NewU128(maxU128)
+	}
+	return sc.NewU128(result)
+}
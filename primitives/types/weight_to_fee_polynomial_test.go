@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// 2 + 1.5x - 0.5x^2
+	weightToFeePolynomial = NewWeightToFeePolynomial(
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128(2), Degree: 0},
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128(1), CoeffFrac: NewPerbillFromPercent(50), Degree: 1},
+		WeightToFeeCoefficient{CoeffFrac: NewPerbillFromPercent(50), Negative: true, Degree: 2},
+	)
+)
+
+func Test_WeightToFeePolynomial_WeightToFee(t *testing.T) {
+	// 2 + 300 - 20_000
+	assert.Equal(t, sc.NewU128(0), weightToFeePolynomial.WeightToFee(WeightFromParts(200, 0)))
+	// 2 + 0 - 0
+	assert.Equal(t, sc.NewU128(2), weightToFeePolynomial.WeightToFee(WeightZero()))
+}
+
+func Test_WeightToFeePolynomial_WeightToFee_Positive(t *testing.T) {
+	polynomial := NewWeightToFeePolynomial(
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128(3), Degree: 1},
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128(1), Negative: true, Degree: 1},
+		WeightToFeeCoefficient{CoeffFrac: NewPerbillFromPercent(10), Degree: 2},
+	)
+
+	// 3 * 100 - 100 + 0.1 * 10_000
+	assert.Equal(t, sc.NewU128(1200), polynomial.WeightToFee(WeightFromParts(100, 7)))
+}
+
+func Test_WeightToFeePolynomial_WeightToFee_FractionalCoefficient(t *testing.T) {
+	polynomial := NewWeightToFeePolynomial(
+		WeightToFeeCoefficient{CoeffFrac: Perbill{Parts: 1}, Degree: 1},
+	)
+
+	// 10^-9 * 3 * 10^9
+	assert.Equal(t, sc.NewU128(3), polynomial.WeightToFee(WeightFromParts(3_000_000_000, 0)))
+}
+
+func Test_WeightToFeePolynomial_WeightToFee_Saturates(t *testing.T) {
+	polynomial := NewWeightToFeePolynomial(
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128(1), Degree: 3},
+	)
+
+	assert.Equal(t, MaxFixedU128().Inner, polynomial.WeightToFee(WeightFromParts(sc.U64(1<<63), 0)))
+}
+
+func Test_WeightToFeePolynomial_Polynomial(t *testing.T) {
+	assert.Equal(t, weightToFeePolynomial.Coefficients, weightToFeePolynomial.Polynomial())
+}
@@ -0,0 +1,105 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// Asset is an amount of a fungible asset, or an instance of a non-fungible asset. It is called MultiAsset
+// in XCM v3.
+type Asset struct {
+	Id  AssetId
+	Fun Fungibility
+}
+
+// MultiAsset is the name of Asset in XCM v3.
+type MultiAsset = Asset
+
+// NewAssetFungible returns `amount` of the fungible asset `id`.
+func NewAssetFungible(id AssetId, amount sc.U128) Asset {
+	return Asset{Id: id, Fun: NewFungibilityFungible(amount)}
+}
+
+func (a Asset) Encode(buffer *bytes.Buffer) error {
+	return a.encodeVersioned(buffer, CurrentVersion)
+}
+
+func (a Asset) encodeVersioned(buffer *bytes.Buffer, version sc.U8) error {
+	if err := a.Id.encodeVersioned(buffer, version); err != nil {
+		return err
+	}
+	return a.Fun.Encode(buffer)
+}
+
+func DecodeAsset(buffer *bytes.Buffer) (Asset, error) {
+	return decodeAssetVersioned(buffer, CurrentVersion)
+}
+
+func decodeAssetVersioned(buffer *bytes.Buffer, version sc.U8) (Asset, error) {
+	id, err := decodeAssetIdVersioned(buffer, version)
+	if err != nil {
+		return Asset{}, err
+	}
+	fun, err := DecodeFungibility(buffer)
+	if err != nil {
+		return Asset{}, err
+	}
+
+	return Asset{
+		Id:  id,
+		Fun: fun,
+	}, nil
+}
+
+func (a Asset) Bytes() []byte {
+	return sc.EncodedBytes(a)
+}
+
+// Assets is a collection of assets. It is called MultiAssets in XCM v3.
+type Assets sc.Sequence[Asset]
+
+// MultiAssets is the name of Assets in XCM v3.
+type MultiAssets = Assets
+
+func (a Assets) Encode(buffer *bytes.Buffer) error {
+	return a.encodeVersioned(buffer, CurrentVersion)
+}
+
+func (a Assets) encodeVersioned(buffer *bytes.Buffer, version sc.U8) error {
+	if err := sc.ToCompact(sc.U32(len(a))).Encode(buffer); err != nil {
+		return err
+	}
+	for _, asset := range a {
+		if err := asset.encodeVersioned(buffer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func DecodeAssets(buffer *bytes.Buffer) (Assets, error) {
+	return decodeAssetsVersioned(buffer, CurrentVersion)
+}
+
+func decodeAssetsVersioned(buffer *bytes.Buffer, version sc.U8) (Assets, error) {
+	length, err := decodeCompactU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := Assets{}
+	for i := sc.U32(0); i < length; i++ {
+		asset, err := decodeAssetVersioned(buffer, version)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, asset)
+	}
+
+	return assets, nil
+}
+
+func (a Assets) Bytes() []byte {
+	return sc.EncodedBytes(a)
+}
@@ -0,0 +1,101 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// AssetFilterDefinite matches the given assets exactly.
+	AssetFilterDefinite sc.U8 = iota
+	// AssetFilterWild matches assets with a wildcard.
+	AssetFilterWild
+)
+
+// AssetFilter selects assets, either exactly or with a wildcard. It is called MultiAssetFilter in XCM v3.
+type AssetFilter struct {
+	sc.VaryingData
+}
+
+func NewAssetFilterDefinite(assets Assets) AssetFilter {
+	return AssetFilter{sc.NewVaryingData(AssetFilterDefinite, assets)}
+}
+
+func NewAssetFilterWild(wild WildAsset) AssetFilter {
+	return AssetFilter{sc.NewVaryingData(AssetFilterWild, wild)}
+}
+
+func (af AssetFilter) Encode(buffer *bytes.Buffer) error {
+	return af.encodeVersioned(buffer, CurrentVersion)
+}
+
+func (af AssetFilter) encodeVersioned(buffer *bytes.Buffer, version sc.U8) error {
+	variant := af.VaryingData[0].(sc.U8)
+	if err := variant.Encode(buffer); err != nil {
+		return err
+	}
+
+	switch variant {
+	case AssetFilterDefinite:
+		return af.VaryingData[1].(Assets).encodeVersioned(buffer, version)
+	case AssetFilterWild:
+		return af.VaryingData[1].(WildAsset).encodeVersioned(buffer, version)
+	default:
+		return errInvalidAssetFilterType
+	}
+}
+
+func DecodeAssetFilter(buffer *bytes.Buffer) (AssetFilter, error) {
+	return decodeAssetFilterVersioned(buffer, CurrentVersion)
+}
+
+func decodeAssetFilterVersioned(buffer *bytes.Buffer, version sc.U8) (AssetFilter, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return AssetFilter{}, err
+	}
+
+	switch b {
+	case AssetFilterDefinite:
+		assets, err := decodeAssetsVersioned(buffer, version)
+		if err != nil {
+			return AssetFilter{}, err
+		}
+		return NewAssetFilterDefinite(assets), nil
+	case AssetFilterWild:
+		wild, err := decodeWildAssetVersioned(buffer, version)
+		if err != nil {
+			return AssetFilter{}, err
+		}
+		return NewAssetFilterWild(wild), nil
+	default:
+		return AssetFilter{}, errInvalidAssetFilterType
+	}
+}
+
+func (af AssetFilter) Bytes() []byte {
+	return sc.EncodedBytes(af)
+}
+
+func (af AssetFilter) IsDefinite() bool {
+	return af.VaryingData[0] == AssetFilterDefinite
+}
+
+func (af AssetFilter) AsDefinite() (Assets, error) {
+	if !af.IsDefinite() {
+		return nil, errInvalidAssetFilterType
+	}
+	return af.VaryingData[1].(Assets), nil
+}
+
+func (af AssetFilter) IsWild() bool {
+	return af.VaryingData[0] == AssetFilterWild
+}
+
+func (af AssetFilter) AsWild() (WildAsset, error) {
+	if !af.IsWild() {
+		return WildAsset{}, errInvalidAssetFilterType
+	}
+	return af.VaryingData[1].(WildAsset), nil
+}
@@ -0,0 +1,85 @@
+package xcm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AssetFilter_EncodeDecode(t *testing.T) {
+	parentId := NewAssetId(NewLocationParent())
+
+	for _, tt := range []struct {
+		name     string
+		filter   AssetFilter
+		expected string
+	}{
+		{name: "Definite", filter: NewAssetFilterDefinite(Assets{targetAsset}), expected: "00040100009101"},
+		{name: "All", filter: NewAssetFilterWild(NewWildAssetAll()), expected: "0100"},
+		{name: "AllOf", filter: NewAssetFilterWild(NewWildAssetAllOf(parentId, WildFungibilityFungible)), expected: "0101010000"},
+		{name: "AllCounted", filter: NewAssetFilterWild(NewWildAssetAllCounted(1)), expected: "010204"},
+		{name: "AllOfCounted", filter: NewAssetFilterWild(NewWildAssetAllOfCounted(parentId, WildFungibilityNonFungible, 2)), expected: "010301000108"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, _ := hex.DecodeString(tt.expected)
+
+			assert.Equal(t, expected, tt.filter.Bytes())
+
+			result, err := DecodeAssetFilter(bytes.NewBuffer(expected))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.filter, result)
+		})
+	}
+}
+
+func Test_AssetFilter_EncodeV3(t *testing.T) {
+	filter := NewAssetFilterWild(NewWildAssetAllOf(NewAssetId(NewLocationParent()), WildFungibilityFungible))
+	expected, _ := hex.DecodeString("010100010000")
+	buffer := &bytes.Buffer{}
+
+	err := filter.encodeVersioned(buffer, VersionV3)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, buffer.Bytes())
+
+	result, err := decodeAssetFilterVersioned(bytes.NewBuffer(expected), VersionV3)
+	assert.NoError(t, err)
+	assert.Equal(t, filter, result)
+}
+
+func Test_AssetFilter_As(t *testing.T) {
+	definite := NewAssetFilterDefinite(Assets{targetAsset})
+	wild := NewAssetFilterWild(NewWildAssetAll())
+
+	assets, err := definite.AsDefinite()
+	assert.NoError(t, err)
+	assert.Equal(t, Assets{targetAsset}, assets)
+	_, err = definite.AsWild()
+	assert.Equal(t, errInvalidAssetFilterType, err)
+
+	wildAsset, err := wild.AsWild()
+	assert.NoError(t, err)
+	assert.Equal(t, NewWildAssetAll(), wildAsset)
+	_, err = wild.AsDefinite()
+	assert.Equal(t, errInvalidAssetFilterType, err)
+}
+
+func Test_WildAsset_Limit(t *testing.T) {
+	parentId := NewAssetId(NewLocationParent())
+
+	assert.Equal(t, sc.NewOption[sc.U32](nil), NewWildAssetAll().Limit())
+	assert.Equal(t, sc.NewOption[sc.U32](sc.U32(3)), NewWildAssetAllCounted(3).Limit())
+	assert.Equal(t, sc.NewOption[sc.U32](sc.U32(4)), NewWildAssetAllOfCounted(parentId, WildFungibilityFungible, 4).Limit())
+}
+
+func Test_WildAsset_Matches(t *testing.T) {
+	parentId := NewAssetId(NewLocationParent())
+	siblingId := NewAssetId(NewLocationSibling(1000))
+
+	assert.True(t, NewWildAssetAll().Matches(targetAsset))
+	assert.True(t, NewWildAssetAllOf(parentId, WildFungibilityFungible).Matches(targetAsset))
+	assert.False(t, NewWildAssetAllOf(parentId, WildFungibilityNonFungible).Matches(targetAsset))
+	assert.False(t, NewWildAssetAllOfCounted(siblingId, WildFungibilityFungible, 1).Matches(targetAsset))
+}
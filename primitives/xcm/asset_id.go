@@ -0,0 +1,71 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// assetIdConcreteV3 is the XCM v3 variant of an asset, identified by its location.
+	assetIdConcreteV3 sc.U8 = iota
+	// assetIdAbstractV3 is the XCM v3 variant of an asset, identified by an abstract 32 byte name.
+	assetIdAbstractV3
+)
+
+// AssetId identifies an asset by its location. In XCM v3 it is the `Concrete` variant of the asset id,
+// while `Abstract` ids are not supported, as they cannot be converted to later versions.
+type AssetId struct {
+	Location Location
+}
+
+func NewAssetId(location Location) AssetId {
+	return AssetId{Location: location}
+}
+
+func (id AssetId) Encode(buffer *bytes.Buffer) error {
+	return id.encodeVersioned(buffer, CurrentVersion)
+}
+
+func (id AssetId) encodeVersioned(buffer *bytes.Buffer, version sc.U8) error {
+	if version == VersionV3 {
+		if err := assetIdConcreteV3.Encode(buffer); err != nil {
+			return err
+		}
+	}
+	return id.Location.Encode(buffer)
+}
+
+func DecodeAssetId(buffer *bytes.Buffer) (AssetId, error) {
+	return decodeAssetIdVersioned(buffer, CurrentVersion)
+}
+
+func decodeAssetIdVersioned(buffer *bytes.Buffer, version sc.U8) (AssetId, error) {
+	if version == VersionV3 {
+		b, err := sc.DecodeU8(buffer)
+		if err != nil {
+			return AssetId{}, err
+		}
+		switch b {
+		case assetIdConcreteV3:
+		case assetIdAbstractV3:
+			return AssetId{}, errAbstractAssetId
+		default:
+			return AssetId{}, errInvalidAssetIdType
+		}
+	}
+
+	location, err := DecodeLocation(buffer)
+	if err != nil {
+		return AssetId{}, err
+	}
+	return NewAssetId(location), nil
+}
+
+func (id AssetId) Bytes() []byte {
+	return sc.EncodedBytes(id)
+}
+
+func (id AssetId) Equal(other AssetId) bool {
+	return id.Location.Equal(other.Location)
+}
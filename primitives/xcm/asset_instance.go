@@ -0,0 +1,79 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// AssetInstanceUndefined is the only instance of the asset class.
+	AssetInstanceUndefined sc.U8 = iota
+	// AssetInstanceIndex is an instance identified by an index.
+	AssetInstanceIndex
+	// AssetInstanceArray4 is an instance identified by a 4 byte value.
+	AssetInstanceArray4
+	// AssetInstanceArray8 is an instance identified by an 8 byte value.
+	AssetInstanceArray8
+	// AssetInstanceArray16 is an instance identified by a 16 byte value.
+	AssetInstanceArray16
+	// AssetInstanceArray32 is an instance identified by a 32 byte value.
+	AssetInstanceArray32
+)
+
+// AssetInstance identifies a non-fungible instance of an asset class.
+type AssetInstance struct {
+	sc.VaryingData
+}
+
+func NewAssetInstanceUndefined() AssetInstance {
+	return AssetInstance{sc.NewVaryingData(AssetInstanceUndefined)}
+}
+
+func NewAssetInstanceIndex(index sc.U128) AssetInstance {
+	return AssetInstance{sc.NewVaryingData(AssetInstanceIndex, sc.ToCompact(index))}
+}
+
+func NewAssetInstanceArray4(value sc.FixedSequence[sc.U8]) AssetInstance {
+	return AssetInstance{sc.NewVaryingData(AssetInstanceArray4, value)}
+}
+
+func NewAssetInstanceArray8(value sc.FixedSequence[sc.U8]) AssetInstance {
+	return AssetInstance{sc.NewVaryingData(AssetInstanceArray8, value)}
+}
+
+func NewAssetInstanceArray16(value sc.FixedSequence[sc.U8]) AssetInstance {
+	return AssetInstance{sc.NewVaryingData(AssetInstanceArray16, value)}
+}
+
+func NewAssetInstanceArray32(value sc.FixedSequence[sc.U8]) AssetInstance {
+	return AssetInstance{sc.NewVaryingData(AssetInstanceArray32, value)}
+}
+
+func DecodeAssetInstance(buffer *bytes.Buffer) (AssetInstance, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return AssetInstance{}, err
+	}
+
+	switch b {
+	case AssetInstanceUndefined:
+		return NewAssetInstanceUndefined(), nil
+	case AssetInstanceIndex:
+		index, err := decodeCompactU128(buffer)
+		if err != nil {
+			return AssetInstance{}, err
+		}
+		return NewAssetInstanceIndex(index), nil
+	case AssetInstanceArray4, AssetInstanceArray8, AssetInstanceArray16, AssetInstanceArray32:
+		// The variants hold arrays of 4, 8, 16 and 32 bytes respectively.
+		size := 4 << (b - AssetInstanceArray4)
+		value, err := sc.DecodeFixedSequence[sc.U8](int(size), buffer)
+		if err != nil {
+			return AssetInstance{}, err
+		}
+		return AssetInstance{sc.NewVaryingData(b, value)}, nil
+	default:
+		return AssetInstance{}, errInvalidAssetInstanceType
+	}
+}
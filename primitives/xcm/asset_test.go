@@ -0,0 +1,108 @@
+package xcm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	targetAsset = NewAssetFungible(NewAssetId(NewLocationParent()), sc.NewU128(100))
+
+	expectedBytesAssetV4, _ = hex.DecodeString("0100009101")
+	expectedBytesAssetV3, _ = hex.DecodeString("000100009101")
+)
+
+func Test_Asset_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := targetAsset.Encode(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedBytesAssetV4, buffer.Bytes())
+	assert.Equal(t, expectedBytesAssetV4, targetAsset.Bytes())
+}
+
+func Test_Asset_EncodeV3(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := targetAsset.encodeVersioned(buffer, VersionV3)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedBytesAssetV3, buffer.Bytes())
+}
+
+func Test_DecodeAsset(t *testing.T) {
+	result, err := DecodeAsset(bytes.NewBuffer(expectedBytesAssetV4))
+
+	assert.NoError(t, err)
+	assert.Equal(t, targetAsset, result)
+}
+
+func Test_DecodeAsset_V3(t *testing.T) {
+	result, err := decodeAssetVersioned(bytes.NewBuffer(expectedBytesAssetV3), VersionV3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, targetAsset, result)
+}
+
+func Test_DecodeAsset_V3Abstract(t *testing.T) {
+	input := append([]byte{1}, bytes.Repeat([]byte{0}, 32)...)
+
+	_, err := decodeAssetVersioned(bytes.NewBuffer(input), VersionV3)
+
+	assert.Equal(t, errAbstractAssetId, err)
+}
+
+func Test_Assets_EncodeDecode(t *testing.T) {
+	nft := Asset{
+		Id:  NewAssetId(NewLocationSibling(1000)),
+		Fun: NewFungibilityNonFungible(NewAssetInstanceArray8(sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{7}, 8)))),
+	}
+	assets := Assets{targetAsset, nft}
+	expected, _ := hex.DecodeString("08" + "0100009101" + "010100a10f" + "0103" + "0707070707070707")
+
+	assert.Equal(t, expected, assets.Bytes())
+
+	result, err := DecodeAssets(bytes.NewBuffer(expected))
+	assert.NoError(t, err)
+	assert.Equal(t, assets, result)
+}
+
+func Test_DecodeAssetInstance(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		input    string
+		expected AssetInstance
+	}{
+		{name: "Undefined", input: "00", expected: NewAssetInstanceUndefined()},
+		{name: "Index", input: "0114", expected: NewAssetInstanceIndex(sc.NewU128(5))},
+		{name: "Array4", input: "0201020304", expected: NewAssetInstanceArray4(sc.FixedSequence[sc.U8]{1, 2, 3, 4})},
+		{name: "Array16", input: "04" + hex.EncodeToString(bytes.Repeat([]byte{9}, 16)), expected: NewAssetInstanceArray16(sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{9}, 16)))},
+		{name: "Array32", input: "05" + hex.EncodeToString(bytes.Repeat([]byte{9}, 32)), expected: NewAssetInstanceArray32(sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{9}, 32)))},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			input, _ := hex.DecodeString(tt.input)
+
+			result, err := DecodeAssetInstance(bytes.NewBuffer(input))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, input, result.Bytes())
+		})
+	}
+}
+
+func Test_Fungibility_AsFungible(t *testing.T) {
+	amount, err := NewFungibilityFungible(sc.NewU128(100)).AsFungible()
+	assert.NoError(t, err)
+	assert.Equal(t, sc.NewU128(100), amount)
+
+	_, err = NewFungibilityNonFungible(NewAssetInstanceUndefined()).AsFungible()
+	assert.Equal(t, errInvalidFungibilityType, err)
+}
+
+func Test_AssetId_Equal(t *testing.T) {
+	assert.True(t, NewAssetId(NewLocationParent()).Equal(NewAssetId(NewLocationParent())))
+	assert.False(t, NewAssetId(NewLocationParent()).Equal(NewAssetId(NewLocationHere())))
+}
@@ -0,0 +1,108 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// BodyIdUnit is the only body in its context.
+	BodyIdUnit sc.U8 = iota
+	// BodyIdMoniker is a body identified by a 4 byte name.
+	BodyIdMoniker
+	// BodyIdIndex is a body identified by an index.
+	BodyIdIndex
+	BodyIdExecutive
+	BodyIdTechnical
+	BodyIdLegislative
+	BodyIdJudicial
+	BodyIdDefense
+	BodyIdAdministration
+	BodyIdTreasury
+)
+
+// BodyId identifies a body of a plurality.
+type BodyId struct {
+	sc.VaryingData
+}
+
+func NewBodyIdUnit() BodyId {
+	return BodyId{sc.NewVaryingData(BodyIdUnit)}
+}
+
+func NewBodyIdMoniker(name sc.FixedSequence[sc.U8]) BodyId {
+	return BodyId{sc.NewVaryingData(BodyIdMoniker, name)}
+}
+
+func NewBodyIdIndex(index sc.U32) BodyId {
+	return BodyId{sc.NewVaryingData(BodyIdIndex, sc.ToCompact(index))}
+}
+
+func NewBodyIdExecutive() BodyId {
+	return BodyId{sc.NewVaryingData(BodyIdExecutive)}
+}
+
+func NewBodyIdTechnical() BodyId {
+	return BodyId{sc.NewVaryingData(BodyIdTechnical)}
+}
+
+func NewBodyIdLegislative() BodyId {
+	return BodyId{sc.NewVaryingData(BodyIdLegislative)}
+}
+
+func NewBodyIdJudicial() BodyId {
+	return BodyId{sc.NewVaryingData(BodyIdJudicial)}
+}
+
+func NewBodyIdDefense() BodyId {
+	return BodyId{sc.NewVaryingData(BodyIdDefense)}
+}
+
+func NewBodyIdAdministration() BodyId {
+	return BodyId{sc.NewVaryingData(BodyIdAdministration)}
+}
+
+func NewBodyIdTreasury() BodyId {
+	return BodyId{sc.NewVaryingData(BodyIdTreasury)}
+}
+
+func DecodeBodyId(buffer *bytes.Buffer) (BodyId, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return BodyId{}, err
+	}
+
+	switch b {
+	case BodyIdUnit:
+		return NewBodyIdUnit(), nil
+	case BodyIdMoniker:
+		name, err := sc.DecodeFixedSequence[sc.U8](4, buffer)
+		if err != nil {
+			return BodyId{}, err
+		}
+		return NewBodyIdMoniker(name), nil
+	case BodyIdIndex:
+		index, err := decodeCompactU32(buffer)
+		if err != nil {
+			return BodyId{}, err
+		}
+		return NewBodyIdIndex(index), nil
+	case BodyIdExecutive:
+		return NewBodyIdExecutive(), nil
+	case BodyIdTechnical:
+		return NewBodyIdTechnical(), nil
+	case BodyIdLegislative:
+		return NewBodyIdLegislative(), nil
+	case BodyIdJudicial:
+		return NewBodyIdJudicial(), nil
+	case BodyIdDefense:
+		return NewBodyIdDefense(), nil
+	case BodyIdAdministration:
+		return NewBodyIdAdministration(), nil
+	case BodyIdTreasury:
+		return NewBodyIdTreasury(), nil
+	default:
+		return BodyId{}, errInvalidBodyIdType
+	}
+}
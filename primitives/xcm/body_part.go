@@ -0,0 +1,83 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// BodyPartVoice is the body's declaration, under whatever means it decides.
+	BodyPartVoice sc.U8 = iota
+	// BodyPartMembers is a given number of members of the body.
+	BodyPartMembers
+	// BodyPartFraction is a given number of members of the body, out of some larger caucus.
+	BodyPartFraction
+	// BodyPartAtLeastProportion is no less than the given proportion of members of the body.
+	BodyPartAtLeastProportion
+	// BodyPartMoreThanProportion is more than the given proportion of members of the body.
+	BodyPartMoreThanProportion
+)
+
+// BodyPart is a part of a plurality.
+type BodyPart struct {
+	sc.VaryingData
+}
+
+func NewBodyPartVoice() BodyPart {
+	return BodyPart{sc.NewVaryingData(BodyPartVoice)}
+}
+
+func NewBodyPartMembers(count sc.U32) BodyPart {
+	return BodyPart{sc.NewVaryingData(BodyPartMembers, sc.ToCompact(count))}
+}
+
+func NewBodyPartFraction(nom sc.U32, denom sc.U32) BodyPart {
+	return BodyPart{sc.NewVaryingData(BodyPartFraction, sc.ToCompact(nom), sc.ToCompact(denom))}
+}
+
+func NewBodyPartAtLeastProportion(nom sc.U32, denom sc.U32) BodyPart {
+	return BodyPart{sc.NewVaryingData(BodyPartAtLeastProportion, sc.ToCompact(nom), sc.ToCompact(denom))}
+}
+
+func NewBodyPartMoreThanProportion(nom sc.U32, denom sc.U32) BodyPart {
+	return BodyPart{sc.NewVaryingData(BodyPartMoreThanProportion, sc.ToCompact(nom), sc.ToCompact(denom))}
+}
+
+func DecodeBodyPart(buffer *bytes.Buffer) (BodyPart, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return BodyPart{}, err
+	}
+
+	switch b {
+	case BodyPartVoice:
+		return NewBodyPartVoice(), nil
+	case BodyPartMembers:
+		count, err := decodeCompactU32(buffer)
+		if err != nil {
+			return BodyPart{}, err
+		}
+		return NewBodyPartMembers(count), nil
+	case BodyPartFraction, BodyPartAtLeastProportion, BodyPartMoreThanProportion:
+		nom, err := decodeCompactU32(buffer)
+		if err != nil {
+			return BodyPart{}, err
+		}
+		denom, err := decodeCompactU32(buffer)
+		if err != nil {
+			return BodyPart{}, err
+		}
+		return BodyPart{sc.NewVaryingData(b, sc.ToCompact(nom), sc.ToCompact(denom))}, nil
+	default:
+		return BodyPart{}, errInvalidBodyPartType
+	}
+}
+
+func decodeCompactU32(buffer *bytes.Buffer) (sc.U32, error) {
+	compact, err := sc.DecodeCompact[sc.U32](buffer)
+	if err != nil {
+		return 0, err
+	}
+	return sc.U32(compact.ToBigInt().Uint64()), nil
+}
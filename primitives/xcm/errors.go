@@ -0,0 +1,28 @@
+package xcm
+
+import "errors"
+
+var (
+	errInvalidNetworkIdType       = errors.New("invalid xcm.NetworkId type")
+	errInvalidBodyIdType          = errors.New("invalid xcm.BodyId type")
+	errInvalidBodyPartType        = errors.New("invalid xcm.BodyPart type")
+	errInvalidJunctionType        = errors.New("invalid xcm.Junction type")
+	errInvalidJunctionsType       = errors.New("invalid xcm.Junctions type")
+	errInvalidAssetIdType         = errors.New("invalid xcm.AssetId type")
+	errAbstractAssetId            = errors.New("abstract xcm.AssetId is not supported")
+	errInvalidAssetInstanceType   = errors.New("invalid xcm.AssetInstance type")
+	errInvalidFungibilityType     = errors.New("invalid xcm.Fungibility type")
+	errInvalidWildFungibilityType = errors.New("invalid xcm.WildFungibility type")
+	errInvalidWildAssetType       = errors.New("invalid xcm.WildAsset type")
+	errInvalidAssetFilterType     = errors.New("invalid xcm.AssetFilter type")
+	errInvalidWeightLimitType     = errors.New("invalid xcm.WeightLimit type")
+	errInvalidOriginKindType      = errors.New("invalid xcm.OriginKind type")
+	errInvalidInstructionType     = errors.New("invalid xcm.Instruction type")
+	errUnsupportedInstruction     = errors.New("unsupported xcm.Instruction")
+	errInvalidVersionedXcmType    = errors.New("invalid xcm.VersionedXcm type")
+	errUnsupportedVersion         = errors.New("unsupported XCM version")
+	errInvalidXcmErrorType        = errors.New("invalid xcm.XcmError type")
+	errInvalidOutcomeType         = errors.New("invalid xcm.Outcome type")
+	errTooManyJunctions           = errors.New("too many junctions in xcm.Junctions")
+	errInvalidCompactU128         = errors.New("invalid compact U128")
+)
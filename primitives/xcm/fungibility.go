@@ -0,0 +1,66 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// FungibilityFungible is an amount of a fungible asset.
+	FungibilityFungible sc.U8 = iota
+	// FungibilityNonFungible is an instance of a non-fungible asset.
+	FungibilityNonFungible
+)
+
+// Fungibility is the amount of a fungible asset or the instance of a non-fungible asset.
+type Fungibility struct {
+	sc.VaryingData
+}
+
+func NewFungibilityFungible(amount sc.U128) Fungibility {
+	return Fungibility{sc.NewVaryingData(FungibilityFungible, sc.ToCompact(amount))}
+}
+
+func NewFungibilityNonFungible(instance AssetInstance) Fungibility {
+	return Fungibility{sc.NewVaryingData(FungibilityNonFungible, instance)}
+}
+
+func DecodeFungibility(buffer *bytes.Buffer) (Fungibility, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return Fungibility{}, err
+	}
+
+	switch b {
+	case FungibilityFungible:
+		amount, err := decodeCompactU128(buffer)
+		if err != nil {
+			return Fungibility{}, err
+		}
+		return NewFungibilityFungible(amount), nil
+	case FungibilityNonFungible:
+		instance, err := DecodeAssetInstance(buffer)
+		if err != nil {
+			return Fungibility{}, err
+		}
+		return NewFungibilityNonFungible(instance), nil
+	default:
+		return Fungibility{}, errInvalidFungibilityType
+	}
+}
+
+func (f Fungibility) IsFungible() bool {
+	return f.VaryingData[0] == FungibilityFungible
+}
+
+func (f Fungibility) AsFungible() (sc.U128, error) {
+	if !f.IsFungible() {
+		return sc.U128{}, errInvalidFungibilityType
+	}
+	amount, ok := f.VaryingData[1].(sc.Compact).Number.(sc.U128)
+	if !ok {
+		return sc.U128{}, errInvalidCompactU128
+	}
+	return amount, nil
+}
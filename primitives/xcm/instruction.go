@@ -0,0 +1,177 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// The supported instructions, indexed by their variant in XCM v3 and v4.
+const (
+	// InstructionWithdrawAsset withdraws assets from the origin into the holding register.
+	InstructionWithdrawAsset sc.U8 = 0
+	// InstructionReceiveTeleportedAsset places assets, which are teleported from the origin, into the holding register.
+	InstructionReceiveTeleportedAsset sc.U8 = 2
+	// InstructionTransact dispatches an encoded call with an origin of the given kind.
+	InstructionTransact sc.U8 = 6
+	// InstructionClearOrigin clears the origin of the message.
+	InstructionClearOrigin sc.U8 = 10
+	// InstructionDepositAsset deposits assets from the holding register into the beneficiary.
+	InstructionDepositAsset sc.U8 = 13
+	// InstructionBuyExecution pays for the execution of the message with assets from the holding register.
+	InstructionBuyExecution sc.U8 = 19
+	// InstructionSetTopic sets the topic of the message.
+	InstructionSetTopic sc.U8 = 44
+	// InstructionClearTopic clears the topic of the message.
+	InstructionClearTopic sc.U8 = 45
+)
+
+// Instruction is a single instruction of an XCM program.
+type Instruction struct {
+	sc.VaryingData
+}
+
+func NewInstructionWithdrawAsset(assets Assets) Instruction {
+	return Instruction{sc.NewVaryingData(InstructionWithdrawAsset, assets)}
+}
+
+func NewInstructionReceiveTeleportedAsset(assets Assets) Instruction {
+	return Instruction{sc.NewVaryingData(InstructionReceiveTeleportedAsset, assets)}
+}
+
+// NewInstructionTransact dispatches the encoded `call` with an origin of `originKind`. The call must not
+// require more than `requireWeightAtMost`.
+func NewInstructionTransact(originKind sc.U8, requireWeightAtMost primitives.Weight, call sc.Sequence[sc.U8]) Instruction {
+	return Instruction{sc.NewVaryingData(InstructionTransact, originKind, requireWeightAtMost, call)}
+}
+
+func NewInstructionClearOrigin() Instruction {
+	return Instruction{sc.NewVaryingData(InstructionClearOrigin)}
+}
+
+func NewInstructionDepositAsset(assets AssetFilter, beneficiary Location) Instruction {
+	return Instruction{sc.NewVaryingData(InstructionDepositAsset, assets, beneficiary)}
+}
+
+func NewInstructionBuyExecution(fees Asset, weightLimit WeightLimit) Instruction {
+	return Instruction{sc.NewVaryingData(InstructionBuyExecution, fees, weightLimit)}
+}
+
+func NewInstructionSetTopic(topic sc.FixedSequence[sc.U8]) Instruction {
+	return Instruction{sc.NewVaryingData(InstructionSetTopic, topic)}
+}
+
+func NewInstructionClearTopic() Instruction {
+	return Instruction{sc.NewVaryingData(InstructionClearTopic)}
+}
+
+func (i Instruction) Encode(buffer *bytes.Buffer) error {
+	return i.encodeVersioned(buffer, CurrentVersion)
+}
+
+func (i Instruction) encodeVersioned(buffer *bytes.Buffer, version sc.U8) error {
+	variant := i.VaryingData[0].(sc.U8)
+	if err := variant.Encode(buffer); err != nil {
+		return err
+	}
+
+	switch variant {
+	case InstructionWithdrawAsset, InstructionReceiveTeleportedAsset:
+		return i.VaryingData[1].(Assets).encodeVersioned(buffer, version)
+	case InstructionTransact:
+		return sc.EncodeEach(buffer,
+			i.VaryingData[1],
+			i.VaryingData[2],
+			i.VaryingData[3],
+		)
+	case InstructionClearOrigin, InstructionClearTopic:
+		return nil
+	case InstructionDepositAsset:
+		if err := i.VaryingData[1].(AssetFilter).encodeVersioned(buffer, version); err != nil {
+			return err
+		}
+		return i.VaryingData[2].Encode(buffer)
+	case InstructionBuyExecution:
+		if err := i.VaryingData[1].(Asset).encodeVersioned(buffer, version); err != nil {
+			return err
+		}
+		return i.VaryingData[2].Encode(buffer)
+	case InstructionSetTopic:
+		return i.VaryingData[1].Encode(buffer)
+	default:
+		return errUnsupportedInstruction
+	}
+}
+
+// DecodeInstruction decodes an instruction of the current version. Instructions, which are not supported by
+// the executor, cannot be decoded.
+func DecodeInstruction(buffer *bytes.Buffer) (Instruction, error) {
+	return decodeInstructionVersioned(buffer, CurrentVersion)
+}
+
+func decodeInstructionVersioned(buffer *bytes.Buffer, version sc.U8) (Instruction, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return Instruction{}, err
+	}
+
+	switch b {
+	case InstructionWithdrawAsset, InstructionReceiveTeleportedAsset:
+		assets, err := decodeAssetsVersioned(buffer, version)
+		if err != nil {
+			return Instruction{}, err
+		}
+		return Instruction{sc.NewVaryingData(b, assets)}, nil
+	case InstructionTransact:
+		originKind, err := DecodeOriginKind(buffer)
+		if err != nil {
+			return Instruction{}, err
+		}
+		requireWeightAtMost, err := primitives.DecodeWeight(buffer)
+		if err != nil {
+			return Instruction{}, err
+		}
+		call, err := sc.DecodeSequence[sc.U8](buffer)
+		if err != nil {
+			return Instruction{}, err
+		}
+		return NewInstructionTransact(originKind, requireWeightAtMost, call), nil
+	case InstructionClearOrigin:
+		return NewInstructionClearOrigin(), nil
+	case InstructionDepositAsset:
+		assets, err := decodeAssetFilterVersioned(buffer, version)
+		if err != nil {
+			return Instruction{}, err
+		}
+		beneficiary, err := DecodeLocation(buffer)
+		if err != nil {
+			return Instruction{}, err
+		}
+		return NewInstructionDepositAsset(assets, beneficiary), nil
+	case InstructionBuyExecution:
+		fees, err := decodeAssetVersioned(buffer, version)
+		if err != nil {
+			return Instruction{}, err
+		}
+		weightLimit, err := DecodeWeightLimit(buffer)
+		if err != nil {
+			return Instruction{}, err
+		}
+		return NewInstructionBuyExecution(fees, weightLimit), nil
+	case InstructionSetTopic:
+		topic, err := sc.DecodeFixedSequence[sc.U8](32, buffer)
+		if err != nil {
+			return Instruction{}, err
+		}
+		return NewInstructionSetTopic(topic), nil
+	case InstructionClearTopic:
+		return NewInstructionClearTopic(), nil
+	default:
+		return Instruction{}, errUnsupportedInstruction
+	}
+}
+
+func (i Instruction) Bytes() []byte {
+	return sc.EncodedBytes(i)
+}
@@ -0,0 +1,199 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// JunctionParachain is an indexed parachain of the relay chain.
+	JunctionParachain sc.U8 = iota
+	// JunctionAccountId32 is a 32 byte account, usually a Substrate account, on an optional network.
+	JunctionAccountId32
+	// JunctionAccountIndex64 is an 8 byte account index, on an optional network.
+	JunctionAccountIndex64
+	// JunctionAccountKey20 is a 20 byte account, usually an Ethereum account, on an optional network.
+	JunctionAccountKey20
+	// JunctionPalletInstance is an instance of a pallet, identified by its index in the runtime.
+	JunctionPalletInstance
+	// JunctionGeneralIndex is a non-descript index within the context location.
+	JunctionGeneralIndex
+	// JunctionGeneralKey is a non-descript key of up to 32 bytes within the context location.
+	JunctionGeneralKey
+	// JunctionOnlyChild is the unambiguous child of the context location.
+	JunctionOnlyChild
+	// JunctionPlurality is a pluralistic body, existing within consensus.
+	JunctionPlurality
+	// JunctionGlobalConsensus is a global network, which is capable of externalizing its own consensus.
+	JunctionGlobalConsensus
+)
+
+// Junction is a single item in a path, which describes the relative location of a consensus system.
+type Junction struct {
+	sc.VaryingData
+}
+
+func NewJunctionParachain(id sc.U32) Junction {
+	return Junction{sc.NewVaryingData(JunctionParachain, sc.ToCompact(id))}
+}
+
+func NewJunctionAccountId32(network sc.Option[NetworkId], id sc.FixedSequence[sc.U8]) Junction {
+	return Junction{sc.NewVaryingData(JunctionAccountId32, network, id)}
+}
+
+func NewJunctionAccountIndex64(network sc.Option[NetworkId], index sc.U64) Junction {
+	return Junction{sc.NewVaryingData(JunctionAccountIndex64, network, sc.ToCompact(index))}
+}
+
+func NewJunctionAccountKey20(network sc.Option[NetworkId], key sc.FixedSequence[sc.U8]) Junction {
+	return Junction{sc.NewVaryingData(JunctionAccountKey20, network, key)}
+}
+
+func NewJunctionPalletInstance(index sc.U8) Junction {
+	return Junction{sc.NewVaryingData(JunctionPalletInstance, index)}
+}
+
+func NewJunctionGeneralIndex(index sc.U128) Junction {
+	return Junction{sc.NewVaryingData(JunctionGeneralIndex, sc.ToCompact(index))}
+}
+
+// NewJunctionGeneralKey creates a general key of `length` bytes, stored in the beginning of the 32 byte `data`.
+func NewJunctionGeneralKey(length sc.U8, data sc.FixedSequence[sc.U8]) Junction {
+	return Junction{sc.NewVaryingData(JunctionGeneralKey, length, data)}
+}
+
+func NewJunctionOnlyChild() Junction {
+	return Junction{sc.NewVaryingData(JunctionOnlyChild)}
+}
+
+func NewJunctionPlurality(id BodyId, part BodyPart) Junction {
+	return Junction{sc.NewVaryingData(JunctionPlurality, id, part)}
+}
+
+func NewJunctionGlobalConsensus(network NetworkId) Junction {
+	return Junction{sc.NewVaryingData(JunctionGlobalConsensus, network)}
+}
+
+func DecodeJunction(buffer *bytes.Buffer) (Junction, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return Junction{}, err
+	}
+
+	switch b {
+	case JunctionParachain:
+		id, err := decodeCompactU32(buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		return NewJunctionParachain(id), nil
+	case JunctionAccountId32:
+		network, err := decodeOptionNetworkId(buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		id, err := sc.DecodeFixedSequence[sc.U8](32, buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		return NewJunctionAccountId32(network, id), nil
+	case JunctionAccountIndex64:
+		network, err := decodeOptionNetworkId(buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		index, err := sc.DecodeCompact[sc.U64](buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		return NewJunctionAccountIndex64(network, sc.U64(index.ToBigInt().Uint64())), nil
+	case JunctionAccountKey20:
+		network, err := decodeOptionNetworkId(buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		key, err := sc.DecodeFixedSequence[sc.U8](20, buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		return NewJunctionAccountKey20(network, key), nil
+	case JunctionPalletInstance:
+		index, err := sc.DecodeU8(buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		return NewJunctionPalletInstance(index), nil
+	case JunctionGeneralIndex:
+		index, err := decodeCompactU128(buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		return NewJunctionGeneralIndex(index), nil
+	case JunctionGeneralKey:
+		length, err := sc.DecodeU8(buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		data, err := sc.DecodeFixedSequence[sc.U8](32, buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		return NewJunctionGeneralKey(length, data), nil
+	case JunctionOnlyChild:
+		return NewJunctionOnlyChild(), nil
+	case JunctionPlurality:
+		id, err := DecodeBodyId(buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		part, err := DecodeBodyPart(buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		return NewJunctionPlurality(id, part), nil
+	case JunctionGlobalConsensus:
+		network, err := DecodeNetworkId(buffer)
+		if err != nil {
+			return Junction{}, err
+		}
+		return NewJunctionGlobalConsensus(network), nil
+	default:
+		return Junction{}, errInvalidJunctionType
+	}
+}
+
+func (j Junction) IsParachain() bool {
+	return j.VaryingData[0] == JunctionParachain
+}
+
+func (j Junction) AsParachain() (sc.U32, error) {
+	if !j.IsParachain() {
+		return 0, errInvalidJunctionType
+	}
+	return sc.U32(j.VaryingData[1].(sc.Compact).ToBigInt().Uint64()), nil
+}
+
+func (j Junction) IsAccountId32() bool {
+	return j.VaryingData[0] == JunctionAccountId32
+}
+
+// AsAccountId32 returns the network and the 32 byte id of the account.
+func (j Junction) AsAccountId32() (sc.Option[NetworkId], sc.FixedSequence[sc.U8], error) {
+	if !j.IsAccountId32() {
+		return sc.Option[NetworkId]{}, nil, errInvalidJunctionType
+	}
+	return j.VaryingData[1].(sc.Option[NetworkId]), j.VaryingData[2].(sc.FixedSequence[sc.U8]), nil
+}
+
+func decodeCompactU128(buffer *bytes.Buffer) (sc.U128, error) {
+	compact, err := sc.DecodeCompact[sc.U128](buffer)
+	if err != nil {
+		return sc.U128{}, err
+	}
+	value, ok := compact.Number.(sc.U128)
+	if !ok {
+		return sc.U128{}, errInvalidCompactU128
+	}
+	return value, nil
+}
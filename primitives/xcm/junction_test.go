@@ -0,0 +1,68 @@
+package xcm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Junction_EncodeDecode(t *testing.T) {
+	key20 := sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{2}, 20))
+	genesis := sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{3}, 32))
+
+	for _, tt := range []struct {
+		name     string
+		junction Junction
+		expected string
+	}{
+		{name: "Parachain", junction: NewJunctionParachain(1000), expected: "00a10f"},
+		{name: "AccountId32", junction: NewJunctionAccountId32(sc.NewOption[NetworkId](NewNetworkIdPolkadot()), accountIdBytes), expected: "010102" + hex.EncodeToString(bytes.Repeat([]byte{1}, 32))},
+		{name: "AccountIndex64", junction: NewJunctionAccountIndex64(sc.NewOption[NetworkId](nil), 1), expected: "020004"},
+		{name: "AccountKey20", junction: NewJunctionAccountKey20(sc.NewOption[NetworkId](NewNetworkIdEthereum(1)), key20), expected: "03010704" + hex.EncodeToString(bytes.Repeat([]byte{2}, 20))},
+		{name: "PalletInstance", junction: NewJunctionPalletInstance(50), expected: "0432"},
+		{name: "GeneralIndex", junction: NewJunctionGeneralIndex(sc.NewU128(1984)), expected: "05011f"},
+		{name: "GeneralKey", junction: NewJunctionGeneralKey(2, genesis), expected: "0602" + hex.EncodeToString(bytes.Repeat([]byte{3}, 32))},
+		{name: "OnlyChild", junction: NewJunctionOnlyChild(), expected: "07"},
+		{name: "Plurality", junction: NewJunctionPlurality(NewBodyIdIndex(1), NewBodyPartFraction(1, 2)), expected: "080204020408"},
+		{name: "GlobalConsensus", junction: NewJunctionGlobalConsensus(NewNetworkIdByGenesis(genesis)), expected: "0900" + hex.EncodeToString(bytes.Repeat([]byte{3}, 32))},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, err := hex.DecodeString(tt.expected)
+			assert.NoError(t, err)
+
+			assert.Equal(t, expected, tt.junction.Bytes())
+
+			result, err := DecodeJunction(bytes.NewBuffer(expected))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.junction, result)
+		})
+	}
+}
+
+func Test_DecodeJunction_Invalid(t *testing.T) {
+	_, err := DecodeJunction(bytes.NewBuffer([]byte{10}))
+
+	assert.Equal(t, errInvalidJunctionType, err)
+}
+
+func Test_Junction_AsParachain(t *testing.T) {
+	id, err := NewJunctionParachain(1000).AsParachain()
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U32(1000), id)
+
+	_, err = NewJunctionOnlyChild().AsParachain()
+	assert.Equal(t, errInvalidJunctionType, err)
+}
+
+func Test_Junction_AsAccountId32(t *testing.T) {
+	network, id, err := NewJunctionAccountId32(sc.NewOption[NetworkId](nil), accountIdBytes).AsAccountId32()
+	assert.NoError(t, err)
+	assert.False(t, bool(network.HasValue))
+	assert.Equal(t, accountIdBytes, id)
+
+	_, _, err = NewJunctionParachain(1000).AsAccountId32()
+	assert.Equal(t, errInvalidJunctionType, err)
+}
@@ -0,0 +1,63 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// MaxJunctions is the maximum number of junctions in an interior location.
+const MaxJunctions = 8
+
+// Junctions is the interior path of a location. It is encoded as the variant `Here` when it is empty,
+// or `X1` to `X8` followed by the junctions.
+type Junctions sc.Sequence[Junction]
+
+// NewJunctions returns the path of `junctions`, which must be at most MaxJunctions.
+func NewJunctions(junctions ...Junction) (Junctions, error) {
+	if len(junctions) > MaxJunctions {
+		return nil, errTooManyJunctions
+	}
+	return junctions, nil
+}
+
+func (j Junctions) Encode(buffer *bytes.Buffer) error {
+	if len(j) > MaxJunctions {
+		return errTooManyJunctions
+	}
+
+	if err := sc.U8(len(j)).Encode(buffer); err != nil {
+		return err
+	}
+	for _, junction := range j {
+		if err := junction.Encode(buffer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func DecodeJunctions(buffer *bytes.Buffer) (Junctions, error) {
+	length, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return nil, err
+	}
+	if length > MaxJunctions {
+		return nil, errInvalidJunctionsType
+	}
+
+	junctions := make(Junctions, 0, length)
+	for i := sc.U8(0); i < length; i++ {
+		junction, err := DecodeJunction(buffer)
+		if err != nil {
+			return nil, err
+		}
+		junctions = append(junctions, junction)
+	}
+
+	return junctions, nil
+}
+
+func (j Junctions) Bytes() []byte {
+	return sc.EncodedBytes(j)
+}
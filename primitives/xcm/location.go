@@ -0,0 +1,80 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// Location is a relative path to a consensus system. It ascends `Parents` levels and then descends the
+// `Interior` junctions.
+type Location struct {
+	Parents  sc.U8
+	Interior Junctions
+}
+
+// MultiLocation is the name of Location in XCM v3. Both versions share the same encoding.
+type MultiLocation = Location
+
+// NewLocationHere returns the location of the local consensus system.
+func NewLocationHere() Location {
+	return Location{Parents: 0, Interior: Junctions{}}
+}
+
+// NewLocationParent returns the location of the parent consensus system, which is the relay chain
+// of a parachain.
+func NewLocationParent() Location {
+	return Location{Parents: 1, Interior: Junctions{}}
+}
+
+// NewLocationSibling returns the location of parachain `paraId` from a sibling parachain.
+func NewLocationSibling(paraId sc.U32) Location {
+	return Location{Parents: 1, Interior: Junctions{NewJunctionParachain(paraId)}}
+}
+
+// NewLocationAccountId32 returns the location of a local 32 byte account without a network.
+func NewLocationAccountId32(id sc.FixedSequence[sc.U8]) Location {
+	return Location{Parents: 0, Interior: Junctions{NewJunctionAccountId32(sc.NewOption[NetworkId](nil), id)}}
+}
+
+func (l Location) Encode(buffer *bytes.Buffer) error {
+	return sc.EncodeEach(buffer,
+		l.Parents,
+		l.Interior,
+	)
+}
+
+func DecodeLocation(buffer *bytes.Buffer) (Location, error) {
+	parents, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return Location{}, err
+	}
+	interior, err := DecodeJunctions(buffer)
+	if err != nil {
+		return Location{}, err
+	}
+
+	return Location{
+		Parents:  parents,
+		Interior: interior,
+	}, nil
+}
+
+func (l Location) Bytes() []byte {
+	return sc.EncodedBytes(l)
+}
+
+// Equal returns whether `l` and `other` are the same path.
+func (l Location) Equal(other Location) bool {
+	return bytes.Equal(l.Bytes(), other.Bytes())
+}
+
+// IsHere returns whether `l` is the local consensus system.
+func (l Location) IsHere() bool {
+	return l.Parents == 0 && len(l.Interior) == 0
+}
+
+// IsParent returns whether `l` is the parent consensus system.
+func (l Location) IsParent() bool {
+	return l.Parents == 1 && len(l.Interior) == 0
+}
@@ -0,0 +1,81 @@
+package xcm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	accountIdBytes = sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{1}, 32))
+
+	expectedBytesLocationParent, _  = hex.DecodeString("0100")
+	expectedBytesLocationSibling, _ = hex.DecodeString("010100a10f")
+	expectedBytesLocationAccount, _ = hex.DecodeString("00010100" + hex.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+)
+
+func Test_Location_Encode(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		location Location
+		expected []byte
+	}{
+		{name: "Parent", location: NewLocationParent(), expected: expectedBytesLocationParent},
+		{name: "Sibling", location: NewLocationSibling(1000), expected: expectedBytesLocationSibling},
+		{name: "AccountId32", location: NewLocationAccountId32(accountIdBytes), expected: expectedBytesLocationAccount},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			err := tt.location.Encode(buffer)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, buffer.Bytes())
+			assert.Equal(t, tt.expected, tt.location.Bytes())
+		})
+	}
+}
+
+func Test_DecodeLocation(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		input    []byte
+		expected Location
+	}{
+		{name: "Parent", input: expectedBytesLocationParent, expected: NewLocationParent()},
+		{name: "Sibling", input: expectedBytesLocationSibling, expected: NewLocationSibling(1000)},
+		{name: "AccountId32", input: expectedBytesLocationAccount, expected: NewLocationAccountId32(accountIdBytes)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := DecodeLocation(bytes.NewBuffer(tt.input))
+			assert.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result))
+		})
+	}
+}
+
+func Test_DecodeLocation_TooManyJunctions(t *testing.T) {
+	_, err := DecodeLocation(bytes.NewBuffer([]byte{0, 9}))
+
+	assert.Equal(t, errInvalidJunctionsType, err)
+}
+
+func Test_NewJunctions_TooMany(t *testing.T) {
+	junctions := make([]Junction, MaxJunctions+1)
+	for i := range junctions {
+		junctions[i] = NewJunctionOnlyChild()
+	}
+
+	_, err := NewJunctions(junctions...)
+
+	assert.Equal(t, errTooManyJunctions, err)
+}
+
+func Test_Location_IsHere_IsParent(t *testing.T) {
+	assert.True(t, NewLocationHere().IsHere())
+	assert.False(t, NewLocationHere().IsParent())
+	assert.True(t, NewLocationParent().IsParent())
+	assert.False(t, NewLocationSibling(1000).IsParent())
+}
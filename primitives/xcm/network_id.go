@@ -0,0 +1,127 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// NetworkIdByGenesis is a network identified by the hash of its genesis block.
+	NetworkIdByGenesis sc.U8 = iota
+	// NetworkIdByFork is a network identified by the hash of a block after a fork.
+	NetworkIdByFork
+	NetworkIdPolkadot
+	NetworkIdKusama
+	NetworkIdWestend
+	NetworkIdRococo
+	NetworkIdWococo
+	// NetworkIdEthereum is an Ethereum network, identified by its chain id.
+	NetworkIdEthereum
+	NetworkIdBitcoinCore
+	NetworkIdBitcoinCash
+	NetworkIdPolkadotBulletin
+)
+
+// NetworkId is a global identifier of a consensus system.
+type NetworkId struct {
+	sc.VaryingData
+}
+
+func NewNetworkIdByGenesis(genesis sc.FixedSequence[sc.U8]) NetworkId {
+	return NetworkId{sc.NewVaryingData(NetworkIdByGenesis, genesis)}
+}
+
+func NewNetworkIdByFork(blockNumber sc.U64, blockHash sc.FixedSequence[sc.U8]) NetworkId {
+	return NetworkId{sc.NewVaryingData(NetworkIdByFork, blockNumber, blockHash)}
+}
+
+func NewNetworkIdPolkadot() NetworkId {
+	return NetworkId{sc.NewVaryingData(NetworkIdPolkadot)}
+}
+
+func NewNetworkIdKusama() NetworkId {
+	return NetworkId{sc.NewVaryingData(NetworkIdKusama)}
+}
+
+func NewNetworkIdWestend() NetworkId {
+	return NetworkId{sc.NewVaryingData(NetworkIdWestend)}
+}
+
+func NewNetworkIdRococo() NetworkId {
+	return NetworkId{sc.NewVaryingData(NetworkIdRococo)}
+}
+
+func NewNetworkIdWococo() NetworkId {
+	return NetworkId{sc.NewVaryingData(NetworkIdWococo)}
+}
+
+func NewNetworkIdEthereum(chainId sc.U64) NetworkId {
+	return NetworkId{sc.NewVaryingData(NetworkIdEthereum, sc.ToCompact(chainId))}
+}
+
+func NewNetworkIdBitcoinCore() NetworkId {
+	return NetworkId{sc.NewVaryingData(NetworkIdBitcoinCore)}
+}
+
+func NewNetworkIdBitcoinCash() NetworkId {
+	return NetworkId{sc.NewVaryingData(NetworkIdBitcoinCash)}
+}
+
+func NewNetworkIdPolkadotBulletin() NetworkId {
+	return NetworkId{sc.NewVaryingData(NetworkIdPolkadotBulletin)}
+}
+
+func DecodeNetworkId(buffer *bytes.Buffer) (NetworkId, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return NetworkId{}, err
+	}
+
+	switch b {
+	case NetworkIdByGenesis:
+		genesis, err := sc.DecodeFixedSequence[sc.U8](32, buffer)
+		if err != nil {
+			return NetworkId{}, err
+		}
+		return NewNetworkIdByGenesis(genesis), nil
+	case NetworkIdByFork:
+		blockNumber, err := sc.DecodeU64(buffer)
+		if err != nil {
+			return NetworkId{}, err
+		}
+		blockHash, err := sc.DecodeFixedSequence[sc.U8](32, buffer)
+		if err != nil {
+			return NetworkId{}, err
+		}
+		return NewNetworkIdByFork(blockNumber, blockHash), nil
+	case NetworkIdPolkadot:
+		return NewNetworkIdPolkadot(), nil
+	case NetworkIdKusama:
+		return NewNetworkIdKusama(), nil
+	case NetworkIdWestend:
+		return NewNetworkIdWestend(), nil
+	case NetworkIdRococo:
+		return NewNetworkIdRococo(), nil
+	case NetworkIdWococo:
+		return NewNetworkIdWococo(), nil
+	case NetworkIdEthereum:
+		chainId, err := sc.DecodeCompact[sc.U64](buffer)
+		if err != nil {
+			return NetworkId{}, err
+		}
+		return NewNetworkIdEthereum(sc.U64(chainId.ToBigInt().Uint64())), nil
+	case NetworkIdBitcoinCore:
+		return NewNetworkIdBitcoinCore(), nil
+	case NetworkIdBitcoinCash:
+		return NewNetworkIdBitcoinCash(), nil
+	case NetworkIdPolkadotBulletin:
+		return NewNetworkIdPolkadotBulletin(), nil
+	default:
+		return NetworkId{}, errInvalidNetworkIdType
+	}
+}
+
+func decodeOptionNetworkId(buffer *bytes.Buffer) (sc.Option[NetworkId], error) {
+	return sc.DecodeOptionWith(buffer, DecodeNetworkId)
+}
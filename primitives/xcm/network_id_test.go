@@ -0,0 +1,63 @@
+package xcm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NetworkId_EncodeDecode(t *testing.T) {
+	hash := sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{3}, 32))
+
+	for _, tt := range []struct {
+		name      string
+		networkId NetworkId
+		expected  string
+	}{
+		{name: "ByFork", networkId: NewNetworkIdByFork(2, hash), expected: "010200000000000000" + hex.EncodeToString(bytes.Repeat([]byte{3}, 32))},
+		{name: "Kusama", networkId: NewNetworkIdKusama(), expected: "03"},
+		{name: "Westend", networkId: NewNetworkIdWestend(), expected: "04"},
+		{name: "Rococo", networkId: NewNetworkIdRococo(), expected: "05"},
+		{name: "Wococo", networkId: NewNetworkIdWococo(), expected: "06"},
+		{name: "BitcoinCore", networkId: NewNetworkIdBitcoinCore(), expected: "08"},
+		{name: "BitcoinCash", networkId: NewNetworkIdBitcoinCash(), expected: "09"},
+		{name: "PolkadotBulletin", networkId: NewNetworkIdPolkadotBulletin(), expected: "0a"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, _ := hex.DecodeString(tt.expected)
+
+			assert.Equal(t, expected, tt.networkId.Bytes())
+
+			result, err := DecodeNetworkId(bytes.NewBuffer(expected))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.networkId, result)
+		})
+	}
+}
+
+func Test_DecodeNetworkId_Invalid(t *testing.T) {
+	_, err := DecodeNetworkId(bytes.NewBuffer([]byte{11}))
+
+	assert.Equal(t, errInvalidNetworkIdType, err)
+}
+
+func Test_BodyId_BodyPart_Decode(t *testing.T) {
+	for _, input := range [][]byte{{0}, {1, 1, 2, 3, 4}, {3}, {4}, {5}, {6}, {7}, {8}, {9}} {
+		result, err := DecodeBodyId(bytes.NewBuffer(input))
+		assert.NoError(t, err)
+		assert.Equal(t, input, result.Bytes())
+	}
+	for _, input := range [][]byte{{0}, {1, 8}, {3, 4, 8}, {4, 4, 8}} {
+		result, err := DecodeBodyPart(bytes.NewBuffer(input))
+		assert.NoError(t, err)
+		assert.Equal(t, input, result.Bytes())
+	}
+
+	_, err := DecodeBodyId(bytes.NewBuffer([]byte{10}))
+	assert.Equal(t, errInvalidBodyIdType, err)
+	_, err = DecodeBodyPart(bytes.NewBuffer([]byte{5}))
+	assert.Equal(t, errInvalidBodyPartType, err)
+}
@@ -0,0 +1,33 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// OriginKindNative is the native origin of the location, for example a parachain origin.
+	OriginKindNative sc.U8 = iota
+	// OriginKindSovereignAccount is the signed origin of the sovereign account of the location.
+	OriginKindSovereignAccount
+	// OriginKindSuperuser is the root origin. It is granted only to trusted locations.
+	OriginKindSuperuser
+	// OriginKindXcm is the XCM origin of the location.
+	OriginKindXcm
+)
+
+// DecodeOriginKind decodes the kind of origin, which is used to dispatch a call with `Transact`.
+func DecodeOriginKind(buffer *bytes.Buffer) (sc.U8, error) {
+	value, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return 0, err
+	}
+
+	switch value {
+	case OriginKindNative, OriginKindSovereignAccount, OriginKindSuperuser, OriginKindXcm:
+		return value, nil
+	default:
+		return 0, errInvalidOriginKindType
+	}
+}
@@ -0,0 +1,83 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// OutcomeComplete means that the program was executed completely. It holds the used weight.
+	OutcomeComplete sc.U8 = iota
+	// OutcomeIncomplete means that the program was executed partially. It holds the used weight and the error.
+	OutcomeIncomplete
+	// OutcomeError means that the program was not executed. It holds the error.
+	OutcomeError
+)
+
+// Outcome is the result of the execution of an XCM program.
+type Outcome struct {
+	sc.VaryingData
+}
+
+func NewOutcomeComplete(used primitives.Weight) Outcome {
+	return Outcome{sc.NewVaryingData(OutcomeComplete, used)}
+}
+
+func NewOutcomeIncomplete(used primitives.Weight, err XcmError) Outcome {
+	return Outcome{sc.NewVaryingData(OutcomeIncomplete, used, err)}
+}
+
+func NewOutcomeError(err XcmError) Outcome {
+	return Outcome{sc.NewVaryingData(OutcomeError, err)}
+}
+
+func DecodeOutcome(buffer *bytes.Buffer) (Outcome, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return Outcome{}, err
+	}
+
+	switch b {
+	case OutcomeComplete:
+		used, err := primitives.DecodeWeight(buffer)
+		if err != nil {
+			return Outcome{}, err
+		}
+		return NewOutcomeComplete(used), nil
+	case OutcomeIncomplete:
+		used, err := primitives.DecodeWeight(buffer)
+		if err != nil {
+			return Outcome{}, err
+		}
+		xcmErr, err := DecodeXcmError(buffer)
+		if err != nil {
+			return Outcome{}, err
+		}
+		return NewOutcomeIncomplete(used, xcmErr), nil
+	case OutcomeError:
+		xcmErr, err := DecodeXcmError(buffer)
+		if err != nil {
+			return Outcome{}, err
+		}
+		return NewOutcomeError(xcmErr), nil
+	default:
+		return Outcome{}, errInvalidOutcomeType
+	}
+}
+
+// WeightUsed returns the weight used by the execution. It is zero, unless the program was executed.
+func (o Outcome) WeightUsed() primitives.Weight {
+	switch o.VaryingData[0] {
+	case OutcomeComplete, OutcomeIncomplete:
+		return o.VaryingData[1].(primitives.Weight)
+	default:
+		return primitives.WeightZero()
+	}
+}
+
+// IsComplete returns whether the program was executed completely.
+func (o Outcome) IsComplete() bool {
+	return o.VaryingData[0] == OutcomeComplete
+}
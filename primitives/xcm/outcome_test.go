@@ -0,0 +1,60 @@
+package xcm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Outcome_EncodeDecode(t *testing.T) {
+	weight := primitives.WeightFromParts(1000, 1)
+
+	for _, tt := range []struct {
+		name     string
+		outcome  Outcome
+		expected string
+	}{
+		{name: "Complete", outcome: NewOutcomeComplete(weight), expected: "00a10f04"},
+		{name: "Incomplete", outcome: NewOutcomeIncomplete(weight, NewXcmErrorBadOrigin()), expected: "01a10f0406"},
+		{name: "Error", outcome: NewOutcomeError(NewXcmErrorWeightLimitReached(weight)), expected: "0224a10f04"},
+		{name: "ErrorTrap", outcome: NewOutcomeError(NewXcmErrorTrap(7)), expected: "02150700000000000000"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, _ := hex.DecodeString(tt.expected)
+
+			assert.Equal(t, expected, tt.outcome.Bytes())
+
+			result, err := DecodeOutcome(bytes.NewBuffer(expected))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.outcome, result)
+		})
+	}
+}
+
+func Test_Outcome_WeightUsed(t *testing.T) {
+	weight := primitives.WeightFromParts(1000, 1)
+
+	assert.Equal(t, weight, NewOutcomeComplete(weight).WeightUsed())
+	assert.Equal(t, weight, NewOutcomeIncomplete(weight, NewXcmErrorOverflow()).WeightUsed())
+	assert.Equal(t, primitives.WeightZero(), NewOutcomeError(NewXcmErrorOverflow()).WeightUsed())
+}
+
+func Test_Outcome_IsComplete(t *testing.T) {
+	assert.True(t, NewOutcomeComplete(primitives.WeightZero()).IsComplete())
+	assert.False(t, NewOutcomeError(NewXcmErrorOverflow()).IsComplete())
+}
+
+func Test_DecodeXcmError_Invalid(t *testing.T) {
+	_, err := DecodeXcmError(bytes.NewBuffer([]byte{40}))
+
+	assert.Equal(t, errInvalidXcmErrorType, err)
+}
+
+func Test_XcmError_Error(t *testing.T) {
+	assert.Equal(t, "origin is invalid for the operation", NewXcmErrorBadOrigin().Error())
+	assert.Equal(t, "stack limit is exceeded", newXcmError(XcmErrorExceedsStackLimit).Error())
+	assert.Equal(t, errInvalidXcmErrorType.Error(), newXcmError(40).Error())
+}
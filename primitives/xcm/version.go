@@ -0,0 +1,20 @@
+package xcm
+
+import (
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// VersionV3 is the version of XCM, in which locations are called MultiLocation and assets are identified
+	// either by a concrete location or an abstract identifier.
+	VersionV3 sc.U8 = 3
+	// VersionV4 is the version of XCM, in which assets are identified only by their location.
+	VersionV4 sc.U8 = 4
+	// CurrentVersion is the latest version of XCM, which is used by the executor.
+	CurrentVersion = VersionV4
+)
+
+// isSupportedVersion returns whether `version` can be encoded and decoded.
+func isSupportedVersion(version sc.U8) bool {
+	return version == VersionV3 || version == VersionV4
+}
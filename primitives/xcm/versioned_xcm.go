@@ -0,0 +1,57 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// VersionedXcm is an XCM program, prefixed with the version of its encoding. The program is held in its
+// latest form, regardless of the version it is encoded with.
+type VersionedXcm struct {
+	Version sc.U8
+	Xcm     Xcm
+}
+
+// NewVersionedXcm returns `xcm` to be encoded with `version`.
+func NewVersionedXcm(version sc.U8, xcm Xcm) (VersionedXcm, error) {
+	if !isSupportedVersion(version) {
+		return VersionedXcm{}, errUnsupportedVersion
+	}
+	return VersionedXcm{Version: version, Xcm: xcm}, nil
+}
+
+func (vx VersionedXcm) Encode(buffer *bytes.Buffer) error {
+	if !isSupportedVersion(vx.Version) {
+		return errUnsupportedVersion
+	}
+	if err := vx.Version.Encode(buffer); err != nil {
+		return err
+	}
+	return vx.Xcm.encodeVersioned(buffer, vx.Version)
+}
+
+// DecodeVersionedXcm decodes an XCM program of a supported version.
+func DecodeVersionedXcm(buffer *bytes.Buffer) (VersionedXcm, error) {
+	version, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return VersionedXcm{}, err
+	}
+	if !isSupportedVersion(version) {
+		return VersionedXcm{}, errInvalidVersionedXcmType
+	}
+
+	xcm, err := decodeXcmVersioned(buffer, version)
+	if err != nil {
+		return VersionedXcm{}, err
+	}
+
+	return VersionedXcm{
+		Version: version,
+		Xcm:     xcm,
+	}, nil
+}
+
+func (vx VersionedXcm) Bytes() []byte {
+	return sc.EncodedBytes(vx)
+}
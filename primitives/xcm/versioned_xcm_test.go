@@ -0,0 +1,94 @@
+package xcm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	targetXcm = Xcm{
+		NewInstructionWithdrawAsset(Assets{targetAsset}),
+		NewInstructionClearOrigin(),
+		NewInstructionBuyExecution(targetAsset, NewWeightLimitUnlimited()),
+		NewInstructionDepositAsset(NewAssetFilterWild(NewWildAssetAllCounted(1)), NewLocationAccountId32(accountIdBytes)),
+	}
+
+	expectedBytesXcmV4 = "10" +
+		"00040100009101" +
+		"0a" +
+		"13010000910100" +
+		"0d010204" + "00010100" + hex.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	expectedBytesXcmV3 = "10" +
+		"0004000100009101" +
+		"0a" +
+		"1300010000910100" +
+		"0d010204" + "00010100" + hex.EncodeToString(bytes.Repeat([]byte{1}, 32))
+)
+
+func Test_VersionedXcm_Encode(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		version  sc.U8
+		expected string
+	}{
+		{name: "V3", version: VersionV3, expected: "03" + expectedBytesXcmV3},
+		{name: "V4", version: VersionV4, expected: "04" + expectedBytesXcmV4},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, _ := hex.DecodeString(tt.expected)
+			versionedXcm, err := NewVersionedXcm(tt.version, targetXcm)
+			assert.NoError(t, err)
+
+			assert.Equal(t, expected, versionedXcm.Bytes())
+
+			result, err := DecodeVersionedXcm(bytes.NewBuffer(expected))
+			assert.NoError(t, err)
+			assert.Equal(t, versionedXcm, result)
+		})
+	}
+}
+
+func Test_NewVersionedXcm_UnsupportedVersion(t *testing.T) {
+	_, err := NewVersionedXcm(2, targetXcm)
+
+	assert.Equal(t, errUnsupportedVersion, err)
+}
+
+func Test_DecodeVersionedXcm_UnsupportedVersion(t *testing.T) {
+	_, err := DecodeVersionedXcm(bytes.NewBuffer([]byte{2, 0}))
+
+	assert.Equal(t, errInvalidVersionedXcmType, err)
+}
+
+func Test_Xcm_Transact(t *testing.T) {
+	xcm := Xcm{
+		NewInstructionTransact(OriginKindSovereignAccount, primitives.WeightFromParts(1000, 0), sc.Sequence[sc.U8]{0, 1}),
+		NewInstructionSetTopic(sc.BytesToFixedSequenceU8(bytes.Repeat([]byte{5}, 32))),
+		NewInstructionClearTopic(),
+		NewInstructionReceiveTeleportedAsset(Assets{}),
+	}
+	expected, _ := hex.DecodeString("10" + "0601a10f00080001" + "2c" + hex.EncodeToString(bytes.Repeat([]byte{5}, 32)) + "2d" + "0200")
+
+	assert.Equal(t, expected, xcm.Bytes())
+
+	result, err := DecodeXcm(bytes.NewBuffer(expected))
+	assert.NoError(t, err)
+	assert.Equal(t, xcm, result)
+}
+
+func Test_DecodeXcm_UnsupportedInstruction(t *testing.T) {
+	_, err := DecodeXcm(bytes.NewBuffer([]byte{4, 1}))
+
+	assert.Equal(t, errUnsupportedInstruction, err)
+}
+
+func Test_DecodeInstruction_InvalidOriginKind(t *testing.T) {
+	_, err := DecodeInstruction(bytes.NewBuffer([]byte{6, 4}))
+
+	assert.Equal(t, errInvalidOriginKindType, err)
+}
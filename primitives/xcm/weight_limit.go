@@ -0,0 +1,56 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// WeightLimitUnlimited means that there is no limit on the weight.
+	WeightLimitUnlimited sc.U8 = iota
+	// WeightLimitLimited holds the maximum weight.
+	WeightLimitLimited
+)
+
+// WeightLimit is an optional limit on the weight, which is bought for execution.
+type WeightLimit struct {
+	sc.VaryingData
+}
+
+func NewWeightLimitUnlimited() WeightLimit {
+	return WeightLimit{sc.NewVaryingData(WeightLimitUnlimited)}
+}
+
+func NewWeightLimitLimited(weight primitives.Weight) WeightLimit {
+	return WeightLimit{sc.NewVaryingData(WeightLimitLimited, weight)}
+}
+
+func DecodeWeightLimit(buffer *bytes.Buffer) (WeightLimit, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return WeightLimit{}, err
+	}
+
+	switch b {
+	case WeightLimitUnlimited:
+		return NewWeightLimitUnlimited(), nil
+	case WeightLimitLimited:
+		weight, err := primitives.DecodeWeight(buffer)
+		if err != nil {
+			return WeightLimit{}, err
+		}
+		return NewWeightLimitLimited(weight), nil
+	default:
+		return WeightLimit{}, errInvalidWeightLimitType
+	}
+}
+
+// Limit returns the maximum weight, if it is limited.
+func (wl WeightLimit) Limit() sc.Option[primitives.Weight] {
+	if wl.VaryingData[0] == WeightLimitLimited {
+		return sc.NewOption[primitives.Weight](wl.VaryingData[1].(primitives.Weight))
+	}
+	return sc.NewOption[primitives.Weight](nil)
+}
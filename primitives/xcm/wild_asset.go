@@ -0,0 +1,170 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	// WildFungibilityFungible matches fungible assets.
+	WildFungibilityFungible sc.U8 = iota
+	// WildFungibilityNonFungible matches non-fungible assets.
+	WildFungibilityNonFungible
+)
+
+func DecodeWildFungibility(buffer *bytes.Buffer) (sc.U8, error) {
+	value, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return 0, err
+	}
+
+	switch value {
+	case WildFungibilityFungible, WildFungibilityNonFungible:
+		return value, nil
+	default:
+		return 0, errInvalidWildFungibilityType
+	}
+}
+
+const (
+	// WildAssetAll matches all assets.
+	WildAssetAll sc.U8 = iota
+	// WildAssetAllOf matches all assets of an asset class with the given fungibility.
+	WildAssetAllOf
+	// WildAssetAllCounted matches all assets, up to the given number of them.
+	WildAssetAllCounted
+	// WildAssetAllOfCounted matches all assets of an asset class with the given fungibility, up to the given
+	// number of them.
+	WildAssetAllOfCounted
+)
+
+// WildAsset matches assets without naming them exactly. It is called WildMultiAsset in XCM v3.
+type WildAsset struct {
+	sc.VaryingData
+}
+
+func NewWildAssetAll() WildAsset {
+	return WildAsset{sc.NewVaryingData(WildAssetAll)}
+}
+
+func NewWildAssetAllOf(id AssetId, fun sc.U8) WildAsset {
+	return WildAsset{sc.NewVaryingData(WildAssetAllOf, id, fun)}
+}
+
+func NewWildAssetAllCounted(count sc.U32) WildAsset {
+	return WildAsset{sc.NewVaryingData(WildAssetAllCounted, count)}
+}
+
+func NewWildAssetAllOfCounted(id AssetId, fun sc.U8, count sc.U32) WildAsset {
+	return WildAsset{sc.NewVaryingData(WildAssetAllOfCounted, id, fun, count)}
+}
+
+func (wa WildAsset) Encode(buffer *bytes.Buffer) error {
+	return wa.encodeVersioned(buffer, CurrentVersion)
+}
+
+func (wa WildAsset) encodeVersioned(buffer *bytes.Buffer, version sc.U8) error {
+	variant := wa.VaryingData[0].(sc.U8)
+	if err := variant.Encode(buffer); err != nil {
+		return err
+	}
+
+	switch variant {
+	case WildAssetAll:
+		return nil
+	case WildAssetAllOf:
+		if err := wa.VaryingData[1].(AssetId).encodeVersioned(buffer, version); err != nil {
+			return err
+		}
+		return wa.VaryingData[2].(sc.U8).Encode(buffer)
+	case WildAssetAllCounted:
+		return sc.ToCompact(wa.VaryingData[1].(sc.U32)).Encode(buffer)
+	case WildAssetAllOfCounted:
+		if err := wa.VaryingData[1].(AssetId).encodeVersioned(buffer, version); err != nil {
+			return err
+		}
+		return sc.EncodeEach(buffer,
+			wa.VaryingData[2].(sc.U8),
+			sc.ToCompact(wa.VaryingData[3].(sc.U32)),
+		)
+	default:
+		return errInvalidWildAssetType
+	}
+}
+
+func DecodeWildAsset(buffer *bytes.Buffer) (WildAsset, error) {
+	return decodeWildAssetVersioned(buffer, CurrentVersion)
+}
+
+func decodeWildAssetVersioned(buffer *bytes.Buffer, version sc.U8) (WildAsset, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return WildAsset{}, err
+	}
+
+	switch b {
+	case WildAssetAll:
+		return NewWildAssetAll(), nil
+	case WildAssetAllOf:
+		id, err := decodeAssetIdVersioned(buffer, version)
+		if err != nil {
+			return WildAsset{}, err
+		}
+		fun, err := DecodeWildFungibility(buffer)
+		if err != nil {
+			return WildAsset{}, err
+		}
+		return NewWildAssetAllOf(id, fun), nil
+	case WildAssetAllCounted:
+		count, err := decodeCompactU32(buffer)
+		if err != nil {
+			return WildAsset{}, err
+		}
+		return NewWildAssetAllCounted(count), nil
+	case WildAssetAllOfCounted:
+		id, err := decodeAssetIdVersioned(buffer, version)
+		if err != nil {
+			return WildAsset{}, err
+		}
+		fun, err := DecodeWildFungibility(buffer)
+		if err != nil {
+			return WildAsset{}, err
+		}
+		count, err := decodeCompactU32(buffer)
+		if err != nil {
+			return WildAsset{}, err
+		}
+		return NewWildAssetAllOfCounted(id, fun, count), nil
+	default:
+		return WildAsset{}, errInvalidWildAssetType
+	}
+}
+
+func (wa WildAsset) Bytes() []byte {
+	return sc.EncodedBytes(wa)
+}
+
+// Limit returns the maximum number of assets matched by `wa`, if it is counted.
+func (wa WildAsset) Limit() sc.Option[sc.U32] {
+	switch wa.VaryingData[0] {
+	case WildAssetAllCounted:
+		return sc.NewOption[sc.U32](wa.VaryingData[1].(sc.U32))
+	case WildAssetAllOfCounted:
+		return sc.NewOption[sc.U32](wa.VaryingData[3].(sc.U32))
+	default:
+		return sc.NewOption[sc.U32](nil)
+	}
+}
+
+// Matches returns whether `asset` is matched by `wa`, without taking the limit into account.
+func (wa WildAsset) Matches(asset Asset) bool {
+	switch wa.VaryingData[0] {
+	case WildAssetAllOf, WildAssetAllOfCounted:
+		id := wa.VaryingData[1].(AssetId)
+		fun := wa.VaryingData[2].(sc.U8)
+		return id.Equal(asset.Id) && (fun == WildFungibilityFungible) == asset.Fun.IsFungible()
+	default:
+		return true
+	}
+}
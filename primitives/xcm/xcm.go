@@ -0,0 +1,52 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// Xcm is a program of instructions, which are executed in order.
+type Xcm sc.Sequence[Instruction]
+
+func (x Xcm) Encode(buffer *bytes.Buffer) error {
+	return x.encodeVersioned(buffer, CurrentVersion)
+}
+
+func (x Xcm) encodeVersioned(buffer *bytes.Buffer, version sc.U8) error {
+	if err := sc.ToCompact(sc.U32(len(x))).Encode(buffer); err != nil {
+		return err
+	}
+	for _, instruction := range x {
+		if err := instruction.encodeVersioned(buffer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func DecodeXcm(buffer *bytes.Buffer) (Xcm, error) {
+	return decodeXcmVersioned(buffer, CurrentVersion)
+}
+
+func decodeXcmVersioned(buffer *bytes.Buffer, version sc.U8) (Xcm, error) {
+	length, err := decodeCompactU32(buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	xcm := Xcm{}
+	for i := sc.U32(0); i < length; i++ {
+		instruction, err := decodeInstructionVersioned(buffer, version)
+		if err != nil {
+			return nil, err
+		}
+		xcm = append(xcm, instruction)
+	}
+
+	return xcm, nil
+}
+
+func (x Xcm) Bytes() []byte {
+	return sc.EncodedBytes(x)
+}
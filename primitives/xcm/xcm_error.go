@@ -0,0 +1,202 @@
+package xcm
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	XcmErrorOverflow sc.U8 = iota
+	XcmErrorUnimplemented
+	XcmErrorUntrustedReserveLocation
+	XcmErrorUntrustedTeleportLocation
+	XcmErrorLocationFull
+	XcmErrorLocationNotInvertible
+	XcmErrorBadOrigin
+	XcmErrorInvalidLocation
+	XcmErrorAssetNotFound
+	XcmErrorFailedToTransactAsset
+	XcmErrorNotWithdrawable
+	XcmErrorLocationCannotHold
+	XcmErrorExceedsMaxMessageSize
+	XcmErrorDestinationUnsupported
+	XcmErrorTransport
+	XcmErrorUnroutable
+	XcmErrorUnknownClaim
+	XcmErrorFailedToDecode
+	XcmErrorMaxWeightInvalid
+	XcmErrorNotHoldingFees
+	XcmErrorTooExpensive
+	XcmErrorTrap
+	XcmErrorExpectationFalse
+	XcmErrorPalletNotFound
+	XcmErrorNameMismatch
+	XcmErrorVersionIncompatible
+	XcmErrorHoldingWouldOverflow
+	XcmErrorExportError
+	XcmErrorReanchorFailed
+	XcmErrorNoDeal
+	XcmErrorFeesNotMet
+	XcmErrorLockError
+	XcmErrorNoPermission
+	XcmErrorUnanchored
+	XcmErrorNotDepositable
+	XcmErrorUnhandledXcmVersion
+	XcmErrorWeightLimitReached
+	XcmErrorBarrier
+	XcmErrorWeightNotComputable
+	XcmErrorExceedsStackLimit
+)
+
+var xcmErrorMessages = []string{
+	"arithmetic overflow",
+	"instruction is not implemented",
+	"origin is not a trusted reserve of the asset",
+	"origin is not a trusted teleporter of the asset",
+	"location is full",
+	"location cannot be inverted",
+	"origin is invalid for the operation",
+	"location is invalid",
+	"asset is not found",
+	"failed to transact the asset",
+	"asset cannot be withdrawn",
+	"location cannot hold the asset",
+	"message exceeds the maximum size",
+	"destination is not supported",
+	"transport failed",
+	"destination is unroutable",
+	"claim is unknown",
+	"failed to decode",
+	"call requires more weight than the maximum",
+	"holding register does not contain the fees",
+	"fees are too expensive",
+	"trap",
+	"expectation is false",
+	"pallet is not found",
+	"pallet name mismatch",
+	"version is incompatible",
+	"holding register would overflow",
+	"export failed",
+	"reanchor failed",
+	"no deal",
+	"fees are not met",
+	"lock failed",
+	"no permission",
+	"location is unanchored",
+	"asset cannot be deposited",
+	"XCM version is not handled",
+	"weight limit is reached",
+	"barrier rejected the message",
+	"weight is not computable",
+	"stack limit is exceeded",
+}
+
+// XcmError is the reason, for which an XCM program failed to execute.
+type XcmError struct {
+	sc.VaryingData
+}
+
+func newXcmError(variant sc.U8) XcmError {
+	return XcmError{sc.NewVaryingData(variant)}
+}
+
+func NewXcmErrorOverflow() XcmError {
+	return newXcmError(XcmErrorOverflow)
+}
+
+func NewXcmErrorUnimplemented() XcmError {
+	return newXcmError(XcmErrorUnimplemented)
+}
+
+func NewXcmErrorUntrustedTeleportLocation() XcmError {
+	return newXcmError(XcmErrorUntrustedTeleportLocation)
+}
+
+func NewXcmErrorBadOrigin() XcmError {
+	return newXcmError(XcmErrorBadOrigin)
+}
+
+func NewXcmErrorAssetNotFound() XcmError {
+	return newXcmError(XcmErrorAssetNotFound)
+}
+
+func NewXcmErrorFailedToTransactAsset() XcmError {
+	return newXcmError(XcmErrorFailedToTransactAsset)
+}
+
+func NewXcmErrorNotWithdrawable() XcmError {
+	return newXcmError(XcmErrorNotWithdrawable)
+}
+
+func NewXcmErrorFailedToDecode() XcmError {
+	return newXcmError(XcmErrorFailedToDecode)
+}
+
+func NewXcmErrorMaxWeightInvalid() XcmError {
+	return newXcmError(XcmErrorMaxWeightInvalid)
+}
+
+func NewXcmErrorNotHoldingFees() XcmError {
+	return newXcmError(XcmErrorNotHoldingFees)
+}
+
+func NewXcmErrorTooExpensive() XcmError {
+	return newXcmError(XcmErrorTooExpensive)
+}
+
+func NewXcmErrorTrap(code sc.U64) XcmError {
+	return XcmError{sc.NewVaryingData(XcmErrorTrap, code)}
+}
+
+func NewXcmErrorUnhandledXcmVersion() XcmError {
+	return newXcmError(XcmErrorUnhandledXcmVersion)
+}
+
+func NewXcmErrorWeightLimitReached(weight primitives.Weight) XcmError {
+	return XcmError{sc.NewVaryingData(XcmErrorWeightLimitReached, weight)}
+}
+
+func NewXcmErrorBarrier() XcmError {
+	return newXcmError(XcmErrorBarrier)
+}
+
+func NewXcmErrorWeightNotComputable() XcmError {
+	return newXcmError(XcmErrorWeightNotComputable)
+}
+
+func DecodeXcmError(buffer *bytes.Buffer) (XcmError, error) {
+	b, err := sc.DecodeU8(buffer)
+	if err != nil {
+		return XcmError{}, err
+	}
+
+	switch b {
+	case XcmErrorTrap:
+		code, err := sc.DecodeU64(buffer)
+		if err != nil {
+			return XcmError{}, err
+		}
+		return NewXcmErrorTrap(code), nil
+	case XcmErrorWeightLimitReached:
+		weight, err := primitives.DecodeWeight(buffer)
+		if err != nil {
+			return XcmError{}, err
+		}
+		return NewXcmErrorWeightLimitReached(weight), nil
+	default:
+		if b > XcmErrorExceedsStackLimit {
+			return XcmError{}, errInvalidXcmErrorType
+		}
+		return newXcmError(b), nil
+	}
+}
+
+func (e XcmError) Error() string {
+	variant, ok := e.VaryingData[0].(sc.U8)
+	if !ok || variant > XcmErrorExceedsStackLimit {
+		return errInvalidXcmErrorType.Error()
+	}
+	return xcmErrorMessages[variant]
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"bytes"
+
+	"github.com/LimeChain/gosemble/execution/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// runtimeCallDecoder decodes calls with the runtime decoder, which is initialized after the modules.
+// It allows modules to decode calls, which are received in messages.
+type runtimeCallDecoder struct {
+	decoder types.RuntimeDecoder
+}
+
+// bind sets the runtime decoder, once it is initialized, and returns it.
+func (d *runtimeCallDecoder) bind(decoder types.RuntimeDecoder) types.RuntimeDecoder {
+	d.decoder = decoder
+	return decoder
+}
+
+func (d *runtimeCallDecoder) DecodeCall(buffer *bytes.Buffer) (primitives.Call, error) {
+	return d.decoder.DecodeCall(buffer)
+}
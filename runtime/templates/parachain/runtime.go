@@ -24,6 +24,7 @@ import (
 	"github.com/LimeChain/gosemble/frame/balances"
 	"github.com/LimeChain/gosemble/frame/executive"
 	"github.com/LimeChain/gosemble/frame/grandpa"
+	"github.com/LimeChain/gosemble/frame/message_queue"
 	"github.com/LimeChain/gosemble/frame/parachain_info"
 	"github.com/LimeChain/gosemble/frame/parachain_system"
 	"github.com/LimeChain/gosemble/frame/session"
@@ -32,6 +33,8 @@ import (
 	"github.com/LimeChain/gosemble/frame/timestamp"
 	"github.com/LimeChain/gosemble/frame/transaction_payment"
 	txExtensions "github.com/LimeChain/gosemble/frame/transaction_payment/extensions"
+	"github.com/LimeChain/gosemble/frame/xcm_executor"
+	"github.com/LimeChain/gosemble/frame/xcmp_queue"
 	"github.com/LimeChain/gosemble/hooks"
 	"github.com/LimeChain/gosemble/primitives/io"
 	"github.com/LimeChain/gosemble/primitives/log"
@@ -39,6 +42,7 @@ import (
 	sessiontypes "github.com/LimeChain/gosemble/primitives/session"
 	"github.com/LimeChain/gosemble/primitives/staking"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/primitives/xcm"
 )
 
 const (
@@ -53,6 +57,8 @@ const (
 const (
 	BalancesMaxLocks    = 50
 	BalancesMaxReserves = 50
+	BalancesMaxFreezes  = 50
+	BalancesMaxHolds    = 50
 )
 
 const (
@@ -81,6 +87,13 @@ var (
 	LengthToFee              primitives.WeightToFee = primitives.IdentityFee{}
 )
 
+var (
+	TargetBlockFullness = primitives.NewPerquintillFromPercent(25)
+	AdjustmentVariable  = primitives.NewFixedU128FromRational(sc.NewU128(75), sc.NewU128(1_000_000))
+	MinimumMultiplier   = primitives.NewFixedU128FromRational(sc.NewU128(1), sc.NewU128(1_000_000_000))
+	MaximumMultiplier   = primitives.MaxFixedU128()
+)
+
 var (
 	Period sc.U64 = 6 * Hours
 	Offset sc.U64 = 0
@@ -91,6 +104,41 @@ const (
 	RelayChainSlotDurationMillis = 6_000
 	BlockProcessingVelocity      = 1
 	UnincludedSegmentCapacity    = 1
+	MaxInboundMessageLen         = 64 * 1024
+)
+
+// MessageQueue
+const (
+	// MessageQueueHeapSize fits a message of MaxInboundMessageLen, preceded by its 5 byte item header.
+	MessageQueueHeapSize = MaxInboundMessageLen + 5
+	MessageQueueMaxStale = 8
+)
+
+// XcmpQueue
+const (
+	XcmpQueueMaxActiveOutboundChannels = 128
+	// XcmpQueueMaxPageSize is the maximum size of an outbound page, which fits the largest outbound message.
+	XcmpQueueMaxPageSize = 64 * 1024
+)
+
+var (
+	// ReservedDmpWeight and ReservedXcmpWeight are a quarter of the maximum block weight each.
+	ReservedDmpWeight  = primitives.WeightFromParts(constants.MaximumBlockWeight.RefTime/4, constants.MaximumBlockWeight.ProofSize/4)
+	ReservedXcmpWeight = primitives.WeightFromParts(constants.MaximumBlockWeight.RefTime/4, constants.MaximumBlockWeight.ProofSize/4)
+	// MessageQueueServiceWeight is 35% of the maximum block weight, used to service the message queues on each block.
+	MessageQueueServiceWeight = primitives.WeightFromParts(constants.MaximumBlockWeight.RefTime*35/100, constants.MaximumBlockWeight.ProofSize*35/100)
+)
+
+// XcmExecutor
+const (
+	XcmMaxInstructions = 100
+)
+
+var (
+	// XcmUnitWeightCost is the weight of a single XCM instruction.
+	XcmUnitWeightCost = primitives.WeightFromParts(1_000_000_000, 64*1024)
+	// RelayLocation is the location of the relay chain, whose native asset is used for the fees of XCM programs.
+	RelayLocation = xcm.NewLocationParent()
 )
 
 const (
@@ -104,6 +152,8 @@ const (
 	AuraIndex            = 32
 	AuraExtIndex         = 33
 	GrandpaIndex         = 34
+	MessageQueueIndex    = 35
+	XcmpQueueIndex       = 36
 )
 
 var (
@@ -129,10 +179,11 @@ var (
 	mdGenerator         = primitives.NewMetadataTypeGenerator()
 	ioStorage           = io.NewStorage()
 	ioTransactionBroker = io.NewTransactionBroker()
+	callDecoder         = &runtimeCallDecoder{}
 	// Modules contains all the modules used by the runtime.
-	modules = initializeModules(io.NewStorage())
+	modules = initializeModules(ioStorage, ioTransactionBroker, callDecoder)
 	extra   = newSignedExtra(modules)
-	decoder = types.NewRuntimeDecoder(modules, extra, sc.U8(0), ioStorage, ioTransactionBroker, logger)
+	decoder = callDecoder.bind(types.NewRuntimeDecoder(modules, extra, sc.U8(0), ioStorage, ioTransactionBroker, logger))
 )
 
 func initializeBlockDefaults() (primitives.BlockWeights, primitives.BlockLength) {
@@ -149,7 +200,7 @@ func initializeBlockDefaults() (primitives.BlockWeights, primitives.BlockLength)
 	return weights, length
 }
 
-func initializeModules(storage io.Storage) []primitives.Module {
+func initializeModules(storage io.Storage, transactionBroker io.TransactionBroker, callDecoder xcm_executor.CallDecoder) []primitives.Module {
 	systemModule := system.New(
 		SystemIndex,
 		system.NewConfig(storage, primitives.BlockHashCount{U32: sc.U32(constants.BlockHashCount)}, blockWeights, blockLength, DbWeight, RuntimeVersion, maxConsumers),
@@ -203,10 +254,74 @@ func initializeModules(storage io.Storage) []primitives.Module {
 	auraExtModule := aura_ext.New(AuraExtIndex, aura_ext.NewConfig(storage, DbWeight), auraModule, logger)
 	consensusHook := aura_ext.NewFixedVelocityConsensusHook(RelayChainSlotDurationMillis, BlockProcessingVelocity, UnincludedSegmentCapacity, DbWeight, auraExtModule, logger)
 	parachainInfoModule := parachain_info.New(ParachainInfoIndex, storage)
+
+	balancesModule := balances.New(
+		BalancesIndex,
+		balances.NewConfig(storage, DbWeight, BalancesMaxLocks, BalancesMaxReserves, BalancesMaxFreezes, BalancesMaxHolds, BalancesExistentialDeposit, systemModule),
+		mdGenerator,
+		logger,
+	)
+
+	relayAssetId := xcm.NewAssetId(RelayLocation)
+	locationConverter := xcm_executor.LocationConverters{
+		xcm_executor.ParentIsPreset{},
+		xcm_executor.SiblingParachainConvertsVia{},
+		xcm_executor.AccountId32Aliases{Network: sc.NewOption[xcm.NetworkId](nil)},
+	}
+	xcmExecutor := xcm_executor.New(
+		xcm_executor.NewConfig(
+			storage,
+			transactionBroker,
+			xcm_executor.NewFungibleAdapter(balancesModule, relayAssetId, locationConverter),
+			xcm_executor.SovereignSignedViaLocation{LocationConverter: locationConverter, ParentIsSuperuser: true},
+			xcm_executor.Case{AssetId: relayAssetId, Origin: RelayLocation},
+			xcm_executor.Barriers{
+				xcm_executor.AllowTopLevelPaidExecution{},
+				xcm_executor.AllowUnpaidExecutionFrom{Origin: RelayLocation},
+			},
+			xcm_executor.FixedWeightBounds{UnitWeightCost: XcmUnitWeightCost, MaxInstructions: XcmMaxInstructions},
+			xcm_executor.UsingComponents{WeightToFee: WeightToFee, AssetId: relayAssetId},
+			callDecoder,
+		),
+		logger,
+	)
+
+	messageQueueModule := message_queue.New(
+		MessageQueueIndex,
+		message_queue.NewConfig(
+			storage,
+			DbWeight,
+			systemModule,
+			xcm_executor.NewProcessXcmMessage(xcmExecutor),
+			MessageQueueHeapSize,
+			MessageQueueMaxStale,
+			sc.NewOption[primitives.Weight](MessageQueueServiceWeight),
+			sc.NewOption[primitives.Weight](MessageQueueServiceWeight),
+		),
+		mdGenerator,
+		logger,
+	)
+	xcmpQueueModule := xcmp_queue.New(
+		XcmpQueueIndex,
+		xcmp_queue.NewConfig(
+			storage,
+			DbWeight,
+			systemModule,
+			parachain_system.NewChannelInfo(storage),
+			messageQueueModule,
+			XcmpQueueMaxActiveOutboundChannels,
+			XcmpQueueMaxPageSize,
+			xcmp_queue.DefaultQueueConfigData(),
+		),
+		mdGenerator,
+		logger,
+	)
 	parachainSystemModule := parachain_system.New(
 		ParachainSystemIndex,
 		parachain_system.NewConfig(storage, DbWeight,
-			parachain_system.NewRelayNumberStrictlyIncreases(logger), parachainInfoModule, systemModule, consensusHook),
+			parachain_system.NewRelayNumberStrictlyIncreases(logger), parachainInfoModule, systemModule, consensusHook,
+			messageQueueModule, xcmpQueueModule, xcmpQueueModule,
+			ReservedDmpWeight, ReservedXcmpWeight, MaxInboundMessageLen),
 		mdGenerator,
 		logger)
 
@@ -216,16 +331,16 @@ func initializeModules(storage io.Storage) []primitives.Module {
 		mdGenerator,
 	)
 
-	balancesModule := balances.New(
-		BalancesIndex,
-		balances.NewConfig(storage, DbWeight, BalancesMaxLocks, BalancesMaxReserves, BalancesExistentialDeposit, systemModule),
-		mdGenerator,
-		logger,
-	)
-
 	tpmModule := transaction_payment.New(
 		TxPaymentsIndex,
-		transaction_payment.NewConfig(storage, OperationalFeeMultiplier, WeightToFee, LengthToFee, blockWeights),
+		transaction_payment.NewConfig(
+			storage,
+			OperationalFeeMultiplier,
+			WeightToFee,
+			LengthToFee,
+			blockWeights,
+			transaction_payment.NewTargetedFeeAdjustment(systemModule, TargetBlockFullness, AdjustmentVariable, MinimumMultiplier, MaximumMultiplier),
+		),
 		mdGenerator,
 	)
 
@@ -239,6 +354,8 @@ func initializeModules(storage io.Storage) []primitives.Module {
 		grandpaModule,
 		balancesModule,
 		tpmModule,
+		messageQueueModule,
+		xcmpQueueModule,
 	}
 }
 
@@ -255,7 +372,7 @@ func newSignedExtra(modules []primitives.Module) primitives.SignedExtra {
 		sysExtensions.NewCheckMortality(systemModule),
 		sysExtensions.NewCheckNonce(systemModule),
 		sysExtensions.NewCheckWeight(systemModule),
-		txExtensions.NewChargeTransactionPayment(systemModule, txPaymentModule, balancesModule),
+		txExtensions.NewChargeTransactionPayment(systemModule, txPaymentModule, balancesModule, hooks.NewDefaultOnUnbalanced(balancesModule)),
 	}
 
 	return primitives.NewSignedExtra(extras, mdGenerator)
@@ -521,10 +638,11 @@ func CollectCollationInfoCollectCollationInfo(dataPtr int32, dataLen int32) int6
 //go:export validate_block
 func ParachainValidateBlock(dataPtr int32, dataLen int32) int64 {
 	hostEnv := pvf.NewHostEnvironment(logger)
-	modules := initializeModules(hostEnv)
+	callDecoder := &runtimeCallDecoder{}
+	modules := initializeModules(hostEnv, hostEnv, callDecoder)
 
 	extra := newSignedExtra(modules)
-	decoder := types.NewRuntimeDecoder(modules, extra, sc.U8(0), hostEnv, hostEnv, logger)
+	decoder := callDecoder.bind(types.NewRuntimeDecoder(modules, extra, sc.U8(0), hostEnv, hostEnv, logger))
 	runtimeExtrinsic := extrinsic.New(modules, extra, mdGenerator, logger)
 	systemModule := primitives.MustGetModule(SystemIndex, modules).(system.Module)
 	auraExtModule := primitives.MustGetModule(AuraExtIndex, modules).(aura_ext.Module)
@@ -15,7 +15,7 @@ import (
 
 func Test_CreateDefaultConfig(t *testing.T) {
 	rt, _ := testhelpers.NewRuntimeInstance(t)
-	expectedGc := []byte("{\"system\":{},\"session\":{\"keys\":[]},\"aura\":{\"authorities\":[]},\"grandpa\":{\"authorities\":[]},\"balances\":{\"balances\":[]},\"transactionPayment\":{\"multiplier\":\"1\"},\"sudo\":{\"key\":\"\"}}")
+	expectedGc := []byte("{\"system\":{},\"session\":{\"keys\":[]},\"aura\":{\"authorities\":[]},\"grandpa\":{\"authorities\":[]},\"balances\":{\"balances\":[]},\"transactionPayment\":{\"multiplier\":\"1000000000000000000\"},\"sudo\":{\"key\":\"\"},\"vesting\":{\"vesting\":[]}}")
 
 	res, err := rt.Exec("GenesisBuilder_create_default_config", []byte{})
 	assert.NoError(t, err)
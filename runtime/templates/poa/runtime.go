@@ -19,9 +19,13 @@ import (
 	"github.com/LimeChain/gosemble/execution/extrinsic"
 	"github.com/LimeChain/gosemble/execution/types"
 	"github.com/LimeChain/gosemble/frame/aura"
+	"github.com/LimeChain/gosemble/frame/authorship"
 	"github.com/LimeChain/gosemble/frame/balances"
 	"github.com/LimeChain/gosemble/frame/executive"
 	"github.com/LimeChain/gosemble/frame/grandpa"
+	"github.com/LimeChain/gosemble/frame/multisig"
+	"github.com/LimeChain/gosemble/frame/proxy"
+	"github.com/LimeChain/gosemble/frame/scheduler"
 	"github.com/LimeChain/gosemble/frame/session"
 	"github.com/LimeChain/gosemble/frame/sudo"
 	"github.com/LimeChain/gosemble/frame/system"
@@ -30,6 +34,8 @@ import (
 	"github.com/LimeChain/gosemble/frame/timestamp"
 	"github.com/LimeChain/gosemble/frame/transaction_payment"
 	txExtensions "github.com/LimeChain/gosemble/frame/transaction_payment/extensions"
+	"github.com/LimeChain/gosemble/frame/utility"
+	"github.com/LimeChain/gosemble/frame/vesting"
 	"github.com/LimeChain/gosemble/hooks"
 	"github.com/LimeChain/gosemble/primitives/io"
 	"github.com/LimeChain/gosemble/primitives/log"
@@ -50,6 +56,31 @@ const (
 const (
 	BalancesMaxLocks    = 50
 	BalancesMaxReserves = 50
+	BalancesMaxFreezes  = 50
+	BalancesMaxHolds    = 50
+)
+
+const (
+	UtilityBatchedCallsLimit = 10_000
+)
+
+const (
+	MultisigMaxSignatories = 100
+)
+
+const (
+	ProxyMaxProxies = 32
+	ProxyMaxPending = 32
+)
+
+const (
+	SchedulerMaxScheduledPerBlock = 50
+	// SchedulerMaxCallSize is the maximum size of an encoded call, which can be scheduled.
+	SchedulerMaxCallSize = 128
+)
+
+const (
+	VestingMaxSchedules = 28
 )
 
 const (
@@ -68,6 +99,27 @@ var (
 	BalancesExistentialDeposit = sc.NewU128(1 * constants.Dollar)
 )
 
+var (
+	MultisigDepositBase   = sc.NewU128(1 * constants.Dollar)
+	MultisigDepositFactor = sc.NewU128(1 * constants.Cents)
+)
+
+var (
+	ProxyDepositBase          = sc.NewU128(1 * constants.Dollar)
+	ProxyDepositFactor        = sc.NewU128(1 * constants.Cents)
+	AnnouncementDepositBase   = sc.NewU128(1 * constants.Dollar)
+	AnnouncementDepositFactor = sc.NewU128(2 * constants.Cents)
+)
+
+var (
+	// SchedulerMaximumWeightRatio is the share of the block weight, which can be consumed by scheduled calls.
+	SchedulerMaximumWeightRatio = primitives.NewPerbillFromPercent(80)
+)
+
+var (
+	VestingMinVestedTransfer = sc.NewU128(1 * constants.Dollar)
+)
+
 var (
 	DbWeight = constants.RocksDbWeight
 )
@@ -76,6 +128,14 @@ var (
 	OperationalFeeMultiplier                        = sc.U8(5)
 	WeightToFee              primitives.WeightToFee = primitives.IdentityFee{}
 	LengthToFee              primitives.WeightToFee = primitives.IdentityFee{}
+	FeesAuthorShare                                 = primitives.NewPermillFromPercent(20)
+)
+
+var (
+	TargetBlockFullness = primitives.NewPerquintillFromPercent(25)
+	AdjustmentVariable  = primitives.NewFixedU128FromRational(sc.NewU128(75), sc.NewU128(1_000_000))
+	MinimumMultiplier   = primitives.NewFixedU128FromRational(sc.NewU128(1), sc.NewU128(1_000_000_000))
+	MaximumMultiplier   = primitives.MaxFixedU128()
 )
 
 var (
@@ -92,6 +152,12 @@ const (
 	BalancesIndex
 	TxPaymentsIndex
 	SudoIndex
+	AuthorshipIndex
+	UtilityIndex
+	MultisigIndex
+	ProxyIndex
+	SchedulerIndex
+	VestingIndex
 	TestableIndex = 255
 )
 
@@ -198,19 +264,116 @@ func initializeModules(storage io.Storage, transactionBroker io.TransactionBroke
 
 	balancesModule := balances.New(
 		BalancesIndex,
-		balances.NewConfig(storage, DbWeight, BalancesMaxLocks, BalancesMaxReserves, BalancesExistentialDeposit, systemModule),
+		balances.NewConfig(storage, DbWeight, BalancesMaxLocks, BalancesMaxReserves, BalancesMaxFreezes, BalancesMaxHolds, BalancesExistentialDeposit, systemModule),
 		mdGenerator,
 		logger,
 	)
 
 	tpmModule := transaction_payment.New(
 		TxPaymentsIndex,
-		transaction_payment.NewConfig(storage, OperationalFeeMultiplier, WeightToFee, LengthToFee, blockWeights),
+		transaction_payment.NewConfig(
+			storage,
+			OperationalFeeMultiplier,
+			WeightToFee,
+			LengthToFee,
+			blockWeights,
+			transaction_payment.NewTargetedFeeAdjustment(systemModule, TargetBlockFullness, AdjustmentVariable, MinimumMultiplier, MaximumMultiplier),
+		),
 		mdGenerator,
 	)
 
 	sudoModule := sudo.New(SudoIndex, sudo.NewConfig(storage, DbWeight, systemModule), mdGenerator, logger)
 
+	authorshipModule := authorship.New(
+		AuthorshipIndex,
+		authorship.NewConfig(
+			storage,
+			session.NewFindAccountFromAuthorIndex(sessionModule, auraModule),
+			authorship.DefaulthEventHandler{},
+			systemModule,
+		),
+		mdGenerator,
+		logger,
+	)
+
+	utilityModule := utility.New(
+		UtilityIndex,
+		utility.NewConfig(storage, transactionBroker, DbWeight, UtilityBatchedCallsLimit, systemModule),
+		mdGenerator,
+		logger,
+	)
+
+	multisigModule := multisig.New(
+		MultisigIndex,
+		multisig.NewConfig(storage, transactionBroker, DbWeight, balancesModule, systemModule, MultisigDepositBase, MultisigDepositFactor, MultisigMaxSignatories),
+		mdGenerator,
+		logger,
+	)
+
+	proxyModule := proxy.New(
+		ProxyIndex,
+		proxy.NewConfig(
+			storage,
+			transactionBroker,
+			DbWeight,
+			balancesModule,
+			systemModule,
+			proxy.TypeFilter{
+				BalancesIndex:  BalancesIndex,
+				UtilityIndex:   UtilityIndex,
+				MultisigIndex:  MultisigIndex,
+				ProxyIndex:     ProxyIndex,
+				SchedulerIndex: SchedulerIndex,
+				SudoIndex:      SudoIndex,
+				VestingIndex:   VestingIndex,
+			},
+			ProxyDepositBase,
+			ProxyDepositFactor,
+			ProxyMaxProxies,
+			ProxyMaxPending,
+			AnnouncementDepositBase,
+			AnnouncementDepositFactor,
+		),
+		mdGenerator,
+		logger,
+	)
+
+	schedulerMaximumWeight, err := SchedulerMaximumWeightRatio.Mul(blockWeights.MaxBlock)
+	if err != nil {
+		logger.Critical(err.Error())
+	}
+
+	schedulerModule := scheduler.New(
+		SchedulerIndex,
+		scheduler.NewConfig(
+			storage,
+			transactionBroker,
+			DbWeight,
+			systemModule,
+			runtimeCallDecoder{},
+			schedulerMaximumWeight.(primitives.Weight),
+			SchedulerMaxScheduledPerBlock,
+			system.EnsureRoot,
+			SchedulerMaxCallSize,
+		),
+		mdGenerator,
+		logger,
+	)
+
+	vestingModule := vesting.New(
+		VestingIndex,
+		vesting.NewConfig(
+			storage,
+			DbWeight,
+			balancesModule,
+			systemModule,
+			VestingMinVestedTransfer,
+			VestingMaxSchedules,
+		),
+		mdGenerator,
+		logger,
+	)
+
 	testableModule := tm.New(TestableIndex, storage, transactionBroker, mdGenerator)
 
 	return []primitives.Module{
@@ -222,6 +385,12 @@ func initializeModules(storage io.Storage, transactionBroker io.TransactionBroke
 		balancesModule,
 		tpmModule,
 		sudoModule,
+		authorshipModule,
+		utilityModule,
+		multisigModule,
+		proxyModule,
+		schedulerModule,
+		vestingModule,
 		testableModule,
 	}
 }
@@ -230,6 +399,9 @@ func newSignedExtra(modules []primitives.Module) primitives.SignedExtra {
 	systemModule := primitives.MustGetModule(SystemIndex, modules).(system.Module)
 	balancesModule := primitives.MustGetModule(BalancesIndex, modules).(balances.Module)
 	txPaymentModule := primitives.MustGetModule(TxPaymentsIndex, modules).(transaction_payment.Module)
+	authorshipModule := primitives.MustGetModule(AuthorshipIndex, modules).(authorship.Module)
+
+	dealWithFees := transaction_payment.NewDealWithFees(balancesModule, authorshipModule, FeesAuthorShare)
 
 	extras := []primitives.SignedExtension{
 		sysExtensions.NewCheckNonZeroAddress(),
@@ -239,7 +411,7 @@ func newSignedExtra(modules []primitives.Module) primitives.SignedExtra {
 		sysExtensions.NewCheckMortality(systemModule),
 		sysExtensions.NewCheckNonce(systemModule),
 		sysExtensions.NewCheckWeight(systemModule),
-		txExtensions.NewChargeTransactionPayment(systemModule, txPaymentModule, balancesModule),
+		txExtensions.NewChargeTransactionPayment(systemModule, txPaymentModule, balancesModule, dealWithFees),
 	}
 
 	return primitives.NewSignedExtra(extras, mdGenerator)
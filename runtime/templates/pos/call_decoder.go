@@ -0,0 +1,15 @@
+package main
+
+import (
+	"bytes"
+
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// runtimeCallDecoder decodes calls with the runtime decoder, which is initialized after the modules.
+// It allows modules to decode calls, which are stored in the state.
+type runtimeCallDecoder struct{}
+
+func (runtimeCallDecoder) DecodeCall(buffer *bytes.Buffer) (primitives.Call, error) {
+	return decoder.DecodeCall(buffer)
+}
@@ -23,8 +23,13 @@ import (
 	"github.com/LimeChain/gosemble/frame/balances"
 	"github.com/LimeChain/gosemble/frame/executive"
 	"github.com/LimeChain/gosemble/frame/grandpa"
+	"github.com/LimeChain/gosemble/frame/multisig"
+	"github.com/LimeChain/gosemble/frame/offences"
+	"github.com/LimeChain/gosemble/frame/proxy"
+	"github.com/LimeChain/gosemble/frame/scheduler"
 	"github.com/LimeChain/gosemble/frame/session"
 	session_historical "github.com/LimeChain/gosemble/frame/session_historical"
+	"github.com/LimeChain/gosemble/frame/staking"
 	"github.com/LimeChain/gosemble/frame/sudo"
 	"github.com/LimeChain/gosemble/frame/system"
 	sysExtensions "github.com/LimeChain/gosemble/frame/system/extensions"
@@ -32,10 +37,13 @@ import (
 	"github.com/LimeChain/gosemble/frame/timestamp"
 	"github.com/LimeChain/gosemble/frame/transaction_payment"
 	txExtensions "github.com/LimeChain/gosemble/frame/transaction_payment/extensions"
+	"github.com/LimeChain/gosemble/frame/utility"
+	"github.com/LimeChain/gosemble/frame/vesting"
 	"github.com/LimeChain/gosemble/hooks"
 	babetypes "github.com/LimeChain/gosemble/primitives/babe"
 	"github.com/LimeChain/gosemble/primitives/io"
 	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/npos_elections"
 	sessiontypes "github.com/LimeChain/gosemble/primitives/session"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
@@ -52,6 +60,40 @@ const (
 const (
 	BalancesMaxLocks    = 50
 	BalancesMaxReserves = 50
+	BalancesMaxFreezes  = 50
+	BalancesMaxHolds    = 50
+)
+
+const (
+	UtilityBatchedCallsLimit = 10_000
+)
+
+const (
+	MultisigMaxSignatories = 100
+)
+
+const (
+	ProxyMaxProxies = 32
+	ProxyMaxPending = 32
+)
+
+const (
+	SchedulerMaxScheduledPerBlock = 50
+	// SchedulerMaxCallSize is the maximum size of an encoded call, which can be scheduled.
+	SchedulerMaxCallSize = 128
+)
+
+const (
+	VestingMaxSchedules = 28
+)
+
+const (
+	StakingHistoryDepth                     = 84
+	StakingMaxNominations                   = 16
+	StakingMaxNominatorRewardedPerValidator = 64
+	StakingMaxUnlockingChunks               = 32
+	StakingMaxValidators                    = 1_000
+	StakingMaxNominators                    = 10_000
 )
 
 const (
@@ -70,6 +112,34 @@ var (
 	BalancesExistentialDeposit = sc.NewU128(1 * constants.Dollar)
 )
 
+var (
+	MultisigDepositBase   = sc.NewU128(1 * constants.Dollar)
+	MultisigDepositFactor = sc.NewU128(1 * constants.Cents)
+)
+
+var (
+	ProxyDepositBase          = sc.NewU128(1 * constants.Dollar)
+	ProxyDepositFactor        = sc.NewU128(1 * constants.Cents)
+	AnnouncementDepositBase   = sc.NewU128(1 * constants.Dollar)
+	AnnouncementDepositFactor = sc.NewU128(2 * constants.Cents)
+)
+
+var (
+	// SchedulerMaximumWeightRatio is the share of the block weight, which can be consumed by scheduled calls.
+	SchedulerMaximumWeightRatio = primitives.NewPerbillFromPercent(80)
+)
+
+var (
+	VestingMinVestedTransfer = sc.NewU128(1 * constants.Dollar)
+)
+
+var (
+	// StakingInflationPerEra is the share of the total issuance, which is paid out to the stakers of each era.
+	StakingInflationPerEra = primitives.NewPerbillFromPercent(1)
+	// StakingElectionBalancing configures the balancing of the stake of the nominators after each election.
+	StakingElectionBalancing = npos_elections.BalancingConfig{Iterations: 10, Tolerance: sc.NewU128(0)}
+)
+
 var (
 	DbWeight = constants.RocksDbWeight
 )
@@ -80,6 +150,13 @@ var (
 	LengthToFee              primitives.WeightToFee = primitives.IdentityFee{}
 )
 
+var (
+	TargetBlockFullness = primitives.NewPerquintillFromPercent(25)
+	AdjustmentVariable  = primitives.NewFixedU128FromRational(sc.NewU128(75), sc.NewU128(1_000_000))
+	MinimumMultiplier   = primitives.NewFixedU128FromRational(sc.NewU128(1), sc.NewU128(1_000_000_000))
+	MaximumMultiplier   = primitives.MaxFixedU128()
+)
+
 var (
 	Period sc.U64 = 6 * Hours
 	Offset sc.U64 = 0
@@ -96,6 +173,13 @@ const (
 	SudoIndex
 	SessionHistoricalIndex
 	AuthorshipIndex
+	UtilityIndex
+	MultisigIndex
+	ProxyIndex
+	SchedulerIndex
+	VestingIndex
+	StakingIndex
+	OffencesIndex
 	TestableIndex = 255
 )
 
@@ -125,6 +209,9 @@ var (
 	}
 
 	EpochDuration = constants.EpochDurationInSlots
+
+	// The longevity of unsigned BABE equivocation reports, in blocks.
+	BabeReportLongevity = sc.U64(BondingDuration*SessionsPerEra) * EpochDuration
 )
 
 var (
@@ -170,6 +257,49 @@ func initializeModules(storage io.Storage, transactionBroker io.TransactionBroke
 		logger,
 	)
 
+	balancesModule := balances.New(
+		BalancesIndex,
+		balances.NewConfig(storage, DbWeight, BalancesMaxLocks, BalancesMaxReserves, BalancesMaxFreezes, BalancesMaxHolds, BalancesExistentialDeposit, systemModule),
+		mdGenerator,
+		logger,
+	)
+
+	stakingModule := staking.New(
+		StakingIndex,
+		staking.NewConfig(
+			storage,
+			DbWeight,
+			balancesModule,
+			systemModule,
+			staking.NewFixedInflation(StakingInflationPerEra),
+			npos_elections.NewOnChainSequentialPhragmen(sc.NewOption[npos_elections.BalancingConfig](StakingElectionBalancing)),
+			SessionsPerEra,
+			BondingDuration,
+			StakingHistoryDepth,
+			StakingMaxNominations,
+			StakingMaxNominatorRewardedPerValidator,
+			StakingMaxUnlockingChunks,
+			StakingMaxValidators,
+			StakingMaxNominators,
+		),
+		mdGenerator,
+		logger,
+	)
+
+	offencesModule := offences.New(
+		OffencesIndex,
+		offences.NewConfig(storage, systemModule, stakingModule),
+		mdGenerator,
+		logger,
+	)
+
+	sessionHistoricalModule := session_historical.New(
+		SessionHistoricalIndex,
+		session_historical.NewConfig(storage, stakingModule, stakingModule),
+		mdGenerator,
+		logger,
+	)
+
 	handler := session.NewHandler([]sessiontypes.OneSessionHandler{})
 
 	periodicSession := session.NewPeriodicSessions(Period, Offset)
@@ -183,7 +313,7 @@ func initializeModules(storage io.Storage, transactionBroker io.TransactionBroke
 			systemModule,
 			periodicSession,
 			handler,
-			session.DefaultManager{},
+			sessionHistoricalModule,
 		),
 		mdGenerator,
 		logger,
@@ -205,18 +335,16 @@ func initializeModules(storage io.Storage, transactionBroker io.TransactionBroke
 			TimestampMinimumPeriod,
 			systemModule.StorageDigest,
 			systemModule,
+			offencesModule,
+			sessionHistoricalModule,
+			BabeReportLongevity,
 		),
 		mdGenerator,
 		logger,
 	)
 	sessionModule.AppendHandlers(babeModule)
-
-	sessionHistoricalModule := session_historical.New(
-		SessionHistoricalIndex,
-		session_historical.NewConfig(sessionModule),
-		mdGenerator,
-		logger,
-	)
+	stakingModule.SetSessionInterface(sessionModule)
+	sessionHistoricalModule.SetSessionModule(sessionModule)
 
 	sessionFindAccount := session.NewFindAccountFromAuthorIndex(sessionModule, babeModule)
 
@@ -225,14 +353,14 @@ func initializeModules(storage io.Storage, transactionBroker io.TransactionBroke
 		authorship.NewConfig(
 			storage,
 			sessionFindAccount,
-			authorship.DefaulthEventHandler{}, // TODO: implemented by "imonline" module
+			stakingModule,
 			systemModule,
 		),
 		mdGenerator,
 		logger,
 	)
 
-	grandpaEquivocationReportSystem := grandpa.NewEquivocationReportSystem(sessionHistoricalModule, authorshipModule, logger)
+	grandpaEquivocationReportSystem := grandpa.NewEquivocationReportSystem(sessionHistoricalModule, authorshipModule, offencesModule, logger)
 
 	grandpaModule := grandpa.New(
 		GrandpaIndex,
@@ -259,20 +387,98 @@ func initializeModules(storage io.Storage, transactionBroker io.TransactionBroke
 		mdGenerator,
 	)
 
-	balancesModule := balances.New(
-		BalancesIndex,
-		balances.NewConfig(storage, DbWeight, BalancesMaxLocks, BalancesMaxReserves, BalancesExistentialDeposit, systemModule),
+	tpmModule := transaction_payment.New(
+		TxPaymentsIndex,
+		transaction_payment.NewConfig(
+			storage,
+			OperationalFeeMultiplier,
+			WeightToFee,
+			LengthToFee,
+			blockWeights,
+			transaction_payment.NewTargetedFeeAdjustment(systemModule, TargetBlockFullness, AdjustmentVariable, MinimumMultiplier, MaximumMultiplier),
+		),
+		mdGenerator,
+	)
+
+	sudoModule := sudo.New(SudoIndex, sudo.NewConfig(storage, DbWeight, systemModule), mdGenerator, logger)
+
+	utilityModule := utility.New(
+		UtilityIndex,
+		utility.NewConfig(storage, transactionBroker, DbWeight, UtilityBatchedCallsLimit, systemModule),
 		mdGenerator,
 		logger,
 	)
 
-	tpmModule := transaction_payment.New(
-		TxPaymentsIndex,
-		transaction_payment.NewConfig(storage, OperationalFeeMultiplier, WeightToFee, LengthToFee, blockWeights),
+	multisigModule := multisig.New(
+		MultisigIndex,
+		multisig.NewConfig(storage, transactionBroker, DbWeight, balancesModule, systemModule, MultisigDepositBase, MultisigDepositFactor, MultisigMaxSignatories),
+		mdGenerator,
+		logger,
+	)
+
+	proxyModule := proxy.New(
+		ProxyIndex,
+		proxy.NewConfig(
+			storage,
+			transactionBroker,
+			DbWeight,
+			balancesModule,
+			systemModule,
+			proxy.TypeFilter{
+				BalancesIndex:  BalancesIndex,
+				UtilityIndex:   UtilityIndex,
+				MultisigIndex:  MultisigIndex,
+				ProxyIndex:     ProxyIndex,
+				SchedulerIndex: SchedulerIndex,
+				SudoIndex:      SudoIndex,
+				VestingIndex:   VestingIndex,
+			},
+			ProxyDepositBase,
+			ProxyDepositFactor,
+			ProxyMaxProxies,
+			ProxyMaxPending,
+			AnnouncementDepositBase,
+			AnnouncementDepositFactor,
+		),
 		mdGenerator,
+		logger,
 	)
 
-	sudoModule := sudo.New(SudoIndex, sudo.NewConfig(storage, DbWeight, systemModule), mdGenerator, logger)
+	schedulerMaximumWeight, err := SchedulerMaximumWeightRatio.Mul(blockWeights.MaxBlock)
+	if err != nil {
+		logger.Critical(err.Error())
+	}
+
+	schedulerModule := scheduler.New(
+		SchedulerIndex,
+		scheduler.NewConfig(
+			storage,
+			transactionBroker,
+			DbWeight,
+			systemModule,
+			runtimeCallDecoder{},
+			schedulerMaximumWeight.(primitives.Weight),
+			SchedulerMaxScheduledPerBlock,
+			system.EnsureRoot,
+			SchedulerMaxCallSize,
+		),
+		mdGenerator,
+		logger,
+	)
+
+	vestingModule := vesting.New(
+		VestingIndex,
+		vesting.NewConfig(
+			storage,
+			DbWeight,
+			balancesModule,
+			systemModule,
+			VestingMinVestedTransfer,
+			VestingMaxSchedules,
+		),
+		mdGenerator,
+		logger,
+	)
 
 	testableModule := tm.New(TestableIndex, storage, transactionBroker, mdGenerator)
 
@@ -285,6 +491,13 @@ func initializeModules(storage io.Storage, transactionBroker io.TransactionBroke
 		balancesModule,
 		tpmModule,
 		sudoModule,
+		utilityModule,
+		multisigModule,
+		proxyModule,
+		schedulerModule,
+		vestingModule,
+		stakingModule,
+		offencesModule,
 		testableModule,
 	}
 }
@@ -302,7 +515,7 @@ func newSignedExtra() primitives.SignedExtra {
 		sysExtensions.NewCheckMortality(systemModule),
 		sysExtensions.NewCheckNonce(systemModule),
 		sysExtensions.NewCheckWeight(systemModule),
-		txExtensions.NewChargeTransactionPayment(systemModule, txPaymentModule, balancesModule),
+		txExtensions.NewChargeTransactionPayment(systemModule, txPaymentModule, balancesModule, hooks.NewDefaultOnUnbalanced(balancesModule)),
 	}
 
 	return primitives.NewSignedExtra(extras, mdGenerator)